@@ -1,11 +1,13 @@
 package auth
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
@@ -24,6 +26,7 @@ type Credentials struct {
 
 const (
 	credentialsFilePath                                = ".stackit/credentials.json" //nolint:gosec // linter false positive
+	profilesFilePath                                   = ".stackit/credentials"      //nolint:gosec // linter false positive
 	tokenCredentialType                 credentialType = "token"
 	serviceAccountKeyCredentialType     credentialType = "service_account_key"
 	serviceAccountKeyPathCredentialType credentialType = "service_account_key_path"
@@ -34,7 +37,8 @@ const (
 var userHomeDir = os.UserHomeDir
 
 // SetupAuth sets up authentication based on the configuration. The different options are
-// custom authentication, no authentication, explicit key flow, explicit token flow or default authentication
+// custom authentication, no authentication, shared token source, explicit workload identity flow, explicit key flow, explicit token flow,
+// the credential provider set with config.WithCredentialProvider or default authentication
 func SetupAuth(cfg *config.Configuration) (rt http.RoundTripper, err error) {
 	if cfg == nil {
 		cfg = &config.Configuration{}
@@ -50,6 +54,18 @@ func SetupAuth(cfg *config.Configuration) (rt http.RoundTripper, err error) {
 			return nil, fmt.Errorf("configuring no auth client: %w", err)
 		}
 		return noAuthRoundTripper, nil
+	} else if cfg.TokenSource != nil {
+		tokenSourceRoundTripper, err := TokenSourceAuth(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("configuring token source authentication: %w", err)
+		}
+		return tokenSourceRoundTripper, nil
+	} else if cfg.WorkloadIdentityTokenPath != "" {
+		workloadIdentityRoundTripper, err := WorkloadIdentityAuth(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("configuring workload identity authentication: %w", err)
+		}
+		return workloadIdentityRoundTripper, nil
 	} else if cfg.ServiceAccountKey != "" || cfg.ServiceAccountKeyPath != "" {
 		keyRoundTripper, err := KeyAuth(cfg)
 		if err != nil {
@@ -62,6 +78,12 @@ func SetupAuth(cfg *config.Configuration) (rt http.RoundTripper, err error) {
 			return nil, fmt.Errorf("configuring token authentication: %w", err)
 		}
 		return tokenRoundTripper, nil
+	} else if cfg.CredentialProvider != nil {
+		providerRoundTripper, err := cfg.CredentialProvider.Provide(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("configuring authentication with %s: %w", cfg.CredentialProvider.Name(), err)
+		}
+		return providerRoundTripper, nil
 	}
 	authRoundTripper, err := DefaultAuth(cfg)
 	if err != nil {
@@ -70,30 +92,106 @@ func SetupAuth(cfg *config.Configuration) (rt http.RoundTripper, err error) {
 	return authRoundTripper, nil
 }
 
-// DefaultAuth will search for a valid service account key or token in several locations.
-// It will first try to use the key flow, by looking into the variables STACKIT_SERVICE_ACCOUNT_KEY, STACKIT_SERVICE_ACCOUNT_KEY_PATH,
+// AuthenticatedHTTPClient returns an HTTP client which authenticates its requests like the generated API clients do,
+// e.g. for a third-party library which talks to a STACKIT API that the SDK doesn't cover. The options are the ones of
+// the generated API clients, all requests are sent with the same transport stack: the middlewares, retries and
+// statistics (see config.AssembleTransport) on top of the authentication set up by SetupAuth.
+//
+// The token is refreshed by the returned client, in the same way as in the generated API clients: with the key flow,
+// an expired access token is refreshed before sending a request, and in the background if WithBackgroundTokenRefresh is used.
+//
+// The options which only apply to an API, e.g. WithRegion or WithEndpoint, have no effect.
+// It lives in this package instead of config, as it uses SetupAuth.
+func AuthenticatedHTTPClient(opts ...config.ConfigurationOption) (*http.Client, error) {
+	cfg := &config.Configuration{
+		DefaultHeader: make(map[string]string),
+		HTTPClient:    &http.Client{},
+	}
+	for _, option := range opts {
+		if err := option(cfg); err != nil {
+			return nil, fmt.Errorf("configuring the client: %w", err)
+		}
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{}
+	}
+
+	authRoundTripper, err := SetupAuth(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("setting up authentication: %w", err)
+	}
+
+	// The HTTP client is copied, so that an HTTP client provided with config.WithHTTPClient can be shared
+	httpClient := *cfg.HTTPClient
+	httpClient.Transport = config.AssembleTransport(cfg, authRoundTripper)
+	return &httpClient, nil
+}
+
+// NewTokenSource returns a clients.TokenSource for the credentials set up by SetupAuth with the options, to be shared
+// by the clients of several APIs with config.WithTokenSource:
+//
+//	tokenSource, err := auth.NewTokenSource(config.WithServiceAccountKeyPath("sa_key.json"))
+//	dnsClient, err := dns.NewAPIClient(config.WithTokenSource(tokenSource))
+//	skeClient, err := ske.NewAPIClient(config.WithTokenSource(tokenSource))
+//
+// The clients share the access token, which is refreshed once when it expires, or in the background if
+// WithBackgroundTokenRefresh is used. The authentication options of the clients have no effect, and the token
+// requests are sent with the HTTP transport of the options, not the ones of the clients.
+func NewTokenSource(opts ...config.ConfigurationOption) (clients.TokenSource, error) {
+	cfg := &config.Configuration{}
+	for _, option := range opts {
+		if err := option(cfg); err != nil {
+			return nil, fmt.Errorf("configuring the token source: %w", err)
+		}
+	}
+	if cfg.NoAuth || cfg.CustomAuth != nil {
+		return nil, fmt.Errorf("configuring the token source: a token source can't be created without authentication or with a custom authentication")
+	}
+
+	authRoundTripper, err := SetupAuth(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("setting up authentication: %w", err)
+	}
+	tokenSource, ok := authRoundTripper.(clients.TokenSource)
+	if !ok {
+		return nil, fmt.Errorf("the authentication flow %T is not a token source", authRoundTripper)
+	}
+	return tokenSource, nil
+}
+
+// TokenSourceAuth configures a flow with the token source set with config.WithTokenSource and returns an
+// http.RoundTripper that can be used to make authenticated requests using its access tokens
+func TokenSourceAuth(cfg *config.Configuration) (http.RoundTripper, error) {
+	tokenSourceCfg := clients.TokenSourceFlowConfig{
+		TokenSource: cfg.TokenSource,
+	}
+
+	if transport := cfg.HTTPTransport(); transport != nil {
+		tokenSourceCfg.HTTPTransport = transport
+	}
+
+	client := &clients.TokenSourceFlow{}
+	if err := client.Init(&tokenSourceCfg); err != nil {
+		return nil, fmt.Errorf("error initializing client: %w", err)
+	}
+
+	return client, nil
+}
+
+// DefaultAuth will search for a valid service account key or token in several locations, with the providers of
+// DefaultCredentialChain in order. If STACKIT_FEDERATED_TOKEN_FILE is set, it uses the WorkloadIdentityAuth flow, see config.WithWorkloadIdentity,
+// and fails if the flow can't be used, without trying the other flows.
+// Otherwise, it will first try to use the key flow, by looking into the variables STACKIT_SERVICE_ACCOUNT_KEY, STACKIT_SERVICE_ACCOUNT_KEY_PATH,
 // STACKIT_PRIVATE_KEY and STACKIT_PRIVATE_KEY_PATH. If the keys cannot be retrieved, it will check the credentials file located in STACKIT_CREDENTIALS_PATH, if specified, or in
 // $HOME/.stackit/credentials.json as a fallback. If the key are found and are valid, the KeyAuth flow is used.
 // If the key flow cannot be used, it will try to find a token in the STACKIT_SERVICE_ACCOUNT_TOKEN. If not present, it will
 // search in the credentials file. If the token is found, the TokenAuth flow is used.
 // DefaultAuth returns an http.RoundTripper that can be used to make authenticated requests.
-// In case the token is not found, DefaultAuth fails.
+// In case the token is not found, DefaultAuth fails with a *CredentialChainError listing the errors of all flows.
+//
+// The order of the flows can be changed, and flows can be added or removed, with config.WithCredentialProvider.
 func DefaultAuth(cfg *config.Configuration) (rt http.RoundTripper, err error) {
-	if cfg == nil {
-		cfg = &config.Configuration{}
-	}
-
-	// Key flow
-	rt, err = KeyAuth(cfg)
-	if err != nil {
-		keyFlowErr := err
-		// Token flow
-		rt, err = TokenAuth(cfg)
-		if err != nil {
-			return nil, fmt.Errorf("no valid credentials were found: trying key flow: %s, trying token flow: %w", keyFlowErr.Error(), err)
-		}
-	}
-	return rt, nil
+	return DefaultCredentialChain().Provide(cfg)
 }
 
 // NoAuth configures a flow without authentication and returns an http.RoundTripper
@@ -110,8 +208,8 @@ func NoAuth(cfgs ...*config.Configuration) (rt http.RoundTripper, err error) {
 		cfg = &config.Configuration{}
 	}
 
-	if cfg.HTTPClient != nil && cfg.HTTPClient.Transport != nil {
-		noAuthConfig.HTTPTransport = cfg.HTTPClient.Transport
+	if transport := cfg.HTTPTransport(); transport != nil {
+		noAuthConfig.HTTPTransport = transport
 	}
 
 	if err := noAuthRoundTripper.Init(noAuthConfig); err != nil {
@@ -127,7 +225,7 @@ func TokenAuth(cfg *config.Configuration) (http.RoundTripper, error) {
 	if cfg.Token == "" {
 		token, tokenSet := os.LookupEnv("STACKIT_SERVICE_ACCOUNT_TOKEN")
 		if !tokenSet || token == "" {
-			credentials, err := readCredentialsFile(cfg.CredentialsFilePath)
+			credentials, err := readCredentials(cfg)
 			if err != nil {
 				return nil, fmt.Errorf("reading from credentials file: %w", err)
 			}
@@ -141,10 +239,11 @@ func TokenAuth(cfg *config.Configuration) (http.RoundTripper, error) {
 
 	tokenCfg := clients.TokenFlowConfig{
 		ServiceAccountToken: cfg.Token,
+		TokenListener:       cfg.TokenListener,
 	}
 
-	if cfg.HTTPClient != nil && cfg.HTTPClient.Transport != nil {
-		tokenCfg.HTTPTransport = cfg.HTTPClient.Transport
+	if transport := cfg.HTTPTransport(); transport != nil {
+		tokenCfg.HTTPTransport = transport
 	}
 
 	client := &clients.TokenFlow{}
@@ -155,6 +254,51 @@ func TokenAuth(cfg *config.Configuration) (http.RoundTripper, error) {
 	return client, nil
 }
 
+// WorkloadIdentityAuth configures the workload identity flow and returns an http.RoundTripper
+// that can be used to make authenticated requests using an access token, which is exchanged for the ID token
+// in the file set with config.WithWorkloadIdentity, or in STACKIT_FEDERATED_TOKEN_FILE with the audience in
+// STACKIT_WORKLOAD_IDENTITY_AUDIENCE.
+func WorkloadIdentityAuth(cfg *config.Configuration) (http.RoundTripper, error) {
+	if cfg.WorkloadIdentityTokenPath == "" {
+		cfg.WorkloadIdentityTokenPath = os.Getenv(clients.FederatedTokenFile)
+	}
+	if cfg.WorkloadIdentityAudience == "" {
+		cfg.WorkloadIdentityAudience = os.Getenv(clients.WorkloadIdentityAudience)
+	}
+	if cfg.WorkloadIdentityTokenPath == "" {
+		return nil, fmt.Errorf("%s not set", clients.FederatedTokenFile)
+	}
+	if cfg.WorkloadIdentityAudience == "" {
+		return nil, fmt.Errorf("%s not set", clients.WorkloadIdentityAudience)
+	}
+
+	if cfg.TokenCustomUrl == "" {
+		tokenCustomUrl, tokenUrlSet := os.LookupEnv("STACKIT_TOKEN_BASEURL")
+		if tokenUrlSet {
+			cfg.TokenCustomUrl = tokenCustomUrl
+		}
+	}
+
+	workloadIdentityCfg := clients.WorkloadIdentityFlowConfig{
+		TokenFilePath: cfg.WorkloadIdentityTokenPath,
+		Audience:      cfg.WorkloadIdentityAudience,
+		TokenUrl:      cfg.TokenCustomUrl,
+		AuthEventHook: cfg.AuthEventHook(),
+		TokenListener: cfg.TokenListener,
+	}
+
+	if transport := cfg.HTTPTransport(); transport != nil {
+		workloadIdentityCfg.HTTPTransport = transport
+	}
+
+	client := &clients.WorkloadIdentityFlow{}
+	if err := client.Init(&workloadIdentityCfg); err != nil {
+		return nil, fmt.Errorf("error initializing client: %w", err)
+	}
+
+	return client, nil
+}
+
 // KeyAuth configures the key flow and returns an http.RoundTripper
 // that can be used to make authenticated requests using an access token
 // The KeyFlow requires a service account key and a private key.
@@ -177,6 +321,7 @@ func KeyAuth(cfg *config.Configuration) (http.RoundTripper, error) {
 
 	// Try to get private key from configuration, environment or credentials file
 	err = getPrivateKey(cfg)
+	privateKeyExtracted := err != nil
 	if err != nil {
 		// If the private key is not provided explicitly, try to extract private key from the service account key
 		// and use it if present
@@ -202,10 +347,26 @@ func KeyAuth(cfg *config.Configuration) (http.RoundTripper, error) {
 		PrivateKey:                    cfg.PrivateKey,
 		TokenUrl:                      cfg.TokenCustomUrl,
 		BackgroundTokenRefreshContext: cfg.BackgroundTokenRefreshContext,
+		KeyExpiryWarningThreshold:     cfg.ServiceAccountKeyExpiryWarningThreshold,
+		KeyExpiryWarningHook:          cfg.ServiceAccountKeyExpiryWarningHook,
+		TokenStore:                    cfg.TokenStore,
+		AuthEventHook:                 cfg.AuthEventHook(),
+		RandSource:                    cfg.RandSource,
+		RefreshGroup:                  cfg.RefreshGroup,
+		AssertionAlgorithm:            cfg.AssertionAlgorithm,
+		TokenListener:                 cfg.TokenListener,
+	}
+
+	if cfg.KeyReloadInterval > 0 {
+		if cfg.ServiceAccountKeyPath == "" {
+			return nil, fmt.Errorf("configuring key authentication: the key can only be reloaded if the service account key is read from a file, see config.WithServiceAccountKeyPath")
+		}
+		keyCfg.KeyReloadInterval = cfg.KeyReloadInterval
+		keyCfg.KeyReloadFunc = keyReloader(cfg.ServiceAccountKeyPath, cfg.PrivateKeyPath, cfg.PrivateKey, privateKeyExtracted)
 	}
 
-	if cfg.HTTPClient != nil && cfg.HTTPClient.Transport != nil {
-		keyCfg.HTTPTransport = cfg.HTTPClient.Transport
+	if transport := cfg.HTTPTransport(); transport != nil {
+		keyCfg.HTTPTransport = transport
 	}
 
 	client := &clients.KeyFlow{}
@@ -216,6 +377,109 @@ func KeyAuth(cfg *config.Configuration) (http.RoundTripper, error) {
 	return client, nil
 }
 
+// readCredentials reads the credentials of the selected profile from the profiles file, see config.WithProfile.
+// If no profile is selected, it reads the credentials file.
+func readCredentials(cfg *config.Configuration) (*Credentials, error) {
+	profile := cfg.Profile
+	if profile == "" {
+		profile = os.Getenv("STACKIT_PROFILE")
+	}
+	if profile == "" {
+		return readCredentialsFile(cfg.CredentialsFilePath)
+	}
+	return readProfile(cfg.CredentialsFilePath, profile)
+}
+
+// readProfile reads the profiles file from the specified path and returns the Credentials of the profile.
+// The profiles file is either a JSON object with the profile names as keys or an INI file with a section per profile.
+func readProfile(path, profile string) (*Credentials, error) {
+	if path == "" {
+		customPath, customPathSet := os.LookupEnv("STACKIT_CREDENTIALS_PATH")
+		if !customPathSet || customPath == "" {
+			home, err := userHomeDir()
+			if err != nil {
+				return nil, fmt.Errorf("getting home directory: %w", err)
+			}
+			path = filepath.Join(home, profilesFilePath)
+		} else {
+			path = customPath
+		}
+	}
+
+	profilesRaw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening file: %w", err)
+	}
+
+	var profiles map[string]json.RawMessage
+	if trimmed := bytes.TrimSpace(profilesRaw); len(trimmed) > 0 && trimmed[0] == '{' {
+		err = json.Unmarshal(trimmed, &profiles)
+	} else {
+		profiles, err = parseINIProfiles(profilesRaw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing profiles file %s: %w", path, err)
+	}
+
+	profileRaw, ok := profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in profiles file %s", profile, path)
+	}
+	var credentials Credentials
+	err = json.Unmarshal(profileRaw, &credentials)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshalling credentials of profile %q: %w", profile, err)
+	}
+	return &credentials, nil
+}
+
+// parseINIProfiles parses an INI file with a section per profile, e.g.
+//
+//	[default]
+//	STACKIT_SERVICE_ACCOUNT_KEY_PATH = /path/to/sa_key.json
+//
+//	[prod]
+//	STACKIT_SERVICE_ACCOUNT_TOKEN = token
+//
+// The credentials of each profile are returned as JSON, with the same keys as the credentials file.
+func parseINIProfiles(raw []byte) (map[string]json.RawMessage, error) {
+	sections := map[string]map[string]string{}
+	var section map[string]string
+	for i, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := sections[name]; !ok {
+				sections[name] = map[string]string{}
+			}
+			section = sections[name]
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found || section == nil {
+			return nil, fmt.Errorf("invalid line %d", i+1)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			value = value[1 : len(value)-1]
+		}
+		section[strings.TrimSpace(key)] = value
+	}
+
+	profiles := make(map[string]json.RawMessage, len(sections))
+	for name, values := range sections {
+		profileRaw, err := json.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+		profiles[name] = profileRaw
+	}
+	return profiles, nil
+}
+
 // readCredentialsFile reads the credentials file from the specified path and returns Credentials
 func readCredentialsFile(path string) (*Credentials, error) {
 	if path == "" {
@@ -292,7 +556,7 @@ func getServiceAccountEmail(cfg *config.Configuration) string {
 
 	email, emailSet := os.LookupEnv("STACKIT_SERVICE_ACCOUNT_EMAIL")
 	if !emailSet || email == "" {
-		credentials, err := readCredentialsFile(cfg.CredentialsFilePath)
+		credentials, err := readCredentials(cfg)
 		if err != nil {
 			// email is not required for authentication, so it shouldnt block it
 			return ""
@@ -303,7 +567,7 @@ func getServiceAccountEmail(cfg *config.Configuration) string {
 }
 
 // getKey searches for a key in the following order: client configuration, environment variable, credentials file.
-func getKey(cfgKey, cfgKeyPath *string, envVarKeyPath, envVarKey string, credTypePath, credTypeKey credentialType, cfgCredFilePath string) error {
+func getKey(cfgKey, cfgKeyPath *string, envVarKeyPath, envVarKey string, credTypePath, credTypeKey credentialType, cfg *config.Configuration) error {
 	if *cfgKey != "" {
 		return nil
 	}
@@ -314,7 +578,7 @@ func getKey(cfgKey, cfgKeyPath *string, envVarKeyPath, envVarKey string, credTyp
 		key, keySet := os.LookupEnv(envVarKey)
 		// if both are not set -> read from credentials file
 		if (!keyPathSet || keyPath == "") && (!keySet || key == "") {
-			credentials, err := readCredentialsFile(cfgCredFilePath)
+			credentials, err := readCredentials(cfg)
 			if err != nil {
 				return fmt.Errorf("reading from credentials file: %w", err)
 			}
@@ -336,23 +600,60 @@ func getKey(cfgKey, cfgKeyPath *string, envVarKeyPath, envVarKey string, credTyp
 		}
 		*cfgKeyPath = keyPath
 	}
-	keyBytes, err := os.ReadFile(*cfgKeyPath)
+	key, err := readKeyFile(*cfgKeyPath)
 	if err != nil {
-		return fmt.Errorf("reading key from file path: %w", err)
+		return err
+	}
+	*cfgKey = key
+	return nil
+}
+
+func readKeyFile(path string) (string, error) {
+	keyBytes, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading key from file path: %w", err)
 	}
 	if len(keyBytes) == 0 {
-		return fmt.Errorf("key path points to an empty file")
+		return "", fmt.Errorf("key path points to an empty file")
+	}
+	return string(keyBytes), nil
+}
+
+// keyReloader returns a function which reads the service account key from serviceAccountKeyPath, and the private
+// key from privateKeyPath if set, otherwise from the service account key if extractPrivateKey is set, see
+// config.WithKeyReload. If neither is set, the private key is privateKey.
+func keyReloader(serviceAccountKeyPath, privateKeyPath, privateKey string, extractPrivateKey bool) func() (*clients.ServiceAccountKeyResponse, string, error) {
+	return func() (*clients.ServiceAccountKeyResponse, string, error) {
+		rawKey, err := readKeyFile(serviceAccountKeyPath)
+		if err != nil {
+			return nil, "", fmt.Errorf("reloading service account key: %w", err)
+		}
+		serviceAccountKey := &clients.ServiceAccountKeyResponse{}
+		if err := json.Unmarshal([]byte(rawKey), serviceAccountKey); err != nil {
+			return nil, "", fmt.Errorf("unmarshalling reloaded service account key: %w", err)
+		}
+		switch {
+		case privateKeyPath != "":
+			privateKey, err = readKeyFile(privateKeyPath)
+			if err != nil {
+				return nil, "", fmt.Errorf("reloading private key: %w", err)
+			}
+		case extractPrivateKey:
+			privateKey = ""
+			if serviceAccountKey.Credentials != nil && serviceAccountKey.Credentials.PrivateKey != nil {
+				privateKey = *serviceAccountKey.Credentials.PrivateKey
+			}
+		}
+		return serviceAccountKey, privateKey, nil
 	}
-	*cfgKey = string(keyBytes)
-	return nil
 }
 
 // getServiceAccountKey configures the service account key in the provided configuration
 func getServiceAccountKey(cfg *config.Configuration) error {
-	return getKey(&cfg.ServiceAccountKey, &cfg.ServiceAccountKeyPath, "STACKIT_SERVICE_ACCOUNT_KEY_PATH", "STACKIT_SERVICE_ACCOUNT_KEY", serviceAccountKeyPathCredentialType, serviceAccountKeyCredentialType, cfg.CredentialsFilePath)
+	return getKey(&cfg.ServiceAccountKey, &cfg.ServiceAccountKeyPath, "STACKIT_SERVICE_ACCOUNT_KEY_PATH", "STACKIT_SERVICE_ACCOUNT_KEY", serviceAccountKeyPathCredentialType, serviceAccountKeyCredentialType, cfg)
 }
 
 // getPrivateKey configures the private key in the provided configuration
 func getPrivateKey(cfg *config.Configuration) error {
-	return getKey(&cfg.PrivateKey, &cfg.PrivateKeyPath, "STACKIT_PRIVATE_KEY_PATH", "STACKIT_PRIVATE_KEY", privateKeyPathCredentialType, privateKeyCredentialType, cfg.CredentialsFilePath)
+	return getKey(&cfg.PrivateKey, &cfg.PrivateKeyPath, "STACKIT_PRIVATE_KEY_PATH", "STACKIT_PRIVATE_KEY", privateKeyPathCredentialType, privateKeyCredentialType, cfg)
 }
@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 	"encoding/pem"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -259,6 +261,226 @@ func TestSetupAuth(t *testing.T) {
 	}
 }
 
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestKeyAuthServiceAccountKeyReader(t *testing.T) {
+	setTemporaryHome(t)
+	privateKey, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Generating private key: %s", err)
+	}
+	saKey, err := json.Marshal(fixtureServiceAccountKey())
+	if err != nil {
+		t.Fatalf("Marshalling service account key: %s", err)
+	}
+
+	// The key is read from a pipe, which can't be read with WithServiceAccountKeyPath
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Creating pipe: %s", err)
+	}
+	defer r.Close()
+	go func() {
+		_, _ = w.Write(saKey)
+		_ = w.Close()
+	}()
+
+	cfg := &config.Configuration{PrivateKey: privateKey}
+	if err := config.WithServiceAccountKeyReader(r)(cfg); err != nil {
+		t.Fatalf("WithServiceAccountKeyReader failed: %v", err)
+	}
+	authRoundTripper, err := SetupAuth(cfg)
+	if err != nil {
+		t.Fatalf("SetupAuth failed: %v", err)
+	}
+	if _, ok := authRoundTripper.(*clients.KeyFlow); !ok {
+		t.Fatalf("expected the key flow, got %T", authRoundTripper)
+	}
+}
+
+func TestKeyAuthKeyReload(t *testing.T) {
+	setTemporaryHome(t)
+	writeKey := func(path string) *clients.ServiceAccountKeyResponse {
+		t.Helper()
+		privateKey, err := generatePrivateKey()
+		if err != nil {
+			t.Fatalf("Generating private key: %s", err)
+		}
+		saKey := fixtureServiceAccountKey(func(k *clients.ServiceAccountKeyResponse) {
+			k.Credentials.PrivateKey = &privateKey
+		})
+		saKeyJSON, err := json.Marshal(saKey)
+		if err != nil {
+			t.Fatalf("Marshalling service account key: %s", err)
+		}
+		if err := os.WriteFile(path, saKeyJSON, 0o600); err != nil {
+			t.Fatalf("Writing service account key: %s", err)
+		}
+		return saKey
+	}
+	saKeyPath := filepath.Join(t.TempDir(), "sa_key.json")
+	writeKey(saKeyPath)
+
+	cfg := &config.Configuration{ServiceAccountKeyPath: saKeyPath}
+	if err := config.WithKeyReload(time.Minute)(cfg); err != nil {
+		t.Fatalf("WithKeyReload failed: %v", err)
+	}
+	authRoundTripper, err := KeyAuth(cfg)
+	if err != nil {
+		t.Fatalf("KeyAuth failed: %v", err)
+	}
+	if _, ok := authRoundTripper.(*clients.KeyFlow); !ok {
+		t.Fatalf("expected the key flow, got %T", authRoundTripper)
+	}
+
+	// The rotated key is read with the private key embedded in it
+	rotated := writeKey(saKeyPath)
+	serviceAccountKey, privateKey, err := keyReloader(saKeyPath, "", "", true)()
+	if err != nil {
+		t.Fatalf("reloading the key failed: %v", err)
+	}
+	if serviceAccountKey.Credentials.Kid != rotated.Credentials.Kid || privateKey != *rotated.Credentials.PrivateKey {
+		t.Fatalf("expected the rotated key")
+	}
+	if err := os.WriteFile(saKeyPath, nil, 0o600); err != nil {
+		t.Fatalf("Truncating service account key: %s", err)
+	}
+	if _, _, err := keyReloader(saKeyPath, "", "", true)(); err == nil {
+		t.Fatalf("expected an error for an empty file")
+	}
+
+	// The key can't be reloaded if it isn't read from a file
+	saKeyJSON, err := json.Marshal(rotated)
+	if err != nil {
+		t.Fatalf("Marshalling service account key: %s", err)
+	}
+	cfg = &config.Configuration{ServiceAccountKey: string(saKeyJSON), KeyReloadInterval: time.Minute}
+	if _, err := KeyAuth(cfg); err == nil {
+		t.Fatalf("expected an error without a service account key path")
+	}
+}
+
+func TestWorkloadIdentityAuth(t *testing.T) {
+	setTemporaryHome(t)
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenPath, []byte("id-token"), 0o600); err != nil {
+		t.Fatalf("Writing ID token: %s", err)
+	}
+
+	// The option takes precedence over the key flow
+	cfg := &config.Configuration{ServiceAccountKey: "invalid"}
+	if err := config.WithWorkloadIdentity(tokenPath, "aud")(cfg); err != nil {
+		t.Fatalf("WithWorkloadIdentity failed: %v", err)
+	}
+	authRoundTripper, err := SetupAuth(cfg)
+	if err != nil {
+		t.Fatalf("SetupAuth failed: %v", err)
+	}
+	flow, ok := authRoundTripper.(*clients.WorkloadIdentityFlow)
+	if !ok {
+		t.Fatalf("expected the workload identity flow, got %T", authRoundTripper)
+	}
+	if flowCfg := flow.GetConfig(); flowCfg.TokenFilePath != tokenPath || flowCfg.Audience != "aud" {
+		t.Fatalf("unexpected flow config %+v", flowCfg)
+	}
+
+	// The default authentication uses the environment
+	t.Setenv(clients.FederatedTokenFile, tokenPath)
+	t.Setenv(clients.WorkloadIdentityAudience, "env-aud")
+	authRoundTripper, err = DefaultAuth(&config.Configuration{})
+	if err != nil {
+		t.Fatalf("DefaultAuth failed: %v", err)
+	}
+	flow, ok = authRoundTripper.(*clients.WorkloadIdentityFlow)
+	if !ok || flow.GetConfig().Audience != "env-aud" {
+		t.Fatalf("expected the workload identity flow with the audience of the environment, got %T", authRoundTripper)
+	}
+
+	t.Setenv(clients.WorkloadIdentityAudience, "")
+	if _, err := DefaultAuth(&config.Configuration{}); err == nil {
+		t.Fatalf("expected an error without an audience")
+	}
+}
+
+func TestNewTokenSource(t *testing.T) {
+	setTemporaryHome(t)
+	tokenSource, err := NewTokenSource(config.WithToken("token"))
+	if err != nil {
+		t.Fatalf("NewTokenSource failed: %v", err)
+	}
+	if _, ok := tokenSource.(*clients.TokenFlow); !ok {
+		t.Fatalf("expected the token flow, got %T", tokenSource)
+	}
+
+	// The token source takes precedence over the credentials of the client
+	cfg := &config.Configuration{ServiceAccountKey: "invalid"}
+	if err := config.WithTokenSource(tokenSource)(cfg); err != nil {
+		t.Fatalf("WithTokenSource failed: %v", err)
+	}
+	authRoundTripper, err := SetupAuth(cfg)
+	if err != nil {
+		t.Fatalf("SetupAuth failed: %v", err)
+	}
+	flow, ok := authRoundTripper.(*clients.TokenSourceFlow)
+	if !ok || flow.GetConfig().TokenSource != tokenSource {
+		t.Fatalf("expected the token source flow, got %T", authRoundTripper)
+	}
+
+	if _, err := NewTokenSource(config.WithoutAuthentication()); err == nil {
+		t.Fatalf("expected an error without authentication")
+	}
+	if _, err := NewTokenSource(config.WithCustomAuth(http.DefaultTransport)); err == nil {
+		t.Fatalf("expected an error with a custom authentication")
+	}
+}
+
+func TestAuthenticatedHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("expected Authorization header to be 'Bearer token', but got %s", r.Header.Get("Authorization"))
+		}
+		if r.Header.Get("X-Middleware") != "set" {
+			t.Errorf("expected the middleware to be applied")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	middleware := func(rt http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Middleware", "set")
+			return rt.RoundTrip(req)
+		})
+	}
+	client, err := AuthenticatedHTTPClient(config.WithToken("token"), config.WithMiddleware(middleware), config.WithTimeout(time.Minute))
+	if err != nil {
+		t.Fatalf("AuthenticatedHTTPClient failed: %v", err)
+	}
+	if client.Timeout != time.Minute {
+		t.Errorf("expected timeout %v, got %v", time.Minute, client.Timeout)
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("sending request: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestAuthenticatedHTTPClientInvalidOption(t *testing.T) {
+	_, err := AuthenticatedHTTPClient(config.WithDialTimeout(0))
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
 func TestReadCredentials(t *testing.T) {
 	for _, test := range []struct {
 		desc               string
@@ -326,6 +548,105 @@ func TestReadCredentials(t *testing.T) {
 	}
 }
 
+func TestReadProfile(t *testing.T) {
+	for _, test := range []struct {
+		desc               string
+		path               string
+		profile            string
+		profileEnv         string
+		credentialType     credentialType
+		isValid            bool
+		expectedCredential string
+	}{
+		{
+			desc:               "json_profile",
+			path:               "test_resources/test_profiles.json",
+			profile:            "bar",
+			credentialType:     tokenCredentialType,
+			isValid:            true,
+			expectedCredential: "bar_token",
+		},
+		{
+			desc:               "ini_profile",
+			path:               "test_resources/test_profiles.ini",
+			profile:            "bar",
+			credentialType:     serviceAccountKeyPathCredentialType,
+			isValid:            true,
+			expectedCredential: "bar_key_path",
+		},
+		{
+			desc:               "ini_quoted_value",
+			path:               "test_resources/test_profiles.ini",
+			profile:            "bar",
+			credentialType:     tokenCredentialType,
+			isValid:            true,
+			expectedCredential: "bar_token",
+		},
+		{
+			desc:               "profile_env",
+			path:               "test_resources/test_profiles.ini",
+			profileEnv:         "default",
+			credentialType:     tokenCredentialType,
+			isValid:            true,
+			expectedCredential: "default_token",
+		},
+		{
+			desc:               "profile_over_env",
+			path:               "test_resources/test_profiles.json",
+			profile:            "bar",
+			profileEnv:         "default",
+			credentialType:     tokenCredentialType,
+			isValid:            true,
+			expectedCredential: "bar_token",
+		},
+		{
+			desc:           "profile_not_found",
+			path:           "test_resources/test_profiles.json",
+			profile:        "foo",
+			credentialType: tokenCredentialType,
+			isValid:        false,
+		},
+		{
+			desc:           "invalid_ini",
+			path:           "test_resources/test_invalid_profiles.ini",
+			profile:        "default",
+			credentialType: tokenCredentialType,
+			isValid:        false,
+		},
+		{
+			desc:               "no_profile_reads_credentials_file",
+			path:               "test_resources/test_credentials_bar.json",
+			credentialType:     tokenCredentialType,
+			isValid:            true,
+			expectedCredential: "bar_token",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			setTemporaryHome(t)
+			t.Setenv("STACKIT_CREDENTIALS_PATH", "")
+			t.Setenv("STACKIT_PROFILE", test.profileEnv)
+
+			var credential string
+			credentials, err := readCredentials(&config.Configuration{CredentialsFilePath: test.path, Profile: test.profile})
+			if err == nil {
+				credential, err = readCredential(test.credentialType, credentials)
+			}
+
+			if err != nil && test.isValid {
+				t.Fatalf("Test returned error on valid test case: %v", err)
+			}
+
+			if err == nil && !test.isValid {
+				t.Fatalf("Test didn't return error on invalid test case")
+			}
+
+			if test.isValid && credential != test.expectedCredential {
+				t.Fatalf("Credential is not correct. Expected %s, got %s", test.expectedCredential, credential)
+			}
+		})
+	}
+}
+
 func TestDefaultAuth(t *testing.T) {
 	privateKey, err := generatePrivateKey()
 	if err != nil {
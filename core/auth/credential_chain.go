@@ -0,0 +1,310 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+// Names of the credential providers of the SDK, e.g. to remove them from a chain with CredentialChain.Without
+const (
+	WorkloadIdentityProviderName = "workload identity flow"
+	KeyProviderName              = "key flow"
+	TokenProviderName            = "token flow"
+	CLIAuthProviderName          = "STACKIT CLI"
+)
+
+const (
+	// cliTokenCommandTimeout limits the time the command of a CLIAuthProvider has to print an access token
+	cliTokenCommandTimeout = 30 * time.Second
+	// cliTokenLeeway is the time before its expiry in which an access token of the STACKIT CLI is replaced
+	cliTokenLeeway = time.Minute
+	// cliOpaqueTokenLifetime is the time an access token of the STACKIT CLI without expiry is reused
+	cliOpaqueTokenLifetime = time.Minute
+)
+
+// defaultCLIAuthCommand prints the access token of the user logged in to the STACKIT CLI
+var defaultCLIAuthCommand = []string{"stackit", "auth", "get-access-token"}
+
+// CredentialChain is a config.CredentialProvider which tries its providers in order and uses the first one with
+// valid credentials, see config.WithCredentialProvider. If none of them has valid credentials, it returns a
+// *CredentialChainError with the errors of all providers. A provider whose credentials are set but invalid stops
+// the chain with a *TerminalCredentialError, so that the next providers aren't used instead.
+//
+// A CredentialChain is immutable, the methods which change the providers return a new chain.
+type CredentialChain struct {
+	providers []config.CredentialProvider
+}
+
+// NewCredentialChain returns a CredentialChain which tries the providers in order
+func NewCredentialChain(providers ...config.CredentialProvider) *CredentialChain {
+	return &CredentialChain{providers: append([]config.CredentialProvider{}, providers...)}
+}
+
+// DefaultCredentialChain returns the chain used by DefaultAuth: the workload identity flow if
+// STACKIT_FEDERATED_TOKEN_FILE is set, the key flow and the token flow, with the credentials in the environment or
+// in the credentials file
+func DefaultCredentialChain() *CredentialChain {
+	return NewCredentialChain(WorkloadIdentityProvider(), KeyProvider(), TokenProvider())
+}
+
+// Providers returns the providers of the chain in order
+func (c *CredentialChain) Providers() []config.CredentialProvider {
+	return append([]config.CredentialProvider{}, c.providers...)
+}
+
+// Append returns a chain which tries the providers after the ones of c
+func (c *CredentialChain) Append(providers ...config.CredentialProvider) *CredentialChain {
+	return NewCredentialChain(append(c.Providers(), providers...)...)
+}
+
+// Prepend returns a chain which tries the providers before the ones of c
+func (c *CredentialChain) Prepend(providers ...config.CredentialProvider) *CredentialChain {
+	return NewCredentialChain(append(append([]config.CredentialProvider{}, providers...), c.providers...)...)
+}
+
+// Without returns a chain without the providers with the names, e.g. Without(TokenProviderName)
+func (c *CredentialChain) Without(names ...string) *CredentialChain {
+	providers := []config.CredentialProvider{}
+	for _, provider := range c.providers {
+		removed := false
+		for _, name := range names {
+			if provider.Name() == name {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			providers = append(providers, provider)
+		}
+	}
+	return &CredentialChain{providers: providers}
+}
+
+// Name returns the name of the chain, with the names of its providers
+func (c *CredentialChain) Name() string {
+	names := make([]string, 0, len(c.providers))
+	for _, provider := range c.providers {
+		names = append(names, provider.Name())
+	}
+	return fmt.Sprintf("credential chain (%s)", strings.Join(names, ", "))
+}
+
+// Provide returns the RoundTripper of the first provider with valid credentials
+func (c *CredentialChain) Provide(cfg *config.Configuration) (http.RoundTripper, error) {
+	if cfg == nil {
+		cfg = &config.Configuration{}
+	}
+	chainErr := &CredentialChainError{}
+	for _, provider := range c.providers {
+		rt, err := provider.Provide(cfg)
+		if err == nil {
+			return rt, nil
+		}
+		chainErr.Attempts = append(chainErr.Attempts, CredentialProviderAttempt{Provider: provider.Name(), Err: err})
+		var terminalErr *TerminalCredentialError
+		if errors.As(err, &terminalErr) {
+			return nil, chainErr
+		}
+	}
+	return nil, chainErr
+}
+
+// TerminalCredentialError is returned by a provider of a CredentialChain whose credentials are set but invalid,
+// e.g. the workload identity flow with STACKIT_FEDERATED_TOKEN_FILE set but no audience. It stops the chain, so
+// that a misconfigured workload doesn't authenticate with other credentials found on the machine.
+type TerminalCredentialError struct {
+	Err error
+}
+
+func (e *TerminalCredentialError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TerminalCredentialError) Unwrap() error {
+	return e.Err
+}
+
+// CredentialProviderAttempt is a provider of a CredentialChain which had no valid credentials
+type CredentialProviderAttempt struct {
+	// Provider is the name of the provider, e.g. KeyProviderName
+	Provider string
+	Err      error
+}
+
+// CredentialChainError is returned by a CredentialChain if none of its providers has valid credentials
+type CredentialChainError struct {
+	// Attempts are the providers of the chain which were tried in order, with their errors. If the last error is a
+	// *TerminalCredentialError, the providers after it weren't tried.
+	Attempts []CredentialProviderAttempt
+}
+
+func (e *CredentialChainError) Error() string {
+	if len(e.Attempts) == 0 {
+		return "no valid credentials were found: the credential chain has no providers"
+	}
+	tried := make([]string, 0, len(e.Attempts))
+	for _, attempt := range e.Attempts {
+		tried = append(tried, fmt.Sprintf("trying %s: %s", attempt.Provider, attempt.Err))
+	}
+	return "no valid credentials were found: " + strings.Join(tried, ", ")
+}
+
+// Unwrap returns the errors of the providers, so that they can be checked with errors.Is and errors.As
+func (e *CredentialChainError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Attempts))
+	for _, attempt := range e.Attempts {
+		errs = append(errs, attempt.Err)
+	}
+	return errs
+}
+
+// credentialProviderFunc is a config.CredentialProvider which sets up the authentication with a function
+type credentialProviderFunc struct {
+	name    string
+	provide func(cfg *config.Configuration) (http.RoundTripper, error)
+}
+
+// NewCredentialProvider returns a config.CredentialProvider with the name, which sets up the authentication with
+// provide, e.g. to add credentials from a secret store to a CredentialChain
+func NewCredentialProvider(name string, provide func(cfg *config.Configuration) (http.RoundTripper, error)) config.CredentialProvider {
+	return &credentialProviderFunc{name: name, provide: provide}
+}
+
+func (p *credentialProviderFunc) Name() string {
+	return p.name
+}
+
+func (p *credentialProviderFunc) Provide(cfg *config.Configuration) (http.RoundTripper, error) {
+	return p.provide(cfg)
+}
+
+// WorkloadIdentityProvider returns a config.CredentialProvider which uses WorkloadIdentityAuth if
+// STACKIT_FEDERATED_TOKEN_FILE is set. If the flow fails, e.g. because STACKIT_WORKLOAD_IDENTITY_AUDIENCE isn't set,
+// it stops the chain with a *TerminalCredentialError.
+func WorkloadIdentityProvider() config.CredentialProvider {
+	return NewCredentialProvider(WorkloadIdentityProviderName, func(cfg *config.Configuration) (http.RoundTripper, error) {
+		if tokenPath, ok := os.LookupEnv(clients.FederatedTokenFile); !ok || tokenPath == "" {
+			return nil, fmt.Errorf("%s not set", clients.FederatedTokenFile)
+		}
+		// WorkloadIdentityAuth sets the token path and the audience of the environment, which must not be left in
+		// the configuration if the flow fails
+		workloadIdentityCfg := *cfg
+		rt, err := WorkloadIdentityAuth(&workloadIdentityCfg)
+		if err != nil {
+			return nil, &TerminalCredentialError{Err: fmt.Errorf("%s is set, trying workload identity flow: %w", clients.FederatedTokenFile, err)}
+		}
+		return rt, nil
+	})
+}
+
+// KeyProvider returns a config.CredentialProvider which uses KeyAuth with the keys in the environment or in the
+// credentials file
+func KeyProvider() config.CredentialProvider {
+	return NewCredentialProvider(KeyProviderName, KeyAuth)
+}
+
+// TokenProvider returns a config.CredentialProvider which uses TokenAuth with the token in the environment or in the
+// credentials file
+func TokenProvider() config.CredentialProvider {
+	return NewCredentialProvider(TokenProviderName, TokenAuth)
+}
+
+// CLIAuthProvider is a config.CredentialProvider which authenticates the requests with the access tokens of the user
+// logged in to the STACKIT CLI with "stackit auth login", e.g. for tools run on the machine of a developer.
+// The access token is printed by the command, and requested again shortly before it expires.
+type CLIAuthProvider struct {
+	// Command prints the access token, by default "stackit auth get-access-token"
+	Command []string
+}
+
+// Name returns CLIAuthProviderName
+func (p *CLIAuthProvider) Name() string {
+	return CLIAuthProviderName
+}
+
+// Provide returns a RoundTripper which authenticates the requests with the access tokens printed by the command,
+// or an error if the command isn't installed or fails, e.g. because no user is logged in
+func (p *CLIAuthProvider) Provide(cfg *config.Configuration) (http.RoundTripper, error) {
+	command := p.Command
+	if len(command) == 0 {
+		command = defaultCLIAuthCommand
+	}
+	if _, err := exec.LookPath(command[0]); err != nil {
+		return nil, fmt.Errorf("finding the STACKIT CLI: %w", err)
+	}
+	tokenSource := &cliTokenSource{command: command, now: time.Now}
+	// The token is requested once, so that the chain tries the next provider without a logged in user
+	if _, err := tokenSource.GetAccessToken(); err != nil {
+		return nil, err
+	}
+
+	tokenSourceCfg := clients.TokenSourceFlowConfig{TokenSource: tokenSource}
+	if cfg != nil {
+		if transport := cfg.HTTPTransport(); transport != nil {
+			tokenSourceCfg.HTTPTransport = transport
+		}
+	}
+	client := &clients.TokenSourceFlow{}
+	if err := client.Init(&tokenSourceCfg); err != nil {
+		return nil, fmt.Errorf("error initializing client: %w", err)
+	}
+	return client, nil
+}
+
+// cliTokenSource is a clients.TokenSource which runs a command of the STACKIT CLI to get the access tokens
+type cliTokenSource struct {
+	command []string
+	now     func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// GetAccessToken returns the last access token of the command, or runs it again if the token is about to expire
+func (s *cliTokenSource) GetAccessToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && s.now().Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cliTokenCommandTimeout)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...) //nolint:gosec // the command is set by the caller
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("getting access token with %q: %w: %s", strings.Join(s.command, " "), err, msg)
+		}
+		return "", fmt.Errorf("getting access token with %q: %w", strings.Join(s.command, " "), err)
+	}
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", errors.New("getting access token: the STACKIT CLI printed no access token, log in with \"stackit auth login\"")
+	}
+
+	s.token = token
+	s.expiresAt = s.now().Add(cliOpaqueTokenLifetime)
+	// The expiration time is read without verifying the token, which is done by the API
+	if parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.RegisteredClaims{}); err == nil {
+		if exp, err := parsed.Claims.GetExpirationTime(); err == nil && exp != nil {
+			s.expiresAt = exp.Time.Add(-cliTokenLeeway)
+		}
+	}
+	return s.token, nil
+}
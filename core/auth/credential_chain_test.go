@@ -0,0 +1,240 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+type testRoundTripper struct {
+	name string
+}
+
+func (rt *testRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("not implemented")
+}
+
+func testProvider(name string, err error, calls *[]string) config.CredentialProvider {
+	return NewCredentialProvider(name, func(*config.Configuration) (http.RoundTripper, error) {
+		*calls = append(*calls, name)
+		if err != nil {
+			return nil, err
+		}
+		return &testRoundTripper{name: name}, nil
+	})
+}
+
+func TestCredentialChain(t *testing.T) {
+	errNotFound := errors.New("not found")
+	var calls []string
+	chain := NewCredentialChain(testProvider("a", errNotFound, &calls), testProvider("b", nil, &calls), testProvider("c", nil, &calls))
+
+	rt, err := chain.Provide(nil)
+	if err != nil {
+		t.Fatalf("Provide() error = %v", err)
+	}
+	if got, ok := rt.(*testRoundTripper); !ok || got.name != "b" {
+		t.Fatalf("expected the round tripper of the first provider with credentials, got %v", rt)
+	}
+	if strings.Join(calls, ",") != "a,b" {
+		t.Fatalf("expected the providers to be tried in order until one succeeds, got %v", calls)
+	}
+
+	calls = nil
+	chain = chain.Without("b", "c").Append(testProvider("d", errNotFound, &calls)).Prepend(testProvider("e", errNotFound, &calls))
+	if chain.Name() != "credential chain (e, a, d)" {
+		t.Fatalf("unexpected name %q", chain.Name())
+	}
+	_, err = chain.Provide(&config.Configuration{})
+	var chainErr *CredentialChainError
+	if !errors.As(err, &chainErr) {
+		t.Fatalf("expected a CredentialChainError, got %v", err)
+	}
+	if len(chainErr.Attempts) != 3 || chainErr.Attempts[0].Provider != "e" || chainErr.Attempts[2].Provider != "d" {
+		t.Fatalf("unexpected attempts %+v", chainErr.Attempts)
+	}
+	if !errors.Is(err, errNotFound) {
+		t.Fatalf("expected the errors of the providers to be wrapped")
+	}
+	if want := "no valid credentials were found: trying e: not found, trying a: not found, trying d: not found"; err.Error() != want {
+		t.Fatalf("expected error %q, got %q", want, err.Error())
+	}
+
+	if _, err := NewCredentialChain().Provide(nil); err == nil {
+		t.Fatalf("expected an error for a chain without providers")
+	}
+}
+
+func TestDefaultCredentialChainError(t *testing.T) {
+	setTemporaryHome(t)
+	for _, env := range []string{
+		"STACKIT_SERVICE_ACCOUNT_KEY_PATH", "STACKIT_SERVICE_ACCOUNT_KEY", "STACKIT_PRIVATE_KEY_PATH", "STACKIT_PRIVATE_KEY",
+		"STACKIT_SERVICE_ACCOUNT_TOKEN", "STACKIT_CREDENTIALS_PATH", clients.FederatedTokenFile,
+	} {
+		t.Setenv(env, "")
+	}
+
+	_, err := DefaultAuth(nil)
+	var chainErr *CredentialChainError
+	if !errors.As(err, &chainErr) {
+		t.Fatalf("expected a CredentialChainError, got %v", err)
+	}
+	var providers []string
+	for _, attempt := range chainErr.Attempts {
+		providers = append(providers, attempt.Provider)
+	}
+	if want := []string{WorkloadIdentityProviderName, KeyProviderName, TokenProviderName}; strings.Join(providers, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected the providers %v to be tried, got %v", want, providers)
+	}
+}
+
+func TestCredentialChainTerminalError(t *testing.T) {
+	var calls []string
+	terminalErr := &TerminalCredentialError{Err: errors.New("invalid")}
+	chain := NewCredentialChain(testProvider("a", terminalErr, &calls), testProvider("b", nil, &calls))
+	_, err := chain.Provide(nil)
+	if !errors.As(err, &terminalErr) {
+		t.Fatalf("expected a TerminalCredentialError, got %v", err)
+	}
+	if strings.Join(calls, ",") != "a" {
+		t.Fatalf("expected the chain to stop at the terminal error, got %v", calls)
+	}
+}
+
+func TestDefaultAuthWorkloadIdentityMisconfigured(t *testing.T) {
+	setTemporaryHome(t)
+	privateKey, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Generating private key: %s", err)
+	}
+	saKey, err := json.Marshal(fixtureServiceAccountKey())
+	if err != nil {
+		t.Fatalf("marshalling service account key: %s", err)
+	}
+	t.Setenv("STACKIT_SERVICE_ACCOUNT_KEY", string(saKey))
+	t.Setenv("STACKIT_PRIVATE_KEY", string(privateKey))
+	t.Setenv("STACKIT_SERVICE_ACCOUNT_TOKEN", "token")
+
+	// The key flow is used without workload identity
+	t.Setenv(clients.FederatedTokenFile, "")
+	rt, err := DefaultAuth(&config.Configuration{})
+	if err != nil {
+		t.Fatalf("DefaultAuth() error = %v", err)
+	}
+	if _, ok := rt.(*clients.KeyFlow); !ok {
+		t.Fatalf("expected the key flow, got %T", rt)
+	}
+
+	// A misconfigured workload identity doesn't fall back to the key or the token
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenPath, []byte("id-token"), 0o600); err != nil {
+		t.Fatalf("Writing ID token: %s", err)
+	}
+	t.Setenv(clients.FederatedTokenFile, tokenPath)
+	t.Setenv(clients.WorkloadIdentityAudience, "")
+	cfg := &config.Configuration{}
+	rt, err = DefaultAuth(cfg)
+	if err == nil {
+		t.Fatalf("expected an error, got %T", rt)
+	}
+	var terminalErr *TerminalCredentialError
+	if !errors.As(err, &terminalErr) || !strings.Contains(err.Error(), clients.FederatedTokenFile+" is set") {
+		t.Fatalf("expected the error of the workload identity flow, got %v", err)
+	}
+	if cfg.WorkloadIdentityTokenPath != "" || cfg.ServiceAccountKey != "" {
+		t.Fatalf("expected the configuration to be unchanged, got %+v", cfg)
+	}
+}
+
+func TestSetupAuthCredentialProvider(t *testing.T) {
+	var calls []string
+	cfg := &config.Configuration{}
+	if err := config.WithCredentialProvider(testProvider("custom", nil, &calls))(cfg); err != nil {
+		t.Fatalf("WithCredentialProvider() error = %v", err)
+	}
+	rt, err := SetupAuth(cfg)
+	if err != nil {
+		t.Fatalf("SetupAuth() error = %v", err)
+	}
+	if _, ok := rt.(*testRoundTripper); !ok {
+		t.Fatalf("expected the round tripper of the provider, got %T", rt)
+	}
+
+	// Explicit credentials take precedence
+	cfg.Token = "token"
+	rt, err = SetupAuth(cfg)
+	if err != nil {
+		t.Fatalf("SetupAuth() error = %v", err)
+	}
+	if _, ok := rt.(*clients.TokenFlow); !ok {
+		t.Fatalf("expected the token flow, got %T", rt)
+	}
+
+	if err := config.WithCredentialProvider(nil)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil provider")
+	}
+}
+
+func TestCLIAuthProvider(t *testing.T) {
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		t.Skip("the test requires a shell")
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	counter := filepath.Join(t.TempDir(), "calls")
+	provider := &CLIAuthProvider{Command: []string{"sh", "-c", "echo call >> " + counter + " && echo " + token}}
+
+	rt, err := provider.Provide(&config.Configuration{})
+	if err != nil {
+		t.Fatalf("Provide() error = %v", err)
+	}
+	flow, ok := rt.(*clients.TokenSourceFlow)
+	if !ok {
+		t.Fatalf("expected a token source flow, got %T", rt)
+	}
+	for i := 0; i < 2; i++ {
+		got, err := flow.GetConfig().TokenSource.GetAccessToken()
+		if err != nil {
+			t.Fatalf("GetAccessToken() error = %v", err)
+		}
+		if got != token {
+			t.Fatalf("expected the token printed by the command, got %q", got)
+		}
+	}
+	calls, err := os.ReadFile(counter)
+	if err != nil {
+		t.Fatalf("reading calls: %v", err)
+	}
+	if n := strings.Count(string(calls), "call"); n != 1 {
+		t.Fatalf("expected the token to be reused until it expires, the command ran %d times", n)
+	}
+
+	for _, tt := range []struct {
+		desc    string
+		command []string
+	}{
+		{"not_installed", []string{"stackit-cli-not-installed"}},
+		{"not_logged_in", []string{"sh", "-c", "echo 'not logged in' >&2; exit 1"}},
+		{"no_token", []string{"true"}},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			provider := &CLIAuthProvider{Command: tt.command}
+			if _, err := provider.Provide(nil); err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
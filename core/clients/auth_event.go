@@ -0,0 +1,101 @@
+package clients
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+// AuthOperation is the operation of the token endpoint an AuthEvent is about
+type AuthOperation string
+
+const (
+	// AuthOperationMint creates a new access token, e.g. with a self-signed JWT of the service account key
+	AuthOperationMint AuthOperation = "mint"
+	// AuthOperationRefresh creates a new access token with the refresh token
+	AuthOperationRefresh AuthOperation = "refresh"
+)
+
+// AuthPhase is the phase of an operation an AuthEvent reports
+type AuthPhase string
+
+const (
+	AuthPhaseStart   AuthPhase = "start"
+	AuthPhaseSuccess AuthPhase = "success"
+	AuthPhaseFailure AuthPhase = "failure"
+)
+
+// AuthFailureReason classifies the error of a failed operation of the token endpoint
+type AuthFailureReason string
+
+const (
+	// AuthFailureKeyExpired means that the service account key is no longer valid
+	AuthFailureKeyExpired AuthFailureReason = "key_expired"
+	// AuthFailureRejected means that the token endpoint rejected the credentials, with a 4xx status code other than 429
+	AuthFailureRejected AuthFailureReason = "rejected"
+	// AuthFailureRateLimited means that the token endpoint responded with 429 Too Many Requests
+	AuthFailureRateLimited AuthFailureReason = "rate_limited"
+	// AuthFailureServerError means that the token endpoint responded with a 5xx status code
+	AuthFailureServerError AuthFailureReason = "server_error"
+	// AuthFailureNetwork means that the token endpoint couldn't be reached or didn't respond in time
+	AuthFailureNetwork AuthFailureReason = "network"
+	// AuthFailureOther is any other error, e.g. an invalid response of the token endpoint
+	AuthFailureOther AuthFailureReason = "other"
+)
+
+// AuthEvent reports the start and the outcome of an operation of the token endpoint, see KeyFlowConfig.AuthEventHook
+type AuthEvent struct {
+	Operation AuthOperation
+	Phase     AuthPhase
+	// Latency of the operation, set for AuthPhaseSuccess and AuthPhaseFailure
+	Latency time.Duration
+	// Reason and Err are set for AuthPhaseFailure
+	Reason AuthFailureReason
+	Err    error
+}
+
+// classifyAuthError returns the AuthFailureReason of the error of a failed operation of the token endpoint
+func classifyAuthError(err error) AuthFailureReason {
+	var keyExpiredErr *ServiceAccountKeyExpiredError
+	if errors.As(err, &keyExpiredErr) {
+		return AuthFailureKeyExpired
+	}
+	var oapiErr *oapierror.GenericOpenAPIError
+	if errors.As(err, &oapiErr) {
+		switch {
+		case oapiErr.StatusCode == http.StatusTooManyRequests:
+			return AuthFailureRateLimited
+		case oapiErr.StatusCode >= http.StatusInternalServerError:
+			return AuthFailureServerError
+		case oapiErr.StatusCode >= http.StatusBadRequest:
+			return AuthFailureRejected
+		}
+		return AuthFailureOther
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return AuthFailureNetwork
+	}
+	return AuthFailureOther
+}
+
+// observeAuthOperation calls hook for the start and the outcome of the operation op run by f, if hook is set
+func observeAuthOperation(hook func(AuthEvent), op AuthOperation, f func() error) error {
+	if hook == nil {
+		return f()
+	}
+	hook(AuthEvent{Operation: op, Phase: AuthPhaseStart})
+	start := time.Now()
+	err := f()
+	event := AuthEvent{Operation: op, Phase: AuthPhaseSuccess, Latency: time.Since(start)}
+	if err != nil {
+		event.Phase = AuthPhaseFailure
+		event.Reason = classifyAuthError(err)
+		event.Err = err
+	}
+	hook(event)
+	return err
+}
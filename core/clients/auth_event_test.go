@@ -0,0 +1,159 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+func testToken(t *testing.T, expiresIn time.Duration) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresIn)),
+	}).SignedString(testSigningKey)
+	if err != nil {
+		t.Fatalf("creating test token: %v", err)
+	}
+	return token
+}
+
+func TestKeyFlowAuthEvents(t *testing.T) {
+	privateKeyBytes, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+
+	for _, tt := range []struct {
+		desc           string
+		statusCode     int
+		refresh        bool
+		wantOperation  AuthOperation
+		wantPhase      AuthPhase
+		wantReason     AuthFailureReason
+		wantAuthFailed bool
+	}{
+		{
+			desc:          "mint",
+			statusCode:    http.StatusOK,
+			wantOperation: AuthOperationMint,
+			wantPhase:     AuthPhaseSuccess,
+		},
+		{
+			desc:          "refresh",
+			statusCode:    http.StatusOK,
+			refresh:       true,
+			wantOperation: AuthOperationRefresh,
+			wantPhase:     AuthPhaseSuccess,
+		},
+		{
+			desc:           "rejected",
+			statusCode:     http.StatusUnauthorized,
+			wantOperation:  AuthOperationMint,
+			wantPhase:      AuthPhaseFailure,
+			wantReason:     AuthFailureRejected,
+			wantAuthFailed: true,
+		},
+		{
+			desc:           "rate_limited",
+			statusCode:     http.StatusTooManyRequests,
+			refresh:        true,
+			wantOperation:  AuthOperationRefresh,
+			wantPhase:      AuthPhaseFailure,
+			wantReason:     AuthFailureRateLimited,
+			wantAuthFailed: true,
+		},
+		{
+			desc:           "server_error",
+			statusCode:     http.StatusBadGateway,
+			wantOperation:  AuthOperationMint,
+			wantPhase:      AuthPhaseFailure,
+			wantReason:     AuthFailureServerError,
+			wantAuthFailed: true,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_ = json.NewEncoder(w).Encode(TokenResponseBody{
+					AccessToken:  testToken(t, time.Hour),
+					RefreshToken: testToken(t, 24*time.Hour),
+				})
+			}))
+			defer server.Close()
+
+			var events []AuthEvent
+			keyFlow := &KeyFlow{}
+			err := keyFlow.Init(&KeyFlowConfig{
+				ServiceAccountKey: fixtureServiceAccountKey(),
+				PrivateKey:        string(privateKeyBytes),
+				TokenUrl:          server.URL,
+				AuthEventHook: func(event AuthEvent) {
+					events = append(events, event)
+				},
+			})
+			if err != nil {
+				t.Fatalf("KeyFlow.Init() error = %v", err)
+			}
+			if tt.refresh {
+				if err := keyFlow.SetToken(testToken(t, -time.Hour), testToken(t, time.Hour)); err != nil {
+					t.Fatalf("SetToken() error = %v", err)
+				}
+			}
+
+			_, err = keyFlow.GetAccessToken()
+			if (err != nil) != tt.wantAuthFailed {
+				t.Fatalf("GetAccessToken() error = %v, wantErr %v", err, tt.wantAuthFailed)
+			}
+
+			if len(events) != 2 {
+				t.Fatalf("expected a start and an end event, got %+v", events)
+			}
+			if events[0].Operation != tt.wantOperation || events[0].Phase != AuthPhaseStart {
+				t.Errorf("expected start event of %s, got %+v", tt.wantOperation, events[0])
+			}
+			end := events[1]
+			if end.Operation != tt.wantOperation || end.Phase != tt.wantPhase || end.Reason != tt.wantReason {
+				t.Errorf("expected %s event of %s with reason %q, got %+v", tt.wantPhase, tt.wantOperation, tt.wantReason, end)
+			}
+			if end.Latency <= 0 {
+				t.Errorf("expected a latency, got %v", end.Latency)
+			}
+			if (end.Err != nil) != tt.wantAuthFailed {
+				t.Errorf("expected error %v, got %v", tt.wantAuthFailed, end.Err)
+			}
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassifyAuthError(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		err  error
+		want AuthFailureReason
+	}{
+		{"key_expired", fmt.Errorf("wrapped: %w", &ServiceAccountKeyExpiredError{ValidUntil: time.Now()}), AuthFailureKeyExpired},
+		{"rejected", &oapierror.GenericOpenAPIError{StatusCode: http.StatusBadRequest}, AuthFailureRejected},
+		{"rate_limited", &oapierror.GenericOpenAPIError{StatusCode: http.StatusTooManyRequests}, AuthFailureRateLimited},
+		{"server_error", &oapierror.GenericOpenAPIError{StatusCode: http.StatusServiceUnavailable}, AuthFailureServerError},
+		{"network", fmt.Errorf("post token: %w", timeoutError{}), AuthFailureNetwork},
+		{"other", fmt.Errorf("unmarshal token response"), AuthFailureOther},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := classifyAuthError(tt.err); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
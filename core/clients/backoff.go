@@ -0,0 +1,133 @@
+package clients
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// Backoff determines the delay between the attempts of a retried request
+type Backoff interface {
+	// NextDelay returns the delay before the next attempt, after attempt (starting at 1) failed with resp.
+	// resp is nil if the attempt failed without a response.
+	NextDelay(attempt int, resp *http.Response) time.Duration
+}
+
+// BackoffFunc is an adapter to use an ordinary function as a Backoff
+type BackoffFunc func(attempt int, resp *http.Response) time.Duration
+
+// NextDelay calls f(attempt, resp)
+func (f BackoffFunc) NextDelay(attempt int, resp *http.Response) time.Duration {
+	return f(attempt, resp)
+}
+
+// ExponentialBackoff doubles the delay after each attempt, starting at BaseDelay and capped at MaxDelay.
+// If Jitter is set, the delay is drawn uniformly between 0 and the exponential delay ("full jitter"),
+// which spreads the retries of many clients failing at the same time.
+type ExponentialBackoff struct {
+	// Defaults to 1 second if not set
+	BaseDelay time.Duration
+	// Defaults to 30 seconds if not set
+	MaxDelay time.Duration
+	Jitter   bool
+	// Source of the jitter, defaults to math/rand. It must be safe for concurrent use, see NewLockedReader.
+	// Set by the API clients if it is nil, see config.WithRandSource
+	Rand io.Reader
+}
+
+// NextDelay implements Backoff
+func (b ExponentialBackoff) NextDelay(attempt int, _ *http.Response) time.Duration {
+	base, maxDelay := backoffBounds(b.BaseDelay, b.MaxDelay)
+	delay := exponentialDelay(base, maxDelay, attempt)
+	if b.Jitter {
+		delay = time.Duration(randInt63n(b.Rand, int64(delay)+1))
+	}
+	return delay
+}
+
+// DecorrelatedJitterBackoff draws the delay uniformly between BaseDelay and three times the delay of the previous
+// attempt, capped at MaxDelay. The delays grow on average like an exponential backoff, but vary more between clients.
+//
+// Since it is shared by all requests of a client, it doesn't keep the delay of the previous attempt,
+// but uses the upper bound of its range instead.
+type DecorrelatedJitterBackoff struct {
+	// Defaults to 1 second if not set
+	BaseDelay time.Duration
+	// Defaults to 30 seconds if not set
+	MaxDelay time.Duration
+	// Source of the jitter, defaults to math/rand. It must be safe for concurrent use, see NewLockedReader.
+	// Set by the API clients if it is nil, see config.WithRandSource
+	Rand io.Reader
+}
+
+// NextDelay implements Backoff
+func (b DecorrelatedJitterBackoff) NextDelay(attempt int, _ *http.Response) time.Duration {
+	base, maxDelay := backoffBounds(b.BaseDelay, b.MaxDelay)
+	upper := base
+	for i := 0; i < attempt && upper < maxDelay; i++ {
+		upper *= 3
+	}
+	if upper > maxDelay {
+		upper = maxDelay
+	}
+	if upper <= base {
+		return upper
+	}
+	return base + time.Duration(randInt63n(b.Rand, int64(upper-base)+1))
+}
+
+// ConstantBackoff waits Delay between all attempts
+type ConstantBackoff struct {
+	Delay time.Duration
+}
+
+// NextDelay implements Backoff
+func (b ConstantBackoff) NextDelay(int, *http.Response) time.Duration {
+	return b.Delay
+}
+
+func backoffBounds(base, maxDelay time.Duration) (baseDelay, maximumDelay time.Duration) {
+	if base <= 0 {
+		base = defaultConflictRetryBaseDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = defaultConflictRetryMaxDelay
+	}
+	if maxDelay < base {
+		maxDelay = base
+	}
+	return base, maxDelay
+}
+
+// exponentialDelay returns base*2^(attempt-1), capped at maxDelay
+func exponentialDelay(base, maxDelay time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// WithRandSource returns backoff with r as the source of its jitter, if it is an ExponentialBackoff or a
+// DecorrelatedJitterBackoff without one. Other backoffs are returned unchanged.
+func WithRandSource(backoff Backoff, r io.Reader) Backoff {
+	if r == nil {
+		return backoff
+	}
+	switch b := backoff.(type) {
+	case ExponentialBackoff:
+		if b.Rand == nil {
+			b.Rand = r
+		}
+		return b
+	case DecorrelatedJitterBackoff:
+		if b.Rand == nil {
+			b.Rand = r
+		}
+		return b
+	}
+	return backoff
+}
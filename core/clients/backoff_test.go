@@ -0,0 +1,142 @@
+package clients
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	b := ExponentialBackoff{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for _, tt := range []struct {
+		attempt       int
+		expectedDelay time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+		{100, 5 * time.Second},
+	} {
+		if got := b.NextDelay(tt.attempt, nil); got != tt.expectedDelay {
+			t.Errorf("attempt %d: expected delay %v, got %v", tt.attempt, tt.expectedDelay, got)
+		}
+	}
+}
+
+func TestExponentialBackoffJitter(t *testing.T) {
+	b := ExponentialBackoff{BaseDelay: time.Second, MaxDelay: 5 * time.Second, Jitter: true}
+	for attempt := 1; attempt <= 10; attempt++ {
+		upper := ExponentialBackoff{BaseDelay: time.Second, MaxDelay: 5 * time.Second}.NextDelay(attempt, nil)
+		for i := 0; i < 100; i++ {
+			if got := b.NextDelay(attempt, nil); got < 0 || got > upper {
+				t.Fatalf("attempt %d: delay %v out of range [0, %v]", attempt, got, upper)
+			}
+		}
+	}
+}
+
+func TestDecorrelatedJitterBackoff(t *testing.T) {
+	b := DecorrelatedJitterBackoff{BaseDelay: time.Second, MaxDelay: 20 * time.Second}
+	for _, tt := range []struct {
+		attempt       int
+		expectedUpper time.Duration
+	}{
+		{1, 3 * time.Second},
+		{2, 9 * time.Second},
+		{3, 20 * time.Second},
+		{100, 20 * time.Second},
+	} {
+		for i := 0; i < 100; i++ {
+			if got := b.NextDelay(tt.attempt, nil); got < time.Second || got > tt.expectedUpper {
+				t.Fatalf("attempt %d: delay %v out of range [1s, %v]", tt.attempt, got, tt.expectedUpper)
+			}
+		}
+	}
+}
+
+func TestBackoffRandSource(t *testing.T) {
+	for _, b := range []Backoff{
+		ExponentialBackoff{BaseDelay: time.Second, MaxDelay: time.Minute, Jitter: true},
+		DecorrelatedJitterBackoff{BaseDelay: time.Second, MaxDelay: time.Minute},
+	} {
+		delays := func() []time.Duration {
+			seeded := WithRandSource(b, rand.New(rand.NewSource(1))) //nolint:gosec // deterministic on purpose
+			var delays []time.Duration
+			for attempt := 1; attempt <= 5; attempt++ {
+				delays = append(delays, seeded.NextDelay(attempt, nil))
+			}
+			return delays
+		}
+		first, second := delays(), delays()
+		for i := range first {
+			if first[i] != second[i] {
+				t.Fatalf("%T: expected the same delays with the same seed, got %v and %v", b, first, second)
+			}
+		}
+	}
+}
+
+func TestWithRandSourceKeepsSource(t *testing.T) {
+	own := rand.New(rand.NewSource(1))                                                            //nolint:gosec // deterministic on purpose
+	b := WithRandSource(ExponentialBackoff{Jitter: true, Rand: own}, rand.New(rand.NewSource(2))) //nolint:gosec // deterministic on purpose
+	if got := b.(ExponentialBackoff).Rand; got != own {
+		t.Errorf("expected the source of the backoff to be kept")
+	}
+	constant := ConstantBackoff{Delay: time.Second}
+	if got := WithRandSource(constant, own); got != constant {
+		t.Errorf("expected other backoffs to be unchanged, got %v", got)
+	}
+}
+
+func TestConstantBackoff(t *testing.T) {
+	b := ConstantBackoff{Delay: 3 * time.Second}
+	for attempt := 1; attempt <= 5; attempt++ {
+		if got := b.NextDelay(attempt, nil); got != 3*time.Second {
+			t.Errorf("attempt %d: expected delay 3s, got %v", attempt, got)
+		}
+	}
+}
+
+func TestConflictRetryRoundTripperBackoff(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("X-Attempt", "conflict")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var attempts []int
+	rt := NewConflictRetryRoundTripper(nil).SetBackoff(BackoffFunc(func(attempt int, resp *http.Response) time.Duration {
+		if resp == nil || resp.Header.Get("X-Attempt") != "conflict" {
+			t.Errorf("expected the response of the failed attempt")
+		}
+		attempts = append(attempts, attempt)
+		return time.Millisecond
+	}))
+
+	req, err := http.NewRequestWithContext(WithConflictRetry(context.Background(), 3), http.MethodPost, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := (&http.Client{Transport: rt}).Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if len(attempts) != 2 || attempts[0] != 1 || attempts[1] != 2 {
+		t.Errorf("expected the backoff to be consulted after attempts [1 2], got %v", attempts)
+	}
+}
@@ -0,0 +1,185 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of the circuit of a host, see CircuitBreaker
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through, it is the state of a healthy host
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails all requests fast with a CircuitOpenError until the cooldown has passed
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe request through after the cooldown, the other requests fail fast
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// CircuitOpenError is returned instead of sending a request to a host whose circuit is open, see CircuitBreaker
+type CircuitOpenError struct {
+	// Host of the request, e.g. "dns.api.stackit.cloud"
+	Host string
+	// Until is the time after which a probe request is sent to the host
+	Until time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for %s after consecutive failures, failing fast until %s", e.Host, e.Until.Format(time.RFC3339))
+}
+
+// CircuitBreaker fails the requests to a host fast after threshold consecutive requests to it failed, e.g. to keep
+// a reconciler from piling up timeouts while a regional API is degraded. A request failed if it got no response,
+// except if its context was canceled or it could not be authenticated, or if it got a response with a 5xx status code.
+//
+// After the failures, the circuit of the host is open and the requests to it fail with a CircuitOpenError for the
+// cooldown. Then the circuit is half-open: a single request is sent as a probe, while the others still fail fast.
+// If the probe succeeds, the circuit is closed again, otherwise it is open for another cooldown.
+//
+// A CircuitBreaker can be shared by multiple clients, see config.WithCircuitBreaker. It is safe for concurrent use.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker returns a CircuitBreaker which opens the circuit of a host after threshold consecutive failures
+// for cooldown
+func NewCircuitBreaker(threshold int, cooldown time.Duration) (*CircuitBreaker, error) {
+	if threshold < 1 {
+		return nil, fmt.Errorf("circuit breaker threshold must be at least 1, got %d", threshold)
+	}
+	if cooldown <= 0 {
+		return nil, fmt.Errorf("circuit breaker cooldown must be positive, got %s", cooldown)
+	}
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		circuits:  map[string]*circuit{},
+	}, nil
+}
+
+// State returns the state of the circuit of host
+func (b *CircuitBreaker) State(host string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.circuits[host]
+	if !ok {
+		return CircuitClosed
+	}
+	if c.state == CircuitOpen && !b.now().Before(c.openedAt.Add(b.cooldown)) {
+		return CircuitHalfOpen
+	}
+	return c.state
+}
+
+// circuit returns the circuit of host. It must be called with mu locked.
+func (b *CircuitBreaker) circuit(host string) *circuit {
+	c, ok := b.circuits[host]
+	if !ok {
+		c = &circuit{}
+		b.circuits[host] = c
+	}
+	return c
+}
+
+// allow returns whether a request may be sent to host, and if it is the probe of a half-open circuit
+func (b *CircuitBreaker) allow(host string) (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuit(host)
+	switch c.state {
+	case CircuitClosed:
+		return false, nil
+	case CircuitOpen:
+		if b.now().Before(c.openedAt.Add(b.cooldown)) {
+			return false, &CircuitOpenError{Host: host, Until: c.openedAt.Add(b.cooldown)}
+		}
+		c.state = CircuitHalfOpen
+	}
+	if c.probing {
+		return false, &CircuitOpenError{Host: host, Until: c.openedAt.Add(b.cooldown)}
+	}
+	c.probing = true
+	return true, nil
+}
+
+// record records the result of a request to host
+func (b *CircuitBreaker) record(host string, probe bool, resp *http.Response, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuit(host)
+	if probe {
+		c.probing = false
+	}
+
+	var authErr *AuthenticationError
+	switch {
+	case err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.As(err, &authErr)):
+		// Not a failure of the host, a canceled probe is sent again with the next request
+		return
+	case err != nil || resp.StatusCode >= http.StatusInternalServerError:
+		c.failures++
+		if probe || c.failures >= b.threshold {
+			c.state = CircuitOpen
+			c.openedAt = b.now()
+		}
+	default:
+		c.state = CircuitClosed
+		c.failures = 0
+	}
+}
+
+// NewCircuitBreakerRoundTripper returns a RoundTripper which sends the requests using rt, unless the circuit of their
+// host is open in breaker. If rt is nil, http.DefaultTransport is used.
+func NewCircuitBreakerRoundTripper(rt http.RoundTripper, breaker *CircuitBreaker) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &circuitBreakerRoundTripper{rt: rt, breaker: breaker}
+}
+
+type circuitBreakerRoundTripper struct {
+	rt      http.RoundTripper
+	breaker *CircuitBreaker
+}
+
+// RoundTrip performs the request
+func (c *circuitBreakerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	probe, err := c.breaker.allow(host)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.rt.RoundTrip(req)
+	c.breaker.record(host, probe, resp, err)
+	return resp, err
+}
@@ -0,0 +1,151 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewCircuitBreaker(t *testing.T) {
+	for _, tt := range []struct {
+		desc      string
+		threshold int
+		cooldown  time.Duration
+		isValid   bool
+	}{
+		{"valid", 5, time.Minute, true},
+		{"zero_threshold", 0, time.Minute, false},
+		{"zero_cooldown", 5, 0, false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := NewCircuitBreaker(tt.threshold, tt.cooldown)
+			if tt.isValid && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	breaker, err := NewCircuitBreaker(3, time.Minute)
+	if err != nil {
+		t.Fatalf("creating circuit breaker: %v", err)
+	}
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+
+	calls := 0
+	var status int
+	var transportErr error
+	rt := NewCircuitBreakerRoundTripper(mockTransportFn{func(*http.Request) (*http.Response, error) {
+		calls++
+		if transportErr != nil {
+			return nil, transportErr
+		}
+		return &http.Response{StatusCode: status, Body: http.NoBody}, nil
+	}}, breaker)
+	send := func(host string) error {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, "https://"+host+"/v1/zones", http.NoBody)
+		if err != nil {
+			t.Fatalf("creating request: %v", err)
+		}
+		resp, err := rt.RoundTrip(req)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+	const host = "dns.api.eu01.stackit.cloud"
+
+	// A success resets the consecutive failures, client errors aren't failures
+	for _, s := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK, http.StatusInternalServerError, http.StatusNotFound} {
+		status = s
+		if err := send(host); err != nil {
+			t.Fatalf("expected the request to be sent, got %v", err)
+		}
+	}
+	if state := breaker.State(host); state != CircuitClosed {
+		t.Fatalf("expected the circuit to be closed, got %s", state)
+	}
+
+	status = http.StatusServiceUnavailable
+	_ = send(host)
+	_ = send(host)
+	transportErr = errors.New("connection refused")
+	_ = send(host)
+	if state := breaker.State(host); state != CircuitOpen {
+		t.Fatalf("expected the circuit to be open, got %s", state)
+	}
+
+	// The requests fail fast, other hosts aren't affected
+	calls = 0
+	err = send(host)
+	var circuitErr *CircuitOpenError
+	if !errors.As(err, &circuitErr) || circuitErr.Host != host || !circuitErr.Until.Equal(now.Add(time.Minute)) {
+		t.Fatalf("expected a CircuitOpenError, got %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no request to be sent, got %d", calls)
+	}
+	transportErr = nil
+	status = http.StatusOK
+	if err := send("ske.api.eu01.stackit.cloud"); err != nil {
+		t.Fatalf("expected another host not to be affected, got %v", err)
+	}
+
+	// A failed probe opens the circuit again
+	now = now.Add(time.Minute)
+	if state := breaker.State(host); state != CircuitHalfOpen {
+		t.Fatalf("expected the circuit to be half-open, got %s", state)
+	}
+	status = http.StatusGatewayTimeout
+	if err := send(host); err != nil {
+		t.Fatalf("expected the probe to be sent, got %v", err)
+	}
+	if err := send(host); !errors.As(err, &circuitErr) {
+		t.Fatalf("expected the circuit to be open after the failed probe, got %v", err)
+	}
+
+	// A canceled probe doesn't change the circuit, a successful probe closes it
+	now = now.Add(time.Minute)
+	transportErr = context.Canceled
+	if err := send(host); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the probe to be sent, got %v", err)
+	}
+	if state := breaker.State(host); state != CircuitHalfOpen {
+		t.Fatalf("expected the circuit to stay half-open, got %s", state)
+	}
+	transportErr = nil
+	status = http.StatusOK
+	if err := send(host); err != nil {
+		t.Fatalf("expected the probe to be sent, got %v", err)
+	}
+	if state := breaker.State(host); state != CircuitClosed {
+		t.Fatalf("expected the circuit to be closed, got %s", state)
+	}
+}
+
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	breaker, err := NewCircuitBreaker(1, time.Minute)
+	if err != nil {
+		t.Fatalf("creating circuit breaker: %v", err)
+	}
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+	breaker.record("host", false, &http.Response{StatusCode: http.StatusInternalServerError}, nil)
+
+	now = now.Add(time.Minute)
+	if probe, err := breaker.allow("host"); !probe || err != nil {
+		t.Fatalf("expected a probe, got %t, %v", probe, err)
+	}
+	// While the probe is in flight, the other requests fail fast
+	if _, err := breaker.allow("host"); err == nil {
+		t.Fatalf("expected a CircuitOpenError during the probe")
+	}
+}
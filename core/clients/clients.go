@@ -1,6 +1,9 @@
 package clients
 
 import (
+	"fmt"
+	"io"
+	"net/http"
 	"time"
 )
 
@@ -8,12 +11,49 @@ const (
 	DefaultClientTimeout = time.Minute
 )
 
-// Deprecated: retry options were removed to reduce complexity of the client. If this functionality is needed, you can provide your own custom HTTP client.
+// AuthenticationError is returned by the authentication flows if a request could not be authenticated,
+// e.g. because no access token could be obtained with the configured credentials
+type AuthenticationError struct {
+	Err error
+}
+
+func (e *AuthenticationError) Error() string {
+	return fmt.Sprintf("authenticating request: %v", e.Err)
+}
+
+func (e *AuthenticationError) Unwrap() error {
+	return e.Err
+}
+
+// RetryConfig configures the retries of the requests of a client with exponential backoff, see config.WithRetryPolicy.
+// Only idempotent requests are retried, unless RetryNonIdempotent is set, see IsIdempotent.
 type RetryConfig struct {
-	MaxRetries       int           // Max retries
+	// Maximum number of attempts in total, including the first one. A value lower than 2 disables the retries
+	MaxAttempts int
+	// Delay before the first retry, which doubles after each attempt. Defaults to 1 second if not set
+	BaseDelay time.Duration
+	// Maximum delay between two attempts. Defaults to 30 seconds if not set
+	MaxDelay time.Duration
+	// If set, the delay is drawn uniformly between 0 and the exponential delay, see ExponentialBackoff
+	Jitter bool
+	// RetryOn decides whether an attempt which failed with err, or which got resp, is retried.
+	// Exactly one of resp and err is not nil. Defaults to DefaultRetryOn if not set
+	RetryOn func(resp *http.Response, err error) bool
+	// If set, requests which aren't idempotent, e.g. POST, are retried as well. Retrying a request which isn't
+	// idempotent may however apply it twice, e.g. create a resource twice if the response of the first attempt was lost
+	RetryNonIdempotent bool
+	// Source of the jitter, defaults to math/rand. It must be safe for concurrent use, see NewLockedReader.
+	// Set by the API clients if it is nil, see config.WithRandSource
+	Rand io.Reader
+
+	// Deprecated: retry options were removed to reduce complexity of the client. This field has no effect, use MaxAttempts instead.
+	MaxRetries int // Max retries
+	// Deprecated: retry options were removed to reduce complexity of the client. This field has no effect, use BaseDelay instead.
 	WaitBetweenCalls time.Duration // Time to wait between requests
-	RetryTimeout     time.Duration // Max time to re-try
-	ClientTimeout    time.Duration // HTTP Client timeout
+	// Deprecated: retry options were removed to reduce complexity of the client. This field has no effect, use a context deadline instead.
+	RetryTimeout time.Duration // Max time to re-try
+	// Deprecated: retry options were removed to reduce complexity of the client. This field has no effect.
+	ClientTimeout time.Duration // HTTP Client timeout
 }
 
 // Deprecated: retry options were removed to reduce complexity of the client. If this functionality is needed, you can provide your own custom HTTP client.
@@ -0,0 +1,278 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultConflictRetryBaseDelay = time.Second
+	defaultConflictRetryMaxDelay  = 30 * time.Second
+
+	defaultBodyErrorMaxAttempts = 3
+)
+
+type conflictRetryContextKey struct{}
+
+type retryPolicyContextKey struct{}
+
+type retryCountContextKey struct{}
+
+// RetryCount counts the retries of the requests sent with a context from WithRetryCount, e.g. for a middleware which
+// reports the number of retries of a call. It is safe for concurrent use.
+type RetryCount struct {
+	n atomic.Int64
+}
+
+// Load returns the number of retries so far
+func (c *RetryCount) Load() int {
+	return int(c.n.Load())
+}
+
+// WithRetryCount returns a copy of ctx with which the retries of the requests by a ConflictRetryRoundTripper are
+// counted in the returned RetryCount. The first attempt of a request isn't counted.
+func WithRetryCount(ctx context.Context) (context.Context, *RetryCount) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	count := &RetryCount{}
+	return context.WithValue(ctx, retryCountContextKey{}, count), count
+}
+
+// RetryPolicy overrides the retries of a single request, see WithRetryPolicy
+type RetryPolicy struct {
+	// Maximum number of attempts in total, including the first one. A value lower than 2 disables the retries
+	MaxAttempts int
+}
+
+// NoRetries is a RetryPolicy which disables the retries of a request, e.g. for a health check that should fail fast
+var NoRetries = RetryPolicy{MaxAttempts: 1}
+
+// WithRetryPolicy returns a copy of ctx with a RetryPolicy for the requests made with it.
+// The policy takes precedence over the retries configured for the client and for the request, e.g. with
+// WithConflictRetry or the RetryOnConflict of a create request, so it can both disable the retries of a call
+// and change its maximum number of attempts. It doesn't enable retries which aren't configured, e.g. a request
+// which didn't opt in to the retries on conflicts isn't retried on 409 Conflict. A RetryBudget of the client still
+// limits the retries.
+//
+// Only has effect if the request is sent through a ConflictRetryRoundTripper, which is the case for
+// all generated API clients.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// GetRetryPolicy returns the RetryPolicy set in ctx with WithRetryPolicy, if any
+func GetRetryPolicy(ctx context.Context) (RetryPolicy, bool) {
+	if ctx == nil {
+		return RetryPolicy{}, false
+	}
+	policy, ok := ctx.Value(retryPolicyContextKey{}).(RetryPolicy)
+	return policy, ok
+}
+
+// WithConflictRetry returns a copy of ctx that enables retrying a request with exponential backoff if it fails
+// with 409 Conflict, up to maxAttempts attempts in total. A value lower than 2 disables the retries.
+//
+// Some resources return 409 Conflict while a parent resource is still settling and a short retry succeeds.
+// Retrying a create request that isn't idempotent may however create a resource twice if the conflict
+// was caused by something else, so this should be paired with an idempotency key where the API supports it.
+//
+// Only has effect if the request is sent through a ConflictRetryRoundTripper, which is the case for
+// all generated API clients.
+func WithConflictRetry(ctx context.Context, maxAttempts int) context.Context {
+	if maxAttempts < 2 {
+		return ctx
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, conflictRetryContextKey{}, maxAttempts)
+}
+
+// ConflictRetryRoundTripper retries requests which fail with 409 Conflict, if enabled for the request using WithConflictRetry,
+// successful requests whose response body reports an error, if enabled with SetRetryOnBodyError, and the requests
+// which fail according to a RetryConfig, if set with SetRetryConfig
+type ConflictRetryRoundTripper struct {
+	rt               http.RoundTripper
+	baseDelay        time.Duration
+	maxDelay         time.Duration
+	budget           *RetryBudget
+	backoff          Backoff
+	retryOnBodyError func(body []byte) bool
+	retryConfig      *RetryConfig
+}
+
+// NewConflictRetryRoundTripper returns a ConflictRetryRoundTripper which sends the requests using rt.
+// If rt is nil, http.DefaultTransport is used.
+func NewConflictRetryRoundTripper(rt http.RoundTripper) *ConflictRetryRoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &ConflictRetryRoundTripper{
+		rt:        rt,
+		baseDelay: defaultConflictRetryBaseDelay,
+		maxDelay:  defaultConflictRetryMaxDelay,
+	}
+}
+
+// SetRetryBudget sets the RetryBudget which limits the retries. If budget is nil, the retries aren't limited.
+func (c *ConflictRetryRoundTripper) SetRetryBudget(budget *RetryBudget) *ConflictRetryRoundTripper {
+	c.budget = budget
+	return c
+}
+
+// SetBackoff sets the Backoff which determines the delay between the attempts.
+// If backoff is nil, the delay doubles after each attempt, starting at 1 second and capped at 30 seconds.
+func (c *ConflictRetryRoundTripper) SetBackoff(backoff Backoff) *ConflictRetryRoundTripper {
+	c.backoff = backoff
+	return c
+}
+
+// SetRetryOnBodyError sets a function which decides, based on the response body, whether a request with a 2xx status
+// code is retried, e.g. for endpoints which report transient failures with an error code in the body of a 200 OK.
+// The request is sent up to 3 times in total, unless a RetryPolicy of the request sets another maximum.
+// If retryOnBodyError is nil, responses with a 2xx status code aren't retried.
+//
+// To inspect it, the body of each 2xx response is read completely into memory before the response is returned,
+// except for the last attempt. The response returned to the caller reads from the buffered body. This removes
+// the streaming of large responses and delays returning the response until the body was received completely.
+func (c *ConflictRetryRoundTripper) SetRetryOnBodyError(retryOnBodyError func(body []byte) bool) *ConflictRetryRoundTripper {
+	c.retryOnBodyError = retryOnBodyError
+	return c
+}
+
+// SetRetryConfig sets the RetryConfig with which the failed requests are retried, in addition to the retries on
+// conflicts and body errors. The delays between these retries are determined by retryConfig, not by the Backoff of
+// SetBackoff. If retryConfig is nil, the requests are only retried on conflicts and body errors.
+func (c *ConflictRetryRoundTripper) SetRetryConfig(retryConfig *RetryConfig) *ConflictRetryRoundTripper {
+	c.retryConfig = retryConfig
+	return c
+}
+
+// RoundTrip performs the request
+func (c *ConflictRetryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if c.budget != nil {
+		c.budget.Deposit()
+	}
+	conflictAttempts, _ := req.Context().Value(conflictRetryContextKey{}).(int)
+	bodyErrorAttempts := 0
+	if c.retryOnBodyError != nil {
+		bodyErrorAttempts = defaultBodyErrorMaxAttempts
+	}
+	retryApplies := c.retryConfig.applies(req)
+	retryAttempts := 0
+	if retryApplies {
+		retryAttempts = c.retryConfig.MaxAttempts
+	}
+	// The policy only changes the retries which are enabled for the request, e.g. it doesn't retry a create request
+	// on conflicts without RetryOnConflict
+	if policy, ok := GetRetryPolicy(req.Context()); ok {
+		if conflictAttempts > 0 {
+			conflictAttempts = policy.MaxAttempts
+		}
+		if bodyErrorAttempts > 0 {
+			bodyErrorAttempts = policy.MaxAttempts
+		}
+		if retryAttempts > 0 {
+			retryAttempts = policy.MaxAttempts
+		}
+	}
+	if conflictAttempts < 2 && bodyErrorAttempts < 2 && retryAttempts < 2 {
+		return c.rt.RoundTrip(req)
+	}
+	// The body can't be sent again if it can't be recreated
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return c.rt.RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 {
+			if count, ok := req.Context().Value(retryCountContextKey{}).(*RetryCount); ok {
+				count.n.Add(1)
+			}
+			attemptReq = req.Clone(req.Context())
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, fmt.Errorf("recreate request body: %w", err)
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		resp, err := c.rt.RoundTrip(attemptReq)
+		if err != nil {
+			if attempt >= retryAttempts || req.Context().Err() != nil || !c.retryConfig.retryOn(nil, err) {
+				return resp, err
+			}
+			if c.budget != nil && !c.budget.TryWithdraw() {
+				return resp, err
+			}
+			if err := waitRetry(req.Context(), c.retryConfig.nextDelay(attempt, nil)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		retry, configRetry := false, false
+		switch {
+		case resp.StatusCode == http.StatusConflict:
+			retry = attempt < conflictAttempts
+		case resp.StatusCode >= 200 && resp.StatusCode < 300 && c.retryOnBodyError != nil && attempt < bodyErrorAttempts:
+			body, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("read response body: %w", err)
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			retry = c.retryOnBodyError(body)
+		}
+		if !retry && attempt < retryAttempts && c.retryConfig.retryOn(resp, nil) {
+			retry, configRetry = true, true
+		}
+		if !retry {
+			return resp, nil
+		}
+		if c.budget != nil && !c.budget.TryWithdraw() {
+			return resp, nil
+		}
+		delay := c.nextDelay(attempt, resp)
+		if configRetry {
+			delay = c.retryConfig.nextDelay(attempt, resp)
+		}
+		// Drain the body so that the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		if err := waitRetry(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitRetry waits delay before the next attempt, or returns the error of ctx if it is done before
+func waitRetry(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (c *ConflictRetryRoundTripper) nextDelay(attempt int, resp *http.Response) time.Duration {
+	if c.backoff != nil {
+		return c.backoff.NextDelay(attempt, resp)
+	}
+	return exponentialDelay(c.baseDelay, c.maxDelay, attempt)
+}
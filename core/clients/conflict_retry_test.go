@@ -0,0 +1,261 @@
+package clients
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConflictRetryRoundTripper(t *testing.T) {
+	for _, tt := range []struct {
+		desc               string
+		maxAttempts        int
+		conflicts          int
+		expectedStatusCode int
+		expectedCalls      int
+	}{
+		{
+			desc:               "retries_disabled",
+			maxAttempts:        0,
+			conflicts:          1,
+			expectedStatusCode: http.StatusConflict,
+			expectedCalls:      1,
+		},
+		{
+			desc:               "succeeds_after_retry",
+			maxAttempts:        3,
+			conflicts:          2,
+			expectedStatusCode: http.StatusCreated,
+			expectedCalls:      3,
+		},
+		{
+			desc:               "attempts_exhausted",
+			maxAttempts:        2,
+			conflicts:          5,
+			expectedStatusCode: http.StatusConflict,
+			expectedCalls:      2,
+		},
+		{
+			desc:               "no_conflict",
+			maxAttempts:        3,
+			conflicts:          0,
+			expectedStatusCode: http.StatusCreated,
+			expectedCalls:      1,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				body, err := io.ReadAll(r.Body)
+				if err != nil || string(body) != "payload" {
+					t.Errorf("unexpected body %q in call %d: %v", body, calls, err)
+				}
+				if calls <= tt.conflicts {
+					w.WriteHeader(http.StatusConflict)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			rt := NewConflictRetryRoundTripper(nil)
+			rt.baseDelay = time.Millisecond
+
+			ctx, retries := WithRetryCount(WithConflictRetry(context.Background(), tt.maxAttempts))
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			resp, err := (&http.Client{Transport: rt}).Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedStatusCode {
+				t.Errorf("expected status code %d, got %d", tt.expectedStatusCode, resp.StatusCode)
+			}
+			if calls != tt.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+			if retries.Load() != tt.expectedCalls-1 {
+				t.Errorf("expected %d retries to be counted, got %d", tt.expectedCalls-1, retries.Load())
+			}
+		})
+	}
+}
+
+func TestConflictRetryRoundTripperContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	}))
+	defer server.Close()
+
+	rt := NewConflictRetryRoundTripper(nil)
+	rt.baseDelay = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(WithConflictRetry(ctx, 3), http.MethodPost, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := (&http.Client{Transport: rt}).Do(req)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatalf("expected error")
+	}
+}
+
+func TestConflictRetryRoundTripperRetryPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		desc          string
+		conflictRetry int
+		policy        *RetryPolicy
+		expectedCalls int
+	}{
+		{
+			desc:          "no_policy",
+			conflictRetry: 3,
+			expectedCalls: 3,
+		},
+		{
+			desc:          "policy_disables_retries",
+			conflictRetry: 3,
+			policy:        &NoRetries,
+			expectedCalls: 1,
+		},
+		{
+			desc:          "policy_overrides_max_attempts",
+			conflictRetry: 3,
+			policy:        &RetryPolicy{MaxAttempts: 5},
+			expectedCalls: 5,
+		},
+		{
+			desc:          "policy_without_opt_in",
+			policy:        &RetryPolicy{MaxAttempts: 5},
+			expectedCalls: 1,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				w.WriteHeader(http.StatusConflict)
+			}))
+			defer server.Close()
+
+			rt := NewConflictRetryRoundTripper(nil)
+			rt.baseDelay = time.Millisecond
+
+			ctx := context.Background()
+			if tt.policy != nil {
+				ctx = WithRetryPolicy(ctx, *tt.policy)
+			}
+			// The request specific retries are set by the API clients on top of the context of the caller
+			ctx = WithConflictRetry(ctx, tt.conflictRetry)
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			resp, err := (&http.Client{Transport: rt}).Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if calls != tt.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestConflictRetryRoundTripperRetryOnBodyError(t *testing.T) {
+	for _, tt := range []struct {
+		desc          string
+		softFailures  int
+		policy        *RetryPolicy
+		expectedBody  string
+		expectedCalls int
+	}{
+		{
+			desc:          "no_body_error",
+			softFailures:  0,
+			expectedBody:  `{"status":"ok"}`,
+			expectedCalls: 1,
+		},
+		{
+			desc:          "succeeds_after_retry",
+			softFailures:  2,
+			expectedBody:  `{"status":"ok"}`,
+			expectedCalls: 3,
+		},
+		{
+			desc:          "attempts_exhausted",
+			softFailures:  5,
+			expectedBody:  `{"code":"BACKEND_UNAVAILABLE"}`,
+			expectedCalls: 3,
+		},
+		{
+			desc:          "retry_policy",
+			softFailures:  5,
+			policy:        &NoRetries,
+			expectedBody:  `{"code":"BACKEND_UNAVAILABLE"}`,
+			expectedCalls: 1,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				body, err := io.ReadAll(r.Body)
+				if err != nil || string(body) != "payload" {
+					t.Errorf("unexpected body %q in call %d: %v", body, calls, err)
+				}
+				w.WriteHeader(http.StatusOK)
+				if calls <= tt.softFailures {
+					_, _ = w.Write([]byte(`{"code":"BACKEND_UNAVAILABLE"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"status":"ok"}`))
+			}))
+			defer server.Close()
+
+			rt := NewConflictRetryRoundTripper(nil).SetRetryOnBodyError(func(body []byte) bool {
+				return strings.Contains(string(body), "BACKEND_UNAVAILABLE")
+			})
+			rt.baseDelay = time.Millisecond
+
+			ctx := context.Background()
+			if tt.policy != nil {
+				ctx = WithRetryPolicy(ctx, *tt.policy)
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			resp, err := (&http.Client{Transport: rt}).Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("reading body: %v", err)
+			}
+			if string(body) != tt.expectedBody {
+				t.Errorf("expected body %q, got %q", tt.expectedBody, body)
+			}
+			if calls != tt.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
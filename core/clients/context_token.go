@@ -0,0 +1,35 @@
+package clients
+
+import (
+	"context"
+)
+
+type contextTokenKey struct{}
+
+// WithToken returns a copy of ctx with an access token for the requests made with it.
+// The authentication flows send it instead of the token obtained with the configured credentials,
+// so that a single client can send requests on behalf of different callers, e.g. the tenants of a multi-tenant server.
+//
+// The token is used as is: it isn't refreshed nor checked for expiry, the caller owns its lifecycle.
+// An empty token has no effect.
+//
+// Only has effect if the request is authenticated with KeyFlow, TokenFlow or NoAuthFlow, which is the case
+// for all generated API clients unless a custom authentication round tripper is configured.
+func WithToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, contextTokenKey{}, token)
+}
+
+// GetToken returns the access token set in ctx with WithToken, if any
+func GetToken(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	token, ok := ctx.Value(contextTokenKey{}).(string)
+	return token, ok
+}
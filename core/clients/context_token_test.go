@@ -0,0 +1,84 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		flow      http.RoundTripper
+		token     string
+		wantToken string
+	}{
+		{
+			name:      "key flow",
+			flow:      &KeyFlow{rt: http.DefaultTransport},
+			token:     "tenant-token",
+			wantToken: "Bearer tenant-token",
+		},
+		{
+			name:      "token flow",
+			flow:      &TokenFlow{http.DefaultTransport, &TokenFlowConfig{ServiceAccountToken: "efg"}},
+			token:     "tenant-token",
+			wantToken: "Bearer tenant-token",
+		},
+		{
+			name:      "token flow without context token",
+			flow:      &TokenFlow{http.DefaultTransport, &TokenFlowConfig{ServiceAccountToken: "efg"}},
+			wantToken: "Bearer efg",
+		},
+		{
+			name:      "no auth flow",
+			flow:      &NoAuthFlow{rt: http.DefaultTransport},
+			token:     "tenant-token",
+			wantToken: "Bearer tenant-token",
+		},
+		{
+			name:      "no auth flow without context token",
+			flow:      &NoAuthFlow{rt: http.DefaultTransport},
+			wantToken: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotToken string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotToken = r.Header.Get("Authorization")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			ctx := WithToken(context.Background(), tt.token)
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			resp, err := tt.flow.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if gotToken != tt.wantToken {
+				t.Errorf("expected Authorization header %q, got %q", tt.wantToken, gotToken)
+			}
+		})
+	}
+}
+
+func TestGetToken(t *testing.T) {
+	if _, ok := GetToken(context.Background()); ok {
+		t.Errorf("expected no token")
+	}
+	if _, ok := GetToken(WithToken(context.Background(), "")); ok {
+		t.Errorf("expected an empty token to have no effect")
+	}
+	token, ok := GetToken(WithToken(context.Background(), "abc"))
+	if !ok || token != "abc" {
+		t.Errorf("expected token %q, got %q", "abc", token)
+	}
+}
@@ -0,0 +1,31 @@
+package clients
+
+import "context"
+
+// DefaultIdempotencyKeyHeader is the header of the idempotency key of a request, see WithIdempotencyKey
+const DefaultIdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a copy of ctx with which the POST, PUT and PATCH requests are sent with key as their
+// idempotency key, so that an API which supports it applies a request only once, even if it is sent again, e.g. by a
+// retry after the response was lost. The key should be unique per operation, e.g. derived from the id of the desired
+// resource of a reconciler, so that it is the same for all attempts of the operation.
+//
+// The requests with an idempotency key are retried by a RetryConfig like idempotent requests, see IsIdempotent.
+// The header of the key is set by the generated API clients, see config.WithIdempotencyKeys.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// GetIdempotencyKey returns the idempotency key set in ctx with WithIdempotencyKey, if any
+func GetIdempotencyKey(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key, ok && key != ""
+}
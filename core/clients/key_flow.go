@@ -2,6 +2,9 @@ package clients
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/json"
@@ -42,12 +45,19 @@ type KeyFlow struct {
 	authClient    *http.Client
 	config        *KeyFlowConfig
 	key           *ServiceAccountKeyResponse
-	privateKey    *rsa.PrivateKey
+	privateKey    crypto.Signer
 	privateKeyPEM []byte
+	signingMethod jwt.SigningMethod
+	// keyMutex protects the key material, which is replaced by UpdateKey, and the state of the key reloads
+	keyMutex      sync.RWMutex
+	lastKeyReload time.Time
+	keyReloadErr  error
 
 	tokenMutex sync.RWMutex
 	token      *TokenResponseBody
 
+	keyExpiryWarningOnce sync.Once
+
 	// If the current access token would expire in less than TokenExpirationLeeway,
 	// the client will refresh it early to prevent clock skew or other timing issues.
 	tokenExpirationLeeway time.Duration
@@ -63,6 +73,37 @@ type KeyFlowConfig struct {
 	BackgroundTokenRefreshContext context.Context // Functionality is enabled if this isn't nil
 	HTTPTransport                 http.RoundTripper
 	AuthHTTPClient                *http.Client
+	// If set, tokens are loaded from and saved to the TokenStore, see TokenStore
+	TokenStore TokenStore
+	// If set, KeyExpiryWarningHook is called once if the service account key expires within KeyExpiryWarningThreshold
+	KeyExpiryWarningThreshold time.Duration
+	KeyExpiryWarningHook      func(validUntil time.Time)
+	// If set, AuthEventHook is called when an access token is minted or refreshed, see AuthEvent
+	AuthEventHook func(event AuthEvent)
+	// Source of the ids of the self-signed JWTs, defaults to crypto/rand. It must be safe for concurrent use
+	RandSource io.Reader
+	// If set, the requests for new access tokens are coalesced with the ones of the other flows of the group
+	// with the same credentials, see RefreshGroup
+	RefreshGroup *RefreshGroup
+	// If set, KeyReloadFunc is called at most once every KeyReloadInterval when an access token is needed,
+	// and the keys it returns replace the current ones, see KeyFlow.UpdateKey
+	KeyReloadInterval time.Duration
+	KeyReloadFunc     func() (serviceAccountKey *ServiceAccountKeyResponse, privateKey string, err error)
+	// Algorithm of the self-signed JWTs, e.g. "RS512" or "ES256". Defaults to RS512 for RSA keys, and to ES256,
+	// ES384 or ES512 for EC keys depending on their curve
+	AssertionAlgorithm string
+	// If set, TokenListener is called with every new access token, after it was minted, refreshed, or obtained
+	// from the RefreshGroup. It is called synchronously and must not block.
+	TokenListener func(token TokenInfo)
+}
+
+// ServiceAccountKeyExpiredError is returned if the service account key is no longer valid
+type ServiceAccountKeyExpiredError struct {
+	ValidUntil time.Time
+}
+
+func (e *ServiceAccountKeyExpiredError) Error() string {
+	return fmt.Sprintf("service account key expired on %s", e.ValidUntil.Format(time.RFC3339))
 }
 
 // TokenResponseBody is the API response
@@ -107,6 +148,8 @@ func (c *KeyFlow) GetConfig() KeyFlowConfig {
 
 // GetServiceAccountEmail returns the service account email
 func (c *KeyFlow) GetServiceAccountEmail() string {
+	c.keyMutex.RLock()
+	defer c.keyMutex.RUnlock()
 	if c.key == nil {
 		return ""
 	}
@@ -136,6 +179,7 @@ func (c *KeyFlow) Init(cfg *KeyFlowConfig) error {
 	}
 
 	c.tokenExpirationLeeway = defaultTokenExpirationLeeway
+	c.lastKeyReload = time.Now()
 
 	if c.rt = cfg.HTTPTransport; c.rt == nil {
 		c.rt = http.DefaultTransport
@@ -190,9 +234,13 @@ func (c *KeyFlow) RoundTrip(req *http.Request) (*http.Response, error) {
 		return nil, fmt.Errorf("please run Init()")
 	}
 
+	if token, ok := GetToken(req.Context()); ok {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		return c.rt.RoundTrip(req)
+	}
 	accessToken, err := c.GetAccessToken()
 	if err != nil {
-		return nil, err
+		return nil, &AuthenticationError{Err: err}
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
 	return c.rt.RoundTrip(req)
@@ -204,6 +252,8 @@ func (c *KeyFlow) GetAccessToken() (string, error) {
 		return "", fmt.Errorf("nil http round tripper, please run Init()")
 	}
 
+	c.reloadKey()
+
 	var accessToken string
 
 	c.tokenMutex.RLock()
@@ -219,6 +269,9 @@ func (c *KeyFlow) GetAccessToken() (string, error) {
 	if !accessTokenExpired {
 		return accessToken, nil
 	}
+	if storedToken, ok := c.loadStoredToken(); ok {
+		return storedToken, nil
+	}
 	if err = c.recreateAccessToken(); err != nil {
 		var oapiErr *oapierror.GenericOpenAPIError
 		if ok := errors.As(err, &oapiErr); ok {
@@ -227,6 +280,9 @@ func (c *KeyFlow) GetAccessToken() (string, error) {
 				err = fmt.Errorf("check if your configured key is valid and if the token endpoint is configured correct: %w", err)
 			}
 		}
+		if reloadErr := c.lastKeyReloadError(); reloadErr != nil {
+			return "", fmt.Errorf("get new access token: %w (the last reload of the key failed: %v)", err, reloadErr)
+		}
 		return "", fmt.Errorf("get new access token: %w", err)
 	}
 
@@ -247,31 +303,144 @@ func (c *KeyFlow) validate() error {
 	}
 
 	c.key = c.config.ServiceAccountKey
+	if err := c.checkKeyExpiry(); err != nil {
+		return &AuthenticationError{Err: err}
+	}
 	var err error
-	c.privateKey, err = jwt.ParseRSAPrivateKeyFromPEM([]byte(c.config.PrivateKey))
+	c.privateKey, c.privateKeyPEM, err = parsePrivateKey(c.config.PrivateKey)
 	if err != nil {
 		return fmt.Errorf("parse private key from PEM file: %w", err)
 	}
-
-	// Encode the private key in PEM format
-	privKeyPEM := &pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(c.privateKey),
+	c.signingMethod, err = assertionSigningMethod(c.privateKey, c.config.AssertionAlgorithm)
+	if err != nil {
+		return err
 	}
-	c.privateKeyPEM = pem.EncodeToMemory(privKeyPEM)
 
 	if c.tokenExpirationLeeway < 0 {
 		return fmt.Errorf("token expiration leeway cannot be negative")
 	}
+	if c.config.KeyReloadFunc != nil && c.config.KeyReloadInterval <= 0 {
+		return fmt.Errorf("key reload interval must be positive")
+	}
 
 	return nil
 }
 
+// checkKeyExpiry returns an error if the service account key has expired
+// and calls the expiry warning hook once if it expires soon
+func (c *KeyFlow) checkKeyExpiry() error {
+	c.keyMutex.RLock()
+	defer c.keyMutex.RUnlock()
+	if c.key == nil || c.key.ValidUntil == nil {
+		return nil
+	}
+	validUntil := *c.key.ValidUntil
+	remaining := time.Until(validUntil)
+	if remaining <= 0 {
+		return &ServiceAccountKeyExpiredError{ValidUntil: validUntil}
+	}
+	if c.config.KeyExpiryWarningHook != nil && remaining < c.config.KeyExpiryWarningThreshold {
+		c.keyExpiryWarningOnce.Do(func() {
+			c.config.KeyExpiryWarningHook(validUntil)
+		})
+	}
+	return nil
+}
+
+// loadStoredToken loads the token from the token store, if configured.
+// It returns the access token and true if the stored access token is still valid.
+func (c *KeyFlow) loadStoredToken() (string, bool) {
+	if c.config.TokenStore == nil {
+		return "", false
+	}
+	token, err := c.config.TokenStore.Load(context.Background())
+	if err != nil || token == nil {
+		return "", false
+	}
+	expired, err := tokenExpired(token.AccessToken, c.tokenExpirationLeeway)
+	if err != nil || expired {
+		return "", false
+	}
+
+	c.tokenMutex.Lock()
+	c.token = token
+	c.tokenMutex.Unlock()
+	return token.AccessToken, true
+}
+
+// saveToken saves the current token to the token store, if configured
+func (c *KeyFlow) saveToken() {
+	if c.config.TokenStore == nil {
+		return
+	}
+	c.tokenMutex.RLock()
+	token := *c.token
+	c.tokenMutex.RUnlock()
+
+	// Best-effort, the token is still valid for this client if it can't be saved
+	_ = c.config.TokenStore.Save(context.Background(), &token)
+}
+
 // Flow auth functions
 
 // recreateAccessToken is used to create a new access token
 // when the existing one isn't valid anymore
 func (c *KeyFlow) recreateAccessToken() error {
+	var err error
+	if c.config.RefreshGroup != nil {
+		err = c.recreateAccessTokenInGroup()
+	} else if err = c.requestNewAccessToken(); err == nil {
+		c.saveToken()
+	}
+	if err != nil {
+		return err
+	}
+
+	if c.config.TokenListener != nil {
+		c.tokenMutex.RLock()
+		token := *c.token
+		c.tokenMutex.RUnlock()
+		notifyTokenListener(c.config.TokenListener, &token)
+	}
+	return nil
+}
+
+// recreateAccessTokenInGroup gets a new access token from the refresh group of the flow, which requests it with
+// this flow unless another flow with the same credentials already does
+func (c *KeyFlow) recreateAccessTokenInGroup() error {
+	var current string
+	c.tokenMutex.RLock()
+	if c.token != nil {
+		current = c.token.AccessToken
+	}
+	c.tokenMutex.RUnlock()
+
+	c.keyMutex.RLock()
+	key := refreshGroupKey(c.key, c.config.TokenUrl)
+	c.keyMutex.RUnlock()
+	token, err := c.config.RefreshGroup.do(key, current, c.tokenExpirationLeeway, func() (*TokenResponseBody, error) {
+		if err := c.requestNewAccessToken(); err != nil {
+			return nil, err
+		}
+		c.tokenMutex.RLock()
+		token := *c.token
+		c.tokenMutex.RUnlock()
+		return &token, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Each flow keeps its own copy, the token is replaced on every refresh
+	shared := *token
+	c.tokenMutex.Lock()
+	c.token = &shared
+	c.tokenMutex.Unlock()
+	c.saveToken()
+	return nil
+}
+
+func (c *KeyFlow) requestNewAccessToken() error {
 	var refreshToken string
 
 	c.tokenMutex.RLock()
@@ -285,13 +454,17 @@ func (c *KeyFlow) recreateAccessToken() error {
 		return err
 	}
 	if !refreshTokenExpired {
-		return c.createAccessTokenWithRefreshToken()
+		return observeAuthOperation(c.config.AuthEventHook, AuthOperationRefresh, c.createAccessTokenWithRefreshToken)
 	}
-	return c.createAccessToken()
+	return observeAuthOperation(c.config.AuthEventHook, AuthOperationMint, c.createAccessToken)
 }
 
 // createAccessToken creates an access token using self signed JWT
 func (c *KeyFlow) createAccessToken() (err error) {
+	// The key may have expired since the flow was initialized
+	if err := c.checkKeyExpiry(); err != nil {
+		return err
+	}
 	grant := "urn:ietf:params:oauth:grant-type:jwt-bearer"
 	assertion, err := c.generateSelfSignedJWT()
 	if err != nil {
@@ -330,19 +503,82 @@ func (c *KeyFlow) createAccessTokenWithRefreshToken() (err error) {
 	return c.parseTokenResponse(res)
 }
 
+// parsePrivateKey parses an RSA or EC private key in PEM format and returns it with its PKCS #1 or SEC 1 encoding
+func parsePrivateKey(privateKey string) (crypto.Signer, []byte, error) {
+	rsaKey, rsaErr := jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKey))
+	if rsaErr == nil {
+		return rsaKey, pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(rsaKey),
+		}), nil
+	}
+	ecKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(privateKey))
+	if err != nil {
+		// Report the error of the RSA key, the most common kind of service account key
+		return nil, nil, rsaErr
+	}
+	der, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ecKey, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+// assertionSigningMethod returns the signing method of the self-signed JWTs for key, which is alg if set
+func assertionSigningMethod(key crypto.Signer, alg string) (jwt.SigningMethod, error) {
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		if alg == "" {
+			return jwt.SigningMethodRS512, nil
+		}
+		switch alg {
+		case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512":
+			return jwt.GetSigningMethod(alg), nil
+		}
+		return nil, fmt.Errorf("assertion algorithm %q cannot be used with an RSA private key, use one of RS256, RS384, RS512, PS256, PS384 or PS512", alg)
+	case *ecdsa.PrivateKey:
+		var curveAlg string
+		switch key.Curve {
+		case elliptic.P256():
+			curveAlg = "ES256"
+		case elliptic.P384():
+			curveAlg = "ES384"
+		case elliptic.P521():
+			curveAlg = "ES512"
+		default:
+			return nil, fmt.Errorf("unsupported curve %s of the EC private key, use P-256, P-384 or P-521", key.Curve.Params().Name)
+		}
+		if alg != "" && alg != curveAlg {
+			return nil, fmt.Errorf("assertion algorithm %q cannot be used with an EC private key on curve %s, use %s", alg, key.Curve.Params().Name, curveAlg)
+		}
+		return jwt.GetSigningMethod(curveAlg), nil
+	}
+	return nil, fmt.Errorf("unsupported private key type %T", key)
+}
+
 // generateSelfSignedJWT generates JWT token
 func (c *KeyFlow) generateSelfSignedJWT() (string, error) {
+	jti, err := uuid.NewRandom()
+	if c.config.RandSource != nil {
+		jti, err = uuid.NewRandomFromReader(c.config.RandSource)
+	}
+	if err != nil {
+		return "", fmt.Errorf("generate JWT id: %w", err)
+	}
+	c.keyMutex.RLock()
+	key, privateKey, signingMethod := c.key, c.privateKey, c.signingMethod
+	c.keyMutex.RUnlock()
 	claims := jwt.MapClaims{
-		"iss": c.key.Credentials.Iss,
-		"sub": c.key.Credentials.Sub,
-		"jti": uuid.New(),
-		"aud": c.key.Credentials.Aud,
+		"iss": key.Credentials.Iss,
+		"sub": key.Credentials.Sub,
+		"jti": jti,
+		"aud": key.Credentials.Aud,
 		"iat": jwt.NewNumericDate(time.Now()),
 		"exp": jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodRS512, claims)
-	token.Header["kid"] = c.key.Credentials.Kid
-	tokenString, err := token.SignedString(c.privateKey)
+	token := jwt.NewWithClaims(signingMethod, claims)
+	token.Header["kid"] = key.Credentials.Kid
+	tokenString, err := token.SignedString(privateKey)
 	if err != nil {
 		return "", err
 	}
@@ -2,6 +2,8 @@ package clients
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -10,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	mathrand "math/rand"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -102,6 +105,23 @@ func TestKeyFlowInit(t *testing.T) {
 			invalidPrivateKey: true,
 			wantErr:           true,
 		},
+		{
+			name: "expired_service_account_key",
+			serviceAccountKey: fixtureServiceAccountKey(func(s *ServiceAccountKeyResponse) {
+				validUntil := time.Now().Add(-time.Hour)
+				s.ValidUntil = &validUntil
+			}),
+			genPrivateKey: true,
+			wantErr:       true,
+		},
+		{
+			name: "no_expiration",
+			serviceAccountKey: fixtureServiceAccountKey(func(s *ServiceAccountKeyResponse) {
+				s.ValidUntil = nil
+			}),
+			genPrivateKey: true,
+			wantErr:       false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -130,6 +150,201 @@ func TestKeyFlowInit(t *testing.T) {
 	}
 }
 
+func TestKeyFlowKeyExpiry(t *testing.T) {
+	privateKeyBytes, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+
+	tests := []struct {
+		name        string
+		validFor    time.Duration
+		threshold   time.Duration
+		wantErr     bool
+		wantWarning bool
+	}{
+		{
+			name:        "expires_soon",
+			validFor:    time.Hour,
+			threshold:   24 * time.Hour,
+			wantWarning: true,
+		},
+		{
+			name:        "expires_later",
+			validFor:    48 * time.Hour,
+			threshold:   24 * time.Hour,
+			wantWarning: false,
+		},
+		{
+			name:      "expired",
+			validFor:  -time.Minute,
+			threshold: 24 * time.Hour,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validUntil := time.Now().Add(tt.validFor)
+			warnings := 0
+			keyFlow := &KeyFlow{}
+			err := keyFlow.Init(&KeyFlowConfig{
+				ServiceAccountKey: fixtureServiceAccountKey(func(s *ServiceAccountKeyResponse) {
+					s.ValidUntil = &validUntil
+				}),
+				PrivateKey:                string(privateKeyBytes),
+				KeyExpiryWarningThreshold: tt.threshold,
+				KeyExpiryWarningHook: func(got time.Time) {
+					warnings++
+					if !got.Equal(validUntil) {
+						t.Errorf("expected validUntil %v, got %v", validUntil, got)
+					}
+				},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("KeyFlow.Init() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				var authErr *AuthenticationError
+				var expiredErr *ServiceAccountKeyExpiredError
+				if !errors.As(err, &authErr) || !errors.As(err, &expiredErr) {
+					t.Fatalf("expected expired service account key error, got %v", err)
+				}
+				return
+			}
+
+			// The hook is only called once
+			if err := keyFlow.checkKeyExpiry(); err != nil {
+				t.Fatalf("checkKeyExpiry() error = %v", err)
+			}
+			wantWarnings := 0
+			if tt.wantWarning {
+				wantWarnings = 1
+			}
+			if warnings != wantWarnings {
+				t.Fatalf("expected %d warnings, got %d", wantWarnings, warnings)
+			}
+		})
+	}
+}
+
+func TestKeyFlowRandSource(t *testing.T) {
+	privateKeyBytes, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+
+	jti := func() string {
+		keyFlow := &KeyFlow{}
+		err := keyFlow.Init(&KeyFlowConfig{
+			ServiceAccountKey: fixtureServiceAccountKey(),
+			PrivateKey:        string(privateKeyBytes),
+			RandSource:        mathrand.New(mathrand.NewSource(1)), //nolint:gosec // deterministic on purpose
+		})
+		if err != nil {
+			t.Fatalf("KeyFlow.Init() error = %v", err)
+		}
+		token, err := keyFlow.generateSelfSignedJWT()
+		if err != nil {
+			t.Fatalf("generateSelfSignedJWT() error = %v", err)
+		}
+		claims := jwt.MapClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+			t.Fatalf("parsing token: %v", err)
+		}
+		id, _ := claims["jti"].(string)
+		return id
+	}
+
+	first, second := jti(), jti()
+	if first == "" || first != second {
+		t.Fatalf("expected the same JWT id with the same seed, got %q and %q", first, second)
+	}
+}
+
+func generateECPrivateKey(curve elliptic.Curve, pkcs8 bool) ([]byte, error) {
+	privKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	if pkcs8 {
+		der, err := x509.MarshalPKCS8PrivateKey(privKey)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+	}
+	der, err := x509.MarshalECPrivateKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+func TestKeyFlowAssertionAlgorithm(t *testing.T) {
+	rsaKey, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+	p256Key, err := generateECPrivateKey(elliptic.P256(), false)
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+	p384Key, err := generateECPrivateKey(elliptic.P384(), true)
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+
+	for _, tt := range []struct {
+		desc       string
+		privateKey []byte
+		alg        string
+		wantAlg    string
+		wantErr    bool
+	}{
+		{desc: "rsa_default", privateKey: rsaKey, wantAlg: "RS512"},
+		{desc: "rsa_rs256", privateKey: rsaKey, alg: "RS256", wantAlg: "RS256"},
+		{desc: "rsa_ps256", privateKey: rsaKey, alg: "PS256", wantAlg: "PS256"},
+		{desc: "rsa_es256", privateKey: rsaKey, alg: "ES256", wantErr: true},
+		{desc: "ec_p256_default", privateKey: p256Key, wantAlg: "ES256"},
+		{desc: "ec_p384_pkcs8_default", privateKey: p384Key, wantAlg: "ES384"},
+		{desc: "ec_p256_es256", privateKey: p256Key, alg: "ES256", wantAlg: "ES256"},
+		{desc: "ec_p256_es384", privateKey: p256Key, alg: "ES384", wantErr: true},
+		{desc: "ec_rs256", privateKey: p256Key, alg: "RS256", wantErr: true},
+		{desc: "invalid_key", privateKey: []byte("not a key"), wantErr: true},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			keyFlow := &KeyFlow{}
+			err := keyFlow.Init(&KeyFlowConfig{
+				ServiceAccountKey:  fixtureServiceAccountKey(),
+				PrivateKey:         string(tt.privateKey),
+				AssertionAlgorithm: tt.alg,
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("KeyFlow.Init() error = %v", err)
+			}
+			token, err := keyFlow.generateSelfSignedJWT()
+			if err != nil {
+				t.Fatalf("generateSelfSignedJWT() error = %v", err)
+			}
+			parsed, err := jwt.Parse(token, func(*jwt.Token) (interface{}, error) {
+				return keyFlow.privateKey.Public(), nil
+			}, jwt.WithValidMethods([]string{tt.wantAlg}))
+			if err != nil {
+				t.Fatalf("verifying token: %v", err)
+			}
+			if parsed.Header["kid"] != keyFlow.key.Credentials.Kid {
+				t.Fatalf("unexpected kid %v", parsed.Header["kid"])
+			}
+		})
+	}
+}
+
 func TestSetToken(t *testing.T) {
 	tests := []struct {
 		name         string
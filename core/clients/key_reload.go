@@ -0,0 +1,81 @@
+package clients
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// UpdateKey replaces the service account key and the private key of the flow, e.g. after the key was rotated.
+// The self-signed JWTs of the next access tokens are signed with the new keys. If the key id or the private key
+// changed, the current access and refresh tokens are discarded, so that the next request gets an access token
+// with the new key. It is safe to call while the flow is used, and the current keys are kept if it returns an error.
+func (c *KeyFlow) UpdateKey(serviceAccountKey *ServiceAccountKeyResponse, privateKey string) error {
+	if serviceAccountKey == nil || serviceAccountKey.Credentials == nil {
+		return fmt.Errorf("service account access key cannot be empty")
+	}
+	if privateKey == "" {
+		return fmt.Errorf("private key cannot be empty")
+	}
+	if serviceAccountKey.ValidUntil != nil && !serviceAccountKey.ValidUntil.After(time.Now()) {
+		return &ServiceAccountKeyExpiredError{ValidUntil: *serviceAccountKey.ValidUntil}
+	}
+	signer, signerPEM, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return fmt.Errorf("parse private key from PEM file: %w", err)
+	}
+	signingMethod, err := assertionSigningMethod(signer, c.config.AssertionAlgorithm)
+	if err != nil {
+		return err
+	}
+
+	c.keyMutex.Lock()
+	changed := c.key == nil || c.key.Credentials.Kid != serviceAccountKey.Credentials.Kid || !bytes.Equal(c.privateKeyPEM, signerPEM)
+	if changed {
+		c.key = serviceAccountKey
+		c.privateKey = signer
+		c.privateKeyPEM = signerPEM
+		c.signingMethod = signingMethod
+		// The new key may expire soon as well
+		c.keyExpiryWarningOnce = sync.Once{}
+	}
+	c.keyMutex.Unlock()
+
+	if changed {
+		c.tokenMutex.Lock()
+		c.token = &TokenResponseBody{}
+		c.tokenMutex.Unlock()
+	}
+	return nil
+}
+
+// reloadKey updates the keys of the flow with the ones returned by the KeyReloadFunc of the configuration,
+// if its KeyReloadInterval has elapsed since the last reload. If the reload fails, the current keys are kept.
+func (c *KeyFlow) reloadKey() {
+	if c.config.KeyReloadFunc == nil {
+		return
+	}
+	c.keyMutex.Lock()
+	if time.Since(c.lastKeyReload) < c.config.KeyReloadInterval {
+		c.keyMutex.Unlock()
+		return
+	}
+	c.lastKeyReload = time.Now()
+	c.keyMutex.Unlock()
+
+	serviceAccountKey, privateKey, err := c.config.KeyReloadFunc()
+	if err == nil {
+		err = c.UpdateKey(serviceAccountKey, privateKey)
+	}
+	c.keyMutex.Lock()
+	c.keyReloadErr = err
+	c.keyMutex.Unlock()
+}
+
+// lastKeyReloadError returns the error of the last reload of the keys, if it failed
+func (c *KeyFlow) lastKeyReloadError() error {
+	c.keyMutex.RLock()
+	defer c.keyMutex.RUnlock()
+	return c.keyReloadErr
+}
@@ -0,0 +1,143 @@
+package clients
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestKeyFlowUpdateKey(t *testing.T) {
+	oldPrivateKey, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+	newPrivateKey, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+
+	keyFlow := &KeyFlow{}
+	err = keyFlow.Init(&KeyFlowConfig{
+		ServiceAccountKey: fixtureServiceAccountKey(),
+		PrivateKey:        string(oldPrivateKey),
+	})
+	if err != nil {
+		t.Fatalf("KeyFlow.Init() error = %v", err)
+	}
+	if err := keyFlow.SetToken(testBearerToken, "refresh"); err != nil {
+		t.Fatalf("SetToken() error = %v", err)
+	}
+
+	// Invalid keys are rejected and the current keys are kept
+	oldKey := keyFlow.key
+	expired := time.Now().Add(-time.Hour)
+	for _, tt := range []struct {
+		desc       string
+		key        *ServiceAccountKeyResponse
+		privateKey string
+	}{
+		{"no_key", nil, string(newPrivateKey)},
+		{"no_private_key", fixtureServiceAccountKey(), ""},
+		{"invalid_private_key", fixtureServiceAccountKey(), "not a key"},
+		{"expired_key", fixtureServiceAccountKey(func(k *ServiceAccountKeyResponse) { k.ValidUntil = &expired }), string(newPrivateKey)},
+	} {
+		if err := keyFlow.UpdateKey(tt.key, tt.privateKey); err == nil {
+			t.Fatalf("%s: expected an error", tt.desc)
+		}
+		if keyFlow.key != oldKey || keyFlow.GetToken().AccessToken != testBearerToken {
+			t.Fatalf("%s: expected the key and the token to be kept", tt.desc)
+		}
+	}
+
+	// The same key keeps the token
+	if err := keyFlow.UpdateKey(oldKey, string(oldPrivateKey)); err != nil {
+		t.Fatalf("UpdateKey() error = %v", err)
+	}
+	if keyFlow.GetToken().AccessToken != testBearerToken {
+		t.Fatalf("expected the token to be kept for the same key")
+	}
+
+	newKey := fixtureServiceAccountKey()
+	if err := keyFlow.UpdateKey(newKey, string(newPrivateKey)); err != nil {
+		t.Fatalf("UpdateKey() error = %v", err)
+	}
+	if token := keyFlow.GetToken(); token.AccessToken != "" || token.RefreshToken != "" {
+		t.Fatalf("expected the token to be discarded, got %+v", token)
+	}
+	if keyFlow.GetServiceAccountEmail() != newKey.Credentials.Iss {
+		t.Fatalf("expected the email of the new key")
+	}
+	assertion, err := keyFlow.generateSelfSignedJWT()
+	if err != nil {
+		t.Fatalf("generateSelfSignedJWT() error = %v", err)
+	}
+	parsed, err := jwt.Parse(assertion, func(*jwt.Token) (interface{}, error) {
+		return keyFlow.privateKey.Public(), nil
+	})
+	if err != nil {
+		t.Fatalf("verifying the assertion with the new key: %v", err)
+	}
+	if parsed.Header["kid"] != newKey.Credentials.Kid {
+		t.Fatalf("expected the kid of the new key, got %v", parsed.Header["kid"])
+	}
+}
+
+func TestKeyFlowReloadKey(t *testing.T) {
+	privateKey, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+
+	calls := 0
+	var reloadErr error
+	keyFlow := &KeyFlow{}
+	err = keyFlow.Init(&KeyFlowConfig{
+		ServiceAccountKey: fixtureServiceAccountKey(),
+		PrivateKey:        string(privateKey),
+		KeyReloadInterval: time.Hour,
+		KeyReloadFunc: func() (*ServiceAccountKeyResponse, string, error) {
+			calls++
+			return fixtureServiceAccountKey(), string(privateKey), reloadErr
+		},
+	})
+	if err != nil {
+		t.Fatalf("KeyFlow.Init() error = %v", err)
+	}
+
+	// The keys were read by Init
+	keyFlow.reloadKey()
+	if calls != 0 {
+		t.Fatalf("expected no reload before the interval elapsed, got %d", calls)
+	}
+
+	initialKey := keyFlow.key
+	reloadErr = fmt.Errorf("file is being replaced")
+	keyFlow.lastKeyReload = time.Now().Add(-time.Hour)
+	keyFlow.reloadKey()
+	if calls != 1 || keyFlow.key != initialKey || keyFlow.lastKeyReloadError() == nil {
+		t.Fatalf("expected a failed reload which keeps the key, got %d calls, error %v", calls, keyFlow.lastKeyReloadError())
+	}
+	keyFlow.reloadKey()
+	if calls != 1 {
+		t.Fatalf("expected a failed reload to be retried after the interval, got %d calls", calls)
+	}
+
+	reloadErr = nil
+	keyFlow.lastKeyReload = time.Now().Add(-time.Hour)
+	keyFlow.reloadKey()
+	if calls != 2 || keyFlow.key == initialKey || keyFlow.lastKeyReloadError() != nil {
+		t.Fatalf("expected the key to be reloaded, got %d calls, error %v", calls, keyFlow.lastKeyReloadError())
+	}
+
+	// The interval is required
+	err = (&KeyFlow{}).Init(&KeyFlowConfig{
+		ServiceAccountKey: fixtureServiceAccountKey(),
+		PrivateKey:        string(privateKey),
+		KeyReloadFunc:     func() (*ServiceAccountKeyResponse, string, error) { return nil, "", nil },
+	})
+	if err == nil {
+		t.Fatalf("expected an error without a reload interval")
+	}
+}
@@ -41,5 +41,9 @@ func (c *NoAuthFlow) RoundTrip(req *http.Request) (*http.Response, error) {
 		return nil, fmt.Errorf("please run Init()")
 	}
 
+	if token, ok := GetToken(req.Context()); ok {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		return c.rt.RoundTrip(req)
+	}
 	return c.rt.RoundTrip(req)
 }
@@ -0,0 +1,40 @@
+package clients
+
+import (
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"sync"
+)
+
+// NewLockedReader returns an io.Reader which serializes the reads from r, so that a random source which isn't safe
+// for concurrent use, e.g. a *rand.Rand, can be shared by the requests of a client
+func NewLockedReader(r io.Reader) io.Reader {
+	if _, ok := r.(*lockedReader); ok {
+		return r
+	}
+	return &lockedReader{r: r}
+}
+
+type lockedReader struct {
+	mu sync.Mutex
+	r  io.Reader
+}
+
+func (l *lockedReader) Read(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Read(p)
+}
+
+// randInt63n returns a random number in [0, n) drawn from r, or from math/rand if r is nil or fails
+func randInt63n(r io.Reader, n int64) int64 {
+	if r != nil {
+		var b [8]byte
+		if _, err := io.ReadFull(r, b[:]); err == nil {
+			v := int64(binary.BigEndian.Uint64(b[:]) >> 1)
+			return v % n
+		}
+	}
+	return rand.Int63n(n) //nolint:gosec // jitter doesn't need a secure random source
+}
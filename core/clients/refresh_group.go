@@ -0,0 +1,97 @@
+package clients
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// RefreshGroup coalesces the requests for new access tokens of the key flows which share it and the same credentials,
+// e.g. many API clients created from the same service account key: while an access token is requested, the other
+// flows wait for it instead of requesting their own, and a token obtained by one flow is reused by the others while it
+// is valid. This protects the token endpoint when the tokens of many clients expire at the same time.
+//
+// A RefreshGroup is safe for concurrent use, the zero value is ready to use.
+type RefreshGroup struct {
+	mu        sync.Mutex
+	calls     map[string]*refreshCall
+	tokens    map[string]*TokenResponseBody
+	performed int64
+	coalesced int64
+}
+
+// RefreshGroupStats are the numbers of requests for new access tokens of a RefreshGroup
+type RefreshGroupStats struct {
+	// Performed is the number of requests sent to the token endpoint
+	Performed int64
+	// Coalesced is the number of requests which got the token of another flow instead
+	Coalesced int64
+}
+
+type refreshCall struct {
+	done  chan struct{}
+	token *TokenResponseBody
+	err   error
+}
+
+// NewRefreshGroup returns an empty RefreshGroup
+func NewRefreshGroup() *RefreshGroup {
+	return &RefreshGroup{}
+}
+
+// Stats returns the numbers of requests for new access tokens since the group was created
+func (g *RefreshGroup) Stats() RefreshGroupStats {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return RefreshGroupStats{Performed: g.performed, Coalesced: g.coalesced}
+}
+
+// do returns a new token of the credentials identified by key, replacing current: the last token obtained for them
+// if it isn't current and still valid for longer than leeway, the one of a request in progress, or the one obtained
+// with refresh.
+func (g *RefreshGroup) do(key, current string, leeway time.Duration, refresh func() (*TokenResponseBody, error)) (*TokenResponseBody, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = map[string]*refreshCall{}
+		g.tokens = map[string]*TokenResponseBody{}
+	}
+	if token, ok := g.tokens[key]; ok && token.AccessToken != current {
+		if expired, err := tokenExpired(token.AccessToken, leeway); err == nil && !expired {
+			g.coalesced++
+			g.mu.Unlock()
+			return token, nil
+		}
+	}
+	if call, ok := g.calls[key]; ok {
+		g.coalesced++
+		g.mu.Unlock()
+		<-call.done
+		return call.token, call.err
+	}
+	call := &refreshCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.performed++
+	g.mu.Unlock()
+
+	call.token, call.err = refresh()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	if call.err == nil {
+		g.tokens[key] = call.token
+	}
+	g.mu.Unlock()
+	close(call.done)
+	return call.token, call.err
+}
+
+// refreshGroupKey identifies the credentials of a key flow in a RefreshGroup
+func refreshGroupKey(key *ServiceAccountKeyResponse, tokenURL string) string {
+	h := sha256.New()
+	for _, s := range []string{key.ID.String(), key.Credentials.Kid, key.Credentials.Iss, tokenURL} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,144 @@
+package clients
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newRefreshGroupTestToken(t *testing.T, expiresAt time.Time) *TokenResponseBody {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		ID:        time.Now().String(),
+	}).SignedString([]byte("test"))
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+	return &TokenResponseBody{AccessToken: token}
+}
+
+func TestRefreshGroupCoalesces(t *testing.T) {
+	group := NewRefreshGroup()
+	token := newRefreshGroupTestToken(t, time.Now().Add(time.Hour))
+
+	release := make(chan struct{})
+	var mu sync.Mutex
+	refreshes := 0
+	refresh := func() (*TokenResponseBody, error) {
+		mu.Lock()
+		refreshes++
+		mu.Unlock()
+		<-release
+		return token, nil
+	}
+
+	const flows = 5
+	var wg sync.WaitGroup
+	results := make([]*TokenResponseBody, flows)
+	for i := 0; i < flows; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got, err := group.do("key", "", time.Second, refresh)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			results[i] = got
+		}(i)
+	}
+	// Wait until all flows have joined the request in progress
+	for group.Stats().Performed+group.Stats().Coalesced < flows {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if refreshes != 1 {
+		t.Fatalf("expected 1 refresh, got %d", refreshes)
+	}
+	for i, got := range results {
+		if got != token {
+			t.Errorf("flow %d: expected the token of the refresh, got %+v", i, got)
+		}
+	}
+
+	// A later flow reuses the valid token, unless it already has it
+	if got, _ := group.do("key", "", time.Second, refresh); got != token {
+		t.Fatalf("expected the cached token, got %+v", got)
+	}
+	if _, err := group.do("key", token.AccessToken, time.Second, refresh); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if refreshes != 2 {
+		t.Fatalf("expected a refresh for the flow with the cached token, got %d refreshes", refreshes)
+	}
+
+	if stats := group.Stats(); stats != (RefreshGroupStats{Performed: 2, Coalesced: flows}) {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestRefreshGroupKeys(t *testing.T) {
+	group := &RefreshGroup{}
+	refreshes := 0
+	refresh := func() (*TokenResponseBody, error) {
+		refreshes++
+		return newRefreshGroupTestToken(t, time.Now().Add(time.Hour)), nil
+	}
+
+	for _, key := range []string{"a", "b", "a"} {
+		if _, err := group.do(key, "", time.Second, refresh); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if refreshes != 2 {
+		t.Fatalf("expected one refresh per key, got %d", refreshes)
+	}
+}
+
+func TestRefreshGroupErrorsAndExpiredTokens(t *testing.T) {
+	group := NewRefreshGroup()
+	errRefresh := errors.New("refresh failed")
+
+	if _, err := group.do("key", "", time.Second, func() (*TokenResponseBody, error) {
+		return nil, errRefresh
+	}); !errors.Is(err, errRefresh) {
+		t.Fatalf("expected the refresh error, got %v", err)
+	}
+
+	expired := newRefreshGroupTestToken(t, time.Now().Add(-time.Hour))
+	for i := 0; i < 2; i++ {
+		if _, err := group.do("key", "", time.Second, func() (*TokenResponseBody, error) {
+			return expired, nil
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if stats := group.Stats(); stats != (RefreshGroupStats{Performed: 3}) {
+		t.Fatalf("expected errors and expired tokens not to be reused, got stats %+v", stats)
+	}
+}
+
+func TestRefreshGroupKey(t *testing.T) {
+	key := fixtureServiceAccountKey()
+	same := *key
+	other := fixtureServiceAccountKey(func(k *ServiceAccountKeyResponse) {
+		k.ID = key.ID
+		k.Credentials.Iss = key.Credentials.Iss
+	})
+
+	if refreshGroupKey(key, "https://token") != refreshGroupKey(&same, "https://token") {
+		t.Fatalf("expected the same key for the same credentials")
+	}
+	if refreshGroupKey(key, "https://token") == refreshGroupKey(other, "https://token") {
+		t.Fatalf("expected different keys for different credentials")
+	}
+	if refreshGroupKey(key, "https://token") == refreshGroupKey(key, "https://other") {
+		t.Fatalf("expected different keys for different token endpoints")
+	}
+}
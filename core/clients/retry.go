@@ -0,0 +1,55 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// DefaultRetryOn is the default RetryOn of a RetryConfig. It retries the attempts which failed without a response,
+// except if the context of the request was canceled, the request could not be authenticated or the circuit of the host
+// is open (see CircuitBreaker), and the attempts which got 429 Too Many Requests, 500 Internal Server Error,
+// 502 Bad Gateway, 503 Service Unavailable or 504 Gateway Timeout.
+func DefaultRetryOn(resp *http.Response, err error) bool {
+	if err != nil {
+		var authErr *AuthenticationError
+		var circuitErr *CircuitOpenError
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && !errors.As(err, &authErr) && !errors.As(err, &circuitErr)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// IsIdempotent reports whether req can be sent more than once with the same effect: requests with the methods
+// GET, HEAD, OPTIONS, TRACE, PUT and DELETE (RFC 9110), and requests with an idempotency key, either in the
+// Idempotency-Key header or in the context, see WithIdempotencyKey
+func IsIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	if _, ok := GetIdempotencyKey(req.Context()); ok {
+		return true
+	}
+	return req.Header.Get(DefaultIdempotencyKeyHeader) != ""
+}
+
+// applies reports whether req may be retried with r
+func (r *RetryConfig) applies(req *http.Request) bool {
+	return r != nil && (r.RetryNonIdempotent || IsIdempotent(req))
+}
+
+func (r *RetryConfig) retryOn(resp *http.Response, err error) bool {
+	if r.RetryOn != nil {
+		return r.RetryOn(resp, err)
+	}
+	return DefaultRetryOn(resp, err)
+}
+
+func (r *RetryConfig) nextDelay(attempt int, resp *http.Response) time.Duration {
+	return ExponentialBackoff{BaseDelay: r.BaseDelay, MaxDelay: r.MaxDelay, Jitter: r.Jitter, Rand: r.Rand}.NextDelay(attempt, resp)
+}
@@ -0,0 +1,60 @@
+package clients
+
+import (
+	"fmt"
+	"sync"
+)
+
+// retryBudgetTolerance is the rounding error of the deposits which is tolerated when withdrawing a retry
+const retryBudgetTolerance = 1e-9
+
+// RetryBudget caps the fraction of requests that are retries, to prevent retry storms during outages.
+//
+// It is a token bucket shared by all requests of a client: every request deposits ratio tokens and every retry
+// withdraws one token. The bucket starts with min tokens, so min retries are allowed in a burst, and afterwards
+// retries are limited to ratio of the requests. It holds at most min tokens, or one if min is 0, so that the requests
+// earn retries without a burst. When the bucket is empty, no retries are made.
+type RetryBudget struct {
+	mu        sync.Mutex
+	tokens    float64
+	maxTokens float64
+	ratio     float64
+}
+
+// NewRetryBudget returns a RetryBudget which allows retrying ratio of the requests (e.g. 0.1 for 10%),
+// with a burst of min retries
+func NewRetryBudget(ratio float64, min int) (*RetryBudget, error) {
+	if ratio < 0 || ratio > 1 {
+		return nil, fmt.Errorf("retry budget ratio must be between 0 and 1, got %v", ratio)
+	}
+	if min < 0 {
+		return nil, fmt.Errorf("retry budget minimum cannot be negative, got %d", min)
+	}
+	return &RetryBudget{
+		tokens:    float64(min),
+		maxTokens: max(float64(min), 1),
+		ratio:     ratio,
+	}, nil
+}
+
+// Deposit records a request, which adds to the budget
+func (b *RetryBudget) Deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens += b.ratio
+	if b.tokens > b.maxTokens {
+		b.tokens = b.maxTokens
+	}
+}
+
+// TryWithdraw withdraws a retry from the budget. It returns false if the budget is exhausted.
+func (b *RetryBudget) TryWithdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// The tolerance accounts for the rounding of the deposits, e.g. ten deposits of 0.1
+	if b.tokens < 1-retryBudgetTolerance {
+		return false
+	}
+	b.tokens = max(b.tokens-1, 0)
+	return true
+}
@@ -0,0 +1,141 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewRetryBudget(t *testing.T) {
+	for _, tt := range []struct {
+		desc    string
+		ratio   float64
+		min     int
+		isValid bool
+	}{
+		{"valid", 0.1, 10, true},
+		{"no_retries", 0, 0, true},
+		{"negative_ratio", -0.1, 10, false},
+		{"ratio_too_high", 1.5, 10, false},
+		{"negative_min", 0.1, -1, false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := NewRetryBudget(tt.ratio, tt.min)
+			if tt.isValid && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	budget, err := NewRetryBudget(0.5, 2)
+	if err != nil {
+		t.Fatalf("creating budget: %v", err)
+	}
+
+	// The burst is available from the start
+	for i := 0; i < 2; i++ {
+		if !budget.TryWithdraw() {
+			t.Fatalf("expected retry %d to be allowed", i)
+		}
+	}
+	if budget.TryWithdraw() {
+		t.Fatalf("expected budget to be exhausted")
+	}
+
+	// Two requests earn one retry
+	budget.Deposit()
+	if budget.TryWithdraw() {
+		t.Fatalf("expected budget to be exhausted after one request")
+	}
+	budget.Deposit()
+	if !budget.TryWithdraw() {
+		t.Fatalf("expected retry to be allowed after two requests")
+	}
+
+	// The budget doesn't grow beyond the burst
+	for i := 0; i < 10; i++ {
+		budget.Deposit()
+	}
+	allowed := 0
+	for budget.TryWithdraw() {
+		allowed++
+	}
+	if allowed != 2 {
+		t.Fatalf("expected 2 retries to be allowed, got %d", allowed)
+	}
+}
+
+func TestRetryBudgetWithoutBurst(t *testing.T) {
+	budget, err := NewRetryBudget(0.1, 0)
+	if err != nil {
+		t.Fatalf("creating budget: %v", err)
+	}
+	if budget.TryWithdraw() {
+		t.Fatalf("expected no retry to be allowed without requests")
+	}
+
+	// Ten requests earn one retry
+	for i := 0; i < 10; i++ {
+		budget.Deposit()
+	}
+	if !budget.TryWithdraw() {
+		t.Fatalf("expected a retry to be allowed after 10 requests")
+	}
+	if budget.TryWithdraw() {
+		t.Fatalf("expected budget to be exhausted")
+	}
+
+	// Without a burst, the budget holds a single retry
+	for i := 0; i < 50; i++ {
+		budget.Deposit()
+	}
+	allowed := 0
+	for budget.TryWithdraw() {
+		allowed++
+	}
+	if allowed != 1 {
+		t.Fatalf("expected 1 retry to be allowed, got %d", allowed)
+	}
+}
+
+func TestConflictRetryRoundTripperRetryBudget(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusConflict)
+	}))
+	defer server.Close()
+
+	budget, err := NewRetryBudget(0, 1)
+	if err != nil {
+		t.Fatalf("creating budget: %v", err)
+	}
+	rt := NewConflictRetryRoundTripper(nil).SetRetryBudget(budget)
+	rt.baseDelay = time.Millisecond
+
+	// The first request uses up the budget with its single retry, the second one isn't retried
+	for _, expectedCalls := range []int{2, 3} {
+		req, err := http.NewRequestWithContext(WithConflictRetry(context.Background(), 5), http.MethodPost, server.URL, http.NoBody)
+		if err != nil {
+			t.Fatalf("creating request: %v", err)
+		}
+		resp, err := (&http.Client{Transport: rt}).Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusConflict {
+			t.Errorf("expected status code %d, got %d", http.StatusConflict, resp.StatusCode)
+		}
+		if calls != expectedCalls {
+			t.Errorf("expected %d calls, got %d", expectedCalls, calls)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConflictRetryRoundTripperRetryConfig(t *testing.T) {
+	for _, tt := range []struct {
+		desc           string
+		method         string
+		idempotencyKey string
+		config         *RetryConfig
+		policy         *RetryPolicy
+		failures       int
+		status         int
+		expectedStatus int
+		expectedCalls  int
+	}{
+		{"no_config", http.MethodGet, "", nil, nil, 1, http.StatusServiceUnavailable, http.StatusServiceUnavailable, 1},
+		{"get_retried", http.MethodGet, "", &RetryConfig{MaxAttempts: 3}, nil, 2, http.StatusServiceUnavailable, http.StatusOK, 3},
+		{"attempts_exhausted", http.MethodDelete, "", &RetryConfig{MaxAttempts: 2}, nil, 5, http.StatusBadGateway, http.StatusBadGateway, 2},
+		{"not_retryable", http.MethodGet, "", &RetryConfig{MaxAttempts: 3}, nil, 1, http.StatusBadRequest, http.StatusBadRequest, 1},
+		{"post_not_retried", http.MethodPost, "", &RetryConfig{MaxAttempts: 3}, nil, 1, http.StatusServiceUnavailable, http.StatusServiceUnavailable, 1},
+		{"post_with_idempotency_key", http.MethodPost, "key", &RetryConfig{MaxAttempts: 3}, nil, 1, http.StatusServiceUnavailable, http.StatusOK, 2},
+		{"post_opted_in", http.MethodPost, "", &RetryConfig{MaxAttempts: 3, RetryNonIdempotent: true}, nil, 1, http.StatusTooManyRequests, http.StatusOK, 2},
+		{"custom_retry_on", http.MethodGet, "", &RetryConfig{MaxAttempts: 3, RetryOn: func(resp *http.Response, _ error) bool {
+			return resp != nil && resp.StatusCode == http.StatusNotFound
+		}}, nil, 1, http.StatusNotFound, http.StatusOK, 2},
+		{"policy_disables", http.MethodGet, "", &RetryConfig{MaxAttempts: 3}, &NoRetries, 1, http.StatusServiceUnavailable, http.StatusServiceUnavailable, 1},
+		{"policy_overrides", http.MethodGet, "", &RetryConfig{MaxAttempts: 2}, &RetryPolicy{MaxAttempts: 4}, 3, http.StatusServiceUnavailable, http.StatusOK, 4},
+		{"policy_without_config", http.MethodGet, "", nil, &RetryPolicy{MaxAttempts: 4}, 1, http.StatusServiceUnavailable, http.StatusServiceUnavailable, 1},
+		{"policy_post_not_retried", http.MethodPost, "", &RetryConfig{MaxAttempts: 3}, &RetryPolicy{MaxAttempts: 4}, 1, http.StatusServiceUnavailable, http.StatusServiceUnavailable, 1},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				if calls <= tt.failures {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			if tt.config != nil {
+				tt.config.BaseDelay = time.Millisecond
+			}
+			rt := NewConflictRetryRoundTripper(nil).SetRetryConfig(tt.config)
+
+			ctx := context.Background()
+			if tt.policy != nil {
+				ctx = WithRetryPolicy(ctx, *tt.policy)
+			}
+			req, err := http.NewRequestWithContext(ctx, tt.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			if tt.idempotencyKey != "" {
+				req.Header.Set("Idempotency-Key", tt.idempotencyKey)
+			}
+			resp, err := (&http.Client{Transport: rt}).Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected status code %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if calls != tt.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestConflictRetryRoundTripperRetryConfigNetworkError(t *testing.T) {
+	calls := 0
+	errNetwork := errors.New("connection reset by peer")
+	rt := NewConflictRetryRoundTripper(mockTransportFn{func(*http.Request) (*http.Response, error) {
+		calls++
+		if calls < 3 {
+			return nil, errNetwork
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}}).SetRetryConfig(&RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond})
+
+	req, err := http.NewRequest(http.MethodGet, "https://dns.api.stackit.cloud/v1/zones", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Fatalf("expected the network errors to be retried, got %v after %d calls", err, calls)
+	}
+	_ = resp.Body.Close()
+
+	// The retries stop when the context is canceled
+	calls = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	rt = NewConflictRetryRoundTripper(mockTransportFn{func(*http.Request) (*http.Response, error) {
+		calls++
+		cancel()
+		return nil, errNetwork
+	}}).SetRetryConfig(&RetryConfig{MaxAttempts: 3, BaseDelay: time.Hour})
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, "https://dns.api.stackit.cloud/v1/zones", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if _, err := rt.RoundTrip(req); !errors.Is(err, errNetwork) || calls != 1 {
+		t.Fatalf("expected the error of the first attempt, got %v after %d calls", err, calls)
+	}
+}
+
+func TestDefaultRetryOn(t *testing.T) {
+	for _, tt := range []struct {
+		desc   string
+		status int
+		err    error
+		want   bool
+	}{
+		{"network_error", 0, errors.New("connection refused"), true},
+		{"canceled", 0, context.Canceled, false},
+		{"deadline_exceeded", 0, context.DeadlineExceeded, false},
+		{"authentication_error", 0, &AuthenticationError{Err: errors.New("invalid key")}, false},
+		{"circuit_open", 0, &CircuitOpenError{Host: "dns.api.stackit.cloud"}, false},
+		{"too_many_requests", http.StatusTooManyRequests, nil, true},
+		{"service_unavailable", http.StatusServiceUnavailable, nil, true},
+		{"bad_request", http.StatusBadRequest, nil, false},
+		{"conflict", http.StatusConflict, nil, false},
+		{"ok", http.StatusOK, nil, false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.status}
+			}
+			if got := DefaultRetryOn(resp, tt.err); got != tt.want {
+				t.Fatalf("DefaultRetryOn() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
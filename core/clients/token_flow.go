@@ -25,6 +25,8 @@ type TokenFlowConfig struct {
 	// Deprecated: retry options were removed to reduce complexity of the client. If this functionality is needed, you can provide your own custom HTTP client.
 	ClientRetry   *RetryConfig
 	HTTPTransport http.RoundTripper
+	// If set, TokenListener is called once with the service account token by Init, see KeyFlowConfig.TokenListener
+	TokenListener func(token TokenInfo)
 }
 
 // GetConfig returns the flow configuration
@@ -42,7 +44,11 @@ func (c *TokenFlow) Init(cfg *TokenFlowConfig) error {
 		c.rt = http.DefaultTransport
 	}
 
-	return c.validate()
+	if err := c.validate(); err != nil {
+		return err
+	}
+	notifyTokenListener(c.config.TokenListener, &TokenResponseBody{AccessToken: c.config.ServiceAccountToken})
+	return nil
 }
 
 // validate the client is configured well
@@ -53,11 +59,23 @@ func (c *TokenFlow) validate() error {
 	return nil
 }
 
+// GetAccessToken returns the service account token
+func (c *TokenFlow) GetAccessToken() (string, error) {
+	if c.config == nil {
+		return "", fmt.Errorf("please run Init()")
+	}
+	return c.config.ServiceAccountToken, nil
+}
+
 // RoundTrip performs the request
 func (c *TokenFlow) RoundTrip(req *http.Request) (*http.Response, error) {
 	if c.rt == nil {
 		return nil, fmt.Errorf("please run Init()")
 	}
+	if token, ok := GetToken(req.Context()); ok {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		return c.rt.RoundTrip(req)
+	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.config.ServiceAccountToken))
 	return c.rt.RoundTrip(req)
 }
@@ -0,0 +1,51 @@
+package clients
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TokenInfo is an access token obtained by an authentication flow, see KeyFlowConfig.TokenListener
+type TokenInfo struct {
+	AccessToken string
+	// RefreshToken is only set by the key flow
+	RefreshToken string
+	TokenType    string
+	// ExpiresAt is the expiration time of the access token, zero if it is unknown
+	ExpiresAt time.Time
+}
+
+// notifyTokenListener calls listener, if set, with the access token of token
+func notifyTokenListener(listener func(token TokenInfo), token *TokenResponseBody) {
+	if listener == nil || token == nil || token.AccessToken == "" {
+		return
+	}
+	tokenType := token.TokenType
+	if tokenType == "" {
+		tokenType = defaultTokenType
+	}
+	listener(TokenInfo{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    tokenType,
+		ExpiresAt:    tokenExpiresAt(token),
+	})
+}
+
+// tokenExpiresAt returns the expiration time of the access token of token from its exp claim, or, if it isn't a JWT,
+// from the expires_in field of the token response
+func tokenExpiresAt(token *TokenResponseBody) time.Time {
+	// We can safely use ParseUnverified because we are not authenticating the user,
+	// We are parsing the token just to get the expiration time claim
+	parsedAccessToken, _, err := jwt.NewParser().ParseUnverified(token.AccessToken, &jwt.RegisteredClaims{})
+	if err == nil {
+		if exp, err := parsedAccessToken.Claims.GetExpirationTime(); err == nil && exp != nil {
+			return exp.Time
+		}
+	}
+	if token.ExpiresIn > 0 {
+		return time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return time.Time{}
+}
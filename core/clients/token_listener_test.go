@@ -0,0 +1,103 @@
+package clients
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestKeyFlowTokenListener(t *testing.T) {
+	privateKeyBytes, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("Error generating private key: %s", err)
+	}
+
+	accessToken, refreshToken := testToken(t, time.Hour), testToken(t, 24*time.Hour)
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(TokenResponseBody{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			TokenType:    "Bearer",
+		})
+	}))
+	defer server.Close()
+
+	var tokens []TokenInfo
+	keyFlow := &KeyFlow{}
+	err = keyFlow.Init(&KeyFlowConfig{
+		ServiceAccountKey: fixtureServiceAccountKey(),
+		PrivateKey:        string(privateKeyBytes),
+		TokenUrl:          server.URL,
+		TokenListener: func(token TokenInfo) {
+			tokens = append(tokens, token)
+		},
+	})
+	if err != nil {
+		t.Fatalf("KeyFlow.Init() error = %v", err)
+	}
+
+	// The listener is called for new tokens only
+	for i := 0; i < 2; i++ {
+		if _, err := keyFlow.GetAccessToken(); err != nil {
+			t.Fatalf("GetAccessToken() error = %v", err)
+		}
+	}
+	if len(tokens) != 1 {
+		t.Fatalf("expected the listener to be called once, got %d calls", len(tokens))
+	}
+	got := tokens[0]
+	if got.AccessToken != accessToken || got.RefreshToken != refreshToken || got.TokenType != "Bearer" {
+		t.Fatalf("unexpected token %+v", got)
+	}
+	if until := time.Until(got.ExpiresAt); until < 59*time.Minute || until > time.Hour {
+		t.Fatalf("expected the expiration time of the access token, got %v", got.ExpiresAt)
+	}
+
+	// Failed refreshes aren't reported
+	status = http.StatusUnauthorized
+	if err := keyFlow.SetToken(testToken(t, -time.Hour), refreshToken); err != nil {
+		t.Fatalf("SetToken() error = %v", err)
+	}
+	if _, err := keyFlow.GetAccessToken(); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if len(tokens) != 1 {
+		t.Fatalf("expected no call for a failed refresh, got %d calls", len(tokens))
+	}
+}
+
+func TestTokenFlowTokenListener(t *testing.T) {
+	var tokens []TokenInfo
+	token := testToken(t, time.Hour)
+	err := (&TokenFlow{}).Init(&TokenFlowConfig{
+		ServiceAccountToken: token,
+		TokenListener: func(token TokenInfo) {
+			tokens = append(tokens, token)
+		},
+	})
+	if err != nil {
+		t.Fatalf("TokenFlow.Init() error = %v", err)
+	}
+	if len(tokens) != 1 || tokens[0].AccessToken != token || tokens[0].TokenType != defaultTokenType || tokens[0].ExpiresAt.IsZero() {
+		t.Fatalf("expected the service account token, got %+v", tokens)
+	}
+
+	// The expiration time of an opaque token is unknown
+	tokens = nil
+	err = (&TokenFlow{}).Init(&TokenFlowConfig{
+		ServiceAccountToken: "opaque",
+		TokenListener: func(token TokenInfo) {
+			tokens = append(tokens, token)
+		},
+	})
+	if err != nil {
+		t.Fatalf("TokenFlow.Init() error = %v", err)
+	}
+	if len(tokens) != 1 || !tokens[0].ExpiresAt.IsZero() {
+		t.Fatalf("expected no expiration time, got %+v", tokens)
+	}
+}
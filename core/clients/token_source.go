@@ -0,0 +1,69 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// TokenSource provides the access tokens of the requests, e.g. a KeyFlow, a WorkloadIdentityFlow or a TokenFlow.
+// A TokenSource can be shared by many API clients, so that the tokens are refreshed once for all of them.
+// It must be safe for concurrent use.
+type TokenSource interface {
+	// GetAccessToken returns a valid access token, and refreshes it if needed
+	GetAccessToken() (string, error)
+}
+
+// TokenSourceFlow handles auth with the access tokens of a TokenSource
+type TokenSourceFlow struct {
+	rt     http.RoundTripper
+	config *TokenSourceFlowConfig
+}
+
+// TokenSourceFlowConfig is the flow config
+type TokenSourceFlowConfig struct {
+	TokenSource   TokenSource
+	HTTPTransport http.RoundTripper
+}
+
+// GetConfig returns the flow configuration
+func (c *TokenSourceFlow) GetConfig() TokenSourceFlowConfig {
+	if c.config == nil {
+		return TokenSourceFlowConfig{}
+	}
+	return *c.config
+}
+
+func (c *TokenSourceFlow) Init(cfg *TokenSourceFlowConfig) error {
+	c.config = cfg
+
+	if c.rt = cfg.HTTPTransport; c.rt == nil {
+		c.rt = http.DefaultTransport
+	}
+
+	return c.validate()
+}
+
+// validate the client is configured well
+func (c *TokenSourceFlow) validate() error {
+	if c.config.TokenSource == nil {
+		return fmt.Errorf("token source cannot be empty")
+	}
+	return nil
+}
+
+// RoundTrip performs the request
+func (c *TokenSourceFlow) RoundTrip(req *http.Request) (*http.Response, error) {
+	if c.rt == nil {
+		return nil, fmt.Errorf("please run Init()")
+	}
+	if token, ok := GetToken(req.Context()); ok {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		return c.rt.RoundTrip(req)
+	}
+	accessToken, err := c.config.TokenSource.GetAccessToken()
+	if err != nil {
+		return nil, &AuthenticationError{Err: err}
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	return c.rt.RoundTrip(req)
+}
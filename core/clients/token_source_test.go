@@ -0,0 +1,81 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+type countingTokenSource struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (s *countingTokenSource) GetAccessToken() (string, error) {
+	n := s.calls.Add(1)
+	if s.err != nil {
+		return "", s.err
+	}
+	return fmt.Sprintf("token-%d", n), nil
+}
+
+func TestTokenSourceFlow(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	if err := (&TokenSourceFlow{}).Init(&TokenSourceFlowConfig{}); err == nil {
+		t.Fatalf("expected an error without a token source")
+	}
+
+	tokenSource := &countingTokenSource{}
+	// Two flows, e.g. of the clients of two APIs, share the token source
+	first, second := &TokenSourceFlow{}, &TokenSourceFlow{}
+	for _, flow := range []*TokenSourceFlow{first, second} {
+		if err := flow.Init(&TokenSourceFlowConfig{TokenSource: tokenSource}); err != nil {
+			t.Fatalf("Init() error = %v", err)
+		}
+	}
+	for i, flow := range []*TokenSourceFlow{first, second} {
+		res, err := (&http.Client{Transport: flow}).Get(server.URL)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		_ = res.Body.Close()
+		if expected := fmt.Sprintf("Bearer token-%d", i+1); authorization != expected {
+			t.Fatalf("expected %q, got %q", expected, authorization)
+		}
+	}
+
+	// The token of the context takes precedence
+	req, err := http.NewRequestWithContext(WithToken(context.Background(), "context-token"), http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	res, err := first.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = res.Body.Close()
+	if authorization != "Bearer context-token" || tokenSource.calls.Load() != 2 {
+		t.Fatalf("expected the token of the context, got %q", authorization)
+	}
+
+	tokenSource.err = fmt.Errorf("token endpoint unavailable")
+	req, err = http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	_, err = first.RoundTrip(req)
+	var authErr *AuthenticationError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("expected an AuthenticationError, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// TokenStore persists the tokens obtained by the key flow, e.g. to share them between replicas of an application
+// and avoid requesting a new token in each of them.
+//
+// The key flow uses the store on a best-effort basis: if a token can't be loaded or saved, a new token is requested.
+type TokenStore interface {
+	// Load returns the stored token, or nil if no token is stored
+	Load(ctx context.Context) (*TokenResponseBody, error)
+	// Save stores the token, replacing the previous one
+	Save(ctx context.Context, token *TokenResponseBody) error
+}
+
+// FileTokenStore is a TokenStore which stores the token in a file, readable only by the current user
+type FileTokenStore struct {
+	path string
+}
+
+var _ TokenStore = &FileTokenStore{}
+
+// NewFileTokenStore returns a FileTokenStore which stores the token in the file at path
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+// Load returns the token stored in the file, or nil if the file doesn't exist
+func (s *FileTokenStore) Load(_ context.Context) (*TokenResponseBody, error) {
+	content, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read token file: %w", err)
+	}
+	token := &TokenResponseBody{}
+	if err := json.Unmarshal(content, token); err != nil {
+		return nil, fmt.Errorf("unmarshal token file: %w", err)
+	}
+	return token, nil
+}
+
+// Save stores the token in the file. The file is replaced atomically, so concurrent readers never see a partial token.
+func (s *FileTokenStore) Save(_ context.Context, token *TokenResponseBody) error {
+	content, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("marshal token: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create temporary token file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // the file no longer exists once renamed
+
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write temporary token file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temporary token file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("replace token file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type memoryTokenStore struct {
+	token *TokenResponseBody
+	saves int
+}
+
+func (s *memoryTokenStore) Load(_ context.Context) (*TokenResponseBody, error) {
+	return s.token, nil
+}
+
+func (s *memoryTokenStore) Save(_ context.Context, token *TokenResponseBody) error {
+	s.token = token
+	s.saves++
+	return nil
+}
+
+func TestFileTokenStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	store := NewFileTokenStore(path)
+
+	token, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("loading missing token file: %v", err)
+	}
+	if token != nil {
+		t.Fatalf("expected no token, got %+v", token)
+	}
+
+	want := &TokenResponseBody{
+		AccessToken:  testBearerToken,
+		ExpiresIn:    2147483647,
+		RefreshToken: testBearerToken,
+		TokenType:    "Bearer",
+	}
+	if err := store.Save(context.Background(), want); err != nil {
+		t.Fatalf("saving token: %v", err)
+	}
+	got, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("loading token: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected token: %s", diff)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat token file: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Fatalf("expected token file permissions 0600, got %o", perm)
+	}
+}
+
+func TestKeyFlowTokenStore(t *testing.T) {
+	tests := []struct {
+		name           string
+		storedToken    *TokenResponseBody
+		wantTokenCalls int
+		wantSaves      int
+	}{
+		{
+			name:           "valid_stored_token",
+			storedToken:    &TokenResponseBody{AccessToken: testBearerToken, RefreshToken: testBearerToken, TokenType: "Bearer"},
+			wantTokenCalls: 0,
+			wantSaves:      0,
+		},
+		{
+			name:           "no_stored_token",
+			wantTokenCalls: 1,
+			wantSaves:      1,
+		},
+		{
+			name:           "invalid_stored_token",
+			storedToken:    &TokenResponseBody{AccessToken: "invalid"},
+			wantTokenCalls: 1,
+			wantSaves:      1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKeyBytes, err := generatePrivateKey()
+			if err != nil {
+				t.Fatalf("Error generating private key: %s", err)
+			}
+			tokenCalls := 0
+			store := &memoryTokenStore{token: tt.storedToken}
+			keyFlow := &KeyFlow{}
+			err = keyFlow.Init(&KeyFlowConfig{
+				ServiceAccountKey: fixtureServiceAccountKey(),
+				PrivateKey:        string(privateKeyBytes),
+				TokenStore:        store,
+				AuthHTTPClient: &http.Client{
+					Transport: mockTransportFn{
+						fn: func(_ *http.Request) (*http.Response, error) {
+							tokenCalls++
+							res := httptest.NewRecorder()
+							res.WriteHeader(http.StatusOK)
+							token := &TokenResponseBody{
+								AccessToken:  testBearerToken,
+								ExpiresIn:    2147483647,
+								RefreshToken: testBearerToken,
+								TokenType:    "Bearer",
+							}
+							if err := json.NewEncoder(res.Body).Encode(token); err != nil {
+								t.Fatalf("encoding token: %v", err)
+							}
+							return res.Result(), nil
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("KeyFlow.Init() error = %v", err)
+			}
+
+			accessToken, err := keyFlow.GetAccessToken()
+			if err != nil {
+				t.Fatalf("GetAccessToken() error = %v", err)
+			}
+			if accessToken != testBearerToken {
+				t.Errorf("expected access token %q, got %q", testBearerToken, accessToken)
+			}
+			if tokenCalls != tt.wantTokenCalls {
+				t.Errorf("expected %d token requests, got %d", tt.wantTokenCalls, tokenCalls)
+			}
+			if store.saves != tt.wantSaves {
+				t.Errorf("expected %d saves, got %d", tt.wantSaves, store.saves)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package clients
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+)
+
+// DefaultIOBufferSize is the size of the buffers of the streaming helpers, see NewBufferedRequest, unless another
+// size is configured with config.WithIOBufferSize
+const DefaultIOBufferSize = 1 << 20
+
+// UploadBody is the body of an upload of unknown length, e.g. read from a pipe or a live stream.
+// When passed as the body of a request of the generated API clients, it is streamed with chunked transfer encoding
+// instead of being read into memory first to determine its length.
+//
+// As the body can only be read once, the request can't be retried, e.g. with WithConflictRetry or a RetryPolicy,
+// and is sent only once, unless GetBody is set to recreate the body for each retry.
+type UploadBody struct {
+	// Reader provides the content of the body, it is read once per attempt
+	Reader io.Reader
+	// GetBody optionally returns a new reader with the same content as Reader, see http.Request.GetBody.
+	// If it is nil, the request is not retried.
+	GetBody func() (io.ReadCloser, error)
+}
+
+// Read reads from Reader, so that an UploadBody can be used where an io.Reader is expected
+func (b *UploadBody) Read(p []byte) (int, error) {
+	return b.Reader.Read(p)
+}
+
+// NewRequest returns a request with method and url which streams the body.
+// If Reader is a *bytes.Buffer, *bytes.Reader or *strings.Reader, its length is known and used, as with http.NewRequest.
+func (b *UploadBody) NewRequest(method, url string) (*http.Request, error) {
+	return b.NewBufferedRequest(method, url, 0)
+}
+
+// NewBufferedRequest returns a request like NewRequest, which reads the body of unknown length from Reader in chunks
+// of up to size bytes, e.g. to read a file or a pipe with fewer and larger reads. The body is not buffered if size is
+// 0 or the length of the body is known. The generated API clients use it with the size of config.WithIOBufferSize.
+func (b *UploadBody) NewBufferedRequest(method, url string, size int) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, b.Reader)
+	if err != nil {
+		return nil, err
+	}
+	// The ContentLength of the request is 0 if the length is unknown, so it is sent with chunked transfer encoding
+	if req.GetBody == nil {
+		if size > 0 && req.Body != nil {
+			req.Body = bufferedBody{Reader: bufio.NewReaderSize(req.Body, size), Closer: req.Body}
+		}
+		req.GetBody = b.GetBody
+	}
+	return req, nil
+}
+
+// bufferedBody reads a request body through a buffer and closes the original body
+type bufferedBody struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,139 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUploadBody(t *testing.T) {
+	for _, tt := range []struct {
+		desc          string
+		withGetBody   bool
+		expectedCalls int
+	}{
+		{
+			desc:          "without_get_body",
+			withGetBody:   false,
+			expectedCalls: 1,
+		},
+		{
+			desc:          "with_get_body",
+			withGetBody:   true,
+			expectedCalls: 2,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if len(r.TransferEncoding) != 1 || r.TransferEncoding[0] != "chunked" {
+					t.Errorf("expected chunked transfer encoding, got %v", r.TransferEncoding)
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil || string(body) != "streamed content" {
+					t.Errorf("unexpected body %q in call %d: %v", body, calls, err)
+				}
+				w.WriteHeader(http.StatusConflict)
+			}))
+			defer server.Close()
+
+			pr, pw := io.Pipe()
+			go func() {
+				_, _ = pw.Write([]byte("streamed "))
+				_, _ = pw.Write([]byte("content"))
+				_ = pw.Close()
+			}()
+			upload := &UploadBody{Reader: pr}
+			if tt.withGetBody {
+				upload.GetBody = func() (io.ReadCloser, error) {
+					return io.NopCloser(strings.NewReader("streamed content")), nil
+				}
+			}
+
+			req, err := upload.NewRequest(http.MethodPut, server.URL)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			rt := NewConflictRetryRoundTripper(nil)
+			rt.baseDelay = time.Millisecond
+			resp, err := (&http.Client{Transport: rt}).Do(req.WithContext(WithConflictRetry(context.Background(), 2)))
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if calls != tt.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestUploadBodyKnownLength(t *testing.T) {
+	upload := &UploadBody{Reader: bytes.NewReader([]byte("content"))}
+	req, err := upload.NewRequest(http.MethodPut, "https://example.com")
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if req.ContentLength != 7 || req.GetBody == nil {
+		t.Errorf("expected the length of the reader to be used, got %d", req.ContentLength)
+	}
+}
+
+// readSizeRecorder records the sizes of the reads of a body of unknown length and whether it was closed
+type readSizeRecorder struct {
+	r      io.Reader
+	sizes  []int
+	closed bool
+}
+
+func (r *readSizeRecorder) Read(p []byte) (int, error) {
+	r.sizes = append(r.sizes, len(p))
+	return r.r.Read(p)
+}
+
+func (r *readSizeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestUploadBodyBuffered(t *testing.T) {
+	content := strings.Repeat("x", 10000)
+	for _, tt := range []struct {
+		desc         string
+		size         int
+		expectedRead int
+	}{
+		{"buffered", 1024, 1024},
+		{"unbuffered", 0, 5},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			body := &readSizeRecorder{r: strings.NewReader(content)}
+			upload := &UploadBody{Reader: body}
+			req, err := upload.NewBufferedRequest(http.MethodPut, "https://example.com", tt.size)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			if req.ContentLength != 0 {
+				t.Fatalf("expected an unknown length, got %d", req.ContentLength)
+			}
+
+			got, err := io.ReadAll(io.LimitReader(req.Body, 5))
+			if err != nil || string(got) != "xxxxx" {
+				t.Fatalf("unexpected body %q: %v", got, err)
+			}
+			if len(body.sizes) == 0 || body.sizes[0] < tt.expectedRead || (tt.size > 0 && body.sizes[0] != tt.size) {
+				t.Errorf("expected reads of %d bytes, got %v", tt.expectedRead, body.sizes)
+			}
+			if err := req.Body.Close(); err != nil || !body.closed {
+				t.Errorf("expected the body to be closed, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package clients
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// Workload Identity Flow
+	// Auth flow env variables
+	FederatedTokenFile       = "STACKIT_FEDERATED_TOKEN_FILE" //nolint:gosec // linter false positive
+	WorkloadIdentityAudience = "STACKIT_WORKLOAD_IDENTITY_AUDIENCE"
+
+	tokenExchangeGrant   = "urn:ietf:params:oauth:grant-type:token-exchange" //nolint:gosec // linter false positive
+	jwtSubjectTokenType  = "urn:ietf:params:oauth:token-type:jwt"            //nolint:gosec // linter false positive
+	accessTokenTokenType = "urn:ietf:params:oauth:token-type:access_token"   //nolint:gosec // linter false positive
+)
+
+// WorkloadIdentityFlow handles auth by exchanging an externally issued OIDC ID token, e.g. a projected Kubernetes
+// service account token, for a STACKIT access token (OAuth 2.0 Token Exchange, RFC 8693)
+type WorkloadIdentityFlow struct {
+	rt         http.RoundTripper
+	authClient *http.Client
+	config     *WorkloadIdentityFlowConfig
+
+	// tokenMutex protects the token and serializes the exchanges, so that concurrent requests share one exchange
+	tokenMutex  sync.Mutex
+	token       *TokenResponseBody
+	tokenExpiry time.Time
+
+	tokenExpirationLeeway time.Duration
+}
+
+// WorkloadIdentityFlowConfig is the flow config
+type WorkloadIdentityFlowConfig struct {
+	// Path of the file with the ID token. It is read for every exchange, as the token in it is rotated,
+	// e.g. by the kubelet for a projected service account token
+	TokenFilePath string
+	// Audience of the STACKIT access token, which identifies the workload identity federation at the token endpoint
+	Audience       string
+	TokenUrl       string
+	HTTPTransport  http.RoundTripper
+	AuthHTTPClient *http.Client
+	// If set, AuthEventHook is called when an access token is exchanged, see AuthEvent
+	AuthEventHook func(event AuthEvent)
+	// If set, TokenListener is called with every new access token, see KeyFlowConfig.TokenListener
+	TokenListener func(token TokenInfo)
+}
+
+// GetConfig returns the flow configuration
+func (c *WorkloadIdentityFlow) GetConfig() WorkloadIdentityFlowConfig {
+	if c.config == nil {
+		return WorkloadIdentityFlowConfig{}
+	}
+	return *c.config
+}
+
+func (c *WorkloadIdentityFlow) Init(cfg *WorkloadIdentityFlowConfig) error {
+	// No concurrency at this point, so no mutex check needed
+	c.token = &TokenResponseBody{}
+	c.config = cfg
+
+	if c.config.TokenUrl == "" {
+		c.config.TokenUrl = tokenAPI
+	}
+
+	c.tokenExpirationLeeway = defaultTokenExpirationLeeway
+
+	if c.rt = cfg.HTTPTransport; c.rt == nil {
+		c.rt = http.DefaultTransport
+	}
+
+	if c.authClient = cfg.AuthHTTPClient; cfg.AuthHTTPClient == nil {
+		c.authClient = &http.Client{
+			Transport: c.rt,
+			Timeout:   DefaultClientTimeout,
+		}
+	}
+
+	return c.validate()
+}
+
+// validate the client is configured well
+func (c *WorkloadIdentityFlow) validate() error {
+	if c.config.TokenFilePath == "" {
+		return fmt.Errorf("ID token file path cannot be empty")
+	}
+	if c.config.Audience == "" {
+		return fmt.Errorf("audience cannot be empty")
+	}
+	// Fail early if the token can't be read, e.g. if the projected volume isn't mounted
+	if _, err := c.readIDToken(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetToken returns the token field
+func (c *WorkloadIdentityFlow) GetToken() TokenResponseBody {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	if c.token == nil {
+		return TokenResponseBody{}
+	}
+	return *c.token
+}
+
+// RoundTrip performs the request
+func (c *WorkloadIdentityFlow) RoundTrip(req *http.Request) (*http.Response, error) {
+	if c.rt == nil {
+		return nil, fmt.Errorf("please run Init()")
+	}
+
+	if token, ok := GetToken(req.Context()); ok {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		return c.rt.RoundTrip(req)
+	}
+	accessToken, err := c.GetAccessToken()
+	if err != nil {
+		return nil, &AuthenticationError{Err: err}
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	return c.rt.RoundTrip(req)
+}
+
+// GetAccessToken returns a short-lived access token, which is exchanged for the current ID token if the last one expired
+func (c *WorkloadIdentityFlow) GetAccessToken() (string, error) {
+	if c.rt == nil {
+		return "", fmt.Errorf("nil http round tripper, please run Init()")
+	}
+
+	c.tokenMutex.Lock()
+	if c.token != nil && c.token.AccessToken != "" && time.Now().Add(c.tokenExpirationLeeway).Before(c.tokenExpiry) {
+		accessToken := c.token.AccessToken
+		c.tokenMutex.Unlock()
+		return accessToken, nil
+	}
+	err := observeAuthOperation(c.config.AuthEventHook, AuthOperationMint, c.exchangeToken)
+	token := *c.token
+	c.tokenMutex.Unlock()
+	if err != nil {
+		return "", fmt.Errorf("exchange ID token for access token: %w", err)
+	}
+
+	notifyTokenListener(c.config.TokenListener, &token)
+	return token.AccessToken, nil
+}
+
+// readIDToken reads the ID token from the configured file
+func (c *WorkloadIdentityFlow) readIDToken() (string, error) {
+	b, err := os.ReadFile(c.config.TokenFilePath)
+	if err != nil {
+		return "", fmt.Errorf("reading ID token from file path: %w", err)
+	}
+	idToken := string(bytes.TrimSpace(b))
+	if idToken == "" {
+		return "", fmt.Errorf("ID token path points to an empty file")
+	}
+	return idToken, nil
+}
+
+// exchangeToken exchanges the current ID token for an access token. It must be called with tokenMutex locked.
+func (c *WorkloadIdentityFlow) exchangeToken() (err error) {
+	idToken, err := c.readIDToken()
+	if err != nil {
+		return err
+	}
+
+	body := url.Values{}
+	body.Set("grant_type", tokenExchangeGrant)
+	body.Set("subject_token", idToken)
+	body.Set("subject_token_type", jwtSubjectTokenType)
+	body.Set("requested_token_type", accessTokenTokenType)
+	body.Set("audience", c.config.Audience)
+	req, err := http.NewRequest(http.MethodPost, c.config.TokenUrl, strings.NewReader(body.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.authClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tempErr := res.Body.Close()
+		if tempErr != nil && err == nil {
+			err = fmt.Errorf("close token exchange response: %w", tempErr)
+		}
+	}()
+
+	resBody, err := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		if err != nil {
+			// Fail silently, omit body from error
+			resBody = []byte{}
+		}
+		return &oapierror.GenericOpenAPIError{
+			StatusCode: res.StatusCode,
+			Body:       resBody,
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	token := &TokenResponseBody{}
+	if err := json.Unmarshal(resBody, token); err != nil {
+		return fmt.Errorf("unmarshal token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return fmt.Errorf("token response has no access token")
+	}
+	expiry, err := accessTokenExpiry(token)
+	if err != nil {
+		return err
+	}
+	c.token = token
+	c.tokenExpiry = expiry
+	return nil
+}
+
+// accessTokenExpiry returns the expiry of token from its expires_in field, or, if not set, from the exp claim of the
+// access token
+func accessTokenExpiry(token *TokenResponseBody) (time.Time, error) {
+	if token.ExpiresIn > 0 {
+		return time.Now().Add(time.Duration(token.ExpiresIn) * time.Second), nil
+	}
+	// We can safely use ParseUnverified because we are not authenticating the user,
+	// We are parsing the token just to get the expiration time claim
+	parsedAccessToken, _, err := jwt.NewParser().ParseUnverified(token.AccessToken, &jwt.RegisteredClaims{})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse access token to read expiration time: %w", err)
+	}
+	exp, err := parsedAccessToken.Claims.GetExpirationTime()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get expiration time from access token: %w", err)
+	}
+	if exp == nil {
+		return time.Time{}, fmt.Errorf("access token has no expiration time")
+	}
+	return exp.Time, nil
+}
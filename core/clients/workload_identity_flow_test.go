@@ -0,0 +1,149 @@
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+func TestWorkloadIdentityFlowInit(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	emptyPath := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(tokenPath, []byte("id-token\n"), 0o600); err != nil {
+		t.Fatalf("writing ID token: %v", err)
+	}
+	if err := os.WriteFile(emptyPath, nil, 0o600); err != nil {
+		t.Fatalf("writing ID token: %v", err)
+	}
+
+	for _, tt := range []struct {
+		desc    string
+		cfg     WorkloadIdentityFlowConfig
+		wantErr bool
+	}{
+		{"ok", WorkloadIdentityFlowConfig{TokenFilePath: tokenPath, Audience: "aud"}, false},
+		{"no_token_path", WorkloadIdentityFlowConfig{Audience: "aud"}, true},
+		{"no_audience", WorkloadIdentityFlowConfig{TokenFilePath: tokenPath}, true},
+		{"missing_file", WorkloadIdentityFlowConfig{TokenFilePath: filepath.Join(t.TempDir(), "missing"), Audience: "aud"}, true},
+		{"empty_file", WorkloadIdentityFlowConfig{TokenFilePath: emptyPath, Audience: "aud"}, true},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			flow := &WorkloadIdentityFlow{}
+			err := flow.Init(&tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Init() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && flow.GetConfig().TokenUrl != tokenAPI {
+				t.Fatalf("expected the default token URL, got %q", flow.GetConfig().TokenUrl)
+			}
+		})
+	}
+}
+
+func TestWorkloadIdentityFlowRoundTrip(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenPath, []byte("id-token-1"), 0o600); err != nil {
+		t.Fatalf("writing ID token: %v", err)
+	}
+
+	exchanges := 0
+	var subjectTokens []string
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exchanges++
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing form: %v", err)
+		}
+		if r.PostForm.Get("grant_type") != tokenExchangeGrant || r.PostForm.Get("subject_token_type") != jwtSubjectTokenType || r.PostForm.Get("audience") != "aud" {
+			t.Errorf("unexpected token exchange request %v", r.PostForm)
+		}
+		subjectTokens = append(subjectTokens, r.PostForm.Get("subject_token"))
+		w.Header().Set("Content-Type", "application/json")
+		// The first access token expires immediately, so that the second request exchanges the rotated ID token
+		expiresIn := 1
+		if exchanges > 1 {
+			expiresIn = 3600
+		}
+		_, _ = fmt.Fprintf(w, `{"access_token": "access-token-%d", "expires_in": %d, "token_type": "Bearer"}`, exchanges, expiresIn)
+	}))
+	defer tokenServer.Close()
+
+	var authorizations []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer apiServer.Close()
+
+	flow := &WorkloadIdentityFlow{}
+	if err := flow.Init(&WorkloadIdentityFlowConfig{TokenFilePath: tokenPath, Audience: "aud", TokenUrl: tokenServer.URL}); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	client := &http.Client{Transport: flow}
+	doRequest := func() {
+		t.Helper()
+		res, err := client.Get(apiServer.URL)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		_ = res.Body.Close()
+	}
+
+	doRequest()
+	if err := os.WriteFile(tokenPath, []byte("id-token-2"), 0o600); err != nil {
+		t.Fatalf("rotating ID token: %v", err)
+	}
+	doRequest()
+	doRequest()
+
+	if exchanges != 2 {
+		t.Fatalf("expected 2 token exchanges, got %d", exchanges)
+	}
+	if subjectTokens[0] != "id-token-1" || subjectTokens[1] != "id-token-2" {
+		t.Fatalf("expected the rotated ID token to be exchanged, got %v", subjectTokens)
+	}
+	expected := []string{"Bearer access-token-1", "Bearer access-token-2", "Bearer access-token-2"}
+	for i := range expected {
+		if authorizations[i] != expected[i] {
+			t.Fatalf("unexpected authorization headers %v", authorizations)
+		}
+	}
+}
+
+func TestWorkloadIdentityFlowExchangeError(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenPath, []byte("id-token"), 0o600); err != nil {
+		t.Fatalf("writing ID token: %v", err)
+	}
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": "invalid_grant"}`))
+	}))
+	defer tokenServer.Close()
+
+	var events []AuthEvent
+	flow := &WorkloadIdentityFlow{}
+	err := flow.Init(&WorkloadIdentityFlowConfig{
+		TokenFilePath: tokenPath,
+		Audience:      "aud",
+		TokenUrl:      tokenServer.URL,
+		AuthEventHook: func(event AuthEvent) { events = append(events, event) },
+	})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+
+	_, err = flow.GetAccessToken()
+	var oapiErr *oapierror.GenericOpenAPIError
+	if !errors.As(err, &oapiErr) || oapiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the error of the token endpoint, got %v", err)
+	}
+	if len(events) != 2 || events[1].Reason != AuthFailureRejected {
+		t.Fatalf("expected a rejected auth event, got %+v", events)
+	}
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"net/http"
+	"time"
+)
+
+// AccessLogEntry describes a single request made to a STACKIT API.
+// It never contains request or response bodies, headers or query parameters, so no credential material is included.
+type AccessLogEntry struct {
+	// Timestamp is the time the request was started
+	Timestamp time.Time
+	// Service is the name of the service, e.g. "dns". Empty if the request wasn't sent by a generated API client.
+	Service string
+	// Operation is the operation id, e.g. "CreateZone". Empty if the request wasn't sent by a generated API client.
+	Operation string
+	Method    string
+	// Path is the URL path of the request, without query parameters
+	Path string
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received
+	StatusCode int
+	Duration   time.Duration
+	// RequestID is the trace id of the response as returned by the API, if any
+	RequestID string
+	// CorrelationID is the correlation id sent with the request, see WithCorrelationID
+	CorrelationID string
+	// Error is the error message if no response was received
+	Error string
+}
+
+// AccessLogger receives an AccessLogEntry for each request
+type AccessLogger func(entry AccessLogEntry)
+
+// WithAccessLog returns a ConfigurationOption that calls logger once for each request made by the client,
+// including the requests made by retries. The logger is called synchronously, so it should not block.
+// If the option is provided more than once, the last logger is used.
+func WithAccessLog(logger AccessLogger) ConfigurationOption {
+	return func(config *Configuration) error {
+		config.AccessLogger = logger
+		return nil
+	}
+}
+
+// AccessLogMiddleware returns a Middleware that calls logger once for each request
+func AccessLogMiddleware(logger AccessLogger) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &accessLogRoundTripper{rt: rt, logger: logger}
+	}
+}
+
+type accessLogRoundTripper struct {
+	rt     http.RoundTripper
+	logger AccessLogger
+}
+
+func (a *accessLogRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := AccessLogEntry{
+		Timestamp: time.Now(),
+		Method:    req.Method,
+		Path:      req.URL.Path,
+	}
+	if op, ok := GetOperation(req.Context()); ok {
+		entry.Service = op.Service
+		entry.Operation = op.Name
+	}
+	entry.CorrelationID, _ = GetCorrelationID(req.Context())
+
+	resp, err := a.rt.RoundTrip(req)
+
+	entry.Duration = time.Since(entry.Timestamp)
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+		entry.RequestID = resp.Header.Get("X-Trace-Id")
+		if entry.RequestID == "" {
+			entry.RequestID = resp.Header.Get("X-Request-Id")
+		}
+	}
+	a.logger(entry)
+	return resp, err
+}
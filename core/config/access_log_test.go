@@ -0,0 +1,108 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAccessLogMiddleware(t *testing.T) {
+	tests := []struct {
+		desc           string
+		ctx            context.Context
+		status         int
+		traceId        string
+		wantService    string
+		wantOperation  string
+		wantStatusCode int
+	}{
+		{
+			desc:           "operation annotated",
+			ctx:            WithOperation(context.Background(), "dns", "CreateZone"),
+			status:         http.StatusAccepted,
+			traceId:        "trace",
+			wantService:    "dns",
+			wantOperation:  "CreateZone",
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			desc:           "no operation",
+			ctx:            context.Background(),
+			status:         http.StatusNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("X-Trace-Id", tt.traceId)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			var entries []AccessLogEntry
+			rt := AccessLogMiddleware(func(entry AccessLogEntry) {
+				entries = append(entries, entry)
+			})(http.DefaultTransport)
+
+			req, err := http.NewRequestWithContext(tt.ctx, http.MethodPost, server.URL+"/v1/zones?secret=value", http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			req.Header.Set("Authorization", "Bearer token")
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("round trip: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if len(entries) != 1 {
+				t.Fatalf("expected 1 entry, got %d", len(entries))
+			}
+			entry := entries[0]
+			if entry.Service != tt.wantService || entry.Operation != tt.wantOperation {
+				t.Errorf("expected operation %s/%s, got %s/%s", tt.wantService, tt.wantOperation, entry.Service, entry.Operation)
+			}
+			if entry.StatusCode != tt.wantStatusCode {
+				t.Errorf("expected status code %d, got %d", tt.wantStatusCode, entry.StatusCode)
+			}
+			if entry.Method != http.MethodPost || entry.Path != "/v1/zones" {
+				t.Errorf("unexpected method and path: %s %s", entry.Method, entry.Path)
+			}
+			if entry.RequestID != tt.traceId {
+				t.Errorf("expected request id %q, got %q", tt.traceId, entry.RequestID)
+			}
+			if entry.Timestamp.IsZero() || entry.Error != "" {
+				t.Errorf("unexpected entry: %+v", entry)
+			}
+		})
+	}
+}
+
+func TestAccessLogMiddlewareTransportError(t *testing.T) {
+	var entries []AccessLogEntry
+	rt := AccessLogMiddleware(func(entry AccessLogEntry) {
+		entries = append(entries, entry)
+	})(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("connection refused")
+	}))
+
+	req, err := http.NewRequest(http.MethodGet, "https://dns.api.stackit.cloud/v1/zones", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Fatalf("expected error")
+	}
+	if len(entries) != 1 || entries[0].StatusCode != 0 || entries[0].Error != "connection refused" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// serviceRegions lists the regions in which the services that take the region as a parameter of their operations are available.
+// The regions of the services with a regional URL are part of their API specification instead.
+// Services which aren't listed aren't validated.
+var serviceRegions = map[string][]string{
+	"alb":               {"eu01", "eu02"},
+	"certificates":      {"eu01", "eu02"},
+	"iaas":              {"eu01", "eu02"},
+	"iaasalpha":         {"eu01", "eu02"},
+	"loadbalancer":      {"eu01", "eu02"},
+	"mongodbflex":       {"eu01", "eu02"},
+	"objectstorage":     {"eu01", "eu02"},
+	"postgresflex":      {"eu01", "eu02"},
+	"runcommand":        {"eu01", "eu02"},
+	"scf":               {"eu01", "eu02"},
+	"serverbackup":      {"eu01", "eu02"},
+	"serverupdate":      {"eu01", "eu02"},
+	"serviceenablement": {"eu01", "eu02"},
+	"ske":               {"eu01", "eu02"},
+	"sqlserverflex":     {"eu01", "eu02"},
+}
+
+// RegionNotAvailableError is returned if a service is not available in the region of the client or of a request
+type RegionNotAvailableError struct {
+	Service   string
+	Region    string
+	Available []string
+}
+
+func (e *RegionNotAvailableError) Error() string {
+	return fmt.Sprintf("service %q is not available in region %q, available regions are: %s", e.Service, e.Region, strings.Join(e.Available, ", "))
+}
+
+// WithAvailableRegions returns a ConfigurationOption that replaces the regions in which the service of the client is available,
+// e.g. for private deployments. The region of the client and of each request is validated against them before sending the request.
+//
+// The regions aren't validated if a custom endpoint or an EndpointResolver is configured.
+func WithAvailableRegions(regions ...string) ConfigurationOption {
+	return func(config *Configuration) error {
+		if len(regions) == 0 {
+			return fmt.Errorf("available regions cannot be empty")
+		}
+		config.AvailableRegions = regions
+		return nil
+	}
+}
+
+// validateRequestRegion validates the region of a request against the regions in which the service is available
+func (c *Configuration) validateRequestRegion(region string) error {
+	if region == "" || c.setCustomEndpoint || c.EndpointResolver != nil {
+		return nil
+	}
+	available := c.AvailableRegions
+	if available == nil {
+		available = serviceRegions[c.ServiceName]
+	}
+	if available == nil || containsCaseSensitive(available, region) {
+		return nil
+	}
+	return &RegionNotAvailableError{Service: c.ServiceName, Region: region, Available: available}
+}
@@ -0,0 +1,151 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestValidateRequestRegion(t *testing.T) {
+	for _, test := range []struct {
+		desc           string
+		serviceName    string
+		opts           []ConfigurationOption
+		region         string
+		expectedErrors bool
+	}{
+		{
+			desc:        "available_region",
+			serviceName: "ske",
+			region:      "eu01",
+		},
+		{
+			desc:           "unavailable_region",
+			serviceName:    "ske",
+			region:         "us01",
+			expectedErrors: true,
+		},
+		{
+			desc:        "no_region",
+			serviceName: "ske",
+		},
+		{
+			desc:        "unknown_service",
+			serviceName: "dns",
+			region:      "us01",
+		},
+		{
+			desc:        "available_regions_override",
+			serviceName: "ske",
+			opts:        []ConfigurationOption{WithAvailableRegions("private01")},
+			region:      "private01",
+		},
+		{
+			desc:           "available_regions_override_replaces_defaults",
+			serviceName:    "ske",
+			opts:           []ConfigurationOption{WithAvailableRegions("private01")},
+			region:         "eu01",
+			expectedErrors: true,
+		},
+		{
+			desc:        "custom_endpoint",
+			serviceName: "ske",
+			opts:        []ConfigurationOption{WithEndpoint("https://ske.private.example")},
+			region:      "us01",
+		},
+		{
+			desc:        "endpoint_resolver",
+			serviceName: "ske",
+			opts: []ConfigurationOption{WithEndpointResolver(func(_, _ string) (string, error) {
+				return "https://ske.private.example", nil
+			})},
+			region: "us01",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := &Configuration{
+				ServiceName: test.serviceName,
+				Servers: ServerConfigurations{
+					{URL: "https://ske.api.stackit.cloud"},
+				},
+			}
+			for _, opt := range test.opts {
+				if err := opt(cfg); err != nil {
+					t.Fatalf("applying option: %v", err)
+				}
+			}
+
+			_, err := cfg.ServerURLForRegion(context.Background(), "DefaultApiService.ListClusters", test.region)
+			if (err != nil) != test.expectedErrors {
+				t.Fatalf("expected error %t, got %v", test.expectedErrors, err)
+			}
+			if err == nil {
+				return
+			}
+			var regionErr *RegionNotAvailableError
+			if !errors.As(err, &regionErr) {
+				t.Fatalf("expected RegionNotAvailableError, got %T", err)
+			}
+			if regionErr.Service != test.serviceName || regionErr.Region != test.region {
+				t.Fatalf("unexpected error %v", regionErr)
+			}
+		})
+	}
+}
+
+func TestConfigureRegionNotAvailable(t *testing.T) {
+	for _, test := range []struct {
+		desc           string
+		opts           []ConfigurationOption
+		region         string
+		expectedErrors bool
+	}{
+		{
+			desc:   "available_region",
+			region: "eu01",
+		},
+		{
+			desc:           "unavailable_region",
+			region:         "eu02",
+			expectedErrors: true,
+		},
+		{
+			desc:   "available_regions_override",
+			opts:   []ConfigurationOption{WithAvailableRegions("eu01", "eu02")},
+			region: "eu02",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Setenv("STACKIT_REGION", "")
+			cfg := &Configuration{
+				ServiceName: "redis",
+				Region:      test.region,
+				Servers: ServerConfigurations{
+					{
+						URL: "https://redis.api.{region}stackit.cloud",
+						Variables: map[string]ServerVariable{
+							"region": {
+								DefaultValue: "eu01.",
+								EnumValues:   []string{"eu01."},
+							},
+						},
+					},
+				},
+			}
+			for _, opt := range test.opts {
+				if err := opt(cfg); err != nil {
+					t.Fatalf("applying option: %v", err)
+				}
+			}
+
+			err := ConfigureRegion(cfg)
+			if (err != nil) != test.expectedErrors {
+				t.Fatalf("expected error %t, got %v", test.expectedErrors, err)
+			}
+			var regionErr *RegionNotAvailableError
+			if err != nil && !errors.As(err, &regionErr) {
+				t.Fatalf("expected RegionNotAvailableError, got %T", err)
+			}
+		})
+	}
+}
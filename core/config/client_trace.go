@@ -0,0 +1,41 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptrace"
+)
+
+// ClientTraceFunc returns the httptrace.ClientTrace to attach to a request with the given context.
+// It may return nil to not trace the request.
+type ClientTraceFunc func(ctx context.Context) *httptrace.ClientTrace
+
+// WithClientTrace returns a ConfigurationOption that attaches the httptrace.ClientTrace returned by traceFunc to each request,
+// to capture e.g. the DNS lookup, connection, TLS handshake and time to first byte of the request.
+// The requests made to obtain access tokens are not traced. If the option is provided more than once, the last traceFunc is used.
+func WithClientTrace(traceFunc ClientTraceFunc) ConfigurationOption {
+	return func(config *Configuration) error {
+		config.ClientTraceFunc = traceFunc
+		return nil
+	}
+}
+
+// ClientTraceMiddleware returns a Middleware that attaches the httptrace.ClientTrace returned by traceFunc to each request
+func ClientTraceMiddleware(traceFunc ClientTraceFunc) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &clientTraceRoundTripper{rt: rt, traceFunc: traceFunc}
+	}
+}
+
+type clientTraceRoundTripper struct {
+	rt        http.RoundTripper
+	traceFunc ClientTraceFunc
+}
+
+func (c *clientTraceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace := c.traceFunc(req.Context())
+	if trace == nil {
+		return c.rt.RoundTrip(req)
+	}
+	return c.rt.RoundTrip(req.WithContext(httptrace.WithClientTrace(req.Context(), trace)))
+}
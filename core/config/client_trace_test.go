@@ -0,0 +1,60 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"testing"
+)
+
+func TestClientTraceMiddleware(t *testing.T) {
+	for _, tt := range []struct {
+		desc      string
+		trace     bool
+		wantCalls bool
+	}{
+		{
+			desc:      "trace",
+			trace:     true,
+			wantCalls: true,
+		},
+		{
+			desc:      "no_trace",
+			trace:     false,
+			wantCalls: false,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			gotConn, gotFirstByte := false, false
+			rt := ClientTraceMiddleware(func(_ context.Context) *httptrace.ClientTrace {
+				if !tt.trace {
+					return nil
+				}
+				return &httptrace.ClientTrace{
+					GotConn:              func(httptrace.GotConnInfo) { gotConn = true },
+					GotFirstResponseByte: func() { gotFirstByte = true },
+				}
+			})(&http.Transport{})
+
+			req, err := http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("round trip: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if gotConn != tt.wantCalls || gotFirstByte != tt.wantCalls {
+				t.Errorf("expected trace calls %t, got connection %t and first byte %t", tt.wantCalls, gotConn, gotFirstByte)
+			}
+		})
+	}
+}
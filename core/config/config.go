@@ -3,10 +3,17 @@
 package config
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -55,8 +62,37 @@ var (
 
 	// ContextHTTPRequest holds the raw HTTP request.
 	ContextHTTPRequest = contextKey("httpRequest")
+
+	// ContextOperation holds the Operation a request belongs to. It is set by the generated API clients.
+	ContextOperation = contextKey("operation")
 )
 
+// Operation identifies the API operation a request belongs to
+type Operation struct {
+	// Service is the name of the service, e.g. "dns"
+	Service string
+	// Name is the operation id, e.g. "CreateZone"
+	Name string
+}
+
+// WithOperation returns a copy of ctx annotated with the API operation a request belongs to.
+// The generated API clients annotate each request, so that middlewares can identify the operation.
+func WithOperation(ctx context.Context, service, name string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ContextOperation, Operation{Service: service, Name: name})
+}
+
+// GetOperation returns the Operation ctx was annotated with, if any
+func GetOperation(ctx context.Context) (Operation, bool) {
+	if ctx == nil {
+		return Operation{}, false
+	}
+	op, ok := ctx.Value(ContextOperation).(Operation)
+	return op, ok
+}
+
 // BasicAuth provides basic http authentication to a request passed via context using ContextBasicAuth
 type BasicAuth struct {
 	UserName string `json:"userName,omitempty"`
@@ -73,28 +109,132 @@ type APIKey struct {
 // such as logging, authentication, etc.
 type Middleware func(http.RoundTripper) http.RoundTripper
 
+// EndpointResolver returns the base URL to be used for the API of a service in a region.
+// If it returns an empty string, the default URL of the API is used.
+type EndpointResolver func(service, region string) (string, error)
+
+// JSONEncoder serializes a request body to JSON
+type JSONEncoder func(v any) ([]byte, error)
+
+// JSONDecoder deserializes a JSON response body into v
+type JSONDecoder func(data []byte, v any) error
+
 // Configuration stores the configuration of the API client
 type Configuration struct {
-	Host                  string            `json:"host,omitempty"`
-	Scheme                string            `json:"scheme,omitempty"`
-	DefaultHeader         map[string]string `json:"defaultHeader,omitempty"`
-	UserAgent             string            `json:"userAgent,omitempty"`
-	Debug                 bool              `json:"debug,omitempty"`
-	NoAuth                bool              `json:"noAuth,omitempty"`
-	ServiceAccountEmail   string            `json:"serviceAccountEmail,omitempty"` // Deprecated: ServiceAccountEmail is not required and will be removed after 12th June 2025.
-	Token                 string            `json:"token,omitempty"`
-	ServiceAccountKey     string            `json:"serviceAccountKey,omitempty"`
-	PrivateKey            string            `json:"privateKey,omitempty"`
-	ServiceAccountKeyPath string            `json:"serviceAccountKeyPath,omitempty"`
-	PrivateKeyPath        string            `json:"privateKeyPath,omitempty"`
-	CredentialsFilePath   string            `json:"credentialsFilePath,omitempty"`
-	TokenCustomUrl        string            `json:"tokenCustomUrl,omitempty"`
-	Region                string            `json:"region,omitempty"`
-	CustomAuth            http.RoundTripper
-	Servers               ServerConfigurations
-	OperationServers      map[string]ServerConfigurations
-	HTTPClient            *http.Client
-	Middleware            []Middleware
+	Host                   string            `json:"host,omitempty"`
+	Scheme                 string            `json:"scheme,omitempty"`
+	DefaultHeader          map[string]string `json:"defaultHeader,omitempty"`
+	UserAgent              string            `json:"userAgent,omitempty"`
+	Debug                  bool              `json:"debug,omitempty"`
+	NoAuth                 bool              `json:"noAuth,omitempty"`
+	ServiceAccountEmail    string            `json:"serviceAccountEmail,omitempty"` // Deprecated: ServiceAccountEmail is not required and will be removed after 12th June 2025.
+	Token                  string            `json:"token,omitempty"`
+	ServiceAccountKey      string            `json:"serviceAccountKey,omitempty"`
+	PrivateKey             string            `json:"privateKey,omitempty"`
+	ServiceAccountKeyPath  string            `json:"serviceAccountKeyPath,omitempty"`
+	PrivateKeyPath         string            `json:"privateKeyPath,omitempty"`
+	CredentialsFilePath    string            `json:"credentialsFilePath,omitempty"`
+	Profile                string            `json:"profile,omitempty"`
+	TokenCustomUrl         string            `json:"tokenCustomUrl,omitempty"`
+	Region                 string            `json:"region,omitempty"`
+	ServiceName            string            `json:"serviceName,omitempty"`
+	CanonicalQueryEncoding bool              `json:"canonicalQueryEncoding,omitempty"`
+	CustomAuth             http.RoundTripper
+	Servers                ServerConfigurations
+	OperationServers       map[string]ServerConfigurations
+	HTTPClient             *http.Client
+	Middleware             []Middleware
+	JSONEncoder            JSONEncoder
+	JSONDecoder            JSONDecoder
+	EndpointResolver       EndpointResolver
+	RetryBudget            *clients.RetryBudget
+	BackoffStrategy        clients.Backoff
+	RetryOnBodyError       func(body []byte) bool
+	TokenStore             clients.TokenStore
+	RateLimitTracker       *RateLimitTracker
+	AccessLogger           AccessLogger
+	ClientTraceFunc        ClientTraceFunc
+	Stats                  *Stats
+	AvailableRegions       []string
+
+	// Only have effect if no HTTP client with a custom Transport is provided, see HTTPTransport
+	DialTimeout           time.Duration
+	KeepAlive             time.Duration
+	TLSHandshakeTimeout   time.Duration
+	ResponseHeaderTimeout time.Duration
+
+	// See WithProxy and WithProxyFunc
+	ProxyFunc func(req *http.Request) (*url.URL, error)
+	// See WithTLSConfig and WithClientCertificate
+	TLSConfig *tls.Config
+	// See WithStrictTLSVerify
+	StrictTLSVerify bool
+	// See WithHostOverride
+	HostOverride string
+	// See WithErrorContext
+	ErrorContext bool
+	// See WithDecompressionAccounting
+	DecompressionAccounting bool
+	ResponseSizeFunc        ResponseSizeFunc
+	// See WithStreamingListDecode
+	StreamingListDecode bool
+	// See WithLenientFieldDecode
+	LenientFieldDecode bool
+	// See WithIOBufferSize
+	IOBufferSize int
+	// See WithResponseCache
+	ResponseCache Cache
+	// See WithWarningHandler
+	WarningHandler WarningHandler
+	// See WithAuthMetrics
+	AuthEventFunc func(event clients.AuthEvent)
+	// See WithRandSource
+	RandSource io.Reader
+	// See WithSharedRefreshGroup
+	RefreshGroup *clients.RefreshGroup
+	// See WithAssertionAlgorithm
+	AssertionAlgorithm string
+	// See WithKeyReload
+	KeyReloadInterval time.Duration
+	// See WithTokenListener
+	TokenListener func(token clients.TokenInfo)
+	// See WithTokenSource
+	TokenSource clients.TokenSource
+	// See WithWorkloadIdentity
+	WorkloadIdentityTokenPath string
+	WorkloadIdentityAudience  string
+	// See WithFollowAuthRedirects
+	FollowAuthRedirects bool
+	// See WithRedirectHook
+	RedirectHook func(req *http.Request, via []*http.Request) error
+	// See WithTrailingSlashPolicy
+	TrailingSlashPolicy TrailingSlashPolicy
+	// See WithCorrelationID
+	CorrelationIDFunc   CorrelationIDFunc
+	CorrelationIDHeader string
+	// See WithRequestLogging
+	RequestLogger   *slog.Logger
+	RequestLogLevel slog.Level
+	// See WithHTTPDump
+	HTTPDumpWriter io.Writer
+	// See WithRetryPolicy
+	RetryPolicy *clients.RetryConfig
+	// See WithThrottle
+	Throttle *Throttle
+	// See WithCircuitBreaker
+	CircuitBreaker *clients.CircuitBreaker
+	// See WithIdempotencyKeys
+	IdempotencyKeys      bool
+	IdempotencyKeyHeader string
+	// See WithCredentialProvider
+	CredentialProvider CredentialProvider
+
+	// If ServiceAccountKeyExpiryWarningHook != nil, it is called once if the service account key expires within
+	// ServiceAccountKeyExpiryWarningThreshold.
+	//
+	// Only has effect for key flow
+	ServiceAccountKeyExpiryWarningThreshold time.Duration
+	ServiceAccountKeyExpiryWarningHook      func(validUntil time.Time)
 
 	// If != nil, a goroutine will be launched that will refresh the service account's access token when it's close to being expired.
 	// The goroutine is killed whenever this context is canceled.
@@ -106,6 +246,10 @@ type Configuration struct {
 	RetryOptions *clients.RetryConfig //nolint:staticcheck //will be removed in a later update
 
 	setCustomEndpoint bool
+	// Set by ConfigureRegion if the API is global
+	globalAPI bool
+	// Servers of a regional API before the region was applied by ConfigureRegion, see RequestOverrides.Region
+	regionServers ServerConfigurations
 }
 
 // ConfigurationOption is an option for an API client. The options are executed sequentially, so
@@ -135,6 +279,37 @@ func WithCustomAuth(rt http.RoundTripper) ConfigurationOption {
 	}
 }
 
+// WithTokenListener returns a ConfigurationOption that calls listener with every new access token of the key flow
+// and the workload identity flow, after it was minted or refreshed, including the refreshes in the background, and
+// once with the token of the token flow, e.g. to persist the tokens, sync them to an external store or emit metrics.
+// listener is called synchronously by the request which needed the token, so it must not block.
+//
+// The tokens are secrets, they must not be logged.
+func WithTokenListener(listener func(token clients.TokenInfo)) ConfigurationOption {
+	return func(config *Configuration) error {
+		if listener == nil {
+			return fmt.Errorf("token listener cannot be nil")
+		}
+		config.TokenListener = listener
+		return nil
+	}
+}
+
+// WithTokenSource returns a ConfigurationOption that authenticates the requests with the access tokens of ts, e.g. one
+// created once with auth.NewTokenSource and passed to the clients of several APIs, so that they share the access token
+// of the same credentials and refresh it once, instead of each client requesting its own tokens from the token endpoint.
+//
+// It takes precedence over all other authentication options except WithCustomAuth and WithoutAuthentication.
+func WithTokenSource(ts clients.TokenSource) ConfigurationOption {
+	return func(config *Configuration) error {
+		if ts == nil {
+			return fmt.Errorf("token source cannot be nil")
+		}
+		config.TokenSource = ts
+		return nil
+	}
+}
+
 // WithUserAgent returns a ConfigurationOption that defines the User-Agent
 func WithUserAgent(userAgent string) ConfigurationOption {
 	return func(config *Configuration) error {
@@ -167,6 +342,18 @@ func WithEndpoint(endpoint string) ConfigurationOption {
 	}
 }
 
+// WithEndpointResolver returns a ConfigurationOption that sets a function which is consulted for the base URL of each request.
+// The resolver receives the name of the service (e.g. "dns") and the region of the request, which is the region given as
+// function parameter for regional operations and the region of the client configuration otherwise.
+// If the resolver returns an empty string, the default URL of the API is used.
+// WithEndpoint takes precedence over this option
+func WithEndpointResolver(resolver EndpointResolver) ConfigurationOption {
+	return func(config *Configuration) error {
+		config.EndpointResolver = resolver
+		return nil
+	}
+}
+
 // WithTokenEndpoint returns a ConfigurationOption that overrides the default url to be used to get a token when using the key flow
 func WithTokenEndpoint(url string) ConfigurationOption {
 	return func(config *Configuration) error {
@@ -202,6 +389,51 @@ func WithServiceAccountKeyPath(serviceAccountKeyPath string) ConfigurationOption
 	}
 }
 
+// WithWorkloadIdentity returns a ConfigurationOption that authenticates by exchanging the OIDC ID token in the file at
+// tokenPath for a STACKIT access token, e.g. a projected Kubernetes service account token, so that no service account
+// key has to be distributed. The file is read for every exchange, so a token rotated by the kubelet is picked up.
+// audience identifies the workload identity federation at the token endpoint.
+//
+// It takes precedence over the key and token flows. The token endpoint can be set with WithTokenEndpoint.
+func WithWorkloadIdentity(tokenPath, audience string) ConfigurationOption {
+	return func(config *Configuration) error {
+		if tokenPath == "" {
+			return fmt.Errorf("workload identity token path cannot be empty")
+		}
+		if audience == "" {
+			return fmt.Errorf("workload identity audience cannot be empty")
+		}
+		config.WorkloadIdentityTokenPath = tokenPath
+		config.WorkloadIdentityAudience = audience
+		return nil
+	}
+}
+
+// WithServiceAccountKeyReader returns a ConfigurationOption that reads the service account key from r, e.g. a pipe
+// or file descriptor the key is injected into, instead of a regular file. r is read once, when the option is applied,
+// and the key is validated, so a missing or malformed key makes the creation of the API client fail.
+// Like WithServiceAccountKey, it takes precedence over WithServiceAccountKeyPath.
+func WithServiceAccountKeyReader(r io.Reader) ConfigurationOption {
+	return func(config *Configuration) error {
+		if r == nil {
+			return fmt.Errorf("service account key reader cannot be nil")
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("reading service account key: %w", err)
+		}
+		var key clients.ServiceAccountKeyResponse
+		if err := json.Unmarshal(data, &key); err != nil {
+			return fmt.Errorf("parsing service account key: %w", err)
+		}
+		if key.Credentials == nil {
+			return fmt.Errorf("service account key has no credentials")
+		}
+		config.ServiceAccountKey = string(data)
+		return nil
+	}
+}
+
 // WithPrivateKey returns a ConfigurationOption that sets the private key
 // This option takes precedence over WithPrivateKeyPath
 func WithPrivateKey(privateKey string) ConfigurationOption {
@@ -237,6 +469,37 @@ func WithToken(token string) ConfigurationOption {
 	}
 }
 
+// WithProfile returns a ConfigurationOption that reads the credentials from the named profile of the profiles file,
+// instead of the credentials file. This option takes precedence over the STACKIT_PROFILE environment variable.
+//
+// The profiles file is located in STACKIT_CREDENTIALS_PATH, if specified, or in $HOME/.stackit/credentials as a fallback.
+// It has the same keys as the credentials file, either as JSON object per profile:
+//
+//	{
+//	  "default": {"STACKIT_SERVICE_ACCOUNT_KEY_PATH": "/path/to/sa_key.json"},
+//	  "prod": {"STACKIT_SERVICE_ACCOUNT_TOKEN": "token"}
+//	}
+//
+// or as INI section per profile:
+//
+//	[default]
+//	STACKIT_SERVICE_ACCOUNT_KEY_PATH = /path/to/sa_key.json
+//
+//	[prod]
+//	STACKIT_SERVICE_ACCOUNT_TOKEN = token
+//
+// As for the credentials file, the credentials explicitly set in the configuration and in the environment variables
+// take precedence over the ones of the profile.
+func WithProfile(name string) ConfigurationOption {
+	return func(config *Configuration) error {
+		if name == "" {
+			return fmt.Errorf("profile cannot be empty")
+		}
+		config.Profile = name
+		return nil
+	}
+}
+
 // Deprecated: retry options were removed to reduce complexity of the client. If this functionality is needed, you can provide your own custom HTTP client. This option has no effect, and will be removed in a later update
 func WithMaxRetries(_ int) ConfigurationOption {
 	return func(_ *Configuration) error {
@@ -310,6 +573,256 @@ func WithBackgroundTokenRefresh(ctx context.Context) ConfigurationOption {
 	}
 }
 
+// WithServiceAccountKeyExpiryWarning returns a ConfigurationOption that calls hook once if the service account key
+// expires within the given duration, e.g. to log a warning that the key needs to be rotated.
+// Independently of this option, an expired service account key is rejected with a clients.AuthenticationError.
+//
+// Only has effect for key flow
+func WithServiceAccountKeyExpiryWarning(within time.Duration, hook func(validUntil time.Time)) ConfigurationOption {
+	return func(c *Configuration) error {
+		if hook == nil {
+			return fmt.Errorf("service account key expiry warning hook cannot be empty")
+		}
+		c.ServiceAccountKeyExpiryWarningThreshold = within
+		c.ServiceAccountKeyExpiryWarningHook = hook
+		return nil
+	}
+}
+
+// WithAuthMetrics returns a ConfigurationOption that calls f when an access token is minted or refreshed with the token
+// endpoint: at the start and at the end of each operation, with its latency and, if it failed, the classified reason.
+// This allows to observe the token endpoint separately from the APIs. The events are also accumulated in the Stats of
+// WithStats, even without this option, see Stats.Auth.
+//
+// Only has effect for key flow
+func WithAuthMetrics(f func(event clients.AuthEvent)) ConfigurationOption {
+	return func(config *Configuration) error {
+		if f == nil {
+			return fmt.Errorf("auth metrics function cannot be nil")
+		}
+		config.AuthEventFunc = f
+		return nil
+	}
+}
+
+// AuthEventHook returns the function called for the events of the token endpoint, see WithAuthMetrics and WithStats,
+// or nil if there is none
+func (c *Configuration) AuthEventHook() func(event clients.AuthEvent) {
+	f, stats := c.AuthEventFunc, c.Stats
+	if f == nil && stats == nil {
+		return nil
+	}
+	return func(event clients.AuthEvent) {
+		if stats != nil {
+			stats.RecordAuthEvent(event)
+		}
+		if f != nil {
+			f(event)
+		}
+	}
+}
+
+// WithTokenStore returns a ConfigurationOption that loads and saves the access tokens using the given store,
+// e.g. to share them between replicas. Use clients.NewFileTokenStore to store them in a file.
+//
+// Only has effect for key flow
+func WithTokenStore(store clients.TokenStore) ConfigurationOption {
+	return func(c *Configuration) error {
+		c.TokenStore = store
+		return nil
+	}
+}
+
+// WithJSONEncoder returns a ConfigurationOption that sets the function used to serialize JSON request bodies.
+// By default, encoding/json is used.
+// If the body implements json.Marshaler, its MarshalJSON method is resolved first and the result is passed to
+// the encoder as generic JSON value (maps, slices and json.Number), so the model's own serialization is preserved.
+func WithJSONEncoder(encoder JSONEncoder) ConfigurationOption {
+	return func(config *Configuration) error {
+		config.JSONEncoder = encoder
+		return nil
+	}
+}
+
+// WithJSONDecoder returns a ConfigurationOption that sets the function used to deserialize JSON response bodies.
+// By default, encoding/json is used.
+// The decoder must honor json.Unmarshaler, since the generated models rely on it to validate enums and nullable fields.
+func WithJSONDecoder(decoder JSONDecoder) ConfigurationOption {
+	return func(config *Configuration) error {
+		config.JSONDecoder = decoder
+		return nil
+	}
+}
+
+// WithRetryBudget returns a ConfigurationOption that limits the retries made by the client to ratio of its requests
+// (e.g. 0.1 for 10%), with a burst of min retries. Once the budget is exhausted, requests are no longer retried until
+// enough requests have been made, which prevents retry storms during outages. See clients.RetryBudget.
+func WithRetryBudget(ratio float64, min int) ConfigurationOption {
+	return func(config *Configuration) error {
+		budget, err := clients.NewRetryBudget(ratio, min)
+		if err != nil {
+			return err
+		}
+		config.RetryBudget = budget
+		return nil
+	}
+}
+
+// WithRetryPolicy returns a ConfigurationOption that retries the requests of the client which fail, e.g. with a
+// network error or 503 Service Unavailable, up to policy.MaxAttempts attempts in total with exponential backoff,
+// see clients.RetryConfig and clients.DefaultRetryOn. Only idempotent requests are retried, e.g. GET, PUT and DELETE,
+// and requests with an Idempotency-Key header, unless policy.RetryNonIdempotent is set. The retries stop when the
+// context of the request is done, they can be limited with WithRetryBudget and overridden per call with
+// clients.WithRetryPolicy, e.g. clients.NoRetries.
+func WithRetryPolicy(policy clients.RetryConfig) ConfigurationOption {
+	return func(config *Configuration) error {
+		if policy.MaxAttempts < 1 {
+			return fmt.Errorf("maximum number of attempts must be at least 1, got %d", policy.MaxAttempts)
+		}
+		if policy.BaseDelay < 0 || policy.MaxDelay < 0 {
+			return fmt.Errorf("retry delays cannot be negative")
+		}
+		if policy.MaxDelay > 0 && policy.MaxDelay < policy.BaseDelay {
+			return fmt.Errorf("maximum retry delay %s is lower than the base delay %s", policy.MaxDelay, policy.BaseDelay)
+		}
+		config.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// WithCircuitBreaker returns a ConfigurationOption that fails the requests of the client to a host fast with a
+// clients.CircuitOpenError after consecutive failures of the requests to it, see clients.CircuitBreaker. Each attempt
+// of a retried request is counted, the attempts failing with a clients.CircuitOpenError are not retried by
+// WithRetryPolicy. The same CircuitBreaker can be used for several clients.
+func WithCircuitBreaker(breaker *clients.CircuitBreaker) ConfigurationOption {
+	return func(config *Configuration) error {
+		if breaker == nil {
+			return fmt.Errorf("circuit breaker cannot be nil")
+		}
+		config.CircuitBreaker = breaker
+		return nil
+	}
+}
+
+// WithBackoffStrategy returns a ConfigurationOption that sets the Backoff which determines the delay between the attempts
+// of a retried request, e.g. clients.ExponentialBackoff, clients.DecorrelatedJitterBackoff or clients.ConstantBackoff.
+// By default, the delay doubles after each attempt, starting at 1 second and capped at 30 seconds.
+func WithBackoffStrategy(backoff clients.Backoff) ConfigurationOption {
+	return func(config *Configuration) error {
+		if backoff == nil {
+			return fmt.Errorf("backoff strategy cannot be nil")
+		}
+		config.BackoffStrategy = backoff
+		return nil
+	}
+}
+
+// WithRandSource returns a ConfigurationOption that draws the random values of the client from r instead of the
+// default sources, e.g. a seeded *rand.Rand to make them deterministic in tests: the jitter of the backoff, see
+// WithBackoffStrategy, and the ids of the self-signed JWTs of the key flow. The reads from r are serialized.
+//
+// The defaults are unchanged without this option: math/rand for the jitter and crypto/rand for the ids.
+func WithRandSource(r io.Reader) ConfigurationOption {
+	return func(config *Configuration) error {
+		if r == nil {
+			return fmt.Errorf("random source cannot be nil")
+		}
+		config.RandSource = clients.NewLockedReader(r)
+		return nil
+	}
+}
+
+// WithSharedRefreshGroup returns a ConfigurationOption that coalesces the requests for new access tokens of the API
+// clients created with the same group and the same service account key: only one of them requests a token at a time,
+// and the others reuse it. group.Stats reports how many requests were sent and how many were coalesced.
+// Only the key flow uses the group.
+func WithSharedRefreshGroup(group *clients.RefreshGroup) ConfigurationOption {
+	return func(config *Configuration) error {
+		if group == nil {
+			return fmt.Errorf("refresh group cannot be nil")
+		}
+		config.RefreshGroup = group
+		return nil
+	}
+}
+
+// WithKeyReload returns a ConfigurationOption that re-reads the service account key file, see WithServiceAccountKeyPath,
+// and the private key file, see WithPrivateKeyPath, at most once every interval when the key flow needs an access token,
+// so that a long-running client picks up a rotated key without a restart. If the private key is part of the service
+// account key, it is reloaded with it. If the keys changed, the next access token is requested with the new keys.
+// If a reload fails, e.g. while the files are being replaced, the current keys are kept and the reload is retried
+// after interval.
+//
+// The service account key must be read from a file, either set with WithServiceAccountKeyPath or with the
+// STACKIT_SERVICE_ACCOUNT_KEY_PATH environment variable or the credentials file, otherwise creating the client fails.
+func WithKeyReload(interval time.Duration) ConfigurationOption {
+	return func(config *Configuration) error {
+		if interval <= 0 {
+			return fmt.Errorf("key reload interval must be positive")
+		}
+		config.KeyReloadInterval = interval
+		return nil
+	}
+}
+
+// WithAssertionAlgorithm returns a ConfigurationOption that sets the algorithm of the JWTs the key flow signs with
+// the private key to request access tokens, one of RS256, RS384, RS512, PS256, PS384 or PS512 for an RSA key, and
+// ES256, ES384 or ES512 for an EC key. By default, it is RS512 for an RSA key, and the one matching the curve of an
+// EC key. The client returns an error when it is created if the algorithm doesn't match the key.
+func WithAssertionAlgorithm(alg string) ConfigurationOption {
+	return func(config *Configuration) error {
+		switch alg {
+		case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512":
+			config.AssertionAlgorithm = alg
+			return nil
+		}
+		return fmt.Errorf("unsupported assertion algorithm %q", alg)
+	}
+}
+
+// WithRetryOnBodyError returns a ConfigurationOption that retries requests with a 2xx status code if retryOnBodyError
+// returns true for the response body, e.g. for endpoints which report transient backend failures with an error code
+// in the body of a 200 OK. A request is sent up to 3 times in total, unless a clients.RetryPolicy sets another maximum,
+// with the delays of WithBackoffStrategy and limited by WithRetryBudget.
+//
+// This has a performance cost: the body of every 2xx response is read completely into memory before the response
+// is returned, except for the last attempt, so large responses are no longer streamed. See
+// clients.ConflictRetryRoundTripper.SetRetryOnBodyError.
+func WithRetryOnBodyError(retryOnBodyError func(body []byte) bool) ConfigurationOption {
+	return func(config *Configuration) error {
+		if retryOnBodyError == nil {
+			return fmt.Errorf("retry on body error function cannot be nil")
+		}
+		config.RetryOnBodyError = retryOnBodyError
+		return nil
+	}
+}
+
+// WithErrorContext returns a ConfigurationOption that wraps the errors returned by the API clients in an
+// oapierror.OperationError with the name of the operation and its path parameters, e.g. the project and resource ids:
+//
+//	dns.GetZone(projectId=xxx, zoneId=yyy): 404 Not Found, status code 404, ...
+//
+// The wrapped error can still be obtained with errors.As, e.g. a *oapierror.GenericOpenAPIError, but not with a type
+// assertion, which is why this is not enabled by default. The values of parameters which may be personal data or
+// secrets, e.g. email addresses, are redacted in the message.
+func WithErrorContext() ConfigurationOption {
+	return func(config *Configuration) error {
+		config.ErrorContext = true
+		return nil
+	}
+}
+
+// WithCanonicalQueryEncoding returns a ConfigurationOption that encodes the query parameters of each request in canonical order.
+// The query parameters are always sorted by key, this option additionally sorts the values of repeated query parameters,
+// so that the same parameters always result in the same query string, e.g. for request signing or cache keys.
+func WithCanonicalQueryEncoding() ConfigurationOption {
+	return func(config *Configuration) error {
+		config.CanonicalQueryEncoding = true
+		return nil
+	}
+}
+
 // WithCustomConfiguration returns a ConfigurationOption that sets a custom Configuration
 func WithCustomConfiguration(cfg *Configuration) ConfigurationOption {
 	return func(config *Configuration) error {
@@ -326,12 +839,67 @@ func WithCustomConfiguration(cfg *Configuration) ConfigurationOption {
 		config.PrivateKeyPath = cfg.PrivateKeyPath
 		config.Region = cfg.Region
 		config.CredentialsFilePath = cfg.CredentialsFilePath
+		config.Profile = cfg.Profile
 		config.CustomAuth = cfg.CustomAuth
 		config.Servers = cfg.Servers
 		config.setCustomEndpoint = (len(cfg.Servers) > 0)
 		config.OperationServers = cfg.OperationServers
 		config.HTTPClient = cfg.HTTPClient
 		config.BackgroundTokenRefreshContext = cfg.BackgroundTokenRefreshContext
+		config.ServiceAccountKeyExpiryWarningThreshold = cfg.ServiceAccountKeyExpiryWarningThreshold
+		config.ServiceAccountKeyExpiryWarningHook = cfg.ServiceAccountKeyExpiryWarningHook
+		config.JSONEncoder = cfg.JSONEncoder
+		config.JSONDecoder = cfg.JSONDecoder
+		config.EndpointResolver = cfg.EndpointResolver
+		config.RetryBudget = cfg.RetryBudget
+		config.BackoffStrategy = cfg.BackoffStrategy
+		config.RetryOnBodyError = cfg.RetryOnBodyError
+		config.TokenStore = cfg.TokenStore
+		config.RateLimitTracker = cfg.RateLimitTracker
+		config.AccessLogger = cfg.AccessLogger
+		config.ClientTraceFunc = cfg.ClientTraceFunc
+		config.Stats = cfg.Stats
+		config.AvailableRegions = cfg.AvailableRegions
+		config.CanonicalQueryEncoding = cfg.CanonicalQueryEncoding
+		config.DialTimeout = cfg.DialTimeout
+		config.KeepAlive = cfg.KeepAlive
+		config.TLSHandshakeTimeout = cfg.TLSHandshakeTimeout
+		config.ResponseHeaderTimeout = cfg.ResponseHeaderTimeout
+		config.ProxyFunc = cfg.ProxyFunc
+		config.TLSConfig = cfg.TLSConfig
+		config.StrictTLSVerify = cfg.StrictTLSVerify
+		config.HostOverride = cfg.HostOverride
+		config.ErrorContext = cfg.ErrorContext
+		config.DecompressionAccounting = cfg.DecompressionAccounting
+		config.ResponseSizeFunc = cfg.ResponseSizeFunc
+		config.StreamingListDecode = cfg.StreamingListDecode
+		config.LenientFieldDecode = cfg.LenientFieldDecode
+		config.IOBufferSize = cfg.IOBufferSize
+		config.ResponseCache = cfg.ResponseCache
+		config.AuthEventFunc = cfg.AuthEventFunc
+		config.RandSource = cfg.RandSource
+		config.RefreshGroup = cfg.RefreshGroup
+		config.AssertionAlgorithm = cfg.AssertionAlgorithm
+		config.KeyReloadInterval = cfg.KeyReloadInterval
+		config.TokenListener = cfg.TokenListener
+		config.TokenSource = cfg.TokenSource
+		config.WorkloadIdentityTokenPath = cfg.WorkloadIdentityTokenPath
+		config.WorkloadIdentityAudience = cfg.WorkloadIdentityAudience
+		config.WarningHandler = cfg.WarningHandler
+		config.FollowAuthRedirects = cfg.FollowAuthRedirects
+		config.RedirectHook = cfg.RedirectHook
+		config.TrailingSlashPolicy = cfg.TrailingSlashPolicy
+		config.CorrelationIDFunc = cfg.CorrelationIDFunc
+		config.CorrelationIDHeader = cfg.CorrelationIDHeader
+		config.RequestLogger = cfg.RequestLogger
+		config.RequestLogLevel = cfg.RequestLogLevel
+		config.HTTPDumpWriter = cfg.HTTPDumpWriter
+		config.RetryPolicy = cfg.RetryPolicy
+		config.Throttle = cfg.Throttle
+		config.CircuitBreaker = cfg.CircuitBreaker
+		config.IdempotencyKeys = cfg.IdempotencyKeys
+		config.IdempotencyKeyHeader = cfg.IdempotencyKeyHeader
+		config.CredentialProvider = cfg.CredentialProvider
 		return nil
 	}
 }
@@ -361,6 +929,46 @@ type ServerConfiguration struct {
 // ServerConfigurations stores multiple ServerConfiguration items
 type ServerConfigurations []ServerConfiguration
 
+// EncodeJSON serializes v using the configured JSONEncoder, falling back to encoding/json
+func (c *Configuration) EncodeJSON(v any) ([]byte, error) {
+	if c == nil || c.JSONEncoder == nil {
+		return json.Marshal(v)
+	}
+	if m, ok := v.(json.Marshaler); ok {
+		raw, err := m.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		var generic any
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		if err := dec.Decode(&generic); err != nil {
+			return nil, fmt.Errorf("decode output of MarshalJSON: %w", err)
+		}
+		v = generic
+	}
+	return c.JSONEncoder(v)
+}
+
+// DecodeJSON deserializes data into v using the configured JSONDecoder, falling back to encoding/json
+func (c *Configuration) DecodeJSON(data []byte, v any) error {
+	if c == nil || c.JSONDecoder == nil {
+		return json.Unmarshal(data, v)
+	}
+	return c.JSONDecoder(data, v)
+}
+
+// CanonicalizeQuery sorts the values of each query parameter in place, if canonical query encoding is enabled.
+// url.Values.Encode sorts the query parameters by key, so the encoded query of the result is deterministic.
+func (c *Configuration) CanonicalizeQuery(query url.Values) {
+	if c == nil || !c.CanonicalQueryEncoding {
+		return
+	}
+	for _, values := range query {
+		sort.Strings(values)
+	}
+}
+
 // AddDefaultHeader adds a new HTTP header to the default header in the request
 func (c *Configuration) AddDefaultHeader(key, value string) {
 	c.DefaultHeader[key] = value
@@ -453,6 +1061,42 @@ func getServerOperationVariables(ctx context.Context, endpoint string) (map[stri
 
 // ServerURLWithContext returns a new server URL given an endpoint
 func (c *Configuration) ServerURLWithContext(ctx context.Context, endpoint string) (string, error) {
+	return c.ServerURLForRegion(ctx, endpoint, "")
+}
+
+// ServerURLForRegion returns a new server URL given an endpoint and the region of the request.
+// If an EndpointResolver is configured, it is consulted first. If region is empty, the region of the RequestOverrides
+// of ctx is used, if any, otherwise the region of the configuration, see WithRequestOverrides.
+// It returns a RegionNotAvailableError if the service is not available in region.
+func (c *Configuration) ServerURLForRegion(ctx context.Context, endpoint, region string) (string, error) {
+	overrides, _ := GetRequestOverrides(ctx)
+	if overrides.Endpoint != "" {
+		return overrides.Endpoint, nil
+	}
+	overrideRegion := region == "" && overrides.Region != "" && !c.globalAPI
+	if overrideRegion {
+		region = overrides.Region
+	}
+	if err := c.validateRequestRegion(region); err != nil {
+		return "", err
+	}
+	if c.EndpointResolver != nil && !c.setCustomEndpoint {
+		if region == "" {
+			region = c.Region
+		}
+		url, err := c.EndpointResolver(c.ServiceName, region)
+		if err != nil {
+			return "", fmt.Errorf("resolving endpoint for service %q in region %q: %w", c.ServiceName, region, err)
+		}
+		if url != "" {
+			return url, nil
+		}
+	}
+
+	if overrideRegion && len(c.regionServers) > 0 {
+		return c.regionalServerURL(region)
+	}
+
 	sc, ok := c.OperationServers[endpoint]
 	if !ok {
 		sc = c.Servers
@@ -475,6 +1119,43 @@ func (c *Configuration) ServerURLWithContext(ctx context.Context, endpoint strin
 	return sc.URL(index, variables)
 }
 
+// regionalServerURL returns the URL of the regional API in region, see RequestOverrides.Region
+func (c *Configuration) regionalServerURL(region string) (string, error) {
+	server := c.regionServers[0]
+	// The regions of WithAvailableRegions were validated by validateRequestRegion
+	if c.AvailableRegions == nil {
+		var available []string
+		for _, regionWithDotSuffix := range server.Variables["region"].EnumValues {
+			available = append(available, strings.TrimSuffix(regionWithDotSuffix, "."))
+		}
+		if len(available) > 0 && !containsCaseSensitive(available, region) {
+			return "", &RegionNotAvailableError{Service: c.ServiceName, Region: region, Available: available}
+		}
+	}
+	return strings.Replace(server.URL, "{region}", fmt.Sprintf("%s.", region), -1), nil
+}
+
+// ResolvedEndpoint returns the base URL of the API after the endpoint, region and endpoint resolver options are applied,
+// i.e. the URL the requests without a region parameter are sent to. It is empty if the URL can't be resolved, e.g. if
+// the endpoint resolver fails.
+func (c *Configuration) ResolvedEndpoint() string {
+	url, err := c.ServerURLForRegion(context.Background(), "", "")
+	if err != nil {
+		return ""
+	}
+	return url
+}
+
+// ResolvedRegion returns the region of the API after ConfigureRegion, including a region set with the STACKIT_REGION
+// environment variable. It is empty for a global API, and for an API with a region parameter per request unless
+// a region was configured.
+func (c *Configuration) ResolvedRegion() string {
+	if c.globalAPI {
+		return ""
+	}
+	return c.Region
+}
+
 // ConfigureRegion configures the API server urls with the user specified region.
 // Does nothing if a custom endpoint is provided.
 // Throws an error if no region is given or if the region is not valid
@@ -495,6 +1176,9 @@ func ConfigureRegion(cfg *Configuration) error {
 	for _, regionWithDotSuffix := range oasRegion.EnumValues {
 		availableRegions = append(availableRegions, strings.TrimSuffix(regionWithDotSuffix, "."))
 	}
+	if cfg.AvailableRegions != nil {
+		availableRegions = cfg.AvailableRegions
+	}
 
 	isRegionSetByEnv := false
 	if cfg.Region == "" {
@@ -510,6 +1194,7 @@ func ConfigureRegion(cfg *Configuration) error {
 		}
 		// API is regional (not global)
 		if containsCaseSensitive(availableRegions, cfg.Region) {
+			cfg.regionServers = servers
 			cfgUrl := strings.Replace(servers[0].URL, "{region}", fmt.Sprintf("%s.", cfg.Region), -1)
 			cfg.Servers = ServerConfigurations{
 				{
@@ -520,9 +1205,10 @@ func ConfigureRegion(cfg *Configuration) error {
 			return nil
 		}
 		// Region is not available.
-		return fmt.Errorf("the provided region is not available for this API, available regions are: %s", availableRegions)
+		return &RegionNotAvailableError{Service: cfg.ServiceName, Region: cfg.Region, Available: availableRegions}
 	}
 	// Global API.
+	cfg.globalAPI = true
 	// If a region is provided by the user via WithRegion() return an error.
 	// The region is provided as a function argument instead of being set in the client configuration.
 	if cfg.Region != "" && !isRegionSetByEnv {
@@ -1,9 +1,18 @@
 package config
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 )
 
 func TestConfigureRegion(t *testing.T) {
@@ -240,3 +249,473 @@ func TestConfigureRegion(t *testing.T) {
 		})
 	}
 }
+
+type marshalerModel struct {
+	Name string
+}
+
+func (m marshalerModel) MarshalJSON() ([]byte, error) {
+	return []byte(`{"z":1,"name":"` + m.Name + `"}`), nil
+}
+
+func TestEncodeJSON(t *testing.T) {
+	// sortedEncoder re-encodes the value through a generic map, which sorts the keys
+	sortedEncoder := func(v any) ([]byte, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var generic any
+		if err := json.Unmarshal(b, &generic); err != nil {
+			return nil, err
+		}
+		return json.Marshal(generic)
+	}
+	for _, test := range []struct {
+		desc     string
+		encoder  JSONEncoder
+		body     any
+		expected string
+	}{
+		{
+			desc:     "default_encoder",
+			body:     struct{ B, A string }{B: "b", A: "a"},
+			expected: `{"B":"b","A":"a"}`,
+		},
+		{
+			desc:     "custom_encoder",
+			encoder:  sortedEncoder,
+			body:     struct{ B, A string }{B: "b", A: "a"},
+			expected: `{"A":"a","B":"b"}`,
+		},
+		{
+			desc:     "custom_encoder_honors_marshaler",
+			encoder:  sortedEncoder,
+			body:     marshalerModel{Name: "foo"},
+			expected: `{"name":"foo","z":1}`,
+		},
+		{
+			desc: "custom_encoder_receives_generic_value_for_marshaler",
+			encoder: func(v any) ([]byte, error) {
+				if _, ok := v.(map[string]any); !ok {
+					return nil, fmt.Errorf("unexpected type %T", v)
+				}
+				return json.Marshal(v)
+			},
+			body:     &marshalerModel{Name: "foo"},
+			expected: `{"name":"foo","z":1}`,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := &Configuration{}
+			err := WithJSONEncoder(test.encoder)(cfg)
+			if err != nil {
+				t.Fatalf("applying option: %v", err)
+			}
+			got, err := cfg.EncodeJSON(test.body)
+			if err != nil {
+				t.Fatalf("EncodeJSON returned error: %v", err)
+			}
+			if string(got) != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	called := false
+	cfg := &Configuration{}
+	err := WithJSONDecoder(func(data []byte, v any) error {
+		called = true
+		return json.Unmarshal(data, v)
+	})(cfg)
+	if err != nil {
+		t.Fatalf("applying option: %v", err)
+	}
+
+	var got map[string]string
+	err = cfg.DecodeJSON([]byte(`{"foo":"bar"}`), &got)
+	if err != nil {
+		t.Fatalf("DecodeJSON returned error: %v", err)
+	}
+	if !called {
+		t.Fatalf("custom decoder was not called")
+	}
+	if !cmp.Equal(got, map[string]string{"foo": "bar"}) {
+		t.Fatalf("unexpected result %v", got)
+	}
+}
+
+func TestServerURLForRegion(t *testing.T) {
+	resolver := func(service, region string) (string, error) {
+		switch {
+		case service == "dns" && region == "eu01":
+			return "https://dns.internal.example", nil
+		case region == "broken":
+			return "", fmt.Errorf("some error")
+		}
+		return "", nil
+	}
+	for _, test := range []struct {
+		desc           string
+		opts           []ConfigurationOption
+		region         string
+		cfgRegion      string
+		expectedURL    string
+		expectedErrors bool
+	}{
+		{
+			desc:        "no_resolver",
+			region:      "eu01",
+			expectedURL: "https://dns.api.stackit.cloud",
+		},
+		{
+			desc:        "resolver_with_request_region",
+			opts:        []ConfigurationOption{WithEndpointResolver(resolver)},
+			region:      "eu01",
+			cfgRegion:   "eu02",
+			expectedURL: "https://dns.internal.example",
+		},
+		{
+			desc:        "resolver_with_configuration_region",
+			opts:        []ConfigurationOption{WithEndpointResolver(resolver)},
+			cfgRegion:   "eu01",
+			expectedURL: "https://dns.internal.example",
+		},
+		{
+			desc:        "resolver_returns_empty",
+			opts:        []ConfigurationOption{WithEndpointResolver(resolver)},
+			region:      "eu02",
+			expectedURL: "https://dns.api.stackit.cloud",
+		},
+		{
+			desc:           "resolver_fails",
+			opts:           []ConfigurationOption{WithEndpointResolver(resolver)},
+			region:         "broken",
+			expectedErrors: true,
+		},
+		{
+			desc:        "custom_endpoint_takes_precedence",
+			opts:        []ConfigurationOption{WithEndpointResolver(resolver), WithEndpoint("https://custom.example")},
+			region:      "eu01",
+			expectedURL: "https://custom.example",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := &Configuration{
+				ServiceName: "dns",
+				Region:      test.cfgRegion,
+				Servers: ServerConfigurations{
+					{URL: "https://dns.api.stackit.cloud"},
+				},
+			}
+			for _, opt := range test.opts {
+				if err := opt(cfg); err != nil {
+					t.Fatalf("applying option: %v", err)
+				}
+			}
+
+			got, err := cfg.ServerURLForRegion(context.Background(), "DefaultApiService.GetZone", test.region)
+			if (err != nil) != test.expectedErrors {
+				t.Fatalf("expected error %t, got %v", test.expectedErrors, err)
+			}
+			if got != test.expectedURL {
+				t.Fatalf("expected URL %q, got %q", test.expectedURL, got)
+			}
+		})
+	}
+}
+
+func TestResolvedEndpointAndRegion(t *testing.T) {
+	regionalServers := ServerConfigurations{
+		{
+			URL: "https://dns.api.{region}stackit.cloud",
+			Variables: map[string]ServerVariable{
+				"region": {DefaultValue: "eu01", EnumValues: []string{"eu01.", "eu02."}},
+			},
+		},
+	}
+	globalServers := ServerConfigurations{
+		{
+			URL: "https://dns.api.{region}stackit.cloud",
+			Variables: map[string]ServerVariable{
+				"region": {DefaultValue: "global", EnumValues: []string{"global"}},
+			},
+		},
+	}
+	for _, test := range []struct {
+		desc             string
+		servers          ServerConfigurations
+		opts             []ConfigurationOption
+		regionEnvVar     string
+		expectedEndpoint string
+		expectedRegion   string
+	}{
+		{
+			desc:             "regional",
+			servers:          regionalServers,
+			opts:             []ConfigurationOption{WithRegion("eu02")},
+			expectedEndpoint: "https://dns.api.eu02.stackit.cloud",
+			expectedRegion:   "eu02",
+		},
+		{
+			desc:             "regional_env",
+			servers:          regionalServers,
+			regionEnvVar:     "eu01",
+			expectedEndpoint: "https://dns.api.eu01.stackit.cloud",
+			expectedRegion:   "eu01",
+		},
+		{
+			desc:             "global_env",
+			servers:          globalServers,
+			regionEnvVar:     "eu01",
+			expectedEndpoint: "https://dns.api.stackit.cloud",
+		},
+		{
+			desc:    "resolver",
+			servers: regionalServers,
+			opts: []ConfigurationOption{WithRegion("eu01"), WithEndpointResolver(func(service, region string) (string, error) {
+				return fmt.Sprintf("https://%s.%s.internal.example", service, region), nil
+			})},
+			expectedEndpoint: "https://dns.eu01.internal.example",
+			expectedRegion:   "eu01",
+		},
+		{
+			desc:             "custom_endpoint",
+			servers:          regionalServers,
+			opts:             []ConfigurationOption{WithRegion("eu01"), WithEndpoint("https://custom.example")},
+			expectedEndpoint: "https://custom.example",
+			expectedRegion:   "eu01",
+		},
+		{
+			desc:    "resolver_fails",
+			servers: regionalServers,
+			opts: []ConfigurationOption{WithRegion("eu01"), WithEndpointResolver(func(_, _ string) (string, error) {
+				return "", fmt.Errorf("some error")
+			})},
+			expectedRegion: "eu01",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Setenv("STACKIT_REGION", test.regionEnvVar)
+			cfg := &Configuration{ServiceName: "dns", Servers: test.servers}
+			for _, opt := range test.opts {
+				if err := opt(cfg); err != nil {
+					t.Fatalf("applying option: %v", err)
+				}
+			}
+			if err := ConfigureRegion(cfg); err != nil {
+				t.Fatalf("configuring region: %v", err)
+			}
+			if got := cfg.ResolvedEndpoint(); got != test.expectedEndpoint {
+				t.Errorf("expected endpoint %q, got %q", test.expectedEndpoint, got)
+			}
+			if got := cfg.ResolvedRegion(); got != test.expectedRegion {
+				t.Errorf("expected region %q, got %q", test.expectedRegion, got)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeQuery(t *testing.T) {
+	for _, test := range []struct {
+		desc     string
+		cfg      *Configuration
+		expected string
+	}{
+		{
+			desc:     "disabled",
+			cfg:      &Configuration{},
+			expected: "filter=b&filter=a&label%5Benv%5D=prod&label%5Bteam%5D=sdk&page=2",
+		},
+		{
+			desc:     "enabled",
+			cfg:      &Configuration{CanonicalQueryEncoding: true},
+			expected: "filter=a&filter=b&label%5Benv%5D=prod&label%5Bteam%5D=sdk&page=2",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			// Map iteration order is random, so a bug would surface over repeated runs
+			for i := 0; i < 100; i++ {
+				query := url.Values{}
+				for k, v := range map[string]string{"page": "2", "label[team]": "sdk", "label[env]": "prod"} {
+					query.Add(k, v)
+				}
+				query.Add("filter", "b")
+				query.Add("filter", "a")
+
+				test.cfg.CanonicalizeQuery(query)
+				if got := query.Encode(); got != test.expected {
+					t.Fatalf("expected query %q, got %q", test.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestWithRandSource(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithRandSource(nil)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil random source")
+	}
+
+	if err := WithRandSource(strings.NewReader("0123456789abcdef"))(cfg); err != nil {
+		t.Fatalf("WithRandSource failed: %v", err)
+	}
+	b := make([]byte, 4)
+	if _, err := cfg.RandSource.Read(b); err != nil || string(b) != "0123" {
+		t.Fatalf("expected to read from the random source, got %q, %v", b, err)
+	}
+}
+
+func TestWithSharedRefreshGroup(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithSharedRefreshGroup(nil)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil refresh group")
+	}
+
+	group := clients.NewRefreshGroup()
+	if err := WithSharedRefreshGroup(group)(cfg); err != nil {
+		t.Fatalf("WithSharedRefreshGroup failed: %v", err)
+	}
+	if cfg.RefreshGroup != group {
+		t.Fatalf("expected the refresh group to be set")
+	}
+}
+
+func TestWithAssertionAlgorithm(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithAssertionAlgorithm("HS256")(cfg); err == nil {
+		t.Fatalf("expected an error for an unsupported algorithm")
+	}
+	if err := WithAssertionAlgorithm("ES256")(cfg); err != nil {
+		t.Fatalf("WithAssertionAlgorithm failed: %v", err)
+	}
+	if cfg.AssertionAlgorithm != "ES256" {
+		t.Fatalf("expected the assertion algorithm to be set, got %q", cfg.AssertionAlgorithm)
+	}
+}
+
+func TestWithTokenListener(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithTokenListener(nil)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil listener")
+	}
+	if err := WithTokenListener(func(clients.TokenInfo) {})(cfg); err != nil {
+		t.Fatalf("WithTokenListener failed: %v", err)
+	}
+	if cfg.TokenListener == nil {
+		t.Fatalf("expected the token listener to be set")
+	}
+}
+
+func TestWithTokenSource(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithTokenSource(nil)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil token source")
+	}
+	tokenSource := &clients.TokenFlow{}
+	if err := WithTokenSource(tokenSource)(cfg); err != nil {
+		t.Fatalf("WithTokenSource failed: %v", err)
+	}
+	if cfg.TokenSource != tokenSource {
+		t.Fatalf("expected the token source to be set")
+	}
+}
+
+func TestWithWorkloadIdentity(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithWorkloadIdentity("", "aud")(cfg); err == nil {
+		t.Fatalf("expected an error for an empty token path")
+	}
+	if err := WithWorkloadIdentity("/var/run/secrets/token", "")(cfg); err == nil {
+		t.Fatalf("expected an error for an empty audience")
+	}
+	if err := WithWorkloadIdentity("/var/run/secrets/token", "aud")(cfg); err != nil {
+		t.Fatalf("WithWorkloadIdentity failed: %v", err)
+	}
+	if cfg.WorkloadIdentityTokenPath != "/var/run/secrets/token" || cfg.WorkloadIdentityAudience != "aud" {
+		t.Fatalf("expected the workload identity to be set, got %q, %q", cfg.WorkloadIdentityTokenPath, cfg.WorkloadIdentityAudience)
+	}
+}
+
+func TestWithKeyReload(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithKeyReload(0)(cfg); err == nil {
+		t.Fatalf("expected an error for a zero interval")
+	}
+	if err := WithKeyReload(time.Minute)(cfg); err != nil {
+		t.Fatalf("WithKeyReload failed: %v", err)
+	}
+	if cfg.KeyReloadInterval != time.Minute {
+		t.Fatalf("expected the key reload interval to be set, got %v", cfg.KeyReloadInterval)
+	}
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		desc    string
+		policy  clients.RetryConfig
+		wantErr bool
+	}{
+		{"ok", clients.RetryConfig{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute, Jitter: true}, false},
+		{"defaults", clients.RetryConfig{MaxAttempts: 3}, false},
+		{"no_attempts", clients.RetryConfig{}, true},
+		{"negative_delay", clients.RetryConfig{MaxAttempts: 3, BaseDelay: -time.Second}, true},
+		{"max_delay_below_base_delay", clients.RetryConfig{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Second}, true},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			cfg := &Configuration{}
+			err := WithRetryPolicy(tt.policy)(cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WithRetryPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (cfg.RetryPolicy == nil || cfg.RetryPolicy.MaxAttempts != tt.policy.MaxAttempts) {
+				t.Fatalf("expected the retry policy to be set, got %+v", cfg.RetryPolicy)
+			}
+		})
+	}
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithCircuitBreaker(nil)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil circuit breaker")
+	}
+	breaker, err := clients.NewCircuitBreaker(5, time.Minute)
+	if err != nil {
+		t.Fatalf("NewCircuitBreaker failed: %v", err)
+	}
+	if err := WithCircuitBreaker(breaker)(cfg); err != nil {
+		t.Fatalf("WithCircuitBreaker failed: %v", err)
+	}
+	if cfg.CircuitBreaker != breaker {
+		t.Fatalf("expected the circuit breaker to be set")
+	}
+}
+
+func TestWithServiceAccountKeyReader(t *testing.T) {
+	for _, tt := range []struct {
+		desc    string
+		reader  io.Reader
+		wantErr bool
+	}{
+		{"valid", strings.NewReader(`{"id": "5e1b0f4a-5d0c-4c0e-9c1d-3b1a1b0e6a11", "credentials": {"kid": "kid", "iss": "iss"}}`), false},
+		{"nil", nil, true},
+		{"read_error", iotest.ErrReader(fmt.Errorf("broken pipe")), true},
+		{"invalid_json", strings.NewReader(`{"credentials":`), true},
+		{"no_credentials", strings.NewReader(`{"id": "5e1b0f4a-5d0c-4c0e-9c1d-3b1a1b0e6a11"}`), true},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			cfg := &Configuration{}
+			err := WithServiceAccountKeyReader(tt.reader)(cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WithServiceAccountKeyReader error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && cfg.ServiceAccountKey == "" {
+				t.Fatalf("expected the service account key to be set")
+			}
+		})
+	}
+}
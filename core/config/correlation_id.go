@@ -0,0 +1,72 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DefaultCorrelationIDHeader is the header of the correlation id if WithCorrelationID is given an empty header
+const DefaultCorrelationIDHeader = "X-Correlation-Id"
+
+type correlationIDContextKey struct{}
+
+// CorrelationIDFunc returns the correlation id of a request with the given context, or an empty string to send
+// the request without one
+type CorrelationIDFunc func(ctx context.Context) string
+
+// WithCorrelationID returns a ConfigurationOption that sends a correlation id generated by gen in header with each
+// request, or in DefaultCorrelationIDHeader if header is empty. The retries of a request are sent with the same id.
+// The id is added to the context of the request, see GetCorrelationID, and to the AccessLogEntry of WithAccessLog,
+// so the logs and metrics of the middlewares can be correlated with the request.
+//
+// No correlation id is sent by default.
+func WithCorrelationID(gen CorrelationIDFunc, header string) ConfigurationOption {
+	return func(config *Configuration) error {
+		if gen == nil {
+			return fmt.Errorf("correlation id generator cannot be nil")
+		}
+		if header == "" {
+			header = DefaultCorrelationIDHeader
+		}
+		config.CorrelationIDFunc = gen
+		config.CorrelationIDHeader = header
+		return nil
+	}
+}
+
+// GetCorrelationID returns the correlation id of a request with the given context, see WithCorrelationID
+func GetCorrelationID(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	id, ok := ctx.Value(correlationIDContextKey{}).(string)
+	return id, ok
+}
+
+// CorrelationIDMiddleware returns a Middleware that sends the correlation id generated by gen in header with each
+// request and adds it to the context of the request. A request which already has the header keeps its id.
+func CorrelationIDMiddleware(gen CorrelationIDFunc, header string) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &correlationIDRoundTripper{rt: rt, gen: gen, header: header}
+	}
+}
+
+type correlationIDRoundTripper struct {
+	rt     http.RoundTripper
+	gen    CorrelationIDFunc
+	header string
+}
+
+func (c *correlationIDRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := req.Header.Get(c.header)
+	if id == "" {
+		id = c.gen(req.Context())
+	}
+	if id == "" {
+		return c.rt.RoundTrip(req)
+	}
+	req = req.Clone(context.WithValue(req.Context(), correlationIDContextKey{}, id))
+	req.Header.Set(c.header, id)
+	return c.rt.RoundTrip(req)
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+func TestCorrelationID(t *testing.T) {
+	var attempts atomic.Int32
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("X-Request-Ref"))
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var generated atomic.Int32
+	var logged []string
+	var middlewareSaw string
+	cfg := &Configuration{BackoffStrategy: clients.ConstantBackoff{}}
+	for _, option := range []ConfigurationOption{
+		WithCorrelationID(func(_ context.Context) string {
+			return "ref-" + strconv.Itoa(int(generated.Add(1)))
+		}, "X-Request-Ref"),
+		WithAccessLog(func(entry AccessLogEntry) {
+			logged = append(logged, entry.CorrelationID)
+		}),
+		WithMiddleware(func(rt http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				middlewareSaw, _ = GetCorrelationID(req.Context())
+				return rt.RoundTrip(req)
+			})
+		}),
+	} {
+		if err := option(cfg); err != nil {
+			t.Fatalf("configuring: %v", err)
+		}
+	}
+	client := &http.Client{Transport: AssembleTransport(cfg, http.DefaultTransport)}
+
+	req, err := http.NewRequestWithContext(clients.WithConflictRetry(context.Background(), 2), http.MethodPost, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if len(received) != 2 || received[0] != "ref-1" || received[1] != "ref-1" {
+		t.Errorf("expected both attempts to be sent with correlation id ref-1, got %q", received)
+	}
+	if len(logged) != 2 || logged[0] != "ref-1" || logged[1] != "ref-1" {
+		t.Errorf("expected the access log entries to contain the correlation id, got %q", logged)
+	}
+	if middlewareSaw != "ref-1" {
+		t.Errorf("expected the middleware to see the correlation id, got %q", middlewareSaw)
+	}
+	if req.Header.Get("X-Request-Ref") != "" {
+		t.Errorf("expected the request of the caller to be unchanged")
+	}
+}
+
+func TestCorrelationIDDefaults(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get(DefaultCorrelationIDHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, tt := range []struct {
+		desc         string
+		gen          CorrelationIDFunc
+		callerHeader string
+		expected     string
+	}{
+		{"default_header", func(_ context.Context) string { return "generated" }, "", "generated"},
+		{"no_id", func(_ context.Context) string { return "" }, "", ""},
+		{"caller_header_kept", func(_ context.Context) string { return "generated" }, "caller", "caller"},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			received = ""
+			cfg := &Configuration{}
+			if err := WithCorrelationID(tt.gen, "")(cfg); err != nil {
+				t.Fatalf("WithCorrelationID failed: %v", err)
+			}
+			client := &http.Client{Transport: AssembleTransport(cfg, http.DefaultTransport)}
+			req, err := http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			if tt.callerHeader != "" {
+				req.Header.Set(DefaultCorrelationIDHeader, tt.callerHeader)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			resp.Body.Close()
+			if received != tt.expected {
+				t.Fatalf("expected correlation id %q, got %q", tt.expected, received)
+			}
+		})
+	}
+
+	if err := WithCorrelationID(nil, "")(&Configuration{}); err == nil {
+		t.Fatalf("expected an error for a nil generator")
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// CredentialProvider sets up the authentication of the requests of a client with the credentials it finds, e.g. the
+// ones in the environment, see WithCredentialProvider. The auth package has the providers of the SDK and
+// auth.CredentialChain, which tries several providers in order.
+type CredentialProvider interface {
+	// Name of the provider in the errors, e.g. "key flow"
+	Name() string
+	// Provide returns a RoundTripper which authenticates the requests, or an error if the provider has no valid
+	// credentials. It can set up the authentication options of cfg, e.g. the service account key it found.
+	Provide(cfg *Configuration) (http.RoundTripper, error)
+}
+
+// WithCredentialProvider returns a ConfigurationOption that sets up the authentication with provider instead of the
+// default auth.DefaultCredentialChain, if no credentials are set explicitly, e.g. with WithServiceAccountKey:
+//
+//	chain := auth.DefaultCredentialChain().Without(auth.TokenProviderName).Append(&auth.CLIAuthProvider{})
+//	client, err := dns.NewAPIClient(config.WithCredentialProvider(chain))
+func WithCredentialProvider(provider CredentialProvider) ConfigurationOption {
+	return func(config *Configuration) error {
+		if provider == nil {
+			return fmt.Errorf("credential provider cannot be nil")
+		}
+		config.CredentialProvider = provider
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ResponseSize are the sizes of the body of a response, see WithDecompressionAccounting
+type ResponseSize struct {
+	Operation Operation
+	// ContentEncoding is the encoding the body was sent with, e.g. "gzip", or empty if it wasn't encoded
+	ContentEncoding string
+	// WireBytes is the number of bytes of the body as received
+	WireBytes int64
+	// DecodedBytes is the number of bytes of the body after decompression, equal to WireBytes if it wasn't decompressed
+	DecodedBytes int64
+}
+
+// ResponseSizeFunc is called with the sizes of the body of each response, see WithDecompressionAccounting
+type ResponseSizeFunc func(size ResponseSize)
+
+// WithDecompressionAccounting returns a ConfigurationOption that disables the transparent decompression of the transport
+// and decompresses gzip-encoded responses in the SDK instead, so that both the size of a body as received and its
+// decompressed size are known, e.g. for bandwidth accounting.
+//
+// Once a body has been read completely or closed, its ResponseSize is passed to f, if not nil, and added to the
+// statistics of the operation if WithStats is set. This includes the requests made to obtain access tokens.
+// The responses are decompressed transparently either way, the option only changes where it happens.
+//
+// If the Accept-Encoding header of a request is set, e.g. by a middleware, the response isn't decompressed,
+// as with the transport, and its DecodedBytes are its WireBytes.
+func WithDecompressionAccounting(f ResponseSizeFunc) ConfigurationOption {
+	return func(config *Configuration) error {
+		config.DecompressionAccounting = true
+		config.ResponseSizeFunc = f
+		return nil
+	}
+}
+
+// decompressionRoundTripper requests gzip-encoded responses and decompresses them, accounting the sizes of the bodies.
+// As the transport only decompresses responses if it requested the encoding itself, it leaves them untouched.
+type decompressionRoundTripper struct {
+	rt       http.RoundTripper
+	stats    *Stats
+	sizeFunc ResponseSizeFunc
+}
+
+func (d *decompressionRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Same conditions as for the transparent decompression of http.Transport
+	requestedGzip := req.Header.Get("Accept-Encoding") == "" && req.Header.Get("Range") == "" && req.Method != http.MethodHead
+	if requestedGzip {
+		req = req.Clone(req.Context())
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	resp, err := d.rt.RoundTrip(req)
+	if err != nil || resp.Body == nil || resp.Body == http.NoBody {
+		return resp, err
+	}
+
+	op, _ := GetOperation(req.Context())
+	body := &accountingBody{
+		rc:     resp.Body,
+		wire:   &countingReader{r: resp.Body},
+		report: d.report,
+		size:   ResponseSize{Operation: op, ContentEncoding: resp.Header.Get("Content-Encoding")},
+	}
+	if requestedGzip && body.size.ContentEncoding == "gzip" {
+		body.gzip = true
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+	}
+	resp.Body = body
+	return resp, nil
+}
+
+func (d *decompressionRoundTripper) report(size ResponseSize) {
+	if d.stats != nil {
+		d.stats.RecordResponseSize(size.Operation, size.WireBytes, size.DecodedBytes)
+	}
+	if d.sizeFunc != nil {
+		d.sizeFunc(size)
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// accountingBody reads a body, decompressing it if gzip is set, and reports its sizes once it was read completely or closed
+type accountingBody struct {
+	rc     io.ReadCloser
+	wire   *countingReader
+	gzip   bool
+	zr     *gzip.Reader
+	size   ResponseSize
+	report func(size ResponseSize)
+	once   sync.Once
+}
+
+func (b *accountingBody) Read(p []byte) (int, error) {
+	var r io.Reader = b.wire
+	if b.gzip {
+		// The gzip reader is created lazily, as it already reads the header
+		if b.zr == nil {
+			zr, err := gzip.NewReader(b.wire)
+			if err != nil {
+				return 0, err
+			}
+			b.zr = zr
+		}
+		r = b.zr
+	}
+	n, err := r.Read(p)
+	b.size.DecodedBytes += int64(n)
+	if err == io.EOF {
+		b.done()
+	}
+	return n, err
+}
+
+func (b *accountingBody) Close() error {
+	b.done()
+	return b.rc.Close()
+}
+
+func (b *accountingBody) done() {
+	b.once.Do(func() {
+		b.size.WireBytes = b.wire.n
+		b.report(b.size)
+	})
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithDecompressionAccounting(t *testing.T) {
+	body := strings.Repeat(`{"name":"zone"}`, 100)
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	_, _ = zw.Write([]byte(body))
+	_ = zw.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") == "gzip" {
+			w.Header().Set("Content-Encoding", "gzip")
+			_, _ = w.Write(compressed.Bytes())
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	for _, tt := range []struct {
+		desc           string
+		acceptEncoding string
+		rangeHeader    string
+		wantBody       string
+		wantSize       ResponseSize
+	}{
+		{
+			desc:     "gzip",
+			wantBody: body,
+			wantSize: ResponseSize{ContentEncoding: "gzip", WireBytes: int64(compressed.Len()), DecodedBytes: int64(len(body))},
+		},
+		{
+			desc:        "range_request",
+			rangeHeader: "bytes=0-",
+			wantBody:    body,
+			wantSize:    ResponseSize{WireBytes: int64(len(body)), DecodedBytes: int64(len(body))},
+		},
+		{
+			desc:           "accept_encoding_set_by_caller",
+			acceptEncoding: "gzip",
+			wantBody:       compressed.String(),
+			wantSize:       ResponseSize{ContentEncoding: "gzip", WireBytes: int64(compressed.Len()), DecodedBytes: int64(compressed.Len())},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var sizes []ResponseSize
+			stats := &Stats{}
+			cfg := &Configuration{}
+			for _, opt := range []ConfigurationOption{WithStats(stats), WithDecompressionAccounting(func(size ResponseSize) { sizes = append(sizes, size) })} {
+				if err := opt(cfg); err != nil {
+					t.Fatalf("applying option: %v", err)
+				}
+			}
+			client := &http.Client{Transport: cfg.HTTPTransport()}
+
+			op := Operation{Service: "dns", Name: "GetZone"}
+			req, err := http.NewRequestWithContext(WithOperation(context.Background(), op.Service, op.Name), http.MethodGet, server.URL, http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			if tt.rangeHeader != "" {
+				req.Header.Set("Range", tt.rangeHeader)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			got, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				t.Fatalf("reading body: %v", err)
+			}
+
+			if string(got) != tt.wantBody {
+				t.Errorf("unexpected body %q", got)
+			}
+			if tt.wantSize.ContentEncoding == "gzip" && tt.acceptEncoding == "" && resp.Header.Get("Content-Encoding") != "" {
+				t.Errorf("expected the Content-Encoding header to be removed")
+			}
+			tt.wantSize.Operation = op
+			if len(sizes) != 1 || sizes[0] != tt.wantSize {
+				t.Fatalf("expected sizes %+v, got %+v", tt.wantSize, sizes)
+			}
+			snapshot := stats.Snapshot()[op]
+			if snapshot.WireBytes != tt.wantSize.WireBytes || snapshot.DecodedBytes != tt.wantSize.DecodedBytes {
+				t.Errorf("unexpected statistics %+v", snapshot)
+			}
+		})
+	}
+}
+
+func TestHTTPTransportWithoutDecompressionAccounting(t *testing.T) {
+	cfg := &Configuration{}
+	if rt := cfg.HTTPTransport(); rt != nil {
+		t.Errorf("expected the default transport, got %T", rt)
+	}
+}
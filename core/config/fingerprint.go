@@ -0,0 +1,108 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+)
+
+// RequestFingerprint returns a hash of the method, the path, the query parameters and the body of req, e.g. to detect
+// duplicate requests. The query parameters are sorted by key and value, so their order doesn't matter. The host, the
+// headers and the context of req are not part of it, so the fingerprint of a request is the same across processes
+// and regions, and independent of the credentials.
+//
+// The body is read with GetBody, it returns an error for a request with a body which can't be read without consuming
+// it, e.g. a streamed upload.
+func RequestFingerprint(req *http.Request) (string, error) {
+	h := sha256.New()
+	// The parts are prefixed with their length, so that they can't be shifted into each other
+	writePart := func(b []byte) {
+		_, _ = fmt.Fprintf(h, "%d:", len(b))
+		_, _ = h.Write(b)
+	}
+	writePart([]byte(req.Method))
+	writePart([]byte(req.URL.EscapedPath()))
+
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			writePart([]byte(key))
+			writePart([]byte(value))
+		}
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return "", fmt.Errorf("the body of the request can't be read without consuming it")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return "", fmt.Errorf("reading the body of the request: %w", err)
+		}
+		defer body.Close() //nolint:errcheck // the body is only read
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return "", fmt.Errorf("reading the body of the request: %w", err)
+		}
+		writePart(b)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// FingerprintOnlyError is returned instead of sending a request by the API clients for the requests with a context
+// from WithFingerprintOnly, with the fingerprint of the request
+type FingerprintOnlyError struct {
+	Fingerprint string
+}
+
+func (e *FingerprintOnlyError) Error() string {
+	return fmt.Sprintf("request not sent, fingerprint %s", e.Fingerprint)
+}
+
+type fingerprintOnlyContextKey struct{}
+
+// WithFingerprintOnly returns a copy of ctx with which the API clients build the requests and return their fingerprint
+// as FingerprintOnlyError without sending them. It is used by the Fingerprint method of the requests of the generated
+// API clients.
+func WithFingerprintOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, fingerprintOnlyContextKey{}, true)
+}
+
+// FingerprintOnly returns a FingerprintOnlyError with the fingerprint of req, or the error of RequestFingerprint,
+// if the context of req is from WithFingerprintOnly. Otherwise, it returns nil and req must be sent.
+// It is called by the generated API clients before sending a request.
+func FingerprintOnly(req *http.Request) error {
+	if only, _ := req.Context().Value(fingerprintOnlyContextKey{}).(bool); !only {
+		return nil
+	}
+	fingerprint, err := RequestFingerprint(req)
+	if err != nil {
+		return fmt.Errorf("fingerprinting the request: %w", err)
+	}
+	return &FingerprintOnlyError{Fingerprint: fingerprint}
+}
+
+// FingerprintFromError returns the fingerprint of a FingerprintOnlyError, or err if it isn't one, e.g. if the
+// request could not be built because a required parameter is missing
+func FingerprintFromError(err error) (string, error) {
+	var fingerprintErr *FingerprintOnlyError
+	if errors.As(err, &fingerprintErr) {
+		return fingerprintErr.Fingerprint, nil
+	}
+	if err == nil {
+		return "", fmt.Errorf("the request was not fingerprinted")
+	}
+	return "", err
+}
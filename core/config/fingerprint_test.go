@@ -0,0 +1,86 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRequestFingerprint(t *testing.T) {
+	newRequest := func(method, url, body string) *http.Request {
+		t.Helper()
+		var r io.Reader
+		if body != "" {
+			r = strings.NewReader(body)
+		}
+		req, err := http.NewRequest(method, url, r)
+		if err != nil {
+			t.Fatalf("creating request: %v", err)
+		}
+		return req
+	}
+	fingerprint := func(req *http.Request) string {
+		t.Helper()
+		fp, err := RequestFingerprint(req)
+		if err != nil {
+			t.Fatalf("RequestFingerprint failed: %v", err)
+		}
+		return fp
+	}
+
+	base := fingerprint(newRequest(http.MethodPost, "https://dns.api.stackit.cloud/v1/projects/pid/zones?b=2&a=1&a=0", `{"name":"a"}`))
+	if base != fingerprint(newRequest(http.MethodPost, "https://dns.api.eu01.stackit.cloud/v1/projects/pid/zones?a=0&b=2&a=1", `{"name":"a"}`)) {
+		t.Errorf("expected the fingerprint not to depend on the host and the order of the query parameters")
+	}
+	req := newRequest(http.MethodPost, "https://dns.api.stackit.cloud/v1/projects/pid/zones?b=2&a=1&a=0", `{"name":"a"}`)
+	req.Header.Set("Authorization", "Bearer token")
+	if base != fingerprint(req) {
+		t.Errorf("expected the fingerprint not to depend on the headers")
+	}
+	for _, other := range []*http.Request{
+		newRequest(http.MethodPut, "https://dns.api.stackit.cloud/v1/projects/pid/zones?b=2&a=1&a=0", `{"name":"a"}`),
+		newRequest(http.MethodPost, "https://dns.api.stackit.cloud/v1/projects/pid2/zones?b=2&a=1&a=0", `{"name":"a"}`),
+		newRequest(http.MethodPost, "https://dns.api.stackit.cloud/v1/projects/pid/zones?b=2&a=1", `{"name":"a"}`),
+		newRequest(http.MethodPost, "https://dns.api.stackit.cloud/v1/projects/pid/zones?b=2&a=1&a=0", `{"name":"b"}`),
+	} {
+		if fingerprint(other) == base {
+			t.Errorf("expected a different fingerprint for %s %s", other.Method, other.URL)
+		}
+	}
+	// A known value, so that the fingerprint doesn't change between processes and releases
+	if got := fingerprint(newRequest(http.MethodGet, "https://dns.api.stackit.cloud/v1/projects/pid/zones", "")); got != "c2319cb518127673a6c4546239072cf84f9c6ee559ed574def3b6b997ee67bed" {
+		t.Errorf("expected a stable fingerprint, got %s", got)
+	}
+
+	streamed := newRequest(http.MethodPost, "https://dns.api.stackit.cloud/v1/upload", "")
+	streamed.Body = io.NopCloser(strings.NewReader("data"))
+	if _, err := RequestFingerprint(streamed); err == nil {
+		t.Errorf("expected an error for a body which can't be read again")
+	}
+}
+
+func TestFingerprintOnly(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://dns.api.stackit.cloud/v1/projects/pid/zones", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if err := FingerprintOnly(req); err != nil {
+		t.Fatalf("expected the request to be sent, got %v", err)
+	}
+
+	err = FingerprintOnly(req.WithContext(WithFingerprintOnly(context.Background())))
+	fp, fpErr := FingerprintFromError(err)
+	if expected, _ := RequestFingerprint(req); fpErr != nil || fp != expected {
+		t.Fatalf("expected the fingerprint %s, got %s, %v", expected, fp, fpErr)
+	}
+
+	if _, err := FingerprintFromError(errors.New("missing parameter")); err == nil || err.Error() != "missing parameter" {
+		t.Fatalf("expected the error, got %v", err)
+	}
+	if _, err := FingerprintFromError(nil); err == nil {
+		t.Fatalf("expected an error if the request was not fingerprinted")
+	}
+}
@@ -0,0 +1,143 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+)
+
+// httpDumpMutex serializes the dumps, as the clients commonly share a writer, e.g. os.Stderr
+var httpDumpMutex sync.Mutex
+
+type httpDumpContextKey struct{}
+
+type httpDumpSetting struct {
+	w io.Writer
+}
+
+// WithHTTPDump returns a ConfigurationOption that writes a dump of each request made by the client and of its
+// response to w, as sent and received on the wire, e.g. to troubleshoot an incompatibility with an API. The requests
+// made by retries are dumped as well. The values of the Authorization, Cookie and other credential headers are
+// scrubbed, the bodies are dumped as they are, so the dumps may still contain personal data or secrets.
+//
+// The dump can be turned on or off per call with WithHTTPDumpWriter. As the whole response body is read into memory
+// to be dumped, this is meant for debugging only.
+func WithHTTPDump(w io.Writer) ConfigurationOption {
+	return func(config *Configuration) error {
+		if w == nil {
+			return fmt.Errorf("dump writer cannot be nil")
+		}
+		config.HTTPDumpWriter = w
+		return nil
+	}
+}
+
+// WithHTTPDumpWriter returns a copy of ctx with which the requests are dumped to w, see WithHTTPDump, regardless of
+// the configuration of the client. If w is nil, the requests sent with ctx are not dumped.
+func WithHTTPDumpWriter(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, httpDumpContextKey{}, httpDumpSetting{w: w})
+}
+
+// HTTPDumpMiddleware returns a Middleware that dumps each request and its response to w, see WithHTTPDump.
+// w may be nil, then only the requests sent with a context from WithHTTPDumpWriter are dumped.
+func HTTPDumpMiddleware(w io.Writer) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &httpDumpRoundTripper{rt: rt, w: w}
+	}
+}
+
+type httpDumpRoundTripper struct {
+	rt http.RoundTripper
+	w  io.Writer
+}
+
+func (d *httpDumpRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	w := d.w
+	if setting, ok := req.Context().Value(httpDumpContextKey{}).(httpDumpSetting); ok {
+		w = setting.w
+	}
+	if w == nil {
+		return d.rt.RoundTrip(req)
+	}
+
+	body, err := requestBodyForDump(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.rt.RoundTrip(req)
+
+	// The request is dumped after it was sent, as the authentication sets the Authorization header
+	var dump bytes.Buffer
+	dumpReq := req.Clone(req.Context())
+	scrubDumpHeaders(dumpReq.Header)
+	dumpReq.Body = io.NopCloser(bytes.NewReader(body))
+	if b, dumpErr := httputil.DumpRequestOut(dumpReq, true); dumpErr != nil {
+		fmt.Fprintf(&dump, "<request %s %s not dumped: %v>\n", req.Method, req.URL.Redacted(), dumpErr)
+	} else {
+		dump.Write(b)
+	}
+	dump.WriteString("\n\n")
+	if err != nil {
+		fmt.Fprintf(&dump, "<no response: %v>\n\n", err)
+	} else {
+		dumpResponse(&dump, resp)
+	}
+
+	httpDumpMutex.Lock()
+	_, _ = w.Write(dump.Bytes())
+	httpDumpMutex.Unlock()
+	return resp, err
+}
+
+// requestBodyForDump returns the body of req. If it can't be read from a copy, see http.Request.GetBody, it is read
+// and replaced by a copy.
+func requestBodyForDump(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			defer body.Close() //nolint:errcheck // a copy of the body
+			return io.ReadAll(body)
+		}
+	}
+	b, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read request body to dump: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+	return b, nil
+}
+
+// dumpResponse writes the dump of resp to dump. The body of resp is replaced by a copy, which can still be read.
+func dumpResponse(dump *bytes.Buffer, resp *http.Response) {
+	header := resp.Header
+	resp.Header = header.Clone()
+	scrubDumpHeaders(resp.Header)
+	b, err := httputil.DumpResponse(resp, true)
+	resp.Header = header
+	if err != nil {
+		fmt.Fprintf(dump, "<response %s not dumped: %v>\n\n", resp.Status, err)
+		return
+	}
+	dump.Write(b)
+	dump.WriteString("\n\n")
+}
+
+// scrubDumpHeaders replaces the values of the credential headers in h, see WithRequestLogging
+func scrubDumpHeaders(h http.Header) {
+	for name := range h {
+		if redactedLogHeaders[http.CanonicalHeaderKey(name)] || isRedactedLogField(name) {
+			h[name] = []string{redactedLogValue}
+		}
+	}
+}
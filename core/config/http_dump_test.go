@@ -0,0 +1,122 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHTTPDumpMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "invalid field", "request": ` + string(body) + `}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		desc       string
+		clientDump bool
+		ctxDump    string
+		wantDump   bool
+	}{
+		{"client", true, "", true},
+		{"no_dump", false, "", false},
+		{"context_enabled", false, "on", true},
+		{"context_disabled", true, "off", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var clientBuf, ctxBuf bytes.Buffer
+			var w io.Writer
+			if tt.clientDump {
+				w = &clientBuf
+			}
+			// The authentication below the middleware sets the Authorization header of the request
+			auth := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req.Header.Set("Authorization", "Bearer secret-token")
+				return http.DefaultTransport.RoundTrip(req)
+			})
+			rt := HTTPDumpMiddleware(w)(auth)
+
+			ctx := context.Background()
+			if tt.ctxDump != "" {
+				var ctxW io.Writer
+				if tt.ctxDump == "on" {
+					ctxW = &ctxBuf
+				}
+				ctx = WithHTTPDumpWriter(ctx, ctxW)
+			}
+			// The body has no GetBody, so it is replaced by a copy
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/zones", io.NopCloser(strings.NewReader(`{"name":"zone"}`)))
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("round trip: %v", err)
+			}
+			body, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil || !strings.Contains(string(body), `"request": {"name":"zone"}`) {
+				t.Fatalf("expected the request body to be sent and the response body to be readable, got %q, %v", body, err)
+			}
+			if resp.Header.Get("Set-Cookie") != "session=secret-cookie" {
+				t.Fatalf("expected the response headers to be kept, got %v", resp.Header)
+			}
+
+			dump := clientBuf.String() + ctxBuf.String()
+			if !tt.wantDump {
+				if dump != "" {
+					t.Fatalf("expected no dump, got %s", dump)
+				}
+				return
+			}
+			for _, want := range []string{"POST /v1/zones HTTP/1.1", `{"name":"zone"}`, "Authorization: <redacted>", "HTTP/1.1 400 Bad Request", "Set-Cookie: <redacted>", `"message": "invalid field"`} {
+				if !strings.Contains(dump, want) {
+					t.Errorf("expected the dump to contain %q, got %s", want, dump)
+				}
+			}
+			if strings.Contains(dump, "secret") {
+				t.Errorf("expected the credential headers to be scrubbed, got %s", dump)
+			}
+		})
+	}
+}
+
+func TestHTTPDumpMiddlewareError(t *testing.T) {
+	var buf bytes.Buffer
+	rt := HTTPDumpMiddleware(&buf)(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, io.ErrUnexpectedEOF
+	}))
+	req, err := http.NewRequest(http.MethodGet, "https://dns.api.stackit.cloud/v1/zones", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Fatalf("expected the error of the transport")
+	}
+	if dump := buf.String(); !strings.Contains(dump, "GET /v1/zones HTTP/1.1") || !strings.Contains(dump, "<no response: unexpected EOF>") {
+		t.Fatalf("unexpected dump %s", dump)
+	}
+}
+
+func TestWithHTTPDump(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithHTTPDump(nil)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil writer")
+	}
+	var buf bytes.Buffer
+	if err := WithHTTPDump(&buf)(cfg); err != nil {
+		t.Fatalf("WithHTTPDump() error = %v", err)
+	}
+	if cfg.HTTPDumpWriter != &buf {
+		t.Fatalf("unexpected configuration %+v", cfg)
+	}
+}
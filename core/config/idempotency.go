@@ -0,0 +1,84 @@
+package config
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+// WithIdempotencyKeys returns a ConfigurationOption that sends each POST, PUT and PATCH request of the client with
+// an idempotency key in header, or in clients.DefaultIdempotencyKeyHeader if header is empty, so that a create
+// request which is retried doesn't create a resource twice. The key is generated per call, a random UUID, unless it
+// is set in the context of the call with clients.WithIdempotencyKey or in the header of the request. All attempts
+// of a call are sent with the same key, and they are retried by WithRetryPolicy like idempotent requests.
+//
+// Without this option, only the keys set with clients.WithIdempotencyKey are sent. The API must support the header,
+// otherwise it is ignored and a retried request may still be applied twice.
+func WithIdempotencyKeys(header string) ConfigurationOption {
+	return func(config *Configuration) error {
+		if header == "" {
+			header = clients.DefaultIdempotencyKeyHeader
+		}
+		config.IdempotencyKeys = true
+		config.IdempotencyKeyHeader = header
+		return nil
+	}
+}
+
+// IdempotencyKeyMiddleware returns a Middleware which sends the POST, PUT and PATCH requests with an idempotency key
+// in header, see WithIdempotencyKeys. If generate is false, only the keys set with clients.WithIdempotencyKey are sent.
+// The generated keys are drawn from randSource, or from crypto/rand if it is nil.
+func IdempotencyKeyMiddleware(generate bool, header string, randSource io.Reader) Middleware {
+	if header == "" {
+		header = clients.DefaultIdempotencyKeyHeader
+	}
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &idempotencyKeyRoundTripper{rt: rt, generate: generate, header: header, randSource: randSource}
+	}
+}
+
+type idempotencyKeyRoundTripper struct {
+	rt         http.RoundTripper
+	generate   bool
+	header     string
+	randSource io.Reader
+}
+
+func (i *idempotencyKeyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+	default:
+		return i.rt.RoundTrip(req)
+	}
+
+	key := req.Header.Get(i.header)
+	if key == "" {
+		key, _ = clients.GetIdempotencyKey(req.Context())
+	}
+	if key == "" && i.generate {
+		id, err := i.newKey()
+		if err != nil {
+			return nil, err
+		}
+		key = id.String()
+	}
+	if key == "" {
+		return i.rt.RoundTrip(req)
+	}
+
+	// The key is added to the context, so that the retries know the request is idempotent.
+	// The request of the caller must not be changed.
+	req = req.Clone(clients.WithIdempotencyKey(req.Context(), key))
+	req.Header.Set(i.header, key)
+	return i.rt.RoundTrip(req)
+}
+
+func (i *idempotencyKeyRoundTripper) newKey() (uuid.UUID, error) {
+	if i.randSource != nil {
+		return uuid.NewRandomFromReader(i.randSource)
+	}
+	return uuid.NewRandom()
+}
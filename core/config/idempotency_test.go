@@ -0,0 +1,122 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+func TestIdempotencyKeyMiddleware(t *testing.T) {
+	for _, tt := range []struct {
+		desc      string
+		generate  bool
+		method    string
+		header    string
+		ctxKey    string
+		expected  string
+		generated bool
+	}{
+		{"generated", true, http.MethodPost, "", "", "", true},
+		{"from_context", false, http.MethodPost, "", "ctx-key", "ctx-key", false},
+		{"from_header", true, http.MethodPatch, "header-key", "ctx-key", "header-key", false},
+		{"disabled", false, http.MethodPost, "", "", "", false},
+		{"read_request", true, http.MethodGet, "", "ctx-key", "", false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var got string
+			var inContext bool
+			rt := IdempotencyKeyMiddleware(tt.generate, "", nil)(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				got = req.Header.Get(clients.DefaultIdempotencyKeyHeader)
+				key, ok := clients.GetIdempotencyKey(req.Context())
+				inContext = ok && key == got
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+			}))
+			ctx := context.Background()
+			if tt.ctxKey != "" {
+				ctx = clients.WithIdempotencyKey(ctx, tt.ctxKey)
+			}
+			req, err := http.NewRequestWithContext(ctx, tt.method, "https://dns.api.stackit.cloud/v1/projects/p/zones", http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			if tt.header != "" {
+				req.Header.Set(clients.DefaultIdempotencyKeyHeader, tt.header)
+			}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("round trip: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			switch {
+			case tt.generated:
+				if len(got) != 36 {
+					t.Fatalf("expected a generated UUID, got %q", got)
+				}
+			case got != tt.expected:
+				t.Fatalf("expected the key %q, got %q", tt.expected, got)
+			}
+			if got != "" && !inContext {
+				t.Fatalf("expected the key in the context of the request")
+			}
+			if tt.header == "" && req.Header.Get(clients.DefaultIdempotencyKeyHeader) != "" {
+				t.Fatalf("expected the request of the caller to be unchanged")
+			}
+		})
+	}
+}
+
+func TestIdempotencyKeysRetries(t *testing.T) {
+	cfg := &Configuration{}
+	for _, opt := range []ConfigurationOption{
+		WithIdempotencyKeys("X-Request-Token"),
+		WithRetryPolicy(clients.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	} {
+		if err := opt(cfg); err != nil {
+			t.Fatalf("applying option: %v", err)
+		}
+	}
+	var keys []string
+	auth := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		keys = append(keys, req.Header.Get("X-Request-Token"))
+		status := http.StatusServiceUnavailable
+		if len(keys) == 3 {
+			status = http.StatusCreated
+		}
+		return &http.Response{StatusCode: status, Body: http.NoBody, Header: http.Header{}, Request: req}, nil
+	})
+	rt := AssembleTransport(cfg, auth)
+
+	// The create request is retried like an idempotent request, with the same key for all attempts
+	req, err := http.NewRequest(http.MethodPost, "https://dns.api.stackit.cloud/v1/projects/p/zones", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = resp.Body.Close()
+	if len(keys) != 3 || keys[0] == "" || keys[1] != keys[0] || keys[2] != keys[0] {
+		t.Fatalf("expected 3 attempts with the same key, got %q", keys)
+	}
+
+	// Each call gets a new key
+	first := keys[0]
+	keys = nil
+	req, err = http.NewRequest(http.MethodPost, "https://dns.api.stackit.cloud/v1/projects/p/zones", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err = rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = resp.Body.Close()
+	if len(keys) == 0 || keys[0] == first {
+		t.Fatalf("expected a new key for the second call, got %q", keys)
+	}
+}
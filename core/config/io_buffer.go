@@ -0,0 +1,47 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+// WithIOBufferSize returns a ConfigurationOption that sets the size of the buffers of the streaming helpers of the
+// client, by default clients.DefaultIOBufferSize (1 MiB): uploads of unknown length, see clients.UploadBody, are read
+// in chunks of up to n bytes, and the responses decoded with WithStreamingListDecode are read through a buffer of n
+// bytes.
+//
+// Larger buffers reduce the number of reads, which can improve the throughput on high-bandwidth links, but each
+// upload or streamed response in progress holds a buffer of n bytes in memory. The other requests and responses,
+// which are read into memory as a whole, aren't affected.
+func WithIOBufferSize(n int) ConfigurationOption {
+	return func(config *Configuration) error {
+		if n <= 0 {
+			return fmt.Errorf("IO buffer size must be positive, got %d", n)
+		}
+		config.IOBufferSize = n
+		return nil
+	}
+}
+
+// NewUploadRequest returns a request with method and url which streams upload with the buffer size of
+// WithIOBufferSize, see clients.UploadBody.NewBufferedRequest. The generated API clients use it for the requests with
+// an UploadBody.
+func (c *Configuration) NewUploadRequest(upload *clients.UploadBody, method, url string) (*http.Request, error) {
+	return upload.NewBufferedRequest(method, url, c.ioBufferSize())
+}
+
+// bufferedReader returns r read through a buffer of the size of WithIOBufferSize
+func (c *Configuration) bufferedReader(r io.Reader) io.Reader {
+	return bufio.NewReaderSize(r, c.ioBufferSize())
+}
+
+func (c *Configuration) ioBufferSize() int {
+	if c.IOBufferSize > 0 {
+		return c.IOBufferSize
+	}
+	return clients.DefaultIOBufferSize
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+// readSizes records the sizes of the reads of a body of unknown length
+type readSizes struct {
+	r     io.Reader
+	sizes []int
+}
+
+func (r *readSizes) Read(p []byte) (int, error) {
+	r.sizes = append(r.sizes, len(p))
+	return r.r.Read(p)
+}
+
+func TestWithIOBufferSize(t *testing.T) {
+	for _, tt := range []struct {
+		desc         string
+		opts         []ConfigurationOption
+		expectedSize int
+	}{
+		{"default", nil, clients.DefaultIOBufferSize},
+		{"custom", []ConfigurationOption{WithIOBufferSize(4096)}, 4096},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			cfg := &Configuration{}
+			for _, opt := range tt.opts {
+				if err := opt(cfg); err != nil {
+					t.Fatalf("applying option: %v", err)
+				}
+			}
+
+			body := &readSizes{r: strings.NewReader(strings.Repeat("x", 10000))}
+			req, err := cfg.NewUploadRequest(&clients.UploadBody{Reader: body}, http.MethodPut, "https://example.com")
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			if _, err := req.Body.Read(make([]byte, 10)); err != nil {
+				t.Fatalf("reading body: %v", err)
+			}
+			if len(body.sizes) != 1 || body.sizes[0] != tt.expectedSize {
+				t.Errorf("expected a read of %d bytes, got %v", tt.expectedSize, body.sizes)
+			}
+		})
+	}
+
+	for _, n := range []int{0, -1} {
+		if err := WithIOBufferSize(n)(&Configuration{}); err == nil {
+			t.Errorf("expected an error for size %d", n)
+		}
+	}
+}
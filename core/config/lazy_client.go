@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+	"sync"
+)
+
+// LazyClient creates an API client on first use, e.g. in plugins which shouldn't contact the token endpoint at startup.
+// It is safe for concurrent use: the client is created exactly once, even if Get is first called by several goroutines at the same time,
+// and all of them wait for it. Any work which should also happen exactly once, like minting the initial token, belongs in newClient.
+//
+// If newClient fails, the error is returned by every call of Get and the client is not created again.
+type LazyClient[T any] struct {
+	once      sync.Once
+	newClient func() (T, error)
+	client    T
+	err       error
+}
+
+// NewLazyClient returns a LazyClient which creates its client with newClient, e.g.
+//
+//	lazy := config.NewLazyClient(func() (*dns.APIClient, error) {
+//		return dns.NewAPIClient(config.WithRegion("eu01"))
+//	})
+func NewLazyClient[T any](newClient func() (T, error)) *LazyClient[T] {
+	return &LazyClient[T]{newClient: newClient}
+}
+
+// Get returns the client, creating it on the first call
+func (l *LazyClient[T]) Get() (T, error) {
+	l.once.Do(func() {
+		if l.newClient == nil {
+			l.err = fmt.Errorf("lazy client has no constructor")
+			return
+		}
+		// The error is set first, so that it is kept if newClient panics
+		l.err = fmt.Errorf("creating the client panicked")
+		l.client, l.err = l.newClient()
+	})
+	return l.client, l.err
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazyClient(t *testing.T) {
+	type client struct{ id int32 }
+
+	var calls atomic.Int32
+	start := make(chan struct{})
+	lazy := NewLazyClient(func() (*client, error) {
+		<-start
+		return &client{id: calls.Add(1)}, nil
+	})
+
+	var wg sync.WaitGroup
+	clients := make([]*client, 50)
+	errs := make([]error, 50)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clients[i], errs[i] = lazy.Get()
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected the client to be created once, got %d", calls.Load())
+	}
+	for i := range clients {
+		if errs[i] != nil {
+			t.Fatalf("expected no error, got %v", errs[i])
+		}
+		if clients[i] != clients[0] {
+			t.Fatalf("expected all goroutines to get the same client")
+		}
+	}
+}
+
+func TestLazyClientError(t *testing.T) {
+	var calls atomic.Int32
+	wantErr := errors.New("token endpoint unavailable")
+	lazy := NewLazyClient(func() (*Configuration, error) {
+		calls.Add(1)
+		return nil, wantErr
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := lazy.Get(); !errors.Is(err, wantErr) {
+				t.Errorf("expected error %v, got %v", wantErr, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if _, err := lazy.Get(); !errors.Is(err, wantErr) {
+		t.Fatalf("expected error %v, got %v", wantErr, err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected the client to be created once, got %d", calls.Load())
+	}
+}
+
+func TestLazyClientPanic(t *testing.T) {
+	lazy := NewLazyClient(func() (*Configuration, error) {
+		panic("boom")
+	})
+
+	func() {
+		defer func() { _ = recover() }()
+		_, _ = lazy.Get()
+	}()
+
+	if _, err := lazy.Get(); err == nil {
+		t.Fatalf("expected an error after the constructor panicked")
+	}
+}
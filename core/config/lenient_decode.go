@@ -0,0 +1,216 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// WithLenientFieldDecode returns a ConfigurationOption that decodes the JSON responses leniently if they can't be
+// decoded into the response model, e.g. because the API returns a number for an enum field of one item of a list.
+// Instead of failing the request:
+//   - a field of an object which can't be decoded is left empty
+//   - an element of an array or a value of a map which can't be decoded is skipped, the other elements of an array of
+//     objects keep the fields which could be decoded
+//
+// The problems are collected in the DecodeWarnings of the context of the request, see WithDecodeWarnings, and are
+// otherwise discarded. A response which isn't valid JSON still fails. The lenient decoding uses encoding/json, also
+// if a decoder is set with WithJSONDecoder, and doesn't apply to the items streamed with WithListItems.
+//
+// By default, a response which can't be decoded fails the request.
+func WithLenientFieldDecode() ConfigurationOption {
+	return func(config *Configuration) error {
+		config.LenientFieldDecode = true
+		return nil
+	}
+}
+
+// DecodeWarning is a value of a response which couldn't be decoded with WithLenientFieldDecode
+type DecodeWarning struct {
+	// Path of the value in the response, e.g. "zones[3].state"
+	Path string
+	// Err is the error of the decoding of the value
+	Err error
+}
+
+func (w DecodeWarning) String() string {
+	return fmt.Sprintf("%s: %v", w.Path, w.Err)
+}
+
+// DecodeWarnings collects the problems of the lenient decoding of the responses of the requests sent with a context
+// from WithDecodeWarnings. It is safe for concurrent use.
+type DecodeWarnings struct {
+	mu       sync.Mutex
+	warnings []DecodeWarning
+}
+
+// List returns the problems collected so far
+func (w *DecodeWarnings) List() []DecodeWarning {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]DecodeWarning(nil), w.warnings...)
+}
+
+func (w *DecodeWarnings) add(warnings []DecodeWarning) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.warnings = append(w.warnings, warnings...)
+}
+
+type decodeWarningsContextKey struct{}
+
+// WithDecodeWarnings returns a copy of ctx which collects the problems of the lenient decoding of the responses of
+// the requests sent with it in the returned DecodeWarnings, see WithLenientFieldDecode:
+//
+//	ctx, warnings := config.WithDecodeWarnings(ctx)
+//	zones, err := client.ListZones(ctx, projectId).Execute()
+//	for _, w := range warnings.List() {
+//		log.Printf("skipped a value of the response: %s", w)
+//	}
+func WithDecodeWarnings(ctx context.Context) (context.Context, *DecodeWarnings) {
+	warnings := &DecodeWarnings{}
+	return context.WithValue(ctx, decodeWarningsContextKey{}, warnings), warnings
+}
+
+// DecodeResponseJSON deserializes the JSON response body data of a request sent with ctx into v with DecodeJSON, and
+// leniently if WithLenientFieldDecode is set and it fails. It is called by the generated API clients.
+func (c *Configuration) DecodeResponseJSON(ctx context.Context, data []byte, v any) error {
+	err := c.DecodeJSON(data, v)
+	if err == nil || c == nil || !c.LenientFieldDecode || !json.Valid(data) {
+		return err
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return err
+	}
+	// The values decoded before the error are discarded, so that the result doesn't depend on the decoder
+	rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
+	var warnings []DecodeWarning
+	decodeLenient(data, rv.Elem(), "", &warnings)
+	if collector, ok := ctx.Value(decodeWarningsContextKey{}).(*DecodeWarnings); ok {
+		collector.add(warnings)
+	}
+	return nil
+}
+
+// decodeLenient decodes data into v, decoding the fields, elements and values of objects, arrays and maps one by one
+// if data can't be decoded as a whole. It reports whether v was decoded at least partially.
+func decodeLenient(data json.RawMessage, v reflect.Value, path string, warnings *[]DecodeWarning) bool {
+	target := reflect.New(v.Type())
+	err := json.Unmarshal(data, target.Interface())
+	if err == nil {
+		v.Set(target.Elem())
+		return true
+	}
+
+	t := v.Type()
+	if t.Kind() == reflect.Pointer {
+		elem := reflect.New(t.Elem())
+		if !decodeLenient(data, elem.Elem(), path, warnings) {
+			return false
+		}
+		v.Set(elem)
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		fields := jsonFields(t)
+		var object map[string]json.RawMessage
+		if len(fields) == 0 || json.Unmarshal(data, &object) != nil {
+			break
+		}
+		names := make([]string, 0, len(object))
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			index, ok := fields[name]
+			if !ok {
+				index, ok = foldedField(fields, name)
+			}
+			if ok {
+				decodeLenient(object[name], v.Field(index), joinPath(path, name), warnings)
+			}
+		}
+		return true
+	case reflect.Slice:
+		var elems []json.RawMessage
+		if json.Unmarshal(data, &elems) != nil {
+			break
+		}
+		if elems == nil {
+			return true
+		}
+		slice := reflect.MakeSlice(t, 0, len(elems))
+		for i, raw := range elems {
+			elem := reflect.New(t.Elem()).Elem()
+			if decodeLenient(raw, elem, fmt.Sprintf("%s[%d]", path, i), warnings) {
+				slice = reflect.Append(slice, elem)
+			}
+		}
+		v.Set(slice)
+		return true
+	case reflect.Map:
+		var values map[string]json.RawMessage
+		if t.Key().Kind() != reflect.String || json.Unmarshal(data, &values) != nil {
+			break
+		}
+		if values == nil {
+			return true
+		}
+		m := reflect.MakeMapWithSize(t, len(values))
+		for key, raw := range values {
+			value := reflect.New(t.Elem()).Elem()
+			if decodeLenient(raw, value, joinPath(path, key), warnings) {
+				m.SetMapIndex(reflect.ValueOf(key).Convert(t.Key()), value)
+			}
+		}
+		v.Set(m)
+		return true
+	}
+	*warnings = append(*warnings, DecodeWarning{Path: path, Err: err})
+	return false
+}
+
+// jsonFields returns the indices of the exported fields of a struct by their JSON names
+func jsonFields(t reflect.Type) map[string]int {
+	fields := map[string]int{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = i
+	}
+	return fields
+}
+
+// foldedField returns the field matching name case-insensitively, as encoding/json does
+func foldedField(fields map[string]int, name string) (int, bool) {
+	for fieldName, index := range fields {
+		if strings.EqualFold(fieldName, name) {
+			return index, true
+		}
+	}
+	return 0, false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// lenientState fails to decode values which aren't strings, like the enums of the generated models
+type lenientState string
+
+func (s *lenientState) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("state: %w", err)
+	}
+	*s = lenientState(value)
+	return nil
+}
+
+type lenientItem struct {
+	Id     *string           `json:"id,omitempty"`
+	State  *lenientState     `json:"state,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type lenientList struct {
+	Items      []lenientItem `json:"items"`
+	TotalPages *int64        `json:"totalPages,omitempty"`
+	Tags       []string      `json:"tags,omitempty"`
+}
+
+func lenientPtr[T any](v T) *T {
+	return &v
+}
+
+func TestDecodeResponseJSON(t *testing.T) {
+	body := []byte(`{
+		"items": [
+			{"id": "a", "state": "ACTIVE"},
+			{"id": "b", "state": 42, "labels": {"env": "prod", "broken": 1}},
+			{"ID": "c", "state": "ACTIVE"}
+		],
+		"totalPages": "3",
+		"tags": ["x", 1, "y"]
+	}`)
+	expected := lenientList{
+		Items: []lenientItem{
+			{Id: lenientPtr("a"), State: lenientPtr(lenientState("ACTIVE"))},
+			{Id: lenientPtr("b"), Labels: map[string]string{"env": "prod"}},
+			{Id: lenientPtr("c"), State: lenientPtr(lenientState("ACTIVE"))},
+		},
+		Tags: []string{"x", "y"},
+	}
+
+	strict := &Configuration{}
+	var v lenientList
+	if err := strict.DecodeResponseJSON(context.Background(), body, &v); err == nil {
+		t.Fatalf("expected the strict decoding to fail")
+	}
+
+	lenient := &Configuration{}
+	if err := WithLenientFieldDecode()(lenient); err != nil {
+		t.Fatalf("WithLenientFieldDecode failed: %v", err)
+	}
+	ctx, warnings := WithDecodeWarnings(context.Background())
+	v = lenientList{}
+	if err := lenient.DecodeResponseJSON(ctx, body, &v); err != nil {
+		t.Fatalf("expected the lenient decoding to succeed, got %v", err)
+	}
+	if diff := cmp.Diff(expected, v); diff != "" {
+		t.Fatalf("unexpected result (-want +got):\n%s", diff)
+	}
+
+	var paths []string
+	for _, w := range warnings.List() {
+		if w.Err == nil {
+			t.Errorf("expected an error for %s", w.Path)
+		}
+		paths = append(paths, w.Path)
+	}
+	if diff := cmp.Diff([]string{"items[1].labels.broken", "items[1].state", "tags[1]", "totalPages"}, paths); diff != "" {
+		t.Fatalf("unexpected warnings (-want +got):\n%s", diff)
+	}
+
+	// Without a collector, the warnings are discarded
+	if err := lenient.DecodeResponseJSON(context.Background(), body, &lenientList{}); err != nil {
+		t.Fatalf("expected the lenient decoding to succeed, got %v", err)
+	}
+	// Invalid JSON still fails
+	if err := lenient.DecodeResponseJSON(ctx, []byte(`{"items": [`), &lenientList{}); err == nil {
+		t.Fatalf("expected an error for invalid JSON")
+	}
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// WithStreamingListDecode returns a ConfigurationOption that allows the list operations of the API client to decode
+// their response while it is received, passing the items to the function set with WithListItems one at a time instead
+// of decoding the whole list into memory. The requests without WithListItems are decoded as before.
+//
+// The other fields of the response, e.g. the total number of pages, are still returned by Execute.
+func WithStreamingListDecode() ConfigurationOption {
+	return func(config *Configuration) error {
+		config.StreamingListDecode = true
+		return nil
+	}
+}
+
+type listItemsContextKey struct{}
+
+// listItems decodes the items of the array field of a list response
+type listItems struct {
+	field  string
+	decode func(dec *json.Decoder) error
+}
+
+// WithListItems returns a context which makes a list operation started with it call fn for each item of the array
+// field of the response as it is decoded, e.g. "rrSets" for the record sets of dns.ListRecordSets. The field is left
+// empty in the response returned by Execute, so the memory used doesn't depend on the number of items.
+// If fn returns an error, the decoding stops and Execute returns the error.
+//
+// The API client must be configured with WithStreamingListDecode, otherwise the request fails.
+// Together with a paginator, e.g. pagination.NewResumableByPageNumber, the items of all pages can be listed
+// with constant memory.
+func WithListItems[T any](ctx context.Context, field string, fn func(item T) error) context.Context {
+	return context.WithValue(ctx, listItemsContextKey{}, &listItems{
+		field: field,
+		decode: func(dec *json.Decoder) error {
+			var item T
+			if err := dec.Decode(&item); err != nil {
+				return fmt.Errorf("decoding item: %w", err)
+			}
+			return fn(item)
+		},
+	})
+}
+
+// DecodeListStream decodes the successful response of a request started with a context from WithListItems into v,
+// passing the items of the array field to the function of WithListItems. The response body is closed.
+// It returns false without reading the response if the response is not successful or WithListItems isn't used,
+// in which case the response is decoded as usual.
+//
+// It is called by the generated API clients, see WithStreamingListDecode.
+func DecodeListStream(ctx context.Context, cfg *Configuration, resp *http.Response, v any) (bool, error) {
+	items, ok := ctx.Value(listItemsContextKey{}).(*listItems)
+	if !ok || resp.StatusCode >= 300 {
+		return false, nil
+	}
+	defer resp.Body.Close() //nolint:errcheck // the body is decoded
+
+	if !cfg.StreamingListDecode {
+		return true, fmt.Errorf("list items requested, but the client is not configured with WithStreamingListDecode")
+	}
+	if err := items.decodeResponse(cfg.bufferedReader(resp.Body), v); err != nil {
+		return true, fmt.Errorf("decoding list response: %w", err)
+	}
+	return true, nil
+}
+
+// decodeResponse decodes the JSON object read from r into v, except the array field whose items are passed to decode
+func (l *listItems) decodeResponse(r io.Reader, v any) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	// The other fields are small, e.g. the number of pages, so they are buffered and decoded at the end
+	rest := map[string]json.RawMessage{}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("unexpected token %v, expected a field name", token)
+		}
+
+		if key != l.field {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return err
+			}
+			rest[key] = raw
+			continue
+		}
+
+		token, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			continue
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("field %q is not an array", l.field)
+		}
+		for dec.More() {
+			if err := l.decode(dec); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(rest)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func expectDelim(dec *json.Decoder, expected json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != expected {
+		return fmt.Errorf("unexpected token %v, expected %v", token, expected)
+	}
+	return nil
+}
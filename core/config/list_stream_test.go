@@ -0,0 +1,148 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type listResponse struct {
+	Items      *[]listItem `json:"items,omitempty"`
+	TotalPages *int64      `json:"totalPages,omitempty"`
+}
+
+type listItem struct {
+	Id string `json:"id"`
+}
+
+func TestDecodeListStream(t *testing.T) {
+	for _, tt := range []struct {
+		desc               string
+		body               string
+		streaming          bool
+		noListItems        bool
+		statusCode         int
+		itemErr            error
+		expectedStreamed   bool
+		expectedErr        bool
+		expectedIds        []string
+		expectedTotalPages int64
+	}{
+		{
+			desc:               "items",
+			body:               `{"items":[{"id":"a"},{"id":"b"}],"totalPages":3}`,
+			streaming:          true,
+			expectedStreamed:   true,
+			expectedIds:        []string{"a", "b"},
+			expectedTotalPages: 3,
+		},
+		{
+			desc:               "items_last",
+			body:               `{"totalPages":1,"items":[{"id":"a"}]}`,
+			streaming:          true,
+			expectedStreamed:   true,
+			expectedIds:        []string{"a"},
+			expectedTotalPages: 1,
+		},
+		{
+			desc:               "null_items",
+			body:               `{"items":null,"totalPages":0}`,
+			streaming:          true,
+			expectedStreamed:   true,
+			expectedTotalPages: 0,
+		},
+		{
+			desc:             "items_not_an_array",
+			body:             `{"items":{"id":"a"}}`,
+			streaming:        true,
+			expectedStreamed: true,
+			expectedErr:      true,
+		},
+		{
+			desc:             "invalid_json",
+			body:             `{"items":[{"id":"a"}`,
+			streaming:        true,
+			expectedStreamed: true,
+			expectedErr:      true,
+			expectedIds:      []string{"a"},
+		},
+		{
+			desc:             "item_error",
+			body:             `{"items":[{"id":"a"},{"id":"b"}]}`,
+			streaming:        true,
+			itemErr:          errors.New("stop"),
+			expectedStreamed: true,
+			expectedErr:      true,
+			expectedIds:      []string{"a"},
+		},
+		{
+			desc:             "streaming_disabled",
+			body:             `{"items":[{"id":"a"}]}`,
+			expectedStreamed: true,
+			expectedErr:      true,
+		},
+		{
+			desc:        "no_list_items",
+			body:        `{"items":[{"id":"a"}]}`,
+			streaming:   true,
+			noListItems: true,
+		},
+		{
+			desc:       "error_response",
+			body:       `{"message":"not found"}`,
+			streaming:  true,
+			statusCode: http.StatusNotFound,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var ids []string
+			ctx := context.Background()
+			if !tt.noListItems {
+				ctx = WithListItems(ctx, "items", func(item listItem) error {
+					ids = append(ids, item.Id)
+					return tt.itemErr
+				})
+			}
+			cfg := &Configuration{}
+			if tt.streaming {
+				if err := WithStreamingListDecode()(cfg); err != nil {
+					t.Fatalf("configuring: %v", err)
+				}
+			}
+			statusCode := tt.statusCode
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+			resp := &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(tt.body))}
+
+			var v *listResponse
+			streamed, err := DecodeListStream(ctx, cfg, resp, &v)
+			if streamed != tt.expectedStreamed {
+				t.Fatalf("expected streamed %v, got %v", tt.expectedStreamed, streamed)
+			}
+			if (err != nil) != tt.expectedErr {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+			if tt.itemErr != nil && !errors.Is(err, tt.itemErr) {
+				t.Fatalf("expected error %v, got %v", tt.itemErr, err)
+			}
+			if diff := cmp.Diff(tt.expectedIds, ids); diff != "" {
+				t.Errorf("unexpected items: %s", diff)
+			}
+			if !tt.expectedStreamed || tt.expectedErr {
+				return
+			}
+			if v == nil || v.Items != nil {
+				t.Fatalf("expected a response without items, got %+v", v)
+			}
+			if v.TotalPages == nil || *v.TotalPages != tt.expectedTotalPages {
+				t.Errorf("expected %d total pages, got %v", tt.expectedTotalPages, v.TotalPages)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package config
+
+import "sync"
+
+var (
+	registeredOperationsMu sync.RWMutex
+	// registeredOperations are the operation ids of each service, see RegisterOperations
+	registeredOperations = map[string]map[string]struct{}{}
+)
+
+// RegisterOperations registers the operation ids of a service, e.g. to check the operations of the responses
+// registered with a stackitmock.Server. The generated API clients register their operations when their package is
+// initialized. Code annotating its own requests with WithOperation can register them as well.
+func RegisterOperations(service string, names ...string) {
+	registeredOperationsMu.Lock()
+	defer registeredOperationsMu.Unlock()
+	ops, ok := registeredOperations[service]
+	if !ok {
+		ops = make(map[string]struct{}, len(names))
+		registeredOperations[service] = ops
+	}
+	for _, name := range names {
+		ops[name] = struct{}{}
+	}
+}
+
+// IsRegisteredOperation reports whether the service of op registered its operations with RegisterOperations, and
+// whether op is one of them
+func IsRegisteredOperation(op Operation) (serviceRegistered, operationRegistered bool) {
+	registeredOperationsMu.RLock()
+	defer registeredOperationsMu.RUnlock()
+	ops, ok := registeredOperations[op.Service]
+	if !ok {
+		return false, false
+	}
+	_, ok = ops[op.Name]
+	return true, ok
+}
@@ -0,0 +1,27 @@
+package config
+
+import "testing"
+
+func TestRegisterOperations(t *testing.T) {
+	RegisterOperations("test-registry", "CreateZone")
+	RegisterOperations("test-registry", "GetZone")
+
+	for _, test := range []struct {
+		desc                  string
+		op                    Operation
+		wantServiceRegistered bool
+		wantOpRegistered      bool
+	}{
+		{"registered", Operation{Service: "test-registry", Name: "CreateZone"}, true, true},
+		{"registered_later", Operation{Service: "test-registry", Name: "GetZone"}, true, true},
+		{"unknown_operation", Operation{Service: "test-registry", Name: "CreateZon"}, true, false},
+		{"unknown_service", Operation{Service: "test-unregistered", Name: "CreateZone"}, false, false},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			serviceRegistered, opRegistered := IsRegisteredOperation(test.op)
+			if serviceRegistered != test.wantServiceRegistered || opRegistered != test.wantOpRegistered {
+				t.Fatalf("expected (%t, %t), got (%t, %t)", test.wantServiceRegistered, test.wantOpRegistered, serviceRegistered, opRegistered)
+			}
+		})
+	}
+}
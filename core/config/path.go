@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// TrailingSlashPolicy specifies whether the paths of the requests end with a slash, see WithTrailingSlashPolicy
+type TrailingSlashPolicy int
+
+const (
+	// TrailingSlashKeep sends the paths as in the API specification, the default
+	TrailingSlashKeep TrailingSlashPolicy = iota
+	// TrailingSlashAdd appends a slash to the paths which don't end with one
+	TrailingSlashAdd
+	// TrailingSlashRemove removes the trailing slash of the paths
+	TrailingSlashRemove
+)
+
+// WithTrailingSlashPolicy returns a ConfigurationOption that specifies whether the paths of the requests end with
+// a slash, e.g. for a gateway which only routes the paths with, or without, a trailing slash.
+// Duplicate slashes in the paths are always collapsed, see NormalizePath.
+func WithTrailingSlashPolicy(policy TrailingSlashPolicy) ConfigurationOption {
+	return func(config *Configuration) error {
+		if policy < TrailingSlashKeep || policy > TrailingSlashRemove {
+			return fmt.Errorf("invalid trailing slash policy %d", policy)
+		}
+		config.TrailingSlashPolicy = policy
+		return nil
+	}
+}
+
+// NormalizePath collapses the duplicate slashes in the path of u, e.g. of a server URL ending with a slash joined
+// with the path of an operation, and applies the trailing slash policy of WithTrailingSlashPolicy.
+// Escaped slashes, e.g. in path parameters, are kept.
+func (c *Configuration) NormalizePath(u *url.URL) {
+	path := u.EscapedPath()
+	for strings.Contains(path, "//") {
+		path = strings.ReplaceAll(path, "//", "/")
+	}
+	if c != nil && path != "" && path != "/" {
+		switch c.TrailingSlashPolicy {
+		case TrailingSlashAdd:
+			if !strings.HasSuffix(path, "/") {
+				path += "/"
+			}
+		case TrailingSlashRemove:
+			path = strings.TrimSuffix(path, "/")
+		}
+	}
+
+	unescaped, err := url.PathUnescape(path)
+	if err != nil {
+		// Can't happen, the path was escaped by u
+		return
+	}
+	u.Path, u.RawPath = unescaped, path
+}
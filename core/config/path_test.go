@@ -0,0 +1,47 @@
+package config
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestNormalizePath(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		url      string
+		policy   TrailingSlashPolicy
+		expected string
+	}{
+		{"unchanged", "https://dns.api.stackit.cloud/v1/projects/p/zones", TrailingSlashKeep, "https://dns.api.stackit.cloud/v1/projects/p/zones"},
+		{"base_url_trailing_slash", "https://dns.api.stackit.cloud//v1/projects/p/zones", TrailingSlashKeep, "https://dns.api.stackit.cloud/v1/projects/p/zones"},
+		{"base_url_path_trailing_slash", "https://gateway.example.com/dns///v1/projects/p/zones", TrailingSlashKeep, "https://gateway.example.com/dns/v1/projects/p/zones"},
+		{"keep_trailing_slash", "https://dns.api.stackit.cloud/v1/projects/p/zones/", TrailingSlashKeep, "https://dns.api.stackit.cloud/v1/projects/p/zones/"},
+		{"add", "https://dns.api.stackit.cloud/v1/projects/p/zones", TrailingSlashAdd, "https://dns.api.stackit.cloud/v1/projects/p/zones/"},
+		{"add_present", "https://dns.api.stackit.cloud/v1/projects/p/zones/", TrailingSlashAdd, "https://dns.api.stackit.cloud/v1/projects/p/zones/"},
+		{"remove", "https://dns.api.stackit.cloud//v1/projects/p/zones//", TrailingSlashRemove, "https://dns.api.stackit.cloud/v1/projects/p/zones"},
+		{"remove_root", "https://dns.api.stackit.cloud/", TrailingSlashRemove, "https://dns.api.stackit.cloud/"},
+		{"escaped_slashes", "https://objectstorage.api.stackit.cloud/v2/objects/a%2F%2Fb//acl", TrailingSlashKeep, "https://objectstorage.api.stackit.cloud/v2/objects/a%2F%2Fb/acl"},
+		{"query", "https://dns.api.stackit.cloud//v1/zones?filter=a//b", TrailingSlashAdd, "https://dns.api.stackit.cloud/v1/zones/?filter=a//b"},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			cfg := &Configuration{}
+			if err := WithTrailingSlashPolicy(tt.policy)(cfg); err != nil {
+				t.Fatalf("WithTrailingSlashPolicy failed: %v", err)
+			}
+			u, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatalf("parsing URL: %v", err)
+			}
+			cfg.NormalizePath(u)
+			if got := u.String(); got != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWithTrailingSlashPolicyInvalid(t *testing.T) {
+	if err := WithTrailingSlashPolicy(TrailingSlashPolicy(42))(&Configuration{}); err == nil {
+		t.Fatalf("expected an error for an invalid policy")
+	}
+}
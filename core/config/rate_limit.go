@@ -0,0 +1,160 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate limit headers returned by the APIs.
+// The API specifications don't document which services return them, so they are used whenever a response contains them.
+// Services which document a rate limit include auditlog (60 requests per minute) and stackitmarketplace (inquiries).
+const (
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// Values of X-RateLimit-Reset above this are a Unix timestamp, the ones below it the number of seconds until the reset
+const rateLimitResetEpochThreshold = 1_000_000_000
+
+// RateLimit is the rate limit of an API as returned in the headers of its last response
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window, or 0 if the response didn't contain it
+	Limit int
+	// Remaining is the number of requests remaining in the current window
+	Remaining int
+	// Reset is the time the current window ends, or the zero time if the response didn't contain it
+	Reset time.Time
+}
+
+// ParseRateLimit returns the rate limit contained in the headers of a response, if it contains X-RateLimit-Remaining.
+// X-RateLimit-Reset may either be the number of seconds until the reset, relative to now, or a Unix timestamp.
+func ParseRateLimit(header http.Header, now time.Time) (RateLimit, bool) {
+	remaining, err := strconv.Atoi(header.Get(HeaderRateLimitRemaining))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	rateLimit := RateLimit{Remaining: remaining}
+	if limit, err := strconv.Atoi(header.Get(HeaderRateLimitLimit)); err == nil {
+		rateLimit.Limit = limit
+	}
+	if reset, err := strconv.ParseFloat(header.Get(HeaderRateLimitReset), 64); err == nil && reset >= 0 {
+		if reset > rateLimitResetEpochThreshold {
+			rateLimit.Reset = time.Unix(0, int64(reset*float64(time.Second)))
+		} else {
+			rateLimit.Reset = now.Add(time.Duration(reset * float64(time.Second)))
+		}
+	}
+	return rateLimit, true
+}
+
+// RateLimitTracker keeps the rate limit of the last response of a client which contained rate limit headers.
+// It is safe for concurrent use.
+type RateLimitTracker struct {
+	adaptive bool
+	now      func() time.Time
+
+	mu     sync.Mutex
+	latest RateLimit
+	ok     bool
+}
+
+// NewRateLimitTracker returns a RateLimitTracker. If adaptive is true, the requests are delayed so that the remaining requests
+// are spread until the reset of the rate limit, and no request is sent if none remain until the reset.
+func NewRateLimitTracker(adaptive bool) *RateLimitTracker {
+	return &RateLimitTracker{
+		adaptive: adaptive,
+		now:      time.Now,
+	}
+}
+
+// Latest returns the rate limit of the last response which contained rate limit headers, if any
+func (t *RateLimitTracker) Latest() (RateLimit, bool) {
+	if t == nil {
+		return RateLimit{}, false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.latest, t.ok
+}
+
+// WithRateLimitTracking returns a ConfigurationOption that keeps the rate limit returned in the headers of the responses,
+// it can be read with the RateLimit method of the API clients. If adaptive is true, the client slows down to stay
+// within the remaining requests instead of being throttled with 429 Too Many Requests, see NewRateLimitTracker.
+func WithRateLimitTracking(adaptive bool) ConfigurationOption {
+	return func(config *Configuration) error {
+		config.RateLimitTracker = NewRateLimitTracker(adaptive)
+		return nil
+	}
+}
+
+// RateLimitMiddleware returns a Middleware that updates tracker with the rate limit headers of the responses
+func RateLimitMiddleware(tracker *RateLimitTracker) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &rateLimitRoundTripper{rt: rt, tracker: tracker}
+	}
+}
+
+type rateLimitRoundTripper struct {
+	rt      http.RoundTripper
+	tracker *RateLimitTracker
+}
+
+func (r *rateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.tracker.adaptive {
+		if err := r.tracker.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := r.rt.RoundTrip(req)
+	if resp != nil {
+		r.tracker.update(resp.Header)
+	}
+	return resp, err
+}
+
+func (t *RateLimitTracker) update(header http.Header) {
+	rateLimit, ok := ParseRateLimit(header, t.now())
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.latest = rateLimit
+	t.ok = true
+}
+
+// wait delays the request so that the remaining requests are spread until the reset
+func (t *RateLimitTracker) wait(ctx context.Context) error {
+	t.mu.Lock()
+	var delay time.Duration
+	if t.ok && !t.latest.Reset.IsZero() {
+		untilReset := t.latest.Reset.Sub(t.now())
+		if untilReset > 0 {
+			delay = untilReset / time.Duration(t.latest.Remaining+1)
+			if t.latest.Remaining <= 0 {
+				delay = untilReset
+			}
+		}
+		// Account for this request, so that concurrent requests are spread as well
+		if t.latest.Remaining > 0 {
+			t.latest.Remaining--
+		}
+	}
+	t.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
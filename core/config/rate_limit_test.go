@@ -0,0 +1,127 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		desc     string
+		header   map[string]string
+		expected RateLimit
+		ok       bool
+	}{
+		{
+			desc: "reset_in_seconds",
+			header: map[string]string{
+				HeaderRateLimitLimit:     "60",
+				HeaderRateLimitRemaining: "59",
+				HeaderRateLimitReset:     "30",
+			},
+			expected: RateLimit{Limit: 60, Remaining: 59, Reset: now.Add(30 * time.Second)},
+			ok:       true,
+		},
+		{
+			desc: "reset_as_timestamp",
+			header: map[string]string{
+				HeaderRateLimitRemaining: "0",
+				HeaderRateLimitReset:     strconv.FormatInt(now.Add(time.Minute).Unix(), 10),
+			},
+			expected: RateLimit{Remaining: 0, Reset: now.Add(time.Minute)},
+			ok:       true,
+		},
+		{
+			desc: "without_reset",
+			header: map[string]string{
+				HeaderRateLimitRemaining: "10",
+			},
+			expected: RateLimit{Remaining: 10},
+			ok:       true,
+		},
+		{
+			desc:   "no_headers",
+			header: map[string]string{},
+		},
+		{
+			desc: "invalid_remaining",
+			header: map[string]string{
+				HeaderRateLimitRemaining: "many",
+			},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.header {
+				header.Set(k, v)
+			}
+			got, ok := ParseRateLimit(header, now)
+			if ok != tt.ok {
+				t.Fatalf("expected ok %v, got %v", tt.ok, ok)
+			}
+			if got.Limit != tt.expected.Limit || got.Remaining != tt.expected.Remaining || !got.Reset.Equal(tt.expected.Reset) {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	remaining := 2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(HeaderRateLimitRemaining, strconv.Itoa(remaining))
+		w.Header().Set(HeaderRateLimitReset, "0.05")
+		remaining--
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tracker := NewRateLimitTracker(true)
+	client := &http.Client{Transport: RateLimitMiddleware(tracker)(http.DefaultTransport)}
+
+	if _, ok := tracker.Latest(); ok {
+		t.Fatalf("expected no rate limit before the first response")
+	}
+
+	send := func(ctx context.Context) (time.Duration, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+		if err != nil {
+			t.Fatalf("creating request: %v", err)
+		}
+		start := time.Now()
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return time.Since(start), err
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := send(context.Background()); err != nil {
+			t.Fatalf("request %d failed: %v", i, err)
+		}
+	}
+	latest, ok := tracker.Latest()
+	if !ok || latest.Remaining != 0 {
+		t.Fatalf("expected no remaining requests, got %+v", latest)
+	}
+
+	// No requests remaining, the next request waits for the reset
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if _, err := send(ctx); err == nil {
+		t.Errorf("expected request to be delayed past the context deadline")
+	}
+	elapsed, err := send(context.Background())
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if elapsed < 20*time.Millisecond {
+		t.Errorf("expected request to wait for the reset, took %v", elapsed)
+	}
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"net/http"
+	"strings"
+)
+
+// WithFollowAuthRedirects returns a ConfigurationOption that specifies whether the requests which follow a redirect
+// to another host are authenticated. By default they are not, so the access token isn't sent to a host the API
+// redirects to, e.g. a signed URL of an object storage. Redirects to the same host that don't downgrade from https
+// to http are always authenticated.
+//
+// Only enable it if the hosts the APIs redirect to are trusted with the credentials of the client.
+func WithFollowAuthRedirects(follow bool) ConfigurationOption {
+	return func(config *Configuration) error {
+		config.FollowAuthRedirects = follow
+		return nil
+	}
+}
+
+// WithRedirectHook returns a ConfigurationOption that calls hook before a redirect is followed, with the request to
+// the redirect target and the requests made so far, the oldest first. If hook returns an error, the redirect is not
+// followed and the request fails with the error.
+//
+// Unlike WithCheckRedirect, the hook also applies if an HTTP client is provided with WithHTTPClient, and it doesn't
+// replace the default limit of 10 redirects of http.Client.
+func WithRedirectHook(hook func(req *http.Request, via []*http.Request) error) ConfigurationOption {
+	return func(config *Configuration) error {
+		config.RedirectHook = hook
+		return nil
+	}
+}
+
+// redirectRoundTripper sends the requests which follow a redirect through the hook of WithRedirectHook and
+// without authentication if they leave the host of the original request, see WithFollowAuthRedirects
+type redirectRoundTripper struct {
+	auth            http.RoundTripper
+	unauthenticated http.RoundTripper
+	follow          bool
+	hook            func(req *http.Request, via []*http.Request) error
+}
+
+func (rt *redirectRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// http.Client sets the response which caused the redirect on the request to the redirect target
+	if req.Response == nil || req.Response.Request == nil {
+		return rt.auth.RoundTrip(req)
+	}
+
+	var via []*http.Request
+	for r := req.Response.Request; r != nil; {
+		via = append([]*http.Request{r}, via...)
+		if r.Response == nil {
+			break
+		}
+		r = r.Response.Request
+	}
+	if rt.hook != nil {
+		if err := rt.hook(req, via); err != nil {
+			return nil, err
+		}
+	}
+
+	if rt.follow || !leavesOrigin(via[0], req) {
+		return rt.auth.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Del("Authorization")
+	return rt.unauthenticated.RoundTrip(req)
+}
+
+// leavesOrigin reports whether the redirect to req leaves the host of origin or downgrades from https to http
+func leavesOrigin(origin, req *http.Request) bool {
+	if !strings.EqualFold(origin.URL.Host, req.URL.Host) {
+		return true
+	}
+	return origin.URL.Scheme == "https" && req.URL.Scheme != "https"
+}
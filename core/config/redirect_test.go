@@ -0,0 +1,107 @@
+package config
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedirectAuth(t *testing.T) {
+	var storageAuth, apiAuth []string
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storageAuth = append(storageAuth, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer storage.Close()
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiAuth = append(apiAuth, r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/same-host":
+			http.Redirect(w, r, "/object", http.StatusFound)
+		case "/cross-host":
+			http.Redirect(w, r, storage.URL+"/object", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer api.Close()
+
+	authRoundTripper := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer token")
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	for _, tt := range []struct {
+		desc            string
+		path            string
+		follow          bool
+		wantAPIAuth     []string
+		wantStorageAuth []string
+	}{
+		{"same_host", "/same-host", false, []string{"Bearer token", "Bearer token"}, nil},
+		{"cross_host", "/cross-host", false, []string{"Bearer token"}, []string{""}},
+		{"cross_host_follow", "/cross-host", true, []string{"Bearer token"}, []string{"Bearer token"}},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiAuth, storageAuth = nil, nil
+			cfg := &Configuration{}
+			if err := WithFollowAuthRedirects(tt.follow)(cfg); err != nil {
+				t.Fatalf("WithFollowAuthRedirects failed: %v", err)
+			}
+			client := &http.Client{Transport: AssembleTransport(cfg, authRoundTripper)}
+
+			resp, err := client.Get(api.URL + tt.path)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			resp.Body.Close()
+
+			if strings.Join(apiAuth, ",") != strings.Join(tt.wantAPIAuth, ",") {
+				t.Errorf("expected the API to receive %q, got %q", tt.wantAPIAuth, apiAuth)
+			}
+			if strings.Join(storageAuth, ",") != strings.Join(tt.wantStorageAuth, ",") {
+				t.Errorf("expected the storage to receive %q, got %q", tt.wantStorageAuth, storageAuth)
+			}
+		})
+	}
+}
+
+func TestRedirectHook(t *testing.T) {
+	errDenied := errors.New("denied")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/first":
+			http.Redirect(w, r, "/second", http.StatusFound)
+		case "/second":
+			http.Redirect(w, r, "/third", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	var redirects []string
+	cfg := &Configuration{}
+	err := WithRedirectHook(func(req *http.Request, via []*http.Request) error {
+		redirects = append(redirects, via[0].URL.Path+" "+req.URL.Path)
+		if len(via) > 1 {
+			return errDenied
+		}
+		return nil
+	})(cfg)
+	if err != nil {
+		t.Fatalf("WithRedirectHook failed: %v", err)
+	}
+	client := &http.Client{Transport: AssembleTransport(cfg, http.DefaultTransport)}
+
+	_, err = client.Get(server.URL + "/first") //nolint:bodyclose // the request fails
+	if !errors.Is(err, errDenied) {
+		t.Fatalf("expected the redirect to be denied, got %v", err)
+	}
+	if strings.Join(redirects, ",") != "/first /second,/first /third" {
+		t.Fatalf("unexpected redirects passed to the hook: %q", redirects)
+	}
+}
@@ -0,0 +1,298 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// redactedLogValue replaces the values of credentials in the request log
+const redactedLogValue = "<redacted>"
+
+// Maximum size of a request or response body in the request log, larger bodies are not logged
+const maxLoggedBodySize = 16 << 10
+
+// Headers which are always redacted in the request log, in canonical form
+var redactedLogHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Auth-Token":        true,
+	"X-Api-Key":           true,
+}
+
+// Names of JSON fields, form fields and query parameters which are redacted in the request log. The names are
+// compared in lower case without "_" and "-", so e.g. "access_token" and "accessToken" are both redacted.
+var redactedLogFields = map[string]bool{
+	"accesstoken":     true,
+	"refreshtoken":    true,
+	"idtoken":         true,
+	"subjecttoken":    true,
+	"token":           true,
+	"assertion":       true,
+	"password":        true,
+	"secret":          true,
+	"clientsecret":    true,
+	"privatekey":      true,
+	"apikey":          true,
+	"accesskey":       true,
+	"secretaccesskey": true,
+	"kubeconfig":      true,
+	// Connection strings, e.g. the uri of the credentials of a database, contain the password
+	"uri":              true,
+	"uris":             true,
+	"dsn":              true,
+	"connectionstring": true,
+}
+
+// WithRequestLogging returns a ConfigurationOption that logs each request made by the client with logger at level,
+// including the requests made by retries. A record has the attributes:
+//   - method, url, status and duration of the request, status is 0 if no response was received
+//   - request_id, the trace id of the response as returned by the API, and correlation_id, see WithCorrelationID
+//   - service and operation, if the request was sent by a generated API client, see GetOperation
+//   - error, if no response was received
+//
+// If logger is enabled for slog.LevelDebug, the record also has the request and response headers and the JSON and
+// form bodies of up to 16 KiB. Credentials are redacted: the Authorization and Cookie headers, and the fields and
+// query parameters with known credential names, e.g. "password", "token" or "privateKey". Use WithAccessLog for
+// structured entries without any headers and bodies.
+func WithRequestLogging(logger *slog.Logger, level slog.Level) ConfigurationOption {
+	return func(config *Configuration) error {
+		if logger == nil {
+			return fmt.Errorf("logger cannot be nil")
+		}
+		config.RequestLogger = logger
+		config.RequestLogLevel = level
+		return nil
+	}
+}
+
+// RequestLogMiddleware returns a Middleware that logs each request with logger at level, see WithRequestLogging
+func RequestLogMiddleware(logger *slog.Logger, level slog.Level) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &requestLogRoundTripper{rt: rt, logger: logger, level: level}
+	}
+}
+
+type requestLogRoundTripper struct {
+	rt     http.RoundTripper
+	logger *slog.Logger
+	level  slog.Level
+}
+
+func (r *requestLogRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if !r.logger.Enabled(ctx, r.level) {
+		return r.rt.RoundTrip(req)
+	}
+	debug := r.logger.Enabled(ctx, slog.LevelDebug)
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", redactLogURL(req.URL)),
+	}
+	if op, ok := GetOperation(ctx); ok {
+		attrs = append(attrs, slog.String("service", op.Service), slog.String("operation", op.Name))
+	}
+	if correlationID, ok := GetCorrelationID(ctx); ok {
+		attrs = append(attrs, slog.String("correlation_id", correlationID))
+	}
+	var requestBody string
+	if debug {
+		requestBody = logRequestBody(req)
+	}
+
+	start := time.Now()
+	resp, err := r.rt.RoundTrip(req)
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+	// The headers are read after the request was sent, as the authentication sets the Authorization header
+	if debug {
+		attrs = append(attrs, slog.Group("request", slog.Any("headers", logHeaders(req.Header)), slog.String("body", requestBody)))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Int("status", 0), slog.String("error", err.Error()))
+		r.logger.LogAttrs(ctx, r.level, "STACKIT API request", attrs...)
+		return resp, err
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	requestID := resp.Header.Get("X-Trace-Id")
+	if requestID == "" {
+		requestID = resp.Header.Get("X-Request-Id")
+	}
+	if requestID != "" {
+		attrs = append(attrs, slog.String("request_id", requestID))
+	}
+	if debug {
+		attrs = append(attrs, slog.Group("response", slog.Any("headers", logHeaders(resp.Header)), slog.String("body", logResponseBody(resp))))
+	}
+	r.logger.LogAttrs(ctx, r.level, "STACKIT API request", attrs...)
+	return resp, nil
+}
+
+// redactLogURL returns u without its credentials, and with the values of the query parameters with credential names
+// redacted
+func redactLogURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	if redacted.RawQuery != "" {
+		query, err := url.ParseQuery(redacted.RawQuery)
+		if err != nil {
+			redacted.RawQuery = redactedLogValue
+		} else {
+			redactLogValues(query)
+			redacted.RawQuery = query.Encode()
+		}
+	}
+	return redacted.String()
+}
+
+// logHeaders returns h with the values of the credential headers redacted
+func logHeaders(h http.Header) map[string]string {
+	headers := make(map[string]string, len(h))
+	for name, values := range h {
+		if redactedLogHeaders[http.CanonicalHeaderKey(name)] || isRedactedLogField(name) {
+			headers[name] = redactedLogValue
+			continue
+		}
+		headers[name] = strings.Join(values, ", ")
+	}
+	return headers
+}
+
+// logRequestBody returns the redacted body of req, which is read from a copy, see http.Request.GetBody
+func logRequestBody(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody {
+		return ""
+	}
+	if req.GetBody == nil {
+		return "<body not logged>"
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return "<body not logged>"
+	}
+	defer body.Close() //nolint:errcheck // a copy of the body
+	b, err := io.ReadAll(io.LimitReader(body, maxLoggedBodySize+1))
+	if err != nil {
+		return "<body not logged>"
+	}
+	return redactLogBody(req.Header.Get("Content-Type"), b)
+}
+
+// logResponseBody returns the redacted body of resp. The logged part of the body is read and put back in front of
+// the rest of the body, so that it can still be read by the caller.
+func logResponseBody(resp *http.Response) string {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return ""
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize+1))
+	resp.Body = &prefixedBody{Reader: io.MultiReader(bytes.NewReader(b), &errReader{err: err, r: resp.Body}), Closer: resp.Body}
+	if err != nil {
+		return "<body not logged>"
+	}
+	return redactLogBody(resp.Header.Get("Content-Type"), b)
+}
+
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// errReader returns err, if not nil, otherwise reads from r
+type errReader struct {
+	err error
+	r   io.Reader
+}
+
+func (e *errReader) Read(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	return e.r.Read(p)
+}
+
+// redactLogBody returns body with the values of the fields with credential names redacted. Only JSON and form bodies
+// are logged, as the credentials of other bodies are not known.
+func redactLogBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if len(body) > maxLoggedBodySize {
+		return fmt.Sprintf("<body of more than %d bytes not logged>", maxLoggedBodySize)
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var v any
+		if err := json.Unmarshal(body, &v); err != nil {
+			return "<invalid JSON body not logged>"
+		}
+		var b strings.Builder
+		enc := json.NewEncoder(&b)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(redactLogJSON(v)); err != nil {
+			return "<body not logged>"
+		}
+		return strings.TrimSuffix(b.String(), "\n")
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "<invalid form body not logged>"
+		}
+		redactLogValues(values)
+		return values.Encode()
+	}
+	if mediaType == "" {
+		return "<body not logged>"
+	}
+	return fmt.Sprintf("<%s body not logged>", mediaType)
+}
+
+// redactLogJSON redacts the values of the fields with credential names in v, a decoded JSON value
+func redactLogJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for name, value := range v {
+			if isRedactedLogField(name) {
+				v[name] = redactedLogValue
+				continue
+			}
+			v[name] = redactLogJSON(value)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactLogJSON(v[i])
+		}
+	}
+	return v
+}
+
+// redactLogValues redacts the values with credential names in values
+func redactLogValues(values url.Values) {
+	for name := range values {
+		if isRedactedLogField(name) {
+			values[name] = []string{redactedLogValue}
+		}
+	}
+}
+
+// IsCredentialName reports whether name is the name of a header, a JSON or form field or a query parameter whose
+// value is a credential, e.g. "Authorization" or "access_token". Their values are redacted in the request log.
+func IsCredentialName(name string) bool {
+	return redactedLogHeaders[http.CanonicalHeaderKey(name)] || isRedactedLogField(name)
+}
+
+func isRedactedLogField(name string) bool {
+	name = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	return redactedLogFields[name]
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestLogMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Trace-Id", "trace")
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"name": "key", "credentials": {"privateKey": "secret-private-key", "kid": "kid"}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		desc         string
+		handlerLevel slog.Level
+		wantRecord   bool
+		wantDetails  bool
+	}{
+		{"info", slog.LevelInfo, true, false},
+		{"debug", slog.LevelDebug, true, true},
+		{"disabled", slog.LevelWarn, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: tt.handlerLevel}))
+			// The authentication below the middleware sets the Authorization header of the request
+			auth := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req.Header.Set("Authorization", "Bearer secret-token")
+				return http.DefaultTransport.RoundTrip(req)
+			})
+			rt := RequestLogMiddleware(logger, slog.LevelInfo)(auth)
+
+			ctx := WithOperation(context.Background(), "serviceaccount", "CreateKey")
+			body := `{"publicKey": "public", "password": "secret-password"}`
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/keys?access_token=secret-query&page=2", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("round trip: %v", err)
+			}
+			respBody, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil || !strings.Contains(string(respBody), "secret-private-key") {
+				t.Fatalf("expected the unredacted response body to be readable, got %q, %v", respBody, err)
+			}
+
+			if !tt.wantRecord {
+				if buf.Len() != 0 {
+					t.Fatalf("expected no record, got %s", buf.String())
+				}
+				return
+			}
+			if strings.Contains(buf.String(), "secret") {
+				t.Fatalf("expected the credentials to be redacted, got %s", buf.String())
+			}
+			var record struct {
+				Method    string `json:"method"`
+				URL       string `json:"url"`
+				Status    int    `json:"status"`
+				RequestID string `json:"request_id"`
+				Service   string `json:"service"`
+				Operation string `json:"operation"`
+				Request   *struct {
+					Headers map[string]string `json:"headers"`
+					Body    string            `json:"body"`
+				} `json:"request"`
+				Response *struct {
+					Body string `json:"body"`
+				} `json:"response"`
+			}
+			if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+				t.Fatalf("decoding record: %v", err)
+			}
+			if record.Method != http.MethodPost || record.Status != http.StatusCreated || record.RequestID != "trace" {
+				t.Errorf("unexpected record %+v", record)
+			}
+			if record.Service != "serviceaccount" || record.Operation != "CreateKey" {
+				t.Errorf("unexpected operation in record %+v", record)
+			}
+			if !strings.HasSuffix(record.URL, "/v1/keys?access_token=%3Credacted%3E&page=2") {
+				t.Errorf("unexpected url %v", record.URL)
+			}
+			if (record.Request != nil) != tt.wantDetails || (record.Response != nil) != tt.wantDetails {
+				t.Fatalf("expected request details %t, got %s", tt.wantDetails, buf.String())
+			}
+			if !tt.wantDetails {
+				return
+			}
+			if record.Request.Headers["Authorization"] != redactedLogValue {
+				t.Errorf("expected the Authorization header to be redacted, got %v", record.Request.Headers)
+			}
+			if record.Request.Body != `{"password":"<redacted>","publicKey":"public"}` {
+				t.Errorf("unexpected request body %v", record.Request.Body)
+			}
+			if record.Response.Body != `{"credentials":{"kid":"kid","privateKey":"<redacted>"},"name":"key"}` {
+				t.Errorf("unexpected response body %v", record.Response.Body)
+			}
+		})
+	}
+}
+
+func TestRedactLogBody(t *testing.T) {
+	tests := []struct {
+		desc        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{"empty", "application/json", "", ""},
+		{"json_array", "application/json; charset=utf-8", `[{"refresh_token": "x"}, {"id": 1}]`, `[{"refresh_token":"<redacted>"},{"id":1}]`},
+		{"connection_string", "application/json", `{"username": "u", "password": "p", "uri": "mysql://u:p@host/db", "uris": ["mysql://u:p@host/db"]}`, `{"password":"<redacted>","uri":"<redacted>","uris":"<redacted>","username":"u"}`},
+		{"invalid_json", "application/json", `{"token": `, "<invalid JSON body not logged>"},
+		{"form", "application/x-www-form-urlencoded", "assertion=x&grant_type=jwt", "assertion=%3Credacted%3E&grant_type=jwt"},
+		{"other", "application/octet-stream", "secret", "<application/octet-stream body not logged>"},
+		{"no_content_type", "", "secret", "<body not logged>"},
+		{"too_large", "application/json", `"` + strings.Repeat("a", maxLoggedBodySize) + `"`, "<body of more than 16384 bytes not logged>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := redactLogBody(tt.contentType, []byte(tt.body)); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWithRequestLogging(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithRequestLogging(nil, slog.LevelInfo)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil logger")
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	if err := WithRequestLogging(logger, slog.LevelWarn)(cfg); err != nil {
+		t.Fatalf("WithRequestLogging() error = %v", err)
+	}
+	if cfg.RequestLogger != logger || cfg.RequestLogLevel != slog.LevelWarn {
+		t.Fatalf("unexpected configuration %+v", cfg)
+	}
+}
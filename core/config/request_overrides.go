@@ -0,0 +1,116 @@
+package config
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+type requestOverridesContextKey struct{}
+
+// RequestOverrides overrides the configuration of a client for the requests sent with a context, see
+// WithRequestOverrides. The zero values of the fields keep the configuration of the client.
+type RequestOverrides struct {
+	// Region of the requests instead of the region of the client, e.g. "eu02". The region parameter of an API with
+	// regional requests takes precedence. Only has effect if the client isn't configured with a custom endpoint.
+	Region string
+	// Endpoint is the base URL of the requests instead of the endpoint of the client, see WithEndpoint
+	Endpoint string
+	// Header is added to the headers of the requests, replacing the values of headers with the same name
+	Header http.Header
+	// Timeout of the requests including their retries and reading the response body, like http.Client.Timeout
+	Timeout time.Duration
+}
+
+// WithRequestOverrides returns a copy of ctx with which the requests override the configuration of the client, e.g.
+// the region or additional headers of a tenant, so that a single client can be used for all tenants:
+//
+//	ctx = config.WithRequestOverrides(ctx, config.RequestOverrides{Region: "eu02", Timeout: 10 * time.Second})
+//	resp, err := client.ListClusters(ctx, projectId).Execute()
+//
+// If ctx already has overrides, the fields of overrides which are set replace them and the headers are merged.
+func WithRequestOverrides(ctx context.Context, overrides RequestOverrides) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if existing, ok := GetRequestOverrides(ctx); ok {
+		if overrides.Region == "" {
+			overrides.Region = existing.Region
+		}
+		if overrides.Endpoint == "" {
+			overrides.Endpoint = existing.Endpoint
+		}
+		if overrides.Timeout == 0 {
+			overrides.Timeout = existing.Timeout
+		}
+		header := existing.Header.Clone()
+		for name, values := range overrides.Header {
+			if header == nil {
+				header = http.Header{}
+			}
+			header[http.CanonicalHeaderKey(name)] = values
+		}
+		overrides.Header = header
+	}
+	return context.WithValue(ctx, requestOverridesContextKey{}, overrides)
+}
+
+// GetRequestOverrides returns the RequestOverrides set in ctx with WithRequestOverrides, if any
+func GetRequestOverrides(ctx context.Context) (RequestOverrides, bool) {
+	if ctx == nil {
+		return RequestOverrides{}, false
+	}
+	overrides, ok := ctx.Value(requestOverridesContextKey{}).(RequestOverrides)
+	return overrides, ok
+}
+
+// RequestOverridesMiddleware returns a Middleware that applies the headers and the timeout of the RequestOverrides of
+// the requests, see WithRequestOverrides. The region and the endpoint are applied by ServerURLForRegion.
+func RequestOverridesMiddleware() Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &requestOverridesRoundTripper{rt: rt}
+	}
+}
+
+type requestOverridesRoundTripper struct {
+	rt http.RoundTripper
+}
+
+func (r *requestOverridesRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	overrides, ok := GetRequestOverrides(req.Context())
+	if !ok || (len(overrides.Header) == 0 && overrides.Timeout <= 0) {
+		return r.rt.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	cancel := context.CancelFunc(func() {})
+	if overrides.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, overrides.Timeout)
+	}
+	// The request of the caller must not be changed
+	req = req.Clone(ctx)
+	for name, values := range overrides.Header {
+		req.Header[http.CanonicalHeaderKey(name)] = values
+	}
+
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil {
+		cancel()
+		return resp, err
+	}
+	// The timeout covers reading the body, so the context is canceled once it was closed
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func regionalTestConfiguration(t *testing.T) *Configuration {
+	t.Helper()
+	cfg := &Configuration{
+		Region: "eu01",
+		Servers: ServerConfigurations{
+			{
+				URL: "https://some-api.api.{region}stackit.cloud",
+				Variables: map[string]ServerVariable{
+					"region": {DefaultValue: "eu01", EnumValues: []string{"eu01.", "eu02."}},
+				},
+			},
+		},
+	}
+	if err := ConfigureRegion(cfg); err != nil {
+		t.Fatalf("ConfigureRegion() error = %v", err)
+	}
+	return cfg
+}
+
+func TestServerURLForRegionRequestOverrides(t *testing.T) {
+	for _, tt := range []struct {
+		desc      string
+		overrides *RequestOverrides
+		region    string
+		expected  string
+		wantErr   bool
+	}{
+		{"no_overrides", nil, "", "https://some-api.api.eu01.stackit.cloud", false},
+		{"region", &RequestOverrides{Region: "eu02"}, "", "https://some-api.api.eu02.stackit.cloud", false},
+		{"unavailable_region", &RequestOverrides{Region: "eu03"}, "", "", true},
+		{"endpoint", &RequestOverrides{Endpoint: "https://tenant.example.com", Region: "eu02"}, "", "https://tenant.example.com", false},
+		{"only_headers", &RequestOverrides{Header: http.Header{"X-Tenant": {"a"}}}, "", "https://some-api.api.eu01.stackit.cloud", false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			cfg := regionalTestConfiguration(t)
+			ctx := context.Background()
+			if tt.overrides != nil {
+				ctx = WithRequestOverrides(ctx, *tt.overrides)
+			}
+			url, err := cfg.ServerURLForRegion(ctx, "", tt.region)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ServerURLForRegion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var regionErr *RegionNotAvailableError
+			if err != nil && !errors.As(err, &regionErr) {
+				t.Fatalf("expected a RegionNotAvailableError, got %v", err)
+			}
+			if url != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, url)
+			}
+		})
+	}
+}
+
+func TestWithRequestOverridesMerge(t *testing.T) {
+	ctx := WithRequestOverrides(context.Background(), RequestOverrides{
+		Region:  "eu01",
+		Timeout: time.Second,
+		Header:  http.Header{"X-Tenant": {"a"}, "X-Team": {"b"}},
+	})
+	ctx = WithRequestOverrides(ctx, RequestOverrides{Region: "eu02", Header: http.Header{"x-tenant": {"c"}}})
+
+	overrides, ok := GetRequestOverrides(ctx)
+	if !ok {
+		t.Fatalf("expected overrides in the context")
+	}
+	if overrides.Region != "eu02" || overrides.Timeout != time.Second {
+		t.Fatalf("unexpected overrides %+v", overrides)
+	}
+	if overrides.Header.Get("X-Tenant") != "c" || overrides.Header.Get("X-Team") != "b" {
+		t.Fatalf("expected the headers to be merged, got %v", overrides.Header)
+	}
+	if _, ok := GetRequestOverrides(context.Background()); ok {
+		t.Fatalf("expected no overrides in an empty context")
+	}
+}
+
+func TestRequestOverridesMiddleware(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Received-Tenant", r.Header.Get("X-Tenant"))
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Path == "/slow" {
+			if flusher, ok := w.(http.Flusher); ok {
+				flusher.Flush()
+			}
+			<-release
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+	client := &http.Client{Transport: RequestOverridesMiddleware()(http.DefaultTransport)}
+
+	ctx := WithRequestOverrides(context.Background(), RequestOverrides{Header: http.Header{"X-Tenant": {"tenant-a"}}, Timeout: 50 * time.Millisecond})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	req.Header.Set("X-Tenant", "default")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.Header.Get("X-Received-Tenant") != "tenant-a" {
+		t.Fatalf("expected the header of the overrides, got %q", resp.Header.Get("X-Received-Tenant"))
+	}
+	if req.Header.Get("X-Tenant") != "default" {
+		t.Fatalf("expected the request of the caller to be unchanged")
+	}
+
+	// The timeout covers reading the body
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/slow", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if _, err := io.ReadAll(resp.Body); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the timeout while reading the body, got %v", err)
+	}
+}
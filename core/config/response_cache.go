@@ -0,0 +1,334 @@
+package config
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedResponse is a response stored in a Cache, see WithResponseCache
+type CachedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	// StoredAt is the time when the response was received or last revalidated
+	StoredAt time.Time
+	// Vary holds the values of the request headers named by the Vary header of the response
+	Vary map[string]string
+}
+
+// Cache stores the responses of the GET requests of API clients, see WithResponseCache.
+// Implementations must be safe for concurrent use, e.g. NewMemoryCache or an adapter to an external store.
+type Cache interface {
+	// Get returns the response stored for key, if any
+	Get(key string) (*CachedResponse, bool)
+	// Set stores resp for key, replacing the response stored for it
+	Set(key string, resp *CachedResponse)
+	// Delete removes the response stored for key, if any
+	Delete(key string)
+}
+
+// WithResponseCache returns a ConfigurationOption that caches the responses of the GET requests of the client in
+// cache, following their Cache-Control and ETag headers:
+//   - A response is cached if its status is 200 OK, and it has an ETag or a freshness lifetime (max-age or Expires),
+//     unless the request or the response has Cache-Control no-store, or the response has Vary: *
+//   - A fresh response is returned from the cache without sending the request
+//   - A stale response, or one with Cache-Control no-cache, is revalidated with If-None-Match and returned if the
+//     API responds with 304 Not Modified
+//   - A successful POST, PUT, PATCH or DELETE request removes the response of its URL from the cache
+//
+// The responses are cached per Authorization header, so they are not shared between clients with different
+// credentials. The cache keys contain a hash of the header, not the header itself. The layers of the client above
+// the authentication, e.g. the retries, the access log and the middlewares, see the cached responses.
+// Requests with a Range or conditional header set by the caller bypass the cache.
+func WithResponseCache(cache Cache) ConfigurationOption {
+	return func(config *Configuration) error {
+		if cache == nil {
+			return fmt.Errorf("response cache cannot be nil")
+		}
+		config.ResponseCache = cache
+		return nil
+	}
+}
+
+// responseCacheRoundTripper serves the GET requests from the cache of WithResponseCache
+type responseCacheRoundTripper struct {
+	rt    http.RoundTripper
+	cache Cache
+	now   func() time.Time
+}
+
+func (c *responseCacheRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := c.rt.RoundTrip(req)
+		if err == nil && isUnsafeMethod(req.Method) && resp.StatusCode < 400 {
+			c.cache.Delete(responseCacheKey(req))
+		}
+		return resp, err
+	}
+	requestDirectives := cacheDirectives(req.Header)
+	if req.Header.Get("Range") != "" || req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" || requestDirectives.has("no-store") {
+		return c.rt.RoundTrip(req)
+	}
+
+	key := responseCacheKey(req)
+	cached, ok := c.cache.Get(key)
+	if ok && !varyMatches(cached, req) {
+		cached, ok = nil, false
+	}
+	if ok && !requestDirectives.has("no-cache") && !cacheDirectives(cached.Header).has("no-cache") && c.fresh(cached) {
+		return cachedHTTPResponse(req, cached, c.now()), nil
+	}
+
+	outReq := req
+	if ok && cached.Header.Get("ETag") != "" {
+		outReq = req.Clone(req.Context())
+		outReq.Header.Set("If-None-Match", cached.Header.Get("ETag"))
+	}
+	resp, err := c.rt.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified && outReq != req {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		revalidated := *cached
+		revalidated.Header = cached.Header.Clone()
+		for _, h := range []string{"Cache-Control", "Date", "ETag", "Expires", "Last-Modified"} {
+			if v := resp.Header.Get(h); v != "" {
+				revalidated.Header.Set(h, v)
+			}
+		}
+		revalidated.StoredAt = c.now()
+		c.cache.Set(key, &revalidated)
+		return cachedHTTPResponse(req, &revalidated, revalidated.StoredAt), nil
+	}
+
+	if !c.cacheable(resp) {
+		if ok {
+			c.cache.Delete(key)
+		}
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	stored := &CachedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+		StoredAt:   c.now(),
+		Vary:       varyValues(resp.Header, req),
+	}
+	c.cache.Set(key, stored)
+	return resp, nil
+}
+
+// cacheable reports whether resp can be stored in the cache
+func (c *responseCacheRoundTripper) cacheable(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK || cacheDirectives(resp.Header).has("no-store") {
+		return false
+	}
+	if strings.Contains(resp.Header.Get("Vary"), "*") {
+		return false
+	}
+	_, hasLifetime := freshnessLifetime(resp.Header)
+	return resp.Header.Get("ETag") != "" || hasLifetime
+}
+
+// fresh reports whether cached can be returned without revalidation
+func (c *responseCacheRoundTripper) fresh(cached *CachedResponse) bool {
+	lifetime, ok := freshnessLifetime(cached.Header)
+	if !ok {
+		return false
+	}
+	age := c.now().Sub(cached.StoredAt)
+	if s, err := strconv.Atoi(cached.Header.Get("Age")); err == nil && s > 0 {
+		age += time.Duration(s) * time.Second
+	}
+	return age < lifetime
+}
+
+// cachedHTTPResponse returns a response for req with the content of cached
+func cachedHTTPResponse(req *http.Request, cached *CachedResponse, now time.Time) *http.Response {
+	header := cached.Header.Clone()
+	header.Set("Age", strconv.Itoa(int(now.Sub(cached.StoredAt).Seconds())))
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", cached.StatusCode, http.StatusText(cached.StatusCode)),
+		StatusCode:    cached.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+		ContentLength: int64(len(cached.Body)),
+		Request:       req,
+	}
+}
+
+// responseCacheKey identifies the response of the GET request of the URL of req with its Authorization header
+func responseCacheKey(req *http.Request) string {
+	scope := ""
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		sum := sha256.Sum256([]byte(auth))
+		scope = hex.EncodeToString(sum[:])
+	}
+	return http.MethodGet + " " + req.URL.String() + " " + scope
+}
+
+func isUnsafeMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// varyValues returns the values of the request headers named by the Vary header of the response
+func varyValues(header http.Header, req *http.Request) map[string]string {
+	values := map[string]string{}
+	for _, v := range header.Values("Vary") {
+		for _, name := range strings.Split(v, ",") {
+			name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+			if name != "" {
+				values[name] = req.Header.Get(name)
+			}
+		}
+	}
+	return values
+}
+
+func varyMatches(cached *CachedResponse, req *http.Request) bool {
+	for name, value := range cached.Vary {
+		if req.Header.Get(name) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// directives are the directives of a Cache-Control header, with their values
+type directives map[string]string
+
+func (d directives) has(name string) bool {
+	_, ok := d[name]
+	return ok
+}
+
+func cacheDirectives(header http.Header) directives {
+	d := directives{}
+	for _, v := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(v, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			if name != "" {
+				d[strings.ToLower(name)] = strings.Trim(value, `"`)
+			}
+		}
+	}
+	return d
+}
+
+// freshnessLifetime returns the time a response is fresh for, from its max-age or Expires header
+func freshnessLifetime(header http.Header) (time.Duration, bool) {
+	if maxAge, ok := cacheDirectives(header)["max-age"]; ok {
+		seconds, err := strconv.Atoi(maxAge)
+		if err != nil || seconds <= 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	expires, err := http.ParseTime(header.Get("Expires"))
+	if err != nil {
+		return 0, false
+	}
+	date, err := http.ParseTime(header.Get("Date"))
+	if err != nil {
+		return 0, false
+	}
+	if lifetime := expires.Sub(date); lifetime > 0 {
+		return lifetime, true
+	}
+	return 0, false
+}
+
+// MemoryCache is an in-memory Cache which holds a bounded number of responses, evicting the least recently used one
+// when it is full. It is safe for concurrent use.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List
+}
+
+type memoryCacheEntry struct {
+	key  string
+	resp *CachedResponse
+}
+
+// NewMemoryCache returns a MemoryCache which holds up to maxEntries responses, or an unbounded number if maxEntries is
+// not positive
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+}
+
+// Get returns the response stored for key and marks it as the most recently used one
+func (c *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*memoryCacheEntry).resp, true
+}
+
+// Set stores resp for key, evicting the least recently used response if the cache is full
+func (c *MemoryCache) Set(key string, resp *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*memoryCacheEntry).resp = resp
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&memoryCacheEntry{key: key, resp: resp})
+	if c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Delete removes the response stored for key
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.lru.Remove(e)
+		delete(c.entries, key)
+	}
+}
+
+// Len returns the number of responses in the cache
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
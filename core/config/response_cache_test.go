@@ -0,0 +1,195 @@
+package config
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// cacheTestAPI responds to the requests with the configured headers and body, and 304 Not Modified if the
+// If-None-Match header of the request matches its ETag
+type cacheTestAPI struct {
+	header   http.Header
+	body     string
+	requests []*http.Request
+}
+
+func (a *cacheTestAPI) RoundTrip(req *http.Request) (*http.Response, error) {
+	a.requests = append(a.requests, req)
+	header := a.header.Clone()
+	if etag := header.Get("ETag"); etag != "" && req.Header.Get("If-None-Match") == etag {
+		return &http.Response{StatusCode: http.StatusNotModified, Header: header, Body: http.NoBody}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(a.body))}, nil
+}
+
+func newCacheTestTransport(api *cacheTestAPI) (rt *responseCacheRoundTripper, advance func(time.Duration)) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	rt = &responseCacheRoundTripper{rt: api, cache: NewMemoryCache(10), now: func() time.Time { return now }}
+	return rt, func(d time.Duration) { now = now.Add(d) }
+}
+
+func cacheTestGet(t *testing.T, rt http.RoundTripper, method, auth string, header ...string) string {
+	t.Helper()
+	req, err := http.NewRequest(method, "https://dns.api.stackit.cloud/v1/projects/pid/zones", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	return string(body)
+}
+
+func TestResponseCacheFreshness(t *testing.T) {
+	api := &cacheTestAPI{header: http.Header{"Cache-Control": {"max-age=60"}, "Etag": {`"v1"`}}, body: "zones"}
+	rt, advance := newCacheTestTransport(api)
+
+	for i := 0; i < 3; i++ {
+		if body := cacheTestGet(t, rt, http.MethodGet, "Bearer a"); body != "zones" {
+			t.Fatalf("unexpected body %q", body)
+		}
+		advance(10 * time.Second)
+	}
+	if len(api.requests) != 1 {
+		t.Fatalf("expected the fresh response to be served from the cache, got %d requests", len(api.requests))
+	}
+
+	// Once stale, the response is revalidated
+	advance(time.Minute)
+	if body := cacheTestGet(t, rt, http.MethodGet, "Bearer a"); body != "zones" {
+		t.Fatalf("unexpected body %q after the revalidation", body)
+	}
+	if len(api.requests) != 2 || api.requests[1].Header.Get("If-None-Match") != `"v1"` {
+		t.Fatalf("expected a conditional request, got %d requests", len(api.requests))
+	}
+	cacheTestGet(t, rt, http.MethodGet, "Bearer a")
+	if len(api.requests) != 2 {
+		t.Fatalf("expected the revalidated response to be fresh again, got %d requests", len(api.requests))
+	}
+
+	// The request can ask for a revalidation
+	cacheTestGet(t, rt, http.MethodGet, "Bearer a", "Cache-Control", "no-cache")
+	if len(api.requests) != 3 {
+		t.Fatalf("expected a revalidation for Cache-Control no-cache, got %d requests", len(api.requests))
+	}
+}
+
+func TestResponseCacheETag(t *testing.T) {
+	api := &cacheTestAPI{header: http.Header{"Etag": {`"v1"`}}, body: "v1"}
+	rt, _ := newCacheTestTransport(api)
+
+	cacheTestGet(t, rt, http.MethodGet, "Bearer a")
+	if body := cacheTestGet(t, rt, http.MethodGet, "Bearer a"); body != "v1" || len(api.requests) != 2 {
+		t.Fatalf("expected a revalidated response, got %q after %d requests", body, len(api.requests))
+	}
+
+	api.header.Set("Etag", `"v2"`)
+	api.body = "v2"
+	if body := cacheTestGet(t, rt, http.MethodGet, "Bearer a"); body != "v2" {
+		t.Fatalf("expected the modified response, got %q", body)
+	}
+	if body := cacheTestGet(t, rt, http.MethodGet, "Bearer a"); body != "v2" || api.requests[3].Header.Get("If-None-Match") != `"v2"` {
+		t.Fatalf("expected the modified response to be cached, got %q", body)
+	}
+}
+
+func TestResponseCacheNotCached(t *testing.T) {
+	for _, tt := range []struct {
+		desc          string
+		header        http.Header
+		requestHeader []string
+	}{
+		{"no_validator", http.Header{}, nil},
+		{"no_store", http.Header{"Cache-Control": {"no-store, max-age=60"}}, nil},
+		{"request_no_store", http.Header{"Cache-Control": {"max-age=60"}}, []string{"Cache-Control", "no-store"}},
+		{"vary_all", http.Header{"Cache-Control": {"max-age=60"}, "Vary": {"*"}}, nil},
+		{"range", http.Header{"Cache-Control": {"max-age=60"}}, []string{"Range", "bytes=0-10"}},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			api := &cacheTestAPI{header: tt.header, body: "zones"}
+			rt, _ := newCacheTestTransport(api)
+
+			cacheTestGet(t, rt, http.MethodGet, "Bearer a", tt.requestHeader...)
+			cacheTestGet(t, rt, http.MethodGet, "Bearer a", tt.requestHeader...)
+			if len(api.requests) != 2 {
+				t.Fatalf("expected the response not to be cached, got %d requests", len(api.requests))
+			}
+		})
+	}
+}
+
+func TestResponseCacheScope(t *testing.T) {
+	api := &cacheTestAPI{header: http.Header{"Cache-Control": {"max-age=60"}, "Vary": {"Accept-Language"}}, body: "zones"}
+	rt, _ := newCacheTestTransport(api)
+
+	cacheTestGet(t, rt, http.MethodGet, "Bearer a")
+	cacheTestGet(t, rt, http.MethodGet, "Bearer b")
+	cacheTestGet(t, rt, http.MethodGet, "Bearer a", "Accept-Language", "de")
+	if len(api.requests) != 3 {
+		t.Fatalf("expected separate responses per Authorization and Vary header, got %d requests", len(api.requests))
+	}
+	for _, key := range []string{"Bearer a", "Bearer b"} {
+		if strings.Contains(responseCacheKey(api.requests[0]), key) {
+			t.Fatalf("expected the Authorization header not to be part of the cache key")
+		}
+	}
+
+	// A modification of the resource removes it from the cache
+	cacheTestGet(t, rt, http.MethodPost, "Bearer b")
+	cacheTestGet(t, rt, http.MethodGet, "Bearer b")
+	if len(api.requests) != 5 {
+		t.Fatalf("expected the response to be removed after the POST request, got %d requests", len(api.requests))
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", &CachedResponse{Body: []byte("a")})
+	cache.Set("b", &CachedResponse{Body: []byte("b")})
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatalf("expected a to be cached")
+	}
+	cache.Set("c", &CachedResponse{Body: []byte("c")})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected the least recently used response to be evicted")
+	}
+	if resp, ok := cache.Get("a"); !ok || string(resp.Body) != "a" {
+		t.Errorf("expected a to be cached, got %v", resp)
+	}
+	cache.Delete("a")
+	if cache.Len() != 1 {
+		t.Errorf("expected 1 response, got %d", cache.Len())
+	}
+}
+
+func TestWithResponseCache(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithResponseCache(nil)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil cache")
+	}
+	if err := WithResponseCache(NewMemoryCache(1))(cfg); err != nil {
+		t.Fatalf("WithResponseCache failed: %v", err)
+	}
+	if _, ok := cfg.HTTPTransport().(*responseCacheRoundTripper); !ok {
+		t.Fatalf("expected the transport to cache the responses, got %T", cfg.HTTPTransport())
+	}
+}
@@ -0,0 +1,202 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+// Stats accumulates the number of requests, the number of errors and a latency summary for each operation of the
+// API clients it is configured for with WithStats. It is safe for concurrent use and the zero value is ready to use.
+//
+// Requests without an Operation, i.e. not sent by a generated API client, are accumulated under the zero Operation.
+// The operations of the token endpoint are accumulated separately, see Auth.
+type Stats struct {
+	mu         sync.Mutex
+	operations map[Operation]*OperationStats
+	auth       map[clients.AuthOperation]*AuthStats
+}
+
+// AuthStats are the statistics of an operation of the token endpoint, see clients.AuthEvent
+type AuthStats struct {
+	Requests int64
+	Failures int64
+	// FailureReasons is the number of failures by reason
+	FailureReasons map[clients.AuthFailureReason]int64
+	Latency        LatencySummary
+}
+
+// OperationStats are the statistics of the requests of an operation
+type OperationStats struct {
+	Requests int64
+	// Errors is the number of requests that failed without a response or with a status code of 400 or higher
+	Errors  int64
+	Latency LatencySummary
+	// WireBytes and DecodedBytes are the total sizes of the response bodies as received and after decompression,
+	// only accounted if WithDecompressionAccounting is set
+	WireBytes    int64
+	DecodedBytes int64
+	// Warnings is the number of warnings of the responses, only counted if WithWarningHandler is set
+	Warnings int64
+}
+
+// LatencySummary summarizes the durations of the requests of an operation
+type LatencySummary struct {
+	Count int64
+	Total time.Duration
+	Min   time.Duration
+	Max   time.Duration
+}
+
+// Mean returns the mean duration of the requests, or 0 if there were none
+func (l LatencySummary) Mean() time.Duration {
+	if l.Count == 0 {
+		return 0
+	}
+	return l.Total / time.Duration(l.Count)
+}
+
+func (l *LatencySummary) observe(d time.Duration) {
+	if l.Count == 0 || d < l.Min {
+		l.Min = d
+	}
+	if d > l.Max {
+		l.Max = d
+	}
+	l.Count++
+	l.Total += d
+}
+
+// Record adds a request of op that took d to the statistics. failed is true if the request failed.
+func (s *Stats) Record(op Operation, d time.Duration, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.operation(op)
+	stats.Requests++
+	if failed {
+		stats.Errors++
+	}
+	stats.Latency.observe(d)
+}
+
+// RecordResponseSize adds the sizes of a response body of op as received and after decompression to the statistics
+func (s *Stats) RecordResponseSize(op Operation, wireBytes, decodedBytes int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.operation(op)
+	stats.WireBytes += wireBytes
+	stats.DecodedBytes += decodedBytes
+}
+
+// RecordWarnings adds n warnings of a response of op to the statistics
+func (s *Stats) RecordWarnings(op Operation, n int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.operation(op).Warnings += n
+}
+
+// RecordAuthEvent adds the outcome of an operation of the token endpoint to the statistics, start events are ignored
+func (s *Stats) RecordAuthEvent(event clients.AuthEvent) {
+	if event.Phase != clients.AuthPhaseSuccess && event.Phase != clients.AuthPhaseFailure {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.auth == nil {
+		s.auth = map[clients.AuthOperation]*AuthStats{}
+	}
+	stats, ok := s.auth[event.Operation]
+	if !ok {
+		stats = &AuthStats{FailureReasons: map[clients.AuthFailureReason]int64{}}
+		s.auth[event.Operation] = stats
+	}
+	stats.Requests++
+	if event.Phase == clients.AuthPhaseFailure {
+		stats.Failures++
+		stats.FailureReasons[event.Reason]++
+	}
+	stats.Latency.observe(event.Latency)
+}
+
+// Auth returns a copy of the statistics of each operation of the token endpoint
+func (s *Stats) Auth() map[clients.AuthOperation]AuthStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	auth := make(map[clients.AuthOperation]AuthStats, len(s.auth))
+	for op, stats := range s.auth {
+		snapshot := *stats
+		snapshot.FailureReasons = make(map[clients.AuthFailureReason]int64, len(stats.FailureReasons))
+		for reason, n := range stats.FailureReasons {
+			snapshot.FailureReasons[reason] = n
+		}
+		auth[op] = snapshot
+	}
+	return auth
+}
+
+// operation returns the statistics of op, creating them if needed. s.mu must be held.
+func (s *Stats) operation(op Operation) *OperationStats {
+	if s.operations == nil {
+		s.operations = map[Operation]*OperationStats{}
+	}
+	stats, ok := s.operations[op]
+	if !ok {
+		stats = &OperationStats{}
+		s.operations[op] = stats
+	}
+	return stats
+}
+
+// Snapshot returns a copy of the statistics of each operation
+func (s *Stats) Snapshot() map[Operation]OperationStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := make(map[Operation]OperationStats, len(s.operations))
+	for op, stats := range s.operations {
+		snapshot[op] = *stats
+	}
+	return snapshot
+}
+
+// Reset removes all statistics
+func (s *Stats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.operations = nil
+	s.auth = nil
+}
+
+// WithStats returns a ConfigurationOption that accumulates the statistics of the requests made by the client in stats,
+// once for each attempt of a request. The same Stats can be used for several clients.
+func WithStats(stats *Stats) ConfigurationOption {
+	return func(config *Configuration) error {
+		if stats == nil {
+			return fmt.Errorf("stats cannot be nil")
+		}
+		config.Stats = stats
+		return nil
+	}
+}
+
+// StatsMiddleware returns a Middleware that records each request in stats
+func StatsMiddleware(stats *Stats) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &statsRoundTripper{rt: rt, stats: stats}
+	}
+}
+
+type statsRoundTripper struct {
+	rt    http.RoundTripper
+	stats *Stats
+}
+
+func (s *statsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	op, _ := GetOperation(req.Context())
+	start := time.Now()
+	resp, err := s.rt.RoundTrip(req)
+	s.stats.Record(op, time.Since(start), err != nil || resp.StatusCode >= http.StatusBadRequest)
+	return resp, err
+}
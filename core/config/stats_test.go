@@ -0,0 +1,177 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+func TestStatsMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	stats := &Stats{}
+	client := &http.Client{Transport: StatsMiddleware(stats)(http.DefaultTransport)}
+	getZone := Operation{Service: "dns", Name: "GetZone"}
+	for _, tt := range []struct {
+		path string
+		op   *Operation
+	}{
+		{"/ok", &getZone},
+		{"/fail", &getZone},
+		{"/ok", &getZone},
+		{"/ok", nil},
+	} {
+		ctx := context.Background()
+		if tt.op != nil {
+			ctx = WithOperation(ctx, tt.op.Service, tt.op.Name)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+tt.path, http.NoBody)
+		if err != nil {
+			t.Fatalf("creating request: %v", err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	snapshot := stats.Snapshot()
+	if len(snapshot) != 2 {
+		t.Fatalf("expected statistics of 2 operations, got %d", len(snapshot))
+	}
+	if got := snapshot[getZone]; got.Requests != 3 || got.Errors != 1 || got.Latency.Count != 3 {
+		t.Errorf("unexpected statistics of %v: %+v", getZone, got)
+	}
+	if got := snapshot[Operation{}]; got.Requests != 1 || got.Errors != 0 {
+		t.Errorf("unexpected statistics of requests without operation: %+v", got)
+	}
+
+	stats.Reset()
+	if got := stats.Snapshot(); len(got) != 0 {
+		t.Errorf("expected no statistics after reset, got %v", got)
+	}
+}
+
+func TestStatsMiddlewareTransportError(t *testing.T) {
+	stats := &Stats{}
+	rt := StatsMiddleware(stats)(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("connection refused")
+	}))
+	req, err := http.NewRequestWithContext(WithOperation(context.Background(), "dns", "GetZone"), http.MethodGet, "https://dns.api.stackit.cloud", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Fatalf("expected error")
+	}
+	if got := stats.Snapshot()[Operation{Service: "dns", Name: "GetZone"}]; got.Requests != 1 || got.Errors != 1 {
+		t.Errorf("unexpected statistics: %+v", got)
+	}
+}
+
+func TestStatsConcurrent(t *testing.T) {
+	stats := &Stats{}
+	op := Operation{Service: "dns", Name: "ListZones"}
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stats.Record(op, time.Millisecond, false)
+			_ = stats.Snapshot()
+		}()
+	}
+	wg.Wait()
+	if got := stats.Snapshot()[op].Requests; got != 50 {
+		t.Errorf("expected 50 requests, got %d", got)
+	}
+}
+
+func TestLatencySummary(t *testing.T) {
+	var l LatencySummary
+	if l.Mean() != 0 {
+		t.Errorf("expected mean 0 without requests, got %v", l.Mean())
+	}
+	for _, d := range []time.Duration{3 * time.Second, time.Second, 2 * time.Second} {
+		l.observe(d)
+	}
+	expected := LatencySummary{Count: 3, Total: 6 * time.Second, Min: time.Second, Max: 3 * time.Second}
+	if l != expected {
+		t.Errorf("expected %+v, got %+v", expected, l)
+	}
+	if l.Mean() != 2*time.Second {
+		t.Errorf("expected mean 2s, got %v", l.Mean())
+	}
+}
+
+func TestAuthMetrics(t *testing.T) {
+	stats := &Stats{}
+	var events []clients.AuthEvent
+	cfg := &Configuration{}
+	for _, option := range []ConfigurationOption{
+		WithStats(stats),
+		WithAuthMetrics(func(event clients.AuthEvent) { events = append(events, event) }),
+	} {
+		if err := option(cfg); err != nil {
+			t.Fatalf("configuring: %v", err)
+		}
+	}
+
+	hook := cfg.AuthEventHook()
+	for _, event := range []clients.AuthEvent{
+		{Operation: clients.AuthOperationMint, Phase: clients.AuthPhaseStart},
+		{Operation: clients.AuthOperationMint, Phase: clients.AuthPhaseSuccess, Latency: 30 * time.Millisecond},
+		{Operation: clients.AuthOperationRefresh, Phase: clients.AuthPhaseStart},
+		{Operation: clients.AuthOperationRefresh, Phase: clients.AuthPhaseFailure, Latency: 10 * time.Millisecond, Reason: clients.AuthFailureServerError},
+		{Operation: clients.AuthOperationRefresh, Phase: clients.AuthPhaseStart},
+		{Operation: clients.AuthOperationRefresh, Phase: clients.AuthPhaseSuccess, Latency: 20 * time.Millisecond},
+	} {
+		hook(event)
+	}
+
+	if len(events) != 6 {
+		t.Fatalf("expected 6 events, got %d", len(events))
+	}
+	expected := map[clients.AuthOperation]AuthStats{
+		clients.AuthOperationMint: {
+			Requests:       1,
+			FailureReasons: map[clients.AuthFailureReason]int64{},
+			Latency:        LatencySummary{Count: 1, Total: 30 * time.Millisecond, Min: 30 * time.Millisecond, Max: 30 * time.Millisecond},
+		},
+		clients.AuthOperationRefresh: {
+			Requests:       2,
+			Failures:       1,
+			FailureReasons: map[clients.AuthFailureReason]int64{clients.AuthFailureServerError: 1},
+			Latency:        LatencySummary{Count: 2, Total: 30 * time.Millisecond, Min: 10 * time.Millisecond, Max: 20 * time.Millisecond},
+		},
+	}
+	if diff := cmp.Diff(expected, stats.Auth()); diff != "" {
+		t.Errorf("unexpected auth statistics: %s", diff)
+	}
+
+	stats.Reset()
+	if len(stats.Auth()) != 0 {
+		t.Errorf("expected no auth statistics after reset")
+	}
+	if (&Configuration{}).AuthEventHook() != nil {
+		t.Errorf("expected no hook without WithAuthMetrics and WithStats")
+	}
+	if err := WithAuthMetrics(nil)(&Configuration{}); err == nil {
+		t.Errorf("expected error for nil function")
+	}
+}
@@ -0,0 +1,178 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Delay of the requests to a host after a 429 Too Many Requests response without a Retry-After header
+const defaultThrottleBackoff = time.Second
+
+// ParseRetryAfter returns the delay requested by the Retry-After header of a response, relative to now, if it
+// contains one. The header is either a number of seconds or an HTTP date (RFC 9110).
+func ParseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := strings.TrimSpace(header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if delay := date.Sub(now); delay > 0 {
+		return delay, true
+	}
+	return 0, true
+}
+
+// Throttle limits the requests per second sent to each host, e.g. to create hundreds of DNS record sets without
+// exceeding the rate limit of the project. The requests to a host are allowed at a rate of requestsPerSecond with
+// bursts of up to burst requests (token bucket). If a host responds with 429 Too Many Requests, no requests are sent
+// to it until the delay of the Retry-After header has passed, or 1 second if the response has none.
+//
+// A Throttle can be shared by multiple clients, see WithThrottle, so that they share the budget of a host.
+// It is safe for concurrent use.
+type Throttle struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*throttleBucket
+}
+
+type throttleBucket struct {
+	tokens float64
+	last   time.Time
+	// No requests are sent before blockedUntil, see ParseRetryAfter
+	blockedUntil time.Time
+}
+
+// NewThrottle returns a Throttle which allows requestsPerSecond requests per second to each host, with bursts of up
+// to burst requests
+func NewThrottle(requestsPerSecond float64, burst int) (*Throttle, error) {
+	if requestsPerSecond <= 0 {
+		return nil, fmt.Errorf("requests per second must be positive, got %v", requestsPerSecond)
+	}
+	if burst < 1 {
+		return nil, fmt.Errorf("burst must be at least 1, got %d", burst)
+	}
+	return &Throttle{
+		rate:    requestsPerSecond,
+		burst:   float64(burst),
+		now:     time.Now,
+		buckets: map[string]*throttleBucket{},
+	}, nil
+}
+
+// WithThrottle returns a ConfigurationOption that limits the requests of the client per host with throttle,
+// including the requests made by retries, see Throttle. The same Throttle can be used for several clients.
+func WithThrottle(throttle *Throttle) ConfigurationOption {
+	return func(config *Configuration) error {
+		if throttle == nil {
+			return fmt.Errorf("throttle cannot be nil")
+		}
+		config.Throttle = throttle
+		return nil
+	}
+}
+
+// ThrottleMiddleware returns a Middleware that delays the requests according to throttle
+func ThrottleMiddleware(throttle *Throttle) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &throttleRoundTripper{rt: rt, throttle: throttle}
+	}
+}
+
+type throttleRoundTripper struct {
+	rt       http.RoundTripper
+	throttle *Throttle
+}
+
+func (t *throttleRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	if err := t.throttle.wait(req.Context(), host); err != nil {
+		return nil, err
+	}
+	resp, err := t.rt.RoundTrip(req)
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		t.throttle.block(host, resp.Header)
+	}
+	return resp, err
+}
+
+// bucket returns the bucket of host, refilled until now. It must be called with mu locked.
+func (t *Throttle) bucket(host string, now time.Time) *throttleBucket {
+	b, ok := t.buckets[host]
+	if !ok {
+		b = &throttleBucket{tokens: t.burst, last: now}
+		t.buckets[host] = b
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * t.rate
+		if b.tokens > t.burst {
+			b.tokens = t.burst
+		}
+		b.last = now
+	}
+	return b
+}
+
+// wait takes a token of the bucket of host, and waits until it is available and host isn't blocked
+func (t *Throttle) wait(ctx context.Context, host string) error {
+	t.mu.Lock()
+	now := t.now()
+	b := t.bucket(host, now)
+	// The token is taken in advance, so that concurrent requests wait for the following tokens
+	b.tokens--
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / t.rate * float64(time.Second))
+	}
+	if blocked := b.blockedUntil.Sub(now); blocked > delay {
+		delay = blocked
+	}
+	t.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		// The request isn't sent, so its token is returned
+		t.mu.Lock()
+		t.bucket(host, t.now()).tokens++
+		t.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// block stops the requests to host for the delay of the Retry-After header
+func (t *Throttle) block(host string, header http.Header) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	delay, ok := ParseRetryAfter(header, now)
+	if !ok {
+		delay = defaultThrottleBackoff
+	}
+	b := t.bucket(host, now)
+	if until := now.Add(delay); until.After(b.blockedUntil) {
+		b.blockedUntil = until
+	}
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		desc       string
+		retryAfter string
+		expected   time.Duration
+		ok         bool
+	}{
+		{"seconds", "30", 30 * time.Second, true},
+		{"http_date", now.Add(time.Minute).Format(http.TimeFormat), time.Minute, true},
+		{"date_in_the_past", now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"missing", "", 0, false},
+		{"negative", "-1", 0, false},
+		{"invalid", "soon", 0, false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			header := http.Header{}
+			if tt.retryAfter != "" {
+				header.Set("Retry-After", tt.retryAfter)
+			}
+			delay, ok := ParseRetryAfter(header, now)
+			if delay != tt.expected || ok != tt.ok {
+				t.Fatalf("expected %v, %t, got %v, %t", tt.expected, tt.ok, delay, ok)
+			}
+		})
+	}
+}
+
+func TestNewThrottle(t *testing.T) {
+	if _, err := NewThrottle(0, 1); err == nil {
+		t.Fatalf("expected an error for zero requests per second")
+	}
+	if _, err := NewThrottle(10, 0); err == nil {
+		t.Fatalf("expected an error for a zero burst")
+	}
+	if err := WithThrottle(nil)(&Configuration{}); err == nil {
+		t.Fatalf("expected an error for a nil throttle")
+	}
+}
+
+func TestThrottleRate(t *testing.T) {
+	throttle, err := NewThrottle(50, 2)
+	if err != nil {
+		t.Fatalf("NewThrottle() error = %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: ThrottleMiddleware(throttle)(http.DefaultTransport)}
+
+	// The burst is sent immediately, the following requests at the rate of 50 per second
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Fatalf("expected the requests after the burst to be delayed, took %v", elapsed)
+	}
+}
+
+func TestThrottleRetryAfter(t *testing.T) {
+	throttle, err := NewThrottle(1000, 10)
+	if err != nil {
+		t.Fatalf("NewThrottle() error = %v", err)
+	}
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	throttle.now = func() time.Time { return now }
+
+	rt := ThrottleMiddleware(throttle)(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("Retry-After", "120")
+		return &http.Response{StatusCode: http.StatusTooManyRequests, Header: header, Body: http.NoBody}, nil
+	}))
+	req, err := http.NewRequest(http.MethodPost, "https://dns.api.stackit.cloud/v1/projects/p/zones/z/rrsets", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if blocked := throttle.buckets["dns.api.stackit.cloud"].blockedUntil; !blocked.Equal(now.Add(2 * time.Minute)) {
+		t.Fatalf("expected the host to be blocked for the Retry-After delay, got %v", blocked)
+	}
+
+	// The requests to the host wait until the delay passed, other hosts aren't affected
+	tokens := throttle.buckets["dns.api.stackit.cloud"].tokens
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := throttle.wait(ctx, "dns.api.stackit.cloud"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to wait until the context is done, got %v", err)
+	}
+	if throttle.buckets["dns.api.stackit.cloud"].tokens != tokens {
+		t.Fatalf("expected the token of the canceled request to be returned")
+	}
+	if err := throttle.wait(context.Background(), "ske.api.stackit.cloud"); err != nil {
+		t.Fatalf("expected another host not to be blocked, got %v", err)
+	}
+}
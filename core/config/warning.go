@@ -0,0 +1,248 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// Warning is a warning of an API on a successful response, e.g. that the operation is deprecated or only
+// partially succeeded. It is either sent as Warning header or in a warnings array of the response body.
+type Warning struct {
+	// Code of the warning, the warn-code of a Warning header, e.g. "299" for a miscellaneous persistent warning,
+	// or the code of a warning in the response body, if any
+	Code string
+	// Agent is the warn-agent of a Warning header, usually the host of the API, empty for a warning in the response body
+	Agent string
+	// Text of the warning
+	Text string
+}
+
+// WarningHandler is called with the warnings of a response, see WithWarningHandler
+type WarningHandler func(ctx context.Context, warnings []Warning)
+
+// Maximum size of a JSON response body inspected for warnings, the warnings of larger bodies are ignored
+const maxWarningBodySize = 1 << 20
+
+// WithWarningHandler returns a ConfigurationOption that calls handler with the warnings of the successful (2xx)
+// responses, e.g. to log a deprecation before the endpoint is removed. ctx is the context of the request, see
+// GetOperation for the operation. The warnings are parsed from:
+//   - the Warning headers (RFC 7234), e.g. `Warning: 299 dns.api.stackit.cloud "This endpoint is deprecated"`
+//   - a "warnings" array at the top level of a JSON response body of up to 1 MiB, with either strings or objects
+//     with a "code" and a "message", "text" or "detail"
+//
+// As the body is only inspected as it is read, handler is called once the body of such a response was read or closed,
+// otherwise when the response is received. If WithStats is set, the warnings are also counted per operation.
+func WithWarningHandler(handler WarningHandler) ConfigurationOption {
+	return func(config *Configuration) error {
+		if handler == nil {
+			return fmt.Errorf("warning handler cannot be nil")
+		}
+		config.WarningHandler = handler
+		return nil
+	}
+}
+
+// WarningMiddleware returns a Middleware which calls handler with the warnings of the successful responses,
+// see WithWarningHandler. If stats is not nil, the warnings are counted in it.
+func WarningMiddleware(handler WarningHandler, stats *Stats) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &warningRoundTripper{rt: rt, handler: handler, stats: stats}
+	}
+}
+
+type warningRoundTripper struct {
+	rt      http.RoundTripper
+	handler WarningHandler
+	stats   *Stats
+}
+
+func (w *warningRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := w.rt.RoundTrip(req)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, err
+	}
+	warnings := ParseWarningHeaders(resp.Header)
+	if resp.Body != nil && resp.Body != http.NoBody && isJSONContentType(resp.Header.Get("Content-Type")) {
+		resp.Body = &warningBody{
+			ReadCloser: resp.Body,
+			warnings:   warnings,
+			report:     func(warnings []Warning) { w.report(req.Context(), warnings) },
+		}
+		return resp, nil
+	}
+	w.report(req.Context(), warnings)
+	return resp, nil
+}
+
+func (w *warningRoundTripper) report(ctx context.Context, warnings []Warning) {
+	if len(warnings) == 0 {
+		return
+	}
+	if w.stats != nil {
+		op, _ := GetOperation(ctx)
+		w.stats.RecordWarnings(op, int64(len(warnings)))
+	}
+	w.handler(ctx, warnings)
+}
+
+// warningBody keeps the first maxWarningBodySize bytes of a response body, and reports the warnings of the headers
+// and the body once it was read or closed
+type warningBody struct {
+	io.ReadCloser
+	buf      bytes.Buffer
+	overflow bool
+	warnings []Warning
+	report   func(warnings []Warning)
+	reported bool
+}
+
+func (b *warningBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if !b.overflow {
+		if b.buf.Len()+n > maxWarningBodySize {
+			b.overflow = true
+			b.buf = bytes.Buffer{}
+		} else {
+			b.buf.Write(p[:n])
+		}
+	}
+	if err == io.EOF {
+		b.finish(true)
+	}
+	return n, err
+}
+
+func (b *warningBody) Close() error {
+	b.finish(false)
+	return b.ReadCloser.Close()
+}
+
+func (b *warningBody) finish(complete bool) {
+	if b.reported {
+		return
+	}
+	b.reported = true
+	warnings := b.warnings
+	if complete && !b.overflow {
+		warnings = append(warnings, ParseWarningBody(b.buf.Bytes())...)
+	}
+	b.buf = bytes.Buffer{}
+	b.report(warnings)
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// ParseWarningHeaders returns the warnings of the Warning headers of a response. A header may contain several
+// comma separated warnings of the form `warn-code warn-agent "warn-text" ["warn-date"]`, malformed ones are skipped.
+func ParseWarningHeaders(header http.Header) []Warning {
+	var warnings []Warning
+	for _, value := range header.Values("Warning") {
+		rest := value
+		for rest != "" {
+			var warning Warning
+			var ok bool
+			warning, rest, ok = parseWarningValue(rest)
+			if ok {
+				warnings = append(warnings, warning)
+			}
+		}
+	}
+	return warnings
+}
+
+// parseWarningValue parses the first warning of a Warning header and returns the remainder after its comma
+func parseWarningValue(s string) (warning Warning, rest string, ok bool) {
+	s = strings.TrimLeft(s, " ,")
+	value := s
+	code, s, _ := strings.Cut(s, " ")
+	agent, s, _ := strings.Cut(strings.TrimLeft(s, " "), " ")
+	s = strings.TrimLeft(s, " ")
+	if !strings.HasPrefix(s, `"`) || strings.Contains(code+agent, ",") {
+		// Malformed, skip to the next warning
+		_, rest, _ = strings.Cut(value, ",")
+		return Warning{}, rest, false
+	}
+	text, s, ok := cutQuoted(s)
+	if !ok {
+		return Warning{}, "", false
+	}
+	// Skip the optional warn-date
+	s = strings.TrimLeft(s, " ")
+	if strings.HasPrefix(s, `"`) {
+		_, s, _ = cutQuoted(s)
+	}
+	_, rest, _ = strings.Cut(s, ",")
+	return Warning{Code: code, Agent: agent, Text: text}, rest, code != "" && agent != ""
+}
+
+// cutQuoted returns the content of the quoted string at the start of s, with its escapes resolved, and the remainder
+func cutQuoted(s string) (content, rest string, ok bool) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case '"':
+			return b.String(), s[i+1:], true
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", "", false
+}
+
+// ParseWarningBody returns the warnings of the "warnings" array at the top level of a JSON response body,
+// with either strings or objects with a "code" and a "message", "text" or "detail"
+func ParseWarningBody(body []byte) []Warning {
+	var envelope struct {
+		Warnings []json.RawMessage `json:"warnings"`
+	}
+	if !bytes.HasPrefix(bytes.TrimSpace(body), []byte("{")) || json.Unmarshal(body, &envelope) != nil {
+		return nil
+	}
+	var warnings []Warning
+	for _, raw := range envelope.Warnings {
+		var text string
+		if json.Unmarshal(raw, &text) == nil {
+			if text != "" {
+				warnings = append(warnings, Warning{Text: text})
+			}
+			continue
+		}
+		var object struct {
+			Code    json.RawMessage `json:"code"`
+			Message string          `json:"message"`
+			Text    string          `json:"text"`
+			Detail  string          `json:"detail"`
+		}
+		if json.Unmarshal(raw, &object) != nil {
+			continue
+		}
+		warning := Warning{Text: object.Message}
+		if code := string(object.Code); code != "null" {
+			warning.Code = strings.Trim(code, `"`)
+		}
+		if warning.Text == "" {
+			warning.Text = object.Text
+		}
+		if warning.Text == "" {
+			warning.Text = object.Detail
+		}
+		if warning.Code != "" || warning.Text != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
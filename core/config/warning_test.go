@@ -0,0 +1,126 @@
+package config
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseWarningHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Add("Warning", `299 dns.api.stackit.cloud "Deprecated, use v2" "Wed, 21 Oct 2015 07:28:00 GMT"`)
+	header.Add("Warning", `199 - "first", 214 proxy "quoted \"text\""`)
+	header.Add("Warning", `malformed, 299 - "after malformed"`)
+
+	expected := []Warning{
+		{Code: "299", Agent: "dns.api.stackit.cloud", Text: "Deprecated, use v2"},
+		{Code: "199", Agent: "-", Text: "first"},
+		{Code: "214", Agent: "proxy", Text: `quoted "text"`},
+		{Code: "299", Agent: "-", Text: "after malformed"},
+	}
+	if diff := cmp.Diff(expected, ParseWarningHeaders(header)); diff != "" {
+		t.Fatalf("unexpected warnings (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseWarningBody(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		body     string
+		expected []Warning
+	}{
+		{"strings", `{"warnings": ["deprecated", ""]}`, []Warning{{Text: "deprecated"}}},
+		{
+			"objects",
+			`{"id": "1", "warnings": [{"code": "PARTIAL", "message": "2 of 3 records created"}, {"code": 42, "detail": "d"}, {"text": "t"}, {"code": null}]}`,
+			[]Warning{{Code: "PARTIAL", Text: "2 of 3 records created"}, {Code: "42", Text: "d"}, {Text: "t"}},
+		},
+		{"no_warnings", `{"id": "1"}`, nil},
+		{"array", `[{"warnings": ["a"]}]`, nil},
+		{"invalid", `{"warnings": `, nil},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, ParseWarningBody([]byte(tt.body))); diff != "" {
+				t.Fatalf("unexpected warnings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWarningMiddleware(t *testing.T) {
+	for _, tt := range []struct {
+		desc        string
+		status      int
+		contentType string
+		body        string
+		readBody    bool
+		expected    []Warning
+	}{
+		{"header_and_body", http.StatusOK, "application/json", `{"warnings": ["body"]}`, true, []Warning{{Code: "299", Agent: "-", Text: "header"}, {Text: "body"}}},
+		{"body_not_read", http.StatusOK, "application/json", `{"warnings": ["body"]}`, false, []Warning{{Code: "299", Agent: "-", Text: "header"}}},
+		{"not_json", http.StatusAccepted, "text/plain", `{"warnings": ["body"]}`, true, []Warning{{Code: "299", Agent: "-", Text: "header"}}},
+		{"error_response", http.StatusBadRequest, "application/json", `{"warnings": ["body"]}`, true, nil},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var got []Warning
+			calls := 0
+			handler := func(ctx context.Context, warnings []Warning) {
+				calls++
+				got = warnings
+				if op, _ := GetOperation(ctx); op.Name != "GetZone" {
+					t.Errorf("expected the context of the request, got operation %+v", op)
+				}
+			}
+			stats := &Stats{}
+			rt := WarningMiddleware(handler, stats)(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: tt.status,
+					Header:     http.Header{"Warning": {`299 - "header"`}, "Content-Type": {tt.contentType}},
+					Body:       io.NopCloser(strings.NewReader(tt.body)),
+				}, nil
+			}))
+			req, err := http.NewRequestWithContext(WithOperation(context.Background(), "dns", "GetZone"), http.MethodGet, "https://dns.api.stackit.cloud", http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			if tt.readBody {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil || string(body) != tt.body {
+					t.Fatalf("expected the body to be unchanged, got %q, %v", body, err)
+				}
+			}
+			_ = resp.Body.Close()
+
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Fatalf("unexpected warnings (-want +got):\n%s", diff)
+			}
+			if tt.expected != nil && calls != 1 {
+				t.Fatalf("expected the handler to be called once, got %d calls", calls)
+			}
+			if warnings := stats.Snapshot()[Operation{Service: "dns", Name: "GetZone"}].Warnings; warnings != int64(len(tt.expected)) {
+				t.Fatalf("expected %d warnings in the statistics, got %d", len(tt.expected), warnings)
+			}
+		})
+	}
+}
+
+func TestWithWarningHandler(t *testing.T) {
+	cfg := &Configuration{}
+	if err := WithWarningHandler(nil)(cfg); err == nil {
+		t.Fatalf("expected an error for a nil handler")
+	}
+	if err := WithWarningHandler(func(context.Context, []Warning) {})(cfg); err != nil {
+		t.Fatalf("WithWarningHandler failed: %v", err)
+	}
+	if cfg.WarningHandler == nil {
+		t.Fatalf("expected the warning handler to be set")
+	}
+}
@@ -0,0 +1,223 @@
+// Package filter builds the filter query parameters of list requests.
+//
+// The fields of the filters are typed, so only the operators supported for the type of a field can be used.
+// The list requests which support a filter have the fields of their endpoint and a FilterExpr method, which checks
+// that the expression only uses these fields with their type, see Expr.Validate.
+//
+// Expr builds filters in the expr-lang syntax (https://expr-lang.org/docs/language-definition), e.g. for iaas:
+//
+//	f := iaas.ListMachineTypesFilterFields
+//	client.ListMachineTypes(ctx, projectId, region).FilterExpr(f.Vcpus.Ge(4).And(f.Name.StartsWith("g1")))
+//
+// SCIMExpr builds filters in the SCIM syntax (RFC 7644), e.g. for stackitmarketplace:
+//
+//	f := stackitmarketplace.ListCatalogProductsFilterFields
+//	client.ListCatalogProducts(ctx).FilterExpr(f.DeliveryMethod.Eq("SAAS"))
+//
+// Expressions can also be built without the fields of an endpoint and passed as string to the filter of a request:
+//
+//	expr := filter.Number("vcpus").Ge(4).And(filter.String("name").StartsWith("g1"))
+//	client.ListMachineTypes(ctx, projectId, region).Filter(expr.String())
+package filter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Kind is the type of a field, which determines the operators it can be used with
+type Kind string
+
+const (
+	KindString Kind = "string"
+	KindNumber Kind = "number"
+	KindBool   Kind = "bool"
+)
+
+// Fields are the fields which can be used in the filter of an endpoint, with their kind
+type Fields map[string]Kind
+
+// validate checks that the used fields are in fields, with the same kind
+func (fields Fields) validate(used map[string]Kind) error {
+	names := make([]string, 0, len(used))
+	for name := range used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		kind, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("field %q can't be used in the filter", name)
+		}
+		if kind != used[name] {
+			return fmt.Errorf("field %q is of type %s, not %s", name, kind, used[name])
+		}
+	}
+	return nil
+}
+
+// mergeFields returns the fields used by two combined expressions
+func mergeFields(a, b map[string]Kind) map[string]Kind {
+	merged := make(map[string]Kind, len(a)+len(b))
+	for name, kind := range a {
+		merged[name] = kind
+	}
+	for name, kind := range b {
+		if existing, ok := merged[name]; ok && existing != kind {
+			// The field is used with different types, which fails the validation with any kind
+			kind = existing + "/" + kind
+		}
+		merged[name] = kind
+	}
+	return merged
+}
+
+// Expr is a filter expression in the expr-lang syntax
+type Expr struct {
+	expr string
+	// Set if the expression needs to be enclosed in parentheses when combined with other expressions
+	compound bool
+	// fields used in the expression, with their kind
+	fields map[string]Kind
+}
+
+// String returns the expression, to be passed to the filter of a list request
+func (e Expr) String() string {
+	return e.expr
+}
+
+// Validate returns an error if the expression uses a field which isn't in fields, or with another kind
+func (e Expr) Validate(fields Fields) error {
+	return fields.validate(e.fields)
+}
+
+// And returns an expression which matches if both e and other match
+func (e Expr) And(other Expr) Expr {
+	return Expr{expr: e.operand() + " && " + other.operand(), compound: true, fields: mergeFields(e.fields, other.fields)}
+}
+
+// Or returns an expression which matches if e or other match
+func (e Expr) Or(other Expr) Expr {
+	return Expr{expr: e.operand() + " || " + other.operand(), compound: true, fields: mergeFields(e.fields, other.fields)}
+}
+
+// Not returns an expression which matches if e doesn't match
+func Not(e Expr) Expr {
+	return Expr{expr: "!" + e.operand(), fields: e.fields}
+}
+
+func (e Expr) operand() string {
+	if e.compound {
+		return "(" + e.expr + ")"
+	}
+	return e.expr
+}
+
+func comparison(field string, kind Kind, operator, value string) Expr {
+	return Expr{expr: field + " " + operator + " " + value, fields: map[string]Kind{field: kind}}
+}
+
+// StringField is a field of type string
+type StringField struct {
+	name string
+}
+
+// String returns the field with the given name, which is of type string
+func String(name string) StringField {
+	return StringField{name: name}
+}
+
+// Eq returns an expression which matches if the field equals value
+func (f StringField) Eq(value string) Expr {
+	return comparison(f.name, KindString, "==", strconv.Quote(value))
+}
+
+// Ne returns an expression which matches if the field doesn't equal value
+func (f StringField) Ne(value string) Expr {
+	return comparison(f.name, KindString, "!=", strconv.Quote(value))
+}
+
+// Contains returns an expression which matches if the field contains value
+func (f StringField) Contains(value string) Expr {
+	return comparison(f.name, KindString, "contains", strconv.Quote(value))
+}
+
+// StartsWith returns an expression which matches if the field starts with value
+func (f StringField) StartsWith(value string) Expr {
+	return comparison(f.name, KindString, "startsWith", strconv.Quote(value))
+}
+
+// EndsWith returns an expression which matches if the field ends with value
+func (f StringField) EndsWith(value string) Expr {
+	return comparison(f.name, KindString, "endsWith", strconv.Quote(value))
+}
+
+// In returns an expression which matches if the field equals one of the values
+func (f StringField) In(values ...string) Expr {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return comparison(f.name, KindString, "in", "["+strings.Join(quoted, ", ")+"]")
+}
+
+// NumberField is a field of a numeric type
+type NumberField struct {
+	name string
+}
+
+// Number returns the field with the given name, which is of a numeric type
+func Number(name string) NumberField {
+	return NumberField{name: name}
+}
+
+// Eq returns an expression which matches if the field equals value
+func (f NumberField) Eq(value float64) Expr {
+	return comparison(f.name, KindNumber, "==", formatNumber(value))
+}
+
+// Ne returns an expression which matches if the field doesn't equal value
+func (f NumberField) Ne(value float64) Expr {
+	return comparison(f.name, KindNumber, "!=", formatNumber(value))
+}
+
+// Gt returns an expression which matches if the field is greater than value
+func (f NumberField) Gt(value float64) Expr {
+	return comparison(f.name, KindNumber, ">", formatNumber(value))
+}
+
+// Ge returns an expression which matches if the field is greater than or equal to value
+func (f NumberField) Ge(value float64) Expr {
+	return comparison(f.name, KindNumber, ">=", formatNumber(value))
+}
+
+// Lt returns an expression which matches if the field is less than value
+func (f NumberField) Lt(value float64) Expr {
+	return comparison(f.name, KindNumber, "<", formatNumber(value))
+}
+
+// Le returns an expression which matches if the field is less than or equal to value
+func (f NumberField) Le(value float64) Expr {
+	return comparison(f.name, KindNumber, "<=", formatNumber(value))
+}
+
+// BoolField is a field of type bool
+type BoolField struct {
+	name string
+}
+
+// Bool returns the field with the given name, which is of type bool
+func Bool(name string) BoolField {
+	return BoolField{name: name}
+}
+
+// Eq returns an expression which matches if the field equals value
+func (f BoolField) Eq(value bool) Expr {
+	return comparison(f.name, KindBool, "==", strconv.FormatBool(value))
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
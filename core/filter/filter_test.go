@@ -0,0 +1,86 @@
+package filter
+
+import (
+	"testing"
+)
+
+func TestExpr(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		expr     Expr
+		expected string
+	}{
+		{"string_eq", String("name").Eq("c1.2"), `name == "c1.2"`},
+		{"string_escaped", String("name").Eq(`a "quoted" \ name`), `name == "a \"quoted\" \\ name"`},
+		{"string_in", String("name").In("c1.1", "c1.2"), `name in ["c1.1", "c1.2"]`},
+		{"string_starts_with", String("name").StartsWith("g1"), `name startsWith "g1"`},
+		{"number_ge", Number("vcpus").Ge(4), `vcpus >= 4`},
+		{"number_fraction", Number("ram").Lt(0.5), `ram < 0.5`},
+		{"bool_eq", Bool("extraSpecs.gpu").Eq(true), `extraSpecs.gpu == true`},
+		{"and", Number("vcpus").Ge(4).And(Number("ram").Le(8192)), `vcpus >= 4 && ram <= 8192`},
+		{
+			"nested",
+			Number("vcpus").Ge(4).And(String("name").StartsWith("g1").Or(String("name").StartsWith("g2"))),
+			`vcpus >= 4 && (name startsWith "g1" || name startsWith "g2")`,
+		},
+		{"not", Not(String("name").Eq("x").Or(Number("vcpus").Eq(1))), `!(name == "x" || vcpus == 1)`},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := tt.expr.String(); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSCIMExpr(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		expr     SCIMExpr
+		expected string
+	}{
+		{"string_eq", SCIMString("deliveryMethod").Eq("SAAS"), `deliveryMethod eq "SAAS"`},
+		{"string_contains", SCIMString("text").Contains(`say "hi"`), `text co "say \"hi\""`},
+		{"present", SCIMString("name").Present(), `name pr`},
+		{"number_gt", SCIMNumber("price").Gt(10), `price gt 10`},
+		{
+			"nested",
+			SCIMString("deliveryMethod").Eq("SAAS").And(SCIMString("name").StartsWith("a").Or(SCIMString("name").StartsWith("b"))),
+			`deliveryMethod eq "SAAS" and (name sw "a" or name sw "b")`,
+		},
+		{"not", SCIMNot(SCIMString("name").Eq("x")), `not (name eq "x")`},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := tt.expr.String(); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestExprValidate(t *testing.T) {
+	fields := Fields{"name": KindString, "vcpus": KindNumber}
+	for _, tt := range []struct {
+		desc    string
+		expr    interface{ Validate(Fields) error }
+		isValid bool
+	}{
+		{"valid", Number("vcpus").Ge(4).And(Not(String("name").StartsWith("g1"))), true},
+		{"unknown_field", Number("vcpus").Ge(4).Or(Number("ram").Ge(4096)), false},
+		{"wrong_kind", String("vcpus").Eq("4"), false},
+		{"conflicting_kinds", String("name").Eq("a").And(Number("name").Eq(1)), false},
+		{"scim_valid", SCIMString("name").Present().And(SCIMNumber("vcpus").Gt(1)), true},
+		{"scim_unknown_field", SCIMNot(SCIMString("text").Eq("a")), false},
+		{"scim_wrong_kind", SCIMNumber("name").Present(), false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.expr.Validate(fields)
+			if tt.isValid && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package filter
+
+import (
+	"strconv"
+)
+
+// SCIMExpr is a filter expression in the SCIM syntax (RFC 7644, section 3.4.2.2)
+type SCIMExpr struct {
+	expr string
+	// Set if the expression needs to be enclosed in parentheses when combined with other expressions
+	compound bool
+	// fields used in the expression, with their kind
+	fields map[string]Kind
+}
+
+// String returns the expression, to be passed to the filter of a list request
+func (e SCIMExpr) String() string {
+	return e.expr
+}
+
+// Validate returns an error if the expression uses an attribute which isn't in fields, or with another kind
+func (e SCIMExpr) Validate(fields Fields) error {
+	return fields.validate(e.fields)
+}
+
+// And returns an expression which matches if both e and other match
+func (e SCIMExpr) And(other SCIMExpr) SCIMExpr {
+	return SCIMExpr{expr: e.operand() + " and " + other.operand(), compound: true, fields: mergeFields(e.fields, other.fields)}
+}
+
+// Or returns an expression which matches if e or other match
+func (e SCIMExpr) Or(other SCIMExpr) SCIMExpr {
+	return SCIMExpr{expr: e.operand() + " or " + other.operand(), compound: true, fields: mergeFields(e.fields, other.fields)}
+}
+
+// SCIMNot returns an expression which matches if e doesn't match
+func SCIMNot(e SCIMExpr) SCIMExpr {
+	return SCIMExpr{expr: "not (" + e.expr + ")", fields: e.fields}
+}
+
+func (e SCIMExpr) operand() string {
+	if e.compound {
+		return "(" + e.expr + ")"
+	}
+	return e.expr
+}
+
+func scimComparison(attribute string, kind Kind, operator, value string) SCIMExpr {
+	return SCIMExpr{expr: attribute + " " + operator + " " + value, fields: map[string]Kind{attribute: kind}}
+}
+
+func scimPresent(attribute string, kind Kind) SCIMExpr {
+	return SCIMExpr{expr: attribute + " pr", fields: map[string]Kind{attribute: kind}}
+}
+
+// SCIMStringField is an attribute of type string
+type SCIMStringField struct {
+	name string
+}
+
+// SCIMString returns the attribute with the given name, which is of type string
+func SCIMString(name string) SCIMStringField {
+	return SCIMStringField{name: name}
+}
+
+// Eq returns an expression which matches if the attribute equals value
+func (f SCIMStringField) Eq(value string) SCIMExpr {
+	return scimComparison(f.name, KindString, "eq", strconv.Quote(value))
+}
+
+// Ne returns an expression which matches if the attribute doesn't equal value
+func (f SCIMStringField) Ne(value string) SCIMExpr {
+	return scimComparison(f.name, KindString, "ne", strconv.Quote(value))
+}
+
+// Contains returns an expression which matches if the attribute contains value
+func (f SCIMStringField) Contains(value string) SCIMExpr {
+	return scimComparison(f.name, KindString, "co", strconv.Quote(value))
+}
+
+// StartsWith returns an expression which matches if the attribute starts with value
+func (f SCIMStringField) StartsWith(value string) SCIMExpr {
+	return scimComparison(f.name, KindString, "sw", strconv.Quote(value))
+}
+
+// EndsWith returns an expression which matches if the attribute ends with value
+func (f SCIMStringField) EndsWith(value string) SCIMExpr {
+	return scimComparison(f.name, KindString, "ew", strconv.Quote(value))
+}
+
+// Present returns an expression which matches if the attribute has a value
+func (f SCIMStringField) Present() SCIMExpr {
+	return scimPresent(f.name, KindString)
+}
+
+// SCIMNumberField is an attribute of a numeric type
+type SCIMNumberField struct {
+	name string
+}
+
+// SCIMNumber returns the attribute with the given name, which is of a numeric type
+func SCIMNumber(name string) SCIMNumberField {
+	return SCIMNumberField{name: name}
+}
+
+// Eq returns an expression which matches if the attribute equals value
+func (f SCIMNumberField) Eq(value float64) SCIMExpr {
+	return scimComparison(f.name, KindNumber, "eq", formatNumber(value))
+}
+
+// Ne returns an expression which matches if the attribute doesn't equal value
+func (f SCIMNumberField) Ne(value float64) SCIMExpr {
+	return scimComparison(f.name, KindNumber, "ne", formatNumber(value))
+}
+
+// Gt returns an expression which matches if the attribute is greater than value
+func (f SCIMNumberField) Gt(value float64) SCIMExpr {
+	return scimComparison(f.name, KindNumber, "gt", formatNumber(value))
+}
+
+// Ge returns an expression which matches if the attribute is greater than or equal to value
+func (f SCIMNumberField) Ge(value float64) SCIMExpr {
+	return scimComparison(f.name, KindNumber, "ge", formatNumber(value))
+}
+
+// Lt returns an expression which matches if the attribute is less than value
+func (f SCIMNumberField) Lt(value float64) SCIMExpr {
+	return scimComparison(f.name, KindNumber, "lt", formatNumber(value))
+}
+
+// Le returns an expression which matches if the attribute is less than or equal to value
+func (f SCIMNumberField) Le(value float64) SCIMExpr {
+	return scimComparison(f.name, KindNumber, "le", formatNumber(value))
+}
+
+// Present returns an expression which matches if the attribute has a value
+func (f SCIMNumberField) Present() SCIMExpr {
+	return scimPresent(f.name, KindNumber)
+}
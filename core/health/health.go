@@ -0,0 +1,139 @@
+// Package health provides helpers to check whether a STACKIT API is reachable
+// and whether the configured credentials are accepted.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+// Status is the result of a health check
+type Status string
+
+const (
+	// StatusReachable means the API answered and the credentials were accepted
+	StatusReachable Status = "reachable"
+	// StatusAuthFailed means the API could be reached, but the credentials were rejected
+	// or no access token could be obtained with them
+	StatusAuthFailed Status = "auth_failed"
+	// StatusUnreachable means the API could not be reached
+	StatusUnreachable Status = "unreachable"
+)
+
+// Checker is implemented by the API clients of all services
+type Checker interface {
+	Healthz(ctx context.Context) error
+}
+
+// Error is returned by Probe and by the Healthz method of the API clients if the check failed
+type Error struct {
+	Status Status
+	Err    error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("health check failed (%s): %v", e.Status, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// StatusOf returns the Status corresponding to an error returned by Probe or Healthz.
+// A nil error means StatusReachable. Errors not created by this package are considered StatusUnreachable.
+func StatusOf(err error) Status {
+	if err == nil {
+		return StatusReachable
+	}
+	var healthErr *Error
+	if errors.As(err, &healthErr) {
+		return healthErr.Status
+	}
+	return StatusUnreachable
+}
+
+// Probe sends an authenticated GET request to url using client and classifies the result:
+//   - if no access token could be obtained or the response status is 401 or 403, the status is StatusAuthFailed
+//   - if the request could not be sent or the response status is 502, 503 or 504, the status is StatusUnreachable
+//   - any other response means the API is reachable, even if the path itself is not found, and nil is returned
+func Probe(ctx context.Context, client *http.Client, url string) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return &Error{Status: StatusUnreachable, Err: err}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		var authErr *clients.AuthenticationError
+		if errors.As(err, &authErr) {
+			return &Error{Status: StatusAuthFailed, Err: err}
+		}
+		return &Error{Status: StatusUnreachable, Err: err}
+	}
+	defer resp.Body.Close() //nolint:errcheck // body is not read
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &Error{Status: StatusAuthFailed, Err: oapierror.NewError(resp.StatusCode, resp.Status)}
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return &Error{Status: StatusUnreachable, Err: oapierror.NewError(resp.StatusCode, resp.Status)}
+	}
+	return nil
+}
+
+// CheckAll runs the health checks of all checkers concurrently and returns the Status of each of them,
+// keyed the same way as the checkers.
+func CheckAll(ctx context.Context, checkers map[string]Checker) map[string]Status {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	statuses := make(map[string]Status, len(checkers))
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func(name string, checker Checker) {
+			defer wg.Done()
+			status := StatusOf(checker.Healthz(ctx))
+			mu.Lock()
+			statuses[name] = status
+			mu.Unlock()
+		}(name, checker)
+	}
+	wg.Wait()
+	return statuses
+}
+
+// Warmup runs the health checks of the API clients concurrently, so that the access token is obtained and the
+// connections to the APIs are established before the first request, e.g. in the readiness probe of a service.
+// It returns an error, joining the ones of the failed checks, if any API client can't reach its API or its
+// credentials are rejected, see StatusOf.
+//
+// It returns once ctx is done, even if a check is still running, e.g. obtaining the access token.
+func Warmup(ctx context.Context, checkers ...Checker) error {
+	errs := make(chan error, len(checkers))
+	for _, checker := range checkers {
+		go func(checker Checker) {
+			errs <- checker.Healthz(ctx)
+		}(checker)
+	}
+
+	var failed []error
+	for range checkers {
+		select {
+		case err := <-errs:
+			if err != nil {
+				failed = append(failed, err)
+			}
+		case <-ctx.Done():
+			return &Error{Status: StatusUnreachable, Err: fmt.Errorf("warmup: %w", ctx.Err())}
+		}
+	}
+	return errors.Join(failed...)
+}
@@ -0,0 +1,142 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+type roundTripperFn func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripperFn) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+type checkerFn func(ctx context.Context) error
+
+func (fn checkerFn) Healthz(ctx context.Context) error {
+	return fn(ctx)
+}
+
+func TestProbe(t *testing.T) {
+	for _, tt := range []struct {
+		desc           string
+		statusCode     int
+		transportErr   error
+		expectedStatus Status
+	}{
+		{
+			desc:           "ok",
+			statusCode:     http.StatusOK,
+			expectedStatus: StatusReachable,
+		},
+		{
+			desc:           "not_found",
+			statusCode:     http.StatusNotFound,
+			expectedStatus: StatusReachable,
+		},
+		{
+			desc:           "unauthorized",
+			statusCode:     http.StatusUnauthorized,
+			expectedStatus: StatusAuthFailed,
+		},
+		{
+			desc:           "forbidden",
+			statusCode:     http.StatusForbidden,
+			expectedStatus: StatusAuthFailed,
+		},
+		{
+			desc:           "bad_gateway",
+			statusCode:     http.StatusBadGateway,
+			expectedStatus: StatusUnreachable,
+		},
+		{
+			desc:           "token_error",
+			transportErr:   &clients.AuthenticationError{Err: fmt.Errorf("invalid key")},
+			expectedStatus: StatusAuthFailed,
+		},
+		{
+			desc:           "connection_error",
+			transportErr:   fmt.Errorf("connection refused"),
+			expectedStatus: StatusUnreachable,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			client := &http.Client{
+				Transport: roundTripperFn(func(req *http.Request) (*http.Response, error) {
+					if tt.transportErr != nil {
+						return nil, tt.transportErr
+					}
+					return http.DefaultTransport.RoundTrip(req)
+				}),
+			}
+
+			err := Probe(context.Background(), client, server.URL)
+			if got := StatusOf(err); got != tt.expectedStatus {
+				t.Fatalf("expected status %s, got %s (error: %v)", tt.expectedStatus, got, err)
+			}
+		})
+	}
+}
+
+func TestCheckAll(t *testing.T) {
+	checkers := map[string]Checker{
+		"dns": checkerFn(func(_ context.Context) error { return nil }),
+		"ske": checkerFn(func(_ context.Context) error {
+			return &Error{Status: StatusAuthFailed, Err: fmt.Errorf("unauthorized")}
+		}),
+		"iaas": checkerFn(func(_ context.Context) error { return fmt.Errorf("some error") }),
+	}
+	expected := map[string]Status{
+		"dns":  StatusReachable,
+		"ske":  StatusAuthFailed,
+		"iaas": StatusUnreachable,
+	}
+
+	got := CheckAll(context.Background(), checkers)
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d statuses, got %d", len(expected), len(got))
+	}
+	for name, status := range expected {
+		if got[name] != status {
+			t.Errorf("expected status %s for %s, got %s", status, name, got[name])
+		}
+	}
+}
+
+func TestWarmup(t *testing.T) {
+	ok := checkerFn(func(_ context.Context) error { return nil })
+	authFailed := checkerFn(func(_ context.Context) error {
+		return &Error{Status: StatusAuthFailed, Err: fmt.Errorf("invalid key")}
+	})
+	release := make(chan struct{})
+	defer close(release)
+	blocked := checkerFn(func(_ context.Context) error {
+		<-release
+		return nil
+	})
+
+	if err := Warmup(context.Background(), ok, ok); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := Warmup(context.Background(), ok, authFailed); StatusOf(err) != StatusAuthFailed {
+		t.Fatalf("expected status %s, got %s: %v", StatusAuthFailed, StatusOf(err), err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := Warmup(ctx, ok, blocked); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline to be exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+// Package labels builds Kubernetes-style label selectors, e.g. for the list requests of iaas which filter by labels:
+//
+//	selector := labels.NewSelector().Eq("env", "prod").NotEq("tier", "db").In("region", "eu01", "eu02")
+//	client.ListServers(ctx, projectId, region).LabelSelectorFrom(selector)
+//
+// If the selector is invalid, the request fails with its error before it is sent. The selector string can also be
+// built with Build, e.g. for a request which takes it as string:
+//
+//	selector, err := labels.NewSelector().Eq("env", "prod").Build()
+//	if err != nil {
+//		// handle error
+//	}
+//	client.ListServers(ctx, projectId, region).LabelSelector(selector)
+package labels
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxNameLength   = 63
+	maxPrefixLength = 253
+	maxValueLength  = 63
+)
+
+var (
+	nameRegex   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	prefixRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// Selector is a label selector, which matches if all of its requirements match.
+// The zero value matches everything.
+//
+// Keys and values are validated as the ones of Kubernetes labels, the first invalid requirement is returned by Build.
+type Selector struct {
+	requirements []string
+	err          error
+}
+
+// NewSelector returns an empty Selector
+func NewSelector() Selector {
+	return Selector{}
+}
+
+// Eq requires the label key to have the given value
+func (s Selector) Eq(key, value string) Selector {
+	return s.add(key, "=", value)
+}
+
+// NotEq requires the label key to not have the given value, which includes not having the label at all
+func (s Selector) NotEq(key, value string) Selector {
+	return s.add(key, "!=", value)
+}
+
+// In requires the label key to have one of the given values
+func (s Selector) In(key string, values ...string) Selector {
+	return s.addSet(key, "in", values)
+}
+
+// NotIn requires the label key to have none of the given values, which includes not having the label at all
+func (s Selector) NotIn(key string, values ...string) Selector {
+	return s.addSet(key, "notin", values)
+}
+
+// Exists requires the label key to be set, with any value
+func (s Selector) Exists(key string) Selector {
+	if err := validateKey(key); err != nil {
+		return s.fail(err)
+	}
+	return s.append(key)
+}
+
+// NotExists requires the label key to not be set
+func (s Selector) NotExists(key string) Selector {
+	if err := validateKey(key); err != nil {
+		return s.fail(err)
+	}
+	return s.append("!" + key)
+}
+
+// Build returns the selector string, or the error of the first invalid requirement
+func (s Selector) Build() (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	return s.String(), nil
+}
+
+// String returns the selector string. If one of the requirements is invalid, it returns an empty string,
+// use Build to get the error.
+func (s Selector) String() string {
+	if s.err != nil {
+		return ""
+	}
+	return strings.Join(s.requirements, ",")
+}
+
+func (s Selector) add(key, operator, value string) Selector {
+	if err := validateKey(key); err != nil {
+		return s.fail(err)
+	}
+	if err := validateValue(value); err != nil {
+		return s.fail(fmt.Errorf("label %q: %w", key, err))
+	}
+	return s.append(key + operator + value)
+}
+
+func (s Selector) addSet(key, operator string, values []string) Selector {
+	if err := validateKey(key); err != nil {
+		return s.fail(err)
+	}
+	if len(values) == 0 {
+		return s.fail(fmt.Errorf("label %q: operator %s requires at least one value", key, operator))
+	}
+	for _, value := range values {
+		if err := validateValue(value); err != nil {
+			return s.fail(fmt.Errorf("label %q: %w", key, err))
+		}
+	}
+	return s.append(fmt.Sprintf("%s %s (%s)", key, operator, strings.Join(values, ",")))
+}
+
+// append returns a copy of s with the requirement, so that selectors derived from the same selector don't share requirements
+func (s Selector) append(requirement string) Selector {
+	if s.err != nil {
+		return s
+	}
+	requirements := make([]string, len(s.requirements), len(s.requirements)+1)
+	copy(requirements, s.requirements)
+	s.requirements = append(requirements, requirement)
+	return s
+}
+
+func (s Selector) fail(err error) Selector {
+	if s.err == nil {
+		s.err = err
+	}
+	return s
+}
+
+// validateKey validates a label key, which is a name with an optional DNS subdomain prefix, e.g. stackit.cloud/env
+func validateKey(key string) error {
+	name := key
+	if prefix, n, found := strings.Cut(key, "/"); found {
+		if prefix == "" || len(prefix) > maxPrefixLength || !prefixRegex.MatchString(prefix) {
+			return fmt.Errorf("invalid label key %q: the prefix must be a DNS subdomain of at most %d characters", key, maxPrefixLength)
+		}
+		name = n
+	}
+	if name == "" || len(name) > maxNameLength || !nameRegex.MatchString(name) {
+		return fmt.Errorf("invalid label key %q: the name must have at most %d alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character", key, maxNameLength)
+	}
+	return nil
+}
+
+func validateValue(value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxValueLength || !nameRegex.MatchString(value) {
+		return fmt.Errorf("invalid label value %q: the value must have at most %d alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character", value, maxValueLength)
+	}
+	return nil
+}
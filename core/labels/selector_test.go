@@ -0,0 +1,97 @@
+package labels
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSelector(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		selector Selector
+		isValid  bool
+		expected string
+	}{
+		{
+			desc:     "empty",
+			selector: NewSelector(),
+			isValid:  true,
+			expected: "",
+		},
+		{
+			desc:     "all_operators",
+			selector: NewSelector().Eq("env", "prod").NotEq("tier", "db").In("region", "eu01", "eu02").NotIn("zone", "a").Exists("team").NotExists("deprecated"),
+			isValid:  true,
+			expected: "env=prod,tier!=db,region in (eu01,eu02),zone notin (a),team,!deprecated",
+		},
+		{
+			desc:     "prefixed_key_and_empty_value",
+			selector: NewSelector().Eq("stackit.cloud/env", ""),
+			isValid:  true,
+			expected: "stackit.cloud/env=",
+		},
+		{
+			desc:     "invalid_key",
+			selector: NewSelector().Eq("env,tier", "prod"),
+		},
+		{
+			desc:     "invalid_prefix",
+			selector: NewSelector().Exists("Stackit.Cloud/env"),
+		},
+		{
+			desc:     "key_too_long",
+			selector: NewSelector().Exists(strings.Repeat("a", 64)),
+		},
+		{
+			desc:     "invalid_value",
+			selector: NewSelector().Eq("env", "prod)"),
+		},
+		{
+			desc:     "invalid_set_value",
+			selector: NewSelector().In("region", "eu01", "eu 02"),
+		},
+		{
+			desc:     "empty_set",
+			selector: NewSelector().NotIn("region"),
+		},
+		{
+			desc:     "error_is_kept",
+			selector: NewSelector().Eq("env", "-prod").Eq("tier", "db"),
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := tt.selector.Build()
+			if tt.isValid && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected error, got selector %q", got)
+				}
+				if tt.selector.String() != "" {
+					t.Errorf("expected empty string for invalid selector, got %q", tt.selector.String())
+				}
+				return
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSelectorDerived(t *testing.T) {
+	base := NewSelector().Eq("env", "prod")
+	db := base.Eq("tier", "db")
+	web := base.Eq("tier", "web")
+
+	if got := db.String(); got != "env=prod,tier=db" {
+		t.Errorf("unexpected selector %q", got)
+	}
+	if got := web.String(); got != "env=prod,tier=web" {
+		t.Errorf("unexpected selector %q", got)
+	}
+	if got := base.String(); got != "env=prod" {
+		t.Errorf("unexpected selector %q", got)
+	}
+}
@@ -0,0 +1,90 @@
+package lro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+)
+
+// WaitHandler describes the wait handler of an async action of a service, e.g. the creation of a DNS zone, so that
+// the async action can be handled as an Operation. The wait packages of the services return the operations of their
+// wait handlers, e.g. wait.CreateZoneOperation and wait.CreateZonePollFunc of dns.
+//
+// The name of an operation is the kind followed by the ids, e.g. "dns/CreateZone/{projectId}/{zoneId}".
+type WaitHandler[T any] struct {
+	// Kind identifies the async action, e.g. "dns/CreateZone"
+	Kind string
+	// IDs is the number of ids the wait handler is created with
+	IDs int
+	// New returns the wait handler for the ids in the name of an operation
+	New func(ctx context.Context, ids []string) *wait.AsyncActionHandler[T]
+}
+
+// Operation returns the operation of the async action identified by the ids, checked with the wait handler.
+// Its throttle and timeout are the ones of the wait handler.
+func (h WaitHandler[T]) Operation(ids ...string) *Operation[T] {
+	escaped := make([]string, 0, len(ids)+1)
+	escaped = append(escaped, h.Kind)
+	for _, id := range ids {
+		escaped = append(escaped, url.PathEscape(id))
+	}
+	op := New(strings.Join(escaped, "/"), h.PollFunc())
+	if len(ids) == h.IDs {
+		handler := h.New(context.Background(), ids)
+		op.SetThrottle(handler.Throttle()).SetTimeout(handler.Timeout())
+	}
+	return op
+}
+
+// PollFunc returns the PollFunc of the operations returned by Operation, e.g. to resume one with Resume.
+//
+// The wait handler is created again for each context the operation is polled with, so the state a wait handler keeps
+// between its checks, e.g. whether an intermediate state was reached, is kept while waiting with Operation.Wait but
+// not between calls of Operation.Poll with different contexts.
+func (h WaitHandler[T]) PollFunc() PollFunc[T] {
+	var (
+		mu      sync.Mutex
+		lastCtx context.Context
+		name    string
+		handler *wait.AsyncActionHandler[T]
+	)
+	return func(ctx context.Context, opName string) (bool, *T, error) {
+		mu.Lock()
+		if handler == nil || lastCtx != ctx || name != opName {
+			ids, err := h.ids(opName)
+			if err != nil {
+				mu.Unlock()
+				return false, nil, err
+			}
+			lastCtx, name, handler = ctx, opName, h.New(ctx, ids)
+		}
+		current := handler
+		mu.Unlock()
+		return current.Check()
+	}
+}
+
+// ids returns the ids in the name of an operation of the wait handler
+func (h WaitHandler[T]) ids(name string) ([]string, error) {
+	rest, ok := strings.CutPrefix(name, h.Kind+"/")
+	if !ok {
+		return nil, fmt.Errorf("invalid %s operation name %q", h.Kind, name)
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != h.IDs {
+		return nil, fmt.Errorf("invalid %s operation name %q: expected %d ids, got %d", h.Kind, name, h.IDs, len(parts))
+	}
+	ids := make([]string, len(parts))
+	for i, part := range parts {
+		id, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s operation name %q: %w", h.Kind, name, err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
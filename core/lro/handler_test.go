@@ -0,0 +1,101 @@
+package lro
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+)
+
+// testWaitHandler returns a WaitHandler whose wait handler finishes after the given number of checks, counting the
+// created wait handlers and the ids they are created with
+func testWaitHandler(checks int, created *int, gotIds *[]string) WaitHandler[result] {
+	return WaitHandler[result]{
+		Kind: "test/CreateResource",
+		IDs:  2,
+		New: func(_ context.Context, ids []string) *wait.AsyncActionHandler[result] {
+			*created++
+			*gotIds = ids
+			calls := 0
+			return wait.New(func() (bool, *result, error) {
+				calls++
+				if calls < checks {
+					return false, nil, nil
+				}
+				return true, &result{Id: ids[1]}, nil
+			}).SetThrottle(time.Millisecond).SetTimeout(time.Second)
+		},
+	}
+}
+
+func TestWaitHandlerOperation(t *testing.T) {
+	var created int
+	var ids []string
+	handler := testWaitHandler(3, &created, &ids)
+
+	op := handler.Operation("pid", "a/b")
+	if want := "test/CreateResource/pid/a%2Fb"; op.Name() != want {
+		t.Fatalf("expected name %s, got %s", want, op.Name())
+	}
+	if op.throttle != time.Millisecond || op.timeout != time.Second {
+		t.Errorf("expected the throttle and timeout of the wait handler, got %s and %s", op.throttle, op.timeout)
+	}
+
+	created = 0
+	res, err := op.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	if diff := cmp.Diff(&result{Id: "a/b"}, res); diff != "" {
+		t.Errorf("unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"pid", "a/b"}, ids); diff != "" {
+		t.Errorf("unexpected ids (-want +got):\n%s", diff)
+	}
+	if created != 1 {
+		t.Errorf("expected the wait handler to be kept while waiting, %d were created", created)
+	}
+}
+
+func TestWaitHandlerResume(t *testing.T) {
+	var created int
+	var ids []string
+	data, err := json.Marshal(testWaitHandler(1, &created, &ids).Operation("pid", "rid"))
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	op, err := Resume(data, testWaitHandler(1, &created, &ids).PollFunc())
+	if err != nil {
+		t.Fatalf("Resume failed: %v", err)
+	}
+	done, err := op.Poll(context.Background())
+	if err != nil || !done {
+		t.Fatalf("expected the resumed operation to finish, got done %t and error %v", done, err)
+	}
+	if res, _ := op.Result(); res == nil || res.Id != "rid" {
+		t.Errorf("unexpected result %+v", res)
+	}
+}
+
+func TestWaitHandlerInvalidName(t *testing.T) {
+	var created int
+	var ids []string
+	poll := testWaitHandler(1, &created, &ids).PollFunc()
+	for _, name := range []string{
+		"other/CreateResource/pid/rid",
+		"test/CreateResource/pid",
+		"test/CreateResource/pid/rid/extra",
+		"test/CreateResource/pid/%zz",
+	} {
+		if _, _, err := poll(context.Background(), name); err == nil {
+			t.Errorf("expected an error for the name %s", name)
+		}
+	}
+	if created != 0 {
+		t.Errorf("expected no wait handler to be created for invalid names, %d were created", created)
+	}
+}
@@ -0,0 +1,196 @@
+// Package lro represents long-running operations, the async work started by an API request, as an Operation
+// identified by a name. An Operation can be polled, waited for and serialized to JSON, so that its handle can be
+// persisted and the polling resumed in another process, e.g. an iaas project request:
+//
+//	op := wait.ProjectRequestOperation(iaasClient, projectId, region, requestId)
+//	data, err := json.Marshal(op)
+//	// persist data, then in another process
+//	op, err := lro.Resume(data, wait.ProjectRequestPollFunc(iaasClient))
+//	request, err := op.Wait(ctx)
+//
+// The wait packages of the services return the async actions of their wait handlers as operations, built with
+// WaitHandler, e.g. the creation of a DNS zone:
+//
+//	op := wait.CreateZoneOperation(dnsClient, projectId, zoneId)
+//	// persist the JSON of op, then in another process
+//	op, err := lro.Resume(data, wait.CreateZonePollFunc(dnsClient))
+package lro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+)
+
+const (
+	defaultThrottle = 5 * time.Second
+	defaultTimeout  = 30 * time.Minute
+)
+
+// PollFunc checks the operation identified by name once.
+//   - done == true if the operation has finished, false otherwise.
+//   - result is the result of the operation, if it has finished.
+//   - err != nil if the operation couldn't be checked, or if it finished unsuccessfully.
+type PollFunc[T any] func(ctx context.Context, name string) (done bool, result *T, err error)
+
+// Operation is a long-running operation with a result of type T. It is safe for concurrent use.
+type Operation[T any] struct {
+	name     string
+	poll     PollFunc[T]
+	throttle time.Duration
+	timeout  time.Duration
+
+	mu     sync.Mutex
+	done   bool
+	result *T
+	err    error
+}
+
+// operationJSON is the serialized form of an Operation
+type operationJSON[T any] struct {
+	Name   string `json:"name"`
+	Done   bool   `json:"done,omitempty"`
+	Result *T     `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// New returns the Operation identified by name, which is checked with poll.
+// The name must contain everything poll needs to check the operation, e.g. the ids of the project and of the resource,
+// so that the operation can be resumed from its JSON.
+func New[T any](name string, poll PollFunc[T]) *Operation[T] {
+	return &Operation[T]{
+		name:     name,
+		poll:     poll,
+		throttle: defaultThrottle,
+		timeout:  defaultTimeout,
+	}
+}
+
+// Resume returns the Operation serialized in data with json.Marshal, which is checked with poll
+func Resume[T any](data []byte, poll PollFunc[T]) (*Operation[T], error) {
+	op := New("", poll)
+	if err := json.Unmarshal(data, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Name returns the name of the operation
+func (o *Operation[T]) Name() string {
+	return o.name
+}
+
+// SetPollFunc sets the function which checks the operation, e.g. after unmarshalling it from JSON
+func (o *Operation[T]) SetPollFunc(poll PollFunc[T]) *Operation[T] {
+	o.poll = poll
+	return o
+}
+
+// SetThrottle sets the time interval between each check of the operation in Wait
+func (o *Operation[T]) SetThrottle(d time.Duration) *Operation[T] {
+	o.throttle = d
+	return o
+}
+
+// SetTimeout sets the maximum duration of Wait
+func (o *Operation[T]) SetTimeout(d time.Duration) *Operation[T] {
+	o.timeout = d
+	return o
+}
+
+// Done reports whether the operation has finished, as of the last check
+func (o *Operation[T]) Done() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.done
+}
+
+// Result returns the result of the operation and the error it finished with, if it has finished
+func (o *Operation[T]) Result() (*T, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.result, o.err
+}
+
+// Poll checks the operation once, unless it has already finished. It returns whether the operation has finished
+// and the error it finished with, or the error of checking it.
+func (o *Operation[T]) Poll(ctx context.Context) (done bool, err error) {
+	o.mu.Lock()
+	if o.done {
+		defer o.mu.Unlock()
+		return true, o.err
+	}
+	o.mu.Unlock()
+	if o.poll == nil {
+		return false, fmt.Errorf("operation %s has no poll function, see SetPollFunc", o.name)
+	}
+
+	done, result, err := o.poll(ctx, o.name)
+	if !done {
+		return false, err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.done = true
+	o.result = result
+	o.err = err
+	return true, err
+}
+
+// Wait checks the operation until it has finished, the timeout is reached or ctx is done.
+// It returns the result of the operation, or the error it finished with. Temporary errors of the API while checking,
+// e.g. 502 Bad Gateway, are retried as in wait.AsyncActionHandler.
+func (o *Operation[T]) Wait(ctx context.Context) (*T, error) {
+	return wait.New(func() (waitFinished bool, response *T, err error) {
+		done, err := o.Poll(ctx)
+		if !done {
+			return false, nil, err
+		}
+		result, err := o.Result()
+		return true, result, err
+	}).SetThrottle(o.throttle).SetTimeout(o.timeout).WaitWithContext(ctx)
+}
+
+// MarshalJSON serializes the name of the operation and, if it has finished, its result and error message
+func (o *Operation[T]) MarshalJSON() ([]byte, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	data := operationJSON[T]{Name: o.name, Done: o.done, Result: o.result}
+	if o.err != nil {
+		data.Error = o.err.Error()
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON restores an operation serialized with MarshalJSON. Its poll function must be set with SetPollFunc,
+// unless it is unmarshalled into an Operation that already has one, see Resume.
+func (o *Operation[T]) UnmarshalJSON(data []byte) error {
+	var v operationJSON[T]
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.Name == "" {
+		return fmt.Errorf("operation has no name")
+	}
+	if o.throttle == 0 {
+		o.throttle = defaultThrottle
+	}
+	if o.timeout == 0 {
+		o.timeout = defaultTimeout
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.name = v.Name
+	o.done = v.Done
+	o.result = v.Result
+	o.err = nil
+	if v.Error != "" {
+		o.err = errors.New(v.Error)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package lro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+type result struct {
+	Id string `json:"id"`
+}
+
+// pollAfter returns a PollFunc which finishes with res and err after the given number of checks
+func pollAfter(checks int, res *result, err error) (PollFunc[result], *int) {
+	calls := 0
+	return func(_ context.Context, name string) (bool, *result, error) {
+		calls++
+		if name != "projects/pid/requests/rid" {
+			return false, nil, fmt.Errorf("unexpected name %s", name)
+		}
+		if calls < checks {
+			return false, nil, nil
+		}
+		return true, res, err
+	}, &calls
+}
+
+func TestOperationWait(t *testing.T) {
+	for _, tt := range []struct {
+		desc       string
+		checks     int
+		res        *result
+		err        error
+		wantRes    *result
+		wantErr    bool
+		wantChecks int
+	}{
+		{
+			desc:       "success",
+			checks:     3,
+			res:        &result{Id: "rid"},
+			wantRes:    &result{Id: "rid"},
+			wantChecks: 3,
+		},
+		{
+			desc:       "failure",
+			checks:     2,
+			res:        &result{Id: "rid"},
+			err:        fmt.Errorf("request failed"),
+			wantRes:    &result{Id: "rid"},
+			wantErr:    true,
+			wantChecks: 2,
+		},
+		{
+			desc:       "timeout",
+			checks:     1000,
+			wantErr:    true,
+			wantChecks: -1,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			poll, calls := pollAfter(tt.checks, tt.res, tt.err)
+			op := New("projects/pid/requests/rid", poll).SetThrottle(time.Millisecond).SetTimeout(50 * time.Millisecond)
+
+			gotRes, err := op.Wait(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Wait error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantRes, gotRes); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+			if tt.wantChecks >= 0 && *calls != tt.wantChecks {
+				t.Errorf("expected %d checks, got %d", tt.wantChecks, *calls)
+			}
+			if tt.wantChecks >= 0 != op.Done() {
+				t.Errorf("unexpected Done %t", op.Done())
+			}
+		})
+	}
+}
+
+func TestOperationPoll(t *testing.T) {
+	poll, calls := pollAfter(2, &result{Id: "rid"}, nil)
+	op := New("projects/pid/requests/rid", poll)
+	if op.Name() != "projects/pid/requests/rid" {
+		t.Errorf("unexpected name %s", op.Name())
+	}
+
+	for i, wantDone := range []bool{false, true, true} {
+		done, err := op.Poll(context.Background())
+		if err != nil {
+			t.Fatalf("Poll %d failed: %v", i, err)
+		}
+		if done != wantDone {
+			t.Errorf("Poll %d: expected done %t, got %t", i, wantDone, done)
+		}
+	}
+	if *calls != 2 {
+		t.Errorf("expected a finished operation not to be checked again, got %d checks", *calls)
+	}
+}
+
+func TestOperationPollTemporaryError(t *testing.T) {
+	calls := 0
+	op := New("projects/pid/requests/rid", func(context.Context, string) (bool, *result, error) {
+		calls++
+		if calls == 1 {
+			return false, nil, &oapierror.GenericOpenAPIError{StatusCode: http.StatusBadGateway}
+		}
+		return true, &result{Id: "rid"}, nil
+	}).SetThrottle(time.Millisecond)
+
+	res, err := op.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("expected the temporary error to be retried, got %v", err)
+	}
+	if res.Id != "rid" {
+		t.Errorf("unexpected result %+v", res)
+	}
+}
+
+func TestOperationJSON(t *testing.T) {
+	for _, tt := range []struct {
+		desc    string
+		checks  int
+		err     error
+		wantErr bool
+	}{
+		{
+			desc:   "pending",
+			checks: 2,
+		},
+		{
+			desc:   "done",
+			checks: 1,
+		},
+		{
+			desc:    "failed",
+			checks:  1,
+			err:     fmt.Errorf("request failed"),
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			poll, _ := pollAfter(tt.checks, &result{Id: "rid"}, tt.err)
+			op := New("projects/pid/requests/rid", poll)
+			_, _ = op.Poll(context.Background())
+
+			data, err := json.Marshal(op)
+			if err != nil {
+				t.Fatalf("marshalling: %v", err)
+			}
+
+			resumedPoll, resumedCalls := pollAfter(1, &result{Id: "rid"}, tt.err)
+			resumed, err := Resume(data, resumedPoll)
+			if err != nil {
+				t.Fatalf("resuming: %v", err)
+			}
+			if resumed.Name() != op.Name() || resumed.Done() != op.Done() {
+				t.Fatalf("expected %s (done %t), got %s (done %t)", op.Name(), op.Done(), resumed.Name(), resumed.Done())
+			}
+			res, err := resumed.SetThrottle(time.Millisecond).Wait(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Wait error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(&result{Id: "rid"}, res); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+			if op.Done() && *resumedCalls != 0 {
+				t.Errorf("expected a finished operation not to be checked after resuming")
+			}
+		})
+	}
+}
+
+func TestOperationUnmarshalJSON(t *testing.T) {
+	var op Operation[result]
+	if err := json.Unmarshal([]byte(`{"done": true}`), &op); err == nil {
+		t.Errorf("expected an error for an operation without name")
+	}
+	if err := json.Unmarshal([]byte(`{"name": "projects/pid/requests/rid"}`), &op); err != nil {
+		t.Fatalf("unmarshalling: %v", err)
+	}
+	if _, err := op.Poll(context.Background()); err == nil {
+		t.Errorf("expected an error without poll function")
+	}
+}
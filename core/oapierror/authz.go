@@ -0,0 +1,57 @@
+package oapierror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// forbiddenMarkers identify an authorization failure in a normalized code or message, see normalizeConflict
+var forbiddenMarkers = []string{"forbidden", "permission", "denied", "unauthorized", "notauthorized", "notpermitted"}
+
+// notFoundMarkers identify a missing resource in a normalized code or message, see normalizeConflict
+var notFoundMarkers = []string{"notfound", "notexist", "nosuch"}
+
+// AuthzHint tells whether err is, or wraps, a GenericOpenAPIError because the caller isn't permitted to access the
+// resource rather than because it doesn't exist. Some APIs return 404 Not Found instead of 403 Forbidden so that the
+// existence of a resource isn't disclosed, but say so in the error code or message of the body, e.g.
+// "PERMISSION_DENIED" or "resource not found or access denied".
+//
+// ok reports whether the answer is known:
+//   - 403 Forbidden returns forbidden and ok, whatever the body says
+//   - 404 Not Found returns forbidden and ok if the body hints at an authorization failure, not forbidden and ok if it
+//     only says that the resource doesn't exist, and not ok if it says neither or both
+//   - other errors are not ok
+//
+// This allows to tell the user whether they lack a permission:
+//
+//	if forbidden, ok := oapierror.AuthzHint(err); ok && forbidden {
+//		return fmt.Errorf("you lack the permission to access the zone: %w", err)
+//	}
+func AuthzHint(err error) (forbidden, ok bool) {
+	var oapiErr *GenericOpenAPIError
+	if !errors.As(err, &oapiErr) {
+		return false, false
+	}
+	switch oapiErr.StatusCode {
+	case http.StatusForbidden:
+		return true, true
+	case http.StatusNotFound:
+	default:
+		return false, false
+	}
+
+	var body any
+	if json.Unmarshal(oapiErr.Body, &body) != nil {
+		return false, false
+	}
+	forbidden = hasMarker(body, forbiddenMarkers)
+	notFound := hasMarker(body, notFoundMarkers)
+	switch {
+	case forbidden && !notFound:
+		return true, true
+	case notFound && !forbidden:
+		return false, true
+	}
+	return false, false
+}
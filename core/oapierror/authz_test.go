@@ -0,0 +1,45 @@
+package oapierror
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAuthzHint(t *testing.T) {
+	for _, tt := range []struct {
+		desc              string
+		statusCode        int
+		body              string
+		expectedForbidden bool
+		expectedOK        bool
+	}{
+		{"forbidden", http.StatusForbidden, `{"message": "Not Found"}`, true, true},
+		{"masked_code", http.StatusNotFound, `{"code": "PERMISSION_DENIED", "message": "zone not found"}`, false, false},
+		{"masked_status", http.StatusNotFound, `{"status": "Forbidden"}`, true, true},
+		{"masked_details", http.StatusNotFound, `{"message": "request failed", "details": [{"reason": "ACCESS_DENIED"}]}`, true, true},
+		{"not_found", http.StatusNotFound, `{"code": "NOT_FOUND", "message": "zone does not exist"}`, false, true},
+		{"no_hint", http.StatusNotFound, `{"message": "request failed"}`, false, false},
+		{"empty_body", http.StatusNotFound, ``, false, false},
+		{"not_json", http.StatusNotFound, `<html>Not Found</html>`, false, false},
+		{"other_status", http.StatusBadRequest, `{"code": "PERMISSION_DENIED"}`, false, false},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := fmt.Errorf("get failed: %w", NewErrorWithBody(tt.statusCode, http.StatusText(tt.statusCode), []byte(tt.body), nil))
+
+			forbidden, ok := AuthzHint(err)
+			if forbidden != tt.expectedForbidden || ok != tt.expectedOK {
+				t.Errorf("expected %v, %v, got %v, %v", tt.expectedForbidden, tt.expectedOK, forbidden, ok)
+			}
+		})
+	}
+}
+
+func TestAuthzHintNotOpenAPIError(t *testing.T) {
+	if _, ok := AuthzHint(fmt.Errorf("some error")); ok {
+		t.Errorf("expected no hint for an error which is not a GenericOpenAPIError")
+	}
+	if _, ok := AuthzHint(nil); ok {
+		t.Errorf("expected no hint for nil")
+	}
+}
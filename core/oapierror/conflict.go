@@ -0,0 +1,98 @@
+package oapierror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+// conflictFields are the fields of the error bodies of the STACKIT APIs which hold the code or message of a conflict, e.g.
+//
+//	{"code": "ALREADY_EXISTS", "message": "..."}
+//	{"error": "resource_already_exists"}
+//	{"status": "ALREADY_EXISTS", "details": [{"reason": "..."}]}
+//	{"errors": [{"type": "duplicate_name", "msg": "..."}]}
+var conflictFields = []string{"code", "status", "error", "errorCode", "reason", "type", "key", "message", "msg", "detail"}
+
+// alreadyExistsMarkers identify an already existing resource in a normalized code or message, see normalizeConflict
+var alreadyExistsMarkers = []string{"alreadyexist", "alreadyinuse", "alreadytaken", "duplicate"}
+
+// IsConflict returns true if err is, or wraps, a GenericOpenAPIError with status code 409 Conflict,
+// e.g. because the resource already exists or is locked by another operation.
+func IsConflict(err error) bool {
+	var oapiErr *GenericOpenAPIError
+	return errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusConflict
+}
+
+// IsAlreadyExists returns true if err is a conflict, see IsConflict, because the resource to create already exists.
+// The error code or message in the body must say so, e.g. "ALREADY_EXISTS" or "a zone with this name already exists",
+// the other conflicts, e.g. a concurrent update of the resource, return false.
+//
+// This allows to create a resource if it doesn't exist yet:
+//
+//	_, err := client.CreateZone(ctx, projectId).CreateZonePayload(payload).Execute()
+//	if err != nil && !oapierror.IsAlreadyExists(err) {
+//		return err
+//	}
+func IsAlreadyExists(err error) bool {
+	var oapiErr *GenericOpenAPIError
+	if !errors.As(err, &oapiErr) || oapiErr.StatusCode != http.StatusConflict {
+		return false
+	}
+	var body any
+	if json.Unmarshal(oapiErr.Body, &body) != nil {
+		return false
+	}
+	return hasMarker(body, alreadyExistsMarkers)
+}
+
+// hasMarker looks for one of markers in the normalized code or message of the conflict fields of the body, see
+// normalizeConflict, including the ones of nested objects, e.g. the items of "details" or "errors"
+func hasMarker(v any, markers []string) bool {
+	switch v := v.(type) {
+	case map[string]any:
+		for _, field := range conflictFields {
+			if s, ok := v[field].(string); ok && containsMarker(s, markers) {
+				return true
+			}
+		}
+		for _, nested := range v {
+			if _, ok := nested.(string); !ok && hasMarker(nested, markers) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if hasMarker(item, markers) {
+				return true
+			}
+		}
+	case string:
+		return containsMarker(v, markers)
+	}
+	return false
+}
+
+func containsMarker(s string, markers []string) bool {
+	normalized := normalizeConflict(s)
+	for _, marker := range markers {
+		if strings.Contains(normalized, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeConflict lowercases s and removes the characters which aren't letters, so that "ALREADY_EXISTS",
+// "AlreadyExists" and "already exists" are the same
+func normalizeConflict(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
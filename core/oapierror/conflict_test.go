@@ -0,0 +1,106 @@
+package oapierror
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+)
+
+func TestIsAlreadyExists(t *testing.T) {
+	for _, tt := range []struct {
+		desc                  string
+		fixture               string
+		body                  string
+		statusCode            int
+		expectedConflict      bool
+		expectedAlreadyExists bool
+	}{
+		{
+			desc:                  "code",
+			fixture:               "test_resources/test_conflict_already_exists_code.json",
+			expectedConflict:      true,
+			expectedAlreadyExists: true,
+		},
+		{
+			desc:                  "message",
+			fixture:               "test_resources/test_conflict_already_exists_message.json",
+			expectedConflict:      true,
+			expectedAlreadyExists: true,
+		},
+		{
+			desc:                  "details",
+			fixture:               "test_resources/test_conflict_already_exists_details.json",
+			expectedConflict:      true,
+			expectedAlreadyExists: true,
+		},
+		{
+			desc:                  "duplicate",
+			fixture:               "test_resources/test_conflict_duplicate.json",
+			expectedConflict:      true,
+			expectedAlreadyExists: true,
+		},
+		{
+			desc:                  "string_list",
+			body:                  `{"errors": ["DNS name already in use"]}`,
+			expectedConflict:      true,
+			expectedAlreadyExists: true,
+		},
+		{
+			desc:             "other_conflict",
+			fixture:          "test_resources/test_conflict_locked.json",
+			expectedConflict: true,
+		},
+		{
+			desc:             "unrelated_field",
+			body:             `{"message": "Conflict", "name": "already-exists"}`,
+			expectedConflict: true,
+		},
+		{
+			desc:             "empty_body",
+			expectedConflict: true,
+		},
+		{
+			desc:             "not_json",
+			body:             `<html>Conflict</html>`,
+			expectedConflict: true,
+		},
+		{
+			desc:       "not_a_conflict",
+			fixture:    "test_resources/test_conflict_already_exists_code.json",
+			statusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			body := []byte(tt.body)
+			if tt.fixture != "" {
+				var err error
+				body, err = os.ReadFile(tt.fixture)
+				if err != nil {
+					t.Fatalf("reading fixture: %v", err)
+				}
+			}
+			statusCode := tt.statusCode
+			if statusCode == 0 {
+				statusCode = http.StatusConflict
+			}
+			err := fmt.Errorf("create failed: %w", NewErrorWithBody(statusCode, http.StatusText(statusCode), body, nil))
+
+			if got := IsConflict(err); got != tt.expectedConflict {
+				t.Errorf("expected IsConflict %v, got %v", tt.expectedConflict, got)
+			}
+			if got := IsAlreadyExists(err); got != tt.expectedAlreadyExists {
+				t.Errorf("expected IsAlreadyExists %v, got %v", tt.expectedAlreadyExists, got)
+			}
+		})
+	}
+}
+
+func TestIsAlreadyExistsNotOpenAPIError(t *testing.T) {
+	if IsConflict(fmt.Errorf("some error")) || IsAlreadyExists(fmt.Errorf("some error")) {
+		t.Errorf("expected false for an error which is not a GenericOpenAPIError")
+	}
+	if IsConflict(nil) || IsAlreadyExists(nil) {
+		t.Errorf("expected false for nil")
+	}
+}
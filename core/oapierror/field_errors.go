@@ -0,0 +1,126 @@
+package oapierror
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// FieldError is a validation error of a single field of a request, as returned by the API
+type FieldError struct {
+	// Name of the field, as in the API specification. Nested fields are separated by dots, e.g. "spec.name".
+	// Empty if the API doesn't reference a field.
+	Field string
+	// Machine-readable code of the error, if returned by the API
+	Code string
+	// Human-readable message of the error
+	Message string
+}
+
+// validationEnvelope holds the validation error formats of the STACKIT APIs
+type validationEnvelope struct {
+	// e.g. objectstorage: {"detail": [{"loc": ["body", "name"], "msg": "...", "type": "..."}]}
+	Detail json.RawMessage `json:"detail"`
+	// e.g. postgresflex, mongodbflex, sqlserverflex: {"fields": {"name": ["..."]}}
+	Fields map[string][]string `json:"fields"`
+	// e.g. observability: {"errors": [{"name": "..."}]}
+	Errors json.RawMessage `json:"errors"`
+	// e.g. cdn: {"details": [{"field": "name", "key": "...", "en": "..."}]},
+	// or alb, certificates, loadbalancer: {"details": [{"fieldViolations": [{"field": "name", "description": "..."}]}]}
+	Details []validationDetail `json:"details"`
+}
+
+type validationDetail struct {
+	Field           string `json:"field"`
+	Key             string `json:"key"`
+	En              string `json:"en"`
+	FieldViolations []struct {
+		Field       string `json:"field"`
+		Reason      string `json:"reason"`
+		Description string `json:"description"`
+	} `json:"fieldViolations"`
+}
+
+type locatedValidationError struct {
+	Loc  []any  `json:"loc"`
+	Msg  string `json:"msg"`
+	Type string `json:"type"`
+}
+
+// FieldErrors returns the field-level validation errors in the body of err, if it is a GenericOpenAPIError.
+// It returns nil if err isn't a GenericOpenAPIError or its body doesn't contain field-level validation errors.
+func FieldErrors(err error) []FieldError {
+	var oapiErr *GenericOpenAPIError
+	if !errors.As(err, &oapiErr) {
+		return nil
+	}
+	var envelope validationEnvelope
+	if json.Unmarshal(oapiErr.Body, &envelope) != nil {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+	var located []locatedValidationError
+	if json.Unmarshal(envelope.Detail, &located) == nil {
+		for _, e := range located {
+			fieldErrors = append(fieldErrors, FieldError{Field: locationToField(e.Loc), Code: e.Type, Message: e.Msg})
+		}
+	}
+
+	fields := make([]string, 0, len(envelope.Fields))
+	for field := range envelope.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		for _, msg := range envelope.Fields[field] {
+			fieldErrors = append(fieldErrors, FieldError{Field: field, Message: msg})
+		}
+	}
+
+	var errorMaps []map[string]string
+	if json.Unmarshal(envelope.Errors, &errorMaps) == nil {
+		for _, m := range errorMaps {
+			fields := make([]string, 0, len(m))
+			for field := range m {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			for _, field := range fields {
+				fieldErrors = append(fieldErrors, FieldError{Field: field, Message: m[field]})
+			}
+		}
+	}
+
+	for _, detail := range envelope.Details {
+		if detail.Key != "" || detail.En != "" {
+			fieldErrors = append(fieldErrors, FieldError{Field: detail.Field, Code: detail.Key, Message: detail.En})
+		}
+		for _, v := range detail.FieldViolations {
+			fieldErrors = append(fieldErrors, FieldError{Field: v.Field, Code: v.Reason, Message: v.Description})
+		}
+	}
+	return fieldErrors
+}
+
+// locationToField converts a location, e.g. ["body", "spec", "name"], to a field name, e.g. "spec.name"
+func locationToField(loc []any) string {
+	parts := make([]string, 0, len(loc))
+	for i, part := range loc {
+		s, ok := part.(string)
+		if !ok {
+			// Index of a list item
+			b, err := json.Marshal(part)
+			if err != nil {
+				continue
+			}
+			s = string(b)
+		}
+		if i == 0 && (s == "body" || s == "query" || s == "path" || s == "header") {
+			continue
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,95 @@
+package oapierror
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFieldErrors(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		fixture  string
+		body     string
+		expected []FieldError
+	}{
+		{
+			desc:    "located_errors",
+			fixture: "test_resources/test_validation_error_detail.json",
+			expected: []FieldError{
+				{Field: "displayName", Code: "value_error.missing", Message: "field required"},
+				{Field: "rules.0.expiration", Code: "value_error.number.not_gt", Message: "ensure this value is greater than 0"},
+			},
+		},
+		{
+			desc:    "fields",
+			fixture: "test_resources/test_validation_error_fields.json",
+			expected: []FieldError{
+				{Field: "acl.items", Message: "invalid CIDR 10.0.0.0/33"},
+				{Field: "name", Message: "must not be empty"},
+				{Field: "name", Message: "must match ^[a-z]+$"},
+			},
+		},
+		{
+			desc:    "details",
+			fixture: "test_resources/test_validation_error_details.json",
+			expected: []FieldError{
+				{Field: "config.backend.originUrl", Code: "invalid_origin_url", Message: "The origin URL must be a valid HTTP or HTTPS URL"},
+			},
+		},
+		{
+			desc:    "field_violations",
+			fixture: "test_resources/test_validation_error_field_violations.json",
+			expected: []FieldError{
+				{Field: "listeners[0].port", Code: "OUT_OF_RANGE", Message: "port must be between 1 and 65535"},
+			},
+		},
+		{
+			desc: "error_map",
+			body: `{"message": "invalid", "errors": [{"name": "name is too long"}]}`,
+			expected: []FieldError{
+				{Field: "name", Message: "name is too long"},
+			},
+		},
+		{
+			desc: "no_validation_envelope",
+			body: `{"code": 404, "message": "Not Found"}`,
+		},
+		{
+			desc: "string_detail",
+			body: `{"detail": "Not Found"}`,
+		},
+		{
+			desc: "not_json",
+			body: `<html>Bad Gateway</html>`,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			body := []byte(tt.body)
+			if tt.fixture != "" {
+				var err error
+				body, err = os.ReadFile(tt.fixture)
+				if err != nil {
+					t.Fatalf("reading fixture: %v", err)
+				}
+			}
+			err := fmt.Errorf("create failed: %w", NewErrorWithBody(400, "Bad Request", body, nil))
+
+			got := FieldErrors(err)
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("unexpected field errors (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFieldErrorsNotOpenAPIError(t *testing.T) {
+	if got := FieldErrors(fmt.Errorf("some error")); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+	if got := FieldErrors(nil); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
@@ -0,0 +1,31 @@
+package oapierror
+
+import (
+	"errors"
+)
+
+// ModelAs returns the error model of err, if err is, or wraps, a GenericOpenAPIError whose Model is of type T.
+// The generated API clients set the error type the API specification defines for the operation and status code,
+// so the model of a response without a matching error type in the specification isn't available:
+//
+//	if msg, ok := oapierror.ModelAs[dns.Message](err); ok {
+//		log.Printf("creating record set failed: %s", msg.GetMessage())
+//	}
+//
+// A model of type *T is returned as well, it is dereferenced.
+func ModelAs[T any](err error) (T, bool) {
+	var zero T
+	var oapiErr *GenericOpenAPIError
+	if !errors.As(err, &oapiErr) {
+		return zero, false
+	}
+	switch model := oapiErr.Model.(type) {
+	case T:
+		return model, true
+	case *T:
+		if model != nil {
+			return *model, true
+		}
+	}
+	return zero, false
+}
@@ -0,0 +1,34 @@
+package oapierror
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testErrorModel struct {
+	Code string
+}
+
+func TestModelAs(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		err      error
+		wantOk   bool
+		wantCode string
+	}{
+		{"value", &GenericOpenAPIError{Model: testErrorModel{Code: "a"}}, true, "a"},
+		{"pointer", &GenericOpenAPIError{Model: &testErrorModel{Code: "b"}}, true, "b"},
+		{"wrapped", fmt.Errorf("create: %w", &GenericOpenAPIError{Model: testErrorModel{Code: "c"}}), true, "c"},
+		{"nil_pointer", &GenericOpenAPIError{Model: (*testErrorModel)(nil)}, false, ""},
+		{"other_type", &GenericOpenAPIError{Model: map[string]any{"Code": "d"}}, false, ""},
+		{"no_model", &GenericOpenAPIError{}, false, ""},
+		{"other_error", fmt.Errorf("other"), false, ""},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			model, ok := ModelAs[testErrorModel](tt.err)
+			if ok != tt.wantOk || model.Code != tt.wantCode {
+				t.Fatalf("expected %q, %t, got %q, %t", tt.wantCode, tt.wantOk, model.Code, ok)
+			}
+		})
+	}
+}
@@ -16,7 +16,23 @@ type GenericOpenAPIError struct {
 	StatusCode   int
 	Body         []byte
 	ErrorMessage string
-	Model        interface{}
+	// ContentType is the content type of the error response. If it isn't JSON, e.g. for the HTML error page of a
+	// proxy, the body isn't decoded and ErrorMessage is the status of the response.
+	ContentType string
+	// Model is the decoded body of the error response, of the error type the API specification defines for the
+	// operation and status code, e.g. dns.Message for a 400 response of dns.CreateRecordSet. It is nil if the
+	// specification doesn't define one, see ModelAs.
+	Model interface{}
+}
+
+// ValidationError is returned by the API clients, before sending the request, if a required parameter of the request is not set
+type ValidationError struct {
+	// Name of the parameter, as in the API specification
+	Field string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s is required and must be specified", e.Field)
 }
 
 func NewError(code int, status string) *GenericOpenAPIError {
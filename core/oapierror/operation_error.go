@@ -0,0 +1,60 @@
+package oapierror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// redactedValue replaces the values of sensitive parameters in the message of an OperationError
+const redactedValue = "<redacted>"
+
+// sensitiveParameterNames are the parts of the names of parameters whose values are not included in the message of
+// an OperationError, as they may contain personal data or secrets
+var sensitiveParameterNames = []string{"email", "mail", "username", "password", "secret", "token"}
+
+// Parameter is a path parameter of a request, see OperationError
+type Parameter struct {
+	Name  string
+	Value string
+}
+
+// OperationError is returned by the API clients if config.WithErrorContext is set, it wraps the error of an operation
+// with the name of the operation and its path parameters, e.g.
+//
+//	dns.GetZone(projectId=xxx, zoneId=yyy): 404 Not Found
+//
+// The wrapped error, e.g. a *GenericOpenAPIError, can be obtained with errors.As.
+// Only the path parameters are included, and the values of parameters which may be personal data or secrets,
+// e.g. an email address, are redacted in the message. They are available unredacted in Parameters.
+type OperationError struct {
+	Service    string
+	Operation  string
+	Parameters []Parameter
+	Err        error
+}
+
+func (e *OperationError) Error() string {
+	params := make([]string, 0, len(e.Parameters))
+	for _, p := range e.Parameters {
+		value := p.Value
+		if isSensitiveParameter(p.Name) {
+			value = redactedValue
+		}
+		params = append(params, fmt.Sprintf("%s=%s", p.Name, value))
+	}
+	return fmt.Sprintf("%s.%s(%s): %v", e.Service, e.Operation, strings.Join(params, ", "), e.Err)
+}
+
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+func isSensitiveParameter(name string) bool {
+	name = strings.ToLower(name)
+	for _, sensitive := range sensitiveParameterNames {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+	return false
+}
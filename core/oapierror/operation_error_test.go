@@ -0,0 +1,58 @@
+package oapierror
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestOperationError(t *testing.T) {
+	for _, tt := range []struct {
+		desc           string
+		err            *OperationError
+		expectedPrefix string
+	}{
+		{
+			desc: "path_parameters",
+			err: &OperationError{
+				Service:    "dns",
+				Operation:  "GetZone",
+				Parameters: []Parameter{{Name: "projectId", Value: "pid"}, {Name: "zoneId", Value: "zid"}},
+				Err:        NewError(http.StatusNotFound, "404 Not Found"),
+			},
+			expectedPrefix: "dns.GetZone(projectId=pid, zoneId=zid): ",
+		},
+		{
+			desc: "sensitive_parameters",
+			err: &OperationError{
+				Service:    "serviceaccount",
+				Operation:  "DeleteServiceAccount",
+				Parameters: []Parameter{{Name: "projectId", Value: "pid"}, {Name: "serviceAccountEmail", Value: "sa@example.com"}},
+				Err:        NewError(http.StatusNotFound, "404 Not Found"),
+			},
+			expectedPrefix: "serviceaccount.DeleteServiceAccount(projectId=pid, serviceAccountEmail=<redacted>): ",
+		},
+		{
+			desc: "no_parameters",
+			err: &OperationError{
+				Service:   "iaas",
+				Operation: "ListKeyPairs",
+				Err:       NewError(http.StatusInternalServerError, "500 Internal Server Error"),
+			},
+			expectedPrefix: "iaas.ListKeyPairs(): ",
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if msg, expected := tt.err.Error(), tt.expectedPrefix+tt.err.Err.Error(); msg != expected {
+				t.Errorf("expected message %q, got %q", expected, msg)
+			}
+			var oapiErr *GenericOpenAPIError
+			if !errors.As(tt.err, &oapiErr) {
+				t.Fatalf("expected the error to wrap a *GenericOpenAPIError")
+			}
+			if oapiErr != tt.err.Err {
+				t.Errorf("expected the wrapped error to be unwrapped")
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Package otel traces the requests of the SDK clients with OpenTelemetry.
+//
+// It is a module of its own, so that the SDK doesn't depend on OpenTelemetry unless it is used.
+// A client records a span per API call with:
+//
+//	dns.NewAPIClient(config.WithMiddleware(otel.NewRoundTripper()))
+package otel
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+// ScopeName is the instrumentation scope of the tracer of the spans
+const ScopeName = "github.com/stackitcloud/stackit-sdk-go/core/otel"
+
+// Attributes of the spans, in addition to the HTTP attributes of the OpenTelemetry semantic conventions
+const (
+	// ServiceKey is the name of the STACKIT service, e.g. "dns"
+	ServiceKey = attribute.Key("stackit.service")
+	// OperationKey is the operation id, e.g. "CreateZone"
+	OperationKey = attribute.Key("stackit.operation")
+)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures the middleware returned by NewRoundTripper
+type Option func(*options)
+
+// WithTracerProvider sets the TracerProvider of the spans, defaults to the global TracerProvider
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = tp
+	}
+}
+
+// WithPropagator sets the propagator which injects the trace context into the headers of the requests,
+// defaults to the global TextMapPropagator
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = p
+	}
+}
+
+// NewRoundTripper returns a config.Middleware which records a client span for each API call, and injects the trace
+// context of the span into the headers of the request, see WithPropagator. The span is a child of the span in the
+// context of the request, if any, and has the attributes:
+//   - stackit.service and stackit.operation, the Operation of the generated API clients, see config.GetOperation
+//   - http.request.method, server.address, url.full (without the query) and http.response.status_code
+//   - http.request.resend_count, the number of retries of the call, if it was retried
+//
+// As the middlewares wrap the retries, the span covers all attempts of a call, see config.AssembleTransport.
+// It ends when the response headers were received, a call which fails or which gets a response with a status
+// code of 400 or higher sets the status of the span to error.
+func NewRoundTripper(opts ...Option) config.Middleware {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &roundTripper{rt: rt, options: o}
+	}
+}
+
+type roundTripper struct {
+	rt      http.RoundTripper
+	options *options
+}
+
+func (t *roundTripper) tracer() trace.Tracer {
+	tp := t.options.tracerProvider
+	if tp == nil {
+		tp = otelapi.GetTracerProvider()
+	}
+	return tp.Tracer(ScopeName)
+}
+
+func (t *roundTripper) propagator() propagation.TextMapPropagator {
+	if t.options.propagator != nil {
+		return t.options.propagator
+	}
+	return otelapi.GetTextMapPropagator()
+}
+
+// RoundTrip performs the request
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	name := "HTTP " + req.Method
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("server.address", req.URL.Hostname()),
+		attribute.String("url.full", redactURL(req.URL)),
+	}
+	if op, ok := config.GetOperation(req.Context()); ok {
+		name = op.Service + "." + op.Name
+		attrs = append(attrs, ServiceKey.String(op.Service), OperationKey.String(op.Name))
+	}
+	if port := req.URL.Port(); port != "" {
+		if p, err := strconv.Atoi(port); err == nil {
+			attrs = append(attrs, attribute.Int("server.port", p))
+		}
+	}
+
+	ctx, span := t.tracer().Start(req.Context(), name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	defer span.End()
+	ctx, retries := clients.WithRetryCount(ctx)
+
+	// The request of the caller must not be changed
+	req = req.Clone(ctx)
+	t.propagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.rt.RoundTrip(req)
+	if n := retries.Load(); n > 0 {
+		span.SetAttributes(attribute.Int("http.request.resend_count", n))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	return resp, nil
+}
+
+// redactURL returns u without its query and credentials, which may contain secrets
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.RawQuery = ""
+	redacted.ForceQuery = false
+	redacted.Fragment = ""
+	redacted.User = nil
+	return redacted.String()
+}
@@ -0,0 +1,138 @@
+package otel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+type noDelay struct{}
+
+func (noDelay) NextDelay(int, *http.Response) time.Duration { return time.Millisecond }
+
+func TestRoundTripper(t *testing.T) {
+	calls := 0
+	var traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		traceparents = append(traceparents, r.Header.Get("Traceparent"))
+		if calls == 1 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	retries := clients.NewConflictRetryRoundTripper(nil).SetBackoff(noDelay{})
+	rt := NewRoundTripper(WithTracerProvider(tp), WithPropagator(propagation.TraceContext{}))(retries)
+
+	parentCtx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	ctx := clients.WithConflictRetry(config.WithOperation(parentCtx, "dns", "CreateZone"), 2)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/projects/pid/zones?token=secret", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = resp.Body.Close()
+	parent.End()
+
+	if req.Header.Get("Traceparent") != "" {
+		t.Fatalf("expected the request of the caller to be unchanged")
+	}
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected a span for the call and the parent span, got %d spans", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "dns.CreateZone" || span.SpanKind() != trace.SpanKindClient {
+		t.Fatalf("unexpected span %q of kind %v", span.Name(), span.SpanKind())
+	}
+	if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Fatalf("expected the span to be a child of the span of the context")
+	}
+	for _, traceparent := range traceparents {
+		if !strings.Contains(traceparent, span.SpanContext().SpanID().String()) {
+			t.Fatalf("expected the trace context of the span in every attempt, got %v", traceparents)
+		}
+	}
+
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+	for key, expected := range map[attribute.Key]attribute.Value{
+		ServiceKey:                  attribute.StringValue("dns"),
+		OperationKey:                attribute.StringValue("CreateZone"),
+		"http.request.method":       attribute.StringValue(http.MethodPost),
+		"url.full":                  attribute.StringValue(server.URL + "/v1/projects/pid/zones"),
+		"http.response.status_code": attribute.IntValue(http.StatusCreated),
+		"http.request.resend_count": attribute.IntValue(1),
+	} {
+		if attrs[key] != expected {
+			t.Errorf("expected attribute %s to be %v, got %v", key, expected.Emit(), attrs[key].Emit())
+		}
+	}
+	if span.Status().Code != codes.Unset {
+		t.Errorf("expected no error status, got %v", span.Status())
+	}
+}
+
+func TestRoundTripperError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	rt := NewRoundTripper(WithTracerProvider(tp))(http.DefaultTransport)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	// A request which fails to be sent
+	req, err = http.NewRequest(http.MethodGet, "http://127.0.0.1:1", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	if spans[0].Name() != "HTTP GET" || spans[0].Status().Code != codes.Error {
+		t.Errorf("expected an error status for a 404 response, got %q %v", spans[0].Name(), spans[0].Status())
+	}
+	if spans[1].Status().Code != codes.Error || len(spans[1].Events()) == 0 {
+		t.Errorf("expected the error to be recorded, got %v", spans[1].Status())
+	}
+}
@@ -0,0 +1,39 @@
+package pagination
+
+import (
+	"context"
+)
+
+// First returns the first n items of the paginated API fetched with fetch, without fetching the pages after the
+// one containing the n-th item, e.g. to sample a large listing. The last page is trimmed to n items.
+// It returns fewer items if the listing is shorter, and none if n is not positive.
+// No more pages are fetched once ctx is done, in which case the error of ctx is returned.
+func First[T any](ctx context.Context, fetch PageFunc[T], n int) ([]T, error) {
+	items := []T{}
+	if n <= 0 {
+		return items, nil
+	}
+	token := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		page, nextToken, err := fetch(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) >= n-len(items) {
+			return append(items, page[:n-len(items)]...), nil
+		}
+		items = append(items, page...)
+		if nextToken == "" {
+			return items, nil
+		}
+		token = nextToken
+	}
+}
+
+// FirstByPageNumber is like First for APIs that paginate by page number
+func FirstByPageNumber[T any](ctx context.Context, fetch PageNumberFunc[T], n int) ([]T, error) {
+	return First(ctx, byPageNumber(fetch), n)
+}
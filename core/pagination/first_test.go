@@ -0,0 +1,67 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFirst(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	for _, tt := range []struct {
+		desc      string
+		n         int
+		expected  []int
+		wantCalls int
+	}{
+		{"within_first_page", 2, []int{1, 2}, 1},
+		{"page_boundary", 3, []int{1, 2, 3}, 1},
+		{"trims_last_page", 5, []int{1, 2, 3, 4, 5}, 2},
+		{"shorter_listing", 10, items, 3},
+		{"zero", 0, []int{}, 0},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			got, err := First(context.Background(), pages(items, 3, &calls), tt.n)
+			if err != nil {
+				t.Fatalf("First failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Fatalf("unexpected items (-want +got):\n%s", diff)
+			}
+			if calls != tt.wantCalls {
+				t.Fatalf("expected %d pages to be fetched, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestFirstContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	fetch := func(ctx context.Context, token string) ([]int, string, error) {
+		cancel()
+		return pages([]int{1, 2, 3, 4}, 2, &calls)(ctx, token)
+	}
+	if _, err := First(ctx, fetch, 4); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected no pages to be fetched after cancellation, got %d calls", calls)
+	}
+}
+
+func TestFirstByPageNumber(t *testing.T) {
+	fetch := func(_ context.Context, page int) ([]int, int, error) {
+		return []int{page*10 + 1, page*10 + 2}, 3, nil
+	}
+	got, err := FirstByPageNumber(context.Background(), fetch, 3)
+	if err != nil {
+		t.Fatalf("FirstByPageNumber failed: %v", err)
+	}
+	if diff := cmp.Diff([]int{11, 12, 21}, got); diff != "" {
+		t.Fatalf("unexpected items (-want +got):\n%s", diff)
+	}
+}
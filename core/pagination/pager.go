@@ -0,0 +1,159 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// DefaultPageSize is the number of items requested per page by NewPagerByOffset if PagerOptions.PageSize is not set
+const DefaultPageSize = 100
+
+// SizedPageFunc is like PageFunc, with the number of items to request for the page, e.g. using the Limit and Cursor of
+// an auditlog ListProjectAuditLogEntries request. pageSize is 0 if the default page size of the API is used.
+type SizedPageFunc[T any] func(ctx context.Context, token string, pageSize int) (items []T, nextToken string, err error)
+
+// SizedPageNumberFunc is like PageNumberFunc, with the number of items to request for the page, e.g. using the Page
+// and PageSize of a dns ListRecordSets request. pageSize is 0 if the default page size of the API is used.
+type SizedPageNumberFunc[T any] func(ctx context.Context, page, pageSize int) (items []T, totalPages int, err error)
+
+// OffsetFunc fetches at most limit items starting at the item with index offset, e.g. using the Offset and Limit of a
+// resourcemanager ListProjects request. The listing ends with the first page with fewer than limit items.
+type OffsetFunc[T any] func(ctx context.Context, offset, limit int) (items []T, err error)
+
+// PagerOptions are the options of a Pager
+type PagerOptions struct {
+	// PageSize is the number of items requested per page, or 0 for the default page size of the API
+	PageSize int
+	// Limit is the maximum number of items listed, or 0 to list all items.
+	// No pages are fetched after the one containing the Limit-th item.
+	Limit int
+}
+
+// Pager lists the items of all pages of a paginated API one at a time, fetching the next page once the items of the
+// previous one have been returned:
+//
+//	zones := pagination.NewPagerByPageNumber(func(ctx context.Context, page, pageSize int) ([]dns.Zone, int, error) {
+//		resp, err := client.ListZones(ctx, projectId).Page(int32(page)).PageSize(int32(pageSize)).Execute()
+//		if err != nil {
+//			return nil, 0, err
+//		}
+//		return resp.GetZones(), int(resp.GetTotalPages()), nil
+//	}, pagination.PagerOptions{PageSize: 100, Limit: 1000})
+//	for {
+//		zone, ok, err := zones.Next(ctx)
+//		if err != nil {
+//			// handle error
+//		}
+//		if !ok {
+//			break
+//		}
+//		// process zone
+//	}
+//
+// It is not safe for concurrent use.
+type Pager[T any] struct {
+	fetch PageFunc[T]
+	limit int
+
+	items []T
+	token string
+	done  bool
+	count int
+}
+
+// NewPager returns a Pager which fetches the pages with fetch, starting at the first page
+func NewPager[T any](fetch SizedPageFunc[T], opts PagerOptions) *Pager[T] {
+	pageSize := max(opts.PageSize, 0)
+	return newPager(func(ctx context.Context, token string) ([]T, string, error) {
+		return fetch(ctx, token, pageSize)
+	}, opts)
+}
+
+// NewPagerByPageNumber returns a Pager for APIs that paginate by page number, starting at the first page
+func NewPagerByPageNumber[T any](fetch SizedPageNumberFunc[T], opts PagerOptions) *Pager[T] {
+	pageSize := max(opts.PageSize, 0)
+	return newPager(byPageNumber(func(ctx context.Context, page int) ([]T, int, error) {
+		return fetch(ctx, page, pageSize)
+	}), opts)
+}
+
+// NewPagerByOffset returns a Pager for APIs that paginate by offset and limit, starting at the first item.
+// If PagerOptions.PageSize is not set, DefaultPageSize items are requested per page.
+func NewPagerByOffset[T any](fetch OffsetFunc[T], opts PagerOptions) *Pager[T] {
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultPageSize
+	}
+	return newPager(byOffset(fetch, opts.PageSize), opts)
+}
+
+func newPager[T any](fetch PageFunc[T], opts PagerOptions) *Pager[T] {
+	return &Pager[T]{fetch: fetch, limit: max(opts.Limit, 0)}
+}
+
+// byOffset returns a PageFunc for fetch, with the offset of the page as token
+func byOffset[T any](fetch OffsetFunc[T], pageSize int) PageFunc[T] {
+	return func(ctx context.Context, token string) ([]T, string, error) {
+		offset := 0
+		if token != "" {
+			var err error
+			offset, err = strconv.Atoi(token)
+			if err != nil || offset < 0 {
+				return nil, "", fmt.Errorf("invalid offset %q", token)
+			}
+		}
+		items, err := fetch(ctx, offset, pageSize)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(items) < pageSize {
+			return items, "", nil
+		}
+		return items, strconv.Itoa(offset + len(items)), nil
+	}
+}
+
+// Next returns the next item, fetching the next page if needed. It returns false once all items, or the limit of
+// items, have been returned. If fetching a page fails, the position is kept, so calling Next again retries the
+// same page. No more pages are fetched once ctx is done, in which case the error of ctx is returned.
+func (p *Pager[T]) Next(ctx context.Context) (T, bool, error) {
+	var zero T
+	if p.limit > 0 && p.count >= p.limit {
+		return zero, false, nil
+	}
+	for len(p.items) == 0 {
+		if p.done {
+			return zero, false, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return zero, false, err
+		}
+		items, nextToken, err := p.fetch(ctx, p.token)
+		if err != nil {
+			return zero, false, err
+		}
+		if nextToken != "" && nextToken == p.token {
+			return zero, false, fmt.Errorf("pagination returned the token %q of the current page as the next page", nextToken)
+		}
+		p.items, p.token, p.done = items, nextToken, nextToken == ""
+	}
+	item := p.items[0]
+	p.items = p.items[1:]
+	p.count++
+	return item, true, nil
+}
+
+// All returns the remaining items, up to the limit of items
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	all := []T{}
+	for {
+		item, ok, err := p.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return all, nil
+		}
+		all = append(all, item)
+	}
+}
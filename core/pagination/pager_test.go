@@ -0,0 +1,155 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPager(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	for _, tt := range []struct {
+		desc      string
+		opts      PagerOptions
+		expected  []int
+		wantCalls int
+	}{
+		{"all", PagerOptions{PageSize: 3}, items, 3},
+		{"default_page_size", PagerOptions{}, items, 2},
+		{"limit", PagerOptions{PageSize: 2, Limit: 3}, []int{1, 2, 3}, 2},
+		{"limit_page_boundary", PagerOptions{PageSize: 2, Limit: 4}, []int{1, 2, 3, 4}, 2},
+		{"limit_above_total", PagerOptions{PageSize: 5, Limit: 10}, items, 2},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			p := NewPager(func(ctx context.Context, token string, pageSize int) ([]int, string, error) {
+				if pageSize == 0 {
+					pageSize = 5
+				}
+				return pages(items, pageSize, &calls)(ctx, token)
+			}, tt.opts)
+			got, err := p.All(context.Background())
+			if err != nil {
+				t.Fatalf("All failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Fatalf("unexpected items (-want +got):\n%s", diff)
+			}
+			if calls != tt.wantCalls {
+				t.Fatalf("expected %d pages to be fetched, got %d", tt.wantCalls, calls)
+			}
+			// Listing is finished, no more pages are fetched
+			if _, ok, err := p.Next(context.Background()); ok || err != nil {
+				t.Fatalf("expected no more items, got %t, %v", ok, err)
+			}
+			if calls != tt.wantCalls {
+				t.Fatalf("expected no more pages to be fetched, got %d calls", calls)
+			}
+		})
+	}
+}
+
+func TestPagerRetriesFailedPage(t *testing.T) {
+	calls := 0
+	fail := true
+	fetch := pages([]int{1, 2, 3, 4}, 2, &calls)
+	p := NewPager(func(ctx context.Context, token string, _ int) ([]int, string, error) {
+		if token != "" && fail {
+			fail = false
+			return nil, "", errors.New("temporary failure")
+		}
+		return fetch(ctx, token)
+	}, PagerOptions{})
+
+	var got []int
+	for {
+		item, ok, err := p.Next(context.Background())
+		if err != nil {
+			continue
+		}
+		if !ok {
+			break
+		}
+		got = append(got, item)
+	}
+	if diff := cmp.Diff([]int{1, 2, 3, 4}, got); diff != "" {
+		t.Fatalf("unexpected items (-want +got):\n%s", diff)
+	}
+}
+
+func TestPagerRepeatedToken(t *testing.T) {
+	p := NewPager(func(_ context.Context, _ string, _ int) ([]int, string, error) {
+		return []int{1}, "same", nil
+	}, PagerOptions{})
+	if _, err := p.All(context.Background()); err == nil {
+		t.Fatalf("expected an error for a repeated page token")
+	}
+}
+
+func TestPagerContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	fetch := pages([]int{1, 2, 3, 4}, 2, &calls)
+	p := NewPager(func(ctx context.Context, token string, _ int) ([]int, string, error) {
+		cancel()
+		return fetch(ctx, token)
+	}, PagerOptions{})
+	if _, err := p.All(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected no pages to be fetched after cancellation, got %d calls", calls)
+	}
+}
+
+func TestPagerByPageNumber(t *testing.T) {
+	var pageSizes []int
+	p := NewPagerByPageNumber(func(_ context.Context, page, pageSize int) ([]int, int, error) {
+		pageSizes = append(pageSizes, pageSize)
+		return []int{page*10 + 1, page*10 + 2}, 3, nil
+	}, PagerOptions{PageSize: 2})
+	got, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("All failed: %v", err)
+	}
+	if diff := cmp.Diff([]int{11, 12, 21, 22, 31, 32}, got); diff != "" {
+		t.Fatalf("unexpected items (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{2, 2, 2}, pageSizes); diff != "" {
+		t.Fatalf("unexpected page sizes (-want +got):\n%s", diff)
+	}
+}
+
+func TestPagerByOffset(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	for _, tt := range []struct {
+		desc        string
+		opts        PagerOptions
+		wantOffsets []int
+	}{
+		{"short_last_page", PagerOptions{PageSize: 2}, []int{0, 2, 4}},
+		{"empty_last_page", PagerOptions{PageSize: 5}, []int{0, 5}},
+		{"default_page_size", PagerOptions{}, []int{0}},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var offsets []int
+			p := NewPagerByOffset(func(_ context.Context, offset, limit int) ([]int, error) {
+				offsets = append(offsets, offset)
+				end := min(offset+limit, len(items))
+				return items[min(offset, end):end], nil
+			}, tt.opts)
+			got, err := p.All(context.Background())
+			if err != nil {
+				t.Fatalf("All failed: %v", err)
+			}
+			if diff := cmp.Diff(items, got); diff != "" {
+				t.Fatalf("unexpected items (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantOffsets, offsets); diff != "" {
+				t.Fatalf("unexpected offsets (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
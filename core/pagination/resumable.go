@@ -0,0 +1,133 @@
+// Package pagination lists the items of paginated APIs, e.g. the record sets of a dns zone:
+//
+//	records := pagination.NewResumableByPageNumber(func(ctx context.Context, page int) ([]dns.RecordSet, int, error) {
+//		resp, err := client.ListRecordSets(ctx, projectId, zoneId).Page(int32(page)).PageSize(1000).Execute()
+//		if err != nil {
+//			return nil, 0, err
+//		}
+//		return resp.GetRrSets(), int(resp.GetTotalPages()), nil
+//	})
+//	if err := records.Restore(savedState); err != nil {
+//		// handle error
+//	}
+//	for {
+//		page, ok, err := records.Next(ctx)
+//		if err != nil {
+//			// handle error
+//		}
+//		if !ok {
+//			break
+//		}
+//		// process page and persist records.State()
+//	}
+package pagination
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// PageFunc fetches the page identified by token, which is empty for the first page.
+// It returns the items of the page and the token of the next page, which is empty if it is the last page.
+type PageFunc[T any] func(ctx context.Context, token string) (items []T, nextToken string, err error)
+
+// PageNumberFunc fetches the page with number page, starting at 1, e.g. using the Page and PageSize of a dns
+// ListRecordSets request. It returns the items of the page and the total number of pages, or 0 if it is unknown,
+// in which case the listing ends with the first empty page.
+type PageNumberFunc[T any] func(ctx context.Context, page int) (items []T, totalPages int, err error)
+
+// Resumable lists the items of a paginated API page by page and can resume the listing where it stopped,
+// e.g. after a restart of the process. It is not safe for concurrent use.
+//
+// State returns an opaque token for the position of the listing, which can be persisted and passed
+// to Restore to resume from it. To process every item at least once, persist the state after
+// processing the items returned by Next.
+type Resumable[T any] struct {
+	fetch PageFunc[T]
+	token string
+	done  bool
+}
+
+// resumableState is the serialized state of a Resumable
+type resumableState struct {
+	Token string `json:"token,omitempty"`
+	Done  bool   `json:"done,omitempty"`
+}
+
+// NewResumable returns a Resumable which fetches the pages with fetch, starting at the first page
+func NewResumable[T any](fetch PageFunc[T]) *Resumable[T] {
+	return &Resumable[T]{fetch: fetch}
+}
+
+// NewResumableByPageNumber returns a Resumable for APIs that paginate by page number, starting at the first page
+func NewResumableByPageNumber[T any](fetch PageNumberFunc[T]) *Resumable[T] {
+	return NewResumable(byPageNumber(fetch))
+}
+
+// byPageNumber returns a PageFunc for fetch, with the number of the page as token
+func byPageNumber[T any](fetch PageNumberFunc[T]) PageFunc[T] {
+	return func(ctx context.Context, token string) ([]T, string, error) {
+		page := 1
+		if token != "" {
+			var err error
+			page, err = strconv.Atoi(token)
+			if err != nil || page < 1 {
+				return nil, "", fmt.Errorf("invalid page number %q", token)
+			}
+		}
+		items, totalPages, err := fetch(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		if (totalPages > 0 && page >= totalPages) || (totalPages <= 0 && len(items) == 0) {
+			return items, "", nil
+		}
+		return items, strconv.Itoa(page + 1), nil
+	}
+}
+
+// Next fetches the next page. It returns false, without items, once all pages have been fetched.
+// If fetching the page fails, the position is kept, so calling Next again retries the same page.
+func (r *Resumable[T]) Next(ctx context.Context) ([]T, bool, error) {
+	if r.done {
+		return nil, false, nil
+	}
+	items, nextToken, err := r.fetch(ctx, r.token)
+	if err != nil {
+		return nil, false, err
+	}
+	r.token = nextToken
+	r.done = nextToken == ""
+	return items, true, nil
+}
+
+// State returns an opaque token for the position of the listing, i.e. the page returned by the next call to Next
+func (r *Resumable[T]) State() string {
+	b, err := json.Marshal(resumableState{Token: r.token, Done: r.done})
+	if err != nil {
+		// Can't happen, the state only consists of a string and a bool
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Restore sets the position of the listing to state, as returned by State. An empty state restarts the listing.
+func (r *Resumable[T]) Restore(state string) error {
+	if state == "" {
+		r.token, r.done = "", false
+		return nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(state)
+	if err != nil {
+		return fmt.Errorf("invalid pagination state: %w", err)
+	}
+	var s resumableState
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid pagination state: %w", err)
+	}
+	r.token, r.done = s.Token, s.Done
+	return nil
+}
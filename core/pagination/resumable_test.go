@@ -0,0 +1,183 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// pages returns a PageFunc over items, with pageSize items per page and the index of the first item of a page as token
+func pages(items []int, pageSize int, calls *int) PageFunc[int] {
+	return func(_ context.Context, token string) ([]int, string, error) {
+		*calls++
+		start := 0
+		if token != "" {
+			var err error
+			start, err = strconv.Atoi(token)
+			if err != nil {
+				return nil, "", err
+			}
+		}
+		end := start + pageSize
+		if end >= len(items) {
+			return items[start:], "", nil
+		}
+		return items[start:end], strconv.Itoa(end), nil
+	}
+}
+
+func collect[T any](t *testing.T, r *Resumable[T]) []T {
+	t.Helper()
+	var all []T
+	for {
+		items, ok, err := r.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		if !ok {
+			return all
+		}
+		all = append(all, items...)
+	}
+}
+
+func TestResumable(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	calls := 0
+	r := NewResumable(pages(items, 3, &calls))
+
+	got := collect(t, r)
+	if diff := cmp.Diff(items, got); diff != "" {
+		t.Fatalf("unexpected items (-want +got):\n%s", diff)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 pages to be fetched, got %d", calls)
+	}
+	// Listing is finished, no more pages are fetched
+	if _, ok, err := r.Next(context.Background()); ok || err != nil {
+		t.Fatalf("expected no more pages, got %t, %v", ok, err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected no more pages to be fetched, got %d calls", calls)
+	}
+}
+
+func TestResumableRestore(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	calls := 0
+	r := NewResumable(pages(items, 3, &calls))
+	first, _, err := r.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Next failed: %v", err)
+	}
+	state := r.State()
+
+	// Resume in a new paginator, e.g. after a restart
+	resumed := NewResumable(pages(items, 3, &calls))
+	if err := resumed.Restore(state); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	got := append(first, collect(t, resumed)...)
+	if diff := cmp.Diff(items, got); diff != "" {
+		t.Fatalf("unexpected items (-want +got):\n%s", diff)
+	}
+
+	// A finished listing stays finished
+	if err := r.Restore(resumed.State()); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if _, ok, err := r.Next(context.Background()); ok || err != nil {
+		t.Fatalf("expected no more pages, got %t, %v", ok, err)
+	}
+
+	// An empty state restarts the listing
+	if err := r.Restore(""); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if diff := cmp.Diff(items, collect(t, r)); diff != "" {
+		t.Fatalf("unexpected items after restart (-want +got):\n%s", diff)
+	}
+}
+
+func TestResumableRestoreInvalid(t *testing.T) {
+	r := NewResumable(pages([]int{1}, 1, new(int)))
+	for _, state := range []string{"not base64!", "bm90IGpzb24"} {
+		if err := r.Restore(state); err == nil {
+			t.Errorf("expected error for state %q", state)
+		}
+	}
+}
+
+func TestResumableErrorKeepsPosition(t *testing.T) {
+	fail := true
+	calls := 0
+	fetch := pages([]int{1, 2, 3, 4}, 2, &calls)
+	r := NewResumable(func(ctx context.Context, token string) ([]int, string, error) {
+		if token != "" && fail {
+			return nil, "", fmt.Errorf("some error")
+		}
+		return fetch(ctx, token)
+	})
+
+	if _, _, err := r.Next(context.Background()); err != nil {
+		t.Fatalf("Next failed: %v", err)
+	}
+	state := r.State()
+	if _, _, err := r.Next(context.Background()); err == nil {
+		t.Fatalf("expected error")
+	}
+	if r.State() != state {
+		t.Fatalf("state changed after error")
+	}
+	fail = false
+	items, ok, err := r.Next(context.Background())
+	if err != nil || !ok {
+		t.Fatalf("Next failed: %t, %v", ok, err)
+	}
+	if diff := cmp.Diff([]int{3, 4}, items); diff != "" {
+		t.Fatalf("unexpected items (-want +got):\n%s", diff)
+	}
+}
+
+func TestResumableByPageNumber(t *testing.T) {
+	for _, tt := range []struct {
+		desc          string
+		totalPages    int
+		expectedItems []int
+		expectedCalls int
+	}{
+		{
+			desc:          "total_pages",
+			totalPages:    3,
+			expectedItems: []int{1, 2, 3},
+			expectedCalls: 3,
+		},
+		{
+			desc:          "unknown_total_pages",
+			totalPages:    0,
+			expectedItems: []int{1, 2, 3, 4, 5},
+			expectedCalls: 6,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			r := NewResumableByPageNumber(func(_ context.Context, page int) ([]int, int, error) {
+				calls++
+				if page > 5 {
+					return nil, tt.totalPages, nil
+				}
+				return []int{page}, tt.totalPages, nil
+			})
+			got := collect(t, r)
+			if diff := cmp.Diff(tt.expectedItems, got); diff != "" {
+				t.Fatalf("unexpected items (-want +got):\n%s", diff)
+			}
+			if calls != tt.expectedCalls {
+				t.Fatalf("expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
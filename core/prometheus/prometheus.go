@@ -0,0 +1,152 @@
+// Package prometheus exposes Prometheus metrics of the API calls of the SDK clients.
+//
+// It is a module of its own, so that the SDK doesn't depend on the Prometheus client library unless it is used.
+// The metrics are created once, registered, and shared by the clients:
+//
+//	metrics := prometheus.NewMetrics()
+//	registry.MustRegister(metrics)
+//	dnsClient, err := dns.NewAPIClient(config.WithMiddleware(metrics.Middleware()))
+//	skeClient, err := ske.NewAPIClient(config.WithMiddleware(metrics.Middleware()))
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+// Labels of the metrics
+const (
+	// ServiceLabel is the name of the STACKIT service, e.g. "dns"
+	ServiceLabel = "service"
+	// OperationLabel is the operation id, e.g. "CreateZone"
+	OperationLabel = "operation"
+	// ClassLabel is the class of an error: "4xx", "5xx", "canceled" or "network"
+	ClassLabel = "class"
+)
+
+// unknownOperation is the value of the labels of a request without an Operation, e.g. of AuthenticatedHTTPClient
+const unknownOperation = "unknown"
+
+type options struct {
+	namespace string
+	buckets   []float64
+}
+
+// Option configures the Metrics returned by NewMetrics
+type Option func(*options)
+
+// WithNamespace sets the namespace of the names of the metrics, defaults to "stackit_sdk"
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithBuckets sets the buckets of the latency histogram in seconds, defaults to prometheus.DefBuckets
+func WithBuckets(buckets []float64) Option {
+	return func(o *options) {
+		o.buckets = buckets
+	}
+}
+
+// Metrics are the metrics of the API calls, labeled by service and operation:
+//   - stackit_sdk_requests_total counts the calls
+//   - stackit_sdk_request_errors_total counts the failed calls by the class of the error, see ClassLabel
+//   - stackit_sdk_request_duration_seconds is a histogram of the latencies of the calls
+//
+// Metrics is a prometheus.Collector, which must be registered once. It is safe for concurrent use.
+type Metrics struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewMetrics returns the metrics of the API calls, see Metrics
+func NewMetrics(opts ...Option) *Metrics {
+	o := &options{namespace: "stackit_sdk", buckets: prometheus.DefBuckets}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: o.namespace,
+			Name:      "requests_total",
+			Help:      "Number of API calls of the STACKIT SDK.",
+		}, []string{ServiceLabel, OperationLabel}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: o.namespace,
+			Name:      "request_errors_total",
+			Help:      "Number of failed API calls of the STACKIT SDK, by the class of the error.",
+		}, []string{ServiceLabel, OperationLabel, ClassLabel}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: o.namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the API calls of the STACKIT SDK, until the response headers were received.",
+			Buckets:   o.buckets,
+		}, []string{ServiceLabel, OperationLabel}),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.errors.Describe(ch)
+	m.duration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.errors.Collect(ch)
+	m.duration.Collect(ch)
+}
+
+// Middleware returns a config.Middleware which records the API calls of a client in m. As the middlewares wrap the
+// retries, a call is recorded once with the latency of all its attempts, see config.AssembleTransport.
+func (m *Metrics) Middleware() config.Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &roundTripper{rt: rt, metrics: m}
+	}
+}
+
+type roundTripper struct {
+	rt      http.RoundTripper
+	metrics *Metrics
+}
+
+// RoundTrip performs the request
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	service, operation := unknownOperation, unknownOperation
+	if op, ok := config.GetOperation(req.Context()); ok {
+		service, operation = op.Service, op.Name
+	}
+
+	start := time.Now()
+	resp, err := t.rt.RoundTrip(req)
+	t.metrics.duration.WithLabelValues(service, operation).Observe(time.Since(start).Seconds())
+	t.metrics.requests.WithLabelValues(service, operation).Inc()
+	if class := errorClass(resp, err); class != "" {
+		t.metrics.errors.WithLabelValues(service, operation, class).Inc()
+	}
+	return resp, err
+}
+
+// errorClass returns the class of the error of a call, or "" if it succeeded
+func errorClass(resp *http.Response, err error) string {
+	switch {
+	case err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)):
+		return "canceled"
+	case err != nil:
+		return "network"
+	case resp.StatusCode >= http.StatusBadRequest:
+		return strconv.Itoa(resp.StatusCode/100) + "xx"
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package prometheus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+func TestMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/broken":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	metrics := NewMetrics(WithBuckets([]float64{0.1, 1}))
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(metrics)
+
+	// Two clients share the metrics
+	first := metrics.Middleware()(http.DefaultTransport)
+	second := metrics.Middleware()(http.DefaultTransport)
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, call := range []struct {
+		rt   http.RoundTripper
+		ctx  context.Context
+		path string
+	}{
+		{first, config.WithOperation(context.Background(), "dns", "GetZone"), "/"},
+		{second, config.WithOperation(context.Background(), "dns", "GetZone"), "/missing"},
+		{first, config.WithOperation(context.Background(), "ske", "ListClusters"), "/broken"},
+		{first, config.WithOperation(canceledCtx, "ske", "ListClusters"), "/"},
+		{second, context.Background(), "/"},
+	} {
+		req, err := http.NewRequestWithContext(call.ctx, http.MethodGet, server.URL+call.path, http.NoBody)
+		if err != nil {
+			t.Fatalf("creating request: %v", err)
+		}
+		resp, err := call.rt.RoundTrip(req)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+	}
+
+	expected := `
+# HELP stackit_sdk_request_errors_total Number of failed API calls of the STACKIT SDK, by the class of the error.
+# TYPE stackit_sdk_request_errors_total counter
+stackit_sdk_request_errors_total{class="4xx",operation="GetZone",service="dns"} 1
+stackit_sdk_request_errors_total{class="5xx",operation="ListClusters",service="ske"} 1
+stackit_sdk_request_errors_total{class="canceled",operation="ListClusters",service="ske"} 1
+# HELP stackit_sdk_requests_total Number of API calls of the STACKIT SDK.
+# TYPE stackit_sdk_requests_total counter
+stackit_sdk_requests_total{operation="GetZone",service="dns"} 2
+stackit_sdk_requests_total{operation="ListClusters",service="ske"} 2
+stackit_sdk_requests_total{operation="unknown",service="unknown"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "stackit_sdk_requests_total", "stackit_sdk_request_errors_total"); err != nil {
+		t.Fatalf("unexpected metrics: %v", err)
+	}
+	if n := testutil.CollectAndCount(metrics.duration); n != 3 {
+		t.Fatalf("expected a latency histogram per operation, got %d", n)
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	metrics := NewMetrics(WithNamespace("provider"))
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(metrics)
+
+	req, err := http.NewRequest(http.MethodGet, "http://127.0.0.1:1", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if _, err := metrics.Middleware()(http.DefaultTransport).RoundTrip(req); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	expected := `
+# HELP provider_request_errors_total Number of failed API calls of the STACKIT SDK, by the class of the error.
+# TYPE provider_request_errors_total counter
+provider_request_errors_total{class="network",operation="unknown",service="unknown"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "provider_request_errors_total"); err != nil {
+		t.Fatalf("unexpected metrics: %v", err)
+	}
+}
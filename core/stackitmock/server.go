@@ -0,0 +1,268 @@
+// Package stackitmock provides an HTTP server which answers the requests of the API clients with canned responses,
+// to test code using the SDK without the STACKIT APIs:
+//
+//	func TestCreateZone(t *testing.T) {
+//		server := stackitmock.NewServer(t)
+//		server.On("dns", "CreateZone").Return(http.StatusAccepted, dns.ZoneResponse{Zone: &dns.Zone{Id: utils.Ptr("zone-id")}})
+//
+//		client, err := dns.NewAPIClient(server.ConfigurationOptions()...)
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		// call the code under test with client
+//
+//		if calls := server.Calls("dns", "CreateZone"); len(calls) != 1 {
+//			t.Fatalf("expected one CreateZone request, got %d", len(calls))
+//		}
+//	}
+//
+// The responses are matched by the service and the operation id of the requests, as annotated by the generated API
+// clients, see config.WithOperation. A request without a matching response fails the test, as does registering a
+// response for an operation which the API client of the service doesn't have.
+package stackitmock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+// operationHeader passes the operation of a request from the API client to the server
+const operationHeader = "X-Stackit-Mock-Operation"
+
+// Server is an HTTP server which answers the requests of the API clients configured with its ConfigurationOptions
+// with the responses registered with On. It is safe for concurrent use.
+type Server struct {
+	t      testing.TB
+	server *httptest.Server
+
+	mu    sync.Mutex
+	stubs map[config.Operation][]*Stub
+	calls []Call
+}
+
+// Call is a request received by a Server
+type Call struct {
+	// Operation of the request, see config.WithOperation
+	Operation config.Operation
+	Method    string
+	// Path of the request, e.g. "/v1/projects/project-id/zones"
+	Path   string
+	Query  map[string][]string
+	Header http.Header
+	Body   []byte
+}
+
+// DecodeBody decodes the JSON body of the request into v, e.g. the payload of a create request
+func (c Call) DecodeBody(v any) error {
+	if err := json.Unmarshal(c.Body, v); err != nil {
+		return fmt.Errorf("decoding the body of %s %s: %w", c.Method, c.Path, err)
+	}
+	return nil
+}
+
+// Stub is a canned response for the requests of an operation, see Server.On
+type Stub struct {
+	respond func(r *http.Request) (status int, body any)
+	times   int
+	served  int
+}
+
+// NewServer starts a Server which is closed at the end of the test
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		t:     t,
+		stubs: map[config.Operation][]*Stub{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// URL returns the base URL of the server
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the server, it is called at the end of the test
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// ConfigurationOptions returns the options of an API client which sends its requests to the server, without
+// authentication. Further options can be appended, e.g. to test the retries of the client.
+func (s *Server) ConfigurationOptions() []config.ConfigurationOption {
+	return []config.ConfigurationOption{
+		config.WithEndpoint(s.server.URL),
+		config.WithoutAuthentication(),
+		config.WithMiddleware(operationMiddleware),
+	}
+}
+
+// operationMiddleware sends the operation of a request to the server in the operation header
+func operationMiddleware(rt http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		op, ok := config.GetOperation(req.Context())
+		if !ok {
+			return rt.RoundTrip(req)
+		}
+		req = req.Clone(req.Context())
+		req.Header.Set(operationHeader, op.Service+"/"+op.Name)
+		return rt.RoundTrip(req)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// On registers a response for the requests of the operation of service, e.g. On("dns", "CreateZone"), which are
+// answered by the Stub until it is exhausted, see Stub.Times. If several stubs are registered for an operation,
+// they answer the requests in the order of registration, e.g. to return the states of a resource while it is created.
+//
+// The operation must be one of the methods of the DefaultApi of the service, whose package registers them when it is
+// imported, see config.RegisterOperations. Otherwise, e.g. for a typo, the test fails.
+func (s *Server) On(service, operation string) *Stub {
+	s.t.Helper()
+	stub := &Stub{respond: func(*http.Request) (int, any) { return http.StatusOK, nil }}
+	op := config.Operation{Service: service, Name: operation}
+	s.checkOperation(op)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stubs[op] = append(s.stubs[op], stub)
+	return stub
+}
+
+// Return answers the requests with status and body, which is encoded as JSON unless it is a []byte or nil
+func (st *Stub) Return(status int, body any) *Stub {
+	st.respond = func(*http.Request) (int, any) { return status, body }
+	return st
+}
+
+// Respond answers the requests with the status and the body returned by fn, e.g. to answer depending on the request
+func (st *Stub) Respond(fn func(r *http.Request) (status int, body any)) *Stub {
+	st.respond = fn
+	return st
+}
+
+// Times limits the requests answered by the stub to n, the following requests are answered by the next stub of
+// the operation. By default, a stub answers all requests.
+func (st *Stub) Times(n int) *Stub {
+	st.times = n
+	return st
+}
+
+// Calls returns the requests of the operation of service received by the server, in the order they were received.
+// The operation is checked like in On.
+func (s *Server) Calls(service, operation string) []Call {
+	s.t.Helper()
+	op := config.Operation{Service: service, Name: operation}
+	s.checkOperation(op)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := []Call{}
+	for _, c := range s.calls {
+		if c.Operation == op {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// checkOperation fails the test if op isn't an operation of its service
+func (s *Server) checkOperation(op config.Operation) {
+	s.t.Helper()
+	serviceRegistered, operationRegistered := config.IsRegisteredOperation(op)
+	if !serviceRegistered {
+		s.t.Errorf("stackitmock: no operations of service %q are registered, import the package of its API client or register them with config.RegisterOperations", op.Service)
+		return
+	}
+	if !operationRegistered {
+		s.t.Errorf("stackitmock: service %q has no operation %q, see the methods of its DefaultApi", op.Service, op.Name)
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.fail(w, http.StatusBadRequest, "reading the body of %s %s: %v", r.Method, r.URL.Path, err)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	var op config.Operation
+	if service, name, ok := strings.Cut(r.Header.Get(operationHeader), "/"); ok {
+		op = config.Operation{Service: service, Name: name}
+	}
+	header := r.Header.Clone()
+	header.Del(operationHeader)
+
+	s.mu.Lock()
+	s.calls = append(s.calls, Call{
+		Operation: op,
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		Query:     r.URL.Query(),
+		Header:    header,
+		Body:      body,
+	})
+	stub := s.nextStub(op)
+	s.mu.Unlock()
+
+	if op.Name == "" {
+		s.fail(w, http.StatusNotImplemented, "unexpected request %s %s without operation, the API client must be configured with the options of the server", r.Method, r.URL.Path)
+		return
+	}
+	if stub == nil {
+		s.fail(w, http.StatusNotImplemented, "unexpected request %s %s of operation %s/%s, register a response with On(%q, %q)", r.Method, r.URL.Path, op.Service, op.Name, op.Service, op.Name)
+		return
+	}
+
+	status, respBody := stub.respond(r)
+	var b []byte
+	switch v := respBody.(type) {
+	case nil:
+	case []byte:
+		b = v
+	default:
+		b, err = json.Marshal(v)
+		if err != nil {
+			s.fail(w, http.StatusInternalServerError, "encoding the response of operation %s/%s: %v", op.Service, op.Name, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+// nextStub returns the stub which answers the next request of op, if any. It must be called with mu locked.
+func (s *Server) nextStub(op config.Operation) *Stub {
+	for _, stub := range s.stubs[op] {
+		if stub.times <= 0 || stub.served < stub.times {
+			stub.served++
+			return stub
+		}
+	}
+	return nil
+}
+
+// fail fails the test and answers the request with status and the message as error
+func (s *Server) fail(w http.ResponseWriter, status int, format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	s.t.Errorf("stackitmock: %s", msg)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": msg})
+}
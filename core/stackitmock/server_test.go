@@ -0,0 +1,171 @@
+package stackitmock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+type zone struct {
+	Id    string `json:"id"`
+	State string `json:"state"`
+}
+
+func init() {
+	// The tests don't import the dns API client, which registers its operations
+	config.RegisterOperations("dns", "CreateZone", "GetZone", "DeleteZone", "ListZones")
+}
+
+// recordingTB records the errors of the server instead of failing the test
+type recordingTB struct {
+	testing.TB
+	mu     sync.Mutex
+	errors []string
+}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// client returns an HTTP client configured like an API client with the options of server
+func client(t *testing.T, server *Server) *http.Client {
+	t.Helper()
+	cfg := &config.Configuration{}
+	for _, opt := range server.ConfigurationOptions() {
+		if err := opt(cfg); err != nil {
+			t.Fatalf("applying option: %v", err)
+		}
+	}
+	return &http.Client{Transport: config.AssembleTransport(cfg, http.DefaultTransport)}
+}
+
+func send(t *testing.T, c *http.Client, server *Server, operation, method, path, body string) (int, string) {
+	t.Helper()
+	ctx := context.Background()
+	if operation != "" {
+		ctx = config.WithOperation(ctx, "dns", operation)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, server.URL()+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %v", err)
+	}
+	return resp.StatusCode, string(b)
+}
+
+func TestServer(t *testing.T) {
+	server := NewServer(t)
+	server.On("dns", "CreateZone").Return(http.StatusAccepted, zone{Id: "zone-id", State: "CREATING"})
+	server.On("dns", "GetZone").Return(http.StatusOK, zone{Id: "zone-id", State: "CREATING"}).Times(2)
+	server.On("dns", "GetZone").Return(http.StatusOK, zone{Id: "zone-id", State: "CREATE_SUCCEEDED"})
+	server.On("dns", "DeleteZone").Respond(func(r *http.Request) (int, any) {
+		if r.URL.Query().Get("force") != "true" {
+			return http.StatusBadRequest, []byte(`{"message":"not forced"}`)
+		}
+		return http.StatusNoContent, nil
+	})
+	c := client(t, server)
+
+	status, body := send(t, c, server, "CreateZone", http.MethodPost, "/v1/projects/p/zones", `{"name":"zone"}`)
+	if status != http.StatusAccepted || body != `{"id":"zone-id","state":"CREATING"}` {
+		t.Fatalf("unexpected response %d %s", status, body)
+	}
+
+	// The stubs of an operation answer in order
+	var states []string
+	for i := 0; i < 4; i++ {
+		_, body := send(t, c, server, "GetZone", http.MethodGet, "/v1/projects/p/zones/zone-id", "")
+		var z zone
+		if err := json.Unmarshal([]byte(body), &z); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		states = append(states, z.State)
+	}
+	if diff := cmp.Diff([]string{"CREATING", "CREATING", "CREATE_SUCCEEDED", "CREATE_SUCCEEDED"}, states); diff != "" {
+		t.Fatalf("unexpected states (-want +got):\n%s", diff)
+	}
+
+	if status, _ := send(t, c, server, "DeleteZone", http.MethodDelete, "/v1/projects/p/zones/zone-id", ""); status != http.StatusBadRequest {
+		t.Fatalf("expected the response of the handler, got %d", status)
+	}
+	if status, _ := send(t, c, server, "DeleteZone", http.MethodDelete, "/v1/projects/p/zones/zone-id?force=true", ""); status != http.StatusNoContent {
+		t.Fatalf("expected the response of the handler, got %d", status)
+	}
+
+	calls := server.Calls("dns", "CreateZone")
+	if len(calls) != 1 {
+		t.Fatalf("expected one CreateZone call, got %d", len(calls))
+	}
+	var payload struct {
+		Name string `json:"name"`
+	}
+	if err := calls[0].DecodeBody(&payload); err != nil {
+		t.Fatalf("DecodeBody() error = %v", err)
+	}
+	if calls[0].Method != http.MethodPost || calls[0].Path != "/v1/projects/p/zones" || payload.Name != "zone" {
+		t.Fatalf("unexpected call %+v", calls[0])
+	}
+	if calls[0].Header.Get(operationHeader) != "" {
+		t.Fatalf("expected the operation header to be removed from the call")
+	}
+	if got := len(server.Calls("dns", "GetZone")); got != 4 {
+		t.Fatalf("expected 4 GetZone calls, got %d", got)
+	}
+}
+
+func TestServerUnexpectedRequest(t *testing.T) {
+	tb := &recordingTB{TB: t}
+	server := NewServer(tb)
+	c := client(t, server)
+
+	for _, operation := range []string{"ListZones", ""} {
+		if status, _ := send(t, c, server, operation, http.MethodGet, "/v1/projects/p/zones", ""); status != http.StatusNotImplemented {
+			t.Fatalf("expected 501 Not Implemented, got %d", status)
+		}
+	}
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	if len(tb.errors) != 2 || !strings.Contains(tb.errors[0], `On("dns", "ListZones")`) {
+		t.Fatalf("expected the unexpected requests to fail the test, got %q", tb.errors)
+	}
+}
+
+func TestServerUnknownOperation(t *testing.T) {
+	tb := &recordingTB{TB: t}
+	server := NewServer(tb)
+
+	server.On("dns", "CreateZon")
+	server.On("dsn", "CreateZone")
+	server.Calls("dns", "GetZones")
+	server.On("dns", "CreateZone")
+
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	want := []string{
+		`stackitmock: service "dns" has no operation "CreateZon", see the methods of its DefaultApi`,
+		`stackitmock: no operations of service "dsn" are registered, import the package of its API client or register them with config.RegisterOperations`,
+		`stackitmock: service "dns" has no operation "GetZones", see the methods of its DefaultApi`,
+	}
+	if diff := cmp.Diff(want, tb.errors); diff != "" {
+		t.Fatalf("unexpected errors (-want +got):\n%s", diff)
+	}
+}
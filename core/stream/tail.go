@@ -0,0 +1,193 @@
+// Package stream implements streaming reads on top of the APIs of the SDK.
+package stream
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultPollInterval = 2 * time.Second
+	DefaultBufferSize   = 16
+	DefaultMaxRetries   = 5
+	DefaultRetryBackoff = time.Second
+	DefaultMaxBackoff   = 30 * time.Second
+)
+
+// SnapshotFunc returns the current tail of a log, e.g. the last lines of the console output of a server
+type SnapshotFunc func(ctx context.Context) (string, error)
+
+// TailOptions configures Tail. Zero values are replaced by the defaults.
+type TailOptions struct {
+	// Interval between two snapshots. Defaults to DefaultPollInterval
+	PollInterval time.Duration
+	// Number of chunks of new output buffered until they are read, the polling is paused while the buffer is full.
+	// Defaults to DefaultBufferSize
+	BufferSize int
+	// Number of consecutive failed snapshots after which the stream fails with the last error.
+	// Defaults to DefaultMaxRetries, a negative value retries until the context is canceled
+	MaxRetries int
+	// Backoff before retrying a failed snapshot, doubled for each consecutive failure up to MaxBackoff.
+	// Defaults to DefaultRetryBackoff and DefaultMaxBackoff
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+}
+
+func (o *TailOptions) setDefaults() {
+	if o.PollInterval <= 0 {
+		o.PollInterval = DefaultPollInterval
+	}
+	if o.BufferSize <= 0 {
+		o.BufferSize = DefaultBufferSize
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = DefaultMaxRetries
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = DefaultRetryBackoff
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = DefaultMaxBackoff
+	}
+}
+
+// Tail streams a log that can only be read as snapshots of its tail, by polling snapshot and writing to the stream
+// only the output which was not part of the previous snapshot.
+//
+// Failed snapshots are retried with exponential backoff, see TailOptions.
+// Once ctx is canceled or the stream is closed, the polling stops and Read returns io.EOF after the buffered output.
+// If the retries are exhausted, Read returns the last error after the buffered output.
+func Tail(ctx context.Context, snapshot SnapshotFunc, opts TailOptions) io.ReadCloser {
+	opts.setDefaults()
+	ctx, cancel := context.WithCancel(ctx)
+	t := &tailReader{
+		chunks: make(chan []byte, opts.BufferSize),
+		cancel: cancel,
+	}
+	go t.poll(ctx, snapshot, opts)
+	return t
+}
+
+type tailReader struct {
+	chunks  chan []byte
+	cancel  context.CancelFunc
+	pending []byte
+
+	mu  sync.Mutex
+	err error
+}
+
+func (t *tailReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for len(t.pending) == 0 {
+		chunk, ok := <-t.chunks
+		if !ok {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			if t.err != nil {
+				return 0, t.err
+			}
+			return 0, io.EOF
+		}
+		t.pending = chunk
+	}
+	n := copy(p, t.pending)
+	t.pending = t.pending[n:]
+	return n, nil
+}
+
+// Close stops the polling
+func (t *tailReader) Close() error {
+	t.cancel()
+	return nil
+}
+
+func (t *tailReader) poll(ctx context.Context, snapshot SnapshotFunc, opts TailOptions) {
+	defer close(t.chunks)
+
+	var previous string
+	failures := 0
+	for {
+		current, err := snapshot(ctx)
+		wait := opts.PollInterval
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			failures++
+			if opts.MaxRetries > 0 && failures > opts.MaxRetries {
+				t.mu.Lock()
+				t.err = fmt.Errorf("reading snapshot, %d retries exhausted: %w", opts.MaxRetries, err)
+				t.mu.Unlock()
+				return
+			}
+			wait = backoff(opts.RetryBackoff, opts.MaxBackoff, failures)
+		} else {
+			failures = 0
+			if output := newOutput(previous, current); output != "" {
+				select {
+				case t.chunks <- []byte(output):
+				case <-ctx.Done():
+					return
+				}
+			}
+			previous = current
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func backoff(base, maxBackoff time.Duration, failures int) time.Duration {
+	d := base
+	for i := 1; i < failures && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
+
+// newOutput returns the part of current which follows the longest suffix of previous that is a prefix of current.
+// If previous and current don't overlap, e.g. because more output was written between the snapshots than fits in one,
+// the whole current snapshot is returned.
+func newOutput(previous, current string) string {
+	overlap := len(previous)
+	if len(current) < overlap {
+		overlap = len(current)
+	}
+	if overlap == 0 {
+		return current
+	}
+
+	// Longest prefix of current which is a suffix of previous, using the prefix function of the concatenation
+	s := current[:overlap] + previous[len(previous)-overlap:]
+	pi := make([]int, len(s))
+	for i := 1; i < len(s); i++ {
+		k := pi[i-1]
+		for k > 0 && s[i] != s[k] {
+			k = pi[k-1]
+		}
+		if s[i] == s[k] {
+			k++
+		}
+		pi[i] = k
+	}
+	k := pi[len(s)-1]
+	for k > overlap {
+		k = pi[k-1]
+	}
+	return current[k:]
+}
@@ -0,0 +1,142 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewOutput(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		previous string
+		current  string
+		expected string
+	}{
+		{"first_snapshot", "", "a\nb\n", "a\nb\n"},
+		{"unchanged", "a\nb\n", "a\nb\n", ""},
+		{"appended", "a\nb\n", "a\nb\nc\n", "c\n"},
+		{"window_shifted", "a\nb\nc\n", "b\nc\nd\ne\n", "d\ne\n"},
+		{"repeated_output", "a\n", "a\na\n", "a\n"},
+		{"no_overlap", "a\nb\n", "c\nd\n", "c\nd\n"},
+		{"empty_snapshot", "a\n", "", ""},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := newOutput(tt.previous, tt.current); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// snapshots returns a SnapshotFunc returning the given snapshots, and its outcome forever once they are exhausted
+func snapshots(outputs []string, errs []error, last error) (SnapshotFunc, func() int) {
+	var mu sync.Mutex
+	calls := 0
+	return func(_ context.Context) (string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			if calls > len(outputs) {
+				return outputs[len(outputs)-1], last
+			}
+			return outputs[calls-1], errs[calls-1]
+		}, func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return calls
+		}
+}
+
+func TestTail(t *testing.T) {
+	transient := errors.New("connection reset")
+	for _, tt := range []struct {
+		desc           string
+		outputs        []string
+		errs           []error
+		last           error
+		maxRetries     int
+		expectedOutput string
+		expectedErr    bool
+	}{
+		{
+			desc:           "retries_exhausted",
+			outputs:        []string{"a\n", "a\nb\n", "", "b\nc\n"},
+			errs:           []error{nil, nil, transient, nil},
+			last:           transient,
+			maxRetries:     2,
+			expectedOutput: "a\nb\nc\n",
+			expectedErr:    true,
+		},
+		{
+			desc:        "always_failing",
+			outputs:     []string{""},
+			errs:        []error{transient},
+			last:        transient,
+			maxRetries:  1,
+			expectedErr: true,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			snapshot, _ := snapshots(tt.outputs, tt.errs, tt.last)
+			r := Tail(context.Background(), snapshot, TailOptions{
+				PollInterval: time.Millisecond,
+				MaxRetries:   tt.maxRetries,
+				RetryBackoff: time.Millisecond,
+			})
+			defer r.Close()
+
+			output, err := io.ReadAll(r)
+			if string(output) != tt.expectedOutput {
+				t.Errorf("expected output %q, got %q", tt.expectedOutput, output)
+			}
+			if tt.expectedErr && !errors.Is(err, tt.last) {
+				t.Errorf("expected error %v, got %v", tt.last, err)
+			}
+			if !tt.expectedErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTailStops(t *testing.T) {
+	for _, tt := range []struct {
+		desc  string
+		close bool
+	}{
+		{"context_canceled", false},
+		{"closed", true},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			snapshot, calls := snapshots([]string{"a\n"}, []error{nil}, nil)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			r := Tail(ctx, snapshot, TailOptions{PollInterval: time.Millisecond})
+
+			buf := make([]byte, 10)
+			n, err := r.Read(buf)
+			if err != nil || string(buf[:n]) != "a\n" {
+				t.Fatalf("expected output %q, got %q: %v", "a\n", buf[:n], err)
+			}
+
+			if tt.close {
+				_ = r.Close()
+			} else {
+				cancel()
+			}
+			_, err = io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("expected EOF after stop, got %v", err)
+			}
+			stoppedAt := calls()
+			time.Sleep(10 * time.Millisecond)
+			if calls() != stoppedAt {
+				t.Errorf("expected polling to stop")
+			}
+		})
+	}
+}
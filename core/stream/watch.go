@@ -0,0 +1,230 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+// Event is a change of a resource received from a watch stream, see Watch
+type Event[T any] struct {
+	// Type of the change, the event field of the server-sent event, e.g. "ADDED" or "DELETED"
+	Type string
+	// ID of the event, usually the resource version. After a reconnect, the stream resumes after the last ID received
+	ID string
+	// Object is the resource decoded from the data of the event
+	Object *T
+	// Err is only set on the last event of a failed stream, e.g. once the reconnects are exhausted
+	Err error
+}
+
+// WatchConnectFunc opens a stream of server-sent events, which resumes after the event with lastEventID, or starts
+// from the current state if lastEventID is empty. See SSEConnect for endpoints which follow the server-sent events
+// specification. The stream must end when ctx is canceled, as the body of an HTTP request sent with ctx does.
+type WatchConnectFunc func(ctx context.Context, lastEventID string) (io.ReadCloser, error)
+
+// WatchOptions configures Watch. Zero values are replaced by the defaults.
+type WatchOptions struct {
+	// Number of events buffered until they are received, the stream is not read while the buffer is full.
+	// Defaults to DefaultBufferSize
+	BufferSize int
+	// Number of consecutive failed connections after which the watch fails with the last error.
+	// Defaults to DefaultMaxRetries, a negative value reconnects until the context is canceled
+	MaxRetries int
+	// Backoff before reconnecting, doubled for each consecutive failure up to MaxBackoff.
+	// Defaults to DefaultRetryBackoff and DefaultMaxBackoff
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+}
+
+func (o *WatchOptions) setDefaults() {
+	if o.BufferSize <= 0 {
+		o.BufferSize = DefaultBufferSize
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = DefaultMaxRetries
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = DefaultRetryBackoff
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = DefaultMaxBackoff
+	}
+}
+
+// Watch consumes a stream of server-sent events opened with connect and sends the events, with their data decoded
+// from JSON into T, to the returned channel. It returns an error if the first connection fails.
+//
+// If the stream is disconnected, Watch reconnects with exponential backoff, see WatchOptions, and resumes after the
+// last event received. Once the reconnects are exhausted, or an event can't be decoded, a last event with the error
+// is sent and the channel is closed. The channel is closed without error event once ctx is canceled.
+func Watch[T any](ctx context.Context, connect WatchConnectFunc, opts WatchOptions) (<-chan Event[T], error) {
+	opts.setDefaults()
+	body, err := connect(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("connecting to the watch stream: %w", err)
+	}
+	events := make(chan Event[T], opts.BufferSize)
+	go watch(ctx, connect, opts, body, events)
+	return events, nil
+}
+
+func watch[T any](ctx context.Context, connect WatchConnectFunc, opts WatchOptions, body io.ReadCloser, events chan<- Event[T]) {
+	defer close(events)
+
+	lastEventID := ""
+	failures := 0
+	for {
+		received, err := readEvents(ctx, body, events, &lastEventID)
+		_ = body.Close()
+		if ctx.Err() != nil {
+			return
+		}
+		var decodeErr *eventDecodeError
+		if errors.As(err, &decodeErr) {
+			sendEvent(ctx, events, Event[T]{Err: err})
+			return
+		}
+		if received {
+			failures = 0
+		}
+
+		// The stream was disconnected, reconnect until a connection succeeds or the retries are exhausted
+		for {
+			failures++
+			if opts.MaxRetries > 0 && failures > opts.MaxRetries {
+				if err == nil {
+					err = io.ErrUnexpectedEOF
+				}
+				sendEvent(ctx, events, Event[T]{Err: fmt.Errorf("watch stream disconnected, %d retries exhausted: %w", opts.MaxRetries, err)})
+				return
+			}
+			timer := time.NewTimer(backoff(opts.RetryBackoff, opts.MaxBackoff, failures))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			body, err = connect(ctx, lastEventID)
+			if err == nil {
+				break
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}
+}
+
+// eventDecodeError is returned by readEvents if the data of an event can't be decoded
+type eventDecodeError struct {
+	id  string
+	err error
+}
+
+func (e *eventDecodeError) Error() string {
+	return fmt.Sprintf("decoding watch event %q: %v", e.id, e.err)
+}
+
+func (e *eventDecodeError) Unwrap() error {
+	return e.err
+}
+
+// readEvents parses the server-sent events of body and sends them to events until body ends or ctx is canceled.
+// It reports whether an event was received, and updates lastEventID with the ID of each event.
+func readEvents[T any](ctx context.Context, body io.Reader, events chan<- Event[T], lastEventID *string) (received bool, err error) {
+	r := bufio.NewReader(body)
+	var eventType string
+	var data []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			// An event which isn't terminated by an empty line is incomplete and discarded
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return received, err
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+
+		if line == "" {
+			if len(data) > 0 {
+				event := Event[T]{Type: eventType, ID: *lastEventID}
+				if err := json.Unmarshal([]byte(strings.Join(data, "\n")), &event.Object); err != nil {
+					return received, &eventDecodeError{id: event.ID, err: err}
+				}
+				if !sendEvent(ctx, events, event) {
+					return received, ctx.Err()
+				}
+				received = true
+			}
+			eventType, data = "", nil
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			// Comment, e.g. a keep-alive
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			eventType = value
+		case "data":
+			data = append(data, value)
+		case "id":
+			*lastEventID = value
+		}
+	}
+}
+
+func sendEvent[T any](ctx context.Context, events chan<- Event[T], event Event[T]) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// SSEConnect returns a WatchConnectFunc which opens the stream of server-sent events of url with a GET request sent
+// with client, e.g. the HTTP client of an API client, which authenticates the request. It resumes after the last event
+// with the Last-Event-ID header. A response with an error status code is returned as a GenericOpenAPIError.
+func SSEConnect(client *http.Client, url string) WatchConnectFunc {
+	return func(ctx context.Context, lastEventID string) (io.ReadCloser, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "text/event-stream")
+		req.Header.Set("Cache-Control", "no-cache")
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode >= 300 {
+			defer resp.Body.Close() //nolint:errcheck // the body is read for the error
+			body, _ := io.ReadAll(resp.Body)
+			return nil, &oapierror.GenericOpenAPIError{
+				StatusCode:   resp.StatusCode,
+				Body:         body,
+				ErrorMessage: resp.Status,
+				ContentType:  resp.Header.Get("Content-Type"),
+			}
+		}
+		return resp.Body, nil
+	}
+}
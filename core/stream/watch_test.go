@@ -0,0 +1,191 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+type watchedResource struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+func TestReadEvents(t *testing.T) {
+	body := strings.Join([]string{
+		": keep-alive",
+		"retry: 1000",
+		"event: ADDED",
+		"id: 1",
+		`data: {"name": "a",`,
+		`data:  "state": "CREATING"}`,
+		"",
+		"",
+		"event: MODIFIED\r",
+		"id: 2\r",
+		`data: {"name": "a", "state": "ACTIVE"}` + "\r",
+		"\r",
+		"event: DELETED",
+		`data: {"name": "incomplete"}`,
+	}, "\n")
+	events := make(chan Event[watchedResource], 10)
+	lastEventID := ""
+
+	received, err := readEvents(context.Background(), strings.NewReader(body), events, &lastEventID)
+	if err != nil || !received {
+		t.Fatalf("expected events without error, got %v, %v", received, err)
+	}
+	close(events)
+
+	got := []Event[watchedResource]{}
+	for event := range events {
+		got = append(got, event)
+	}
+	want := []Event[watchedResource]{
+		{Type: "ADDED", ID: "1", Object: &watchedResource{Name: "a", State: "CREATING"}},
+		{Type: "MODIFIED", ID: "2", Object: &watchedResource{Name: "a", State: "ACTIVE"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected events: %s", diff)
+	}
+	if lastEventID != "2" {
+		t.Fatalf("expected the last event id 2, got %q", lastEventID)
+	}
+}
+
+// watchServer serves the given streams of server-sent events, one per connection, and records the Last-Event-ID
+// header of each connection. Once the streams are exhausted, it responds with 503 Service Unavailable.
+func watchServer(t *testing.T, streams []string, holdLast bool) (server *httptest.Server, lastEventIDs func() []string) {
+	t.Helper()
+	var mu sync.Mutex
+	ids := []string{}
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "text/event-stream" {
+			t.Errorf("expected to accept server-sent events, got %q", r.Header.Get("Accept"))
+		}
+		mu.Lock()
+		ids = append(ids, r.Header.Get("Last-Event-ID"))
+		n := len(ids)
+		mu.Unlock()
+		if n > len(streams) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = io.WriteString(w, streams[n-1])
+		w.(http.Flusher).Flush()
+		if holdLast && n == len(streams) {
+			<-r.Context().Done()
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, ids...)
+	}
+}
+
+func receiveEvents(t *testing.T, events <-chan Event[watchedResource], n int) []Event[watchedResource] {
+	t.Helper()
+	got := []Event[watchedResource]{}
+	for len(got) < n {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatalf("channel closed after %d events", len(got))
+			}
+			got = append(got, event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out after %d events", len(got))
+		}
+	}
+	return got
+}
+
+func TestWatchResumes(t *testing.T) {
+	server, lastEventIDs := watchServer(t, []string{
+		"event: ADDED\nid: 1\ndata: {\"name\": \"a\"}\n\nevent: ADDED\nid: 2\ndata: {\"name\": \"b\"}\n\n",
+		"event: DELETED\nid: 3\ndata: {\"name\": \"a\"}\n\n",
+	}, true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := Watch[watchedResource](ctx, SSEConnect(server.Client(), server.URL), WatchOptions{RetryBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+
+	got := receiveEvents(t, events, 3)
+	for i, want := range []string{"ADDED 1 a", "ADDED 2 b", "DELETED 3 a"} {
+		if s := fmt.Sprintf("%s %s %s", got[i].Type, got[i].ID, got[i].Object.Name); s != want || got[i].Err != nil {
+			t.Errorf("event %d: expected %q, got %q, %v", i, want, s, got[i].Err)
+		}
+	}
+	if ids := lastEventIDs(); strings.Join(ids, ",") != ",2" {
+		t.Errorf("expected to resume after event 2, got last event ids %q", ids)
+	}
+
+	cancel()
+	for event := range events {
+		t.Errorf("unexpected event after the cancellation: %+v", event)
+	}
+}
+
+func TestWatchRetriesExhausted(t *testing.T) {
+	server, lastEventIDs := watchServer(t, []string{"id: 1\ndata: {\"name\": \"a\"}\n\n"}, false)
+
+	events, err := Watch[watchedResource](context.Background(), SSEConnect(server.Client(), server.URL), WatchOptions{MaxRetries: 2, RetryBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+
+	got := receiveEvents(t, events, 2)
+	var oapiErr *oapierror.GenericOpenAPIError
+	if got[0].Err != nil || !errors.As(got[1].Err, &oapiErr) || oapiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected an event and the error of the last reconnect, got %+v", got)
+	}
+	if _, ok := <-events; ok {
+		t.Fatalf("expected the channel to be closed after the error event")
+	}
+	if ids := lastEventIDs(); strings.Join(ids, ",") != ",1,1" {
+		t.Errorf("expected 2 reconnects after event 1, got last event ids %q", ids)
+	}
+}
+
+func TestWatchDecodeError(t *testing.T) {
+	server, _ := watchServer(t, []string{"id: 1\ndata: {\"name\": \"a\"}\n\nid: 2\ndata: not json\n\n"}, true)
+
+	events, err := Watch[watchedResource](context.Background(), SSEConnect(server.Client(), server.URL), WatchOptions{})
+	if err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+
+	got := receiveEvents(t, events, 2)
+	if got[0].Err != nil || got[1].Err == nil || !strings.Contains(got[1].Err.Error(), `"2"`) {
+		t.Fatalf("expected an event and the decode error of event 2, got %+v", got)
+	}
+	if _, ok := <-events; ok {
+		t.Fatalf("expected the channel to be closed after the error event")
+	}
+}
+
+func TestWatchConnectError(t *testing.T) {
+	server, _ := watchServer(t, nil, false)
+
+	_, err := Watch[watchedResource](context.Background(), SSEConnect(server.Client(), server.URL), WatchOptions{})
+	var oapiErr *oapierror.GenericOpenAPIError
+	if !errors.As(err, &oapiErr) || oapiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected the error of the first connection, got %v", err)
+	}
+}
@@ -0,0 +1,403 @@
+// Package testutil provides helpers to test code using the SDK. A Recorder records the requests of the API clients
+// to the real APIs once, and replays them in the tests afterwards, so the tests run without credentials:
+//
+//	func TestCreateZone(t *testing.T) {
+//		recorder := testutil.NewTestRecorder(t, "testdata/create_zone.json")
+//		opts := append([]config.ConfigurationOption{config.WithServiceAccountKeyPath(keyPath)}, recorder.ConfigurationOptions()...)
+//		client, err := dns.NewAPIClient(opts...)
+//		...
+//	}
+//
+// The interactions are recorded if the environment variable STACKIT_SDK_RECORD is set, e.g.
+// STACKIT_SDK_RECORD=1 go test ./..., and replayed otherwise.
+package testutil
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+// RecordEnv is the environment variable which makes NewTestRecorder record the interactions instead of replaying them
+const RecordEnv = "STACKIT_SDK_RECORD"
+
+// redactedValue replaces the values of credentials in the recordings
+const redactedValue = "<redacted>"
+
+// Mode is the mode of a Recorder
+type Mode int
+
+const (
+	// ModeReplay answers the requests with the recorded responses, without sending them
+	ModeReplay Mode = iota
+	// ModeRecord sends the requests and records the interactions, replacing the recording when it is saved
+	ModeRecord
+)
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request of an Interaction
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+	// BodyBase64 is true if Body is base64 encoded, because it isn't valid UTF-8
+	BodyBase64 bool `json:"bodyBase64,omitempty"`
+}
+
+// RecordedResponse is a response of an Interaction
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	// BodyBase64 is true if Body is base64 encoded, because it isn't valid UTF-8
+	BodyBase64 bool `json:"bodyBase64,omitempty"`
+}
+
+// recording is the content of a recording file
+type recording struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper which records the interactions of the API clients with the APIs to a file, or
+// replays them from it, see NewRecorder. It is safe for concurrent use.
+//
+// The credentials are removed from the recordings: the values of the credential headers, query parameters and
+// JSON and form fields are replaced, see config.IsCredentialName. Further data, e.g. ids of projects, can be
+// removed with SetScrub.
+//
+// A request is answered with the first recorded interaction which wasn't replayed yet and has the same method, URL
+// and body, with JSON bodies compared by value. So a resource polled by a wait handler gets its states in the
+// recorded order.
+type Recorder struct {
+	path  string
+	mode  Mode
+	rt    http.RoundTripper
+	scrub func(*Interaction)
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// NewRecorder returns a Recorder for the recording file path. In ModeRecord, the requests are sent with rt,
+// or http.DefaultTransport if it is nil, and the recording is written by Save. In ModeReplay, the recording is read.
+func NewRecorder(path string, mode Mode, rt http.RoundTripper) (*Recorder, error) {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	r := &Recorder{path: path, mode: mode, rt: rt}
+	if mode != ModeReplay {
+		return r, nil
+	}
+	b, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("reading recording: %w", err)
+	}
+	var rec recording
+	if err := json.Unmarshal(b, &rec); err != nil {
+		return nil, fmt.Errorf("decoding recording %s: %w", path, err)
+	}
+	r.interactions = rec.Interactions
+	r.replayed = make([]bool, len(rec.Interactions))
+	return r, nil
+}
+
+// NewTestRecorder returns a Recorder for the recording file path which records the interactions if the environment
+// variable RecordEnv is set, and replays them otherwise. The recording is saved at the end of the test if it passed.
+func NewTestRecorder(t testing.TB, path string) *Recorder {
+	t.Helper()
+	mode := ModeReplay
+	if os.Getenv(RecordEnv) != "" {
+		mode = ModeRecord
+	}
+	r, err := NewRecorder(path, mode, nil)
+	if err != nil {
+		t.Fatalf("creating recorder: %v", err)
+	}
+	t.Cleanup(func() {
+		if t.Failed() {
+			return
+		}
+		if err := r.Save(); err != nil {
+			t.Errorf("saving recording: %v", err)
+		}
+	})
+	return r
+}
+
+// SetScrub sets a function which removes further data from each interaction before it is recorded, e.g. the ids of
+// projects. It isn't applied to the requests which are replayed, so the recorded requests must still match them.
+func (r *Recorder) SetScrub(scrub func(*Interaction)) *Recorder {
+	r.scrub = scrub
+	return r
+}
+
+// Mode returns the mode of the recorder
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// ConfigurationOptions returns the options of an API client which sends its requests through the recorder.
+// In ModeReplay, the authentication is disabled, so that no credentials are needed.
+// They must be passed after the other options of the API client.
+func (r *Recorder) ConfigurationOptions() []config.ConfigurationOption {
+	opts := []config.ConfigurationOption{config.WithHTTPClient(&http.Client{Transport: r})}
+	if r.mode == ModeReplay {
+		opts = append(opts, config.WithoutAuthentication())
+	}
+	return opts
+}
+
+// RoundTrip records or replays the interaction of req
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %w", err)
+	}
+	recorded := recordRequest(req, reqBody)
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{Request: recorded}
+	interaction.Response.StatusCode = resp.StatusCode
+	interaction.Response.Header = scrubHeader(resp.Header)
+	interaction.Response.Body, interaction.Response.BodyBase64 = recordBody(resp.Header.Get("Content-Type"), respBody)
+	if r.scrub != nil {
+		r.scrub(&interaction)
+	}
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay answers req with the first matching interaction which wasn't replayed yet
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.interactions {
+		if r.replayed[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		r.replayed[i] = true
+		body := []byte(interaction.Response.Body)
+		if interaction.Response.BodyBase64 {
+			var err error
+			body, err = base64.StdEncoding.DecodeString(interaction.Response.Body)
+			if err != nil {
+				return nil, fmt.Errorf("decoding recorded response body: %w", err)
+			}
+		}
+		header := interaction.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		// The recorded body can be shorter than the original one after the credentials were redacted
+		header.Del("Content-Length")
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no recorded interaction for %s %s in %s", recorded.Method, recorded.URL, r.path)
+}
+
+// Save writes the recorded interactions to the recording file in ModeRecord, creating its directory if needed.
+// It does nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode == ModeReplay {
+		return nil
+	}
+	r.mu.Lock()
+	rec := recording{Interactions: r.interactions}
+	if rec.Interactions == nil {
+		rec.Interactions = []Interaction{}
+	}
+	b, err := json.MarshalIndent(rec, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("encoding recording: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o750); err != nil {
+		return fmt.Errorf("creating recording directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(b, '\n'), 0o600); err != nil {
+		return fmt.Errorf("writing recording: %w", err)
+	}
+	return nil
+}
+
+// readRequestBody returns the body of req and replaces it by a copy, so that it can still be sent
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	b, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// recordRequest returns req as it is recorded, without credentials
+func recordRequest(req *http.Request, body []byte) RecordedRequest {
+	recorded := RecordedRequest{
+		Method: req.Method,
+		URL:    scrubURL(req.URL),
+		Header: scrubHeader(req.Header),
+	}
+	recorded.Body, recorded.BodyBase64 = recordBody(req.Header.Get("Content-Type"), body)
+	return recorded
+}
+
+// matches reports whether the recorded request matches req, which has been recorded with recordRequest
+func matches(recorded, req RecordedRequest) bool {
+	if recorded.Method != req.Method || recorded.URL != req.URL || recorded.BodyBase64 != req.BodyBase64 {
+		return false
+	}
+	if recorded.Body == req.Body {
+		return true
+	}
+	var recordedValue, value any
+	if json.Unmarshal([]byte(recorded.Body), &recordedValue) != nil || json.Unmarshal([]byte(req.Body), &value) != nil {
+		return false
+	}
+	return reflect.DeepEqual(recordedValue, value)
+}
+
+// scrubURL returns u without its credentials, and with the values of the credential query parameters redacted
+func scrubURL(u *url.URL) string {
+	scrubbed := *u
+	scrubbed.User = nil
+	if scrubbed.RawQuery != "" {
+		query := scrubbed.Query()
+		for name := range query {
+			if config.IsCredentialName(name) {
+				query[name] = []string{redactedValue}
+			}
+		}
+		scrubbed.RawQuery = query.Encode()
+	}
+	return scrubbed.String()
+}
+
+// scrubHeader returns a copy of h with the values of the credential headers redacted
+func scrubHeader(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	scrubbed := h.Clone()
+	for name := range scrubbed {
+		if config.IsCredentialName(name) {
+			scrubbed[name] = []string{redactedValue}
+		}
+	}
+	return scrubbed
+}
+
+// recordBody returns body as it is recorded: with the values of the credential fields of JSON and form bodies
+// redacted, and base64 encoded if it isn't valid UTF-8
+func recordBody(contentType string, body []byte) (string, bool) {
+	if len(body) == 0 {
+		return "", false
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		if scrubbed, err := scrubJSON(body); err == nil {
+			return scrubbed, false
+		}
+	case mediaType == "application/x-www-form-urlencoded":
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			for name := range values {
+				if config.IsCredentialName(name) {
+					values[name] = []string{redactedValue}
+				}
+			}
+			return values.Encode(), false
+		}
+	}
+	if !utf8.Valid(body) {
+		return base64.StdEncoding.EncodeToString(body), true
+	}
+	return string(body), false
+}
+
+// scrubJSON returns the JSON body with the values of the credential fields redacted
+func scrubJSON(body []byte) (string, error) {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(scrubJSONValue(v)); err != nil {
+		return "", err
+	}
+	s := strings.TrimSuffix(b.String(), "\n")
+	if s == "" {
+		return "", errors.New("empty JSON body")
+	}
+	return s, nil
+}
+
+func scrubJSONValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for name, value := range v {
+			if config.IsCredentialName(name) {
+				v[name] = redactedValue
+				continue
+			}
+			v[name] = scrubJSONValue(value)
+		}
+	case []any:
+		for i := range v {
+			v[i] = scrubJSONValue(v[i])
+		}
+	}
+	return v
+}
@@ -0,0 +1,210 @@
+package testutil
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/token":
+			_, _ = w.Write([]byte(`{"access_token":"secret-token","expires_in":3600}`))
+		case "/binary":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write([]byte{0xff, 0xfe, 0x00})
+		default:
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{"call": calls, "request": string(body)})
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func doRequest(t *testing.T, rt http.RoundTripper, method, url, contentType, body string) (int, string) {
+	t.Helper()
+	var reqBody io.Reader = http.NoBody
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), method, url, reqBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret-token")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := (&http.Client{Transport: rt}).Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %v", err)
+	}
+	return resp.StatusCode, string(b)
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	server := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "testdata", "recording.json")
+
+	recorder, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	type exchange struct {
+		method, path, contentType, body string
+	}
+	exchanges := []exchange{
+		{http.MethodPost, "/zones", "application/json", `{"name":"zone","password":"pw"}`},
+		{http.MethodGet, "/zones/1?page=2", "", ""},
+		{http.MethodGet, "/zones/1?page=2", "", ""},
+		{http.MethodPost, "/token", "application/x-www-form-urlencoded", "grant_type=x&assertion=secret-jwt"},
+		{http.MethodGet, "/binary", "", ""},
+	}
+	recorded := make([]string, len(exchanges))
+	for i, e := range exchanges {
+		_, recorded[i] = doRequest(t, recorder, e.method, server.URL+e.path, e.contentType, e.body)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading recording: %v", err)
+	}
+	for _, secret := range []string{"secret-token", "secret-jwt", `\"pw\"`} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expected %s to be redacted in the recording:\n%s", secret, b)
+		}
+	}
+
+	// The replay doesn't need the server
+	server.Close()
+	replayer, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	// JSON bodies match by value, the credentials are redacted before matching
+	exchanges[0].body = `{"password":"other","name":"zone"}`
+	for i, e := range exchanges {
+		status, body := doRequest(t, replayer, e.method, server.URL+e.path, e.contentType, e.body)
+		if i == 0 && status != http.StatusCreated {
+			t.Errorf("expected the recorded status, got %d", status)
+		}
+		want := recorded[i]
+		if e.path == "/token" {
+			want = `{"access_token":"<redacted>","expires_in":3600}`
+		}
+		if strings.TrimSpace(body) != strings.TrimSpace(want) && !jsonEqual(body, want) {
+			t.Errorf("request %d: expected the recorded body %q, got %q", i, want, body)
+		}
+	}
+
+	// The interactions are replayed once
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/zones/1?page=2", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	if resp, err := replayer.RoundTrip(req); err == nil {
+		_ = resp.Body.Close()
+		t.Fatalf("expected an error for a request without a remaining interaction")
+	}
+}
+
+func TestRecorderReplayNoMatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.json")
+	content := `{"interactions":[{"request":{"method":"POST","url":"https://dns.api.stackit.cloud/zones","body":"{\"name\":\"a\"}"},"response":{"statusCode":200}}]}`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing recording: %v", err)
+	}
+	replayer, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	for _, tt := range []struct {
+		desc, method, url, body string
+	}{
+		{"method", http.MethodPut, "https://dns.api.stackit.cloud/zones", `{"name":"a"}`},
+		{"path", http.MethodPost, "https://dns.api.stackit.cloud/records", `{"name":"a"}`},
+		{"body", http.MethodPost, "https://dns.api.stackit.cloud/zones", `{"name":"b"}`},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(context.Background(), tt.method, tt.url, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if resp, err := replayer.RoundTrip(req); err == nil {
+				_ = resp.Body.Close()
+				t.Fatalf("expected an error for a request without a recorded interaction")
+			}
+		})
+	}
+}
+
+func TestRecorderScrub(t *testing.T) {
+	server := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "recording.json")
+	recorder, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	recorder.SetScrub(func(i *Interaction) {
+		i.Request.URL = strings.ReplaceAll(i.Request.URL, "project-id", "PROJECT")
+	})
+	doRequest(t, recorder, http.MethodGet, server.URL+"/projects/project-id?access_token=abc", "", "")
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading recording: %v", err)
+	}
+	if strings.Contains(string(b), "project-id") || strings.Contains(string(b), "abc") {
+		t.Fatalf("expected the recording to be scrubbed:\n%s", b)
+	}
+}
+
+func TestNewRecorderMissingRecording(t *testing.T) {
+	if _, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil); err == nil {
+		t.Fatalf("expected an error for a missing recording")
+	}
+}
+
+func jsonEqual(a, b string) bool {
+	var va, vb any
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+	return string(ja) == string(jb)
+}
+
+func TestScrubJSONConnectionString(t *testing.T) {
+	got, err := scrubJSON([]byte(`{"credentials": {"host": "host", "password": "p", "uri": "mysql://u:p@host/db"}}`))
+	if err != nil {
+		t.Fatalf("scrubJSON() error = %v", err)
+	}
+	if strings.Contains(got, "u:p@") || !strings.Contains(got, `"host":"host"`) {
+		t.Fatalf("expected the uri with the password to be redacted, got %s", got)
+	}
+}
@@ -0,0 +1,16 @@
+package utils
+
+import (
+	"context"
+)
+
+// DeleteAll calls del for each of the ids, running up to concurrency calls at the same time.
+// It doesn't stop at the first error: all ids are processed and the errors are returned by id.
+// The ids which were deleted successfully are not part of the result, so an empty result means that all were deleted.
+//
+// If ctx is canceled, the ids which weren't processed yet fail with the context error.
+// If concurrency is lower than 1, the ids are processed one at a time. Duplicated ids are only processed once.
+// See RunAll to process the ids with other calls than deletions.
+func DeleteAll(ctx context.Context, ids []string, del func(ctx context.Context, id string) error, concurrency int) map[string]error {
+	return RunAll(ctx, ids, del, concurrency)
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDeleteAll(t *testing.T) {
+	tests := []struct {
+		desc        string
+		ids         []string
+		failing     map[string]bool
+		concurrency int
+		wantFailed  []string
+		wantDeleted []string
+	}{
+		{
+			desc:        "all_deleted",
+			ids:         []string{"a", "b", "c"},
+			concurrency: 2,
+			wantFailed:  []string{},
+			wantDeleted: []string{"a", "b", "c"},
+		},
+		{
+			desc:        "some_fail",
+			ids:         []string{"a", "b", "c", "d"},
+			failing:     map[string]bool{"b": true, "d": true},
+			concurrency: 4,
+			wantFailed:  []string{"b", "d"},
+			wantDeleted: []string{"a", "b", "c", "d"},
+		},
+		{
+			desc:        "duplicated_ids",
+			ids:         []string{"a", "a", "b"},
+			concurrency: 0,
+			wantFailed:  []string{},
+			wantDeleted: []string{"a", "b"},
+		},
+		{
+			desc:        "no_ids",
+			ids:         nil,
+			concurrency: 1,
+			wantFailed:  []string{},
+			wantDeleted: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var mu sync.Mutex
+			deleted := map[string]bool{}
+			errs := DeleteAll(context.Background(), tt.ids, func(_ context.Context, id string) error {
+				mu.Lock()
+				deleted[id] = true
+				mu.Unlock()
+				if tt.failing[id] {
+					return fmt.Errorf("delete %s failed", id)
+				}
+				return nil
+			}, tt.concurrency)
+
+			failed := []string{}
+			for _, id := range tt.ids {
+				if errs[id] != nil && !Contains(failed, id) {
+					failed = append(failed, id)
+				}
+			}
+			if diff := cmp.Diff(tt.wantFailed, failed); diff != "" {
+				t.Errorf("unexpected failed ids: %s", diff)
+			}
+			if len(errs) != len(tt.wantFailed) {
+				t.Errorf("expected %d errors, got %d", len(tt.wantFailed), len(errs))
+			}
+			if len(deleted) != len(tt.wantDeleted) {
+				t.Errorf("expected %d deletions, got %d", len(tt.wantDeleted), len(deleted))
+			}
+			for _, id := range tt.wantDeleted {
+				if !deleted[id] {
+					t.Errorf("expected %s to be deleted", id)
+				}
+			}
+		})
+	}
+}
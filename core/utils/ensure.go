@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+// Ensure makes sure that a resource exists: it creates it with create and returns it with created set to true.
+// If create fails with a 409 Conflict, e.g. because the resource was created before or concurrently, the existing
+// resource is fetched with get and returned with created set to false.
+//
+// get returns nil, without an error, if there is no such resource, in which case the conflict was caused by
+// something else and its error is returned.
+func Ensure[T any](ctx context.Context, create, get func(ctx context.Context) (*T, error)) (resource *T, created bool, err error) {
+	resource, err = create(ctx)
+	if err == nil {
+		return resource, true, nil
+	}
+	if !oapierror.IsConflict(err) {
+		return nil, false, err
+	}
+
+	existing, getErr := get(ctx)
+	if getErr != nil {
+		return nil, false, fmt.Errorf("get existing resource: %w", getErr)
+	}
+	if existing == nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+func TestEnsure(t *testing.T) {
+	conflict := &oapierror.GenericOpenAPIError{StatusCode: http.StatusConflict}
+	badRequest := &oapierror.GenericOpenAPIError{StatusCode: http.StatusBadRequest}
+	errGet := errors.New("get failed")
+
+	for _, tt := range []struct {
+		desc        string
+		createErr   error
+		existing    *string
+		getErr      error
+		wantValue   string
+		wantCreated bool
+		wantErr     error
+	}{
+		{desc: "created", wantValue: "new", wantCreated: true},
+		{desc: "already_exists", createErr: conflict, existing: Ptr("existing"), wantValue: "existing"},
+		{desc: "conflict_without_resource", createErr: conflict, wantErr: conflict},
+		{desc: "get_fails", createErr: conflict, getErr: errGet, wantErr: errGet},
+		{desc: "create_fails", createErr: badRequest, existing: Ptr("existing"), wantErr: badRequest},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			resource, created, err := Ensure(context.Background(),
+				func(_ context.Context) (*string, error) {
+					if tt.createErr != nil {
+						return nil, tt.createErr
+					}
+					return Ptr("new"), nil
+				},
+				func(_ context.Context) (*string, error) {
+					return tt.existing, tt.getErr
+				},
+			)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Ensure failed: %v", err)
+			}
+			if *resource != tt.wantValue || created != tt.wantCreated {
+				t.Fatalf("expected %q, created %t, got %q, created %t", tt.wantValue, tt.wantCreated, *resource, created)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"context"
+	"sync"
+)
+
+// RunAll calls run for each of the keys, running up to concurrency calls at the same time, e.g. to create many
+// resources concurrently. It doesn't stop at the first error: all keys are processed and the errors are returned by
+// key. The keys which were processed successfully are not part of the result, so an empty result means that all
+// calls succeeded.
+//
+// If ctx is canceled, the keys which weren't processed yet fail with the context error.
+// If concurrency is lower than 1, the keys are processed one at a time. Duplicated keys are only processed once.
+func RunAll(ctx context.Context, keys []string, run func(ctx context.Context, key string) error, concurrency int) map[string]error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		errs   = map[string]error{}
+		seen   = map[string]bool{}
+		tokens = make(chan struct{}, concurrency)
+	)
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if err := acquire(ctx, tokens); err != nil {
+			mu.Lock()
+			errs[key] = err
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			defer func() { <-tokens }()
+			if err := run(ctx, key); err != nil {
+				mu.Lock()
+				errs[key] = err
+				mu.Unlock()
+			}
+		}(key)
+	}
+	wg.Wait()
+	return errs
+}
+
+// acquire takes a token, unless ctx is done first
+func acquire(ctx context.Context, tokens chan struct{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case tokens <- struct{}{}:
+		return nil
+	}
+}
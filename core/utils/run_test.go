@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunAllConcurrency(t *testing.T) {
+	var running, maxRunning int32
+	keys := []string{"a", "b", "c", "d", "e", "f"}
+	errs := RunAll(context.Background(), keys, func(_ context.Context, _ string) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}, 2)
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	if maxRunning > 2 {
+		t.Fatalf("expected at most 2 concurrent calls, got %d", maxRunning)
+	}
+}
+
+func TestRunAllContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs := RunAll(ctx, []string{"a", "b"}, func(_ context.Context, _ string) error {
+		return nil
+	}, 1)
+	for _, key := range []string{"a", "b"} {
+		if errs[key] != context.Canceled { //nolint:errorlint // the context error is returned as is
+			t.Errorf("expected %s to fail with context canceled, got %v", key, errs[key])
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+// BatchFetch fetches the resources with the given ids with a single request, e.g. a list request of the resources of a
+// project, and returns them by id. The resources which aren't returned, e.g. because they were deleted, are passed as
+// nil to the ready functions of their waiters.
+type BatchFetch[T any] func(ctx context.Context, ids []string) (map[string]*T, error)
+
+// BatchGet fetches the resource with the given id, for services without an endpoint to fetch many resources at once.
+// A resource which isn't found, i.e. the API returns 404 Not Found, is passed as nil to the ready functions of its
+// waiters.
+type BatchGet[T any] func(ctx context.Context, id string) (*T, error)
+
+// BatchPoller waits for many resources of the same type with a single poll loop: instead of each waiter fetching its
+// resource, the poller fetches the resources of all waiters with one BatchFetch per interval, and evaluates the ready
+// function of each waiter for its resource. This reduces the requests of waits for many resources, e.g. after a bulk
+// creation, from one per resource to one per interval.
+//
+// If the poller has no BatchFetch, or the API rejects it with 404 Not Found, 405 Method Not Allowed or 501 Not
+// Implemented, the resources are fetched one by one with the BatchGet of the poller, still in a single poll loop.
+//
+// A BatchPoller is safe for concurrent use: typically, each waiter calls Wait from its own goroutine.
+//
+// The resources are fetched by the poll loop, not by the waiters, so the values of the contexts passed to Wait, e.g.
+// clients.WithToken or clients.WithRetryPolicy, aren't passed to the fetches. The fetches are canceled once no waiter
+// is left, and each poll is limited by the timeout set with SetPollTimeout.
+//
+//	poller := wait.NewBatchPoller(listServers, getServer)
+//	for _, id := range ids {
+//		go func(id string) {
+//			server, err := poller.Wait(ctx, id, serverActive)
+//			...
+//		}(id)
+//	}
+type BatchPoller[T any] struct {
+	fetch             BatchFetch[T]
+	get               BatchGet[T]
+	throttle          time.Duration
+	timeout           time.Duration
+	pollTimeout       time.Duration
+	tempErrRetryLimit int
+
+	mu      sync.Mutex
+	waiters map[*batchWaiter[T]]struct{}
+	running bool
+	// loopCtx is the context of the fetches of the poll loop, canceled with cancelLoop once no waiter is left
+	loopCtx     context.Context
+	cancelLoop  context.CancelFunc
+	unsupported bool
+}
+
+type batchWaiter[T any] struct {
+	id                    string
+	ready                 AsyncActionReadyFunc[T]
+	retryTempErrorCounter int
+	done                  chan batchResult[T]
+}
+
+type batchResult[T any] struct {
+	res *T
+	err error
+}
+
+// NewBatchPoller initializes a BatchPoller which fetches the resources with fetch, or one by one with get if fetch is
+// nil or not supported by the API. At least one of them must be set.
+func NewBatchPoller[T any](fetch BatchFetch[T], get BatchGet[T]) *BatchPoller[T] {
+	return &BatchPoller[T]{
+		fetch:             fetch,
+		get:               get,
+		throttle:          5 * time.Second,
+		timeout:           30 * time.Minute,
+		pollTimeout:       time.Minute,
+		tempErrRetryLimit: 5,
+		waiters:           map[*batchWaiter[T]]struct{}{},
+	}
+}
+
+// SetThrottle sets the time interval between each poll of the resources.
+func (p *BatchPoller[T]) SetThrottle(d time.Duration) *BatchPoller[T] {
+	p.throttle = d
+	return p
+}
+
+// SetTimeout sets the duration for the timeout of each wait.
+func (p *BatchPoller[T]) SetTimeout(d time.Duration) *BatchPoller[T] {
+	p.timeout = d
+	return p
+}
+
+// SetPollTimeout sets the maximum duration of each poll of the resources, i.e. of the BatchFetch or of all BatchGet
+// of an interval. A poll which times out counts as a temporary error of the waits, see SetTempErrRetryLimit.
+func (p *BatchPoller[T]) SetPollTimeout(d time.Duration) *BatchPoller[T] {
+	p.pollTimeout = d
+	return p
+}
+
+// SetTempErrRetryLimit sets the retry limit of each wait if a temporary error is found.
+// The list of temporary errors is defined in the RetryHttpErrorStatusCodes variable.
+func (p *BatchPoller[T]) SetTempErrRetryLimit(l int) *BatchPoller[T] {
+	p.tempErrRetryLimit = l
+	return p
+}
+
+// Wait waits until ready reports the resource with id as done, and returns it. The wait finishes with an error once
+// ready reports the resource as failed, see AsyncActionReadyFunc, if fetching the resources fails with an error which
+// isn't temporary, or when ctx is done or the timeout of the poller is reached.
+//
+// ready is called with nil if the resource wasn't fetched, e.g. because it was deleted.
+//
+// ctx only bounds the wait, its values aren't passed to the fetches of the poller, see BatchPoller.
+func (p *BatchPoller[T]) Wait(ctx context.Context, id string, ready AsyncActionReadyFunc[T]) (*T, error) {
+	if p.throttle == 0 {
+		return nil, fmt.Errorf("throttle can't be 0")
+	}
+	if p.fetch == nil && p.get == nil {
+		return nil, fmt.Errorf("the poller can't fetch the resources")
+	}
+	if ready == nil {
+		return nil, fmt.Errorf("ready function can't be nil")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	w := &batchWaiter[T]{id: id, ready: ready, done: make(chan batchResult[T], 1)}
+	p.mu.Lock()
+	p.waiters[w] = struct{}{}
+	if p.loopCtx == nil || p.loopCtx.Err() != nil {
+		// The context of the loop was canceled when the last waiter left, the next fetches need a new one
+		p.loopCtx, p.cancelLoop = context.WithCancel(context.Background())
+	}
+	if !p.running {
+		p.running = true
+		go p.loop()
+	}
+	p.mu.Unlock()
+
+	select {
+	case r := <-w.done:
+		return r.res, r.err
+	case <-ctx.Done():
+		p.remove(w)
+		return nil, fmt.Errorf("wait for %q has timed out", id)
+	}
+}
+
+// remove removes w from the waiters of the poller and cancels the fetches of the loop if no waiter is left
+func (p *BatchPoller[T]) remove(w *batchWaiter[T]) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.waiters, w)
+	if len(p.waiters) == 0 && p.cancelLoop != nil {
+		p.cancelLoop()
+	}
+}
+
+// loop polls the resources of the waiters until there are none left
+func (p *BatchPoller[T]) loop() {
+	ticker := time.NewTicker(p.throttle)
+	defer ticker.Stop()
+
+	for {
+		p.mu.Lock()
+		if len(p.waiters) == 0 {
+			p.running = false
+			p.mu.Unlock()
+			return
+		}
+		waiters := make([]*batchWaiter[T], 0, len(p.waiters))
+		for w := range p.waiters {
+			waiters = append(waiters, w)
+		}
+		loopCtx := p.loopCtx
+		p.mu.Unlock()
+
+		p.poll(loopCtx, waiters)
+		select {
+		case <-ticker.C:
+		case <-loopCtx.Done():
+		}
+	}
+}
+
+// poll fetches the resources of waiters with a timeout and finishes the waits which are done
+func (p *BatchPoller[T]) poll(ctx context.Context, waiters []*batchWaiter[T]) {
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, w := range waiters {
+		if !seen[w.id] {
+			seen[w.id] = true
+			ids = append(ids, w.id)
+		}
+	}
+	pollCtx, cancel := context.WithTimeout(ctx, p.pollTimeout)
+	defer cancel()
+	results := p.fetchAll(pollCtx, ids)
+	if ctx.Err() != nil {
+		// All waiters left during the poll
+		return
+	}
+	pollTimedOut := errors.Is(pollCtx.Err(), context.DeadlineExceeded)
+
+	for _, w := range waiters {
+		r := results[w.id]
+		if r.err != nil {
+			var err error
+			if pollTimedOut && errors.Is(r.err, context.DeadlineExceeded) {
+				w.retryTempErrorCounter++
+				if w.retryTempErrorCounter >= p.tempErrRetryLimit {
+					err = fmt.Errorf("fetching the resources has timed out and the retry limit was reached: %w", r.err)
+				}
+			} else {
+				w.retryTempErrorCounter, err = handleTempError(w.retryTempErrorCounter, p.tempErrRetryLimit, RetryHttpErrorStatusCodes, r.err)
+			}
+			if err != nil {
+				p.finish(w, nil, err)
+			}
+			continue
+		}
+
+		done, failed, err := w.ready(r.res)
+		switch {
+		case failed:
+			if err == nil {
+				err = fmt.Errorf("resource failed to become ready")
+			}
+			p.finish(w, r.res, err)
+		case err != nil:
+			w.retryTempErrorCounter, err = handleTempError(w.retryTempErrorCounter, p.tempErrRetryLimit, RetryHttpErrorStatusCodes, err)
+			if err != nil {
+				p.finish(w, nil, err)
+			}
+		case done:
+			p.finish(w, r.res, nil)
+		}
+	}
+}
+
+// fetchAll fetches the resources with ids with the BatchFetch of the poller, or one by one with its BatchGet
+func (p *BatchPoller[T]) fetchAll(ctx context.Context, ids []string) map[string]batchResult[T] {
+	results := map[string]batchResult[T]{}
+
+	p.mu.Lock()
+	unsupported := p.unsupported
+	p.mu.Unlock()
+	if p.fetch != nil && !unsupported {
+		resources, err := p.fetch(ctx, ids)
+		if err == nil || p.get == nil || !isBatchUnsupported(err) {
+			for _, id := range ids {
+				results[id] = batchResult[T]{res: resources[id], err: err}
+			}
+			return results
+		}
+		p.mu.Lock()
+		p.unsupported = true
+		p.mu.Unlock()
+	}
+
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			results[id] = batchResult[T]{err: ctx.Err()}
+			continue
+		}
+		res, err := p.get(ctx, id)
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			res, err = nil, nil
+		}
+		results[id] = batchResult[T]{res: res, err: err}
+	}
+	return results
+}
+
+// finish removes w from the waiters of the poller and returns the result of its wait
+func (p *BatchPoller[T]) finish(w *batchWaiter[T], res *T, err error) {
+	p.remove(w)
+	w.done <- batchResult[T]{res: res, err: err}
+}
+
+// isBatchUnsupported reports whether err means that the API has no endpoint to fetch many resources at once
+func isBatchUnsupported(err error) bool {
+	var oapiErr *oapierror.GenericOpenAPIError
+	if !errors.As(err, &oapiErr) {
+		return false
+	}
+	switch oapiErr.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	}
+	return false
+}
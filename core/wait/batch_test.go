@@ -0,0 +1,295 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+type batchResource struct {
+	id     string
+	status string
+}
+
+// batchAPI returns the resources as ACTIVE from the third time they are fetched
+type batchAPI struct {
+	mu         sync.Mutex
+	fetched    map[string]int
+	fetchCalls int
+	getCalls   int
+	fetchErr   error
+	deleted    map[string]bool
+}
+
+func newBatchAPI() *batchAPI {
+	return &batchAPI{fetched: map[string]int{}, deleted: map[string]bool{}}
+}
+
+func (a *batchAPI) resource(id string) *batchResource {
+	a.fetched[id]++
+	status := "CREATING"
+	if a.fetched[id] >= 3 {
+		status = "ACTIVE"
+	}
+	return &batchResource{id: id, status: status}
+}
+
+func (a *batchAPI) fetch(_ context.Context, ids []string) (map[string]*batchResource, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.fetchCalls++
+	if a.fetchErr != nil {
+		return nil, a.fetchErr
+	}
+	resources := map[string]*batchResource{}
+	for _, id := range ids {
+		if !a.deleted[id] {
+			resources[id] = a.resource(id)
+		}
+	}
+	return resources, nil
+}
+
+func (a *batchAPI) get(_ context.Context, id string) (*batchResource, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.getCalls++
+	if a.deleted[id] {
+		return nil, oapierror.NewError(http.StatusNotFound, "Not Found")
+	}
+	return a.resource(id), nil
+}
+
+func batchResourceActive(r *batchResource) (done, failed bool, err error) {
+	if r == nil {
+		return false, true, fmt.Errorf("resource not found")
+	}
+	return r.status == "ACTIVE", r.status == "ERROR", nil
+}
+
+func batchResourceDeleted(r *batchResource) (done, failed bool, err error) {
+	return r == nil, false, nil
+}
+
+// waitAll waits for the resources with ids concurrently and returns the results by id
+func waitAll(p *BatchPoller[batchResource], ids []string, ready AsyncActionReadyFunc[batchResource]) (map[string]*batchResource, map[string]error) {
+	var mu sync.Mutex
+	resources := map[string]*batchResource{}
+	errs := map[string]error{}
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			res, err := p.Wait(context.Background(), id, ready)
+			mu.Lock()
+			defer mu.Unlock()
+			resources[id] = res
+			errs[id] = err
+		}(id)
+	}
+	wg.Wait()
+	return resources, errs
+}
+
+func TestBatchPoller(t *testing.T) {
+	ids := []string{}
+	for i := 0; i < 50; i++ {
+		ids = append(ids, fmt.Sprintf("id-%d", i))
+	}
+
+	for _, tt := range []struct {
+		desc         string
+		fetch        bool
+		get          bool
+		fetchErr     error
+		wantFetch    bool
+		wantGetCalls bool
+		wantErr      bool
+	}{
+		{desc: "batch", fetch: true, get: true, wantFetch: true},
+		{desc: "batch_unsupported", fetch: true, get: true, fetchErr: oapierror.NewError(http.StatusNotImplemented, "Not Implemented"), wantGetCalls: true},
+		{desc: "batch_unsupported_without_get", fetch: true, fetchErr: oapierror.NewError(http.StatusNotImplemented, "Not Implemented"), wantErr: true},
+		{desc: "get_only", get: true, wantGetCalls: true},
+		{desc: "fetch_error", fetch: true, get: true, fetchErr: oapierror.NewError(http.StatusBadRequest, "Bad Request"), wantErr: true},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			api := newBatchAPI()
+			api.fetchErr = tt.fetchErr
+			var fetch BatchFetch[batchResource]
+			var get BatchGet[batchResource]
+			if tt.fetch {
+				fetch = api.fetch
+			}
+			if tt.get {
+				get = api.get
+			}
+			poller := NewBatchPoller(fetch, get).SetThrottle(20 * time.Millisecond)
+
+			resources, errs := waitAll(poller, ids, batchResourceActive)
+
+			for _, id := range ids {
+				if tt.wantErr {
+					if errs[id] == nil {
+						t.Errorf("%s: expected an error", id)
+					}
+					continue
+				}
+				if errs[id] != nil {
+					t.Errorf("%s: unexpected error: %v", id, errs[id])
+					continue
+				}
+				if resources[id] == nil || resources[id].id != id || resources[id].status != "ACTIVE" {
+					t.Errorf("%s: unexpected resource %+v", id, resources[id])
+				}
+			}
+			if tt.wantFetch && api.fetchCalls >= len(ids) {
+				t.Errorf("expected the resources to be fetched together, got %d fetches for %d resources", api.fetchCalls, len(ids))
+			}
+			if tt.wantGetCalls && tt.fetch && api.fetchCalls != 1 {
+				t.Errorf("expected the unsupported batch fetch to be called once, got %d calls", api.fetchCalls)
+			}
+			if tt.wantGetCalls != (api.getCalls > 0) {
+				t.Errorf("unexpected number of get calls: %d", api.getCalls)
+			}
+		})
+	}
+}
+
+func TestBatchPollerDeleted(t *testing.T) {
+	api := newBatchAPI()
+	api.deleted["a"] = true
+	for _, tt := range []struct {
+		desc  string
+		fetch BatchFetch[batchResource]
+	}{
+		{"batch", api.fetch},
+		{"get", nil},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			poller := NewBatchPoller(tt.fetch, api.get).SetThrottle(10 * time.Millisecond)
+
+			res, err := poller.Wait(context.Background(), "a", batchResourceDeleted)
+			if err != nil || res != nil {
+				t.Fatalf("expected the deletion to be done, got %+v, %v", res, err)
+			}
+			if _, err := poller.Wait(context.Background(), "a", batchResourceActive); err == nil {
+				t.Fatalf("expected an error for a missing resource")
+			}
+		})
+	}
+}
+
+func TestBatchPollerFailures(t *testing.T) {
+	api := newBatchAPI()
+	poller := NewBatchPoller(api.fetch, nil).SetThrottle(10 * time.Millisecond)
+
+	res, err := poller.Wait(context.Background(), "a", func(r *batchResource) (done, failed bool, err error) {
+		return false, r.status == "CREATING", nil
+	})
+	if err == nil || res == nil {
+		t.Fatalf("expected the failed resource and an error, got %+v, %v", res, err)
+	}
+
+	poller.SetTimeout(50 * time.Millisecond)
+	if _, err := poller.Wait(context.Background(), "b", func(_ *batchResource) (done, failed bool, err error) {
+		return false, false, nil
+	}); err == nil {
+		t.Fatalf("expected a timeout")
+	}
+
+	if _, err := NewBatchPoller[batchResource](nil, nil).Wait(context.Background(), "a", batchResourceActive); err == nil {
+		t.Fatalf("expected an error for a poller without fetch and get")
+	}
+	if _, err := poller.Wait(context.Background(), "a", nil); err == nil {
+		t.Fatalf("expected an error for a nil ready function")
+	}
+	if _, err := NewBatchPoller(api.fetch, nil).SetThrottle(0).Wait(context.Background(), "a", batchResourceActive); err == nil {
+		t.Fatalf("expected an error for a throttle of 0")
+	}
+}
+
+func TestBatchPollerTempErrors(t *testing.T) {
+	api := newBatchAPI()
+	calls := 0
+	fetch := func(ctx context.Context, ids []string) (map[string]*batchResource, error) {
+		calls++
+		if calls <= 2 {
+			return nil, oapierror.NewError(http.StatusBadGateway, "Bad Gateway")
+		}
+		return api.fetch(ctx, ids)
+	}
+	poller := NewBatchPoller(fetch, nil).SetThrottle(10 * time.Millisecond)
+
+	if _, err := poller.Wait(context.Background(), "a", batchResourceActive); err != nil {
+		t.Fatalf("expected the temporary errors to be retried, got %v", err)
+	}
+
+	calls = 0
+	poller.SetTempErrRetryLimit(2)
+	if _, err := poller.Wait(context.Background(), "b", batchResourceActive); err == nil {
+		t.Fatalf("expected an error once the retry limit is reached")
+	}
+}
+
+func TestBatchPollerHungFetch(t *testing.T) {
+	canceled := make(chan struct{})
+	fetch := func(ctx context.Context, _ []string) (map[string]*batchResource, error) {
+		<-ctx.Done()
+		close(canceled)
+		return nil, ctx.Err()
+	}
+	poller := NewBatchPoller(fetch, nil).SetThrottle(10 * time.Millisecond).SetPollTimeout(time.Hour).SetTimeout(20 * time.Millisecond)
+
+	if _, err := poller.Wait(context.Background(), "a", batchResourceActive); err == nil {
+		t.Fatalf("expected the wait to time out")
+	}
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatalf("expected the fetch to be canceled once no waiter is left")
+	}
+
+	// A later wait isn't affected by the canceled fetch
+	api := newBatchAPI()
+	poller.fetch = api.fetch
+	poller.SetTimeout(time.Second)
+	if _, err := poller.Wait(context.Background(), "b", batchResourceActive); err != nil {
+		t.Fatalf("expected the resource to become ready, got %v", err)
+	}
+}
+
+func TestBatchPollerPollTimeout(t *testing.T) {
+	api := newBatchAPI()
+	var mu sync.Mutex
+	calls := 0
+	fetch := func(ctx context.Context, ids []string) (map[string]*batchResource, error) {
+		mu.Lock()
+		calls++
+		hang := calls == 1
+		mu.Unlock()
+		if hang {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return api.fetch(ctx, ids)
+	}
+	poller := NewBatchPoller(fetch, nil).SetThrottle(10 * time.Millisecond).SetPollTimeout(10 * time.Millisecond)
+
+	if _, err := poller.Wait(context.Background(), "a", batchResourceActive); err != nil {
+		t.Fatalf("expected the timed out poll to be retried, got %v", err)
+	}
+
+	mu.Lock()
+	calls = 0
+	mu.Unlock()
+	poller.SetTempErrRetryLimit(1)
+	if _, err := poller.Wait(context.Background(), "b", batchResourceActive); err == nil {
+		t.Fatalf("expected an error once the retry limit is reached")
+	}
+}
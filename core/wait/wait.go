@@ -2,10 +2,12 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 )
@@ -15,16 +17,39 @@ var RetryHttpErrorStatusCodes = []int{http.StatusBadGateway, http.StatusGatewayT
 // AsyncActionCheck reports whether a specific async action has finished.
 //   - waitFinished == true if the async action is finished, false otherwise.
 //   - response contains data regarding the current state of the resource targeted by the async action, if applicable. If not applicable, T should be struct{}.
+//     If the async action isn't finished, it may be the resource in its intermediate state, see SetProgressFunc.
 //   - err != nil if there was an error checking if the async action finished, or if it finished unsuccessfully.
 type AsyncActionCheck[T any] func() (waitFinished bool, response *T, err error)
 
+// AsyncActionStateFetch fetches the resource targeted by a specific async action and returns it with its current state,
+// e.g. the value of its status field.
+type AsyncActionStateFetch[T any] func() (response *T, state string, err error)
+
+// AsyncActionReadyFunc reports whether the resource fetched by an AsyncActionStateFetch is ready, e.g. if several of its
+// fields or sub-resources reached a state.
+//   - done == true if the resource is ready, false otherwise.
+//   - failed == true if the resource can't become ready anymore. The wait finishes with err, or an error if err is nil.
+//   - err != nil if the readiness couldn't be evaluated, the wait finishes with err unless it is a temporary error.
+type AsyncActionReadyFunc[T any] func(resource *T) (done bool, failed bool, err error)
+
+// AsyncActionProgressFunc is called with the resource targeted by an async action in its current state, while the
+// async action isn't finished, see SetProgressFunc.
+type AsyncActionProgressFunc[T any] func(resource *T)
+
 // AsyncActionHandler handles waiting for a specific async action to be finished.
 type AsyncActionHandler[T any] struct {
 	checkFn                  AsyncActionCheck[T]
+	stateFetchFn             AsyncActionStateFetch[T]
+	readyFn                  AsyncActionReadyFunc[T]
+	progressFn               AsyncActionProgressFunc[T]
+	successStates            []string
+	failureStates            []string
 	sleepBeforeWait          time.Duration
 	throttle                 time.Duration
+	backoff                  clients.Backoff
 	timeout                  time.Duration
 	tempErrRetryLimit        int
+	tempErrStatusCodes       []int
 	IntermediateStateReached bool
 }
 
@@ -45,6 +70,17 @@ func (h *AsyncActionHandler[T]) SetThrottle(d time.Duration) *AsyncActionHandler
 	return h
 }
 
+// SetBackoff sets the delay between the checks of the async action, replacing the fixed interval of SetThrottle,
+// e.g. to check a long-running creation often at first and less often later:
+//
+//	handler.SetBackoff(clients.ExponentialBackoff{BaseDelay: 2 * time.Second, MaxDelay: time.Minute})
+//
+// The delay after the n-th check is backoff.NextDelay(n, nil). If it is not positive, the throttle is used instead.
+func (h *AsyncActionHandler[T]) SetBackoff(backoff clients.Backoff) *AsyncActionHandler[T] {
+	h.backoff = backoff
+	return h
+}
+
 // SetTimeout sets the duration for wait timeout.
 func (h *AsyncActionHandler[T]) SetTimeout(d time.Duration) *AsyncActionHandler[T] {
 	h.timeout = d
@@ -64,24 +100,181 @@ func (h *AsyncActionHandler[T]) SetTempErrRetryLimit(l int) *AsyncActionHandler[
 	return h
 }
 
+// SetTempErrStatusCodes sets the status codes of the temporary errors of the checks, which are retried up to the
+// limit set with SetTempErrRetryLimit, e.g. to also retry 503 Service Unavailable. Defaults to
+// RetryHttpErrorStatusCodes.
+func (h *AsyncActionHandler[T]) SetTempErrStatusCodes(codes []int) *AsyncActionHandler[T] {
+	h.tempErrStatusCodes = codes
+	return h
+}
+
+// SetStateFetch sets the function which fetches the state of the resource, used instead of the check of the handler
+// if terminal states are set with SetTerminalStates. The wait handlers of the services set it where the async action
+// is reflected in a state of the resource.
+func (h *AsyncActionHandler[T]) SetStateFetch(f AsyncActionStateFetch[T]) *AsyncActionHandler[T] {
+	h.stateFetchFn = f
+	return h
+}
+
+// SetTerminalStates replaces the states checked by the handler. The wait finishes successfully, returning the resource,
+// when it reaches one of the success states, and with an error when it reaches one of the failure states.
+// In any other state, including states unknown to the SDK, the wait continues until the timeout.
+//
+// The handler must fetch the state of the resource, see SetStateFetch, otherwise WaitWithContext returns an error.
+// The terminal states are ignored if a ready function is set with SetReadyFunc.
+func (h *AsyncActionHandler[T]) SetTerminalStates(success, failure []string) *AsyncActionHandler[T] {
+	h.successStates = success
+	h.failureStates = failure
+	return h
+}
+
+// SetReadyFunc replaces the check of the handler with f, evaluated for the resource after each fetch, e.g. to wait
+// until a cluster is active and all of its node pools are healthy. The wait finishes successfully, returning the resource,
+// once f reports it as done, and with an error once f reports it as failed.
+//
+// f takes precedence over the terminal states: if both are set, the terminal states are ignored.
+// The handler must fetch the resource, see SetStateFetch, otherwise WaitWithContext returns an error.
+func (h *AsyncActionHandler[T]) SetReadyFunc(f AsyncActionReadyFunc[T]) *AsyncActionHandler[T] {
+	h.readyFn = f
+	return h
+}
+
+// SetProgressFunc sets a function which is called with the resource after each check which doesn't finish the async
+// action, e.g. to show the status of a cluster while it is created instead of blocking silently:
+//
+//	handler.SetProgressFunc(func(cluster *ske.Cluster) {
+//		status := cluster.GetStatus()
+//		fmt.Printf("cluster is %s\n", status.GetAggregated())
+//	})
+//
+// To receive the states on a channel, f can send them to it, e.g. without blocking the wait if they aren't received.
+// f is called by WaitWithContext, the next check is done once it returns.
+//
+// f is called if the check of the handler returns the resource while the async action isn't finished, which the wait
+// handlers of the services do where they support it, or if the handler evaluates terminal states or a ready function.
+func (h *AsyncActionHandler[T]) SetProgressFunc(f AsyncActionProgressFunc[T]) *AsyncActionHandler[T] {
+	h.progressFn = f
+	return h
+}
+
+// reportProgress passes the resource of a check which didn't finish the async action to the progress function
+func (h *AsyncActionHandler[T]) reportProgress(res *T) {
+	if res != nil && h.progressFn != nil {
+		h.progressFn(res)
+	}
+}
+
+// readyCheck returns an AsyncActionCheck for the ready function of the handler
+func (h *AsyncActionHandler[T]) readyCheck() AsyncActionCheck[T] {
+	return func() (waitFinished bool, response *T, err error) {
+		res, _, err := h.stateFetchFn()
+		if err != nil {
+			return false, nil, err
+		}
+		done, failed, err := h.readyFn(res)
+		if failed {
+			if err == nil {
+				err = fmt.Errorf("resource failed to become ready")
+			}
+			return true, res, err
+		}
+		if err != nil {
+			return false, nil, err
+		}
+		if done {
+			return true, res, nil
+		}
+		h.reportProgress(res)
+		return false, nil, nil
+	}
+}
+
+// stateCheck returns an AsyncActionCheck for the terminal states of the handler
+func (h *AsyncActionHandler[T]) stateCheck() AsyncActionCheck[T] {
+	return func() (waitFinished bool, response *T, err error) {
+		res, state, err := h.stateFetchFn()
+		if err != nil {
+			return false, nil, err
+		}
+		if utils.Contains(h.successStates, state) {
+			return true, res, nil
+		}
+		if utils.Contains(h.failureStates, state) {
+			return true, res, fmt.Errorf("reached failure state %s", state)
+		}
+		h.reportProgress(res)
+		return false, nil, nil
+	}
+}
+
+// check returns the check done by WaitWithContext, depending on the ready function and the terminal states
+func (h *AsyncActionHandler[T]) check() (AsyncActionCheck[T], error) {
+	if h.readyFn != nil {
+		if h.stateFetchFn == nil {
+			return nil, fmt.Errorf("a ready function is set, but the handler doesn't fetch the resource")
+		}
+		return h.readyCheck(), nil
+	}
+	if h.successStates != nil || h.failureStates != nil {
+		if h.stateFetchFn == nil {
+			return nil, fmt.Errorf("terminal states are set, but the handler doesn't fetch the state of the resource")
+		}
+		return h.stateCheck(), nil
+	}
+	return h.checkFn, nil
+}
+
+// Check checks the async action once, as WaitWithContext does before each wait, e.g. to poll it without blocking.
+// Temporary errors aren't retried and the sleep before the wait is skipped.
+func (h *AsyncActionHandler[T]) Check() (waitFinished bool, response *T, err error) {
+	checkFn, err := h.check()
+	if err != nil {
+		return false, nil, err
+	}
+	return checkFn()
+}
+
+// Throttle returns the time interval between each check of the async action, see SetThrottle
+func (h *AsyncActionHandler[T]) Throttle() time.Duration {
+	return h.throttle
+}
+
+// Timeout returns the duration for wait timeout, see SetTimeout
+func (h *AsyncActionHandler[T]) Timeout() time.Duration {
+	return h.timeout
+}
+
 // WaitWithContext starts the wait until there's an error or wait is done
 func (h *AsyncActionHandler[T]) WaitWithContext(ctx context.Context) (res *T, err error) {
 	if h.throttle == 0 {
 		return nil, fmt.Errorf("throttle can't be 0")
 	}
+	checkFn, err := h.check()
+	if err != nil {
+		return nil, err
+	}
 
+	// The derived context is done at the sooner of the handler timeout and the deadline of ctx
 	ctx, cancel := context.WithTimeout(ctx, h.timeout)
 	defer cancel()
 
 	// Wait some seconds for the API to process the request
-	time.Sleep(h.sleepBeforeWait)
+	if h.sleepBeforeWait > 0 {
+		timer := time.NewTimer(h.sleepBeforeWait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("WaitWithContext() has timed out")
+		case <-timer.C:
+		}
+	}
 
 	ticker := time.NewTicker(h.throttle)
 	defer ticker.Stop()
 
 	var retryTempErrorCounter = 0
-	for {
-		done, res, err := h.checkFn()
+	for check := 1; ; check++ {
+		done, res, err := checkFn()
 		if err != nil {
 			retryTempErrorCounter, err = h.handleError(retryTempErrorCounter, err)
 			if err != nil {
@@ -91,27 +284,53 @@ func (h *AsyncActionHandler[T]) WaitWithContext(ctx context.Context) (res *T, er
 		if done {
 			return res, nil
 		}
+		h.reportProgress(res)
 
-		select {
-		case <-ctx.Done():
+		if !h.waitNextCheck(ctx, ticker, check) {
 			return res, fmt.Errorf("WaitWithContext() has timed out")
-		case <-ticker.C:
-			continue
 		}
 	}
 }
 
+// waitNextCheck waits for the next check after the given one, see SetBackoff. It returns false if ctx is done before.
+func (h *AsyncActionHandler[T]) waitNextCheck(ctx context.Context, ticker *time.Ticker, check int) bool {
+	next := ticker.C
+	if h.backoff != nil {
+		if delay := h.backoff.NextDelay(check, nil); delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+			next = timer.C
+		}
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-next:
+		return true
+	}
+}
+
 func (h *AsyncActionHandler[T]) handleError(retryTempErrorCounter int, err error) (int, error) {
-	oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+	codes := h.tempErrStatusCodes
+	if codes == nil {
+		codes = RetryHttpErrorStatusCodes
+	}
+	return handleTempError(retryTempErrorCounter, h.tempErrRetryLimit, codes, err)
+}
+
+// handleTempError returns nil for a temporary error, i.e. with one of the status codes, until it was found limit times
+func handleTempError(retryTempErrorCounter, limit int, codes []int, err error) (int, error) {
+	var oapiErr *oapierror.GenericOpenAPIError
+	ok := errors.As(err, &oapiErr)
 	if !ok {
 		return retryTempErrorCounter, fmt.Errorf("found non-GenericOpenApiError: %w", err)
 	}
 	// Some APIs may return temporary errors and the request should be retried
-	if !utils.Contains(RetryHttpErrorStatusCodes, oapiErr.StatusCode) {
+	if !utils.Contains(codes, oapiErr.StatusCode) {
 		return retryTempErrorCounter, err
 	}
 	retryTempErrorCounter++
-	if retryTempErrorCounter == h.tempErrRetryLimit {
+	if retryTempErrorCounter == limit {
 		return retryTempErrorCounter, fmt.Errorf("temporary error was found and the retry limit was reached: %w", err)
 	}
 	return retryTempErrorCounter, nil
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
@@ -270,7 +271,7 @@ func TestWaitWithContext(t *testing.T) {
 			handlerTimeout:                 100 * time.Millisecond,
 			handlerTempErrRetryLimit:       0,
 			contextTimeout:                 1000 * time.Millisecond,
-			wantCheckFnNumberCalls:         1,
+			wantCheckFnNumberCalls:         0,
 			wantErr:                        true,
 		},
 		{
@@ -283,7 +284,7 @@ func TestWaitWithContext(t *testing.T) {
 			handlerTimeout:                 1000 * time.Millisecond,
 			handlerTempErrRetryLimit:       0,
 			contextTimeout:                 100 * time.Millisecond,
-			wantCheckFnNumberCalls:         1,
+			wantCheckFnNumberCalls:         0,
 			wantErr:                        true,
 		},
 		{
@@ -382,6 +383,41 @@ func TestWaitWithContext(t *testing.T) {
 	}
 }
 
+func TestWaitWithContextDeadline(t *testing.T) {
+	for _, tt := range []struct {
+		desc            string
+		sleepBeforeWait time.Duration
+	}{
+		{
+			desc:            "default_timeout",
+			sleepBeforeWait: 0,
+		},
+		{
+			desc:            "long_sleep_before_wait",
+			sleepBeforeWait: time.Hour,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// The default timeout of the handler is much longer than the deadline of the context
+			handler := New(func() (waitFinished bool, response *struct{}, err error) {
+				return false, nil, nil
+			}).SetThrottle(10 * time.Millisecond).SetSleepBeforeWait(tt.sleepBeforeWait)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			_, err := handler.WaitWithContext(ctx)
+			if err == nil {
+				t.Fatalf("expected error but got none")
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Fatalf("expected wait to end at the context deadline, took %v", elapsed)
+			}
+		})
+	}
+}
+
 func TestHandleError(t *testing.T) {
 	for _, tt := range []struct {
 		desc              string
@@ -440,3 +476,353 @@ func TestHandleError(t *testing.T) {
 		})
 	}
 }
+
+func TestSetTerminalStates(t *testing.T) {
+	for _, tt := range []struct {
+		desc          string
+		states        []string
+		setStateFetch bool
+		wantState     string
+		wantErr       bool
+	}{
+		{
+			desc:          "first_success_state",
+			states:        []string{"CREATING", "ACTIVE"},
+			setStateFetch: true,
+			wantState:     "ACTIVE",
+		},
+		{
+			desc:          "second_success_state",
+			states:        []string{"CREATING", "DEGRADED"},
+			setStateFetch: true,
+			wantState:     "DEGRADED",
+		},
+		{
+			desc:          "unknown_state_keeps_polling",
+			states:        []string{"CREATING", "MIGRATING", "ACTIVE"},
+			setStateFetch: true,
+			wantState:     "ACTIVE",
+		},
+		{
+			desc:          "failure_state",
+			states:        []string{"CREATING", "FAILED"},
+			setStateFetch: true,
+			wantState:     "FAILED",
+			wantErr:       true,
+		},
+		{
+			desc:          "never_terminal",
+			states:        []string{"CREATING"},
+			setStateFetch: true,
+			wantErr:       true,
+		},
+		{
+			desc:    "no_state_fetch",
+			states:  []string{"ACTIVE"},
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			type resource struct{ state string }
+			calls := 0
+			handler := New(func() (waitFinished bool, res *resource, err error) {
+				return true, nil, fmt.Errorf("the check of the handler must not be used")
+			})
+			if tt.setStateFetch {
+				handler.SetStateFetch(func() (*resource, string, error) {
+					state := tt.states[calls]
+					if calls < len(tt.states)-1 {
+						calls++
+					}
+					return &resource{state: state}, state, nil
+				})
+			}
+			handler.SetTerminalStates([]string{"ACTIVE", "DEGRADED"}, []string{"FAILED"}).SetThrottle(time.Millisecond).SetTimeout(50 * time.Millisecond)
+
+			got, err := handler.WaitWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %t, got %v", tt.wantErr, err)
+			}
+			if tt.wantState != "" && (got == nil || got.state != tt.wantState) {
+				t.Fatalf("expected resource in state %q, got %+v", tt.wantState, got)
+			}
+		})
+	}
+}
+
+func TestSetReadyFunc(t *testing.T) {
+	type resource struct {
+		status    string
+		nodePools []string
+	}
+	for _, tt := range []struct {
+		desc           string
+		resources      []resource
+		setStateFetch  bool
+		terminalStates bool
+		readyErr       error
+		wantStatus     string
+		wantErr        bool
+	}{
+		{
+			desc: "all_ready",
+			resources: []resource{
+				{status: "CREATING", nodePools: []string{"CREATING"}},
+				{status: "ACTIVE", nodePools: []string{"HEALTHY", "CREATING"}},
+				{status: "ACTIVE", nodePools: []string{"HEALTHY", "HEALTHY"}},
+			},
+			setStateFetch: true,
+			wantStatus:    "ACTIVE",
+		},
+		{
+			desc: "failed",
+			resources: []resource{
+				{status: "CREATING", nodePools: []string{"CREATING"}},
+				{status: "ACTIVE", nodePools: []string{"ERROR"}},
+			},
+			setStateFetch: true,
+			wantStatus:    "ACTIVE",
+			wantErr:       true,
+		},
+		{
+			desc: "precedence_over_terminal_states",
+			resources: []resource{
+				{status: "ACTIVE", nodePools: []string{"CREATING"}},
+				{status: "ACTIVE", nodePools: []string{"HEALTHY"}},
+			},
+			setStateFetch:  true,
+			terminalStates: true,
+			wantStatus:     "ACTIVE",
+		},
+		{
+			desc:          "ready_func_error",
+			resources:     []resource{{status: "ACTIVE"}},
+			setStateFetch: true,
+			readyErr:      fmt.Errorf("unexpected resource"),
+			wantErr:       true,
+		},
+		{
+			desc: "never_ready",
+			resources: []resource{
+				{status: "ACTIVE", nodePools: []string{"CREATING"}},
+			},
+			setStateFetch: true,
+			wantErr:       true,
+		},
+		{
+			desc:      "no_state_fetch",
+			resources: []resource{{status: "ACTIVE"}},
+			wantErr:   true,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			handler := New(func() (waitFinished bool, res *resource, err error) {
+				return true, nil, fmt.Errorf("the check of the handler must not be used")
+			})
+			if tt.setStateFetch {
+				handler.SetStateFetch(func() (*resource, string, error) {
+					r := tt.resources[calls]
+					if calls < len(tt.resources)-1 {
+						calls++
+					}
+					return &r, r.status, nil
+				})
+			}
+			if tt.terminalStates {
+				handler.SetTerminalStates([]string{"ACTIVE"}, nil)
+			}
+			handler.SetReadyFunc(func(r *resource) (done, failed bool, err error) {
+				if tt.readyErr != nil {
+					return false, false, tt.readyErr
+				}
+				if r.status != "ACTIVE" {
+					return false, false, nil
+				}
+				for _, state := range r.nodePools {
+					if state == "ERROR" {
+						return false, true, fmt.Errorf("node pool failed")
+					}
+					if state != "HEALTHY" {
+						return false, false, nil
+					}
+				}
+				return true, false, nil
+			}).SetThrottle(time.Millisecond).SetTimeout(50 * time.Millisecond)
+
+			got, err := handler.WaitWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %t, got %v", tt.wantErr, err)
+			}
+			if tt.wantStatus != "" && (got == nil || got.status != tt.wantStatus) {
+				t.Fatalf("expected resource with status %q, got %+v", tt.wantStatus, got)
+			}
+			if !tt.wantErr && calls != len(tt.resources)-1 {
+				t.Fatalf("expected the wait to finish once all node pools are healthy, got %d fetches", calls+1)
+			}
+		})
+	}
+}
+
+func TestSetBackoff(t *testing.T) {
+	var checks []time.Time
+	handler := New(func() (waitFinished bool, res *interface{}, err error) {
+		checks = append(checks, time.Now())
+		return len(checks) == 4, nil, nil
+	})
+	handler.SetThrottle(time.Hour)
+	handler.SetBackoff(clients.BackoffFunc(func(attempt int, _ *http.Response) time.Duration {
+		if attempt == 2 {
+			// Not positive, the throttle is used
+			return 0
+		}
+		return time.Duration(attempt) * 10 * time.Millisecond
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if _, err := handler.WaitWithContext(ctx); err == nil {
+		t.Fatalf("expected the wait to time out while using the throttle")
+	}
+	if len(checks) != 2 {
+		t.Fatalf("expected 2 checks before the throttle, got %d", len(checks))
+	}
+
+	checks = nil
+	handler.SetBackoff(clients.ExponentialBackoff{BaseDelay: 10 * time.Millisecond, MaxDelay: 40 * time.Millisecond})
+	if _, err := handler.WaitWithContext(context.Background()); err != nil {
+		t.Fatalf("WaitWithContext() error = %v", err)
+	}
+	if len(checks) != 4 {
+		t.Fatalf("expected 4 checks, got %d", len(checks))
+	}
+	// The delays are 10ms, 20ms and 40ms
+	if elapsed := checks[3].Sub(checks[0]); elapsed < 70*time.Millisecond {
+		t.Fatalf("expected the checks to back off, took %v", elapsed)
+	}
+	if growing := checks[3].Sub(checks[2]) > checks[1].Sub(checks[0]); !growing {
+		t.Fatalf("expected the delays to grow, got %v", checks)
+	}
+}
+
+func TestSetTempErrStatusCodes(t *testing.T) {
+	calls := 0
+	handler := New(func() (waitFinished bool, res *interface{}, err error) {
+		calls++
+		if calls == 1 {
+			return false, nil, &oapierror.GenericOpenAPIError{StatusCode: http.StatusServiceUnavailable}
+		}
+		return true, nil, nil
+	})
+	handler.SetThrottle(time.Millisecond)
+	if _, err := handler.WaitWithContext(context.Background()); err == nil {
+		t.Fatalf("expected 503 not to be retried by default")
+	}
+
+	calls = 0
+	handler.SetTempErrStatusCodes([]int{http.StatusServiceUnavailable})
+	if _, err := handler.WaitWithContext(context.Background()); err != nil {
+		t.Fatalf("expected 503 to be retried, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 checks, got %d", calls)
+	}
+}
+
+func TestSetProgressFunc(t *testing.T) {
+	type resource struct {
+		state string
+	}
+	states := []string{"creating", "creating", "configuring", "active"}
+	for _, tt := range []struct {
+		desc           string
+		terminalStates bool
+	}{
+		{"check", false},
+		{"terminal_states", true},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			checks := 0
+			fetch := func() (*resource, string, error) {
+				r := &resource{state: states[checks]}
+				checks++
+				return r, r.state, nil
+			}
+			handler := New(func() (waitFinished bool, res *resource, err error) {
+				r, state, _ := fetch()
+				return state == "active", r, nil
+			})
+			handler.SetThrottle(time.Millisecond)
+			if tt.terminalStates {
+				handler.SetStateFetch(fetch).SetTerminalStates([]string{"active"}, []string{"failed"})
+			}
+			var progress []string
+			handler.SetProgressFunc(func(r *resource) {
+				progress = append(progress, r.state)
+			})
+
+			res, err := handler.WaitWithContext(context.Background())
+			if err != nil {
+				t.Fatalf("WaitWithContext() error = %v", err)
+			}
+			if res.state != "active" {
+				t.Fatalf("expected the active resource, got %q", res.state)
+			}
+			if diff := cmp.Diff([]string{"creating", "creating", "configuring"}, progress); diff != "" {
+				t.Fatalf("unexpected progress (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetProgressFuncTimeout(t *testing.T) {
+	reported := 0
+	handler := New(func() (waitFinished bool, res *struct{}, err error) {
+		return false, &struct{}{}, nil
+	}).SetThrottle(time.Millisecond).SetTimeout(20 * time.Millisecond)
+	handler.SetProgressFunc(func(*struct{}) { reported++ })
+
+	res, err := handler.WaitWithContext(context.Background())
+	if err == nil {
+		t.Fatalf("expected the wait to time out")
+	}
+	// The resource of the last check is returned on timeout
+	if res == nil {
+		t.Fatalf("expected the resource of the last check")
+	}
+	if reported == 0 {
+		t.Fatalf("expected the progress to be reported")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	type resource struct{ state string }
+	states := []string{"CREATING", "ACTIVE"}
+	calls := 0
+	handler := New(func() (waitFinished bool, res *resource, err error) {
+		return true, nil, fmt.Errorf("the check of the handler must not be used")
+	}).SetStateFetch(func() (*resource, string, error) {
+		state := states[calls]
+		calls++
+		return &resource{state: state}, state, nil
+	}).SetTerminalStates([]string{"ACTIVE"}, []string{"FAILED"})
+
+	for i, wantDone := range []bool{false, true} {
+		done, res, err := handler.Check()
+		if err != nil {
+			t.Fatalf("Check %d failed: %v", i, err)
+		}
+		if done != wantDone {
+			t.Errorf("Check %d: expected done %t, got %t", i, wantDone, done)
+		}
+		if done && (res == nil || res.state != "ACTIVE") {
+			t.Errorf("Check %d: unexpected resource %+v", i, res)
+		}
+	}
+
+	if _, _, err := New(func() (bool, *resource, error) { return true, nil, nil }).SetReadyFunc(func(*resource) (bool, bool, error) {
+		return true, false, nil
+	}).Check(); err == nil {
+		t.Errorf("expected an error for a ready function without state fetch")
+	}
+}
@@ -3,7 +3,7 @@ module github.com/stackitcloud/stackit-sdk-go/examples/authentication
 go 1.21
 
 require (
-	github.com/stackitcloud/stackit-sdk-go/core v0.20.0
+	github.com/stackitcloud/stackit-sdk-go/core v0.21.0
 	github.com/stackitcloud/stackit-sdk-go/services/dns v0.17.2
 )
 
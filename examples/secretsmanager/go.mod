@@ -3,7 +3,7 @@ module github.com/stackitcloud/stackit-sdk-go/examples/secretsmanager
 go 1.21
 
 require (
-	github.com/stackitcloud/stackit-sdk-go/core v0.20.0
+	github.com/stackitcloud/stackit-sdk-go/core v0.21.0
 	github.com/stackitcloud/stackit-sdk-go/services/secretsmanager v0.13.2
 )
 
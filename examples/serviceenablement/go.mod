@@ -7,5 +7,5 @@ require github.com/stackitcloud/stackit-sdk-go/services/serviceenablement v1.2.3
 require (
 	github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
-	github.com/stackitcloud/stackit-sdk-go/core v0.20.0 // indirect
+	github.com/stackitcloud/stackit-sdk-go/core v0.21.0 // indirect
 )
@@ -2,7 +2,7 @@ module github.com/stackitcloud/stackit-sdk-go/examples/sqlserverflex
 
 go 1.21
 
-require github.com/stackitcloud/stackit-sdk-go/core v0.20.0
+require github.com/stackitcloud/stackit-sdk-go/core v0.21.0
 
 require (
 	github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
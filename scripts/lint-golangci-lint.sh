@@ -49,6 +49,14 @@ else
         echo ">> Linting core"
         cd ${CORE_PATH}
         golangci-lint run ${GOLANG_CI_ARGS}
+
+        echo ">> Linting core/otel"
+        cd ${CORE_PATH}/otel
+        golangci-lint run ${GOLANG_CI_ARGS}
+
+        echo ">> Linting core/prometheus"
+        cd ${CORE_PATH}/prometheus
+        golangci-lint run ${GOLANG_CI_ARGS}
     fi
 
     for service_dir in ${SERVICES_PATH}/*; do
@@ -13,6 +13,12 @@ go work sync
 cd ${CORE_PATH}
 go mod tidy
 
+cd ${CORE_PATH}/otel
+go mod tidy
+
+cd ${CORE_PATH}/prometheus
+go mod tidy
+
 for service_dir in ${SERVICES_PATH}/*; do
     cd ${service_dir}
     go mod tidy
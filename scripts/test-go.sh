@@ -44,6 +44,12 @@ else
     if [ "${SKIP_NON_GENERATED_FILES}" = false ]; then
         echo ">> Testing core"
         go test ${CORE_PATH}/... ${GOTEST_ARGS}
+
+        echo ">> Testing core/otel"
+        go test ${CORE_PATH}/otel/... ${GOTEST_ARGS}
+
+        echo ">> Testing core/prometheus"
+        go test ${CORE_PATH}/prometheus/... ${GOTEST_ARGS}
     fi
 
     for service_dir in ${SERVICES_PATH}/*; do
@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateCredentials Create credentials for observability of the application load balancer
@@ -310,39 +313,76 @@ type DefaultApi interface {
 	UpdateTargetPoolExecute(ctx context.Context, projectId string, region string, name string, targetPoolName string) (*TargetPool, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateCredentialsRequest interface {
 	CreateCredentialsPayload(createCredentialsPayload CreateCredentialsPayload) ApiCreateCredentialsRequest
 	XRequestID(xRequestID string) ApiCreateCredentialsRequest
+	RetryOnConflict(maxAttempts int) ApiCreateCredentialsRequest
+	SetQueryParam(key, value string) ApiCreateCredentialsRequest
+	AddQueryParam(key, value string) ApiCreateCredentialsRequest
+	Clone() ApiCreateCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateCredentialsResponse, error)
 }
 
 type ApiCreateLoadBalancerRequest interface {
 	CreateLoadBalancerPayload(createLoadBalancerPayload CreateLoadBalancerPayload) ApiCreateLoadBalancerRequest
 	XRequestID(xRequestID string) ApiCreateLoadBalancerRequest
+	RetryOnConflict(maxAttempts int) ApiCreateLoadBalancerRequest
+	SetQueryParam(key, value string) ApiCreateLoadBalancerRequest
+	AddQueryParam(key, value string) ApiCreateLoadBalancerRequest
+	Clone() ApiCreateLoadBalancerRequest
+	Fingerprint() (string, error)
 	Execute() (*LoadBalancer, error)
 }
 
 type ApiDeleteCredentialsRequest interface {
+	SetQueryParam(key, value string) ApiDeleteCredentialsRequest
+	AddQueryParam(key, value string) ApiDeleteCredentialsRequest
+	Clone() ApiDeleteCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (map[string]interface{}, error)
 }
 
 type ApiDeleteLoadBalancerRequest interface {
+	SetQueryParam(key, value string) ApiDeleteLoadBalancerRequest
+	AddQueryParam(key, value string) ApiDeleteLoadBalancerRequest
+	Clone() ApiDeleteLoadBalancerRequest
+	Fingerprint() (string, error)
 	Execute() (map[string]interface{}, error)
 }
 
 type ApiGetCredentialsRequest interface {
+	SetQueryParam(key, value string) ApiGetCredentialsRequest
+	AddQueryParam(key, value string) ApiGetCredentialsRequest
+	Clone() ApiGetCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*GetCredentialsResponse, error)
 }
 
 type ApiGetLoadBalancerRequest interface {
+	SetQueryParam(key, value string) ApiGetLoadBalancerRequest
+	AddQueryParam(key, value string) ApiGetLoadBalancerRequest
+	Clone() ApiGetLoadBalancerRequest
+	Fingerprint() (string, error)
 	Execute() (*LoadBalancer, error)
 }
 
 type ApiGetQuotaRequest interface {
+	SetQueryParam(key, value string) ApiGetQuotaRequest
+	AddQueryParam(key, value string) ApiGetQuotaRequest
+	Clone() ApiGetQuotaRequest
+	Fingerprint() (string, error)
 	Execute() (*GetQuotaResponse, error)
 }
 
 type ApiListCredentialsRequest interface {
+	SetQueryParam(key, value string) ApiListCredentialsRequest
+	AddQueryParam(key, value string) ApiListCredentialsRequest
+	Clone() ApiListCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListCredentialsResponse, error)
 }
 
@@ -351,25 +391,45 @@ type ApiListLoadBalancersRequest interface {
 	PageSize(pageSize string) ApiListLoadBalancersRequest
 	// page_id is a page identifier returned by the previous response and is used to request the next page
 	PageId(pageId string) ApiListLoadBalancersRequest
+	SetQueryParam(key, value string) ApiListLoadBalancersRequest
+	AddQueryParam(key, value string) ApiListLoadBalancersRequest
+	Clone() ApiListLoadBalancersRequest
+	Fingerprint() (string, error)
 	Execute() (*ListLoadBalancersResponse, error)
 }
 
 type ApiListPlansRequest interface {
+	SetQueryParam(key, value string) ApiListPlansRequest
+	AddQueryParam(key, value string) ApiListPlansRequest
+	Clone() ApiListPlansRequest
+	Fingerprint() (string, error)
 	Execute() (*ListPlansResponse, error)
 }
 
 type ApiUpdateCredentialsRequest interface {
 	UpdateCredentialsPayload(updateCredentialsPayload UpdateCredentialsPayload) ApiUpdateCredentialsRequest
+	SetQueryParam(key, value string) ApiUpdateCredentialsRequest
+	AddQueryParam(key, value string) ApiUpdateCredentialsRequest
+	Clone() ApiUpdateCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*UpdateCredentialsResponse, error)
 }
 
 type ApiUpdateLoadBalancerRequest interface {
 	UpdateLoadBalancerPayload(updateLoadBalancerPayload UpdateLoadBalancerPayload) ApiUpdateLoadBalancerRequest
+	SetQueryParam(key, value string) ApiUpdateLoadBalancerRequest
+	AddQueryParam(key, value string) ApiUpdateLoadBalancerRequest
+	Clone() ApiUpdateLoadBalancerRequest
+	Fingerprint() (string, error)
 	Execute() (*LoadBalancer, error)
 }
 
 type ApiUpdateTargetPoolRequest interface {
 	UpdateTargetPoolPayload(updateTargetPoolPayload UpdateTargetPoolPayload) ApiUpdateTargetPoolRequest
+	SetQueryParam(key, value string) ApiUpdateTargetPoolRequest
+	AddQueryParam(key, value string) ApiUpdateTargetPoolRequest
+	Clone() ApiUpdateTargetPoolRequest
+	Fingerprint() (string, error)
 	Execute() (*TargetPool, error)
 }
 
@@ -383,6 +443,8 @@ type CreateCredentialsRequest struct {
 	region                   string
 	createCredentialsPayload *CreateCredentialsPayload
 	xRequestID               *string
+	retryOnConflict          int
+	queryParams              []queryParam
 }
 
 func (r CreateCredentialsRequest) CreateCredentialsPayload(createCredentialsPayload CreateCredentialsPayload) ApiCreateCredentialsRequest {
@@ -395,7 +457,50 @@ func (r CreateCredentialsRequest) XRequestID(xRequestID string) ApiCreateCredent
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateCredentialsRequest) RetryOnConflict(maxAttempts int) ApiCreateCredentialsRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateCredentialsRequest) SetQueryParam(key, value string) ApiCreateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateCredentialsRequest) AddQueryParam(key, value string) ApiCreateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateCredentialsRequest) Clone() ApiCreateCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateCredentialsRequest) execute() (*CreateCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -407,7 +512,7 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.CreateCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.CreateCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -419,8 +524,14 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.createCredentialsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createCredentialsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createCredentialsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -445,7 +556,8 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 	}
 	// body params
 	localVarPostBody = r.createCredentialsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -476,10 +588,14 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -489,7 +605,7 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -499,7 +615,7 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -551,6 +667,8 @@ type CreateLoadBalancerRequest struct {
 	region                    string
 	createLoadBalancerPayload *CreateLoadBalancerPayload
 	xRequestID                *string
+	retryOnConflict           int
+	queryParams               []queryParam
 }
 
 func (r CreateLoadBalancerRequest) CreateLoadBalancerPayload(createLoadBalancerPayload CreateLoadBalancerPayload) ApiCreateLoadBalancerRequest {
@@ -563,7 +681,50 @@ func (r CreateLoadBalancerRequest) XRequestID(xRequestID string) ApiCreateLoadBa
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateLoadBalancerRequest) RetryOnConflict(maxAttempts int) ApiCreateLoadBalancerRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateLoadBalancerRequest) SetQueryParam(key, value string) ApiCreateLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateLoadBalancerRequest) AddQueryParam(key, value string) ApiCreateLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateLoadBalancerRequest) Clone() ApiCreateLoadBalancerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateLoadBalancerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateLoadBalancer", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateLoadBalancerRequest) execute() (*LoadBalancer, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -575,7 +736,7 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.CreateLoadBalancer")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.CreateLoadBalancer", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -587,8 +748,14 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.createLoadBalancerPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createLoadBalancerPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createLoadBalancerPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -613,7 +780,8 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	}
 	// body params
 	localVarPostBody = r.createLoadBalancerPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateLoadBalancer"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -644,10 +812,14 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -657,7 +829,7 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -667,7 +839,7 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -717,9 +889,46 @@ type DeleteCredentialsRequest struct {
 	projectId      string
 	region         string
 	credentialsRef string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteCredentialsRequest) SetQueryParam(key, value string) ApiDeleteCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteCredentialsRequest) AddQueryParam(key, value string) ApiDeleteCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteCredentialsRequest) Clone() ApiDeleteCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "credentialsRef", Value: ParameterValueToString(r.credentialsRef, "credentialsRef")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteCredentialsRequest) execute() (map[string]interface{}, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -731,7 +940,7 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -762,7 +971,8 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -793,10 +1003,14 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -806,7 +1020,7 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -816,7 +1030,7 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -862,14 +1076,51 @@ func (a *APIClient) DeleteCredentialsExecute(ctx context.Context, projectId stri
 }
 
 type DeleteLoadBalancerRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	name       string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	name        string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteLoadBalancerRequest) SetQueryParam(key, value string) ApiDeleteLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteLoadBalancerRequest) AddQueryParam(key, value string) ApiDeleteLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteLoadBalancerRequest) Clone() ApiDeleteLoadBalancerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteLoadBalancerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteLoadBalancer", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "name", Value: ParameterValueToString(r.name, "name")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteLoadBalancerRequest) execute() (map[string]interface{}, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -881,7 +1132,7 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteLoadBalancer")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteLoadBalancer", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -912,7 +1163,8 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteLoadBalancer"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -943,10 +1195,14 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -956,7 +1212,7 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -966,7 +1222,7 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1017,9 +1273,46 @@ type GetCredentialsRequest struct {
 	projectId      string
 	region         string
 	credentialsRef string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetCredentialsRequest) SetQueryParam(key, value string) ApiGetCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetCredentialsRequest) AddQueryParam(key, value string) ApiGetCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetCredentialsRequest) Clone() ApiGetCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "credentialsRef", Value: ParameterValueToString(r.credentialsRef, "credentialsRef")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetCredentialsRequest) execute() (*GetCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1031,7 +1324,7 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1044,6 +1337,15 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.credentialsRef == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "credentialsRef"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1062,7 +1364,8 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1093,10 +1396,14 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1106,7 +1413,7 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1116,7 +1423,7 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1162,14 +1469,51 @@ func (a *APIClient) GetCredentialsExecute(ctx context.Context, projectId string,
 }
 
 type GetLoadBalancerRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	name       string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	name        string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetLoadBalancerRequest) SetQueryParam(key, value string) ApiGetLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetLoadBalancerRequest) AddQueryParam(key, value string) ApiGetLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetLoadBalancerRequest) Clone() ApiGetLoadBalancerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetLoadBalancerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetLoadBalancer", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "name", Value: ParameterValueToString(r.name, "name")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetLoadBalancerRequest) execute() (*LoadBalancer, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1181,7 +1525,7 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetLoadBalancer")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetLoadBalancer", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1194,6 +1538,15 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.name == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "name"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1212,7 +1565,8 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetLoadBalancer"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1243,10 +1597,14 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1256,7 +1614,7 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1266,7 +1624,7 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1314,13 +1672,50 @@ func (a *APIClient) GetLoadBalancerExecute(ctx context.Context, projectId string
 }
 
 type GetQuotaRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetQuotaRequest) SetQueryParam(key, value string) ApiGetQuotaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetQuotaRequest) AddQueryParam(key, value string) ApiGetQuotaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetQuotaRequest) Clone() ApiGetQuotaRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetQuotaRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetQuota", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetQuotaRequest) execute() (*GetQuotaResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1332,7 +1727,7 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetQuota")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetQuota", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1344,6 +1739,12 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1362,7 +1763,8 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetQuota"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1393,10 +1795,14 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1406,7 +1812,7 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1416,7 +1822,7 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1461,13 +1867,50 @@ func (a *APIClient) GetQuotaExecute(ctx context.Context, projectId string, regio
 }
 
 type ListCredentialsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListCredentialsRequest) SetQueryParam(key, value string) ApiListCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListCredentialsRequest) AddQueryParam(key, value string) ApiListCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListCredentialsRequest) Clone() ApiListCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListCredentialsRequest) execute() (*ListCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1479,7 +1922,7 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1491,6 +1934,12 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1509,7 +1958,8 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1528,6 +1978,10 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1540,10 +1994,14 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1553,7 +2011,7 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1563,7 +2021,7 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1606,12 +2064,13 @@ func (a *APIClient) ListCredentialsExecute(ctx context.Context, projectId string
 }
 
 type ListLoadBalancersRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	pageSize   *string
-	pageId     *string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	pageSize    *string
+	pageId      *string
+	queryParams []queryParam
 }
 
 // page_size specifies how many load balancers should be returned on this page. Must be a positive number &lt;&#x3D; 1000
@@ -1628,7 +2087,43 @@ func (r ListLoadBalancersRequest) PageId(pageId string) ApiListLoadBalancersRequ
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListLoadBalancersRequest) SetQueryParam(key, value string) ApiListLoadBalancersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListLoadBalancersRequest) AddQueryParam(key, value string) ApiListLoadBalancersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListLoadBalancersRequest) Clone() ApiListLoadBalancersRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListLoadBalancersRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListLoadBalancers", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListLoadBalancersRequest) execute() (*ListLoadBalancersResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1640,7 +2135,7 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListLoadBalancers")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListLoadBalancers", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1652,6 +2147,12 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	if r.pageSize != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "pageSize", r.pageSize, "")
@@ -1676,7 +2177,8 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListLoadBalancers"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1695,6 +2197,10 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1707,10 +2213,14 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1720,7 +2230,7 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1730,7 +2240,7 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1775,12 +2285,49 @@ func (a *APIClient) ListLoadBalancersExecute(ctx context.Context, projectId stri
 }
 
 type ListPlansRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListPlansRequest) SetQueryParam(key, value string) ApiListPlansRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListPlansRequest) AddQueryParam(key, value string) ApiListPlansRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListPlansRequest) Clone() ApiListPlansRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListPlansRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListPlans", oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListPlansRequest) execute() (*ListPlansResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1792,7 +2339,7 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListPlans")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListPlans", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1803,6 +2350,9 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1821,7 +2371,8 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListPlans"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1840,6 +2391,10 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1852,10 +2407,14 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1865,7 +2424,7 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1875,7 +2434,7 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1921,6 +2480,7 @@ type UpdateCredentialsRequest struct {
 	region                   string
 	credentialsRef           string
 	updateCredentialsPayload *UpdateCredentialsPayload
+	queryParams              []queryParam
 }
 
 func (r UpdateCredentialsRequest) UpdateCredentialsPayload(updateCredentialsPayload UpdateCredentialsPayload) ApiUpdateCredentialsRequest {
@@ -1928,7 +2488,43 @@ func (r UpdateCredentialsRequest) UpdateCredentialsPayload(updateCredentialsPayl
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateCredentialsRequest) SetQueryParam(key, value string) ApiUpdateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateCredentialsRequest) AddQueryParam(key, value string) ApiUpdateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateCredentialsRequest) Clone() ApiUpdateCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "credentialsRef", Value: ParameterValueToString(r.credentialsRef, "credentialsRef")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateCredentialsRequest) execute() (*UpdateCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -1940,7 +2536,7 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1953,8 +2549,17 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.credentialsRef == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "credentialsRef"}
+	}
 	if r.updateCredentialsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateCredentialsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateCredentialsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1976,7 +2581,8 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 	}
 	// body params
 	localVarPostBody = r.updateCredentialsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2007,10 +2613,14 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2020,7 +2630,7 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2030,7 +2640,7 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2082,6 +2692,7 @@ type UpdateLoadBalancerRequest struct {
 	region                    string
 	name                      string
 	updateLoadBalancerPayload *UpdateLoadBalancerPayload
+	queryParams               []queryParam
 }
 
 func (r UpdateLoadBalancerRequest) UpdateLoadBalancerPayload(updateLoadBalancerPayload UpdateLoadBalancerPayload) ApiUpdateLoadBalancerRequest {
@@ -2089,7 +2700,43 @@ func (r UpdateLoadBalancerRequest) UpdateLoadBalancerPayload(updateLoadBalancerP
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateLoadBalancerRequest) SetQueryParam(key, value string) ApiUpdateLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateLoadBalancerRequest) AddQueryParam(key, value string) ApiUpdateLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateLoadBalancerRequest) Clone() ApiUpdateLoadBalancerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateLoadBalancerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateLoadBalancer", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "name", Value: ParameterValueToString(r.name, "name")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateLoadBalancerRequest) execute() (*LoadBalancer, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -2101,7 +2748,7 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateLoadBalancer")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateLoadBalancer", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2114,8 +2761,17 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.name == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "name"}
+	}
 	if r.updateLoadBalancerPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateLoadBalancerPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateLoadBalancerPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2137,7 +2793,8 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	}
 	// body params
 	localVarPostBody = r.updateLoadBalancerPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateLoadBalancer"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2168,10 +2825,14 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2181,7 +2842,7 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2191,7 +2852,7 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2247,6 +2908,7 @@ type UpdateTargetPoolRequest struct {
 	name                    string
 	targetPoolName          string
 	updateTargetPoolPayload *UpdateTargetPoolPayload
+	queryParams             []queryParam
 }
 
 func (r UpdateTargetPoolRequest) UpdateTargetPoolPayload(updateTargetPoolPayload UpdateTargetPoolPayload) ApiUpdateTargetPoolRequest {
@@ -2254,7 +2916,43 @@ func (r UpdateTargetPoolRequest) UpdateTargetPoolPayload(updateTargetPoolPayload
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateTargetPoolRequest) SetQueryParam(key, value string) ApiUpdateTargetPoolRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateTargetPoolRequest) AddQueryParam(key, value string) ApiUpdateTargetPoolRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateTargetPoolRequest) Clone() ApiUpdateTargetPoolRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateTargetPoolRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateTargetPool", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "name", Value: ParameterValueToString(r.name, "name")}, oapierror.Parameter{Name: "targetPoolName", Value: ParameterValueToString(r.targetPoolName, "targetPoolName")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateTargetPoolRequest) execute() (*TargetPool, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -2266,7 +2964,7 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateTargetPool")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateTargetPool", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2280,8 +2978,20 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.name == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "name"}
+	}
+	if r.targetPoolName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "targetPoolName"}
+	}
 	if r.updateTargetPoolPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateTargetPoolPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateTargetPoolPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2303,7 +3013,8 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 	}
 	// body params
 	localVarPostBody = r.updateTargetPoolPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateTargetPool"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2334,10 +3045,14 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2347,7 +3062,7 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2357,7 +3072,7 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
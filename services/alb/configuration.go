@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/alb",
+		ServiceName:   "alb",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,25 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateCredentials",
+	"CreateLoadBalancer",
+	"DeleteCredentials",
+	"DeleteLoadBalancer",
+	"GetCredentials",
+	"GetLoadBalancer",
+	"GetQuota",
+	"ListCredentials",
+	"ListLoadBalancers",
+	"ListPlans",
+	"UpdateCredentials",
+	"UpdateLoadBalancer",
+	"UpdateTargetPool",
+}
+
+func init() {
+	config.RegisterOperations("alb", operations...)
+}
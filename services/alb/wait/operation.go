@@ -0,0 +1,56 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/alb"
+)
+
+// CreateOrUpdateLoadbalancerOperation returns the async action of CreateOrUpdateLoadbalancerWaitHandler as an
+// lro.Operation, which can be serialized to resume waiting for it in another process with
+// CreateOrUpdateLoadbalancerPollFunc. The name of the operation is
+// "alb/CreateOrUpdateLoadbalancer/{projectId}/{region}/{name}".
+func CreateOrUpdateLoadbalancerOperation(client APIClientLoadbalancerInterface, projectId, region, name string) *lro.Operation[alb.LoadBalancer] {
+	return createOrUpdateLoadbalancerOperationHandler(client).Operation(projectId, region, name)
+}
+
+// CreateOrUpdateLoadbalancerPollFunc returns the lro.PollFunc of the operations returned by
+// CreateOrUpdateLoadbalancerOperation, e.g. to resume one with lro.Resume
+func CreateOrUpdateLoadbalancerPollFunc(client APIClientLoadbalancerInterface) lro.PollFunc[alb.LoadBalancer] {
+	return createOrUpdateLoadbalancerOperationHandler(client).PollFunc()
+}
+
+func createOrUpdateLoadbalancerOperationHandler(client APIClientLoadbalancerInterface) lro.WaitHandler[alb.LoadBalancer] {
+	return lro.WaitHandler[alb.LoadBalancer]{
+		Kind: "alb/CreateOrUpdateLoadbalancer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[alb.LoadBalancer] {
+			return CreateOrUpdateLoadbalancerWaitHandler(ctx, client, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteLoadbalancerOperation returns the async action of DeleteLoadbalancerWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with DeleteLoadbalancerPollFunc. The name of the operation
+// is "alb/DeleteLoadbalancer/{projectId}/{region}/{name}".
+func DeleteLoadbalancerOperation(client APIClientLoadbalancerInterface, projectId, region, name string) *lro.Operation[alb.LoadBalancer] {
+	return deleteLoadbalancerOperationHandler(client).Operation(projectId, region, name)
+}
+
+// DeleteLoadbalancerPollFunc returns the lro.PollFunc of the operations returned by DeleteLoadbalancerOperation, e.g.
+// to resume one with lro.Resume
+func DeleteLoadbalancerPollFunc(client APIClientLoadbalancerInterface) lro.PollFunc[alb.LoadBalancer] {
+	return deleteLoadbalancerOperationHandler(client).PollFunc()
+}
+
+func deleteLoadbalancerOperationHandler(client APIClientLoadbalancerInterface) lro.WaitHandler[alb.LoadBalancer] {
+	return lro.WaitHandler[alb.LoadBalancer]{
+		Kind: "alb/DeleteLoadbalancer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[alb.LoadBalancer] {
+			return DeleteLoadbalancerWaitHandler(ctx, client, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -50,6 +50,13 @@ func CreateOrUpdateLoadbalancerWaitHandler(ctx context.Context, client APIClient
 
 		return false, nil, nil
 	})
+	handler.SetStateFetch(func() (*alb.LoadBalancer, string, error) {
+		s, err := client.GetLoadBalancerExecute(ctx, projectId, region, name)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		return s, string(s.GetStatus()), nil
+	})
 	handler.SetTimeout(10 * time.Minute)
 	return handler
 }
@@ -13,16 +13,118 @@ package archiving
 import (
 	"bytes"
 	"context"
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
+type DefaultApi interface {
+	/*
+		CreateInstance provision a service instance
+		Provision a service instance.
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@return ApiCreateInstanceRequest
+	*/
+	CreateInstance(ctx context.Context, projectId string) ApiCreateInstanceRequest
+	/*
+		CreateInstanceExecute executes the request
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@return InstanceProvision
+
+	*/
+	CreateInstanceExecute(ctx context.Context, projectId string) (*InstanceProvision, error)
+	/*
+		DeleteInstance delete service instance
+		Deprovision a service instance.
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@param instanceId Instance id
+		@return ApiDeleteInstanceRequest
+	*/
+	DeleteInstance(ctx context.Context, projectId string, instanceId string) ApiDeleteInstanceRequest
+	/*
+		DeleteInstanceExecute executes the request
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@param instanceId Instance id
+
+	*/
+	DeleteInstanceExecute(ctx context.Context, projectId string, instanceId string) error
+	/*
+		GetInstance get a service instance
+		get a service instance
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@param instanceId Instance id
+		@return ApiGetInstanceRequest
+	*/
+	GetInstance(ctx context.Context, projectId string, instanceId string) ApiGetInstanceRequest
+	/*
+		GetInstanceExecute executes the request
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@param instanceId Instance id
+		@return Instance
+
+	*/
+	GetInstanceExecute(ctx context.Context, projectId string, instanceId string) (*Instance, error)
+	/*
+		ListInstances get service instances list
+		Get a list of available instances
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@return ApiListInstancesRequest
+	*/
+	ListInstances(ctx context.Context, projectId string) ApiListInstancesRequest
+	/*
+		ListInstancesExecute executes the request
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@return ListInstancesResponse
+
+	*/
+	ListInstancesExecute(ctx context.Context, projectId string) (*ListInstancesResponse, error)
+	/*
+		PartialUpdateInstance update a service instance
+		Update a service instance. This could be a repository update.
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@param instanceId Instance id
+		@return ApiPartialUpdateInstanceRequest
+	*/
+	PartialUpdateInstance(ctx context.Context, projectId string, instanceId string) ApiPartialUpdateInstanceRequest
+	/*
+		PartialUpdateInstanceExecute executes the request
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param projectId Project id on which user has permissions
+		@param instanceId Instance id
+
+	*/
+	PartialUpdateInstanceExecute(ctx context.Context, projectId string, instanceId string) error
+}
+
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 // DefaultApiService DefaultApi service
 type DefaultApiService service
 
@@ -31,6 +133,8 @@ type ApiCreateInstanceRequest struct {
 	apiService            *DefaultApiService
 	projectId             string
 	createInstancePayload *CreateInstancePayload
+	retryOnConflict       int
+	queryParams           []queryParam
 }
 
 // Parameters for the requested service instance provision
@@ -40,7 +144,50 @@ func (r ApiCreateInstanceRequest) CreateInstancePayload(createInstancePayload Cr
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r ApiCreateInstanceRequest) RetryOnConflict(maxAttempts int) ApiCreateInstanceRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ApiCreateInstanceRequest) SetQueryParam(key, value string) ApiCreateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ApiCreateInstanceRequest) AddQueryParam(key, value string) ApiCreateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ApiCreateInstanceRequest) Clone() ApiCreateInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ApiCreateInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ApiCreateInstanceRequest) Execute() (*InstanceProvision, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ApiCreateInstanceRequest) execute() (*InstanceProvision, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -59,8 +206,11 @@ func (r ApiCreateInstanceRequest) Execute() (*InstanceProvision, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createInstancePayload == nil {
-		return localVarReturnValue, fmt.Errorf("createInstancePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -82,7 +232,8 @@ func (r ApiCreateInstanceRequest) Execute() (*InstanceProvision, error) {
 	}
 	// body params
 	localVarPostBody = r.createInstancePayload
-	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := a.client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), a.client.cfg.ServiceName, "CreateInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -113,10 +264,14 @@ func (r ApiCreateInstanceRequest) Execute() (*InstanceProvision, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -127,7 +282,7 @@ func (r ApiCreateInstanceRequest) Execute() (*InstanceProvision, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -138,7 +293,7 @@ func (r ApiCreateInstanceRequest) Execute() (*InstanceProvision, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = a.client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -178,13 +333,50 @@ func (a *APIClient) CreateInstanceExecute(ctx context.Context, projectId string)
 }
 
 type ApiDeleteInstanceRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ApiDeleteInstanceRequest) SetQueryParam(key, value string) ApiDeleteInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ApiDeleteInstanceRequest) AddQueryParam(key, value string) ApiDeleteInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ApiDeleteInstanceRequest) Clone() ApiDeleteInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ApiDeleteInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ApiDeleteInstanceRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")})
+	}
+	return err
+}
+
+func (r ApiDeleteInstanceRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -203,6 +395,12 @@ func (r ApiDeleteInstanceRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return &oapierror.ValidationError{Field: "instanceId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -221,7 +419,8 @@ func (r ApiDeleteInstanceRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := a.client.prepareRequest(config.WithOperation(r.ctx, a.client.cfg.ServiceName, "DeleteInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -252,10 +451,14 @@ func (r ApiDeleteInstanceRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -266,7 +469,7 @@ func (r ApiDeleteInstanceRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -277,7 +480,7 @@ func (r ApiDeleteInstanceRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -321,13 +524,50 @@ func (a *APIClient) DeleteInstanceExecute(ctx context.Context, projectId string,
 }
 
 type ApiGetInstanceRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ApiGetInstanceRequest) SetQueryParam(key, value string) ApiGetInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ApiGetInstanceRequest) AddQueryParam(key, value string) ApiGetInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ApiGetInstanceRequest) Clone() ApiGetInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ApiGetInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ApiGetInstanceRequest) Execute() (*Instance, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ApiGetInstanceRequest) execute() (*Instance, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -347,6 +587,12 @@ func (r ApiGetInstanceRequest) Execute() (*Instance, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -365,7 +611,8 @@ func (r ApiGetInstanceRequest) Execute() (*Instance, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := a.client.prepareRequest(config.WithOperation(r.ctx, a.client.cfg.ServiceName, "GetInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -396,10 +643,14 @@ func (r ApiGetInstanceRequest) Execute() (*Instance, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -410,7 +661,7 @@ func (r ApiGetInstanceRequest) Execute() (*Instance, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -421,7 +672,7 @@ func (r ApiGetInstanceRequest) Execute() (*Instance, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -432,7 +683,7 @@ func (r ApiGetInstanceRequest) Execute() (*Instance, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = a.client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -475,12 +726,49 @@ func (a *APIClient) GetInstanceExecute(ctx context.Context, projectId string, in
 }
 
 type ApiListInstancesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ApiListInstancesRequest) SetQueryParam(key, value string) ApiListInstancesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ApiListInstancesRequest) AddQueryParam(key, value string) ApiListInstancesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ApiListInstancesRequest) Clone() ApiListInstancesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ApiListInstancesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ApiListInstancesRequest) Execute() (*ListInstancesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListInstances", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ApiListInstancesRequest) execute() (*ListInstancesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -499,6 +787,9 @@ func (r ApiListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -517,7 +808,8 @@ func (r ApiListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := a.client.prepareRequest(config.WithOperation(r.ctx, a.client.cfg.ServiceName, "ListInstances"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -536,6 +828,10 @@ func (r ApiListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := a.client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -548,10 +844,14 @@ func (r ApiListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -562,7 +862,7 @@ func (r ApiListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -573,7 +873,7 @@ func (r ApiListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -584,7 +884,7 @@ func (r ApiListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = a.client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -629,6 +929,7 @@ type ApiPartialUpdateInstanceRequest struct {
 	projectId                    string
 	instanceId                   string
 	partialUpdateInstancePayload *PartialUpdateInstancePayload
+	queryParams                  []queryParam
 }
 
 // Parameters for the requested update operation on service instance.
@@ -638,7 +939,43 @@ func (r ApiPartialUpdateInstanceRequest) PartialUpdateInstancePayload(partialUpd
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ApiPartialUpdateInstanceRequest) SetQueryParam(key, value string) ApiPartialUpdateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ApiPartialUpdateInstanceRequest) AddQueryParam(key, value string) ApiPartialUpdateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ApiPartialUpdateInstanceRequest) Clone() ApiPartialUpdateInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ApiPartialUpdateInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ApiPartialUpdateInstanceRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")})
+	}
+	return err
+}
+
+func (r ApiPartialUpdateInstanceRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPatch
 		localVarPostBody   interface{}
@@ -657,8 +994,14 @@ func (r ApiPartialUpdateInstanceRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return &oapierror.ValidationError{Field: "instanceId"}
+	}
 	if r.partialUpdateInstancePayload == nil {
-		return fmt.Errorf("partialUpdateInstancePayload is required and must be specified")
+		return &oapierror.ValidationError{Field: "partialUpdateInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -680,7 +1023,8 @@ func (r ApiPartialUpdateInstanceRequest) Execute() error {
 	}
 	// body params
 	localVarPostBody = r.partialUpdateInstancePayload
-	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := a.client.prepareRequest(config.WithOperation(r.ctx, a.client.cfg.ServiceName, "PartialUpdateInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -711,10 +1055,14 @@ func (r ApiPartialUpdateInstanceRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -725,7 +1073,7 @@ func (r ApiPartialUpdateInstanceRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -736,7 +1084,7 @@ func (r ApiPartialUpdateInstanceRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
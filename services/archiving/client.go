@@ -32,7 +32,10 @@ import (
 	"unicode/utf8"
 
 	"github.com/stackitcloud/stackit-sdk-go/core/auth"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/health"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
 var (
@@ -80,12 +83,10 @@ func NewAPIClient(opts ...config.ConfigurationOption) (*APIClient, error) {
 		return nil, fmt.Errorf("setting up authentication: %w", err)
 	}
 
-	roundTripper := authRoundTripper
-	if cfg.Middleware != nil {
-		roundTripper = config.ChainMiddleware(roundTripper, cfg.Middleware...)
-	}
-
-	cfg.HTTPClient.Transport = roundTripper
+	// The HTTP client is copied, so that an HTTP client provided with config.WithHTTPClient can be shared between API clients
+	httpClient := *cfg.HTTPClient
+	httpClient.Transport = config.AssembleTransport(cfg, authRoundTripper)
+	cfg.HTTPClient = &httpClient
 
 	c := &APIClient{}
 	c.cfg = cfg
@@ -258,8 +259,20 @@ func parameterToJson(obj interface{}) (string, error) {
 	return string(jsonBuf), err
 }
 
+// wrapError wraps err in an oapierror.OperationError with the operation and its path parameters, if enabled with config.WithErrorContext
+func (a *DefaultApiService) wrapError(err error, operation string, parameters ...oapierror.Parameter) error {
+	if !a.client.cfg.ErrorContext {
+		return err
+	}
+	return &oapierror.OperationError{Service: a.client.cfg.ServiceName, Operation: operation, Parameters: parameters, Err: err}
+}
+
 // callAPI do the request.
 func (c *APIClient) callAPI(request *http.Request) (*http.Response, error) {
+	// Requests with a context from config.WithFingerprintOnly are not sent
+	if err := config.FingerprintOnly(request); err != nil {
+		return nil, err
+	}
 	if c.cfg.Debug {
 		dump, err := httputil.DumpRequestOut(request, true)
 		if err != nil {
@@ -289,6 +302,33 @@ func (c *APIClient) GetConfig() *config.Configuration {
 	return c.cfg
 }
 
+// Healthz checks whether the API is reachable and the configured credentials are accepted.
+// An authenticated request is sent to the base URL of the API. The result can be classified using health.StatusOf.
+func (c *APIClient) Healthz(ctx context.Context) error {
+	basePath, err := c.cfg.ServerURLWithContext(ctx, "")
+	if err != nil {
+		return &health.Error{Status: health.StatusUnreachable, Err: err}
+	}
+	return health.Probe(ctx, c.cfg.HTTPClient, basePath)
+}
+
+// Endpoint returns the base URL the client sends its requests to, after the endpoint, region and endpoint resolver
+// options are applied, see config.Configuration.ResolvedEndpoint.
+func (c *APIClient) Endpoint() string {
+	return c.cfg.ResolvedEndpoint()
+}
+
+// Region returns the region the client is configured for, see config.Configuration.ResolvedRegion.
+func (c *APIClient) Region() string {
+	return c.cfg.ResolvedRegion()
+}
+
+// RateLimit returns the rate limit returned by the API in its last response with rate limit headers.
+// Only available if the client is configured with config.WithRateLimitTracking.
+func (c *APIClient) RateLimit() (config.RateLimit, bool) {
+	return c.cfg.RateLimitTracker.Latest()
+}
+
 type formFile struct {
 	fileBytes    []byte
 	fileName     string
@@ -307,6 +347,15 @@ func (c *APIClient) prepareRequest(
 
 	var body *bytes.Buffer
 
+	// Uploads of unknown length are streamed instead of being read into memory, see clients.UploadBody
+	upload, isUpload := postBody.(*clients.UploadBody)
+	if isUpload {
+		postBody = nil
+		if headerParams["Content-Type"] == "" {
+			headerParams["Content-Type"] = "application/octet-stream"
+		}
+	}
+
 	// Detect postBody type and post.
 	if postBody != nil {
 		contentType := headerParams["Content-Type"]
@@ -315,7 +364,7 @@ func (c *APIClient) prepareRequest(
 			headerParams["Content-Type"] = contentType
 		}
 
-		body, err = setBody(postBody, contentType)
+		body, err = setBody(postBody, contentType, c.cfg)
 		if err != nil {
 			return nil, err
 		}
@@ -378,6 +427,7 @@ func (c *APIClient) prepareRequest(
 	if err != nil {
 		return nil, err
 	}
+	c.cfg.NormalizePath(url)
 
 	// Override request host, if applicable
 	if c.cfg.Host != "" {
@@ -396,6 +446,7 @@ func (c *APIClient) prepareRequest(
 			query.Add(k, iv)
 		}
 	}
+	c.cfg.CanonicalizeQuery(query)
 
 	// Encode the parameters.
 	url.RawQuery = queryParamSplit.ReplaceAllStringFunc(query.Encode(), func(s string) string {
@@ -405,7 +456,9 @@ func (c *APIClient) prepareRequest(
 	})
 
 	// Generate a new request
-	if body != nil {
+	if isUpload {
+		localVarRequest, err = c.cfg.NewUploadRequest(upload, method, url.String())
+	} else if body != nil {
 		localVarRequest, err = http.NewRequest(method, url.String(), body)
 	} else {
 		localVarRequest, err = http.NewRequest(method, url.String(), nil)
@@ -440,8 +493,44 @@ func (c *APIClient) prepareRequest(
 	return localVarRequest, nil
 }
 
-func (c *APIClient) decode(v interface{}, b []byte, contentType string) (err error) {
-	if len(b) == 0 {
+// decodeListStream passes the items of a list response to the function set with config.WithListItems, see config.WithStreamingListDecode
+func (c *APIClient) decodeListStream(ctx context.Context, resp *http.Response, v interface{}) (bool, error) {
+	return config.DecodeListStream(ctx, c.cfg, resp, v)
+}
+
+// queryParam is a query parameter set with SetQueryParam or AddQueryParam of a request
+type queryParam struct {
+	key   string
+	value string
+	add   bool
+}
+
+// appendQueryParam appends p to params, without modifying the query parameters of the copies of a request
+func appendQueryParam(params []queryParam, p queryParam) []queryParam {
+	return append(params[:len(params):len(params)], p)
+}
+
+// applyQueryParams applies the query parameters set with SetQueryParam and AddQueryParam to the generated ones,
+// in the order in which they were set
+func applyQueryParams(query url.Values, params []queryParam) {
+	for _, p := range params {
+		if p.add {
+			query.Add(p.key, p.value)
+		} else {
+			query.Set(p.key, p.value)
+		}
+	}
+}
+
+// isErrorModelContentType reports whether the body of an error response can be decoded into an error model.
+// Other bodies, e.g. the HTML error page of a proxy, are only returned raw in the GenericOpenAPIError.
+func isErrorModelContentType(contentType string) bool {
+	return jsonCheck.MatchString(contentType) || xmlCheck.MatchString(contentType)
+}
+
+func (c *APIClient) decode(ctx context.Context, v interface{}, b []byte, contentType string) (err error) {
+	// Responses without content, e.g. 204 No Content, decode to the zero value
+	if len(bytes.TrimSpace(b)) == 0 {
 		return nil
 	}
 	if s, ok := v.(*string); ok {
@@ -487,7 +576,7 @@ func (c *APIClient) decode(v interface{}, b []byte, contentType string) (err err
 			} else {
 				return fmt.Errorf("unknown type with GetActualInstance but no unmarshalObj.UnmarshalJSON defined")
 			}
-		} else if err = json.Unmarshal(b, v); err != nil { // simple model
+		} else if err = c.cfg.DecodeResponseJSON(ctx, b, v); err != nil { // simple model
 			return err
 		}
 		return nil
@@ -523,7 +612,7 @@ func newStrictDecoder(data []byte) *json.Decoder {
 }
 
 // Set request body from an interface{}
-func setBody(body interface{}, contentType string) (bodyBuf *bytes.Buffer, err error) {
+func setBody(body interface{}, contentType string, cfg *config.Configuration) (bodyBuf *bytes.Buffer, err error) {
 	if bodyBuf == nil {
 		bodyBuf = &bytes.Buffer{}
 	}
@@ -539,7 +628,11 @@ func setBody(body interface{}, contentType string) (bodyBuf *bytes.Buffer, err e
 	} else if s, ok := body.(*string); ok {
 		_, err = bodyBuf.WriteString(*s)
 	} else if jsonCheck.MatchString(contentType) {
-		err = json.NewEncoder(bodyBuf).Encode(body)
+		var b []byte
+		b, err = cfg.EncodeJSON(body)
+		if err == nil {
+			_, err = bodyBuf.Write(b)
+		}
 	} else if xmlCheck.MatchString(contentType) {
 		err = xml.NewEncoder(bodyBuf).Encode(body)
 	}
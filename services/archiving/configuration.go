@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "OpenAPI-Generator/1.0.0/go",
+		ServiceName:   "archiving",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -39,3 +40,17 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateInstance",
+	"DeleteInstance",
+	"GetInstance",
+	"ListInstances",
+	"PartialUpdateInstance",
+}
+
+func init() {
+	config.RegisterOperations("archiving", operations...)
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		ListFolderAuditLogEntries Folder - Download audit log entries
@@ -87,6 +89,9 @@ type DefaultApi interface {
 	ListProjectAuditLogEntriesExecute(ctx context.Context, projectId string) (*ListAuditLogEntriesResponse, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiListFolderAuditLogEntriesRequest interface {
 	// An ISO timestamp to specify the beginning of the time range from which entries should be returned, based on the eventTimeStamp. If not given, defaults to the beginning of time.
 	StartTimeRange(startTimeRange time.Time) ApiListFolderAuditLogEntriesRequest
@@ -96,6 +101,10 @@ type ApiListFolderAuditLogEntriesRequest interface {
 	Limit(limit float32) ApiListFolderAuditLogEntriesRequest
 	// A pagination cursor to load further audit log entries for. May be included in the response of previous calls of the API.
 	Cursor(cursor string) ApiListFolderAuditLogEntriesRequest
+	SetQueryParam(key, value string) ApiListFolderAuditLogEntriesRequest
+	AddQueryParam(key, value string) ApiListFolderAuditLogEntriesRequest
+	Clone() ApiListFolderAuditLogEntriesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListAuditLogEntriesResponse, error)
 }
 
@@ -108,6 +117,10 @@ type ApiListOrganizationAuditLogEntriesRequest interface {
 	Limit(limit float32) ApiListOrganizationAuditLogEntriesRequest
 	// A pagination cursor to load further audit log entries for. May be included in the response of previous calls of the API.
 	Cursor(cursor string) ApiListOrganizationAuditLogEntriesRequest
+	SetQueryParam(key, value string) ApiListOrganizationAuditLogEntriesRequest
+	AddQueryParam(key, value string) ApiListOrganizationAuditLogEntriesRequest
+	Clone() ApiListOrganizationAuditLogEntriesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListAuditLogEntriesResponse, error)
 }
 
@@ -120,6 +133,10 @@ type ApiListProjectAuditLogEntriesRequest interface {
 	Limit(limit float32) ApiListProjectAuditLogEntriesRequest
 	// A pagination cursor to load further audit log entries for. May be included in the response of previous calls of the API.
 	Cursor(cursor string) ApiListProjectAuditLogEntriesRequest
+	SetQueryParam(key, value string) ApiListProjectAuditLogEntriesRequest
+	AddQueryParam(key, value string) ApiListProjectAuditLogEntriesRequest
+	Clone() ApiListProjectAuditLogEntriesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListAuditLogEntriesResponse, error)
 }
 
@@ -134,6 +151,7 @@ type ListFolderAuditLogEntriesRequest struct {
 	endTimeRange   *time.Time
 	limit          *float32
 	cursor         *string
+	queryParams    []queryParam
 }
 
 // An ISO timestamp to specify the beginning of the time range from which entries should be returned, based on the eventTimeStamp. If not given, defaults to the beginning of time.
@@ -164,7 +182,43 @@ func (r ListFolderAuditLogEntriesRequest) Cursor(cursor string) ApiListFolderAud
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListFolderAuditLogEntriesRequest) SetQueryParam(key, value string) ApiListFolderAuditLogEntriesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListFolderAuditLogEntriesRequest) AddQueryParam(key, value string) ApiListFolderAuditLogEntriesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListFolderAuditLogEntriesRequest) Clone() ApiListFolderAuditLogEntriesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListFolderAuditLogEntriesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListFolderAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListFolderAuditLogEntries", oapierror.Parameter{Name: "folderId", Value: ParameterValueToString(r.folderId, "folderId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListFolderAuditLogEntriesRequest) execute() (*ListAuditLogEntriesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -187,11 +241,14 @@ func (r ListFolderAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespons
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.folderId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "folderId"}
+	}
 	if r.startTimeRange == nil {
-		return localVarReturnValue, fmt.Errorf("startTimeRange is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "startTimeRange"}
 	}
 	if r.endTimeRange == nil {
-		return localVarReturnValue, fmt.Errorf("endTimeRange is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "endTimeRange"}
 	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "start-time-range", r.startTimeRange, "")
@@ -219,7 +276,8 @@ func (r ListFolderAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespons
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListFolderAuditLogEntries"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -238,6 +296,10 @@ func (r ListFolderAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespons
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -250,10 +312,14 @@ func (r ListFolderAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespons
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -264,7 +330,7 @@ func (r ListFolderAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespons
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -275,7 +341,7 @@ func (r ListFolderAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespons
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -286,7 +352,7 @@ func (r ListFolderAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespons
 		}
 		if localVarHTTPResponse.StatusCode == 429 {
 			var v GatewayErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -297,7 +363,7 @@ func (r ListFolderAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespons
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -345,6 +411,7 @@ type ListOrganizationAuditLogEntriesRequest struct {
 	endTimeRange   *time.Time
 	limit          *float32
 	cursor         *string
+	queryParams    []queryParam
 }
 
 // An ISO timestamp to specify the beginning of the time range from which entries should be returned, based on the eventTimeStamp. If not given, defaults to the beginning of time.
@@ -375,7 +442,43 @@ func (r ListOrganizationAuditLogEntriesRequest) Cursor(cursor string) ApiListOrg
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListOrganizationAuditLogEntriesRequest) SetQueryParam(key, value string) ApiListOrganizationAuditLogEntriesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListOrganizationAuditLogEntriesRequest) AddQueryParam(key, value string) ApiListOrganizationAuditLogEntriesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListOrganizationAuditLogEntriesRequest) Clone() ApiListOrganizationAuditLogEntriesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListOrganizationAuditLogEntriesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListOrganizationAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListOrganizationAuditLogEntries", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListOrganizationAuditLogEntriesRequest) execute() (*ListAuditLogEntriesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -398,11 +501,14 @@ func (r ListOrganizationAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesR
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "organizationId"}
+	}
 	if r.startTimeRange == nil {
-		return localVarReturnValue, fmt.Errorf("startTimeRange is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "startTimeRange"}
 	}
 	if r.endTimeRange == nil {
-		return localVarReturnValue, fmt.Errorf("endTimeRange is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "endTimeRange"}
 	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "start-time-range", r.startTimeRange, "")
@@ -430,7 +536,8 @@ func (r ListOrganizationAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesR
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListOrganizationAuditLogEntries"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -449,6 +556,10 @@ func (r ListOrganizationAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesR
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -461,10 +572,14 @@ func (r ListOrganizationAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesR
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -475,7 +590,7 @@ func (r ListOrganizationAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesR
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -486,7 +601,7 @@ func (r ListOrganizationAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesR
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -497,7 +612,7 @@ func (r ListOrganizationAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesR
 		}
 		if localVarHTTPResponse.StatusCode == 429 {
 			var v GatewayErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -508,7 +623,7 @@ func (r ListOrganizationAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesR
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -556,6 +671,7 @@ type ListProjectAuditLogEntriesRequest struct {
 	endTimeRange   *time.Time
 	limit          *float32
 	cursor         *string
+	queryParams    []queryParam
 }
 
 // An ISO timestamp to specify the beginning of the time range from which entries should be returned, based on the eventTimeStamp. If not given, defaults to the beginning of time.
@@ -586,7 +702,43 @@ func (r ListProjectAuditLogEntriesRequest) Cursor(cursor string) ApiListProjectA
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListProjectAuditLogEntriesRequest) SetQueryParam(key, value string) ApiListProjectAuditLogEntriesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListProjectAuditLogEntriesRequest) AddQueryParam(key, value string) ApiListProjectAuditLogEntriesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListProjectAuditLogEntriesRequest) Clone() ApiListProjectAuditLogEntriesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListProjectAuditLogEntriesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListProjectAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListProjectAuditLogEntries", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListProjectAuditLogEntriesRequest) execute() (*ListAuditLogEntriesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -609,11 +761,14 @@ func (r ListProjectAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespon
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.startTimeRange == nil {
-		return localVarReturnValue, fmt.Errorf("startTimeRange is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "startTimeRange"}
 	}
 	if r.endTimeRange == nil {
-		return localVarReturnValue, fmt.Errorf("endTimeRange is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "endTimeRange"}
 	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "start-time-range", r.startTimeRange, "")
@@ -641,7 +796,8 @@ func (r ListProjectAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespon
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListProjectAuditLogEntries"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -660,6 +816,10 @@ func (r ListProjectAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespon
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -672,10 +832,14 @@ func (r ListProjectAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespon
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -686,7 +850,7 @@ func (r ListProjectAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespon
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -697,7 +861,7 @@ func (r ListProjectAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespon
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -708,7 +872,7 @@ func (r ListProjectAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespon
 		}
 		if localVarHTTPResponse.StatusCode == 429 {
 			var v GatewayErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -719,7 +883,7 @@ func (r ListProjectAuditLogEntriesRequest) Execute() (*ListAuditLogEntriesRespon
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -181,14 +181,9 @@ func (v *AuditLogEntryResponseEventType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := AuditLogEntryResponseEventType(value)
-	for _, existing := range AllowedAuditLogEntryResponseEventTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid AuditLogEntryResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewAuditLogEntryResponseEventTypeFromValue returns a pointer to a valid AuditLogEntryResponseEventType
@@ -212,6 +207,17 @@ func (v AuditLogEntryResponseEventType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v AuditLogEntryResponseEventType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v AuditLogEntryResponseEventType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to EventTypeEventType value
 func (v AuditLogEntryResponseEventType) Ptr() *AuditLogEntryResponseEventType {
 	return &v
@@ -510,14 +516,9 @@ func (v *AuditLogEntryResponseSeverity) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := AuditLogEntryResponseSeverity(value)
-	for _, existing := range AllowedAuditLogEntryResponseSeverityEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid AuditLogEntryResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewAuditLogEntryResponseSeverityFromValue returns a pointer to a valid AuditLogEntryResponseSeverity
@@ -541,6 +542,17 @@ func (v AuditLogEntryResponseSeverity) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v AuditLogEntryResponseSeverity) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v AuditLogEntryResponseSeverity) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to SeveritySeverity value
 func (v AuditLogEntryResponseSeverity) Ptr() *AuditLogEntryResponseSeverity {
 	return &v
@@ -676,14 +688,9 @@ func (v *AuditLogEntryResponseVisibility) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := AuditLogEntryResponseVisibility(value)
-	for _, existing := range AllowedAuditLogEntryResponseVisibilityEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid AuditLogEntryResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewAuditLogEntryResponseVisibilityFromValue returns a pointer to a valid AuditLogEntryResponseVisibility
@@ -707,6 +714,17 @@ func (v AuditLogEntryResponseVisibility) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v AuditLogEntryResponseVisibility) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v AuditLogEntryResponseVisibility) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to VisibilityVisibility value
 func (v AuditLogEntryResponseVisibility) Ptr() *AuditLogEntryResponseVisibility {
 	return &v
@@ -47,11 +47,11 @@ func TestAuditLogEntryResponseEventType_UnmarshalJSON(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -90,11 +90,11 @@ func TestAuditLogEntryResponseSeverity_UnmarshalJSON(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -133,11 +133,11 @@ func TestAuditLogEntryResponseVisibility_UnmarshalJSON(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -24,6 +24,8 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		AddMembers Add members to a resource
@@ -175,27 +177,50 @@ type DefaultApi interface {
 	RemoveMembersExecute(ctx context.Context, resourceId string) (*MembersResponse, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiAddMembersRequest interface {
 	AddMembersPayload(addMembersPayload AddMembersPayload) ApiAddMembersRequest
+	SetQueryParam(key, value string) ApiAddMembersRequest
+	AddQueryParam(key, value string) ApiAddMembersRequest
+	Clone() ApiAddMembersRequest
+	Fingerprint() (string, error)
 	Execute() (*MembersResponse, error)
 }
 
 type ApiGetAssignableSubjectsRequest interface {
 	Subject(subject string) ApiGetAssignableSubjectsRequest
+	SetQueryParam(key, value string) ApiGetAssignableSubjectsRequest
+	AddQueryParam(key, value string) ApiGetAssignableSubjectsRequest
+	Clone() ApiGetAssignableSubjectsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListAssignableSubjectsResponse, error)
 }
 
 type ApiListMembersRequest interface {
 	Subject(subject string) ApiListMembersRequest
+	SetQueryParam(key, value string) ApiListMembersRequest
+	AddQueryParam(key, value string) ApiListMembersRequest
+	Clone() ApiListMembersRequest
+	Fingerprint() (string, error)
 	Execute() (*ListMembersResponse, error)
 }
 
 type ApiListPermissionsRequest interface {
 	ResourceType(resourceType string) ApiListPermissionsRequest
+	SetQueryParam(key, value string) ApiListPermissionsRequest
+	AddQueryParam(key, value string) ApiListPermissionsRequest
+	Clone() ApiListPermissionsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListPermissionsResponse, error)
 }
 
 type ApiListRolesRequest interface {
+	SetQueryParam(key, value string) ApiListRolesRequest
+	AddQueryParam(key, value string) ApiListRolesRequest
+	Clone() ApiListRolesRequest
+	Fingerprint() (string, error)
 	Execute() (*RolesResponse, error)
 }
 
@@ -203,6 +228,10 @@ type ApiListUserMembershipsRequest interface {
 	ResourceType(resourceType string) ApiListUserMembershipsRequest
 	ResourceId(resourceId string) ApiListUserMembershipsRequest
 	ParentResourceId(parentResourceId string) ApiListUserMembershipsRequest
+	SetQueryParam(key, value string) ApiListUserMembershipsRequest
+	AddQueryParam(key, value string) ApiListUserMembershipsRequest
+	Clone() ApiListUserMembershipsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListUserMembershipsResponse, error)
 }
 
@@ -210,11 +239,19 @@ type ApiListUserPermissionsRequest interface {
 	Resource(resource string) ApiListUserPermissionsRequest
 	ResourceType(resourceType string) ApiListUserPermissionsRequest
 	Permissions(permissions []string) ApiListUserPermissionsRequest
+	SetQueryParam(key, value string) ApiListUserPermissionsRequest
+	AddQueryParam(key, value string) ApiListUserPermissionsRequest
+	Clone() ApiListUserPermissionsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListUserPermissionsResponse, error)
 }
 
 type ApiRemoveMembersRequest interface {
 	RemoveMembersPayload(removeMembersPayload RemoveMembersPayload) ApiRemoveMembersRequest
+	SetQueryParam(key, value string) ApiRemoveMembersRequest
+	AddQueryParam(key, value string) ApiRemoveMembersRequest
+	Clone() ApiRemoveMembersRequest
+	Fingerprint() (string, error)
 	Execute() (*MembersResponse, error)
 }
 
@@ -226,6 +263,7 @@ type AddMembersRequest struct {
 	apiService        *DefaultApiService
 	resourceId        string
 	addMembersPayload *AddMembersPayload
+	queryParams       []queryParam
 }
 
 func (r AddMembersRequest) AddMembersPayload(addMembersPayload AddMembersPayload) ApiAddMembersRequest {
@@ -233,7 +271,43 @@ func (r AddMembersRequest) AddMembersPayload(addMembersPayload AddMembersPayload
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r AddMembersRequest) SetQueryParam(key, value string) ApiAddMembersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r AddMembersRequest) AddQueryParam(key, value string) ApiAddMembersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r AddMembersRequest) Clone() ApiAddMembersRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r AddMembersRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r AddMembersRequest) Execute() (*MembersResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "AddMembers", oapierror.Parameter{Name: "resourceId", Value: ParameterValueToString(r.resourceId, "resourceId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r AddMembersRequest) execute() (*MembersResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -256,8 +330,11 @@ func (r AddMembersRequest) Execute() (*MembersResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.resourceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "resourceId"}
+	}
 	if r.addMembersPayload == nil {
-		return localVarReturnValue, fmt.Errorf("addMembersPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "addMembersPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -279,7 +356,8 @@ func (r AddMembersRequest) Execute() (*MembersResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.addMembersPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "AddMembers"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -310,10 +388,14 @@ func (r AddMembersRequest) Execute() (*MembersResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -324,7 +406,7 @@ func (r AddMembersRequest) Execute() (*MembersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -335,7 +417,7 @@ func (r AddMembersRequest) Execute() (*MembersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -346,7 +428,7 @@ func (r AddMembersRequest) Execute() (*MembersResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -391,6 +473,7 @@ type GetAssignableSubjectsRequest struct {
 	resourceType string
 	resourceId   string
 	subject      *string
+	queryParams  []queryParam
 }
 
 func (r GetAssignableSubjectsRequest) Subject(subject string) ApiGetAssignableSubjectsRequest {
@@ -398,7 +481,43 @@ func (r GetAssignableSubjectsRequest) Subject(subject string) ApiGetAssignableSu
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetAssignableSubjectsRequest) SetQueryParam(key, value string) ApiGetAssignableSubjectsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetAssignableSubjectsRequest) AddQueryParam(key, value string) ApiGetAssignableSubjectsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetAssignableSubjectsRequest) Clone() ApiGetAssignableSubjectsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetAssignableSubjectsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r GetAssignableSubjectsRequest) Execute() (*ListAssignableSubjectsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetAssignableSubjects", oapierror.Parameter{Name: "resourceType", Value: ParameterValueToString(r.resourceType, "resourceType")}, oapierror.Parameter{Name: "resourceId", Value: ParameterValueToString(r.resourceId, "resourceId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetAssignableSubjectsRequest) execute() (*ListAssignableSubjectsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -422,6 +541,12 @@ func (r GetAssignableSubjectsRequest) Execute() (*ListAssignableSubjectsResponse
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.resourceType == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "resourceType"}
+	}
+	if r.resourceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "resourceId"}
+	}
 
 	if r.subject != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "subject", r.subject, "")
@@ -443,7 +568,8 @@ func (r GetAssignableSubjectsRequest) Execute() (*ListAssignableSubjectsResponse
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetAssignableSubjects"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -474,10 +600,14 @@ func (r GetAssignableSubjectsRequest) Execute() (*ListAssignableSubjectsResponse
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -488,7 +618,7 @@ func (r GetAssignableSubjectsRequest) Execute() (*ListAssignableSubjectsResponse
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -499,7 +629,7 @@ func (r GetAssignableSubjectsRequest) Execute() (*ListAssignableSubjectsResponse
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -510,7 +640,7 @@ func (r GetAssignableSubjectsRequest) Execute() (*ListAssignableSubjectsResponse
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -558,6 +688,7 @@ type ListMembersRequest struct {
 	resourceType string
 	resourceId   string
 	subject      *string
+	queryParams  []queryParam
 }
 
 func (r ListMembersRequest) Subject(subject string) ApiListMembersRequest {
@@ -565,7 +696,43 @@ func (r ListMembersRequest) Subject(subject string) ApiListMembersRequest {
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListMembersRequest) SetQueryParam(key, value string) ApiListMembersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListMembersRequest) AddQueryParam(key, value string) ApiListMembersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListMembersRequest) Clone() ApiListMembersRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListMembersRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListMembersRequest) Execute() (*ListMembersResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListMembers", oapierror.Parameter{Name: "resourceType", Value: ParameterValueToString(r.resourceType, "resourceType")}, oapierror.Parameter{Name: "resourceId", Value: ParameterValueToString(r.resourceId, "resourceId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListMembersRequest) execute() (*ListMembersResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -589,6 +756,12 @@ func (r ListMembersRequest) Execute() (*ListMembersResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.resourceType == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "resourceType"}
+	}
+	if r.resourceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "resourceId"}
+	}
 
 	if r.subject != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "subject", r.subject, "")
@@ -610,7 +783,8 @@ func (r ListMembersRequest) Execute() (*ListMembersResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListMembers"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -629,6 +803,10 @@ func (r ListMembersRequest) Execute() (*ListMembersResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -641,10 +819,14 @@ func (r ListMembersRequest) Execute() (*ListMembersResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -655,7 +837,7 @@ func (r ListMembersRequest) Execute() (*ListMembersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -666,7 +848,7 @@ func (r ListMembersRequest) Execute() (*ListMembersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -677,7 +859,7 @@ func (r ListMembersRequest) Execute() (*ListMembersResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -723,6 +905,7 @@ type ListPermissionsRequest struct {
 	ctx          context.Context
 	apiService   *DefaultApiService
 	resourceType *string
+	queryParams  []queryParam
 }
 
 func (r ListPermissionsRequest) ResourceType(resourceType string) ApiListPermissionsRequest {
@@ -730,7 +913,43 @@ func (r ListPermissionsRequest) ResourceType(resourceType string) ApiListPermiss
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListPermissionsRequest) SetQueryParam(key, value string) ApiListPermissionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListPermissionsRequest) AddQueryParam(key, value string) ApiListPermissionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListPermissionsRequest) Clone() ApiListPermissionsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListPermissionsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListPermissionsRequest) Execute() (*ListPermissionsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListPermissions")
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListPermissionsRequest) execute() (*ListPermissionsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -773,7 +992,8 @@ func (r ListPermissionsRequest) Execute() (*ListPermissionsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListPermissions"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -792,6 +1012,10 @@ func (r ListPermissionsRequest) Execute() (*ListPermissionsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -804,10 +1028,14 @@ func (r ListPermissionsRequest) Execute() (*ListPermissionsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -818,7 +1046,7 @@ func (r ListPermissionsRequest) Execute() (*ListPermissionsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -829,7 +1057,7 @@ func (r ListPermissionsRequest) Execute() (*ListPermissionsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -840,7 +1068,7 @@ func (r ListPermissionsRequest) Execute() (*ListPermissionsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -881,9 +1109,46 @@ type ListRolesRequest struct {
 	apiService   *DefaultApiService
 	resourceType string
 	resourceId   string
+	queryParams  []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListRolesRequest) SetQueryParam(key, value string) ApiListRolesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListRolesRequest) AddQueryParam(key, value string) ApiListRolesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListRolesRequest) Clone() ApiListRolesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListRolesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListRolesRequest) Execute() (*RolesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListRoles", oapierror.Parameter{Name: "resourceType", Value: ParameterValueToString(r.resourceType, "resourceType")}, oapierror.Parameter{Name: "resourceId", Value: ParameterValueToString(r.resourceId, "resourceId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListRolesRequest) execute() (*RolesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -907,6 +1172,12 @@ func (r ListRolesRequest) Execute() (*RolesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.resourceType == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "resourceType"}
+	}
+	if r.resourceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "resourceId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -925,7 +1196,8 @@ func (r ListRolesRequest) Execute() (*RolesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListRoles"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -944,6 +1216,10 @@ func (r ListRolesRequest) Execute() (*RolesResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -956,10 +1232,14 @@ func (r ListRolesRequest) Execute() (*RolesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -970,7 +1250,7 @@ func (r ListRolesRequest) Execute() (*RolesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -981,7 +1261,7 @@ func (r ListRolesRequest) Execute() (*RolesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -992,7 +1272,7 @@ func (r ListRolesRequest) Execute() (*RolesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1041,6 +1321,7 @@ type ListUserMembershipsRequest struct {
 	resourceType     *string
 	resourceId       *string
 	parentResourceId *string
+	queryParams      []queryParam
 }
 
 func (r ListUserMembershipsRequest) ResourceType(resourceType string) ApiListUserMembershipsRequest {
@@ -1058,7 +1339,43 @@ func (r ListUserMembershipsRequest) ParentResourceId(parentResourceId string) Ap
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListUserMembershipsRequest) SetQueryParam(key, value string) ApiListUserMembershipsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListUserMembershipsRequest) AddQueryParam(key, value string) ApiListUserMembershipsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListUserMembershipsRequest) Clone() ApiListUserMembershipsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListUserMembershipsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListUserMembershipsRequest) Execute() (*ListUserMembershipsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListUserMemberships", oapierror.Parameter{Name: "email", Value: ParameterValueToString(r.email, "email")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListUserMembershipsRequest) execute() (*ListUserMembershipsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1081,6 +1398,9 @@ func (r ListUserMembershipsRequest) Execute() (*ListUserMembershipsResponse, err
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.email == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "email"}
+	}
 
 	if r.resourceType != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "resourceType", r.resourceType, "")
@@ -1108,7 +1428,8 @@ func (r ListUserMembershipsRequest) Execute() (*ListUserMembershipsResponse, err
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListUserMemberships"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1127,6 +1448,10 @@ func (r ListUserMembershipsRequest) Execute() (*ListUserMembershipsResponse, err
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1139,10 +1464,14 @@ func (r ListUserMembershipsRequest) Execute() (*ListUserMembershipsResponse, err
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1153,7 +1482,7 @@ func (r ListUserMembershipsRequest) Execute() (*ListUserMembershipsResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1164,7 +1493,7 @@ func (r ListUserMembershipsRequest) Execute() (*ListUserMembershipsResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1175,7 +1504,7 @@ func (r ListUserMembershipsRequest) Execute() (*ListUserMembershipsResponse, err
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1221,6 +1550,7 @@ type ListUserPermissionsRequest struct {
 	resource     *string
 	resourceType *string
 	permissions  *[]string
+	queryParams  []queryParam
 }
 
 func (r ListUserPermissionsRequest) Resource(resource string) ApiListUserPermissionsRequest {
@@ -1238,7 +1568,43 @@ func (r ListUserPermissionsRequest) Permissions(permissions []string) ApiListUse
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListUserPermissionsRequest) SetQueryParam(key, value string) ApiListUserPermissionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListUserPermissionsRequest) AddQueryParam(key, value string) ApiListUserPermissionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListUserPermissionsRequest) Clone() ApiListUserPermissionsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListUserPermissionsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListUserPermissionsRequest) Execute() (*ListUserPermissionsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListUserPermissions", oapierror.Parameter{Name: "email", Value: ParameterValueToString(r.email, "email")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListUserPermissionsRequest) execute() (*ListUserPermissionsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1261,6 +1627,9 @@ func (r ListUserPermissionsRequest) Execute() (*ListUserPermissionsResponse, err
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.email == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "email"}
+	}
 
 	if r.resource != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "resource", r.resource, "")
@@ -1296,7 +1665,8 @@ func (r ListUserPermissionsRequest) Execute() (*ListUserPermissionsResponse, err
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListUserPermissions"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1315,6 +1685,10 @@ func (r ListUserPermissionsRequest) Execute() (*ListUserPermissionsResponse, err
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1327,10 +1701,14 @@ func (r ListUserPermissionsRequest) Execute() (*ListUserPermissionsResponse, err
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1341,7 +1719,7 @@ func (r ListUserPermissionsRequest) Execute() (*ListUserPermissionsResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1352,7 +1730,7 @@ func (r ListUserPermissionsRequest) Execute() (*ListUserPermissionsResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1363,7 +1741,7 @@ func (r ListUserPermissionsRequest) Execute() (*ListUserPermissionsResponse, err
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1407,6 +1785,7 @@ type RemoveMembersRequest struct {
 	apiService           *DefaultApiService
 	resourceId           string
 	removeMembersPayload *RemoveMembersPayload
+	queryParams          []queryParam
 }
 
 func (r RemoveMembersRequest) RemoveMembersPayload(removeMembersPayload RemoveMembersPayload) ApiRemoveMembersRequest {
@@ -1414,7 +1793,43 @@ func (r RemoveMembersRequest) RemoveMembersPayload(removeMembersPayload RemoveMe
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r RemoveMembersRequest) SetQueryParam(key, value string) ApiRemoveMembersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r RemoveMembersRequest) AddQueryParam(key, value string) ApiRemoveMembersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r RemoveMembersRequest) Clone() ApiRemoveMembersRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r RemoveMembersRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r RemoveMembersRequest) Execute() (*MembersResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "RemoveMembers", oapierror.Parameter{Name: "resourceId", Value: ParameterValueToString(r.resourceId, "resourceId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r RemoveMembersRequest) execute() (*MembersResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1437,8 +1852,11 @@ func (r RemoveMembersRequest) Execute() (*MembersResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.resourceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "resourceId"}
+	}
 	if r.removeMembersPayload == nil {
-		return localVarReturnValue, fmt.Errorf("removeMembersPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "removeMembersPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1460,7 +1878,8 @@ func (r RemoveMembersRequest) Execute() (*MembersResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.removeMembersPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "RemoveMembers"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1491,10 +1910,14 @@ func (r RemoveMembersRequest) Execute() (*MembersResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1505,7 +1928,7 @@ func (r RemoveMembersRequest) Execute() (*MembersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1516,7 +1939,7 @@ func (r RemoveMembersRequest) Execute() (*MembersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1527,7 +1950,7 @@ func (r RemoveMembersRequest) Execute() (*MembersResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/authorization",
+		ServiceName:   "authorization",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,20 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"AddMembers",
+	"GetAssignableSubjects",
+	"ListMembers",
+	"ListPermissions",
+	"ListRoles",
+	"ListUserMemberships",
+	"ListUserPermissions",
+	"RemoveMembers",
+}
+
+func init() {
+	config.RegisterOperations("authorization", operations...)
+}
@@ -20,10 +20,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateDistribution Create new distribution
@@ -343,40 +346,72 @@ type DefaultApi interface {
 	PutCustomDomainExecute(ctx context.Context, projectId string, distributionId string, domain string) (*PutCustomDomainResponse, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateDistributionRequest interface {
 	CreateDistributionPayload(createDistributionPayload CreateDistributionPayload) ApiCreateDistributionRequest
+	RetryOnConflict(maxAttempts int) ApiCreateDistributionRequest
+	SetQueryParam(key, value string) ApiCreateDistributionRequest
+	AddQueryParam(key, value string) ApiCreateDistributionRequest
+	Clone() ApiCreateDistributionRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateDistributionResponse, error)
 }
 
 type ApiDeleteCustomDomainRequest interface {
 	IntentId(intentId string) ApiDeleteCustomDomainRequest
+	SetQueryParam(key, value string) ApiDeleteCustomDomainRequest
+	AddQueryParam(key, value string) ApiDeleteCustomDomainRequest
+	Clone() ApiDeleteCustomDomainRequest
+	Fingerprint() (string, error)
 	Execute() (*DeleteCustomDomainResponse, error)
 }
 
 type ApiDeleteDistributionRequest interface {
 	// While optional, it is greatly encouraged to provide an &#x60;intentId&#x60;.  This is used to deduplicate requests.   If multiple DELETE-Requests with the same &#x60;intentId&#x60; are received, all but the first request are dropped.
 	IntentId(intentId string) ApiDeleteDistributionRequest
+	SetQueryParam(key, value string) ApiDeleteDistributionRequest
+	AddQueryParam(key, value string) ApiDeleteDistributionRequest
+	Clone() ApiDeleteDistributionRequest
+	Fingerprint() (string, error)
 	Execute() (*DeleteDistributionResponse, error)
 }
 
 type ApiFindCachePathsRequest interface {
 	// A substring of the search query.
 	Path(path string) ApiFindCachePathsRequest
+	SetQueryParam(key, value string) ApiFindCachePathsRequest
+	AddQueryParam(key, value string) ApiFindCachePathsRequest
+	Clone() ApiFindCachePathsRequest
+	Fingerprint() (string, error)
 	Execute() (*FindCachePathsResponse, error)
 }
 
 type ApiGetCacheInfoRequest interface {
 	PurgePath(purgePath string) ApiGetCacheInfoRequest
+	SetQueryParam(key, value string) ApiGetCacheInfoRequest
+	AddQueryParam(key, value string) ApiGetCacheInfoRequest
+	Clone() ApiGetCacheInfoRequest
+	Fingerprint() (string, error)
 	Execute() (*GetCacheInfoResponse, error)
 }
 
 type ApiGetCustomDomainRequest interface {
+	SetQueryParam(key, value string) ApiGetCustomDomainRequest
+	AddQueryParam(key, value string) ApiGetCustomDomainRequest
+	Clone() ApiGetCustomDomainRequest
+	Fingerprint() (string, error)
 	Execute() (*GetCustomDomainResponse, error)
 }
 
 type ApiGetDistributionRequest interface {
 	// If set, the top level of a distribution contains a &#x60;waf&#x60; property, which defines the status of the waf. This includes a list of all resolved rules.
 	WithWafStatus(withWafStatus bool) ApiGetDistributionRequest
+	SetQueryParam(key, value string) ApiGetDistributionRequest
+	AddQueryParam(key, value string) ApiGetDistributionRequest
+	Clone() ApiGetDistributionRequest
+	Fingerprint() (string, error)
 	Execute() (*GetDistributionResponse, error)
 }
 
@@ -400,6 +435,10 @@ type ApiGetLogsRequest interface {
 	StatusCode(statusCode int32) ApiGetLogsRequest
 	// Filters based on whether the request was served from the CDN cache. Can be combined with other filters
 	CacheHit(cacheHit bool) ApiGetLogsRequest
+	SetQueryParam(key, value string) ApiGetLogsRequest
+	AddQueryParam(key, value string) ApiGetLogsRequest
+	Clone() ApiGetLogsRequest
+	Fingerprint() (string, error)
 	Execute() (*GetLogsResponse, error)
 }
 
@@ -410,6 +449,10 @@ type ApiGetStatisticsRequest interface {
 	To(to time.Time) ApiGetStatisticsRequest
 	// Over which interval should statistics be aggregated?  defaults to hourly resolution  **NOTE**: Intervals are grouped in buckets that start and end based on a day in UTC+0 time. So for the &#x60;daily&#x60; interval, the group starts (inclusive) and ends (exclusive) at &#x60;00:00Z&#x60;
 	Interval(interval string) ApiGetStatisticsRequest
+	SetQueryParam(key, value string) ApiGetStatisticsRequest
+	AddQueryParam(key, value string) ApiGetStatisticsRequest
+	Clone() ApiGetStatisticsRequest
+	Fingerprint() (string, error)
 	Execute() (*GetStatisticsResponse, error)
 }
 
@@ -423,25 +466,45 @@ type ApiListDistributionsRequest interface {
 	// The following sort options exist. We default to &#x60;createdAt&#x60; - &#x60;id&#x60; - Sort by distribution Id using String comparison - &#x60;updatedAt&#x60; - Sort by when the distribution configuration was last modified,    for example by changing the regions or response headers - &#x60;createdAt&#x60; - Sort by when the distribution was initially created. - &#x60;originUrl&#x60; - Sort by originUrl using String comparison - &#x60;status&#x60; - Sort by distribution status, using String comparison - &#x60;originUrlRelated&#x60; - The originUrl is segmented and reversed before sorting. E.g. &#x60;www.example.com&#x60; is converted to &#x60;com.example.www&#x60; for sorting. This way, distributions pointing to the same domain trees are grouped next to each other.
 	SortBy(sortBy string) ApiListDistributionsRequest
 	SortOrder(sortOrder string) ApiListDistributionsRequest
+	SetQueryParam(key, value string) ApiListDistributionsRequest
+	AddQueryParam(key, value string) ApiListDistributionsRequest
+	Clone() ApiListDistributionsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListDistributionsResponse, error)
 }
 
 type ApiListWafCollectionsRequest interface {
+	SetQueryParam(key, value string) ApiListWafCollectionsRequest
+	AddQueryParam(key, value string) ApiListWafCollectionsRequest
+	Clone() ApiListWafCollectionsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListWafCollectionsResponse, error)
 }
 
 type ApiPatchDistributionRequest interface {
 	PatchDistributionPayload(patchDistributionPayload PatchDistributionPayload) ApiPatchDistributionRequest
+	SetQueryParam(key, value string) ApiPatchDistributionRequest
+	AddQueryParam(key, value string) ApiPatchDistributionRequest
+	Clone() ApiPatchDistributionRequest
+	Fingerprint() (string, error)
 	Execute() (*PatchDistributionResponse, error)
 }
 
 type ApiPurgeCacheRequest interface {
 	PurgeCachePayload(purgeCachePayload PurgeCachePayload) ApiPurgeCacheRequest
+	SetQueryParam(key, value string) ApiPurgeCacheRequest
+	AddQueryParam(key, value string) ApiPurgeCacheRequest
+	Clone() ApiPurgeCacheRequest
+	Fingerprint() (string, error)
 	Execute() (map[string]interface{}, error)
 }
 
 type ApiPutCustomDomainRequest interface {
 	PutCustomDomainPayload(putCustomDomainPayload PutCustomDomainPayload) ApiPutCustomDomainRequest
+	SetQueryParam(key, value string) ApiPutCustomDomainRequest
+	AddQueryParam(key, value string) ApiPutCustomDomainRequest
+	Clone() ApiPutCustomDomainRequest
+	Fingerprint() (string, error)
 	Execute() (*PutCustomDomainResponse, error)
 }
 
@@ -453,6 +516,8 @@ type CreateDistributionRequest struct {
 	apiService                *DefaultApiService
 	projectId                 string
 	createDistributionPayload *CreateDistributionPayload
+	retryOnConflict           int
+	queryParams               []queryParam
 }
 
 func (r CreateDistributionRequest) CreateDistributionPayload(createDistributionPayload CreateDistributionPayload) ApiCreateDistributionRequest {
@@ -460,7 +525,50 @@ func (r CreateDistributionRequest) CreateDistributionPayload(createDistributionP
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateDistributionRequest) RetryOnConflict(maxAttempts int) ApiCreateDistributionRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateDistributionRequest) SetQueryParam(key, value string) ApiCreateDistributionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateDistributionRequest) AddQueryParam(key, value string) ApiCreateDistributionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateDistributionRequest) Clone() ApiCreateDistributionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateDistributionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateDistributionRequest) Execute() (*CreateDistributionResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateDistribution", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateDistributionRequest) execute() (*CreateDistributionResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -483,8 +591,11 @@ func (r CreateDistributionRequest) Execute() (*CreateDistributionResponse, error
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createDistributionPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createDistributionPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createDistributionPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -506,7 +617,8 @@ func (r CreateDistributionRequest) Execute() (*CreateDistributionResponse, error
 	}
 	// body params
 	localVarPostBody = r.createDistributionPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateDistribution"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -537,10 +649,14 @@ func (r CreateDistributionRequest) Execute() (*CreateDistributionResponse, error
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -551,7 +667,7 @@ func (r CreateDistributionRequest) Execute() (*CreateDistributionResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -562,7 +678,7 @@ func (r CreateDistributionRequest) Execute() (*CreateDistributionResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -573,7 +689,7 @@ func (r CreateDistributionRequest) Execute() (*CreateDistributionResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -583,7 +699,7 @@ func (r CreateDistributionRequest) Execute() (*CreateDistributionResponse, error
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -593,7 +709,7 @@ func (r CreateDistributionRequest) Execute() (*CreateDistributionResponse, error
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -639,6 +755,7 @@ type DeleteCustomDomainRequest struct {
 	distributionId string
 	domain         string
 	intentId       *string
+	queryParams    []queryParam
 }
 
 func (r DeleteCustomDomainRequest) IntentId(intentId string) ApiDeleteCustomDomainRequest {
@@ -646,7 +763,43 @@ func (r DeleteCustomDomainRequest) IntentId(intentId string) ApiDeleteCustomDoma
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteCustomDomainRequest) SetQueryParam(key, value string) ApiDeleteCustomDomainRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteCustomDomainRequest) AddQueryParam(key, value string) ApiDeleteCustomDomainRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteCustomDomainRequest) Clone() ApiDeleteCustomDomainRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteCustomDomainRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteCustomDomainRequest) Execute() (*DeleteCustomDomainResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteCustomDomain", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")}, oapierror.Parameter{Name: "domain", Value: ParameterValueToString(r.domain, "domain")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteCustomDomainRequest) execute() (*DeleteCustomDomainResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -671,6 +824,15 @@ func (r DeleteCustomDomainRequest) Execute() (*DeleteCustomDomainResponse, error
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
+	if r.domain == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "domain"}
+	}
 	if strlen(r.domain) > 72 {
 		return localVarReturnValue, fmt.Errorf("domain must have less than 72 elements")
 	}
@@ -695,7 +857,8 @@ func (r DeleteCustomDomainRequest) Execute() (*DeleteCustomDomainResponse, error
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteCustomDomain"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -726,10 +889,14 @@ func (r DeleteCustomDomainRequest) Execute() (*DeleteCustomDomainResponse, error
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -740,7 +907,7 @@ func (r DeleteCustomDomainRequest) Execute() (*DeleteCustomDomainResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -751,7 +918,7 @@ func (r DeleteCustomDomainRequest) Execute() (*DeleteCustomDomainResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -761,7 +928,7 @@ func (r DeleteCustomDomainRequest) Execute() (*DeleteCustomDomainResponse, error
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -771,7 +938,7 @@ func (r DeleteCustomDomainRequest) Execute() (*DeleteCustomDomainResponse, error
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -822,6 +989,7 @@ type DeleteDistributionRequest struct {
 	projectId      string
 	distributionId string
 	intentId       *string
+	queryParams    []queryParam
 }
 
 // While optional, it is greatly encouraged to provide an &#x60;intentId&#x60;.  This is used to deduplicate requests.   If multiple DELETE-Requests with the same &#x60;intentId&#x60; are received, all but the first request are dropped.
@@ -831,7 +999,43 @@ func (r DeleteDistributionRequest) IntentId(intentId string) ApiDeleteDistributi
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteDistributionRequest) SetQueryParam(key, value string) ApiDeleteDistributionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteDistributionRequest) AddQueryParam(key, value string) ApiDeleteDistributionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteDistributionRequest) Clone() ApiDeleteDistributionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteDistributionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteDistributionRequest) Execute() (*DeleteDistributionResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteDistribution", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteDistributionRequest) execute() (*DeleteDistributionResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -855,6 +1059,12 @@ func (r DeleteDistributionRequest) Execute() (*DeleteDistributionResponse, error
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
 
 	if r.intentId != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "intentId", r.intentId, "")
@@ -876,7 +1086,8 @@ func (r DeleteDistributionRequest) Execute() (*DeleteDistributionResponse, error
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteDistribution"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -907,10 +1118,14 @@ func (r DeleteDistributionRequest) Execute() (*DeleteDistributionResponse, error
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -921,7 +1136,7 @@ func (r DeleteDistributionRequest) Execute() (*DeleteDistributionResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -932,7 +1147,7 @@ func (r DeleteDistributionRequest) Execute() (*DeleteDistributionResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -942,7 +1157,7 @@ func (r DeleteDistributionRequest) Execute() (*DeleteDistributionResponse, error
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -952,7 +1167,7 @@ func (r DeleteDistributionRequest) Execute() (*DeleteDistributionResponse, error
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1000,6 +1215,7 @@ type FindCachePathsRequest struct {
 	projectId      string
 	distributionId string
 	path           *string
+	queryParams    []queryParam
 }
 
 // A substring of the search query.
@@ -1009,7 +1225,43 @@ func (r FindCachePathsRequest) Path(path string) ApiFindCachePathsRequest {
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r FindCachePathsRequest) SetQueryParam(key, value string) ApiFindCachePathsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r FindCachePathsRequest) AddQueryParam(key, value string) ApiFindCachePathsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r FindCachePathsRequest) Clone() ApiFindCachePathsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r FindCachePathsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r FindCachePathsRequest) Execute() (*FindCachePathsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "FindCachePaths", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r FindCachePathsRequest) execute() (*FindCachePathsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1033,8 +1285,14 @@ func (r FindCachePathsRequest) Execute() (*FindCachePathsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
 	if r.path == nil {
-		return localVarReturnValue, fmt.Errorf("path is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "path"}
 	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
@@ -1055,7 +1313,8 @@ func (r FindCachePathsRequest) Execute() (*FindCachePathsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "FindCachePaths"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1086,10 +1345,14 @@ func (r FindCachePathsRequest) Execute() (*FindCachePathsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1100,7 +1363,7 @@ func (r FindCachePathsRequest) Execute() (*FindCachePathsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1111,7 +1374,7 @@ func (r FindCachePathsRequest) Execute() (*FindCachePathsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1121,7 +1384,7 @@ func (r FindCachePathsRequest) Execute() (*FindCachePathsResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1131,7 +1394,7 @@ func (r FindCachePathsRequest) Execute() (*FindCachePathsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1192,6 +1455,7 @@ type GetCacheInfoRequest struct {
 	projectId      string
 	distributionId string
 	purgePath      *string
+	queryParams    []queryParam
 }
 
 func (r GetCacheInfoRequest) PurgePath(purgePath string) ApiGetCacheInfoRequest {
@@ -1199,7 +1463,43 @@ func (r GetCacheInfoRequest) PurgePath(purgePath string) ApiGetCacheInfoRequest
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetCacheInfoRequest) SetQueryParam(key, value string) ApiGetCacheInfoRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetCacheInfoRequest) AddQueryParam(key, value string) ApiGetCacheInfoRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetCacheInfoRequest) Clone() ApiGetCacheInfoRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetCacheInfoRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r GetCacheInfoRequest) Execute() (*GetCacheInfoResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetCacheInfo", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetCacheInfoRequest) execute() (*GetCacheInfoResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1223,6 +1523,12 @@ func (r GetCacheInfoRequest) Execute() (*GetCacheInfoResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
 
 	if r.purgePath != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "purgePath", r.purgePath, "")
@@ -1244,7 +1550,8 @@ func (r GetCacheInfoRequest) Execute() (*GetCacheInfoResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetCacheInfo"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1275,10 +1582,14 @@ func (r GetCacheInfoRequest) Execute() (*GetCacheInfoResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1289,7 +1600,7 @@ func (r GetCacheInfoRequest) Execute() (*GetCacheInfoResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1300,7 +1611,7 @@ func (r GetCacheInfoRequest) Execute() (*GetCacheInfoResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1310,7 +1621,7 @@ func (r GetCacheInfoRequest) Execute() (*GetCacheInfoResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1320,7 +1631,7 @@ func (r GetCacheInfoRequest) Execute() (*GetCacheInfoResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1371,9 +1682,46 @@ type GetCustomDomainRequest struct {
 	projectId      string
 	distributionId string
 	domain         string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetCustomDomainRequest) SetQueryParam(key, value string) ApiGetCustomDomainRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetCustomDomainRequest) AddQueryParam(key, value string) ApiGetCustomDomainRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetCustomDomainRequest) Clone() ApiGetCustomDomainRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetCustomDomainRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetCustomDomainRequest) Execute() (*GetCustomDomainResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetCustomDomain", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")}, oapierror.Parameter{Name: "domain", Value: ParameterValueToString(r.domain, "domain")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetCustomDomainRequest) execute() (*GetCustomDomainResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1398,6 +1746,15 @@ func (r GetCustomDomainRequest) Execute() (*GetCustomDomainResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
+	if r.domain == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "domain"}
+	}
 	if strlen(r.domain) > 72 {
 		return localVarReturnValue, fmt.Errorf("domain must have less than 72 elements")
 	}
@@ -1419,7 +1776,8 @@ func (r GetCustomDomainRequest) Execute() (*GetCustomDomainResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetCustomDomain"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1450,10 +1808,14 @@ func (r GetCustomDomainRequest) Execute() (*GetCustomDomainResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1464,7 +1826,7 @@ func (r GetCustomDomainRequest) Execute() (*GetCustomDomainResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1475,7 +1837,7 @@ func (r GetCustomDomainRequest) Execute() (*GetCustomDomainResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1486,7 +1848,7 @@ func (r GetCustomDomainRequest) Execute() (*GetCustomDomainResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1496,7 +1858,7 @@ func (r GetCustomDomainRequest) Execute() (*GetCustomDomainResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1506,7 +1868,7 @@ func (r GetCustomDomainRequest) Execute() (*GetCustomDomainResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1557,6 +1919,7 @@ type GetDistributionRequest struct {
 	projectId      string
 	distributionId string
 	withWafStatus  *bool
+	queryParams    []queryParam
 }
 
 // If set, the top level of a distribution contains a &#x60;waf&#x60; property, which defines the status of the waf. This includes a list of all resolved rules.
@@ -1566,7 +1929,43 @@ func (r GetDistributionRequest) WithWafStatus(withWafStatus bool) ApiGetDistribu
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetDistributionRequest) SetQueryParam(key, value string) ApiGetDistributionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetDistributionRequest) AddQueryParam(key, value string) ApiGetDistributionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetDistributionRequest) Clone() ApiGetDistributionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetDistributionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r GetDistributionRequest) Execute() (*GetDistributionResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetDistribution", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetDistributionRequest) execute() (*GetDistributionResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1590,6 +1989,12 @@ func (r GetDistributionRequest) Execute() (*GetDistributionResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
 
 	if r.withWafStatus != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "withWafStatus", r.withWafStatus, "")
@@ -1611,7 +2016,8 @@ func (r GetDistributionRequest) Execute() (*GetDistributionResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetDistribution"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1642,10 +2048,14 @@ func (r GetDistributionRequest) Execute() (*GetDistributionResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1656,7 +2066,7 @@ func (r GetDistributionRequest) Execute() (*GetDistributionResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1667,7 +2077,7 @@ func (r GetDistributionRequest) Execute() (*GetDistributionResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1678,7 +2088,7 @@ func (r GetDistributionRequest) Execute() (*GetDistributionResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1688,7 +2098,7 @@ func (r GetDistributionRequest) Execute() (*GetDistributionResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1698,7 +2108,7 @@ func (r GetDistributionRequest) Execute() (*GetDistributionResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1755,6 +2165,7 @@ type GetLogsRequest struct {
 	requestCountryCode *string
 	statusCode         *int32
 	cacheHit           *bool
+	queryParams        []queryParam
 }
 
 // the start of the time range for which logs should be returned
@@ -1825,7 +2236,43 @@ func (r GetLogsRequest) CacheHit(cacheHit bool) ApiGetLogsRequest {
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetLogsRequest) SetQueryParam(key, value string) ApiGetLogsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetLogsRequest) AddQueryParam(key, value string) ApiGetLogsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetLogsRequest) Clone() ApiGetLogsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetLogsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r GetLogsRequest) Execute() (*GetLogsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetLogs", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetLogsRequest) execute() (*GetLogsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1849,6 +2296,12 @@ func (r GetLogsRequest) Execute() (*GetLogsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
 
 	if r.from != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
@@ -1897,7 +2350,8 @@ func (r GetLogsRequest) Execute() (*GetLogsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetLogs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1928,10 +2382,14 @@ func (r GetLogsRequest) Execute() (*GetLogsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1942,7 +2400,7 @@ func (r GetLogsRequest) Execute() (*GetLogsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1953,7 +2411,7 @@ func (r GetLogsRequest) Execute() (*GetLogsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1963,7 +2421,7 @@ func (r GetLogsRequest) Execute() (*GetLogsResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1973,7 +2431,7 @@ func (r GetLogsRequest) Execute() (*GetLogsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2021,6 +2479,7 @@ type GetStatisticsRequest struct {
 	from           *time.Time
 	to             *time.Time
 	interval       *string
+	queryParams    []queryParam
 }
 
 // the start of the time range for which statistics should be returned
@@ -2044,7 +2503,43 @@ func (r GetStatisticsRequest) Interval(interval string) ApiGetStatisticsRequest
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetStatisticsRequest) SetQueryParam(key, value string) ApiGetStatisticsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetStatisticsRequest) AddQueryParam(key, value string) ApiGetStatisticsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetStatisticsRequest) Clone() ApiGetStatisticsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetStatisticsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r GetStatisticsRequest) Execute() (*GetStatisticsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetStatistics", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetStatisticsRequest) execute() (*GetStatisticsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2068,8 +2563,14 @@ func (r GetStatisticsRequest) Execute() (*GetStatisticsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
 	if r.from == nil {
-		return localVarReturnValue, fmt.Errorf("from is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "from"}
 	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
@@ -2096,7 +2597,8 @@ func (r GetStatisticsRequest) Execute() (*GetStatisticsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetStatistics"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2127,10 +2629,14 @@ func (r GetStatisticsRequest) Execute() (*GetStatisticsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2141,7 +2647,7 @@ func (r GetStatisticsRequest) Execute() (*GetStatisticsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2152,7 +2658,7 @@ func (r GetStatisticsRequest) Execute() (*GetStatisticsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2162,7 +2668,7 @@ func (r GetStatisticsRequest) Execute() (*GetStatisticsResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2172,7 +2678,7 @@ func (r GetStatisticsRequest) Execute() (*GetStatisticsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2233,6 +2739,7 @@ type ListDistributionsRequest struct {
 	pageIdentifier *string
 	sortBy         *string
 	sortOrder      *string
+	queryParams    []queryParam
 }
 
 // Quantifies how many distributions should be returned on this  page. Must be a natural number between 1 and 100 (inclusive)
@@ -2268,7 +2775,43 @@ func (r ListDistributionsRequest) SortOrder(sortOrder string) ApiListDistributio
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListDistributionsRequest) SetQueryParam(key, value string) ApiListDistributionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListDistributionsRequest) AddQueryParam(key, value string) ApiListDistributionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListDistributionsRequest) Clone() ApiListDistributionsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListDistributionsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListDistributionsRequest) Execute() (*ListDistributionsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListDistributions", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListDistributionsRequest) execute() (*ListDistributionsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2291,6 +2834,9 @@ func (r ListDistributionsRequest) Execute() (*ListDistributionsResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	if r.pageSize != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "pageSize", r.pageSize, "")
@@ -2324,7 +2870,8 @@ func (r ListDistributionsRequest) Execute() (*ListDistributionsResponse, error)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListDistributions"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2343,6 +2890,10 @@ func (r ListDistributionsRequest) Execute() (*ListDistributionsResponse, error)
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2355,10 +2906,14 @@ func (r ListDistributionsRequest) Execute() (*ListDistributionsResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2369,7 +2924,7 @@ func (r ListDistributionsRequest) Execute() (*ListDistributionsResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2380,7 +2935,7 @@ func (r ListDistributionsRequest) Execute() (*ListDistributionsResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2390,7 +2945,7 @@ func (r ListDistributionsRequest) Execute() (*ListDistributionsResponse, error)
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2400,7 +2955,7 @@ func (r ListDistributionsRequest) Execute() (*ListDistributionsResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2441,12 +2996,49 @@ func (a *APIClient) ListDistributionsExecute(ctx context.Context, projectId stri
 }
 
 type ListWafCollectionsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListWafCollectionsRequest) SetQueryParam(key, value string) ApiListWafCollectionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListWafCollectionsRequest) AddQueryParam(key, value string) ApiListWafCollectionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListWafCollectionsRequest) Clone() ApiListWafCollectionsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListWafCollectionsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListWafCollectionsRequest) Execute() (*ListWafCollectionsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListWafCollections", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListWafCollectionsRequest) execute() (*ListWafCollectionsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2469,6 +3061,9 @@ func (r ListWafCollectionsRequest) Execute() (*ListWafCollectionsResponse, error
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2487,7 +3082,8 @@ func (r ListWafCollectionsRequest) Execute() (*ListWafCollectionsResponse, error
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListWafCollections"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2506,6 +3102,10 @@ func (r ListWafCollectionsRequest) Execute() (*ListWafCollectionsResponse, error
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2518,10 +3118,14 @@ func (r ListWafCollectionsRequest) Execute() (*ListWafCollectionsResponse, error
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2532,7 +3136,7 @@ func (r ListWafCollectionsRequest) Execute() (*ListWafCollectionsResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2543,7 +3147,7 @@ func (r ListWafCollectionsRequest) Execute() (*ListWafCollectionsResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2553,7 +3157,7 @@ func (r ListWafCollectionsRequest) Execute() (*ListWafCollectionsResponse, error
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2563,7 +3167,7 @@ func (r ListWafCollectionsRequest) Execute() (*ListWafCollectionsResponse, error
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2608,6 +3212,7 @@ type PatchDistributionRequest struct {
 	projectId                string
 	distributionId           string
 	patchDistributionPayload *PatchDistributionPayload
+	queryParams              []queryParam
 }
 
 func (r PatchDistributionRequest) PatchDistributionPayload(patchDistributionPayload PatchDistributionPayload) ApiPatchDistributionRequest {
@@ -2615,7 +3220,43 @@ func (r PatchDistributionRequest) PatchDistributionPayload(patchDistributionPayl
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PatchDistributionRequest) SetQueryParam(key, value string) ApiPatchDistributionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PatchDistributionRequest) AddQueryParam(key, value string) ApiPatchDistributionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PatchDistributionRequest) Clone() ApiPatchDistributionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PatchDistributionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PatchDistributionRequest) Execute() (*PatchDistributionResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PatchDistribution", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PatchDistributionRequest) execute() (*PatchDistributionResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -2639,6 +3280,12 @@ func (r PatchDistributionRequest) Execute() (*PatchDistributionResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -2659,7 +3306,8 @@ func (r PatchDistributionRequest) Execute() (*PatchDistributionResponse, error)
 	}
 	// body params
 	localVarPostBody = r.patchDistributionPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PatchDistribution"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2690,10 +3338,14 @@ func (r PatchDistributionRequest) Execute() (*PatchDistributionResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2704,7 +3356,7 @@ func (r PatchDistributionRequest) Execute() (*PatchDistributionResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2715,7 +3367,7 @@ func (r PatchDistributionRequest) Execute() (*PatchDistributionResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2725,7 +3377,7 @@ func (r PatchDistributionRequest) Execute() (*PatchDistributionResponse, error)
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2735,7 +3387,7 @@ func (r PatchDistributionRequest) Execute() (*PatchDistributionResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2783,6 +3435,7 @@ type PurgeCacheRequest struct {
 	projectId         string
 	distributionId    string
 	purgeCachePayload *PurgeCachePayload
+	queryParams       []queryParam
 }
 
 func (r PurgeCacheRequest) PurgeCachePayload(purgeCachePayload PurgeCachePayload) ApiPurgeCacheRequest {
@@ -2790,7 +3443,43 @@ func (r PurgeCacheRequest) PurgeCachePayload(purgeCachePayload PurgeCachePayload
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PurgeCacheRequest) SetQueryParam(key, value string) ApiPurgeCacheRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PurgeCacheRequest) AddQueryParam(key, value string) ApiPurgeCacheRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PurgeCacheRequest) Clone() ApiPurgeCacheRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PurgeCacheRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PurgeCacheRequest) Execute() (map[string]interface{}, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PurgeCache", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PurgeCacheRequest) execute() (map[string]interface{}, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2834,7 +3523,8 @@ func (r PurgeCacheRequest) Execute() (map[string]interface{}, error) {
 	}
 	// body params
 	localVarPostBody = r.purgeCachePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PurgeCache"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2865,10 +3555,14 @@ func (r PurgeCacheRequest) Execute() (map[string]interface{}, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2879,7 +3573,7 @@ func (r PurgeCacheRequest) Execute() (map[string]interface{}, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2890,7 +3584,7 @@ func (r PurgeCacheRequest) Execute() (map[string]interface{}, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2900,7 +3594,7 @@ func (r PurgeCacheRequest) Execute() (map[string]interface{}, error) {
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2910,7 +3604,7 @@ func (r PurgeCacheRequest) Execute() (map[string]interface{}, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2960,6 +3654,7 @@ type PutCustomDomainRequest struct {
 	distributionId         string
 	domain                 string
 	putCustomDomainPayload *PutCustomDomainPayload
+	queryParams            []queryParam
 }
 
 func (r PutCustomDomainRequest) PutCustomDomainPayload(putCustomDomainPayload PutCustomDomainPayload) ApiPutCustomDomainRequest {
@@ -2967,7 +3662,43 @@ func (r PutCustomDomainRequest) PutCustomDomainPayload(putCustomDomainPayload Pu
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PutCustomDomainRequest) SetQueryParam(key, value string) ApiPutCustomDomainRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PutCustomDomainRequest) AddQueryParam(key, value string) ApiPutCustomDomainRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PutCustomDomainRequest) Clone() ApiPutCustomDomainRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PutCustomDomainRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PutCustomDomainRequest) Execute() (*PutCustomDomainResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PutCustomDomain", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "distributionId", Value: ParameterValueToString(r.distributionId, "distributionId")}, oapierror.Parameter{Name: "domain", Value: ParameterValueToString(r.domain, "domain")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PutCustomDomainRequest) execute() (*PutCustomDomainResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -2992,6 +3723,15 @@ func (r PutCustomDomainRequest) Execute() (*PutCustomDomainResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.distributionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "distributionId"}
+	}
+	if r.domain == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "domain"}
+	}
 	if strlen(r.domain) > 72 {
 		return localVarReturnValue, fmt.Errorf("domain must have less than 72 elements")
 	}
@@ -3015,7 +3755,8 @@ func (r PutCustomDomainRequest) Execute() (*PutCustomDomainResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.putCustomDomainPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PutCustomDomain"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3046,10 +3787,14 @@ func (r PutCustomDomainRequest) Execute() (*PutCustomDomainResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3060,7 +3805,7 @@ func (r PutCustomDomainRequest) Execute() (*PutCustomDomainResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 422 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3071,7 +3816,7 @@ func (r PutCustomDomainRequest) Execute() (*PutCustomDomainResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericJsonResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3081,7 +3826,7 @@ func (r PutCustomDomainRequest) Execute() (*PutCustomDomainResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v GenericJsonResponse
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -3091,7 +3836,7 @@ func (r PutCustomDomainRequest) Execute() (*PutCustomDomainResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/cdn",
+		ServiceName:   "cdn",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,26 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateDistribution",
+	"DeleteCustomDomain",
+	"DeleteDistribution",
+	"FindCachePaths",
+	"GetCacheInfo",
+	"GetCustomDomain",
+	"GetDistribution",
+	"GetLogs",
+	"GetStatistics",
+	"ListDistributions",
+	"ListWafCollections",
+	"PatchDistribution",
+	"PurgeCache",
+	"PutCustomDomain",
+}
+
+func init() {
+	config.RegisterOperations("cdn", operations...)
+}
@@ -184,14 +184,9 @@ func (v *DistributionStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := DistributionStatus(value)
-	for _, existing := range AllowedDistributionStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Distribution", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewDistributionStatusFromValue returns a pointer to a valid DistributionStatus
@@ -215,6 +210,17 @@ func (v DistributionStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v DistributionStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v DistributionStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v DistributionStatus) Ptr() *DistributionStatus {
 	return &v
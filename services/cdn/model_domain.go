@@ -116,14 +116,9 @@ func (v *DomainTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := DomainTypes(value)
-	for _, existing := range AllowedDomainTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Domain", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewDomainTypesFromValue returns a pointer to a valid DomainTypes
@@ -147,6 +142,17 @@ func (v DomainTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v DomainTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v DomainTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v DomainTypes) Ptr() *DomainTypes {
 	return &v
@@ -48,14 +48,9 @@ func (v *DomainStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := DomainStatus(value)
-	for _, existing := range AllowedDomainStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid DomainStatus", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewDomainStatusFromValue returns a pointer to a valid DomainStatus
@@ -79,6 +74,17 @@ func (v DomainStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v DomainStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v DomainStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to DomainStatus value
 func (v DomainStatus) Ptr() *DomainStatus {
 	return &v
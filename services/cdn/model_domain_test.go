@@ -40,11 +40,11 @@ func TestDomainTypes_UnmarshalJSON(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -120,14 +120,9 @@ func (v *ErrorDetailsKey) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ErrorDetailsKey(value)
-	for _, existing := range AllowedErrorDetailsKeyEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ErrorDetails", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewErrorDetailsKeyFromValue returns a pointer to a valid ErrorDetailsKey
@@ -151,6 +146,17 @@ func (v ErrorDetailsKey) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ErrorDetailsKey) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ErrorDetailsKey) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyKey value
 func (v ErrorDetailsKey) Ptr() *ErrorDetailsKey {
 	return &v
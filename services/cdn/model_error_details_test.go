@@ -47,11 +47,11 @@ func TestErrorDetailsKey_UnmarshalJSON(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
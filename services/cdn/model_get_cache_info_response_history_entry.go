@@ -76,14 +76,9 @@ func (v *GetCacheInfoResponseHistoryEntryTypes) UnmarshalJSON(src []byte) error
 		return nil
 	}
 	enumTypeValue := GetCacheInfoResponseHistoryEntryTypes(value)
-	for _, existing := range AllowedGetCacheInfoResponseHistoryEntryTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid GetCacheInfoResponseHistoryEntry", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewGetCacheInfoResponseHistoryEntryTypesFromValue returns a pointer to a valid GetCacheInfoResponseHistoryEntryTypes
@@ -107,6 +102,17 @@ func (v GetCacheInfoResponseHistoryEntryTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v GetCacheInfoResponseHistoryEntryTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v GetCacheInfoResponseHistoryEntryTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v GetCacheInfoResponseHistoryEntryTypes) Ptr() *GetCacheInfoResponseHistoryEntryTypes {
 	return &v
@@ -48,14 +48,9 @@ func (v *Region) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := Region(value)
-	for _, existing := range AllowedRegionEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Region", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewRegionFromValue returns a pointer to a valid Region
@@ -79,6 +74,17 @@ func (v Region) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v Region) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v Region) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to Region value
 func (v Region) Ptr() *Region {
 	return &v
@@ -101,14 +101,9 @@ func (v *StatusErrorKey) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := StatusErrorKey(value)
-	for _, existing := range AllowedStatusErrorKeyEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid StatusError", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewStatusErrorKeyFromValue returns a pointer to a valid StatusErrorKey
@@ -132,6 +127,17 @@ func (v StatusErrorKey) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v StatusErrorKey) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v StatusErrorKey) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyKey value
 func (v StatusErrorKey) Ptr() *StatusErrorKey {
 	return &v
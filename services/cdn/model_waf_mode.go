@@ -44,14 +44,9 @@ func (v *WafMode) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := WafMode(value)
-	for _, existing := range AllowedWafModeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid WafMode", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewWafModeFromValue returns a pointer to a valid WafMode
@@ -75,6 +70,17 @@ func (v WafMode) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v WafMode) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v WafMode) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to WafMode value
 func (v WafMode) Ptr() *WafMode {
 	return &v
@@ -42,14 +42,9 @@ func (v *WafType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := WafType(value)
-	for _, existing := range AllowedWafTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid WafType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewWafTypeFromValue returns a pointer to a valid WafType
@@ -73,6 +68,17 @@ func (v WafType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v WafType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v WafType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to WafType value
 func (v WafType) Ptr() *WafType {
 	return &v
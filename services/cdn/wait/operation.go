@@ -0,0 +1,124 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/cdn"
+)
+
+// CreateDistributionPoolOperation returns the async action of CreateDistributionPoolWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with CreateDistributionPoolPollFunc. The name of
+// the operation is "cdn/CreateDistributionPool/{projectId}/{distributionId}".
+func CreateDistributionPoolOperation(api APIClientInterface, projectId, distributionId string) *lro.Operation[cdn.GetDistributionResponse] {
+	return createDistributionPoolOperationHandler(api).Operation(projectId, distributionId)
+}
+
+// CreateDistributionPoolPollFunc returns the lro.PollFunc of the operations returned by
+// CreateDistributionPoolOperation, e.g. to resume one with lro.Resume
+func CreateDistributionPoolPollFunc(api APIClientInterface) lro.PollFunc[cdn.GetDistributionResponse] {
+	return createDistributionPoolOperationHandler(api).PollFunc()
+}
+
+func createDistributionPoolOperationHandler(api APIClientInterface) lro.WaitHandler[cdn.GetDistributionResponse] {
+	return lro.WaitHandler[cdn.GetDistributionResponse]{
+		Kind: "cdn/CreateDistributionPool",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[cdn.GetDistributionResponse] {
+			return CreateDistributionPoolWaitHandler(ctx, api, ids[0], ids[1])
+		},
+	}
+}
+
+// UpdateDistributionOperation returns the async action of UpdateDistributionWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with UpdateDistributionPollFunc. The name of the operation
+// is "cdn/UpdateDistribution/{projectId}/{distributionId}".
+func UpdateDistributionOperation(api APIClientInterface, projectId, distributionId string) *lro.Operation[cdn.GetDistributionResponse] {
+	return updateDistributionOperationHandler(api).Operation(projectId, distributionId)
+}
+
+// UpdateDistributionPollFunc returns the lro.PollFunc of the operations returned by UpdateDistributionOperation, e.g.
+// to resume one with lro.Resume
+func UpdateDistributionPollFunc(api APIClientInterface) lro.PollFunc[cdn.GetDistributionResponse] {
+	return updateDistributionOperationHandler(api).PollFunc()
+}
+
+func updateDistributionOperationHandler(api APIClientInterface) lro.WaitHandler[cdn.GetDistributionResponse] {
+	return lro.WaitHandler[cdn.GetDistributionResponse]{
+		Kind: "cdn/UpdateDistribution",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[cdn.GetDistributionResponse] {
+			return UpdateDistributionWaitHandler(ctx, api, ids[0], ids[1])
+		},
+	}
+}
+
+// DeleteDistributionOperation returns the async action of DeleteDistributionWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with DeleteDistributionPollFunc. The name of the operation
+// is "cdn/DeleteDistribution/{projectId}/{distributionId}".
+func DeleteDistributionOperation(api APIClientInterface, projectId, distributionId string) *lro.Operation[cdn.GetDistributionResponse] {
+	return deleteDistributionOperationHandler(api).Operation(projectId, distributionId)
+}
+
+// DeleteDistributionPollFunc returns the lro.PollFunc of the operations returned by DeleteDistributionOperation, e.g.
+// to resume one with lro.Resume
+func DeleteDistributionPollFunc(api APIClientInterface) lro.PollFunc[cdn.GetDistributionResponse] {
+	return deleteDistributionOperationHandler(api).PollFunc()
+}
+
+func deleteDistributionOperationHandler(api APIClientInterface) lro.WaitHandler[cdn.GetDistributionResponse] {
+	return lro.WaitHandler[cdn.GetDistributionResponse]{
+		Kind: "cdn/DeleteDistribution",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[cdn.GetDistributionResponse] {
+			return DeleteDistributionWaitHandler(ctx, api, ids[0], ids[1])
+		},
+	}
+}
+
+// CreateCDNCustomDomainOperation returns the async action of CreateCDNCustomDomainWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with CreateCDNCustomDomainPollFunc. The name of
+// the operation is "cdn/CreateCDNCustomDomain/{projectId}/{distributionId}/{domain}".
+func CreateCDNCustomDomainOperation(a APIClientInterface, projectId, distributionId, domain string) *lro.Operation[cdn.CustomDomain] {
+	return createCDNCustomDomainOperationHandler(a).Operation(projectId, distributionId, domain)
+}
+
+// CreateCDNCustomDomainPollFunc returns the lro.PollFunc of the operations returned by CreateCDNCustomDomainOperation,
+// e.g. to resume one with lro.Resume
+func CreateCDNCustomDomainPollFunc(a APIClientInterface) lro.PollFunc[cdn.CustomDomain] {
+	return createCDNCustomDomainOperationHandler(a).PollFunc()
+}
+
+func createCDNCustomDomainOperationHandler(a APIClientInterface) lro.WaitHandler[cdn.CustomDomain] {
+	return lro.WaitHandler[cdn.CustomDomain]{
+		Kind: "cdn/CreateCDNCustomDomain",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[cdn.CustomDomain] {
+			return CreateCDNCustomDomainWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteCDNCustomDomainOperation returns the async action of DeleteCDNCustomDomainWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with DeleteCDNCustomDomainPollFunc. The name of
+// the operation is "cdn/DeleteCDNCustomDomain/{projectId}/{distributionId}/{domain}".
+func DeleteCDNCustomDomainOperation(a APIClientInterface, projectId, distributionId, domain string) *lro.Operation[cdn.CustomDomain] {
+	return deleteCDNCustomDomainOperationHandler(a).Operation(projectId, distributionId, domain)
+}
+
+// DeleteCDNCustomDomainPollFunc returns the lro.PollFunc of the operations returned by DeleteCDNCustomDomainOperation,
+// e.g. to resume one with lro.Resume
+func DeleteCDNCustomDomainPollFunc(a APIClientInterface) lro.PollFunc[cdn.CustomDomain] {
+	return deleteCDNCustomDomainOperationHandler(a).PollFunc()
+}
+
+func deleteCDNCustomDomainOperationHandler(a APIClientInterface) lro.WaitHandler[cdn.CustomDomain] {
+	return lro.WaitHandler[cdn.CustomDomain]{
+		Kind: "cdn/DeleteCDNCustomDomain",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[cdn.CustomDomain] {
+			return DeleteCDNCustomDomainWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateCertificate Store a TLS certificate in a project.
@@ -110,16 +113,32 @@ type DefaultApi interface {
 	ListCertificatesExecute(ctx context.Context, projectId string, region string) (*ListCertificatesResponse, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateCertificateRequest interface {
 	CreateCertificatePayload(createCertificatePayload CreateCertificatePayload) ApiCreateCertificateRequest
+	RetryOnConflict(maxAttempts int) ApiCreateCertificateRequest
+	SetQueryParam(key, value string) ApiCreateCertificateRequest
+	AddQueryParam(key, value string) ApiCreateCertificateRequest
+	Clone() ApiCreateCertificateRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateCertificateResponse, error)
 }
 
 type ApiDeleteCertificateRequest interface {
+	SetQueryParam(key, value string) ApiDeleteCertificateRequest
+	AddQueryParam(key, value string) ApiDeleteCertificateRequest
+	Clone() ApiDeleteCertificateRequest
+	Fingerprint() (string, error)
 	Execute() (map[string]interface{}, error)
 }
 
 type ApiGetCertificateRequest interface {
+	SetQueryParam(key, value string) ApiGetCertificateRequest
+	AddQueryParam(key, value string) ApiGetCertificateRequest
+	Clone() ApiGetCertificateRequest
+	Fingerprint() (string, error)
 	Execute() (*GetCertificateResponse, error)
 }
 
@@ -128,6 +147,10 @@ type ApiListCertificatesRequest interface {
 	PageSize(pageSize string) ApiListCertificatesRequest
 	// page_id is a page identifier returned by the previous response and is used to request the next page
 	PageId(pageId string) ApiListCertificatesRequest
+	SetQueryParam(key, value string) ApiListCertificatesRequest
+	AddQueryParam(key, value string) ApiListCertificatesRequest
+	Clone() ApiListCertificatesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListCertificatesResponse, error)
 }
 
@@ -140,6 +163,8 @@ type CreateCertificateRequest struct {
 	projectId                string
 	region                   string
 	createCertificatePayload *CreateCertificatePayload
+	retryOnConflict          int
+	queryParams              []queryParam
 }
 
 func (r CreateCertificateRequest) CreateCertificatePayload(createCertificatePayload CreateCertificatePayload) ApiCreateCertificateRequest {
@@ -147,7 +172,50 @@ func (r CreateCertificateRequest) CreateCertificatePayload(createCertificatePayl
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateCertificateRequest) RetryOnConflict(maxAttempts int) ApiCreateCertificateRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateCertificateRequest) SetQueryParam(key, value string) ApiCreateCertificateRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateCertificateRequest) AddQueryParam(key, value string) ApiCreateCertificateRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateCertificateRequest) Clone() ApiCreateCertificateRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateCertificateRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateCertificateRequest) Execute() (*CreateCertificateResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateCertificate", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateCertificateRequest) execute() (*CreateCertificateResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -159,7 +227,7 @@ func (r CreateCertificateRequest) Execute() (*CreateCertificateResponse, error)
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.CreateCertificate")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.CreateCertificate", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -171,8 +239,14 @@ func (r CreateCertificateRequest) Execute() (*CreateCertificateResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.createCertificatePayload == nil {
-		return localVarReturnValue, fmt.Errorf("createCertificatePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createCertificatePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -194,7 +268,8 @@ func (r CreateCertificateRequest) Execute() (*CreateCertificateResponse, error)
 	}
 	// body params
 	localVarPostBody = r.createCertificatePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateCertificate"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -225,10 +300,14 @@ func (r CreateCertificateRequest) Execute() (*CreateCertificateResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -238,7 +317,7 @@ func (r CreateCertificateRequest) Execute() (*CreateCertificateResponse, error)
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -248,7 +327,7 @@ func (r CreateCertificateRequest) Execute() (*CreateCertificateResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -291,14 +370,51 @@ func (a *APIClient) CreateCertificateExecute(ctx context.Context, projectId stri
 }
 
 type DeleteCertificateRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	id         string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	id          string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteCertificateRequest) SetQueryParam(key, value string) ApiDeleteCertificateRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteCertificateRequest) AddQueryParam(key, value string) ApiDeleteCertificateRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteCertificateRequest) Clone() ApiDeleteCertificateRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteCertificateRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteCertificateRequest) Execute() (map[string]interface{}, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteCertificate", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "id", Value: ParameterValueToString(r.id, "id")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteCertificateRequest) execute() (map[string]interface{}, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -310,7 +426,7 @@ func (r DeleteCertificateRequest) Execute() (map[string]interface{}, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteCertificate")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteCertificate", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -341,7 +457,8 @@ func (r DeleteCertificateRequest) Execute() (map[string]interface{}, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteCertificate"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -372,10 +489,14 @@ func (r DeleteCertificateRequest) Execute() (map[string]interface{}, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -385,7 +506,7 @@ func (r DeleteCertificateRequest) Execute() (map[string]interface{}, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -395,7 +516,7 @@ func (r DeleteCertificateRequest) Execute() (map[string]interface{}, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -441,14 +562,51 @@ func (a *APIClient) DeleteCertificateExecute(ctx context.Context, projectId stri
 }
 
 type GetCertificateRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	id         string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	id          string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetCertificateRequest) SetQueryParam(key, value string) ApiGetCertificateRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetCertificateRequest) AddQueryParam(key, value string) ApiGetCertificateRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetCertificateRequest) Clone() ApiGetCertificateRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetCertificateRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetCertificateRequest) Execute() (*GetCertificateResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetCertificate", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "id", Value: ParameterValueToString(r.id, "id")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetCertificateRequest) execute() (*GetCertificateResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -460,7 +618,7 @@ func (r GetCertificateRequest) Execute() (*GetCertificateResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetCertificate")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetCertificate", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -473,6 +631,15 @@ func (r GetCertificateRequest) Execute() (*GetCertificateResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.id == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "id"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -491,7 +658,8 @@ func (r GetCertificateRequest) Execute() (*GetCertificateResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetCertificate"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -522,10 +690,14 @@ func (r GetCertificateRequest) Execute() (*GetCertificateResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -535,7 +707,7 @@ func (r GetCertificateRequest) Execute() (*GetCertificateResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -545,7 +717,7 @@ func (r GetCertificateRequest) Execute() (*GetCertificateResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -591,12 +763,13 @@ func (a *APIClient) GetCertificateExecute(ctx context.Context, projectId string,
 }
 
 type ListCertificatesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	pageSize   *string
-	pageId     *string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	pageSize    *string
+	pageId      *string
+	queryParams []queryParam
 }
 
 // page_size specifies how many certificates should be returned on this page. Must be a positive number &lt;&#x3D; 1000
@@ -613,7 +786,43 @@ func (r ListCertificatesRequest) PageId(pageId string) ApiListCertificatesReques
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListCertificatesRequest) SetQueryParam(key, value string) ApiListCertificatesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListCertificatesRequest) AddQueryParam(key, value string) ApiListCertificatesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListCertificatesRequest) Clone() ApiListCertificatesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListCertificatesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListCertificatesRequest) Execute() (*ListCertificatesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListCertificates", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListCertificatesRequest) execute() (*ListCertificatesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -625,7 +834,7 @@ func (r ListCertificatesRequest) Execute() (*ListCertificatesResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListCertificates")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListCertificates", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -637,6 +846,12 @@ func (r ListCertificatesRequest) Execute() (*ListCertificatesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	if r.pageSize != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "pageSize", r.pageSize, "")
@@ -661,7 +876,8 @@ func (r ListCertificatesRequest) Execute() (*ListCertificatesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListCertificates"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -680,6 +896,10 @@ func (r ListCertificatesRequest) Execute() (*ListCertificatesResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -692,10 +912,14 @@ func (r ListCertificatesRequest) Execute() (*ListCertificatesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Status
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -705,7 +929,7 @@ func (r ListCertificatesRequest) Execute() (*ListCertificatesResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -715,7 +939,7 @@ func (r ListCertificatesRequest) Execute() (*ListCertificatesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CloneZone Clone an existing zone with all record sets to a new zone with a different name
@@ -512,61 +515,115 @@ type DefaultApi interface {
 	ValidateMoveCodeExecute(ctx context.Context, projectId string, zoneId string) (*Message, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCloneZoneRequest interface {
 	// zone to clone
 	CloneZonePayload(cloneZonePayload CloneZonePayload) ApiCloneZoneRequest
+	SetQueryParam(key, value string) ApiCloneZoneRequest
+	AddQueryParam(key, value string) ApiCloneZoneRequest
+	Clone() ApiCloneZoneRequest
+	Fingerprint() (string, error)
 	Execute() (*ZoneResponse, error)
 }
 
 type ApiCreateLabelRequest interface {
 	// record set to create
 	CreateLabelPayload(createLabelPayload CreateLabelPayload) ApiCreateLabelRequest
+	SetQueryParam(key, value string) ApiCreateLabelRequest
+	AddQueryParam(key, value string) ApiCreateLabelRequest
+	Clone() ApiCreateLabelRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateLabelResponse, error)
 }
 
 type ApiCreateMoveCodeRequest interface {
+	RetryOnConflict(maxAttempts int) ApiCreateMoveCodeRequest
+	SetQueryParam(key, value string) ApiCreateMoveCodeRequest
+	AddQueryParam(key, value string) ApiCreateMoveCodeRequest
+	Clone() ApiCreateMoveCodeRequest
+	Fingerprint() (string, error)
 	Execute() (*MoveCodeResponse, error)
 }
 
 type ApiCreateRecordSetRequest interface {
 	// record set to create
 	CreateRecordSetPayload(createRecordSetPayload CreateRecordSetPayload) ApiCreateRecordSetRequest
+	RetryOnConflict(maxAttempts int) ApiCreateRecordSetRequest
+	SetQueryParam(key, value string) ApiCreateRecordSetRequest
+	AddQueryParam(key, value string) ApiCreateRecordSetRequest
+	Clone() ApiCreateRecordSetRequest
+	Fingerprint() (string, error)
 	Execute() (*RecordSetResponse, error)
 }
 
 type ApiCreateZoneRequest interface {
 	// zone to create
 	CreateZonePayload(createZonePayload CreateZonePayload) ApiCreateZoneRequest
+	RetryOnConflict(maxAttempts int) ApiCreateZoneRequest
+	SetQueryParam(key, value string) ApiCreateZoneRequest
+	AddQueryParam(key, value string) ApiCreateZoneRequest
+	Clone() ApiCreateZoneRequest
+	Fingerprint() (string, error)
 	Execute() (*ZoneResponse, error)
 }
 
 type ApiDeleteLabelRequest interface {
+	SetQueryParam(key, value string) ApiDeleteLabelRequest
+	AddQueryParam(key, value string) ApiDeleteLabelRequest
+	Clone() ApiDeleteLabelRequest
+	Fingerprint() (string, error)
 	Execute() (*DeleteLabelResponse, error)
 }
 
 type ApiDeleteMoveCodeRequest interface {
+	SetQueryParam(key, value string) ApiDeleteMoveCodeRequest
+	AddQueryParam(key, value string) ApiDeleteMoveCodeRequest
+	Clone() ApiDeleteMoveCodeRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiDeleteRecordSetRequest interface {
+	SetQueryParam(key, value string) ApiDeleteRecordSetRequest
+	AddQueryParam(key, value string) ApiDeleteRecordSetRequest
+	Clone() ApiDeleteRecordSetRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiDeleteZoneRequest interface {
+	SetQueryParam(key, value string) ApiDeleteZoneRequest
+	AddQueryParam(key, value string) ApiDeleteZoneRequest
+	Clone() ApiDeleteZoneRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiExportRecordSetsRequest interface {
 	// export configuration
 	ExportRecordSetsPayload(exportRecordSetsPayload ExportRecordSetsPayload) ApiExportRecordSetsRequest
+	SetQueryParam(key, value string) ApiExportRecordSetsRequest
+	AddQueryParam(key, value string) ApiExportRecordSetsRequest
+	Clone() ApiExportRecordSetsRequest
+	Fingerprint() (string, error)
 	Execute() (*ZoneDataExchange, error)
 }
 
 type ApiGetRecordSetRequest interface {
+	SetQueryParam(key, value string) ApiGetRecordSetRequest
+	AddQueryParam(key, value string) ApiGetRecordSetRequest
+	Clone() ApiGetRecordSetRequest
+	Fingerprint() (string, error)
 	Execute() (*RecordSetResponse, error)
 }
 
 type ApiGetZoneRequest interface {
+	SetQueryParam(key, value string) ApiGetZoneRequest
+	AddQueryParam(key, value string) ApiGetZoneRequest
+	Clone() ApiGetZoneRequest
+	Fingerprint() (string, error)
 	Execute() (*ZoneResponse, error)
 }
 
@@ -577,10 +634,18 @@ type ApiImportRecordSetsRequest interface {
 	Format(format string) ApiImportRecordSetsRequest
 	// type of the zone import
 	ImportType(importType string) ApiImportRecordSetsRequest
+	SetQueryParam(key, value string) ApiImportRecordSetsRequest
+	AddQueryParam(key, value string) ApiImportRecordSetsRequest
+	Clone() ApiImportRecordSetsRequest
+	Fingerprint() (string, error)
 	Execute() (*ImportRecordSetsResponse, error)
 }
 
 type ApiListLabelsRequest interface {
+	SetQueryParam(key, value string) ApiListLabelsRequest
+	AddQueryParam(key, value string) ApiListLabelsRequest
+	Clone() ApiListLabelsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListLabelsResponse, error)
 }
 
@@ -649,6 +714,10 @@ type ApiListRecordSetsRequest interface {
 	OrderByState(orderByState string) ApiListRecordSetsRequest
 	// order by record count
 	OrderByRecordCount(orderByRecordCount string) ApiListRecordSetsRequest
+	SetQueryParam(key, value string) ApiListRecordSetsRequest
+	AddQueryParam(key, value string) ApiListRecordSetsRequest
+	Clone() ApiListRecordSetsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListRecordSetsResponse, error)
 }
 
@@ -741,48 +810,84 @@ type ApiListZonesRequest interface {
 	OrderByUpdateStarted(orderByUpdateStarted string) ApiListZonesRequest
 	// order by updateFinished
 	OrderByUpdateFinished(orderByUpdateFinished string) ApiListZonesRequest
+	SetQueryParam(key, value string) ApiListZonesRequest
+	AddQueryParam(key, value string) ApiListZonesRequest
+	Clone() ApiListZonesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListZonesResponse, error)
 }
 
 type ApiMoveZoneRequest interface {
 	// information about the move
 	MoveZonePayload(moveZonePayload MoveZonePayload) ApiMoveZoneRequest
+	SetQueryParam(key, value string) ApiMoveZoneRequest
+	AddQueryParam(key, value string) ApiMoveZoneRequest
+	Clone() ApiMoveZoneRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiPartialUpdateRecordRequest interface {
 	// rrset to update
 	PartialUpdateRecordPayload(partialUpdateRecordPayload PartialUpdateRecordPayload) ApiPartialUpdateRecordRequest
+	SetQueryParam(key, value string) ApiPartialUpdateRecordRequest
+	AddQueryParam(key, value string) ApiPartialUpdateRecordRequest
+	Clone() ApiPartialUpdateRecordRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiPartialUpdateRecordSetRequest interface {
 	// record set to patch
 	PartialUpdateRecordSetPayload(partialUpdateRecordSetPayload PartialUpdateRecordSetPayload) ApiPartialUpdateRecordSetRequest
+	SetQueryParam(key, value string) ApiPartialUpdateRecordSetRequest
+	AddQueryParam(key, value string) ApiPartialUpdateRecordSetRequest
+	Clone() ApiPartialUpdateRecordSetRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiPartialUpdateZoneRequest interface {
 	// zone to update
 	PartialUpdateZonePayload(partialUpdateZonePayload PartialUpdateZonePayload) ApiPartialUpdateZoneRequest
+	SetQueryParam(key, value string) ApiPartialUpdateZoneRequest
+	AddQueryParam(key, value string) ApiPartialUpdateZoneRequest
+	Clone() ApiPartialUpdateZoneRequest
+	Fingerprint() (string, error)
 	Execute() (*ZoneResponse, error)
 }
 
 type ApiRestoreRecordSetRequest interface {
+	SetQueryParam(key, value string) ApiRestoreRecordSetRequest
+	AddQueryParam(key, value string) ApiRestoreRecordSetRequest
+	Clone() ApiRestoreRecordSetRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiRestoreZoneRequest interface {
+	SetQueryParam(key, value string) ApiRestoreZoneRequest
+	AddQueryParam(key, value string) ApiRestoreZoneRequest
+	Clone() ApiRestoreZoneRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiRetrieveZoneRequest interface {
+	SetQueryParam(key, value string) ApiRetrieveZoneRequest
+	AddQueryParam(key, value string) ApiRetrieveZoneRequest
+	Clone() ApiRetrieveZoneRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiValidateMoveCodeRequest interface {
 	// information about the move
 	ValidateMoveCodePayload(validateMoveCodePayload ValidateMoveCodePayload) ApiValidateMoveCodeRequest
+	SetQueryParam(key, value string) ApiValidateMoveCodeRequest
+	AddQueryParam(key, value string) ApiValidateMoveCodeRequest
+	Clone() ApiValidateMoveCodeRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
@@ -795,6 +900,7 @@ type CloneZoneRequest struct {
 	projectId        string
 	zoneId           string
 	cloneZonePayload *CloneZonePayload
+	queryParams      []queryParam
 }
 
 // zone to clone
@@ -804,7 +910,43 @@ func (r CloneZoneRequest) CloneZonePayload(cloneZonePayload CloneZonePayload) Ap
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CloneZoneRequest) SetQueryParam(key, value string) ApiCloneZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CloneZoneRequest) AddQueryParam(key, value string) ApiCloneZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CloneZoneRequest) Clone() ApiCloneZoneRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CloneZoneRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CloneZoneRequest) Execute() (*ZoneResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CloneZone", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CloneZoneRequest) execute() (*ZoneResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -828,8 +970,14 @@ func (r CloneZoneRequest) Execute() (*ZoneResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 	if r.cloneZonePayload == nil {
-		return localVarReturnValue, fmt.Errorf("cloneZonePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "cloneZonePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -851,7 +999,8 @@ func (r CloneZoneRequest) Execute() (*ZoneResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.cloneZonePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "CloneZone"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -882,10 +1031,14 @@ func (r CloneZoneRequest) Execute() (*ZoneResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -896,7 +1049,7 @@ func (r CloneZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -907,7 +1060,7 @@ func (r CloneZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -918,7 +1071,7 @@ func (r CloneZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -929,7 +1082,7 @@ func (r CloneZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -940,7 +1093,7 @@ func (r CloneZoneRequest) Execute() (*ZoneResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -988,6 +1141,7 @@ type CreateLabelRequest struct {
 	projectId          string
 	zoneId             string
 	createLabelPayload *CreateLabelPayload
+	queryParams        []queryParam
 }
 
 // record set to create
@@ -997,7 +1151,43 @@ func (r CreateLabelRequest) CreateLabelPayload(createLabelPayload CreateLabelPay
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateLabelRequest) SetQueryParam(key, value string) ApiCreateLabelRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateLabelRequest) AddQueryParam(key, value string) ApiCreateLabelRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateLabelRequest) Clone() ApiCreateLabelRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateLabelRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateLabelRequest) Execute() (*CreateLabelResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateLabel", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateLabelRequest) execute() (*CreateLabelResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -1021,8 +1211,14 @@ func (r CreateLabelRequest) Execute() (*CreateLabelResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 	if r.createLabelPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createLabelPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createLabelPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1044,7 +1240,8 @@ func (r CreateLabelRequest) Execute() (*CreateLabelResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createLabelPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "CreateLabel"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1075,10 +1272,14 @@ func (r CreateLabelRequest) Execute() (*CreateLabelResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1089,7 +1290,7 @@ func (r CreateLabelRequest) Execute() (*CreateLabelResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1100,7 +1301,7 @@ func (r CreateLabelRequest) Execute() (*CreateLabelResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1111,7 +1312,7 @@ func (r CreateLabelRequest) Execute() (*CreateLabelResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1122,7 +1323,7 @@ func (r CreateLabelRequest) Execute() (*CreateLabelResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1165,13 +1366,58 @@ func (a *APIClient) CreateLabelExecute(ctx context.Context, projectId string, zo
 }
 
 type CreateMoveCodeRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
+	ctx             context.Context
+	apiService      *DefaultApiService
+	projectId       string
+	zoneId          string
+	retryOnConflict int
+	queryParams     []queryParam
+}
+
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateMoveCodeRequest) RetryOnConflict(maxAttempts int) ApiCreateMoveCodeRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateMoveCodeRequest) SetQueryParam(key, value string) ApiCreateMoveCodeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateMoveCodeRequest) AddQueryParam(key, value string) ApiCreateMoveCodeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateMoveCodeRequest) Clone() ApiCreateMoveCodeRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateMoveCodeRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r CreateMoveCodeRequest) Execute() (*MoveCodeResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateMoveCode", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateMoveCodeRequest) execute() (*MoveCodeResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1195,6 +1441,12 @@ func (r CreateMoveCodeRequest) Execute() (*MoveCodeResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1213,7 +1465,8 @@ func (r CreateMoveCodeRequest) Execute() (*MoveCodeResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateMoveCode"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1244,10 +1497,14 @@ func (r CreateMoveCodeRequest) Execute() (*MoveCodeResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1258,7 +1515,7 @@ func (r CreateMoveCodeRequest) Execute() (*MoveCodeResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1269,7 +1526,7 @@ func (r CreateMoveCodeRequest) Execute() (*MoveCodeResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1280,7 +1537,7 @@ func (r CreateMoveCodeRequest) Execute() (*MoveCodeResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1291,7 +1548,7 @@ func (r CreateMoveCodeRequest) Execute() (*MoveCodeResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1339,6 +1596,8 @@ type CreateRecordSetRequest struct {
 	projectId              string
 	zoneId                 string
 	createRecordSetPayload *CreateRecordSetPayload
+	retryOnConflict        int
+	queryParams            []queryParam
 }
 
 // record set to create
@@ -1348,7 +1607,50 @@ func (r CreateRecordSetRequest) CreateRecordSetPayload(createRecordSetPayload Cr
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateRecordSetRequest) RetryOnConflict(maxAttempts int) ApiCreateRecordSetRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateRecordSetRequest) SetQueryParam(key, value string) ApiCreateRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateRecordSetRequest) AddQueryParam(key, value string) ApiCreateRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateRecordSetRequest) Clone() ApiCreateRecordSetRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateRecordSetRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateRecordSetRequest) Execute() (*RecordSetResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateRecordSet", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateRecordSetRequest) execute() (*RecordSetResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1372,8 +1674,14 @@ func (r CreateRecordSetRequest) Execute() (*RecordSetResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 	if r.createRecordSetPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createRecordSetPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createRecordSetPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1395,7 +1703,8 @@ func (r CreateRecordSetRequest) Execute() (*RecordSetResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createRecordSetPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateRecordSet"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1426,10 +1735,14 @@ func (r CreateRecordSetRequest) Execute() (*RecordSetResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1440,7 +1753,7 @@ func (r CreateRecordSetRequest) Execute() (*RecordSetResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1451,7 +1764,7 @@ func (r CreateRecordSetRequest) Execute() (*RecordSetResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1462,7 +1775,7 @@ func (r CreateRecordSetRequest) Execute() (*RecordSetResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1473,7 +1786,7 @@ func (r CreateRecordSetRequest) Execute() (*RecordSetResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1484,7 +1797,7 @@ func (r CreateRecordSetRequest) Execute() (*RecordSetResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1531,6 +1844,8 @@ type CreateZoneRequest struct {
 	apiService        *DefaultApiService
 	projectId         string
 	createZonePayload *CreateZonePayload
+	retryOnConflict   int
+	queryParams       []queryParam
 }
 
 // zone to create
@@ -1540,7 +1855,50 @@ func (r CreateZoneRequest) CreateZonePayload(createZonePayload CreateZonePayload
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateZoneRequest) RetryOnConflict(maxAttempts int) ApiCreateZoneRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateZoneRequest) SetQueryParam(key, value string) ApiCreateZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateZoneRequest) AddQueryParam(key, value string) ApiCreateZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateZoneRequest) Clone() ApiCreateZoneRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateZoneRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateZoneRequest) Execute() (*ZoneResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateZone", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateZoneRequest) execute() (*ZoneResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1563,8 +1921,11 @@ func (r CreateZoneRequest) Execute() (*ZoneResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createZonePayload == nil {
-		return localVarReturnValue, fmt.Errorf("createZonePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createZonePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1586,7 +1947,8 @@ func (r CreateZoneRequest) Execute() (*ZoneResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createZonePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateZone"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1617,10 +1979,14 @@ func (r CreateZoneRequest) Execute() (*ZoneResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1631,7 +1997,7 @@ func (r CreateZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1642,7 +2008,7 @@ func (r CreateZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1653,7 +2019,7 @@ func (r CreateZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1664,7 +2030,7 @@ func (r CreateZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1675,7 +2041,7 @@ func (r CreateZoneRequest) Execute() (*ZoneResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1715,14 +2081,51 @@ func (a *APIClient) CreateZoneExecute(ctx context.Context, projectId string) (*Z
 }
 
 type DeleteLabelRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
-	key        string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	key         string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteLabelRequest) SetQueryParam(key, value string) ApiDeleteLabelRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteLabelRequest) AddQueryParam(key, value string) ApiDeleteLabelRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteLabelRequest) Clone() ApiDeleteLabelRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteLabelRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteLabelRequest) Execute() (*DeleteLabelResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteLabel", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")}, oapierror.Parameter{Name: "key", Value: ParameterValueToString(r.key, "key")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteLabelRequest) execute() (*DeleteLabelResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -1747,6 +2150,15 @@ func (r DeleteLabelRequest) Execute() (*DeleteLabelResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
+	if r.key == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "key"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1765,7 +2177,8 @@ func (r DeleteLabelRequest) Execute() (*DeleteLabelResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteLabel"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1796,10 +2209,14 @@ func (r DeleteLabelRequest) Execute() (*DeleteLabelResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1810,7 +2227,7 @@ func (r DeleteLabelRequest) Execute() (*DeleteLabelResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1821,7 +2238,7 @@ func (r DeleteLabelRequest) Execute() (*DeleteLabelResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1832,7 +2249,7 @@ func (r DeleteLabelRequest) Execute() (*DeleteLabelResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1843,7 +2260,7 @@ func (r DeleteLabelRequest) Execute() (*DeleteLabelResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1854,7 +2271,7 @@ func (r DeleteLabelRequest) Execute() (*DeleteLabelResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1900,13 +2317,50 @@ func (a *APIClient) DeleteLabelExecute(ctx context.Context, projectId string, zo
 }
 
 type DeleteMoveCodeRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteMoveCodeRequest) SetQueryParam(key, value string) ApiDeleteMoveCodeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteMoveCodeRequest) AddQueryParam(key, value string) ApiDeleteMoveCodeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteMoveCodeRequest) Clone() ApiDeleteMoveCodeRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteMoveCodeRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteMoveCodeRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteMoveCode", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteMoveCodeRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -1930,6 +2384,12 @@ func (r DeleteMoveCodeRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1948,7 +2408,8 @@ func (r DeleteMoveCodeRequest) Execute() (*Message, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteMoveCode"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1979,10 +2440,14 @@ func (r DeleteMoveCodeRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1993,7 +2458,7 @@ func (r DeleteMoveCodeRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2004,7 +2469,7 @@ func (r DeleteMoveCodeRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2015,7 +2480,7 @@ func (r DeleteMoveCodeRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2026,7 +2491,7 @@ func (r DeleteMoveCodeRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2069,14 +2534,51 @@ func (a *APIClient) DeleteMoveCodeExecute(ctx context.Context, projectId string,
 }
 
 type DeleteRecordSetRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
-	rrSetId    string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	rrSetId     string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteRecordSetRequest) SetQueryParam(key, value string) ApiDeleteRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteRecordSetRequest) AddQueryParam(key, value string) ApiDeleteRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteRecordSetRequest) Clone() ApiDeleteRecordSetRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteRecordSetRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteRecordSetRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteRecordSet", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")}, oapierror.Parameter{Name: "rrSetId", Value: ParameterValueToString(r.rrSetId, "rrSetId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteRecordSetRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -2101,6 +2603,15 @@ func (r DeleteRecordSetRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
+	if r.rrSetId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "rrSetId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2119,7 +2630,8 @@ func (r DeleteRecordSetRequest) Execute() (*Message, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteRecordSet"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2150,10 +2662,14 @@ func (r DeleteRecordSetRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2164,7 +2680,7 @@ func (r DeleteRecordSetRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2175,7 +2691,7 @@ func (r DeleteRecordSetRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2186,7 +2702,7 @@ func (r DeleteRecordSetRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2197,7 +2713,7 @@ func (r DeleteRecordSetRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2243,13 +2759,50 @@ func (a *APIClient) DeleteRecordSetExecute(ctx context.Context, projectId string
 }
 
 type DeleteZoneRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteZoneRequest) SetQueryParam(key, value string) ApiDeleteZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteZoneRequest) AddQueryParam(key, value string) ApiDeleteZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteZoneRequest) Clone() ApiDeleteZoneRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteZoneRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteZoneRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteZone", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteZoneRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -2273,6 +2826,12 @@ func (r DeleteZoneRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2291,7 +2850,8 @@ func (r DeleteZoneRequest) Execute() (*Message, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteZone"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2322,10 +2882,14 @@ func (r DeleteZoneRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2336,7 +2900,7 @@ func (r DeleteZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2347,7 +2911,7 @@ func (r DeleteZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2358,7 +2922,7 @@ func (r DeleteZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2369,7 +2933,7 @@ func (r DeleteZoneRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2417,6 +2981,7 @@ type ExportRecordSetsRequest struct {
 	projectId               string
 	zoneId                  string
 	exportRecordSetsPayload *ExportRecordSetsPayload
+	queryParams             []queryParam
 }
 
 // export configuration
@@ -2426,7 +2991,43 @@ func (r ExportRecordSetsRequest) ExportRecordSetsPayload(exportRecordSetsPayload
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ExportRecordSetsRequest) SetQueryParam(key, value string) ApiExportRecordSetsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ExportRecordSetsRequest) AddQueryParam(key, value string) ApiExportRecordSetsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ExportRecordSetsRequest) Clone() ApiExportRecordSetsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ExportRecordSetsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ExportRecordSetsRequest) Execute() (*ZoneDataExchange, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ExportRecordSets", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ExportRecordSetsRequest) execute() (*ZoneDataExchange, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2450,8 +3051,14 @@ func (r ExportRecordSetsRequest) Execute() (*ZoneDataExchange, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 	if r.exportRecordSetsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("exportRecordSetsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "exportRecordSetsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2473,7 +3080,8 @@ func (r ExportRecordSetsRequest) Execute() (*ZoneDataExchange, error) {
 	}
 	// body params
 	localVarPostBody = r.exportRecordSetsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ExportRecordSets"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2504,10 +3112,14 @@ func (r ExportRecordSetsRequest) Execute() (*ZoneDataExchange, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2518,7 +3130,7 @@ func (r ExportRecordSetsRequest) Execute() (*ZoneDataExchange, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2529,7 +3141,7 @@ func (r ExportRecordSetsRequest) Execute() (*ZoneDataExchange, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2540,7 +3152,7 @@ func (r ExportRecordSetsRequest) Execute() (*ZoneDataExchange, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2583,14 +3195,51 @@ func (a *APIClient) ExportRecordSetsExecute(ctx context.Context, projectId strin
 }
 
 type GetRecordSetRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
-	rrSetId    string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	rrSetId     string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetRecordSetRequest) SetQueryParam(key, value string) ApiGetRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetRecordSetRequest) AddQueryParam(key, value string) ApiGetRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetRecordSetRequest) Clone() ApiGetRecordSetRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetRecordSetRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetRecordSetRequest) Execute() (*RecordSetResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetRecordSet", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")}, oapierror.Parameter{Name: "rrSetId", Value: ParameterValueToString(r.rrSetId, "rrSetId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetRecordSetRequest) execute() (*RecordSetResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2615,6 +3264,15 @@ func (r GetRecordSetRequest) Execute() (*RecordSetResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
+	if r.rrSetId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "rrSetId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2633,7 +3291,8 @@ func (r GetRecordSetRequest) Execute() (*RecordSetResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetRecordSet"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2664,10 +3323,14 @@ func (r GetRecordSetRequest) Execute() (*RecordSetResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2678,7 +3341,7 @@ func (r GetRecordSetRequest) Execute() (*RecordSetResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2689,7 +3352,7 @@ func (r GetRecordSetRequest) Execute() (*RecordSetResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2700,7 +3363,7 @@ func (r GetRecordSetRequest) Execute() (*RecordSetResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2711,7 +3374,7 @@ func (r GetRecordSetRequest) Execute() (*RecordSetResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2757,13 +3420,50 @@ func (a *APIClient) GetRecordSetExecute(ctx context.Context, projectId string, z
 }
 
 type GetZoneRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetZoneRequest) SetQueryParam(key, value string) ApiGetZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetZoneRequest) AddQueryParam(key, value string) ApiGetZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetZoneRequest) Clone() ApiGetZoneRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetZoneRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetZoneRequest) Execute() (*ZoneResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetZone", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetZoneRequest) execute() (*ZoneResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2787,6 +3487,12 @@ func (r GetZoneRequest) Execute() (*ZoneResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2805,7 +3511,8 @@ func (r GetZoneRequest) Execute() (*ZoneResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetZone"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2836,10 +3543,14 @@ func (r GetZoneRequest) Execute() (*ZoneResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2850,7 +3561,7 @@ func (r GetZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2861,7 +3572,7 @@ func (r GetZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2872,7 +3583,7 @@ func (r GetZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2883,7 +3594,7 @@ func (r GetZoneRequest) Execute() (*ZoneResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2933,6 +3644,7 @@ type ImportRecordSetsRequest struct {
 	importRecordSetsPayload *ImportRecordSetsPayload
 	format                  *string
 	importType              *string
+	queryParams             []queryParam
 }
 
 // accepts all response bodies for the export endpoint
@@ -2956,7 +3668,43 @@ func (r ImportRecordSetsRequest) ImportType(importType string) ApiImportRecordSe
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ImportRecordSetsRequest) SetQueryParam(key, value string) ApiImportRecordSetsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ImportRecordSetsRequest) AddQueryParam(key, value string) ApiImportRecordSetsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ImportRecordSetsRequest) Clone() ApiImportRecordSetsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ImportRecordSetsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ImportRecordSetsRequest) Execute() (*ImportRecordSetsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ImportRecordSets", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ImportRecordSetsRequest) execute() (*ImportRecordSetsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2980,8 +3728,14 @@ func (r ImportRecordSetsRequest) Execute() (*ImportRecordSetsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 	if r.importRecordSetsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("importRecordSetsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "importRecordSetsPayload"}
 	}
 
 	if r.format != nil {
@@ -3009,7 +3763,8 @@ func (r ImportRecordSetsRequest) Execute() (*ImportRecordSetsResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.importRecordSetsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ImportRecordSets"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3040,10 +3795,14 @@ func (r ImportRecordSetsRequest) Execute() (*ImportRecordSetsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3054,7 +3813,7 @@ func (r ImportRecordSetsRequest) Execute() (*ImportRecordSetsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3065,7 +3824,7 @@ func (r ImportRecordSetsRequest) Execute() (*ImportRecordSetsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3076,7 +3835,7 @@ func (r ImportRecordSetsRequest) Execute() (*ImportRecordSetsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3087,7 +3846,7 @@ func (r ImportRecordSetsRequest) Execute() (*ImportRecordSetsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3130,13 +3889,50 @@ func (a *APIClient) ImportRecordSetsExecute(ctx context.Context, projectId strin
 }
 
 type ListLabelsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListLabelsRequest) SetQueryParam(key, value string) ApiListLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListLabelsRequest) AddQueryParam(key, value string) ApiListLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListLabelsRequest) Clone() ApiListLabelsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListLabelsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListLabels", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListLabelsRequest) execute() (*ListLabelsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3160,6 +3956,12 @@ func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3178,7 +3980,8 @@ func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListLabels"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3197,6 +4000,10 @@ func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -3209,10 +4016,14 @@ func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3223,7 +4034,7 @@ func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3234,7 +4045,7 @@ func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3245,7 +4056,7 @@ func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3256,7 +4067,7 @@ func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3267,7 +4078,7 @@ func (r ListLabelsRequest) Execute() (*ListLabelsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3346,6 +4157,7 @@ type ListRecordSetsRequest struct {
 	orderByType             *string
 	orderByState            *string
 	orderByRecordCount      *string
+	queryParams             []queryParam
 }
 
 // page
@@ -3572,7 +4384,43 @@ func (r ListRecordSetsRequest) OrderByRecordCount(orderByRecordCount string) Api
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListRecordSetsRequest) SetQueryParam(key, value string) ApiListRecordSetsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListRecordSetsRequest) AddQueryParam(key, value string) ApiListRecordSetsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListRecordSetsRequest) Clone() ApiListRecordSetsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListRecordSetsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListRecordSetsRequest) Execute() (*ListRecordSetsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListRecordSets", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListRecordSetsRequest) execute() (*ListRecordSetsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3596,6 +4444,12 @@ func (r ListRecordSetsRequest) Execute() (*ListRecordSetsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 
 	if r.page != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
@@ -3710,7 +4564,8 @@ func (r ListRecordSetsRequest) Execute() (*ListRecordSetsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListRecordSets"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3729,6 +4584,10 @@ func (r ListRecordSetsRequest) Execute() (*ListRecordSetsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -3741,10 +4600,14 @@ func (r ListRecordSetsRequest) Execute() (*ListRecordSetsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3755,7 +4618,7 @@ func (r ListRecordSetsRequest) Execute() (*ListRecordSetsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3766,7 +4629,7 @@ func (r ListRecordSetsRequest) Execute() (*ListRecordSetsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3777,7 +4640,7 @@ func (r ListRecordSetsRequest) Execute() (*ListRecordSetsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3788,7 +4651,7 @@ func (r ListRecordSetsRequest) Execute() (*ListRecordSetsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3878,6 +4741,7 @@ type ListZonesRequest struct {
 	orderByCreationFinished *string
 	orderByUpdateStarted    *string
 	orderByUpdateFinished   *string
+	queryParams             []queryParam
 }
 
 // page
@@ -4188,7 +5052,43 @@ func (r ListZonesRequest) OrderByUpdateFinished(orderByUpdateFinished string) Ap
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListZonesRequest) SetQueryParam(key, value string) ApiListZonesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListZonesRequest) AddQueryParam(key, value string) ApiListZonesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListZonesRequest) Clone() ApiListZonesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListZonesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListZonesRequest) Execute() (*ListZonesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListZones", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListZonesRequest) execute() (*ListZonesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -4211,6 +5111,9 @@ func (r ListZonesRequest) Execute() (*ListZonesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	if r.page != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
@@ -4361,7 +5264,8 @@ func (r ListZonesRequest) Execute() (*ListZonesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListZones"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4380,6 +5284,10 @@ func (r ListZonesRequest) Execute() (*ListZonesResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -4392,10 +5300,14 @@ func (r ListZonesRequest) Execute() (*ListZonesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4406,7 +5318,7 @@ func (r ListZonesRequest) Execute() (*ListZonesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4417,7 +5329,7 @@ func (r ListZonesRequest) Execute() (*ListZonesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4428,7 +5340,7 @@ func (r ListZonesRequest) Execute() (*ListZonesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4439,7 +5351,7 @@ func (r ListZonesRequest) Execute() (*ListZonesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4483,6 +5395,7 @@ type MoveZoneRequest struct {
 	apiService      *DefaultApiService
 	projectId       string
 	moveZonePayload *MoveZonePayload
+	queryParams     []queryParam
 }
 
 // information about the move
@@ -4492,7 +5405,43 @@ func (r MoveZoneRequest) MoveZonePayload(moveZonePayload MoveZonePayload) ApiMov
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r MoveZoneRequest) SetQueryParam(key, value string) ApiMoveZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r MoveZoneRequest) AddQueryParam(key, value string) ApiMoveZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r MoveZoneRequest) Clone() ApiMoveZoneRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r MoveZoneRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r MoveZoneRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "MoveZone", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r MoveZoneRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -4515,8 +5464,11 @@ func (r MoveZoneRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.moveZonePayload == nil {
-		return localVarReturnValue, fmt.Errorf("moveZonePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "moveZonePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -4538,7 +5490,8 @@ func (r MoveZoneRequest) Execute() (*Message, error) {
 	}
 	// body params
 	localVarPostBody = r.moveZonePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "MoveZone"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4569,10 +5522,14 @@ func (r MoveZoneRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4583,7 +5540,7 @@ func (r MoveZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4594,7 +5551,7 @@ func (r MoveZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4605,7 +5562,7 @@ func (r MoveZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4616,7 +5573,7 @@ func (r MoveZoneRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4662,6 +5619,7 @@ type PartialUpdateRecordRequest struct {
 	zoneId                     string
 	rrSetId                    string
 	partialUpdateRecordPayload *PartialUpdateRecordPayload
+	queryParams                []queryParam
 }
 
 // rrset to update
@@ -4671,7 +5629,43 @@ func (r PartialUpdateRecordRequest) PartialUpdateRecordPayload(partialUpdateReco
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateRecordRequest) SetQueryParam(key, value string) ApiPartialUpdateRecordRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateRecordRequest) AddQueryParam(key, value string) ApiPartialUpdateRecordRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateRecordRequest) Clone() ApiPartialUpdateRecordRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateRecordRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateRecordRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateRecord", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")}, oapierror.Parameter{Name: "rrSetId", Value: ParameterValueToString(r.rrSetId, "rrSetId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateRecordRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -4696,8 +5690,17 @@ func (r PartialUpdateRecordRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
+	if r.rrSetId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "rrSetId"}
+	}
 	if r.partialUpdateRecordPayload == nil {
-		return localVarReturnValue, fmt.Errorf("partialUpdateRecordPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "partialUpdateRecordPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -4719,7 +5722,8 @@ func (r PartialUpdateRecordRequest) Execute() (*Message, error) {
 	}
 	// body params
 	localVarPostBody = r.partialUpdateRecordPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateRecord"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4750,10 +5754,14 @@ func (r PartialUpdateRecordRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4764,7 +5772,7 @@ func (r PartialUpdateRecordRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4775,7 +5783,7 @@ func (r PartialUpdateRecordRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4786,7 +5794,7 @@ func (r PartialUpdateRecordRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4797,7 +5805,7 @@ func (r PartialUpdateRecordRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4849,6 +5857,7 @@ type PartialUpdateRecordSetRequest struct {
 	zoneId                        string
 	rrSetId                       string
 	partialUpdateRecordSetPayload *PartialUpdateRecordSetPayload
+	queryParams                   []queryParam
 }
 
 // record set to patch
@@ -4858,7 +5867,43 @@ func (r PartialUpdateRecordSetRequest) PartialUpdateRecordSetPayload(partialUpda
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateRecordSetRequest) SetQueryParam(key, value string) ApiPartialUpdateRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateRecordSetRequest) AddQueryParam(key, value string) ApiPartialUpdateRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateRecordSetRequest) Clone() ApiPartialUpdateRecordSetRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateRecordSetRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateRecordSetRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateRecordSet", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")}, oapierror.Parameter{Name: "rrSetId", Value: ParameterValueToString(r.rrSetId, "rrSetId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateRecordSetRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -4883,8 +5928,17 @@ func (r PartialUpdateRecordSetRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
+	if r.rrSetId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "rrSetId"}
+	}
 	if r.partialUpdateRecordSetPayload == nil {
-		return localVarReturnValue, fmt.Errorf("partialUpdateRecordSetPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "partialUpdateRecordSetPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -4906,7 +5960,8 @@ func (r PartialUpdateRecordSetRequest) Execute() (*Message, error) {
 	}
 	// body params
 	localVarPostBody = r.partialUpdateRecordSetPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateRecordSet"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4937,10 +5992,14 @@ func (r PartialUpdateRecordSetRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4951,7 +6010,7 @@ func (r PartialUpdateRecordSetRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4962,7 +6021,7 @@ func (r PartialUpdateRecordSetRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4973,7 +6032,7 @@ func (r PartialUpdateRecordSetRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4984,7 +6043,7 @@ func (r PartialUpdateRecordSetRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5035,6 +6094,7 @@ type PartialUpdateZoneRequest struct {
 	projectId                string
 	zoneId                   string
 	partialUpdateZonePayload *PartialUpdateZonePayload
+	queryParams              []queryParam
 }
 
 // zone to update
@@ -5044,7 +6104,43 @@ func (r PartialUpdateZoneRequest) PartialUpdateZonePayload(partialUpdateZonePayl
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateZoneRequest) SetQueryParam(key, value string) ApiPartialUpdateZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateZoneRequest) AddQueryParam(key, value string) ApiPartialUpdateZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateZoneRequest) Clone() ApiPartialUpdateZoneRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateZoneRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateZoneRequest) Execute() (*ZoneResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateZone", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateZoneRequest) execute() (*ZoneResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -5068,8 +6164,14 @@ func (r PartialUpdateZoneRequest) Execute() (*ZoneResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 	if r.partialUpdateZonePayload == nil {
-		return localVarReturnValue, fmt.Errorf("partialUpdateZonePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "partialUpdateZonePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -5091,7 +6193,8 @@ func (r PartialUpdateZoneRequest) Execute() (*ZoneResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.partialUpdateZonePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateZone"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5122,10 +6225,14 @@ func (r PartialUpdateZoneRequest) Execute() (*ZoneResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5136,7 +6243,7 @@ func (r PartialUpdateZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5147,7 +6254,7 @@ func (r PartialUpdateZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5158,7 +6265,7 @@ func (r PartialUpdateZoneRequest) Execute() (*ZoneResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5169,7 +6276,7 @@ func (r PartialUpdateZoneRequest) Execute() (*ZoneResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5212,14 +6319,51 @@ func (a *APIClient) PartialUpdateZoneExecute(ctx context.Context, projectId stri
 }
 
 type RestoreRecordSetRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
-	rrSetId    string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	rrSetId     string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r RestoreRecordSetRequest) SetQueryParam(key, value string) ApiRestoreRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r RestoreRecordSetRequest) AddQueryParam(key, value string) ApiRestoreRecordSetRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r RestoreRecordSetRequest) Clone() ApiRestoreRecordSetRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r RestoreRecordSetRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r RestoreRecordSetRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "RestoreRecordSet", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")}, oapierror.Parameter{Name: "rrSetId", Value: ParameterValueToString(r.rrSetId, "rrSetId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r RestoreRecordSetRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -5244,6 +6388,15 @@ func (r RestoreRecordSetRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
+	if r.rrSetId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "rrSetId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5262,7 +6415,8 @@ func (r RestoreRecordSetRequest) Execute() (*Message, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "RestoreRecordSet"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5293,10 +6447,14 @@ func (r RestoreRecordSetRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5307,7 +6465,7 @@ func (r RestoreRecordSetRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5318,7 +6476,7 @@ func (r RestoreRecordSetRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5329,7 +6487,7 @@ func (r RestoreRecordSetRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5340,7 +6498,7 @@ func (r RestoreRecordSetRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5386,13 +6544,50 @@ func (a *APIClient) RestoreRecordSetExecute(ctx context.Context, projectId strin
 }
 
 type RestoreZoneRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r RestoreZoneRequest) SetQueryParam(key, value string) ApiRestoreZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r RestoreZoneRequest) AddQueryParam(key, value string) ApiRestoreZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r RestoreZoneRequest) Clone() ApiRestoreZoneRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r RestoreZoneRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r RestoreZoneRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "RestoreZone", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r RestoreZoneRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -5416,6 +6611,12 @@ func (r RestoreZoneRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5434,7 +6635,8 @@ func (r RestoreZoneRequest) Execute() (*Message, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "RestoreZone"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5465,10 +6667,14 @@ func (r RestoreZoneRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5479,7 +6685,7 @@ func (r RestoreZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5490,7 +6696,7 @@ func (r RestoreZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5501,7 +6707,7 @@ func (r RestoreZoneRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5544,13 +6750,50 @@ func (a *APIClient) RestoreZoneExecute(ctx context.Context, projectId string, zo
 }
 
 type RetrieveZoneRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	zoneId     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	zoneId      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r RetrieveZoneRequest) SetQueryParam(key, value string) ApiRetrieveZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r RetrieveZoneRequest) AddQueryParam(key, value string) ApiRetrieveZoneRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r RetrieveZoneRequest) Clone() ApiRetrieveZoneRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r RetrieveZoneRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r RetrieveZoneRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "RetrieveZone", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r RetrieveZoneRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -5574,6 +6817,12 @@ func (r RetrieveZoneRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5592,7 +6841,8 @@ func (r RetrieveZoneRequest) Execute() (*Message, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "RetrieveZone"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5623,10 +6873,14 @@ func (r RetrieveZoneRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5637,7 +6891,7 @@ func (r RetrieveZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5648,7 +6902,7 @@ func (r RetrieveZoneRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5659,7 +6913,7 @@ func (r RetrieveZoneRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5707,6 +6961,7 @@ type ValidateMoveCodeRequest struct {
 	projectId               string
 	zoneId                  string
 	validateMoveCodePayload *ValidateMoveCodePayload
+	queryParams             []queryParam
 }
 
 // information about the move
@@ -5716,7 +6971,43 @@ func (r ValidateMoveCodeRequest) ValidateMoveCodePayload(validateMoveCodePayload
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ValidateMoveCodeRequest) SetQueryParam(key, value string) ApiValidateMoveCodeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ValidateMoveCodeRequest) AddQueryParam(key, value string) ApiValidateMoveCodeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ValidateMoveCodeRequest) Clone() ApiValidateMoveCodeRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ValidateMoveCodeRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ValidateMoveCodeRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ValidateMoveCode", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "zoneId", Value: ParameterValueToString(r.zoneId, "zoneId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ValidateMoveCodeRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -5740,8 +7031,14 @@ func (r ValidateMoveCodeRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.zoneId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "zoneId"}
+	}
 	if r.validateMoveCodePayload == nil {
-		return localVarReturnValue, fmt.Errorf("validateMoveCodePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "validateMoveCodePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -5763,7 +7060,8 @@ func (r ValidateMoveCodeRequest) Execute() (*Message, error) {
 	}
 	// body params
 	localVarPostBody = r.validateMoveCodePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ValidateMoveCode"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5794,10 +7092,14 @@ func (r ValidateMoveCodeRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5808,7 +7110,7 @@ func (r ValidateMoveCodeRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessage
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5819,7 +7121,7 @@ func (r ValidateMoveCodeRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5830,7 +7132,7 @@ func (r ValidateMoveCodeRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
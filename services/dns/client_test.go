@@ -0,0 +1,687 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+)
+
+func TestExecuteEmptyResponse(t *testing.T) {
+	for _, tt := range []struct {
+		desc        string
+		statusCode  int
+		contentType string
+		body        string
+	}{
+		{
+			desc:       "no_content",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			desc:        "ok_empty_body",
+			statusCode:  http.StatusOK,
+			contentType: "application/json",
+		},
+		{
+			desc:        "ok_whitespace_body",
+			statusCode:  http.StatusOK,
+			contentType: "application/json",
+			body:        "\n",
+		},
+		{
+			desc:       "accepted_whitespace_body_without_content_type",
+			statusCode: http.StatusAccepted,
+			body:       " \r\n",
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("creating API client: %v", err)
+			}
+
+			resp, err := apiClient.DeleteZoneExecute(context.Background(), "pid", "zid")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if resp != nil {
+				t.Errorf("expected zero value, got %+v", resp)
+			}
+		})
+	}
+}
+
+func TestExecuteRequiredParameters(t *testing.T) {
+	payload := &CloneZonePayload{DnsName: utils.Ptr("clone.example.com")}
+	for _, tt := range []struct {
+		desc          string
+		projectId     string
+		zoneId        string
+		payload       *CloneZonePayload
+		expectedField string
+	}{
+		{
+			desc:      "all_set",
+			projectId: "pid",
+			zoneId:    "zid",
+			payload:   payload,
+		},
+		{
+			desc:          "missing_project_id",
+			zoneId:        "zid",
+			payload:       payload,
+			expectedField: "projectId",
+		},
+		{
+			desc:          "missing_zone_id",
+			projectId:     "pid",
+			payload:       payload,
+			expectedField: "zoneId",
+		},
+		{
+			desc:          "missing_payload",
+			projectId:     "pid",
+			zoneId:        "zid",
+			expectedField: "cloneZonePayload",
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("creating API client: %v", err)
+			}
+
+			request := apiClient.CloneZone(context.Background(), tt.projectId, tt.zoneId)
+			if tt.payload != nil {
+				request = request.CloneZonePayload(*tt.payload)
+			}
+			_, err = request.Execute()
+
+			if tt.expectedField == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if calls != 1 {
+					t.Fatalf("expected request to be sent")
+				}
+				return
+			}
+			var validationErr *oapierror.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected *oapierror.ValidationError, got %v", err)
+			}
+			if validationErr.Field != tt.expectedField {
+				t.Errorf("expected field %q, got %q", tt.expectedField, validationErr.Field)
+			}
+			if calls != 0 {
+				t.Errorf("expected no request to be sent")
+			}
+		})
+	}
+}
+
+func TestForProject(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+	projectClient := apiClient.ForProject("pid")
+	if projectClient.ProjectId() != "pid" {
+		t.Errorf("expected project id %q, got %q", "pid", projectClient.ProjectId())
+	}
+
+	if _, err := projectClient.GetZoneExecute(context.Background(), "zid"); err != nil {
+		t.Fatalf("GetZoneExecute failed: %v", err)
+	}
+	if _, err := projectClient.ListZones(context.Background()).Execute(); err != nil {
+		t.Fatalf("ListZones failed: %v", err)
+	}
+
+	expected := []string{"/v1/projects/pid/zones/zid", "/v1/projects/pid/zones"}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected paths %v, got %v", expected, paths)
+	}
+	for i := range expected {
+		if paths[i] != expected[i] {
+			t.Errorf("expected path %q, got %q", expected[i], paths[i])
+		}
+	}
+}
+
+func TestNewAPIClientSharedHTTPClient(t *testing.T) {
+	var authHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{}
+	first, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithHTTPClient(httpClient), config.WithToken("first-token"))
+	if err != nil {
+		t.Fatalf("creating first API client: %v", err)
+	}
+	second, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithHTTPClient(httpClient), config.WithToken("second-token"))
+	if err != nil {
+		t.Fatalf("creating second API client: %v", err)
+	}
+	if httpClient.Transport != nil {
+		t.Fatalf("the provided HTTP client was modified")
+	}
+
+	for _, apiClient := range []*APIClient{first, second} {
+		if _, err := apiClient.DeleteZoneExecute(context.Background(), "pid", "zid"); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+	expected := []string{"Bearer first-token", "Bearer second-token"}
+	if len(authHeaders) != 2 || authHeaders[0] != expected[0] || authHeaders[1] != expected[1] {
+		t.Fatalf("expected authorization headers %v, got %v", expected, authHeaders)
+	}
+}
+
+func TestEnumUnknownValue(t *testing.T) {
+	for _, tt := range []struct {
+		desc          string
+		state         string
+		expectedKnown bool
+	}{
+		{
+			desc:          "known_value",
+			state:         "CREATE_SUCCEEDED",
+			expectedKnown: true,
+		},
+		{
+			desc:          "unknown_value",
+			state:         "MIGRATING",
+			expectedKnown: false,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			var zone Zone
+			if err := json.Unmarshal([]byte(`{"state": "`+tt.state+`"}`), &zone); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			state := zone.GetState()
+			if state.String() != tt.state {
+				t.Errorf("expected state %q to be preserved, got %q", tt.state, state.String())
+			}
+			if state.IsKnown() != tt.expectedKnown {
+				t.Errorf("expected IsKnown %t, got %t", tt.expectedKnown, state.IsKnown())
+			}
+		})
+	}
+}
+
+func TestWithErrorContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	for _, tt := range []struct {
+		desc           string
+		errorContext   bool
+		expectedPrefix string
+	}{
+		{
+			desc:           "enabled",
+			errorContext:   true,
+			expectedPrefix: "dns.GetZone(projectId=pid, zoneId=zid): ",
+		},
+		{
+			desc:         "disabled",
+			errorContext: false,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			opts := []config.ConfigurationOption{config.WithEndpoint(server.URL), config.WithoutAuthentication()}
+			if tt.errorContext {
+				opts = append(opts, config.WithErrorContext())
+			}
+			apiClient, err := NewAPIClient(opts...)
+			if err != nil {
+				t.Fatalf("creating API client: %v", err)
+			}
+
+			_, err = apiClient.GetZoneExecute(context.Background(), "pid", "zid")
+			var oapiErr *oapierror.GenericOpenAPIError
+			if !errors.As(err, &oapiErr) || oapiErr.StatusCode != http.StatusNotFound {
+				t.Fatalf("expected a wrapped *oapierror.GenericOpenAPIError with status code 404, got %v", err)
+			}
+			if expected := tt.expectedPrefix + oapiErr.Error(); err.Error() != expected {
+				t.Errorf("expected error %q, got %q", expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestPrepareRequestUploadBody(t *testing.T) {
+	apiClient, err := NewAPIClient(config.WithEndpoint("https://dns.example.com"), config.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	upload := &clients.UploadBody{Reader: pr}
+	req, err := apiClient.prepareRequest(context.Background(), "https://dns.example.com/upload", http.MethodPut, upload, map[string]string{}, url.Values{}, url.Values{}, nil)
+	if err != nil {
+		t.Fatalf("preparing request: %v", err)
+	}
+	_ = pw.Close()
+
+	if req.ContentLength != 0 {
+		t.Errorf("expected unknown content length, got %d", req.ContentLength)
+	}
+	if req.GetBody != nil {
+		t.Errorf("expected the body not to be recreatable")
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "application/octet-stream" {
+		t.Errorf("expected content type application/octet-stream, got %q", contentType)
+	}
+}
+
+func TestStreamingListDecode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"itemsPerPage":2,"rrSets":[{"id":"rid-1","name":"a.example.com."},{"id":"rid-2","name":"b.example.com."}],"totalItems":3,"totalPages":2}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication(), config.WithStreamingListDecode())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+
+	var ids []string
+	ctx := config.WithListItems(context.Background(), "rrSets", func(rrSet RecordSet) error {
+		ids = append(ids, rrSet.GetId())
+		return nil
+	})
+	resp, err := apiClient.ListRecordSets(ctx, "pid", "zid").Execute()
+	if err != nil {
+		t.Fatalf("listing record sets: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "rid-1" || ids[1] != "rid-2" {
+		t.Errorf("expected the record sets rid-1 and rid-2 to be streamed, got %v", ids)
+	}
+	if resp.RrSets != nil {
+		t.Errorf("expected the record sets not to be part of the response, got %v", *resp.RrSets)
+	}
+	if resp.GetTotalPages() != 2 || resp.GetTotalItems() != 3 {
+		t.Errorf("expected 2 pages and 3 items, got %d pages and %d items", resp.GetTotalPages(), resp.GetTotalItems())
+	}
+
+	// Without WithListItems, the response is decoded as before
+	resp, err = apiClient.ListRecordSets(context.Background(), "pid", "zid").Execute()
+	if err != nil {
+		t.Fatalf("listing record sets: %v", err)
+	}
+	if len(resp.GetRrSets()) != 2 {
+		t.Errorf("expected 2 record sets, got %d", len(resp.GetRrSets()))
+	}
+}
+
+func TestLenientFieldDecode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"rrSets":[{"id":"rid-1","state":"CREATE_SUCCEEDED"},{"id":"rid-2","state":42}],"totalItems":2}`))
+	}))
+	defer server.Close()
+
+	strictClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+	if _, err := strictClient.ListRecordSets(context.Background(), "pid", "zid").Execute(); err == nil {
+		t.Fatalf("expected the strict decoding to fail")
+	}
+
+	apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication(), config.WithLenientFieldDecode())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+	ctx, warnings := config.WithDecodeWarnings(context.Background())
+	resp, err := apiClient.ListRecordSets(ctx, "pid", "zid").Execute()
+	if err != nil {
+		t.Fatalf("listing record sets: %v", err)
+	}
+	if len(resp.GetRrSets()) != 2 || resp.GetRrSets()[1].GetId() != "rid-2" || resp.GetRrSets()[1].State != nil || resp.GetTotalItems() != 2 {
+		t.Errorf("expected both record sets without the state of rid-2, got %+v", resp)
+	}
+	if list := warnings.List(); len(list) != 1 || list[0].Path != "rrSets[1].state" {
+		t.Errorf("expected a warning for rrSets[1].state, got %v", list)
+	}
+}
+
+func TestQueryParams(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+
+	for _, tt := range []struct {
+		desc     string
+		request  func() ApiListRecordSetsRequest
+		expected url.Values
+	}{
+		{
+			desc: "set_and_add",
+			request: func() ApiListRecordSetsRequest {
+				return apiClient.ListRecordSets(context.Background(), "pid", "zid").
+					PageSize(10).
+					SetQueryParam("beta", "true").
+					AddQueryParam("tag", "a").
+					AddQueryParam("tag", "b")
+			},
+			expected: url.Values{"pageSize": {"10"}, "beta": {"true"}, "tag": {"a", "b"}},
+		},
+		{
+			desc: "replace_generated",
+			request: func() ApiListRecordSetsRequest {
+				return apiClient.ListRecordSets(context.Background(), "pid", "zid").
+					PageSize(10).
+					SetQueryParam("pageSize", "20")
+			},
+			expected: url.Values{"pageSize": {"20"}},
+		},
+		{
+			desc: "add_to_generated",
+			request: func() ApiListRecordSetsRequest {
+				return apiClient.ListRecordSets(context.Background(), "pid", "zid").
+					PageSize(10).
+					AddQueryParam("pageSize", "20")
+			},
+			expected: url.Values{"pageSize": {"10", "20"}},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			if _, err := tt.request().Execute(); err != nil {
+				t.Fatalf("listing record sets: %v", err)
+			}
+			if query.Encode() != tt.expected.Encode() {
+				t.Errorf("expected query %q, got %q", tt.expected.Encode(), query.Encode())
+			}
+		})
+	}
+
+	// The query parameters of a request are not shared with the requests derived from it
+	base := apiClient.ListRecordSets(context.Background(), "pid", "zid").AddQueryParam("tag", "a")
+	_ = base.AddQueryParam("tag", "b")
+	if _, err := base.AddQueryParam("tag", "c").Execute(); err != nil {
+		t.Fatalf("listing record sets: %v", err)
+	}
+	if expected := (url.Values{"tag": {"a", "c"}}); query.Encode() != expected.Encode() {
+		t.Errorf("expected query %q, got %q", expected.Encode(), query.Encode())
+	}
+}
+
+func TestRequestClone(t *testing.T) {
+	var mu sync.Mutex
+	queries := map[string]url.Values{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries[r.URL.Query().Get("worker")] = r.URL.Query()
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+
+	// A partially configured request is forked and executed concurrently, run with -race to detect shared state
+	template := apiClient.ListRecordSets(context.Background(), "pid", "zid").PageSize(10).AddQueryParam("tag", "shared")
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			worker := fmt.Sprint(i)
+			req := template.Clone().Page(int32(i)).AddQueryParam("tag", worker).SetQueryParam("worker", worker)
+			if _, err := req.Execute(); err != nil {
+				t.Errorf("listing record sets: %v", err)
+			}
+			if _, err := template.Execute(); err != nil {
+				t.Errorf("listing record sets with the template: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < 20; i++ {
+		worker := fmt.Sprint(i)
+		expected := url.Values{"pageSize": {"10"}, "page": {worker}, "tag": {"shared", worker}, "worker": {worker}}
+		if queries[worker].Encode() != expected.Encode() {
+			t.Errorf("expected query %q, got %q", expected.Encode(), queries[worker].Encode())
+		}
+	}
+	if expected := (url.Values{"pageSize": {"10"}, "tag": {"shared"}}); queries[""].Encode() != expected.Encode() {
+		t.Errorf("expected the template to be unchanged, got query %q", queries[""].Encode())
+	}
+}
+
+func TestRequestFingerprint(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+
+	create := func(name string) ApiCreateZoneRequest {
+		return apiClient.CreateZone(context.Background(), "pid").CreateZonePayload(CreateZonePayload{Name: utils.Ptr(name), DnsName: utils.Ptr("example.com")})
+	}
+	first, err := create("a").Fingerprint()
+	if err != nil {
+		t.Fatalf("fingerprinting the request: %v", err)
+	}
+	second, err := create("a").Fingerprint()
+	if err != nil || second != first {
+		t.Fatalf("expected the same fingerprint for the same request, got %s and %s, %v", first, second, err)
+	}
+	if other, _ := create("b").Fingerprint(); other == first {
+		t.Errorf("expected a different fingerprint for another payload")
+	}
+	if requests.Load() != 0 {
+		t.Errorf("expected no request to be sent, got %d", requests.Load())
+	}
+
+	listFirst, _ := apiClient.ListRecordSets(context.Background(), "pid", "zid").PageSize(10).AddQueryParam("tag", "a").Fingerprint()
+	listSecond, _ := apiClient.ListRecordSets(context.Background(), "pid", "zid").AddQueryParam("tag", "a").PageSize(10).Fingerprint()
+	if listFirst == "" || listFirst != listSecond {
+		t.Errorf("expected the fingerprint not to depend on the order of the query parameters, got %q and %q", listFirst, listSecond)
+	}
+
+	if _, err := apiClient.CreateZone(context.Background(), "pid").Fingerprint(); err == nil {
+		t.Errorf("expected an error for a missing payload")
+	}
+	if _, err := create("a").Execute(); err != nil || requests.Load() != 1 {
+		t.Errorf("expected the request to be sent, got %d requests, %v", requests.Load(), err)
+	}
+}
+
+func TestErrorModel(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusBadRequest)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(int(status.Load()))
+		_, _ = w.Write([]byte(`{"error": "unauthorized", "message": "invalid record"}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+	payload := CreateRecordSetPayload{
+		Name:    utils.Ptr("www"),
+		Records: &[]RecordPayload{{Content: utils.Ptr("192.0.2.1")}},
+		Type:    CreateRecordSetPayloadGetTypeAttributeType(utils.Ptr(CREATERECORDSETPAYLOADTYPE_A)),
+	}
+
+	// The error type depends on the status code, as in the API specification
+	_, err = apiClient.CreateRecordSet(context.Background(), "pid", "zid").CreateRecordSetPayload(payload).Execute()
+	if msg, ok := oapierror.ModelAs[Message](err); !ok || msg.GetMessage() != "invalid record" {
+		t.Fatalf("expected a Message model for status 400, got %v", err)
+	}
+	if _, ok := oapierror.ModelAs[ErrorMessage](err); ok {
+		t.Fatalf("expected no ErrorMessage model for status 400")
+	}
+
+	status.Store(http.StatusUnauthorized)
+	_, err = apiClient.CreateRecordSet(context.Background(), "pid", "zid").CreateRecordSetPayload(payload).Execute()
+	if msg, ok := oapierror.ModelAs[ErrorMessage](err); !ok || msg.GetError() != "unauthorized" {
+		t.Fatalf("expected an ErrorMessage model for status 401, got %v", err)
+	}
+}
+
+func TestNonJSONErrorBody(t *testing.T) {
+	body := "<html><body><h1>502 Bad Gateway</h1>" + strings.Repeat("<p>upstream unavailable</p>", 50) + "</body></html>"
+	var status atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(int(status.Load()))
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	apiClient, err := NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("creating API client: %v", err)
+	}
+
+	// 400 has an error model in the API specification, 502 hasn't
+	for _, code := range []int{http.StatusBadGateway, http.StatusBadRequest} {
+		status.Store(int32(code))
+		_, err = apiClient.GetZone(context.Background(), "pid", "zid").Execute()
+
+		var oapiErr *oapierror.GenericOpenAPIError
+		if !errors.As(err, &oapiErr) {
+			t.Fatalf("%d: expected a GenericOpenAPIError, got %v", code, err)
+		}
+		if oapiErr.StatusCode != code || oapiErr.ErrorMessage != fmt.Sprintf("%d %s", code, http.StatusText(code)) {
+			t.Errorf("%d: expected the status of the response, got %d %q", code, oapiErr.StatusCode, oapiErr.ErrorMessage)
+		}
+		if oapiErr.ContentType != "text/html; charset=utf-8" || string(oapiErr.Body) != body || oapiErr.Model != nil {
+			t.Errorf("%d: expected the raw body without model, got content type %q and model %v", code, oapiErr.ContentType, oapiErr.Model)
+		}
+		if !strings.Contains(err.Error(), "truncated") {
+			t.Errorf("%d: expected the body to be truncated in the error message, got %q", code, err.Error())
+		}
+	}
+}
+
+func TestNormalizePath(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	for _, tt := range []struct {
+		desc     string
+		endpoint string
+		opts     []config.ConfigurationOption
+		expected string
+	}{
+		{"endpoint", server.URL, nil, "/v1/projects/pid/zones"},
+		{"endpoint_trailing_slash", server.URL + "/", nil, "/v1/projects/pid/zones"},
+		{"endpoint_path_trailing_slash", server.URL + "/dns/", nil, "/dns/v1/projects/pid/zones"},
+		{"trailing_slash_policy", server.URL + "/", []config.ConfigurationOption{config.WithTrailingSlashPolicy(config.TrailingSlashAdd)}, "/v1/projects/pid/zones/"},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			opts := append([]config.ConfigurationOption{config.WithEndpoint(tt.endpoint), config.WithoutAuthentication()}, tt.opts...)
+			apiClient, err := NewAPIClient(opts...)
+			if err != nil {
+				t.Fatalf("creating API client: %v", err)
+			}
+			if _, err := apiClient.ListZonesExecute(context.Background(), "pid"); err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			if path != tt.expected {
+				t.Fatalf("expected path %q, got %q", tt.expected, path)
+			}
+		})
+	}
+}
+
+func TestRegisteredOperations(t *testing.T) {
+	api := reflect.TypeOf((*DefaultApi)(nil)).Elem()
+	checked := 0
+	for i := 0; i < api.NumMethod(); i++ {
+		name, ok := strings.CutSuffix(api.Method(i).Name, "Execute")
+		if !ok {
+			continue
+		}
+		checked++
+		if _, registered := config.IsRegisteredOperation(config.Operation{Service: "dns", Name: name}); !registered {
+			t.Errorf("expected the operation %s to be registered", name)
+		}
+	}
+	if checked == 0 {
+		t.Fatalf("expected the methods of DefaultApi to be checked")
+	}
+	if _, registered := config.IsRegisteredOperation(config.Operation{Service: "dns", Name: "CreateZon"}); registered {
+		t.Errorf("expected an unknown operation not to be registered")
+	}
+}
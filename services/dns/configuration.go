@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/dns",
+		ServiceName:   "dns",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,36 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CloneZone",
+	"CreateLabel",
+	"CreateMoveCode",
+	"CreateRecordSet",
+	"CreateZone",
+	"DeleteLabel",
+	"DeleteMoveCode",
+	"DeleteRecordSet",
+	"DeleteZone",
+	"ExportRecordSets",
+	"GetRecordSet",
+	"GetZone",
+	"ImportRecordSets",
+	"ListLabels",
+	"ListRecordSets",
+	"ListZones",
+	"MoveZone",
+	"PartialUpdateRecord",
+	"PartialUpdateRecordSet",
+	"PartialUpdateZone",
+	"RestoreRecordSet",
+	"RestoreZone",
+	"RetrieveZone",
+	"ValidateMoveCode",
+}
+
+func init() {
+	config.RegisterOperations("dns", operations...)
+}
@@ -183,14 +183,9 @@ func (v *CreateRecordSetPayloadTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CreateRecordSetPayloadTypes(value)
-	for _, existing := range AllowedCreateRecordSetPayloadTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateRecordSetPayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateRecordSetPayloadTypesFromValue returns a pointer to a valid CreateRecordSetPayloadTypes
@@ -214,6 +209,17 @@ func (v CreateRecordSetPayloadTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateRecordSetPayloadTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateRecordSetPayloadTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v CreateRecordSetPayloadTypes) Ptr() *CreateRecordSetPayloadTypes {
 	return &v
@@ -320,14 +320,9 @@ func (v *CreateZonePayloadTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CreateZonePayloadTypes(value)
-	for _, existing := range AllowedCreateZonePayloadTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateZonePayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateZonePayloadTypesFromValue returns a pointer to a valid CreateZonePayloadTypes
@@ -351,6 +346,17 @@ func (v CreateZonePayloadTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateZonePayloadTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateZonePayloadTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v CreateZonePayloadTypes) Ptr() *CreateZonePayloadTypes {
 	return &v
@@ -77,14 +77,9 @@ func (v *ExportRecordSetsPayloadFormat) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ExportRecordSetsPayloadFormat(value)
-	for _, existing := range AllowedExportRecordSetsPayloadFormatEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ExportRecordSetsPayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewExportRecordSetsPayloadFormatFromValue returns a pointer to a valid ExportRecordSetsPayloadFormat
@@ -108,6 +103,17 @@ func (v ExportRecordSetsPayloadFormat) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ExportRecordSetsPayloadFormat) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ExportRecordSetsPayloadFormat) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to FormatFormat value
 func (v ExportRecordSetsPayloadFormat) Ptr() *ExportRecordSetsPayloadFormat {
 	return &v
@@ -55,14 +55,9 @@ func (v *PartialUpdateRecordPayloadAction) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := PartialUpdateRecordPayloadAction(value)
-	for _, existing := range AllowedPartialUpdateRecordPayloadActionEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid PartialUpdateRecordPayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPartialUpdateRecordPayloadActionFromValue returns a pointer to a valid PartialUpdateRecordPayloadAction
@@ -86,6 +81,17 @@ func (v PartialUpdateRecordPayloadAction) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v PartialUpdateRecordPayloadAction) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v PartialUpdateRecordPayloadAction) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ActionAction value
 func (v PartialUpdateRecordPayloadAction) Ptr() *PartialUpdateRecordPayloadAction {
 	return &v
@@ -235,14 +235,9 @@ func (v *RecordSetState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := RecordSetState(value)
-	for _, existing := range AllowedRecordSetStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid RecordSet", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewRecordSetStateFromValue returns a pointer to a valid RecordSetState
@@ -266,6 +261,17 @@ func (v RecordSetState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v RecordSetState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v RecordSetState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v RecordSetState) Ptr() *RecordSetState {
 	return &v
@@ -425,14 +431,9 @@ func (v *RecordSetTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := RecordSetTypes(value)
-	for _, existing := range AllowedRecordSetTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid RecordSet", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewRecordSetTypesFromValue returns a pointer to a valid RecordSetTypes
@@ -456,6 +457,17 @@ func (v RecordSetTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v RecordSetTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v RecordSetTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v RecordSetTypes) Ptr() *RecordSetTypes {
 	return &v
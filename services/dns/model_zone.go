@@ -519,14 +519,9 @@ func (v *ZoneState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ZoneState(value)
-	for _, existing := range AllowedZoneStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Zone", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewZoneStateFromValue returns a pointer to a valid ZoneState
@@ -550,6 +545,17 @@ func (v ZoneState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ZoneState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ZoneState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v ZoneState) Ptr() *ZoneState {
 	return &v
@@ -643,14 +649,9 @@ func (v *ZoneTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ZoneTypes(value)
-	for _, existing := range AllowedZoneTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Zone", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewZoneTypesFromValue returns a pointer to a valid ZoneTypes
@@ -674,6 +675,17 @@ func (v ZoneTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ZoneTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ZoneTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v ZoneTypes) Ptr() *ZoneTypes {
 	return &v
@@ -807,14 +819,9 @@ func (v *ZoneVisibility) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ZoneVisibility(value)
-	for _, existing := range AllowedZoneVisibilityEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Zone", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewZoneVisibilityFromValue returns a pointer to a valid ZoneVisibility
@@ -838,6 +845,17 @@ func (v ZoneVisibility) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ZoneVisibility) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ZoneVisibility) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to VisibilityVisibility value
 func (v ZoneVisibility) Ptr() *ZoneVisibility {
 	return &v
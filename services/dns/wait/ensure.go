@@ -0,0 +1,99 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+// APIClientEnsureZoneInterface is the interface needed to ensure that a zone exists
+type APIClientEnsureZoneInterface interface {
+	CreateZone(ctx context.Context, projectId string) dns.ApiCreateZoneRequest
+	ListZones(ctx context.Context, projectId string) dns.ApiListZonesRequest
+	PartialUpdateZone(ctx context.Context, projectId, zoneId string) dns.ApiPartialUpdateZoneRequest
+}
+
+// EnsureZone makes sure that a zone with the dns name of spec exists in the project: it creates the zone or, if it
+// already exists, returns the existing one, see utils.Ensure. created reports whether the zone was created.
+// A zone which was created is returned as soon as its creation is accepted, see CreateZoneWaitHandler.
+//
+// If reconcile is true, the settings of an existing zone which differ from the ones set in spec are updated, the ones
+// not set in spec are kept. The extensions are not reconciled. See PartialUpdateZoneWaitHandler to wait for the update.
+func EnsureZone(ctx context.Context, a APIClientEnsureZoneInterface, projectId string, spec dns.CreateZonePayload, reconcile bool) (zone *dns.Zone, created bool, err error) {
+	dnsName := spec.GetDnsName()
+	zone, created, err = utils.Ensure(ctx,
+		func(ctx context.Context) (*dns.Zone, error) {
+			resp, err := a.CreateZone(ctx, projectId).CreateZonePayload(spec).Execute()
+			if err != nil {
+				return nil, err
+			}
+			return resp.Zone, nil
+		},
+		func(ctx context.Context) (*dns.Zone, error) {
+			resp, err := a.ListZones(ctx, projectId).DnsNameEq(dnsName).Execute()
+			if err != nil {
+				return nil, err
+			}
+			for _, zone := range resp.GetZones() {
+				if zone.GetDnsName() == dnsName && zone.GetState() != dns.ZONESTATE_DELETE_SUCCEEDED {
+					return &zone, nil
+				}
+			}
+			return nil, nil
+		},
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("ensure zone %q: %w", dnsName, err)
+	}
+	if created || !reconcile {
+		return zone, created, nil
+	}
+
+	payload, drifted := zoneDrift(zone, spec)
+	if !drifted {
+		return zone, false, nil
+	}
+	resp, err := a.PartialUpdateZone(ctx, projectId, zone.GetId()).PartialUpdateZonePayload(payload).Execute()
+	if err != nil {
+		return nil, false, fmt.Errorf("reconcile zone %q: %w", dnsName, err)
+	}
+	return resp.Zone, false, nil
+}
+
+// zoneDrift returns the update of the settings of zone which differ from the ones set in spec
+func zoneDrift(zone *dns.Zone, spec dns.CreateZonePayload) (payload dns.PartialUpdateZonePayload, drifted bool) {
+	if spec.Acl != nil && *spec.Acl != zone.GetAcl() {
+		payload.Acl, drifted = spec.Acl, true
+	}
+	if spec.ContactEmail != nil && *spec.ContactEmail != zone.GetContactEmail() {
+		payload.ContactEmail, drifted = spec.ContactEmail, true
+	}
+	if spec.DefaultTTL != nil && *spec.DefaultTTL != zone.GetDefaultTTL() {
+		payload.DefaultTTL, drifted = spec.DefaultTTL, true
+	}
+	if spec.Description != nil && *spec.Description != zone.GetDescription() {
+		payload.Description, drifted = spec.Description, true
+	}
+	if spec.ExpireTime != nil && *spec.ExpireTime != zone.GetExpireTime() {
+		payload.ExpireTime, drifted = spec.ExpireTime, true
+	}
+	if spec.Name != nil && *spec.Name != zone.GetName() {
+		payload.Name, drifted = spec.Name, true
+	}
+	if spec.NegativeCache != nil && *spec.NegativeCache != zone.GetNegativeCache() {
+		payload.NegativeCache, drifted = spec.NegativeCache, true
+	}
+	if spec.Primaries != nil && !slices.Equal(*spec.Primaries, zone.GetPrimaries()) {
+		payload.Primaries, drifted = spec.Primaries, true
+	}
+	if spec.RefreshTime != nil && *spec.RefreshTime != zone.GetRefreshTime() {
+		payload.RefreshTime, drifted = spec.RefreshTime, true
+	}
+	if spec.RetryTime != nil && *spec.RetryTime != zone.GetRetryTime() {
+		payload.RetryTime, drifted = spec.RetryTime, true
+	}
+	return payload, drifted
+}
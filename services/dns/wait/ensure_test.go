@@ -0,0 +1,148 @@
+package wait
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+type apiClientEnsureZoneMocked struct {
+	existing *dns.Zone
+	updated  *dns.PartialUpdateZonePayload
+}
+
+func (a *apiClientEnsureZoneMocked) CreateZone(_ context.Context, _ string) dns.ApiCreateZoneRequest {
+	return &createZoneRequestMocked{client: a}
+}
+
+func (a *apiClientEnsureZoneMocked) ListZones(_ context.Context, _ string) dns.ApiListZonesRequest {
+	return &listZonesRequestMocked{client: a}
+}
+
+func (a *apiClientEnsureZoneMocked) PartialUpdateZone(_ context.Context, _, _ string) dns.ApiPartialUpdateZoneRequest {
+	return &partialUpdateZoneRequestMocked{client: a}
+}
+
+type createZoneRequestMocked struct {
+	dns.ApiCreateZoneRequest
+	client  *apiClientEnsureZoneMocked
+	payload dns.CreateZonePayload
+}
+
+func (r *createZoneRequestMocked) CreateZonePayload(payload dns.CreateZonePayload) dns.ApiCreateZoneRequest {
+	r.payload = payload
+	return r
+}
+
+func (r *createZoneRequestMocked) Execute() (*dns.ZoneResponse, error) {
+	if r.client.existing != nil {
+		return nil, &oapierror.GenericOpenAPIError{StatusCode: http.StatusConflict}
+	}
+	return &dns.ZoneResponse{Zone: &dns.Zone{Id: utils.Ptr("new"), DnsName: r.payload.DnsName}}, nil
+}
+
+type listZonesRequestMocked struct {
+	dns.ApiListZonesRequest
+	client  *apiClientEnsureZoneMocked
+	dnsName string
+}
+
+func (r *listZonesRequestMocked) DnsNameEq(dnsName string) dns.ApiListZonesRequest {
+	r.dnsName = dnsName
+	return r
+}
+
+func (r *listZonesRequestMocked) Execute() (*dns.ListZonesResponse, error) {
+	deleted := dns.Zone{Id: utils.Ptr("deleted"), DnsName: utils.Ptr(r.dnsName), State: utils.Ptr(dns.ZONESTATE_DELETE_SUCCEEDED)}
+	return &dns.ListZonesResponse{Zones: &[]dns.Zone{deleted, *r.client.existing}}, nil
+}
+
+type partialUpdateZoneRequestMocked struct {
+	dns.ApiPartialUpdateZoneRequest
+	client *apiClientEnsureZoneMocked
+}
+
+func (r *partialUpdateZoneRequestMocked) PartialUpdateZonePayload(payload dns.PartialUpdateZonePayload) dns.ApiPartialUpdateZoneRequest {
+	r.client.updated = &payload
+	return r
+}
+
+func (r *partialUpdateZoneRequestMocked) Execute() (*dns.ZoneResponse, error) {
+	zone := *r.client.existing
+	zone.DefaultTTL = r.client.updated.DefaultTTL
+	return &dns.ZoneResponse{Zone: &zone}, nil
+}
+
+func TestEnsureZone(t *testing.T) {
+	existing := &dns.Zone{
+		Id:          utils.Ptr("existing"),
+		Name:        utils.Ptr("example"),
+		DnsName:     utils.Ptr("example.com"),
+		DefaultTTL:  utils.Ptr(int64(3600)),
+		Description: utils.Ptr("described"),
+		State:       utils.Ptr(dns.ZONESTATE_CREATE_SUCCEEDED),
+	}
+	for _, tt := range []struct {
+		desc        string
+		existing    *dns.Zone
+		spec        dns.CreateZonePayload
+		reconcile   bool
+		wantId      string
+		wantCreated bool
+		wantUpdate  *dns.PartialUpdateZonePayload
+	}{
+		{
+			desc:        "created",
+			spec:        dns.CreateZonePayload{Name: utils.Ptr("example"), DnsName: utils.Ptr("example.com")},
+			wantId:      "new",
+			wantCreated: true,
+		},
+		{
+			desc:     "already_exists",
+			existing: existing,
+			spec:     dns.CreateZonePayload{Name: utils.Ptr("example"), DnsName: utils.Ptr("example.com"), DefaultTTL: utils.Ptr(int64(60))},
+			wantId:   "existing",
+		},
+		{
+			desc:       "reconcile_drift",
+			existing:   existing,
+			spec:       dns.CreateZonePayload{Name: utils.Ptr("example"), DnsName: utils.Ptr("example.com"), DefaultTTL: utils.Ptr(int64(60))},
+			reconcile:  true,
+			wantId:     "existing",
+			wantUpdate: &dns.PartialUpdateZonePayload{DefaultTTL: utils.Ptr(int64(60))},
+		},
+		{
+			desc:      "reconcile_no_drift",
+			existing:  existing,
+			spec:      dns.CreateZonePayload{Name: utils.Ptr("example"), DnsName: utils.Ptr("example.com"), Description: utils.Ptr("described")},
+			reconcile: true,
+			wantId:    "existing",
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientEnsureZoneMocked{existing: tt.existing}
+			zone, created, err := EnsureZone(context.Background(), apiClient, "pid", tt.spec, tt.reconcile)
+			if err != nil {
+				t.Fatalf("EnsureZone failed: %v", err)
+			}
+			if zone.GetId() != tt.wantId || created != tt.wantCreated {
+				t.Fatalf("expected zone %q, created %t, got %q, created %t", tt.wantId, tt.wantCreated, zone.GetId(), created)
+			}
+			if (apiClient.updated == nil) != (tt.wantUpdate == nil) {
+				t.Fatalf("expected update %+v, got %+v", tt.wantUpdate, apiClient.updated)
+			}
+			if tt.wantUpdate != nil {
+				if apiClient.updated.DefaultTTL == nil || *apiClient.updated.DefaultTTL != *tt.wantUpdate.DefaultTTL || apiClient.updated.Name != nil {
+					t.Fatalf("expected update %+v, got %+v", tt.wantUpdate, apiClient.updated)
+				}
+				if zone.GetDefaultTTL() != 60 {
+					t.Fatalf("expected the updated zone to be returned, got %+v", zone)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+// CreateZoneOperation returns the async action of CreateZoneWaitHandler as an lro.Operation, which can be serialized to
+// resume waiting for it in another process with CreateZonePollFunc. The name of the operation is
+// "dns/CreateZone/{projectId}/{instanceId}".
+func CreateZoneOperation(a APIClientInterface, projectId, instanceId string) *lro.Operation[dns.ZoneResponse] {
+	return createZoneOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// CreateZonePollFunc returns the lro.PollFunc of the operations returned by CreateZoneOperation, e.g. to resume one
+// with lro.Resume
+func CreateZonePollFunc(a APIClientInterface) lro.PollFunc[dns.ZoneResponse] {
+	return createZoneOperationHandler(a).PollFunc()
+}
+
+func createZoneOperationHandler(a APIClientInterface) lro.WaitHandler[dns.ZoneResponse] {
+	return lro.WaitHandler[dns.ZoneResponse]{
+		Kind: "dns/CreateZone",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[dns.ZoneResponse] {
+			return CreateZoneWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// PartialUpdateZoneOperation returns the async action of PartialUpdateZoneWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with PartialUpdateZonePollFunc. The name of the operation is
+// "dns/PartialUpdateZone/{projectId}/{instanceId}".
+func PartialUpdateZoneOperation(a APIClientInterface, projectId, instanceId string) *lro.Operation[dns.ZoneResponse] {
+	return partialUpdateZoneOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// PartialUpdateZonePollFunc returns the lro.PollFunc of the operations returned by PartialUpdateZoneOperation, e.g. to
+// resume one with lro.Resume
+func PartialUpdateZonePollFunc(a APIClientInterface) lro.PollFunc[dns.ZoneResponse] {
+	return partialUpdateZoneOperationHandler(a).PollFunc()
+}
+
+func partialUpdateZoneOperationHandler(a APIClientInterface) lro.WaitHandler[dns.ZoneResponse] {
+	return lro.WaitHandler[dns.ZoneResponse]{
+		Kind: "dns/PartialUpdateZone",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[dns.ZoneResponse] {
+			return PartialUpdateZoneWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// DeleteZoneOperation returns the async action of DeleteZoneWaitHandler as an lro.Operation, which can be serialized to
+// resume waiting for it in another process with DeleteZonePollFunc. The name of the operation is
+// "dns/DeleteZone/{projectId}/{instanceId}".
+func DeleteZoneOperation(a APIClientInterface, projectId, instanceId string) *lro.Operation[dns.ZoneResponse] {
+	return deleteZoneOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// DeleteZonePollFunc returns the lro.PollFunc of the operations returned by DeleteZoneOperation, e.g. to resume one
+// with lro.Resume
+func DeleteZonePollFunc(a APIClientInterface) lro.PollFunc[dns.ZoneResponse] {
+	return deleteZoneOperationHandler(a).PollFunc()
+}
+
+func deleteZoneOperationHandler(a APIClientInterface) lro.WaitHandler[dns.ZoneResponse] {
+	return lro.WaitHandler[dns.ZoneResponse]{
+		Kind: "dns/DeleteZone",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[dns.ZoneResponse] {
+			return DeleteZoneWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// CreateRecordSetOperation returns the async action of CreateRecordSetWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateRecordSetPollFunc. The name of the operation is
+// "dns/CreateRecordSet/{projectId}/{instanceId}/{rrSetId}".
+func CreateRecordSetOperation(a APIClientInterface, projectId, instanceId, rrSetId string) *lro.Operation[dns.RecordSetResponse] {
+	return createRecordSetOperationHandler(a).Operation(projectId, instanceId, rrSetId)
+}
+
+// CreateRecordSetPollFunc returns the lro.PollFunc of the operations returned by CreateRecordSetOperation, e.g. to
+// resume one with lro.Resume
+func CreateRecordSetPollFunc(a APIClientInterface) lro.PollFunc[dns.RecordSetResponse] {
+	return createRecordSetOperationHandler(a).PollFunc()
+}
+
+func createRecordSetOperationHandler(a APIClientInterface) lro.WaitHandler[dns.RecordSetResponse] {
+	return lro.WaitHandler[dns.RecordSetResponse]{
+		Kind: "dns/CreateRecordSet",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[dns.RecordSetResponse] {
+			return CreateRecordSetWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// PartialUpdateRecordSetOperation returns the async action of PartialUpdateRecordSetWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with PartialUpdateRecordSetPollFunc. The name of
+// the operation is "dns/PartialUpdateRecordSet/{projectId}/{instanceId}/{rrSetId}".
+func PartialUpdateRecordSetOperation(a APIClientInterface, projectId, instanceId, rrSetId string) *lro.Operation[dns.RecordSetResponse] {
+	return partialUpdateRecordSetOperationHandler(a).Operation(projectId, instanceId, rrSetId)
+}
+
+// PartialUpdateRecordSetPollFunc returns the lro.PollFunc of the operations returned by
+// PartialUpdateRecordSetOperation, e.g. to resume one with lro.Resume
+func PartialUpdateRecordSetPollFunc(a APIClientInterface) lro.PollFunc[dns.RecordSetResponse] {
+	return partialUpdateRecordSetOperationHandler(a).PollFunc()
+}
+
+func partialUpdateRecordSetOperationHandler(a APIClientInterface) lro.WaitHandler[dns.RecordSetResponse] {
+	return lro.WaitHandler[dns.RecordSetResponse]{
+		Kind: "dns/PartialUpdateRecordSet",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[dns.RecordSetResponse] {
+			return PartialUpdateRecordSetWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteRecordSetOperation returns the async action of DeleteRecordSetWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteRecordSetPollFunc. The name of the operation is
+// "dns/DeleteRecordSet/{projectId}/{instanceId}/{rrSetId}".
+func DeleteRecordSetOperation(a APIClientInterface, projectId, instanceId, rrSetId string) *lro.Operation[dns.RecordSetResponse] {
+	return deleteRecordSetOperationHandler(a).Operation(projectId, instanceId, rrSetId)
+}
+
+// DeleteRecordSetPollFunc returns the lro.PollFunc of the operations returned by DeleteRecordSetOperation, e.g. to
+// resume one with lro.Resume
+func DeleteRecordSetPollFunc(a APIClientInterface) lro.PollFunc[dns.RecordSetResponse] {
+	return deleteRecordSetOperationHandler(a).PollFunc()
+}
+
+func deleteRecordSetOperationHandler(a APIClientInterface) lro.WaitHandler[dns.RecordSetResponse] {
+	return lro.WaitHandler[dns.RecordSetResponse]{
+		Kind: "dns/DeleteRecordSet",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[dns.RecordSetResponse] {
+			return DeleteRecordSetWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package wait
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func TestCreateZoneOperation(t *testing.T) {
+	apiClient := &apiClientMocked{resourceState: string(dns.ZONESTATE_CREATE_SUCCEEDED)}
+	op := CreateZoneOperation(apiClient, "pid", "zid")
+	if want := "dns/CreateZone/pid/zid"; op.Name() != want {
+		t.Fatalf("expected name %s, got %s", want, op.Name())
+	}
+
+	data, err := json.Marshal(op)
+	if err != nil {
+		t.Fatalf("marshalling operation: %v", err)
+	}
+	resumed, err := lro.Resume(data, CreateZonePollFunc(apiClient))
+	if err != nil {
+		t.Fatalf("resuming operation: %v", err)
+	}
+	resp, err := resumed.SetThrottle(time.Millisecond).Wait(context.Background())
+	if err != nil {
+		t.Fatalf("waiting for operation: %v", err)
+	}
+	if resp.Zone.GetId() != "zid" {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+
+	apiClient.resourceState = string(dns.ZONESTATE_CREATE_FAILED)
+	failed := CreateZoneOperation(apiClient, "pid", "zid").SetThrottle(time.Millisecond)
+	if _, err := failed.Wait(context.Background()); err == nil {
+		t.Fatalf("expected the failed zone creation to finish the operation with an error")
+	}
+	if !failed.Done() {
+		t.Fatalf("expected the operation to be done")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/core/wait"
 	"github.com/stackitcloud/stackit-sdk-go/services/dns"
 )
@@ -48,6 +49,14 @@ func CreateZoneWaitHandler(ctx context.Context, a APIClientInterface, projectId,
 		}
 		return false, nil, nil
 	})
+	handler.SetStateFetch(func() (*dns.ZoneResponse, string, error) {
+		s, err := a.GetZoneExecute(ctx, projectId, instanceId)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		zone := s.GetZone()
+		return s, string(zone.GetState()), nil
+	})
 	handler.SetTimeout(10 * time.Minute)
 	return handler
 }
@@ -70,6 +79,14 @@ func PartialUpdateZoneWaitHandler(ctx context.Context, a APIClientInterface, pro
 		}
 		return false, nil, nil
 	})
+	handler.SetStateFetch(func() (*dns.ZoneResponse, string, error) {
+		s, err := a.GetZoneExecute(ctx, projectId, instanceId)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		zone := s.GetZone()
+		return s, string(zone.GetState()), nil
+	})
 	handler.SetTimeout(10 * time.Minute)
 	return handler
 }
@@ -97,6 +114,26 @@ func DeleteZoneWaitHandler(ctx context.Context, a APIClientInterface, projectId,
 	return handler
 }
 
+// Interface needed for DeleteZonesAndWait
+type APIClientDeleteZoneInterface interface {
+	APIClientInterface
+	DeleteZoneExecute(ctx context.Context, projectId, zoneId string) (*dns.Message, error)
+}
+
+// DeleteZonesAndWait deletes the zones and waits for each deletion to finish, running up to concurrency deletions at the same time.
+// It doesn't stop at the first failure, the errors are returned by zone id. See utils.DeleteAll.
+func DeleteZonesAndWait(ctx context.Context, a APIClientDeleteZoneInterface, projectId string, zoneIds []string, concurrency int) map[string]error {
+	return utils.DeleteAll(ctx, zoneIds, func(ctx context.Context, zoneId string) error {
+		if _, err := a.DeleteZoneExecute(ctx, projectId, zoneId); err != nil {
+			return fmt.Errorf("delete zone: %w", err)
+		}
+		if _, err := DeleteZoneWaitHandler(ctx, a, projectId, zoneId).WaitWithContext(ctx); err != nil {
+			return fmt.Errorf("wait for zone deletion: %w", err)
+		}
+		return nil
+	}, concurrency)
+}
+
 // CreateRecordWaitHandler will wait for recordset creation
 func CreateRecordSetWaitHandler(ctx context.Context, a APIClientInterface, projectId, instanceId, rrSetId string) *wait.AsyncActionHandler[dns.RecordSetResponse] {
 	handler := wait.New(func() (waitFinished bool, response *dns.RecordSetResponse, err error) {
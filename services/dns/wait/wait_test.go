@@ -2,6 +2,7 @@ package wait
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -460,3 +461,75 @@ func TestDeleteRecordSetWaitHandler(t *testing.T) {
 		})
 	}
 }
+
+// Used for testing the deletion of multiple zones
+type apiClientDeleteZoneMocked struct {
+	apiClientMocked
+	mu          sync.Mutex
+	deleteFails map[string]bool
+	deleted     []string
+}
+
+func (a *apiClientDeleteZoneMocked) GetZoneExecute(_ context.Context, _, zoneId string) (*dns.ZoneResponse, error) {
+	return &dns.ZoneResponse{
+		Zone: &dns.Zone{
+			State: utils.Ptr(dns.ZONESTATE_DELETE_SUCCEEDED),
+			Id:    utils.Ptr(zoneId),
+		},
+	}, nil
+}
+
+func (a *apiClientDeleteZoneMocked) DeleteZoneExecute(_ context.Context, _, zoneId string) (*dns.Message, error) {
+	if a.deleteFails[zoneId] {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: 400,
+		}
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.deleted = append(a.deleted, zoneId)
+	return &dns.Message{}, nil
+}
+
+func TestDeleteZonesAndWait(t *testing.T) {
+	tests := []struct {
+		desc        string
+		deleteFails map[string]bool
+		wantFailed  []string
+		wantDeleted int
+	}{
+		{
+			desc:        "all_deleted",
+			wantFailed:  []string{},
+			wantDeleted: 3,
+		},
+		{
+			desc:        "delete_fails",
+			deleteFails: map[string]bool{"zid-2": true},
+			wantFailed:  []string{"zid-2"},
+			wantDeleted: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientDeleteZoneMocked{
+				deleteFails: tt.deleteFails,
+			}
+
+			errs := DeleteZonesAndWait(context.Background(), apiClient, "pid", []string{"zid-1", "zid-2", "zid-3"}, 2)
+
+			failed := []string{}
+			for _, zoneId := range []string{"zid-1", "zid-2", "zid-3"} {
+				if errs[zoneId] != nil {
+					failed = append(failed, zoneId)
+				}
+			}
+			if diff := cmp.Diff(tt.wantFailed, failed); diff != "" {
+				t.Fatalf("unexpected failed zones: %s", diff)
+			}
+			if len(apiClient.deleted) != tt.wantDeleted {
+				t.Fatalf("expected %d deleted zones, got %d", tt.wantDeleted, len(apiClient.deleted))
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+// Package zonefile exports the record sets of a DNS zone as a RFC 1035 zonefile and imports them from one.
+package zonefile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+// pageSize is the page size used to list the record sets of a zone while exporting it
+const pageSize = 100
+
+// Interface needed for Export and Import
+type APIClientInterface interface {
+	GetZoneExecute(ctx context.Context, projectId, zoneId string) (*dns.ZoneResponse, error)
+	ListRecordSets(ctx context.Context, projectId, zoneId string) dns.ApiListRecordSetsRequest
+	CreateRecordSet(ctx context.Context, projectId, zoneId string) dns.ApiCreateRecordSetRequest
+}
+
+// Export returns the record sets of the zone as a RFC 1035 zonefile.
+// Owner names below the zone are written relative to the $ORIGIN, record sets which were deleted are left out.
+func Export(ctx context.Context, a APIClientInterface, projectId, zoneId string) ([]byte, error) {
+	zoneResp, err := a.GetZoneExecute(ctx, projectId, zoneId)
+	if err != nil {
+		return nil, fmt.Errorf("get zone: %w", err)
+	}
+	if zoneResp == nil || zoneResp.Zone == nil {
+		return nil, fmt.Errorf("get zone: the response is not valid: the zone is missing")
+	}
+	origin := fqdn(zoneResp.Zone.GetDnsName())
+
+	var rrSets []dns.RecordSet
+	for page := int32(1); ; page++ {
+		resp, err := a.ListRecordSets(ctx, projectId, zoneId).Page(page).PageSize(pageSize).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("list record sets: %w", err)
+		}
+		if resp == nil {
+			return nil, fmt.Errorf("list record sets: the response is not valid: the response is empty")
+		}
+		rrSets = append(rrSets, resp.GetRrSets()...)
+		if int64(page) >= resp.GetTotalPages() {
+			break
+		}
+	}
+
+	sort.SliceStable(rrSets, func(i, j int) bool {
+		nameI, nameJ := fqdn(rrSets[i].GetName()), fqdn(rrSets[j].GetName())
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+		typeI, typeJ := rrSets[i].GetType(), rrSets[j].GetType()
+		// The SOA record goes first, as zonefiles usually start with it
+		if (typeI == dns.RECORDSETTYPE_SOA) != (typeJ == dns.RECORDSETTYPE_SOA) {
+			return typeI == dns.RECORDSETTYPE_SOA
+		}
+		return typeI < typeJ
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "$ORIGIN %s\n", origin)
+	if ttl, ok := zoneResp.Zone.GetDefaultTTLOk(); ok {
+		fmt.Fprintf(&buf, "$TTL %d\n", ttl)
+	}
+	for i := range rrSets {
+		rrSet := &rrSets[i]
+		if rrSet.GetState() == dns.RECORDSETSTATE_DELETE_SUCCEEDED {
+			continue
+		}
+		owner := relativeName(fqdn(rrSet.GetName()), origin)
+		for _, record := range rrSet.GetRecords() {
+			fmt.Fprintf(&buf, "%s\t%d\tIN\t%s\t%s\n", owner, rrSet.GetTtl(), rrSet.GetType(), record.GetContent())
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// Import parses the RFC 1035 zonefile and creates a record set for each owner name and type in it,
+// running up to concurrency creations at the same time. The zone name is used as $ORIGIN until the zonefile sets one.
+// The SOA record and the NS records of the zone apex are skipped, as they are managed by the DNS service.
+//
+// A zonefile which can't be parsed fails before any record set is created.
+// Otherwise, it doesn't stop at the first failure, the errors are returned by "<name> <type>", e.g. "www.example.com. A".
+// The record sets are created asynchronously, wait.CreateRecordSetWaitHandler can be used to wait for them.
+func Import(ctx context.Context, a APIClientInterface, projectId, zoneId string, data []byte, concurrency int) (map[string]error, error) {
+	zoneResp, err := a.GetZoneExecute(ctx, projectId, zoneId)
+	if err != nil {
+		return nil, fmt.Errorf("get zone: %w", err)
+	}
+	if zoneResp == nil || zoneResp.Zone == nil {
+		return nil, fmt.Errorf("get zone: the response is not valid: the zone is missing")
+	}
+
+	keys, payloads, err := parseZonefile(data, fqdn(zoneResp.Zone.GetDnsName()))
+	if err != nil {
+		return nil, fmt.Errorf("parse zonefile: %w", err)
+	}
+
+	return utils.RunAll(ctx, keys, func(ctx context.Context, key string) error {
+		if _, err := a.CreateRecordSet(ctx, projectId, zoneId).CreateRecordSetPayload(payloads[key]).Execute(); err != nil {
+			return fmt.Errorf("create record set: %w", err)
+		}
+		return nil
+	}, concurrency), nil
+}
+
+// zonefileLine is a logical line of a zonefile, i.e. a directive or a record which may span several lines using parentheses
+type zonefileLine struct {
+	number int
+	// inheritOwner is set if the line starts with a blank, in which case the owner of the previous record is used
+	inheritOwner bool
+	fields       []string
+}
+
+// splitZonefile splits the zonefile into its logical lines, dropping comments and parentheses.
+// Quoted strings are kept as a single field, including the quotes.
+func splitZonefile(data []byte) ([]zonefileLine, error) {
+	var (
+		lines   []zonefileLine
+		current = zonefileLine{number: 1}
+		field   strings.Builder
+		number  = 1
+		depth   = 0
+		quoted  = false
+		escaped = false
+		comment = false
+		start   = true
+	)
+	flush := func() {
+		if field.Len() > 0 {
+			current.fields = append(current.fields, field.String())
+			field.Reset()
+		}
+	}
+
+	for _, c := range string(data) {
+		if start {
+			start = false
+			current.inheritOwner = c == ' ' || c == '\t'
+		}
+		switch {
+		case c == '\n':
+			if quoted {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+			}
+			escaped, comment = false, false
+			number++
+			flush()
+			if depth > 0 {
+				continue
+			}
+			if len(current.fields) > 0 {
+				lines = append(lines, current)
+			}
+			current = zonefileLine{number: number}
+			start = true
+		case comment:
+		case escaped:
+			escaped = false
+			field.WriteRune(c)
+		case c == '\\':
+			escaped = true
+			field.WriteRune(c)
+		case c == '"':
+			quoted = !quoted
+			field.WriteRune(c)
+		case quoted:
+			field.WriteRune(c)
+		case c == ';':
+			comment = true
+		case c == '(':
+			flush()
+			depth++
+		case c == ')':
+			flush()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced closing parenthesis", number)
+			}
+			depth--
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		default:
+			field.WriteRune(c)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced opening parenthesis", current.number)
+	}
+	flush()
+	if len(current.fields) > 0 {
+		lines = append(lines, current)
+	}
+	return lines, nil
+}
+
+// zonefileNameFields is the index of the domain name in the data of the record types which reference one,
+// such names are made absolute when importing
+var zonefileNameFields = map[dns.CreateRecordSetPayloadTypes]int{
+	dns.CREATERECORDSETPAYLOADTYPE_CNAME: 0,
+	dns.CREATERECORDSETPAYLOADTYPE_NS:    0,
+	dns.CREATERECORDSETPAYLOADTYPE_PTR:   0,
+	dns.CREATERECORDSETPAYLOADTYPE_DNAME: 0,
+	dns.CREATERECORDSETPAYLOADTYPE_ALIAS: 0,
+	dns.CREATERECORDSETPAYLOADTYPE_MX:    1,
+	dns.CREATERECORDSETPAYLOADTYPE_SRV:   3,
+}
+
+// parseZonefile parses the zonefile into the payloads to create its record sets, keyed by "<name> <type>".
+// The keys are returned in the order in which the record sets first appear in the zonefile.
+func parseZonefile(data []byte, apex string) ([]string, map[string]dns.CreateRecordSetPayload, error) {
+	lines, err := splitZonefile(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		keys       []string
+		payloads   = map[string]dns.CreateRecordSetPayload{}
+		seen       = map[string]map[string]bool{}
+		origin     = apex
+		defaultTTL *int64
+		owner      string
+	)
+	for _, line := range lines {
+		fields := line.fields
+		if !line.inheritOwner && strings.HasPrefix(fields[0], "$") {
+			directive := strings.ToUpper(fields[0])
+			switch directive {
+			case "$ORIGIN":
+				if len(fields) != 2 {
+					return nil, nil, fmt.Errorf("line %d: $ORIGIN expects a single domain name", line.number)
+				}
+				origin = absoluteName(fields[1], origin)
+			case "$TTL":
+				if len(fields) != 2 {
+					return nil, nil, fmt.Errorf("line %d: $TTL expects a single TTL", line.number)
+				}
+				ttl, err := parseTTL(fields[1])
+				if err != nil {
+					return nil, nil, fmt.Errorf("line %d: %w", line.number, err)
+				}
+				defaultTTL = &ttl
+			default:
+				return nil, nil, fmt.Errorf("line %d: unsupported directive %s", line.number, directive)
+			}
+			continue
+		}
+
+		if line.inheritOwner {
+			if owner == "" {
+				return nil, nil, fmt.Errorf("line %d: the first record has no owner name", line.number)
+			}
+		} else {
+			owner = absoluteName(fields[0], origin)
+			fields = fields[1:]
+		}
+
+		// The TTL and the class are optional and may come in any order
+		ttl := defaultTTL
+		hasClass := false
+		for len(fields) > 0 {
+			if !hasClass && strings.EqualFold(fields[0], "IN") {
+				hasClass = true
+				fields = fields[1:]
+				continue
+			}
+			if v, err := parseTTL(fields[0]); err == nil && ttl == defaultTTL {
+				ttl = &v
+				fields = fields[1:]
+				continue
+			}
+			break
+		}
+		if len(fields) < 2 {
+			return nil, nil, fmt.Errorf("line %d: the record type or data is missing", line.number)
+		}
+
+		rrType := dns.CreateRecordSetPayloadTypes(strings.ToUpper(fields[0]))
+		if !rrType.IsValid() {
+			return nil, nil, fmt.Errorf("line %d: unsupported record type %s", line.number, fields[0])
+		}
+		if rrType == dns.CREATERECORDSETPAYLOADTYPE_SOA || (rrType == dns.CREATERECORDSETPAYLOADTYPE_NS && owner == apex) {
+			continue
+		}
+
+		rdata := append([]string{}, fields[1:]...)
+		if i, ok := zonefileNameFields[rrType]; ok && i < len(rdata) {
+			rdata[i] = absoluteName(rdata[i], origin)
+		}
+		content := strings.Join(rdata, " ")
+
+		key := fmt.Sprintf("%s %s", owner, rrType)
+		payload, ok := payloads[key]
+		if !ok {
+			keys = append(keys, key)
+			seen[key] = map[string]bool{}
+			payload = dns.CreateRecordSetPayload{
+				Name:    utils.Ptr(owner),
+				Type:    dns.CreateRecordSetPayloadGetTypeAttributeType(&rrType),
+				Ttl:     ttl,
+				Records: &[]dns.RecordPayload{},
+			}
+		}
+		if !seen[key][content] {
+			seen[key][content] = true
+			*payload.Records = append(*payload.Records, dns.RecordPayload{Content: utils.Ptr(content)})
+		}
+		payloads[key] = payload
+	}
+	return keys, payloads, nil
+}
+
+// parseTTL parses a TTL in seconds or in the BIND format, e.g. "1h30m"
+func parseTTL(s string) (int64, error) {
+	if v, err := strconv.ParseUint(s, 10, 31); err == nil {
+		return int64(v), nil
+	}
+
+	var ttl, v int64
+	digits := false
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			v = v*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := map[rune]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}[c]
+		if !ok || !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		ttl += v * unit
+		v, digits = 0, false
+	}
+	if digits || ttl > 1<<31-1 {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return ttl, nil
+}
+
+// fqdn returns the name with a trailing dot
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// absoluteName returns the owner name of a zonefile as an absolute domain name, relative names are appended to the origin
+func absoluteName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "." + origin
+}
+
+// relativeName returns the shortest form of the name in a zonefile with the given origin
+func relativeName(name, origin string) string {
+	if name == origin {
+		return "@"
+	}
+	if strings.HasSuffix(name, "."+origin) {
+		return strings.TrimSuffix(name, "."+origin)
+	}
+	return name
+}
@@ -0,0 +1,249 @@
+package zonefile
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+type apiClientZonefileMocked struct {
+	pages       [][]dns.RecordSet
+	mu          sync.Mutex
+	createFails map[string]bool
+	created     map[string]dns.CreateRecordSetPayload
+}
+
+func (a *apiClientZonefileMocked) GetZoneExecute(_ context.Context, _, _ string) (*dns.ZoneResponse, error) {
+	return &dns.ZoneResponse{
+		Zone: &dns.Zone{
+			DnsName:    utils.Ptr("example.com"),
+			DefaultTTL: utils.Ptr(int64(3600)),
+		},
+	}, nil
+}
+
+func (a *apiClientZonefileMocked) ListRecordSets(_ context.Context, _, _ string) dns.ApiListRecordSetsRequest {
+	return &listRecordSetsRequestMocked{client: a}
+}
+
+func (a *apiClientZonefileMocked) CreateRecordSet(_ context.Context, _, _ string) dns.ApiCreateRecordSetRequest {
+	return &createRecordSetRequestMocked{client: a}
+}
+
+type listRecordSetsRequestMocked struct {
+	dns.ApiListRecordSetsRequest
+	client *apiClientZonefileMocked
+	page   int32
+}
+
+func (r *listRecordSetsRequestMocked) Page(page int32) dns.ApiListRecordSetsRequest {
+	r.page = page
+	return r
+}
+
+func (r *listRecordSetsRequestMocked) PageSize(_ int32) dns.ApiListRecordSetsRequest {
+	return r
+}
+
+func (r *listRecordSetsRequestMocked) Execute() (*dns.ListRecordSetsResponse, error) {
+	return &dns.ListRecordSetsResponse{
+		RrSets:     &r.client.pages[r.page-1],
+		TotalPages: utils.Ptr(int64(len(r.client.pages))),
+	}, nil
+}
+
+type createRecordSetRequestMocked struct {
+	dns.ApiCreateRecordSetRequest
+	client  *apiClientZonefileMocked
+	payload dns.CreateRecordSetPayload
+}
+
+func (r *createRecordSetRequestMocked) CreateRecordSetPayload(payload dns.CreateRecordSetPayload) dns.ApiCreateRecordSetRequest {
+	r.payload = payload
+	return r
+}
+
+func (r *createRecordSetRequestMocked) Execute() (*dns.RecordSetResponse, error) {
+	key := *r.payload.Name + " " + string(*r.payload.Type)
+	if r.client.createFails[key] {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: 400,
+		}
+	}
+	r.client.mu.Lock()
+	defer r.client.mu.Unlock()
+	r.client.created[key] = r.payload
+	return &dns.RecordSetResponse{}, nil
+}
+
+func recordSet(name string, rrType dns.RecordSetTypes, ttl int64, contents ...string) dns.RecordSet {
+	records := []dns.Record{}
+	for _, content := range contents {
+		records = append(records, dns.Record{Content: utils.Ptr(content)})
+	}
+	return dns.RecordSet{
+		Name:    utils.Ptr(name),
+		Type:    dns.RecordSetGetTypeAttributeType(&rrType),
+		Ttl:     utils.Ptr(ttl),
+		Records: &records,
+	}
+}
+
+func TestExport(t *testing.T) {
+	deleted := recordSet("old.example.com.", dns.RECORDSETTYPE_A, 60, "192.0.2.9")
+	deleted.State = dns.RecordSetGetStateAttributeType(utils.Ptr(dns.RECORDSETSTATE_DELETE_SUCCEEDED))
+
+	apiClient := &apiClientZonefileMocked{
+		pages: [][]dns.RecordSet{
+			{
+				recordSet("www.example.com.", dns.RECORDSETTYPE_A, 300, "192.0.2.1", "192.0.2.2"),
+				recordSet("example.com.", dns.RECORDSETTYPE_NS, 3600, "ns1.example.net."),
+			},
+			{
+				recordSet("example.com.", dns.RECORDSETTYPE_SOA, 3600, "ns1.example.net. hostmaster.example.com. 1 3600 600 1209600 60"),
+				recordSet("mail.example.org.", dns.RECORDSETTYPE_A, 300, "192.0.2.3"),
+				deleted,
+			},
+		},
+	}
+
+	data, err := Export(context.Background(), apiClient, "pid", "zid")
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	want := "$ORIGIN example.com.\n" +
+		"$TTL 3600\n" +
+		"@\t3600\tIN\tSOA\tns1.example.net. hostmaster.example.com. 1 3600 600 1209600 60\n" +
+		"@\t3600\tIN\tNS\tns1.example.net.\n" +
+		"mail.example.org.\t300\tIN\tA\t192.0.2.3\n" +
+		"www\t300\tIN\tA\t192.0.2.1\n" +
+		"www\t300\tIN\tA\t192.0.2.2\n"
+	if diff := cmp.Diff(want, string(data)); diff != "" {
+		t.Fatalf("unexpected zonefile: %s", diff)
+	}
+}
+
+func TestImport(t *testing.T) {
+	zonefile := `$TTL 1h
+@	IN	SOA	ns1.example.net. hostmaster.example.com. (
+		2024010101 ; serial
+		3600       ; refresh
+		600        ; retry
+		1209600    ; expire
+		60 )       ; minimum
+	IN	NS	ns1.example.net.
+	IN	MX	10 mail
+www	300	IN	A	192.0.2.1
+	IN	300	A	192.0.2.2
+	A	192.0.2.1
+txt	TXT	"v=spf1 -all; a \"quoted\" (text)"
+$ORIGIN sub.example.com.
+api	CNAME	www.example.com.
+srv	SRV	0 5 443 api
+`
+	wantCreated := map[string]dns.CreateRecordSetPayload{
+		"example.com. MX": {
+			Name:    utils.Ptr("example.com."),
+			Type:    dns.CreateRecordSetPayloadGetTypeAttributeType(utils.Ptr(dns.CREATERECORDSETPAYLOADTYPE_MX)),
+			Ttl:     utils.Ptr(int64(3600)),
+			Records: &[]dns.RecordPayload{{Content: utils.Ptr("10 mail.example.com.")}},
+		},
+		"www.example.com. A": {
+			Name:    utils.Ptr("www.example.com."),
+			Type:    dns.CreateRecordSetPayloadGetTypeAttributeType(utils.Ptr(dns.CREATERECORDSETPAYLOADTYPE_A)),
+			Ttl:     utils.Ptr(int64(300)),
+			Records: &[]dns.RecordPayload{{Content: utils.Ptr("192.0.2.1")}, {Content: utils.Ptr("192.0.2.2")}},
+		},
+		"txt.example.com. TXT": {
+			Name:    utils.Ptr("txt.example.com."),
+			Type:    dns.CreateRecordSetPayloadGetTypeAttributeType(utils.Ptr(dns.CREATERECORDSETPAYLOADTYPE_TXT)),
+			Ttl:     utils.Ptr(int64(3600)),
+			Records: &[]dns.RecordPayload{{Content: utils.Ptr(`"v=spf1 -all; a \"quoted\" (text)"`)}},
+		},
+		"api.sub.example.com. CNAME": {
+			Name:    utils.Ptr("api.sub.example.com."),
+			Type:    dns.CreateRecordSetPayloadGetTypeAttributeType(utils.Ptr(dns.CREATERECORDSETPAYLOADTYPE_CNAME)),
+			Ttl:     utils.Ptr(int64(3600)),
+			Records: &[]dns.RecordPayload{{Content: utils.Ptr("www.example.com.")}},
+		},
+		"srv.sub.example.com. SRV": {
+			Name:    utils.Ptr("srv.sub.example.com."),
+			Type:    dns.CreateRecordSetPayloadGetTypeAttributeType(utils.Ptr(dns.CREATERECORDSETPAYLOADTYPE_SRV)),
+			Ttl:     utils.Ptr(int64(3600)),
+			Records: &[]dns.RecordPayload{{Content: utils.Ptr("0 5 443 api.sub.example.com.")}},
+		},
+	}
+
+	apiClient := &apiClientZonefileMocked{
+		createFails: map[string]bool{"api.sub.example.com. CNAME": true},
+		created:     map[string]dns.CreateRecordSetPayload{},
+	}
+	errs, err := Import(context.Background(), apiClient, "pid", "zid", []byte(zonefile), 2)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	if len(errs) != 1 || errs["api.sub.example.com. CNAME"] == nil {
+		t.Fatalf("expected only the CNAME record set to fail, got %v", errs)
+	}
+	delete(wantCreated, "api.sub.example.com. CNAME")
+	if diff := cmp.Diff(wantCreated, apiClient.created); diff != "" {
+		t.Fatalf("unexpected created record sets: %s", diff)
+	}
+}
+
+func TestImportInvalid(t *testing.T) {
+	tests := []struct {
+		desc     string
+		zonefile string
+	}{
+		{
+			desc:     "unbalanced_parenthesis",
+			zonefile: "www A ( 192.0.2.1\n",
+		},
+		{
+			desc:     "unterminated_quote",
+			zonefile: "www TXT \"text\n",
+		},
+		{
+			desc:     "unsupported_directive",
+			zonefile: "$INCLUDE other.zone\n",
+		},
+		{
+			desc:     "unsupported_type",
+			zonefile: "www IN WKS 192.0.2.1 TCP\n",
+		},
+		{
+			desc:     "missing_owner",
+			zonefile: "\tA 192.0.2.1\n",
+		},
+		{
+			desc:     "missing_data",
+			zonefile: "www 300 IN A\n",
+		},
+		{
+			desc:     "invalid_ttl",
+			zonefile: "$TTL 1x\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientZonefileMocked{
+				created: map[string]dns.CreateRecordSetPayload{},
+			}
+			_, err := Import(context.Background(), apiClient, "pid", "zid", []byte(tt.zonefile), 2)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if len(apiClient.created) != 0 {
+				t.Fatalf("expected no record sets to be created, got %d", len(apiClient.created))
+			}
+		})
+	}
+}
@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateInstance Create an Instance.
@@ -159,34 +162,66 @@ type DefaultApi interface {
 	PatchInstanceExecute(ctx context.Context, projectId string, instanceId string) (*Instance, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateInstanceRequest interface {
 	// Instance configuration options.
 	CreateInstancePayload(createInstancePayload CreateInstancePayload) ApiCreateInstanceRequest
+	RetryOnConflict(maxAttempts int) ApiCreateInstanceRequest
+	SetQueryParam(key, value string) ApiCreateInstanceRequest
+	AddQueryParam(key, value string) ApiCreateInstanceRequest
+	Clone() ApiCreateInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*Instance, error)
 }
 
 type ApiDeleteInstanceRequest interface {
+	SetQueryParam(key, value string) ApiDeleteInstanceRequest
+	AddQueryParam(key, value string) ApiDeleteInstanceRequest
+	Clone() ApiDeleteInstanceRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiGetInstanceRequest interface {
+	SetQueryParam(key, value string) ApiGetInstanceRequest
+	AddQueryParam(key, value string) ApiGetInstanceRequest
+	Clone() ApiGetInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*Instance, error)
 }
 
 type ApiListFlavorsRequest interface {
+	SetQueryParam(key, value string) ApiListFlavorsRequest
+	AddQueryParam(key, value string) ApiListFlavorsRequest
+	Clone() ApiListFlavorsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListFlavors, error)
 }
 
 type ApiListInstancesRequest interface {
+	SetQueryParam(key, value string) ApiListInstancesRequest
+	AddQueryParam(key, value string) ApiListInstancesRequest
+	Clone() ApiListInstancesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListInstances, error)
 }
 
 type ApiListRunnerLabelsRequest interface {
+	SetQueryParam(key, value string) ApiListRunnerLabelsRequest
+	AddQueryParam(key, value string) ApiListRunnerLabelsRequest
+	Clone() ApiListRunnerLabelsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListRunnerLabels, error)
 }
 
 type ApiPatchInstanceRequest interface {
 	PatchOperation(patchOperation []PatchOperation) ApiPatchInstanceRequest
+	SetQueryParam(key, value string) ApiPatchInstanceRequest
+	AddQueryParam(key, value string) ApiPatchInstanceRequest
+	Clone() ApiPatchInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*Instance, error)
 }
 
@@ -198,6 +233,8 @@ type CreateInstanceRequest struct {
 	apiService            *DefaultApiService
 	projectId             string
 	createInstancePayload *CreateInstancePayload
+	retryOnConflict       int
+	queryParams           []queryParam
 }
 
 // Instance configuration options.
@@ -207,7 +244,50 @@ func (r CreateInstanceRequest) CreateInstancePayload(createInstancePayload Creat
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateInstanceRequest) RetryOnConflict(maxAttempts int) ApiCreateInstanceRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateInstanceRequest) SetQueryParam(key, value string) ApiCreateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateInstanceRequest) AddQueryParam(key, value string) ApiCreateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateInstanceRequest) Clone() ApiCreateInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateInstanceRequest) Execute() (*Instance, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateInstanceRequest) execute() (*Instance, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -230,6 +310,9 @@ func (r CreateInstanceRequest) Execute() (*Instance, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -237,7 +320,7 @@ func (r CreateInstanceRequest) Execute() (*Instance, error) {
 		return localVarReturnValue, fmt.Errorf("projectId must have less than 36 elements")
 	}
 	if r.createInstancePayload == nil {
-		return localVarReturnValue, fmt.Errorf("createInstancePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -259,7 +342,8 @@ func (r CreateInstanceRequest) Execute() (*Instance, error) {
 	}
 	// body params
 	localVarPostBody = r.createInstancePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -290,10 +374,14 @@ func (r CreateInstanceRequest) Execute() (*Instance, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -304,7 +392,7 @@ func (r CreateInstanceRequest) Execute() (*Instance, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v UnauthorizedResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -315,7 +403,7 @@ func (r CreateInstanceRequest) Execute() (*Instance, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -326,7 +414,7 @@ func (r CreateInstanceRequest) Execute() (*Instance, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -337,7 +425,7 @@ func (r CreateInstanceRequest) Execute() (*Instance, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -377,13 +465,50 @@ func (a *APIClient) CreateInstanceExecute(ctx context.Context, projectId string)
 }
 
 type DeleteInstanceRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteInstanceRequest) SetQueryParam(key, value string) ApiDeleteInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteInstanceRequest) AddQueryParam(key, value string) ApiDeleteInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteInstanceRequest) Clone() ApiDeleteInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteInstanceRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")})
+	}
+	return err
+}
+
+func (r DeleteInstanceRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -406,6 +531,12 @@ func (r DeleteInstanceRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return &oapierror.ValidationError{Field: "instanceId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -436,7 +567,8 @@ func (r DeleteInstanceRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -467,10 +599,14 @@ func (r DeleteInstanceRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -481,7 +617,7 @@ func (r DeleteInstanceRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v UnauthorizedResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -492,7 +628,7 @@ func (r DeleteInstanceRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -536,13 +672,50 @@ func (a *APIClient) DeleteInstanceExecute(ctx context.Context, projectId string,
 }
 
 type GetInstanceRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetInstanceRequest) SetQueryParam(key, value string) ApiGetInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetInstanceRequest) AddQueryParam(key, value string) ApiGetInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetInstanceRequest) Clone() ApiGetInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetInstanceRequest) Execute() (*Instance, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetInstanceRequest) execute() (*Instance, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -566,6 +739,12 @@ func (r GetInstanceRequest) Execute() (*Instance, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -596,7 +775,8 @@ func (r GetInstanceRequest) Execute() (*Instance, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -627,10 +807,14 @@ func (r GetInstanceRequest) Execute() (*Instance, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -641,7 +825,7 @@ func (r GetInstanceRequest) Execute() (*Instance, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v UnauthorizedResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -652,7 +836,7 @@ func (r GetInstanceRequest) Execute() (*Instance, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -663,7 +847,7 @@ func (r GetInstanceRequest) Execute() (*Instance, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -706,12 +890,49 @@ func (a *APIClient) GetInstanceExecute(ctx context.Context, projectId string, in
 }
 
 type ListFlavorsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListFlavorsRequest) SetQueryParam(key, value string) ApiListFlavorsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListFlavorsRequest) AddQueryParam(key, value string) ApiListFlavorsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListFlavorsRequest) Clone() ApiListFlavorsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListFlavorsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListFlavorsRequest) Execute() (*ListFlavors, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListFlavors", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListFlavorsRequest) execute() (*ListFlavors, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -734,6 +955,9 @@ func (r ListFlavorsRequest) Execute() (*ListFlavors, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -758,7 +982,8 @@ func (r ListFlavorsRequest) Execute() (*ListFlavors, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListFlavors"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -777,6 +1002,10 @@ func (r ListFlavorsRequest) Execute() (*ListFlavors, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -789,10 +1018,14 @@ func (r ListFlavorsRequest) Execute() (*ListFlavors, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -803,7 +1036,7 @@ func (r ListFlavorsRequest) Execute() (*ListFlavors, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v UnauthorizedResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -814,7 +1047,7 @@ func (r ListFlavorsRequest) Execute() (*ListFlavors, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -825,7 +1058,7 @@ func (r ListFlavorsRequest) Execute() (*ListFlavors, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -865,12 +1098,49 @@ func (a *APIClient) ListFlavorsExecute(ctx context.Context, projectId string) (*
 }
 
 type ListInstancesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListInstancesRequest) SetQueryParam(key, value string) ApiListInstancesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListInstancesRequest) AddQueryParam(key, value string) ApiListInstancesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListInstancesRequest) Clone() ApiListInstancesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListInstancesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListInstancesRequest) Execute() (*ListInstances, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListInstances", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListInstancesRequest) execute() (*ListInstances, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -893,6 +1163,9 @@ func (r ListInstancesRequest) Execute() (*ListInstances, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -917,7 +1190,8 @@ func (r ListInstancesRequest) Execute() (*ListInstances, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListInstances"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -936,6 +1210,10 @@ func (r ListInstancesRequest) Execute() (*ListInstances, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -948,10 +1226,14 @@ func (r ListInstancesRequest) Execute() (*ListInstances, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -962,7 +1244,7 @@ func (r ListInstancesRequest) Execute() (*ListInstances, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v UnauthorizedResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -973,7 +1255,7 @@ func (r ListInstancesRequest) Execute() (*ListInstances, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -984,7 +1266,7 @@ func (r ListInstancesRequest) Execute() (*ListInstances, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1024,12 +1306,49 @@ func (a *APIClient) ListInstancesExecute(ctx context.Context, projectId string)
 }
 
 type ListRunnerLabelsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListRunnerLabelsRequest) SetQueryParam(key, value string) ApiListRunnerLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListRunnerLabelsRequest) AddQueryParam(key, value string) ApiListRunnerLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListRunnerLabelsRequest) Clone() ApiListRunnerLabelsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListRunnerLabelsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListRunnerLabelsRequest) Execute() (*ListRunnerLabels, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListRunnerLabels", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListRunnerLabelsRequest) execute() (*ListRunnerLabels, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1052,6 +1371,9 @@ func (r ListRunnerLabelsRequest) Execute() (*ListRunnerLabels, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -1076,7 +1398,8 @@ func (r ListRunnerLabelsRequest) Execute() (*ListRunnerLabels, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListRunnerLabels"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1095,6 +1418,10 @@ func (r ListRunnerLabelsRequest) Execute() (*ListRunnerLabels, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1107,10 +1434,14 @@ func (r ListRunnerLabelsRequest) Execute() (*ListRunnerLabels, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1121,7 +1452,7 @@ func (r ListRunnerLabelsRequest) Execute() (*ListRunnerLabels, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v UnauthorizedResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1132,7 +1463,7 @@ func (r ListRunnerLabelsRequest) Execute() (*ListRunnerLabels, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1143,7 +1474,7 @@ func (r ListRunnerLabelsRequest) Execute() (*ListRunnerLabels, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1188,6 +1519,7 @@ type PatchInstanceRequest struct {
 	projectId      string
 	instanceId     string
 	patchOperation *[]PatchOperation
+	queryParams    []queryParam
 }
 
 func (r PatchInstanceRequest) PatchOperation(patchOperation []PatchOperation) ApiPatchInstanceRequest {
@@ -1195,7 +1527,43 @@ func (r PatchInstanceRequest) PatchOperation(patchOperation []PatchOperation) Ap
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PatchInstanceRequest) SetQueryParam(key, value string) ApiPatchInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PatchInstanceRequest) AddQueryParam(key, value string) ApiPatchInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PatchInstanceRequest) Clone() ApiPatchInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PatchInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PatchInstanceRequest) Execute() (*Instance, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PatchInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PatchInstanceRequest) execute() (*Instance, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -1219,6 +1587,12 @@ func (r PatchInstanceRequest) Execute() (*Instance, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -1232,7 +1606,7 @@ func (r PatchInstanceRequest) Execute() (*Instance, error) {
 		return localVarReturnValue, fmt.Errorf("instanceId must have less than 36 elements")
 	}
 	if r.patchOperation == nil {
-		return localVarReturnValue, fmt.Errorf("patchOperation is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "patchOperation"}
 	}
 
 	// to determine the Content-Type header
@@ -1254,7 +1628,8 @@ func (r PatchInstanceRequest) Execute() (*Instance, error) {
 	}
 	// body params
 	localVarPostBody = r.patchOperation
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PatchInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1285,10 +1660,14 @@ func (r PatchInstanceRequest) Execute() (*Instance, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1299,7 +1678,7 @@ func (r PatchInstanceRequest) Execute() (*Instance, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v UnauthorizedResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1310,7 +1689,7 @@ func (r PatchInstanceRequest) Execute() (*Instance, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v GenericErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1321,7 +1700,7 @@ func (r PatchInstanceRequest) Execute() (*Instance, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/git",
+		ServiceName:   "git",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,19 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateInstance",
+	"DeleteInstance",
+	"GetInstance",
+	"ListFlavors",
+	"ListInstances",
+	"ListRunnerLabels",
+	"PatchInstance",
+}
+
+func init() {
+	config.RegisterOperations("git", operations...)
+}
@@ -75,14 +75,9 @@ func (v *CreateInstancePayloadFlavor) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CreateInstancePayloadFlavor(value)
-	for _, existing := range AllowedCreateInstancePayloadFlavorEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateInstancePayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateInstancePayloadFlavorFromValue returns a pointer to a valid CreateInstancePayloadFlavor
@@ -106,6 +101,17 @@ func (v CreateInstancePayloadFlavor) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateInstancePayloadFlavor) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateInstancePayloadFlavor) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to FlavorFlavor value
 func (v CreateInstancePayloadFlavor) Ptr() *CreateInstancePayloadFlavor {
 	return &v
@@ -59,14 +59,9 @@ func (v *FlavorAvailability) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := FlavorAvailability(value)
-	for _, existing := range AllowedFlavorAvailabilityEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Flavor", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewFlavorAvailabilityFromValue returns a pointer to a valid FlavorAvailability
@@ -90,6 +85,17 @@ func (v FlavorAvailability) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v FlavorAvailability) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v FlavorAvailability) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to AvailabilityAvailability value
 func (v FlavorAvailability) Ptr() *FlavorAvailability {
 	return &v
@@ -55,14 +55,9 @@ func (v *PatchOperationOp) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := PatchOperationOp(value)
-	for _, existing := range AllowedPatchOperationOpEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid PatchOperation", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPatchOperationOpFromValue returns a pointer to a valid PatchOperationOp
@@ -86,6 +81,17 @@ func (v PatchOperationOp) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v PatchOperationOp) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v PatchOperationOp) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to OpOp value
 func (v PatchOperationOp) Ptr() *PatchOperationOp {
 	return &v
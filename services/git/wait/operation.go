@@ -0,0 +1,55 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/git"
+)
+
+// CreateGitInstanceOperation returns the async action of CreateGitInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateGitInstancePollFunc. The name of the operation is
+// "git/CreateGitInstance/{projectId}/{instanceId}".
+func CreateGitInstanceOperation(a APIClientInterface, projectId, instanceId string) *lro.Operation[git.Instance] {
+	return createGitInstanceOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// CreateGitInstancePollFunc returns the lro.PollFunc of the operations returned by CreateGitInstanceOperation, e.g. to
+// resume one with lro.Resume
+func CreateGitInstancePollFunc(a APIClientInterface) lro.PollFunc[git.Instance] {
+	return createGitInstanceOperationHandler(a).PollFunc()
+}
+
+func createGitInstanceOperationHandler(a APIClientInterface) lro.WaitHandler[git.Instance] {
+	return lro.WaitHandler[git.Instance]{
+		Kind: "git/CreateGitInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[git.Instance] {
+			return CreateGitInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// DeleteGitInstanceOperation returns the async action of DeleteGitInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteGitInstancePollFunc. The name of the operation is
+// "git/DeleteGitInstance/{projectId}/{instanceId}".
+func DeleteGitInstanceOperation(a APIClientInterface, projectId, instanceId string) *lro.Operation[git.Instance] {
+	return deleteGitInstanceOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// DeleteGitInstancePollFunc returns the lro.PollFunc of the operations returned by DeleteGitInstanceOperation, e.g. to
+// resume one with lro.Resume
+func DeleteGitInstancePollFunc(a APIClientInterface) lro.PollFunc[git.Instance] {
+	return deleteGitInstanceOperationHandler(a).PollFunc()
+}
+
+func deleteGitInstanceOperationHandler(a APIClientInterface) lro.WaitHandler[git.Instance] {
+	return lro.WaitHandler[git.Instance]{
+		Kind: "git/DeleteGitInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[git.Instance] {
+			return DeleteGitInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/filter"
+	"github.com/stackitcloud/stackit-sdk-go/core/labels"
 )
 
 func Test_iaas_DefaultApiService(t *testing.T) {
@@ -4564,6 +4566,84 @@ func Test_iaas_DefaultApiService(t *testing.T) {
 		}
 	})
 
+	t.Run("Test DefaultApiService ListMachineTypes FilterExpr", func(t *testing.T) {
+		projectId := randString(36)
+		region := "region-value"
+		_apiUrlPath := "/v2/projects/" + projectId + "/regions/" + region + "/machine-types"
+
+		var receivedFilter string
+		testDefaultApiServeMux := http.NewServeMux()
+		testDefaultApiServeMux.HandleFunc(_apiUrlPath, func(w http.ResponseWriter, req *http.Request) {
+			receivedFilter = req.URL.Query().Get("filter")
+			w.Header().Add("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(MachineTypeListResponse{})
+		})
+		testServer := httptest.NewServer(testDefaultApiServeMux)
+		defer testServer.Close()
+
+		apiClient, err := NewAPIClient(config.WithEndpoint(testServer.URL), config.WithoutAuthentication())
+		if err != nil {
+			t.Fatalf("creating API client: %v", err)
+		}
+
+		f := ListMachineTypesFilterFields
+		_, reqErr := apiClient.ListMachineTypes(context.Background(), projectId, region).FilterExpr(f.Vcpus.Ge(4).And(f.Name.StartsWith("g1"))).Execute()
+		if reqErr != nil {
+			t.Fatalf("error in call: %v", reqErr)
+		}
+		if want := `vcpus >= 4 && name startsWith "g1"`; receivedFilter != want {
+			t.Fatalf("expected filter %q, got %q", want, receivedFilter)
+		}
+
+		receivedFilter = ""
+		_, reqErr = apiClient.ListMachineTypes(context.Background(), projectId, region).FilterExpr(filter.Number("gpus").Ge(1)).Execute()
+		if reqErr == nil {
+			t.Fatalf("expected an error for a field which can't be used in the filter")
+		}
+		if receivedFilter != "" {
+			t.Fatalf("expected the request with an invalid filter not to be sent")
+		}
+	})
+
+	t.Run("Test DefaultApiService ListServers LabelSelectorFrom", func(t *testing.T) {
+		projectId := randString(36)
+		region := "region-value"
+		_apiUrlPath := "/v2/projects/" + projectId + "/regions/" + region + "/servers"
+
+		var receivedSelector string
+		testDefaultApiServeMux := http.NewServeMux()
+		testDefaultApiServeMux.HandleFunc(_apiUrlPath, func(w http.ResponseWriter, req *http.Request) {
+			receivedSelector = req.URL.Query().Get("label_selector")
+			w.Header().Add("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(ServerListResponse{})
+		})
+		testServer := httptest.NewServer(testDefaultApiServeMux)
+		defer testServer.Close()
+
+		apiClient, err := NewAPIClient(config.WithEndpoint(testServer.URL), config.WithoutAuthentication())
+		if err != nil {
+			t.Fatalf("creating API client: %v", err)
+		}
+
+		selector := labels.NewSelector().Eq("env", "prod").In("region", "eu01", "eu02")
+		_, reqErr := apiClient.ListServers(context.Background(), projectId, region).LabelSelectorFrom(selector).Execute()
+		if reqErr != nil {
+			t.Fatalf("error in call: %v", reqErr)
+		}
+		if want := "env=prod,region in (eu01,eu02)"; receivedSelector != want {
+			t.Fatalf("expected label selector %q, got %q", want, receivedSelector)
+		}
+
+		receivedSelector = ""
+		_, reqErr = apiClient.ListServers(context.Background(), projectId, region).LabelSelectorFrom(labels.NewSelector().Eq("env", "in valid")).Execute()
+		if reqErr == nil {
+			t.Fatalf("expected an error for an invalid label selector")
+		}
+		if receivedSelector != "" {
+			t.Fatalf("expected the request with an invalid label selector not to be sent")
+		}
+	})
+
 	t.Run("Test DefaultApiService ListNetworkAreaProjects", func(t *testing.T) {
 		_apiUrlPath := "/v2/organizations/{organizationId}/network-areas/{areaId}/projects"
 		organizationIdValue := randString(36)
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/iaas",
+		ServiceName:   "iaas",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,146 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"AddNetworkToServer",
+	"AddNicToServer",
+	"AddPublicIpToServer",
+	"AddRoutesToRoutingTable",
+	"AddRoutingTableToArea",
+	"AddSecurityGroupToServer",
+	"AddServiceAccountToServer",
+	"AddVolumeToServer",
+	"CreateAffinityGroup",
+	"CreateBackup",
+	"CreateImage",
+	"CreateKeyPair",
+	"CreateNetwork",
+	"CreateNetworkArea",
+	"CreateNetworkAreaRange",
+	"CreateNetworkAreaRegion",
+	"CreateNetworkAreaRoute",
+	"CreateNic",
+	"CreatePublicIP",
+	"CreateSecurityGroup",
+	"CreateSecurityGroupRule",
+	"CreateServer",
+	"CreateSnapshot",
+	"CreateVolume",
+	"DeallocateServer",
+	"DeleteAffinityGroup",
+	"DeleteBackup",
+	"DeleteImage",
+	"DeleteImageShare",
+	"DeleteImageShareConsumer",
+	"DeleteKeyPair",
+	"DeleteNetwork",
+	"DeleteNetworkArea",
+	"DeleteNetworkAreaRange",
+	"DeleteNetworkAreaRegion",
+	"DeleteNetworkAreaRoute",
+	"DeleteNic",
+	"DeletePublicIP",
+	"DeleteRouteFromRoutingTable",
+	"DeleteRoutingTableFromArea",
+	"DeleteSecurityGroup",
+	"DeleteSecurityGroupRule",
+	"DeleteServer",
+	"DeleteSnapshot",
+	"DeleteVolume",
+	"GetAffinityGroup",
+	"GetAttachedVolume",
+	"GetBackup",
+	"GetImage",
+	"GetImageShare",
+	"GetImageShareConsumer",
+	"GetKeyPair",
+	"GetMachineType",
+	"GetNetwork",
+	"GetNetworkArea",
+	"GetNetworkAreaRange",
+	"GetNetworkAreaRegion",
+	"GetNetworkAreaRoute",
+	"GetNic",
+	"GetOrganizationRequest",
+	"GetProjectDetails",
+	"GetProjectNIC",
+	"GetProjectRequest",
+	"GetPublicIP",
+	"GetRouteOfRoutingTable",
+	"GetRoutingTableOfArea",
+	"GetSecurityGroup",
+	"GetSecurityGroupRule",
+	"GetServer",
+	"GetServerConsole",
+	"GetServerLog",
+	"GetSnapshot",
+	"GetVolume",
+	"GetVolumePerformanceClass",
+	"ListAffinityGroups",
+	"ListAttachedVolumes",
+	"ListAvailabilityZones",
+	"ListBackups",
+	"ListImages",
+	"ListKeyPairs",
+	"ListMachineTypes",
+	"ListNetworkAreaProjects",
+	"ListNetworkAreaRanges",
+	"ListNetworkAreaRegions",
+	"ListNetworkAreaRoutes",
+	"ListNetworkAreas",
+	"ListNetworks",
+	"ListNics",
+	"ListProjectNICs",
+	"ListPublicIPRanges",
+	"ListPublicIPs",
+	"ListQuotas",
+	"ListRoutesOfRoutingTable",
+	"ListRoutingTablesOfArea",
+	"ListSecurityGroupRules",
+	"ListSecurityGroups",
+	"ListServerNICs",
+	"ListServerServiceAccounts",
+	"ListServers",
+	"ListSnapshotsInProject",
+	"ListVolumePerformanceClasses",
+	"ListVolumes",
+	"PartialUpdateNetwork",
+	"PartialUpdateNetworkArea",
+	"RebootServer",
+	"RemoveNetworkFromServer",
+	"RemoveNicFromServer",
+	"RemovePublicIpFromServer",
+	"RemoveSecurityGroupFromServer",
+	"RemoveServiceAccountFromServer",
+	"RemoveVolumeFromServer",
+	"RescueServer",
+	"ResizeServer",
+	"ResizeVolume",
+	"RestoreBackup",
+	"SetImageShare",
+	"StartServer",
+	"StopServer",
+	"UnrescueServer",
+	"UpdateAttachedVolume",
+	"UpdateBackup",
+	"UpdateImage",
+	"UpdateImageShare",
+	"UpdateKeyPair",
+	"UpdateNetworkAreaRegion",
+	"UpdateNetworkAreaRoute",
+	"UpdateNic",
+	"UpdatePublicIP",
+	"UpdateRouteOfRoutingTable",
+	"UpdateRoutingTableOfArea",
+	"UpdateSecurityGroup",
+	"UpdateServer",
+	"UpdateSnapshot",
+	"UpdateVolume",
+}
+
+func init() {
+	config.RegisterOperations("iaas", operations...)
+}
@@ -64,7 +64,7 @@ func (dst *AreaId) UnmarshalJSON(data []byte) error {
 	err = json.Unmarshal(data, &dstAreaId2.StaticAreaID)
 	if err == nil {
 		jsonStaticAreaID, _ := json.Marshal(&dstAreaId2.StaticAreaID)
-		if string(jsonStaticAreaID) != "{}" { // empty struct
+		if string(jsonStaticAreaID) != "{}" && dstAreaId2.StaticAreaID.IsKnown() { // empty struct
 			dst.StaticAreaID = dstAreaId2.StaticAreaID
 			match++
 		}
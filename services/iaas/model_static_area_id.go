@@ -42,14 +42,9 @@ func (v *StaticAreaID) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := StaticAreaID(value)
-	for _, existing := range AllowedStaticAreaIDEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid StaticAreaID", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewStaticAreaIDFromValue returns a pointer to a valid StaticAreaID
@@ -73,6 +68,17 @@ func (v StaticAreaID) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v StaticAreaID) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v StaticAreaID) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StaticAreaID value
 func (v StaticAreaID) Ptr() *StaticAreaID {
 	return &v
@@ -0,0 +1,585 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+// CreateNetworkAreaRegionOperation returns the async action of CreateNetworkAreaRegionWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with CreateNetworkAreaRegionPollFunc. The name of
+// the operation is "iaas/CreateNetworkAreaRegion/{organizationId}/{areaId}/{region}".
+func CreateNetworkAreaRegionOperation(a APIClientInterface, organizationId, areaId, region string) *lro.Operation[iaas.RegionalArea] {
+	return createNetworkAreaRegionOperationHandler(a).Operation(organizationId, areaId, region)
+}
+
+// CreateNetworkAreaRegionPollFunc returns the lro.PollFunc of the operations returned by
+// CreateNetworkAreaRegionOperation, e.g. to resume one with lro.Resume
+func CreateNetworkAreaRegionPollFunc(a APIClientInterface) lro.PollFunc[iaas.RegionalArea] {
+	return createNetworkAreaRegionOperationHandler(a).PollFunc()
+}
+
+func createNetworkAreaRegionOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.RegionalArea] {
+	return lro.WaitHandler[iaas.RegionalArea]{
+		Kind: "iaas/CreateNetworkAreaRegion",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.RegionalArea] {
+			return CreateNetworkAreaRegionWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteNetworkAreaRegionOperation returns the async action of DeleteNetworkAreaRegionWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with DeleteNetworkAreaRegionPollFunc. The name of
+// the operation is "iaas/DeleteNetworkAreaRegion/{organizationId}/{areaId}/{region}".
+func DeleteNetworkAreaRegionOperation(a APIClientInterface, organizationId, areaId, region string) *lro.Operation[iaas.RegionalArea] {
+	return deleteNetworkAreaRegionOperationHandler(a).Operation(organizationId, areaId, region)
+}
+
+// DeleteNetworkAreaRegionPollFunc returns the lro.PollFunc of the operations returned by
+// DeleteNetworkAreaRegionOperation, e.g. to resume one with lro.Resume
+func DeleteNetworkAreaRegionPollFunc(a APIClientInterface) lro.PollFunc[iaas.RegionalArea] {
+	return deleteNetworkAreaRegionOperationHandler(a).PollFunc()
+}
+
+func deleteNetworkAreaRegionOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.RegionalArea] {
+	return lro.WaitHandler[iaas.RegionalArea]{
+		Kind: "iaas/DeleteNetworkAreaRegion",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.RegionalArea] {
+			return DeleteNetworkAreaRegionWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// ReadyForNetworkAreaDeletionOperation returns the async action of ReadyForNetworkAreaDeletionWaitHandler as an
+// lro.Operation, which can be serialized to resume waiting for it in another process with
+// ReadyForNetworkAreaDeletionPollFunc. The name of the operation is
+// "iaas/ReadyForNetworkAreaDeletion/{organizationId}/{areaId}".
+func ReadyForNetworkAreaDeletionOperation(a APIClientInterface, r ResourceManagerAPIClientInterface, organizationId, areaId string) *lro.Operation[iaas.ProjectListResponse] {
+	return readyForNetworkAreaDeletionOperationHandler(a, r).Operation(organizationId, areaId)
+}
+
+// ReadyForNetworkAreaDeletionPollFunc returns the lro.PollFunc of the operations returned by
+// ReadyForNetworkAreaDeletionOperation, e.g. to resume one with lro.Resume
+func ReadyForNetworkAreaDeletionPollFunc(a APIClientInterface, r ResourceManagerAPIClientInterface) lro.PollFunc[iaas.ProjectListResponse] {
+	return readyForNetworkAreaDeletionOperationHandler(a, r).PollFunc()
+}
+
+func readyForNetworkAreaDeletionOperationHandler(a APIClientInterface, r ResourceManagerAPIClientInterface) lro.WaitHandler[iaas.ProjectListResponse] {
+	return lro.WaitHandler[iaas.ProjectListResponse]{
+		Kind: "iaas/ReadyForNetworkAreaDeletion",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.ProjectListResponse] {
+			return ReadyForNetworkAreaDeletionWaitHandler(ctx, a, r, ids[0], ids[1])
+		},
+	}
+}
+
+// CreateNetworkOperation returns the async action of CreateNetworkWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateNetworkPollFunc. The name of the operation is
+// "iaas/CreateNetwork/{projectId}/{region}/{networkId}".
+func CreateNetworkOperation(a APIClientInterface, projectId, region, networkId string) *lro.Operation[iaas.Network] {
+	return createNetworkOperationHandler(a).Operation(projectId, region, networkId)
+}
+
+// CreateNetworkPollFunc returns the lro.PollFunc of the operations returned by CreateNetworkOperation, e.g. to resume
+// one with lro.Resume
+func CreateNetworkPollFunc(a APIClientInterface) lro.PollFunc[iaas.Network] {
+	return createNetworkOperationHandler(a).PollFunc()
+}
+
+func createNetworkOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Network] {
+	return lro.WaitHandler[iaas.Network]{
+		Kind: "iaas/CreateNetwork",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Network] {
+			return CreateNetworkWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// UpdateNetworkOperation returns the async action of UpdateNetworkWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with UpdateNetworkPollFunc. The name of the operation is
+// "iaas/UpdateNetwork/{projectId}/{region}/{networkId}".
+func UpdateNetworkOperation(a APIClientInterface, projectId, region, networkId string) *lro.Operation[iaas.Network] {
+	return updateNetworkOperationHandler(a).Operation(projectId, region, networkId)
+}
+
+// UpdateNetworkPollFunc returns the lro.PollFunc of the operations returned by UpdateNetworkOperation, e.g. to resume
+// one with lro.Resume
+func UpdateNetworkPollFunc(a APIClientInterface) lro.PollFunc[iaas.Network] {
+	return updateNetworkOperationHandler(a).PollFunc()
+}
+
+func updateNetworkOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Network] {
+	return lro.WaitHandler[iaas.Network]{
+		Kind: "iaas/UpdateNetwork",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Network] {
+			return UpdateNetworkWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteNetworkOperation returns the async action of DeleteNetworkWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteNetworkPollFunc. The name of the operation is
+// "iaas/DeleteNetwork/{projectId}/{region}/{networkId}".
+func DeleteNetworkOperation(a APIClientInterface, projectId, region, networkId string) *lro.Operation[iaas.Network] {
+	return deleteNetworkOperationHandler(a).Operation(projectId, region, networkId)
+}
+
+// DeleteNetworkPollFunc returns the lro.PollFunc of the operations returned by DeleteNetworkOperation, e.g. to resume
+// one with lro.Resume
+func DeleteNetworkPollFunc(a APIClientInterface) lro.PollFunc[iaas.Network] {
+	return deleteNetworkOperationHandler(a).PollFunc()
+}
+
+func deleteNetworkOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Network] {
+	return lro.WaitHandler[iaas.Network]{
+		Kind: "iaas/DeleteNetwork",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Network] {
+			return DeleteNetworkWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CreateVolumeOperation returns the async action of CreateVolumeWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateVolumePollFunc. The name of the operation is
+// "iaas/CreateVolume/{projectId}/{region}/{volumeId}".
+func CreateVolumeOperation(a APIClientInterface, projectId, region, volumeId string) *lro.Operation[iaas.Volume] {
+	return createVolumeOperationHandler(a).Operation(projectId, region, volumeId)
+}
+
+// CreateVolumePollFunc returns the lro.PollFunc of the operations returned by CreateVolumeOperation, e.g. to resume one
+// with lro.Resume
+func CreateVolumePollFunc(a APIClientInterface) lro.PollFunc[iaas.Volume] {
+	return createVolumeOperationHandler(a).PollFunc()
+}
+
+func createVolumeOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Volume] {
+	return lro.WaitHandler[iaas.Volume]{
+		Kind: "iaas/CreateVolume",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Volume] {
+			return CreateVolumeWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteVolumeOperation returns the async action of DeleteVolumeWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteVolumePollFunc. The name of the operation is
+// "iaas/DeleteVolume/{projectId}/{region}/{volumeId}".
+func DeleteVolumeOperation(a APIClientInterface, projectId, region, volumeId string) *lro.Operation[iaas.Volume] {
+	return deleteVolumeOperationHandler(a).Operation(projectId, region, volumeId)
+}
+
+// DeleteVolumePollFunc returns the lro.PollFunc of the operations returned by DeleteVolumeOperation, e.g. to resume one
+// with lro.Resume
+func DeleteVolumePollFunc(a APIClientInterface) lro.PollFunc[iaas.Volume] {
+	return deleteVolumeOperationHandler(a).PollFunc()
+}
+
+func deleteVolumeOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Volume] {
+	return lro.WaitHandler[iaas.Volume]{
+		Kind: "iaas/DeleteVolume",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Volume] {
+			return DeleteVolumeWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CreateServerOperation returns the async action of CreateServerWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateServerPollFunc. The name of the operation is
+// "iaas/CreateServer/{projectId}/{region}/{serverId}".
+func CreateServerOperation(a APIClientInterface, projectId, region, serverId string) *lro.Operation[iaas.Server] {
+	return createServerOperationHandler(a).Operation(projectId, region, serverId)
+}
+
+// CreateServerPollFunc returns the lro.PollFunc of the operations returned by CreateServerOperation, e.g. to resume one
+// with lro.Resume
+func CreateServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.Server] {
+	return createServerOperationHandler(a).PollFunc()
+}
+
+func createServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Server] {
+	return lro.WaitHandler[iaas.Server]{
+		Kind: "iaas/CreateServer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Server] {
+			return CreateServerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// ResizeServerOperation returns the async action of ResizeServerWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with ResizeServerPollFunc. The name of the operation is
+// "iaas/ResizeServer/{projectId}/{region}/{serverId}".
+func ResizeServerOperation(a APIClientInterface, projectId, region, serverId string) *lro.Operation[iaas.Server] {
+	return resizeServerOperationHandler(a).Operation(projectId, region, serverId)
+}
+
+// ResizeServerPollFunc returns the lro.PollFunc of the operations returned by ResizeServerOperation, e.g. to resume one
+// with lro.Resume
+func ResizeServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.Server] {
+	return resizeServerOperationHandler(a).PollFunc()
+}
+
+func resizeServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Server] {
+	return lro.WaitHandler[iaas.Server]{
+		Kind: "iaas/ResizeServer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Server] {
+			return ResizeServerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteServerOperation returns the async action of DeleteServerWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteServerPollFunc. The name of the operation is
+// "iaas/DeleteServer/{projectId}/{region}/{serverId}".
+func DeleteServerOperation(a APIClientInterface, projectId, region, serverId string) *lro.Operation[iaas.Server] {
+	return deleteServerOperationHandler(a).Operation(projectId, region, serverId)
+}
+
+// DeleteServerPollFunc returns the lro.PollFunc of the operations returned by DeleteServerOperation, e.g. to resume one
+// with lro.Resume
+func DeleteServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.Server] {
+	return deleteServerOperationHandler(a).PollFunc()
+}
+
+func deleteServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Server] {
+	return lro.WaitHandler[iaas.Server]{
+		Kind: "iaas/DeleteServer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Server] {
+			return DeleteServerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// StartServerOperation returns the async action of StartServerWaitHandler as an lro.Operation, which can be serialized
+// to resume waiting for it in another process with StartServerPollFunc. The name of the operation is
+// "iaas/StartServer/{projectId}/{region}/{serverId}".
+func StartServerOperation(a APIClientInterface, projectId, region, serverId string) *lro.Operation[iaas.Server] {
+	return startServerOperationHandler(a).Operation(projectId, region, serverId)
+}
+
+// StartServerPollFunc returns the lro.PollFunc of the operations returned by StartServerOperation, e.g. to resume one
+// with lro.Resume
+func StartServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.Server] {
+	return startServerOperationHandler(a).PollFunc()
+}
+
+func startServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Server] {
+	return lro.WaitHandler[iaas.Server]{
+		Kind: "iaas/StartServer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Server] {
+			return StartServerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// StopServerOperation returns the async action of StopServerWaitHandler as an lro.Operation, which can be serialized to
+// resume waiting for it in another process with StopServerPollFunc. The name of the operation is
+// "iaas/StopServer/{projectId}/{region}/{serverId}".
+func StopServerOperation(a APIClientInterface, projectId, region, serverId string) *lro.Operation[iaas.Server] {
+	return stopServerOperationHandler(a).Operation(projectId, region, serverId)
+}
+
+// StopServerPollFunc returns the lro.PollFunc of the operations returned by StopServerOperation, e.g. to resume one
+// with lro.Resume
+func StopServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.Server] {
+	return stopServerOperationHandler(a).PollFunc()
+}
+
+func stopServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Server] {
+	return lro.WaitHandler[iaas.Server]{
+		Kind: "iaas/StopServer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Server] {
+			return StopServerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeallocateServerOperation returns the async action of DeallocateServerWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeallocateServerPollFunc. The name of the operation is
+// "iaas/DeallocateServer/{projectId}/{region}/{serverId}".
+func DeallocateServerOperation(a APIClientInterface, projectId, region, serverId string) *lro.Operation[iaas.Server] {
+	return deallocateServerOperationHandler(a).Operation(projectId, region, serverId)
+}
+
+// DeallocateServerPollFunc returns the lro.PollFunc of the operations returned by DeallocateServerOperation, e.g. to
+// resume one with lro.Resume
+func DeallocateServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.Server] {
+	return deallocateServerOperationHandler(a).PollFunc()
+}
+
+func deallocateServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Server] {
+	return lro.WaitHandler[iaas.Server]{
+		Kind: "iaas/DeallocateServer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Server] {
+			return DeallocateServerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// RescueServerOperation returns the async action of RescueServerWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with RescueServerPollFunc. The name of the operation is
+// "iaas/RescueServer/{projectId}/{region}/{serverId}".
+func RescueServerOperation(a APIClientInterface, projectId, region, serverId string) *lro.Operation[iaas.Server] {
+	return rescueServerOperationHandler(a).Operation(projectId, region, serverId)
+}
+
+// RescueServerPollFunc returns the lro.PollFunc of the operations returned by RescueServerOperation, e.g. to resume one
+// with lro.Resume
+func RescueServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.Server] {
+	return rescueServerOperationHandler(a).PollFunc()
+}
+
+func rescueServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Server] {
+	return lro.WaitHandler[iaas.Server]{
+		Kind: "iaas/RescueServer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Server] {
+			return RescueServerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// UnrescueServerOperation returns the async action of UnrescueServerWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with UnrescueServerPollFunc. The name of the operation is
+// "iaas/UnrescueServer/{projectId}/{region}/{serverId}".
+func UnrescueServerOperation(a APIClientInterface, projectId, region, serverId string) *lro.Operation[iaas.Server] {
+	return unrescueServerOperationHandler(a).Operation(projectId, region, serverId)
+}
+
+// UnrescueServerPollFunc returns the lro.PollFunc of the operations returned by UnrescueServerOperation, e.g. to resume
+// one with lro.Resume
+func UnrescueServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.Server] {
+	return unrescueServerOperationHandler(a).PollFunc()
+}
+
+func unrescueServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Server] {
+	return lro.WaitHandler[iaas.Server]{
+		Kind: "iaas/UnrescueServer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Server] {
+			return UnrescueServerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// AddVolumeToServerOperation returns the async action of AddVolumeToServerWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with AddVolumeToServerPollFunc. The name of the operation is
+// "iaas/AddVolumeToServer/{projectId}/{region}/{serverId}/{volumeId}".
+func AddVolumeToServerOperation(a APIClientInterface, projectId, region, serverId, volumeId string) *lro.Operation[iaas.VolumeAttachment] {
+	return addVolumeToServerOperationHandler(a).Operation(projectId, region, serverId, volumeId)
+}
+
+// AddVolumeToServerPollFunc returns the lro.PollFunc of the operations returned by AddVolumeToServerOperation, e.g. to
+// resume one with lro.Resume
+func AddVolumeToServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.VolumeAttachment] {
+	return addVolumeToServerOperationHandler(a).PollFunc()
+}
+
+func addVolumeToServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.VolumeAttachment] {
+	return lro.WaitHandler[iaas.VolumeAttachment]{
+		Kind: "iaas/AddVolumeToServer",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.VolumeAttachment] {
+			return AddVolumeToServerWaitHandler(ctx, a, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
+
+// RemoveVolumeFromServerOperation returns the async action of RemoveVolumeFromServerWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with RemoveVolumeFromServerPollFunc. The name of
+// the operation is "iaas/RemoveVolumeFromServer/{projectId}/{region}/{serverId}/{volumeId}".
+func RemoveVolumeFromServerOperation(a APIClientInterface, projectId, region, serverId, volumeId string) *lro.Operation[iaas.VolumeAttachment] {
+	return removeVolumeFromServerOperationHandler(a).Operation(projectId, region, serverId, volumeId)
+}
+
+// RemoveVolumeFromServerPollFunc returns the lro.PollFunc of the operations returned by
+// RemoveVolumeFromServerOperation, e.g. to resume one with lro.Resume
+func RemoveVolumeFromServerPollFunc(a APIClientInterface) lro.PollFunc[iaas.VolumeAttachment] {
+	return removeVolumeFromServerOperationHandler(a).PollFunc()
+}
+
+func removeVolumeFromServerOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.VolumeAttachment] {
+	return lro.WaitHandler[iaas.VolumeAttachment]{
+		Kind: "iaas/RemoveVolumeFromServer",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.VolumeAttachment] {
+			return RemoveVolumeFromServerWaitHandler(ctx, a, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
+
+// UploadImageOperation returns the async action of UploadImageWaitHandler as an lro.Operation, which can be serialized
+// to resume waiting for it in another process with UploadImagePollFunc. The name of the operation is
+// "iaas/UploadImage/{projectId}/{region}/{imageId}".
+func UploadImageOperation(a APIClientInterface, projectId, region, imageId string) *lro.Operation[iaas.Image] {
+	return uploadImageOperationHandler(a).Operation(projectId, region, imageId)
+}
+
+// UploadImagePollFunc returns the lro.PollFunc of the operations returned by UploadImageOperation, e.g. to resume one
+// with lro.Resume
+func UploadImagePollFunc(a APIClientInterface) lro.PollFunc[iaas.Image] {
+	return uploadImageOperationHandler(a).PollFunc()
+}
+
+func uploadImageOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Image] {
+	return lro.WaitHandler[iaas.Image]{
+		Kind: "iaas/UploadImage",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Image] {
+			return UploadImageWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteImageOperation returns the async action of DeleteImageWaitHandler as an lro.Operation, which can be serialized
+// to resume waiting for it in another process with DeleteImagePollFunc. The name of the operation is
+// "iaas/DeleteImage/{projectId}/{region}/{imageId}".
+func DeleteImageOperation(a APIClientInterface, projectId, region, imageId string) *lro.Operation[iaas.Image] {
+	return deleteImageOperationHandler(a).Operation(projectId, region, imageId)
+}
+
+// DeleteImagePollFunc returns the lro.PollFunc of the operations returned by DeleteImageOperation, e.g. to resume one
+// with lro.Resume
+func DeleteImagePollFunc(a APIClientInterface) lro.PollFunc[iaas.Image] {
+	return deleteImageOperationHandler(a).PollFunc()
+}
+
+func deleteImageOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Image] {
+	return lro.WaitHandler[iaas.Image]{
+		Kind: "iaas/DeleteImage",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Image] {
+			return DeleteImageWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CreateBackupOperation returns the async action of CreateBackupWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateBackupPollFunc. The name of the operation is
+// "iaas/CreateBackup/{projectId}/{region}/{backupId}".
+func CreateBackupOperation(a APIClientInterface, projectId, region, backupId string) *lro.Operation[iaas.Backup] {
+	return createBackupOperationHandler(a).Operation(projectId, region, backupId)
+}
+
+// CreateBackupPollFunc returns the lro.PollFunc of the operations returned by CreateBackupOperation, e.g. to resume one
+// with lro.Resume
+func CreateBackupPollFunc(a APIClientInterface) lro.PollFunc[iaas.Backup] {
+	return createBackupOperationHandler(a).PollFunc()
+}
+
+func createBackupOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Backup] {
+	return lro.WaitHandler[iaas.Backup]{
+		Kind: "iaas/CreateBackup",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Backup] {
+			return CreateBackupWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteBackupOperation returns the async action of DeleteBackupWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteBackupPollFunc. The name of the operation is
+// "iaas/DeleteBackup/{projectId}/{region}/{backupId}".
+func DeleteBackupOperation(a APIClientInterface, projectId, region, backupId string) *lro.Operation[iaas.Backup] {
+	return deleteBackupOperationHandler(a).Operation(projectId, region, backupId)
+}
+
+// DeleteBackupPollFunc returns the lro.PollFunc of the operations returned by DeleteBackupOperation, e.g. to resume one
+// with lro.Resume
+func DeleteBackupPollFunc(a APIClientInterface) lro.PollFunc[iaas.Backup] {
+	return deleteBackupOperationHandler(a).PollFunc()
+}
+
+func deleteBackupOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Backup] {
+	return lro.WaitHandler[iaas.Backup]{
+		Kind: "iaas/DeleteBackup",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Backup] {
+			return DeleteBackupWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// RestoreBackupOperation returns the async action of RestoreBackupWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with RestoreBackupPollFunc. The name of the operation is
+// "iaas/RestoreBackup/{projectId}/{region}/{backupId}".
+func RestoreBackupOperation(a APIClientInterface, projectId, region, backupId string) *lro.Operation[iaas.Backup] {
+	return restoreBackupOperationHandler(a).Operation(projectId, region, backupId)
+}
+
+// RestoreBackupPollFunc returns the lro.PollFunc of the operations returned by RestoreBackupOperation, e.g. to resume
+// one with lro.Resume
+func RestoreBackupPollFunc(a APIClientInterface) lro.PollFunc[iaas.Backup] {
+	return restoreBackupOperationHandler(a).PollFunc()
+}
+
+func restoreBackupOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Backup] {
+	return lro.WaitHandler[iaas.Backup]{
+		Kind: "iaas/RestoreBackup",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Backup] {
+			return RestoreBackupWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CreateSnapshotOperation returns the async action of CreateSnapshotWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateSnapshotPollFunc. The name of the operation is
+// "iaas/CreateSnapshot/{projectId}/{region}/{snapshotId}".
+func CreateSnapshotOperation(a APIClientInterface, projectId, region, snapshotId string) *lro.Operation[iaas.Snapshot] {
+	return createSnapshotOperationHandler(a).Operation(projectId, region, snapshotId)
+}
+
+// CreateSnapshotPollFunc returns the lro.PollFunc of the operations returned by CreateSnapshotOperation, e.g. to resume
+// one with lro.Resume
+func CreateSnapshotPollFunc(a APIClientInterface) lro.PollFunc[iaas.Snapshot] {
+	return createSnapshotOperationHandler(a).PollFunc()
+}
+
+func createSnapshotOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Snapshot] {
+	return lro.WaitHandler[iaas.Snapshot]{
+		Kind: "iaas/CreateSnapshot",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Snapshot] {
+			return CreateSnapshotWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteSnapshotOperation returns the async action of DeleteSnapshotWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteSnapshotPollFunc. The name of the operation is
+// "iaas/DeleteSnapshot/{projectId}/{region}/{snapshotId}".
+func DeleteSnapshotOperation(a APIClientInterface, projectId, region, snapshotId string) *lro.Operation[iaas.Snapshot] {
+	return deleteSnapshotOperationHandler(a).Operation(projectId, region, snapshotId)
+}
+
+// DeleteSnapshotPollFunc returns the lro.PollFunc of the operations returned by DeleteSnapshotOperation, e.g. to resume
+// one with lro.Resume
+func DeleteSnapshotPollFunc(a APIClientInterface) lro.PollFunc[iaas.Snapshot] {
+	return deleteSnapshotOperationHandler(a).PollFunc()
+}
+
+func deleteSnapshotOperationHandler(a APIClientInterface) lro.WaitHandler[iaas.Snapshot] {
+	return lro.WaitHandler[iaas.Snapshot]{
+		Kind: "iaas/DeleteSnapshot",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaas.Snapshot] {
+			return DeleteSnapshotWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
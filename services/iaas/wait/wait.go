@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/stream"
 	"github.com/stackitcloud/stackit-sdk-go/core/wait"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/stackit-sdk-go/services/resourcemanager"
@@ -61,6 +65,10 @@ type APIClientInterface interface {
 	GetSnapshotExecute(ctx context.Context, projectId, region, snapshotId string) (*iaas.Snapshot, error)
 }
 
+type APIClientServerLogInterface interface {
+	GetServerLogExecute(ctx context.Context, projectId, region, serverId string) (*iaas.GetServerLog200Response, error)
+}
+
 type ResourceManagerAPIClientInterface interface {
 	GetProjectExecute(ctx context.Context, id string) (*resourcemanager.GetProjectResponse, error)
 }
@@ -266,7 +274,8 @@ func DeleteNetworkWaitHandler(ctx context.Context, a APIClientInterface, project
 		if err == nil {
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, network, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError: %w", err)
 		}
@@ -297,6 +306,13 @@ func CreateVolumeWaitHandler(ctx context.Context, a APIClientInterface, projectI
 		}
 		return false, volume, nil
 	})
+	handler.SetStateFetch(func() (*iaas.Volume, string, error) {
+		s, err := a.GetVolumeExecute(ctx, projectId, region, volumeId)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		return s, s.GetStatus(), nil
+	})
 	handler.SetTimeout(30 * time.Minute)
 	return handler
 }
@@ -316,7 +332,8 @@ func DeleteVolumeWaitHandler(ctx context.Context, a APIClientInterface, projectI
 			}
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, volume, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError: %w", err)
 		}
@@ -350,6 +367,13 @@ func CreateServerWaitHandler(ctx context.Context, a APIClientInterface, projectI
 		}
 		return false, server, nil
 	})
+	handler.SetStateFetch(func() (*iaas.Server, string, error) {
+		s, err := a.GetServerExecute(ctx, projectId, region, serverId)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		return s, s.GetStatus(), nil
+	})
 	handler.SetTimeout(20 * time.Minute)
 	return handler
 }
@@ -407,7 +431,8 @@ func DeleteServerWaitHandler(ctx context.Context, a APIClientInterface, projectI
 			}
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, server, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError: %w", err)
 		}
@@ -562,48 +587,73 @@ func UnrescueServerWaitHandler(ctx context.Context, a APIClientInterface, projec
 //	_, err = wait.ProjectRequestWaitHandler(context.Background(), iaasClient, projectId, requestId).WaitWithContext(context.Background())
 func ProjectRequestWaitHandler(ctx context.Context, a APIClientInterface, projectId, region, requestId string) *wait.AsyncActionHandler[iaas.Request] {
 	handler := wait.New(func() (waitFinished bool, response *iaas.Request, err error) {
-		request, err := a.GetProjectRequestExecute(ctx, projectId, region, requestId)
-		if err != nil {
-			return false, request, err
-		}
+		return checkProjectRequest(ctx, a, projectId, region, requestId)
+	})
+	handler.SetTimeout(20 * time.Minute)
+	return handler
+}
 
-		if request == nil {
-			return false, nil, fmt.Errorf("request failed for request with id %s: nil response from GetProjectRequestExecute", requestId)
-		}
+// checkProjectRequest checks once whether a project request has finished
+func checkProjectRequest(ctx context.Context, a APIClientInterface, projectId, region, requestId string) (waitFinished bool, response *iaas.Request, err error) {
+	request, err := a.GetProjectRequestExecute(ctx, projectId, region, requestId)
+	if err != nil {
+		return false, request, err
+	}
 
-		if request.RequestId == nil || request.RequestAction == nil || request.Status == nil {
-			return false, request, fmt.Errorf("request failed for request with id %s, the response is not valid: the id, the request action or the status are missing", requestId)
-		}
+	if request == nil {
+		return false, nil, fmt.Errorf("request failed for request with id %s: nil response from GetProjectRequestExecute", requestId)
+	}
 
-		if *request.RequestId != requestId {
-			return false, request, fmt.Errorf("request failed for request with id %s: the response id doesn't match the request id", requestId)
-		}
+	if request.RequestId == nil || request.RequestAction == nil || request.Status == nil {
+		return false, request, fmt.Errorf("request failed for request with id %s, the response is not valid: the id, the request action or the status are missing", requestId)
+	}
 
-		switch *request.RequestAction {
-		case RequestCreateAction:
-			if *request.Status == RequestCreatedStatus {
-				return true, request, nil
-			}
-		case RequestUpdateAction:
-			if *request.Status == RequestUpdatedStatus {
-				return true, request, nil
-			}
-		case RequestDeleteAction:
-			if *request.Status == RequestDeletedStatus {
-				return true, request, nil
-			}
-		default:
-			return false, request, fmt.Errorf("request failed for request with id %s, the request action %s is not supported", requestId, *request.RequestAction)
-		}
+	if *request.RequestId != requestId {
+		return false, request, fmt.Errorf("request failed for request with id %s: the response id doesn't match the request id", requestId)
+	}
 
-		if *request.Status == RequestFailedStatus {
-			return true, request, fmt.Errorf("request failed for request with id %s", requestId)
-		}
+	switch *request.RequestAction {
+	case RequestCreateAction:
+		if *request.Status == RequestCreatedStatus {
+			return true, request, nil
+		}
+	case RequestUpdateAction:
+		if *request.Status == RequestUpdatedStatus {
+			return true, request, nil
+		}
+	case RequestDeleteAction:
+		if *request.Status == RequestDeletedStatus {
+			return true, request, nil
+		}
+	default:
+		return false, request, fmt.Errorf("request failed for request with id %s, the request action %s is not supported", requestId, *request.RequestAction)
+	}
 
-		return false, request, nil
-	})
-	handler.SetTimeout(20 * time.Minute)
-	return handler
+	if *request.Status == RequestFailedStatus {
+		return true, request, fmt.Errorf("request failed for request with id %s", requestId)
+	}
+
+	return false, request, nil
+}
+
+// ProjectRequestOperation returns the project request with id requestId as an lro.Operation, which can be serialized
+// to resume waiting for it in another process with ProjectRequestPollFunc, see ProjectRequestWaitHandler for
+// how to obtain the request id. The name of the operation is "projects/{projectId}/regions/{region}/requests/{requestId}".
+func ProjectRequestOperation(a APIClientInterface, projectId, region, requestId string) *lro.Operation[iaas.Request] {
+	name := fmt.Sprintf("projects/%s/regions/%s/requests/%s", projectId, region, requestId)
+	return lro.New(name, ProjectRequestPollFunc(a)).SetTimeout(20 * time.Minute)
+}
+
+// ProjectRequestPollFunc returns the lro.PollFunc of the operations returned by ProjectRequestOperation,
+// e.g. to resume one with lro.Resume
+func ProjectRequestPollFunc(a APIClientInterface) lro.PollFunc[iaas.Request] {
+	return func(ctx context.Context, name string) (bool, *iaas.Request, error) {
+		parts := strings.Split(name, "/")
+		if len(parts) != 6 || parts[0] != "projects" || parts[2] != "regions" || parts[4] != "requests" {
+			return false, nil, fmt.Errorf("invalid project request operation name %q", name)
+		}
+		return checkProjectRequest(ctx, a, parts[1], parts[3], parts[5])
+	}
 }
 
 // AddVolumeToServerWaitHandler will wait for a volume to be attached to a server
@@ -621,7 +671,8 @@ func AddVolumeToServerWaitHandler(ctx context.Context, a APIClientInterface, pro
 			}
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, volumeAttachment, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError: %w", err)
 		}
@@ -646,7 +697,8 @@ func RemoveVolumeFromServerWaitHandler(ctx context.Context, a APIClientInterface
 			}
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, volumeAttachment, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError: %w", err)
 		}
@@ -696,7 +748,8 @@ func DeleteImageWaitHandler(ctx context.Context, a APIClientInterface, projectId
 			}
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, image, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError: %w", err)
 		}
@@ -834,3 +887,220 @@ func DeleteSnapshotWaitHandler(ctx context.Context, a APIClientInterface, projec
 	handler.SetTimeout(20 * time.Minute)
 	return handler
 }
+
+// TailServerLog streams the console log of a server, starting with the last lines of the log, until ctx is canceled
+// or the stream is closed. The log is polled with GetServerLog, see stream.Tail for the buffering and retry options.
+func TailServerLog(ctx context.Context, a APIClientServerLogInterface, projectId, region, serverId string, opts stream.TailOptions) io.ReadCloser {
+	return stream.Tail(ctx, func(ctx context.Context) (string, error) {
+		serverLog, err := a.GetServerLogExecute(ctx, projectId, region, serverId)
+		if err != nil {
+			return "", err
+		}
+		return serverLog.GetOutput(), nil
+	}, opts)
+}
+
+// ResourceType is a type of resource which labels can be set on with SetLabels
+type ResourceType string
+
+const (
+	ResourceTypeServer        ResourceType = "server"
+	ResourceTypeVolume        ResourceType = "volume"
+	ResourceTypeNetwork       ResourceType = "network"
+	ResourceTypeSecurityGroup ResourceType = "security_group"
+	ResourceTypePublicIP      ResourceType = "public_ip"
+	ResourceTypeImage         ResourceType = "image"
+	ResourceTypeSnapshot      ResourceType = "snapshot"
+	ResourceTypeBackup        ResourceType = "backup"
+)
+
+// ResourceRef references a resource of a project, see SetLabels
+type ResourceRef struct {
+	Type   ResourceType
+	Region string
+	Id     string
+}
+
+// MergeMode determines how SetLabels combines the given labels with the existing labels of a resource
+type MergeMode int
+
+const (
+	// MergeModeAdditive adds the labels to the existing labels of the resource, overwriting the values of existing keys
+	MergeModeAdditive MergeMode = iota
+	// MergeModeReplace replaces the existing labels of the resource with the labels
+	MergeModeReplace
+)
+
+// setLabelsConcurrency is the maximum number of resources updated by SetLabels at the same time
+const setLabelsConcurrency = 5
+
+type APIClientLabelsInterface interface {
+	GetServerExecute(ctx context.Context, projectId, region, serverId string) (*iaas.Server, error)
+	UpdateServer(ctx context.Context, projectId, region, serverId string) iaas.ApiUpdateServerRequest
+	GetVolumeExecute(ctx context.Context, projectId, region, volumeId string) (*iaas.Volume, error)
+	UpdateVolume(ctx context.Context, projectId, region, volumeId string) iaas.ApiUpdateVolumeRequest
+	GetNetworkExecute(ctx context.Context, projectId, region, networkId string) (*iaas.Network, error)
+	PartialUpdateNetwork(ctx context.Context, projectId, region, networkId string) iaas.ApiPartialUpdateNetworkRequest
+	GetSecurityGroupExecute(ctx context.Context, projectId, region, securityGroupId string) (*iaas.SecurityGroup, error)
+	UpdateSecurityGroup(ctx context.Context, projectId, region, securityGroupId string) iaas.ApiUpdateSecurityGroupRequest
+	GetPublicIPExecute(ctx context.Context, projectId, region, publicIpId string) (*iaas.PublicIp, error)
+	UpdatePublicIP(ctx context.Context, projectId, region, publicIpId string) iaas.ApiUpdatePublicIPRequest
+	GetImageExecute(ctx context.Context, projectId, region, imageId string) (*iaas.Image, error)
+	UpdateImage(ctx context.Context, projectId, region, imageId string) iaas.ApiUpdateImageRequest
+	GetSnapshotExecute(ctx context.Context, projectId, region, snapshotId string) (*iaas.Snapshot, error)
+	UpdateSnapshot(ctx context.Context, projectId, region, snapshotId string) iaas.ApiUpdateSnapshotRequest
+	GetBackupExecute(ctx context.Context, projectId, region, backupId string) (*iaas.Backup, error)
+	UpdateBackup(ctx context.Context, projectId, region, backupId string) iaas.ApiUpdateBackupRequest
+}
+
+// SetLabels sets labels on the resources of a project, which can be of different types, updating up to 5 resources at the same time.
+// With MergeModeAdditive the labels are added to the existing labels of each resource, which are fetched first,
+// with MergeModeReplace the existing labels are replaced.
+//
+// It doesn't stop at the first error: all resources are processed and the errors are returned by resource.
+// The resources which were updated successfully are not part of the result, so an empty result means that all were updated.
+// If ctx is canceled, the resources which weren't processed yet fail with the context error.
+func SetLabels(ctx context.Context, a APIClientLabelsInterface, projectId string, refs []ResourceRef, labels map[string]string, mode MergeMode) map[ResourceRef]error {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		errs   = map[ResourceRef]error{}
+		seen   = map[ResourceRef]bool{}
+		tokens = make(chan struct{}, setLabelsConcurrency)
+	)
+	setErr := func(ref ResourceRef, err error) {
+		mu.Lock()
+		errs[ref] = err
+		mu.Unlock()
+	}
+	for _, ref := range refs {
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+
+		if err := ctx.Err(); err != nil {
+			setErr(ref, err)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			setErr(ref, ctx.Err())
+			continue
+		case tokens <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(ref ResourceRef) {
+			defer wg.Done()
+			defer func() { <-tokens }()
+			if err := setResourceLabels(ctx, a, projectId, ref, labels, mode); err != nil {
+				setErr(ref, err)
+			}
+		}(ref)
+	}
+	wg.Wait()
+	return errs
+}
+
+// setResourceLabels sets the labels of a single resource
+func setResourceLabels(ctx context.Context, a APIClientLabelsInterface, projectId string, ref ResourceRef, labels map[string]string, mode MergeMode) error {
+	var err error
+	switch ref.Type {
+	case ResourceTypeServer:
+		var current map[string]interface{}
+		if mode == MergeModeAdditive {
+			var s *iaas.Server
+			if s, err = a.GetServerExecute(ctx, projectId, ref.Region, ref.Id); err != nil {
+				return err
+			}
+			current = s.GetLabels()
+		}
+		_, err = a.UpdateServer(ctx, projectId, ref.Region, ref.Id).UpdateServerPayload(iaas.UpdateServerPayload{Labels: mergeLabels(current, labels)}).Execute()
+	case ResourceTypeVolume:
+		var current map[string]interface{}
+		if mode == MergeModeAdditive {
+			var s *iaas.Volume
+			if s, err = a.GetVolumeExecute(ctx, projectId, ref.Region, ref.Id); err != nil {
+				return err
+			}
+			current = s.GetLabels()
+		}
+		_, err = a.UpdateVolume(ctx, projectId, ref.Region, ref.Id).UpdateVolumePayload(iaas.UpdateVolumePayload{Labels: mergeLabels(current, labels)}).Execute()
+	case ResourceTypeNetwork:
+		var current map[string]interface{}
+		if mode == MergeModeAdditive {
+			var s *iaas.Network
+			if s, err = a.GetNetworkExecute(ctx, projectId, ref.Region, ref.Id); err != nil {
+				return err
+			}
+			current = s.GetLabels()
+		}
+		err = a.PartialUpdateNetwork(ctx, projectId, ref.Region, ref.Id).PartialUpdateNetworkPayload(iaas.PartialUpdateNetworkPayload{Labels: mergeLabels(current, labels)}).Execute()
+	case ResourceTypeSecurityGroup:
+		var current map[string]interface{}
+		if mode == MergeModeAdditive {
+			var s *iaas.SecurityGroup
+			if s, err = a.GetSecurityGroupExecute(ctx, projectId, ref.Region, ref.Id); err != nil {
+				return err
+			}
+			current = s.GetLabels()
+		}
+		_, err = a.UpdateSecurityGroup(ctx, projectId, ref.Region, ref.Id).UpdateSecurityGroupPayload(iaas.UpdateSecurityGroupPayload{Labels: mergeLabels(current, labels)}).Execute()
+	case ResourceTypePublicIP:
+		var current map[string]interface{}
+		if mode == MergeModeAdditive {
+			var s *iaas.PublicIp
+			if s, err = a.GetPublicIPExecute(ctx, projectId, ref.Region, ref.Id); err != nil {
+				return err
+			}
+			current = s.GetLabels()
+		}
+		_, err = a.UpdatePublicIP(ctx, projectId, ref.Region, ref.Id).UpdatePublicIPPayload(iaas.UpdatePublicIPPayload{Labels: mergeLabels(current, labels)}).Execute()
+	case ResourceTypeImage:
+		var current map[string]interface{}
+		if mode == MergeModeAdditive {
+			var s *iaas.Image
+			if s, err = a.GetImageExecute(ctx, projectId, ref.Region, ref.Id); err != nil {
+				return err
+			}
+			current = s.GetLabels()
+		}
+		_, err = a.UpdateImage(ctx, projectId, ref.Region, ref.Id).UpdateImagePayload(iaas.UpdateImagePayload{Labels: mergeLabels(current, labels)}).Execute()
+	case ResourceTypeSnapshot:
+		var current map[string]interface{}
+		if mode == MergeModeAdditive {
+			var s *iaas.Snapshot
+			if s, err = a.GetSnapshotExecute(ctx, projectId, ref.Region, ref.Id); err != nil {
+				return err
+			}
+			current = s.GetLabels()
+		}
+		_, err = a.UpdateSnapshot(ctx, projectId, ref.Region, ref.Id).UpdateSnapshotPayload(iaas.UpdateSnapshotPayload{Labels: mergeLabels(current, labels)}).Execute()
+	case ResourceTypeBackup:
+		var current map[string]interface{}
+		if mode == MergeModeAdditive {
+			var s *iaas.Backup
+			if s, err = a.GetBackupExecute(ctx, projectId, ref.Region, ref.Id); err != nil {
+				return err
+			}
+			current = s.GetLabels()
+		}
+		_, err = a.UpdateBackup(ctx, projectId, ref.Region, ref.Id).UpdateBackupPayload(iaas.UpdateBackupPayload{Labels: mergeLabels(current, labels)}).Execute()
+	default:
+		return fmt.Errorf("unsupported resource type %q", ref.Type)
+	}
+	return err
+}
+
+// mergeLabels returns a copy of current with the labels added
+func mergeLabels(current map[string]interface{}, labels map[string]string) *map[string]interface{} {
+	merged := make(map[string]interface{}, len(current)+len(labels))
+	for k, v := range current {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return &merged
+}
@@ -2,12 +2,17 @@ package wait
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/stream"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/stackit-sdk-go/services/resourcemanager"
@@ -1094,6 +1099,42 @@ func TestUnrescueServerWaitHandler(t *testing.T) {
 	}
 }
 
+func TestProjectRequestOperation(t *testing.T) {
+	apiClient := &apiClientMocked{
+		requestAction: RequestCreateAction,
+		resourceState: RequestCreatedStatus,
+	}
+	op := ProjectRequestOperation(apiClient, "pid", "eu01", "rid")
+	if op.Name() != "projects/pid/regions/eu01/requests/rid" {
+		t.Fatalf("unexpected name %s", op.Name())
+	}
+	data, err := json.Marshal(op)
+	if err != nil {
+		t.Fatalf("marshalling: %v", err)
+	}
+
+	resumed, err := lro.Resume(data, ProjectRequestPollFunc(apiClient))
+	if err != nil {
+		t.Fatalf("resuming: %v", err)
+	}
+	gotRes, err := resumed.SetThrottle(time.Millisecond).Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	wantRes := &iaas.Request{
+		RequestId:     utils.Ptr("rid"),
+		RequestAction: utils.Ptr(RequestCreateAction),
+		Status:        utils.Ptr(RequestCreatedStatus),
+	}
+	if diff := cmp.Diff(wantRes, gotRes); diff != "" {
+		t.Errorf("unexpected result (-want +got):\n%s", diff)
+	}
+
+	if _, err := lro.New("projects/pid/requests/rid", ProjectRequestPollFunc(apiClient)).Poll(context.Background()); err == nil {
+		t.Errorf("expected an error for an invalid name")
+	}
+}
+
 func TestProjectRequestWaitHandler(t *testing.T) {
 	tests := []struct {
 		desc          string
@@ -1984,7 +2025,7 @@ func TestDeleteNetworkAreaRegionWaitHandler(t *testing.T) {
 
 			handler := DeleteNetworkAreaRegionWaitHandler(context.Background(), apiClient, "pid", "region", "nid")
 
-			gotRes, err := handler.SetTimeout(10 * time.Millisecond).WaitWithContext(context.Background())
+			gotRes, err := handler.SetSleepBeforeWait(0).SetTimeout(10 * time.Millisecond).WaitWithContext(context.Background())
 
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
@@ -1995,3 +2036,164 @@ func TestDeleteNetworkAreaRegionWaitHandler(t *testing.T) {
 		})
 	}
 }
+
+type serverLogMocked struct {
+	logs  []string
+	calls int
+}
+
+func (m *serverLogMocked) GetServerLogExecute(_ context.Context, _, _, _ string) (*iaas.GetServerLog200Response, error) {
+	if m.calls >= len(m.logs) {
+		return nil, &oapierror.GenericOpenAPIError{StatusCode: http.StatusInternalServerError}
+	}
+	m.calls++
+	return &iaas.GetServerLog200Response{Output: utils.Ptr(m.logs[m.calls-1])}, nil
+}
+
+func TestTailServerLog(t *testing.T) {
+	apiClient := &serverLogMocked{
+		logs: []string{"boot\n", "boot\nlogin:\n", "login:\nroot\n"},
+	}
+
+	r := TailServerLog(context.Background(), apiClient, "pid", "eu01", "sid", stream.TailOptions{
+		PollInterval: time.Millisecond,
+		MaxRetries:   1,
+		RetryBackoff: time.Millisecond,
+	})
+	defer r.Close()
+
+	output, err := io.ReadAll(r)
+	if err == nil {
+		t.Fatalf("expected error once the retries are exhausted")
+	}
+	if diff := cmp.Diff(string(output), "boot\nlogin:\nroot\n"); diff != "" {
+		t.Errorf("unexpected output (-got +want):\n%s", diff)
+	}
+}
+
+type labelsMocked struct {
+	APIClientLabelsInterface
+
+	mu             sync.Mutex
+	serverLabels   map[string]interface{}
+	volumeFails    bool
+	updatedServers map[string]map[string]interface{}
+	getCalls       int
+}
+
+type updateServerRequestMocked struct {
+	iaas.ApiUpdateServerRequest
+
+	a       *labelsMocked
+	id      string
+	payload iaas.UpdateServerPayload
+}
+
+func (r *updateServerRequestMocked) UpdateServerPayload(p iaas.UpdateServerPayload) iaas.ApiUpdateServerRequest {
+	r.payload = p
+	return r
+}
+
+func (r *updateServerRequestMocked) Execute() (*iaas.Server, error) {
+	r.a.mu.Lock()
+	defer r.a.mu.Unlock()
+	r.a.updatedServers[r.id] = r.payload.GetLabels()
+	return &iaas.Server{Id: utils.Ptr(r.id)}, nil
+}
+
+func (a *labelsMocked) GetServerExecute(_ context.Context, _, _, serverId string) (*iaas.Server, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.getCalls++
+	return &iaas.Server{Id: utils.Ptr(serverId), Labels: &a.serverLabels}, nil
+}
+
+func (a *labelsMocked) UpdateServer(_ context.Context, _, _, serverId string) iaas.ApiUpdateServerRequest {
+	return &updateServerRequestMocked{a: a, id: serverId}
+}
+
+func (a *labelsMocked) GetVolumeExecute(_ context.Context, _, _, _ string) (*iaas.Volume, error) {
+	return nil, &oapierror.GenericOpenAPIError{StatusCode: http.StatusNotFound}
+}
+
+func TestSetLabels(t *testing.T) {
+	tests := []struct {
+		desc         string
+		mode         MergeMode
+		wantLabels   map[string]interface{}
+		wantGetCalls int
+		wantErrRefs  []ResourceRef
+	}{
+		{
+			desc:         "additive",
+			mode:         MergeModeAdditive,
+			wantLabels:   map[string]interface{}{"team": "infra", "env": "prod", "cost-center": "42"},
+			wantGetCalls: 2,
+			wantErrRefs:  []ResourceRef{{Type: ResourceTypeVolume, Region: "eu01", Id: "vid"}, {Type: "unknown", Id: "xid"}},
+		},
+		{
+			desc:         "replace",
+			mode:         MergeModeReplace,
+			wantLabels:   map[string]interface{}{"env": "prod", "cost-center": "42"},
+			wantGetCalls: 0,
+			wantErrRefs:  []ResourceRef{{Type: "unknown", Id: "xid"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			a := &labelsMocked{
+				serverLabels:   map[string]interface{}{"team": "infra", "env": "dev"},
+				updatedServers: map[string]map[string]interface{}{},
+			}
+			refs := []ResourceRef{
+				{Type: ResourceTypeServer, Region: "eu01", Id: "sid1"},
+				{Type: ResourceTypeServer, Region: "eu01", Id: "sid2"},
+				{Type: ResourceTypeServer, Region: "eu01", Id: "sid2"},
+				{Type: ResourceTypeVolume, Region: "eu01", Id: "vid"},
+				{Type: "unknown", Id: "xid"},
+			}
+			if tt.mode == MergeModeReplace {
+				// Replacing doesn't fetch the volume, so that it would be updated
+				refs = refs[:3]
+				refs = append(refs, ResourceRef{Type: "unknown", Id: "xid"})
+			}
+
+			errs := SetLabels(context.Background(), a, "pid", refs, map[string]string{"env": "prod", "cost-center": "42"}, tt.mode)
+
+			if len(errs) != len(tt.wantErrRefs) {
+				t.Fatalf("expected errors for %v, got %v", tt.wantErrRefs, errs)
+			}
+			for _, ref := range tt.wantErrRefs {
+				if errs[ref] == nil {
+					t.Errorf("expected error for %v", ref)
+				}
+			}
+			if a.getCalls != tt.wantGetCalls {
+				t.Errorf("expected %d get calls, got %d", tt.wantGetCalls, a.getCalls)
+			}
+			wantUpdated := map[string]map[string]interface{}{"sid1": tt.wantLabels, "sid2": tt.wantLabels}
+			if diff := cmp.Diff(wantUpdated, a.updatedServers); diff != "" {
+				t.Errorf("unexpected updated labels (-want +got):\n%s", diff)
+			}
+			if a.serverLabels["env"] != "dev" {
+				t.Errorf("the existing labels were modified")
+			}
+		})
+	}
+}
+
+func TestSetLabelsContextCanceled(t *testing.T) {
+	a := &labelsMocked{updatedServers: map[string]map[string]interface{}{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	refs := []ResourceRef{{Type: ResourceTypeServer, Id: "sid1"}, {Type: ResourceTypeServer, Id: "sid2"}}
+
+	errs := SetLabels(ctx, a, "pid", refs, map[string]string{"env": "prod"}, MergeModeReplace)
+
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+	if len(a.updatedServers) != 0 {
+		t.Errorf("expected no updates, got %v", a.updatedServers)
+	}
+}
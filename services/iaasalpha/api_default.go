@@ -19,10 +19,14 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/labels"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		AddRoutesToRoutingTable Create new routes in a routing table.
@@ -347,81 +351,151 @@ type DefaultApi interface {
 	UpdateRoutingTableOfAreaExecute(ctx context.Context, organizationId string, areaId string, region string, routingTableId string) (*RoutingTable, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiAddRoutesToRoutingTableRequest interface {
 	// Request an addition of routes to a routing table.
 	AddRoutesToRoutingTablePayload(addRoutesToRoutingTablePayload AddRoutesToRoutingTablePayload) ApiAddRoutesToRoutingTableRequest
+	SetQueryParam(key, value string) ApiAddRoutesToRoutingTableRequest
+	AddQueryParam(key, value string) ApiAddRoutesToRoutingTableRequest
+	Clone() ApiAddRoutesToRoutingTableRequest
+	Fingerprint() (string, error)
 	Execute() (*RouteListResponse, error)
 }
 
 type ApiAddRoutingTableToAreaRequest interface {
 	// Request an addition of a routing table to an area.
 	AddRoutingTableToAreaPayload(addRoutingTableToAreaPayload AddRoutingTableToAreaPayload) ApiAddRoutingTableToAreaRequest
+	SetQueryParam(key, value string) ApiAddRoutingTableToAreaRequest
+	AddQueryParam(key, value string) ApiAddRoutingTableToAreaRequest
+	Clone() ApiAddRoutingTableToAreaRequest
+	Fingerprint() (string, error)
 	Execute() (*RoutingTable, error)
 }
 
 type ApiCreateNetworkRequest interface {
 	// Request a network creation.
 	CreateNetworkPayload(createNetworkPayload CreateNetworkPayload) ApiCreateNetworkRequest
+	RetryOnConflict(maxAttempts int) ApiCreateNetworkRequest
+	SetQueryParam(key, value string) ApiCreateNetworkRequest
+	AddQueryParam(key, value string) ApiCreateNetworkRequest
+	Clone() ApiCreateNetworkRequest
+	Fingerprint() (string, error)
 	Execute() (*Network, error)
 }
 
 type ApiDeleteNetworkRequest interface {
+	SetQueryParam(key, value string) ApiDeleteNetworkRequest
+	AddQueryParam(key, value string) ApiDeleteNetworkRequest
+	Clone() ApiDeleteNetworkRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteRouteFromRoutingTableRequest interface {
+	SetQueryParam(key, value string) ApiDeleteRouteFromRoutingTableRequest
+	AddQueryParam(key, value string) ApiDeleteRouteFromRoutingTableRequest
+	Clone() ApiDeleteRouteFromRoutingTableRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteRoutingTableFromAreaRequest interface {
+	SetQueryParam(key, value string) ApiDeleteRoutingTableFromAreaRequest
+	AddQueryParam(key, value string) ApiDeleteRoutingTableFromAreaRequest
+	Clone() ApiDeleteRoutingTableFromAreaRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiGetNetworkRequest interface {
+	SetQueryParam(key, value string) ApiGetNetworkRequest
+	AddQueryParam(key, value string) ApiGetNetworkRequest
+	Clone() ApiGetNetworkRequest
+	Fingerprint() (string, error)
 	Execute() (*Network, error)
 }
 
 type ApiGetRouteOfRoutingTableRequest interface {
+	SetQueryParam(key, value string) ApiGetRouteOfRoutingTableRequest
+	AddQueryParam(key, value string) ApiGetRouteOfRoutingTableRequest
+	Clone() ApiGetRouteOfRoutingTableRequest
+	Fingerprint() (string, error)
 	Execute() (*Route, error)
 }
 
 type ApiGetRoutingTableOfAreaRequest interface {
+	SetQueryParam(key, value string) ApiGetRoutingTableOfAreaRequest
+	AddQueryParam(key, value string) ApiGetRoutingTableOfAreaRequest
+	Clone() ApiGetRoutingTableOfAreaRequest
+	Fingerprint() (string, error)
 	Execute() (*RoutingTable, error)
 }
 
 type ApiListNetworksRequest interface {
 	// Filter resources by labels.
 	LabelSelector(labelSelector string) ApiListNetworksRequest
+	// Filter resources by the labels of a selector built with the labels package of the core module.
+	LabelSelectorFrom(selector labels.Selector) ApiListNetworksRequest
+	SetQueryParam(key, value string) ApiListNetworksRequest
+	AddQueryParam(key, value string) ApiListNetworksRequest
+	Clone() ApiListNetworksRequest
+	Fingerprint() (string, error)
 	Execute() (*NetworkListResponse, error)
 }
 
 type ApiListRoutesOfRoutingTableRequest interface {
 	// Filter resources by labels.
 	LabelSelector(labelSelector string) ApiListRoutesOfRoutingTableRequest
+	// Filter resources by the labels of a selector built with the labels package of the core module.
+	LabelSelectorFrom(selector labels.Selector) ApiListRoutesOfRoutingTableRequest
+	SetQueryParam(key, value string) ApiListRoutesOfRoutingTableRequest
+	AddQueryParam(key, value string) ApiListRoutesOfRoutingTableRequest
+	Clone() ApiListRoutesOfRoutingTableRequest
+	Fingerprint() (string, error)
 	Execute() (*RouteListResponse, error)
 }
 
 type ApiListRoutingTablesOfAreaRequest interface {
 	// Filter resources by labels.
 	LabelSelector(labelSelector string) ApiListRoutingTablesOfAreaRequest
+	// Filter resources by the labels of a selector built with the labels package of the core module.
+	LabelSelectorFrom(selector labels.Selector) ApiListRoutingTablesOfAreaRequest
+	SetQueryParam(key, value string) ApiListRoutingTablesOfAreaRequest
+	AddQueryParam(key, value string) ApiListRoutingTablesOfAreaRequest
+	Clone() ApiListRoutingTablesOfAreaRequest
+	Fingerprint() (string, error)
 	Execute() (*RoutingTableListResponse, error)
 }
 
 type ApiPartialUpdateNetworkRequest interface {
 	// Request an update of a network.
 	PartialUpdateNetworkPayload(partialUpdateNetworkPayload PartialUpdateNetworkPayload) ApiPartialUpdateNetworkRequest
+	SetQueryParam(key, value string) ApiPartialUpdateNetworkRequest
+	AddQueryParam(key, value string) ApiPartialUpdateNetworkRequest
+	Clone() ApiPartialUpdateNetworkRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiUpdateRouteOfRoutingTableRequest interface {
 	// Request an update of a route in a routing table.
 	UpdateRouteOfRoutingTablePayload(updateRouteOfRoutingTablePayload UpdateRouteOfRoutingTablePayload) ApiUpdateRouteOfRoutingTableRequest
+	SetQueryParam(key, value string) ApiUpdateRouteOfRoutingTableRequest
+	AddQueryParam(key, value string) ApiUpdateRouteOfRoutingTableRequest
+	Clone() ApiUpdateRouteOfRoutingTableRequest
+	Fingerprint() (string, error)
 	Execute() (*Route, error)
 }
 
 type ApiUpdateRoutingTableOfAreaRequest interface {
 	// Request an update of a routing table.
 	UpdateRoutingTableOfAreaPayload(updateRoutingTableOfAreaPayload UpdateRoutingTableOfAreaPayload) ApiUpdateRoutingTableOfAreaRequest
+	SetQueryParam(key, value string) ApiUpdateRoutingTableOfAreaRequest
+	AddQueryParam(key, value string) ApiUpdateRoutingTableOfAreaRequest
+	Clone() ApiUpdateRoutingTableOfAreaRequest
+	Fingerprint() (string, error)
 	Execute() (*RoutingTable, error)
 }
 
@@ -436,6 +510,7 @@ type AddRoutesToRoutingTableRequest struct {
 	region                         string
 	routingTableId                 string
 	addRoutesToRoutingTablePayload *AddRoutesToRoutingTablePayload
+	queryParams                    []queryParam
 }
 
 // Request an addition of routes to a routing table.
@@ -445,7 +520,43 @@ func (r AddRoutesToRoutingTableRequest) AddRoutesToRoutingTablePayload(addRoutes
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r AddRoutesToRoutingTableRequest) SetQueryParam(key, value string) ApiAddRoutesToRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r AddRoutesToRoutingTableRequest) AddQueryParam(key, value string) ApiAddRoutesToRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r AddRoutesToRoutingTableRequest) Clone() ApiAddRoutesToRoutingTableRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r AddRoutesToRoutingTableRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "AddRoutesToRoutingTable", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "routingTableId", Value: ParameterValueToString(r.routingTableId, "routingTableId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r AddRoutesToRoutingTableRequest) execute() (*RouteListResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -457,7 +568,7 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.AddRoutesToRoutingTable")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.AddRoutesToRoutingTable", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -471,6 +582,18 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.routingTableId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "routingTableId"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return localVarReturnValue, fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -490,7 +613,7 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		return localVarReturnValue, fmt.Errorf("routingTableId must have less than 36 elements")
 	}
 	if r.addRoutesToRoutingTablePayload == nil {
-		return localVarReturnValue, fmt.Errorf("addRoutesToRoutingTablePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "addRoutesToRoutingTablePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -512,7 +635,8 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.addRoutesToRoutingTablePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "AddRoutesToRoutingTable"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -543,10 +667,14 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -557,7 +685,7 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -568,7 +696,7 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -579,7 +707,7 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -590,7 +718,7 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -601,7 +729,7 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -612,7 +740,7 @@ func (r AddRoutesToRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -667,6 +795,7 @@ type AddRoutingTableToAreaRequest struct {
 	areaId                       string
 	region                       string
 	addRoutingTableToAreaPayload *AddRoutingTableToAreaPayload
+	queryParams                  []queryParam
 }
 
 // Request an addition of a routing table to an area.
@@ -676,7 +805,43 @@ func (r AddRoutingTableToAreaRequest) AddRoutingTableToAreaPayload(addRoutingTab
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r AddRoutingTableToAreaRequest) SetQueryParam(key, value string) ApiAddRoutingTableToAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r AddRoutingTableToAreaRequest) AddQueryParam(key, value string) ApiAddRoutingTableToAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r AddRoutingTableToAreaRequest) Clone() ApiAddRoutingTableToAreaRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r AddRoutingTableToAreaRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "AddRoutingTableToArea", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r AddRoutingTableToAreaRequest) execute() (*RoutingTable, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -688,7 +853,7 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.AddRoutingTableToArea")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.AddRoutingTableToArea", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -701,6 +866,15 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return localVarReturnValue, fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -714,7 +888,7 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 		return localVarReturnValue, fmt.Errorf("areaId must have less than 36 elements")
 	}
 	if r.addRoutingTableToAreaPayload == nil {
-		return localVarReturnValue, fmt.Errorf("addRoutingTableToAreaPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "addRoutingTableToAreaPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -736,7 +910,8 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 	}
 	// body params
 	localVarPostBody = r.addRoutingTableToAreaPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "AddRoutingTableToArea"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -767,10 +942,14 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -781,7 +960,7 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -792,7 +971,7 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -803,7 +982,7 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -814,7 +993,7 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -825,7 +1004,7 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -836,7 +1015,7 @@ func (r AddRoutingTableToAreaRequest) Execute() (*RoutingTable, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -887,6 +1066,8 @@ type CreateNetworkRequest struct {
 	projectId            string
 	region               string
 	createNetworkPayload *CreateNetworkPayload
+	retryOnConflict      int
+	queryParams          []queryParam
 }
 
 // Request a network creation.
@@ -896,7 +1077,50 @@ func (r CreateNetworkRequest) CreateNetworkPayload(createNetworkPayload CreateNe
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateNetworkRequest) RetryOnConflict(maxAttempts int) ApiCreateNetworkRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateNetworkRequest) SetQueryParam(key, value string) ApiCreateNetworkRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateNetworkRequest) AddQueryParam(key, value string) ApiCreateNetworkRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateNetworkRequest) Clone() ApiCreateNetworkRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateNetworkRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateNetworkRequest) Execute() (*Network, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateNetwork", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateNetworkRequest) execute() (*Network, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -908,7 +1132,7 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.CreateNetwork")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.CreateNetwork", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -920,6 +1144,12 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -927,7 +1157,7 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 		return localVarReturnValue, fmt.Errorf("projectId must have less than 36 elements")
 	}
 	if r.createNetworkPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createNetworkPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createNetworkPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -949,7 +1179,8 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 	}
 	// body params
 	localVarPostBody = r.createNetworkPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateNetwork"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -980,10 +1211,14 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -994,7 +1229,7 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1005,7 +1240,7 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1016,7 +1251,7 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1027,7 +1262,7 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1038,7 +1273,7 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1049,7 +1284,7 @@ func (r CreateNetworkRequest) Execute() (*Network, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1092,14 +1327,51 @@ func (a *APIClient) CreateNetworkExecute(ctx context.Context, projectId string,
 }
 
 type DeleteNetworkRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	networkId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	networkId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteNetworkRequest) SetQueryParam(key, value string) ApiDeleteNetworkRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteNetworkRequest) AddQueryParam(key, value string) ApiDeleteNetworkRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteNetworkRequest) Clone() ApiDeleteNetworkRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteNetworkRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteNetworkRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteNetwork", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "networkId", Value: ParameterValueToString(r.networkId, "networkId")})
+	}
+	return err
+}
+
+func (r DeleteNetworkRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1110,7 +1382,7 @@ func (r DeleteNetworkRequest) Execute() error {
 	if !ok {
 		return fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteNetwork")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteNetwork", r.region)
 	if err != nil {
 		return &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1123,6 +1395,15 @@ func (r DeleteNetworkRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return &oapierror.ValidationError{Field: "region"}
+	}
+	if r.networkId == "" {
+		return &oapierror.ValidationError{Field: "networkId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -1153,7 +1434,8 @@ func (r DeleteNetworkRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteNetwork"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1184,10 +1466,14 @@ func (r DeleteNetworkRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1198,7 +1484,7 @@ func (r DeleteNetworkRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1209,7 +1495,7 @@ func (r DeleteNetworkRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1220,7 +1506,7 @@ func (r DeleteNetworkRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1231,7 +1517,7 @@ func (r DeleteNetworkRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1242,7 +1528,7 @@ func (r DeleteNetworkRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1296,9 +1582,46 @@ type DeleteRouteFromRoutingTableRequest struct {
 	region         string
 	routingTableId string
 	routeId        string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteRouteFromRoutingTableRequest) SetQueryParam(key, value string) ApiDeleteRouteFromRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteRouteFromRoutingTableRequest) AddQueryParam(key, value string) ApiDeleteRouteFromRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteRouteFromRoutingTableRequest) Clone() ApiDeleteRouteFromRoutingTableRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteRouteFromRoutingTableRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteRouteFromRoutingTableRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteRouteFromRoutingTable", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "routingTableId", Value: ParameterValueToString(r.routingTableId, "routingTableId")}, oapierror.Parameter{Name: "routeId", Value: ParameterValueToString(r.routeId, "routeId")})
+	}
+	return err
+}
+
+func (r DeleteRouteFromRoutingTableRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1309,7 +1632,7 @@ func (r DeleteRouteFromRoutingTableRequest) Execute() error {
 	if !ok {
 		return fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteRouteFromRoutingTable")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteRouteFromRoutingTable", r.region)
 	if err != nil {
 		return &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1324,6 +1647,21 @@ func (r DeleteRouteFromRoutingTableRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return &oapierror.ValidationError{Field: "region"}
+	}
+	if r.routingTableId == "" {
+		return &oapierror.ValidationError{Field: "routingTableId"}
+	}
+	if r.routeId == "" {
+		return &oapierror.ValidationError{Field: "routeId"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -1366,7 +1704,8 @@ func (r DeleteRouteFromRoutingTableRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteRouteFromRoutingTable"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1397,10 +1736,14 @@ func (r DeleteRouteFromRoutingTableRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1411,7 +1754,7 @@ func (r DeleteRouteFromRoutingTableRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1422,7 +1765,7 @@ func (r DeleteRouteFromRoutingTableRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1433,7 +1776,7 @@ func (r DeleteRouteFromRoutingTableRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1444,7 +1787,7 @@ func (r DeleteRouteFromRoutingTableRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1503,9 +1846,46 @@ type DeleteRoutingTableFromAreaRequest struct {
 	areaId         string
 	region         string
 	routingTableId string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteRoutingTableFromAreaRequest) SetQueryParam(key, value string) ApiDeleteRoutingTableFromAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteRoutingTableFromAreaRequest) AddQueryParam(key, value string) ApiDeleteRoutingTableFromAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteRoutingTableFromAreaRequest) Clone() ApiDeleteRoutingTableFromAreaRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteRoutingTableFromAreaRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteRoutingTableFromAreaRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteRoutingTableFromArea", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "routingTableId", Value: ParameterValueToString(r.routingTableId, "routingTableId")})
+	}
+	return err
+}
+
+func (r DeleteRoutingTableFromAreaRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1516,7 +1896,7 @@ func (r DeleteRoutingTableFromAreaRequest) Execute() error {
 	if !ok {
 		return fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteRoutingTableFromArea")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteRoutingTableFromArea", r.region)
 	if err != nil {
 		return &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1530,6 +1910,18 @@ func (r DeleteRoutingTableFromAreaRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return &oapierror.ValidationError{Field: "region"}
+	}
+	if r.routingTableId == "" {
+		return &oapierror.ValidationError{Field: "routingTableId"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -1566,7 +1958,8 @@ func (r DeleteRoutingTableFromAreaRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteRoutingTableFromArea"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1597,10 +1990,14 @@ func (r DeleteRoutingTableFromAreaRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1611,7 +2008,7 @@ func (r DeleteRoutingTableFromAreaRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1622,7 +2019,7 @@ func (r DeleteRoutingTableFromAreaRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1633,7 +2030,7 @@ func (r DeleteRoutingTableFromAreaRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1644,7 +2041,7 @@ func (r DeleteRoutingTableFromAreaRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1694,14 +2091,51 @@ func (a *APIClient) DeleteRoutingTableFromAreaExecute(ctx context.Context, organ
 }
 
 type GetNetworkRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	networkId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	networkId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetNetworkRequest) SetQueryParam(key, value string) ApiGetNetworkRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetNetworkRequest) AddQueryParam(key, value string) ApiGetNetworkRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetNetworkRequest) Clone() ApiGetNetworkRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetNetworkRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetNetworkRequest) Execute() (*Network, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetNetwork", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "networkId", Value: ParameterValueToString(r.networkId, "networkId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetNetworkRequest) execute() (*Network, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1713,7 +2147,7 @@ func (r GetNetworkRequest) Execute() (*Network, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetNetwork")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetNetwork", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1726,6 +2160,15 @@ func (r GetNetworkRequest) Execute() (*Network, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.networkId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "networkId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -1756,7 +2199,8 @@ func (r GetNetworkRequest) Execute() (*Network, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetNetwork"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1787,10 +2231,14 @@ func (r GetNetworkRequest) Execute() (*Network, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1801,7 +2249,7 @@ func (r GetNetworkRequest) Execute() (*Network, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1812,7 +2260,7 @@ func (r GetNetworkRequest) Execute() (*Network, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1823,7 +2271,7 @@ func (r GetNetworkRequest) Execute() (*Network, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1834,7 +2282,7 @@ func (r GetNetworkRequest) Execute() (*Network, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1845,7 +2293,7 @@ func (r GetNetworkRequest) Execute() (*Network, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1898,9 +2346,46 @@ type GetRouteOfRoutingTableRequest struct {
 	region         string
 	routingTableId string
 	routeId        string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetRouteOfRoutingTableRequest) SetQueryParam(key, value string) ApiGetRouteOfRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetRouteOfRoutingTableRequest) AddQueryParam(key, value string) ApiGetRouteOfRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetRouteOfRoutingTableRequest) Clone() ApiGetRouteOfRoutingTableRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetRouteOfRoutingTableRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetRouteOfRoutingTable", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "routingTableId", Value: ParameterValueToString(r.routingTableId, "routingTableId")}, oapierror.Parameter{Name: "routeId", Value: ParameterValueToString(r.routeId, "routeId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetRouteOfRoutingTableRequest) execute() (*Route, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1912,7 +2397,7 @@ func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetRouteOfRoutingTable")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetRouteOfRoutingTable", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1927,6 +2412,21 @@ func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.routingTableId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "routingTableId"}
+	}
+	if r.routeId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "routeId"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return localVarReturnValue, fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -1969,7 +2469,8 @@ func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetRouteOfRoutingTable"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2000,10 +2501,14 @@ func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2014,7 +2519,7 @@ func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2025,7 +2530,7 @@ func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2036,7 +2541,7 @@ func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2047,7 +2552,7 @@ func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2058,7 +2563,7 @@ func (r GetRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2116,9 +2621,46 @@ type GetRoutingTableOfAreaRequest struct {
 	areaId         string
 	region         string
 	routingTableId string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetRoutingTableOfAreaRequest) SetQueryParam(key, value string) ApiGetRoutingTableOfAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetRoutingTableOfAreaRequest) AddQueryParam(key, value string) ApiGetRoutingTableOfAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetRoutingTableOfAreaRequest) Clone() ApiGetRoutingTableOfAreaRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetRoutingTableOfAreaRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetRoutingTableOfArea", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "routingTableId", Value: ParameterValueToString(r.routingTableId, "routingTableId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetRoutingTableOfAreaRequest) execute() (*RoutingTable, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2130,7 +2672,7 @@ func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetRoutingTableOfArea")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetRoutingTableOfArea", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2144,6 +2686,18 @@ func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.routingTableId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "routingTableId"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return localVarReturnValue, fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -2180,7 +2734,8 @@ func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetRoutingTableOfArea"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2211,10 +2766,14 @@ func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2225,7 +2784,7 @@ func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2236,7 +2795,7 @@ func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2247,7 +2806,7 @@ func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2258,7 +2817,7 @@ func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2269,7 +2828,7 @@ func (r GetRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2318,21 +2877,70 @@ func (a *APIClient) GetRoutingTableOfAreaExecute(ctx context.Context, organizati
 }
 
 type ListNetworksRequest struct {
-	ctx           context.Context
-	apiService    *DefaultApiService
-	projectId     string
-	region        string
-	labelSelector *string
+	ctx              context.Context
+	apiService       *DefaultApiService
+	projectId        string
+	region           string
+	labelSelector    *string
+	labelSelectorErr error
+	queryParams      []queryParam
 }
 
 // Filter resources by labels.
 
 func (r ListNetworksRequest) LabelSelector(labelSelector string) ApiListNetworksRequest {
 	r.labelSelector = &labelSelector
+	r.labelSelectorErr = nil
+	return r
+}
+
+// LabelSelectorFrom filters the resources by the labels of selector, e.g.
+// labels.NewSelector().Eq("env", "prod").In("region", "eu01", "eu02").
+// If selector is invalid, the request fails with its error before it is sent.
+func (r ListNetworksRequest) LabelSelectorFrom(selector labels.Selector) ApiListNetworksRequest {
+	labelSelector, err := selector.Build()
+	r.labelSelector = &labelSelector
+	r.labelSelectorErr = err
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListNetworksRequest) SetQueryParam(key, value string) ApiListNetworksRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListNetworksRequest) AddQueryParam(key, value string) ApiListNetworksRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
 	return r
 }
 
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListNetworksRequest) Clone() ApiListNetworksRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListNetworksRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListNetworks", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListNetworksRequest) execute() (*NetworkListResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2344,7 +2952,7 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListNetworks")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListNetworks", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2356,6 +2964,12 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -2363,6 +2977,9 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 		return localVarReturnValue, fmt.Errorf("projectId must have less than 36 elements")
 	}
 
+	if r.labelSelectorErr != nil {
+		return localVarReturnValue, fmt.Errorf("invalid label selector: %w", r.labelSelectorErr)
+	}
 	if r.labelSelector != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "label_selector", r.labelSelector, "")
 	}
@@ -2383,7 +3000,8 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListNetworks"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2402,6 +3020,10 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2414,10 +3036,14 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2428,7 +3054,7 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2439,7 +3065,7 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2450,7 +3076,7 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2461,7 +3087,7 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2472,7 +3098,7 @@ func (r ListNetworksRequest) Execute() (*NetworkListResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2515,23 +3141,72 @@ func (a *APIClient) ListNetworksExecute(ctx context.Context, projectId string, r
 }
 
 type ListRoutesOfRoutingTableRequest struct {
-	ctx            context.Context
-	apiService     *DefaultApiService
-	organizationId string
-	areaId         string
-	region         string
-	routingTableId string
-	labelSelector  *string
+	ctx              context.Context
+	apiService       *DefaultApiService
+	organizationId   string
+	areaId           string
+	region           string
+	routingTableId   string
+	labelSelector    *string
+	labelSelectorErr error
+	queryParams      []queryParam
 }
 
 // Filter resources by labels.
 
 func (r ListRoutesOfRoutingTableRequest) LabelSelector(labelSelector string) ApiListRoutesOfRoutingTableRequest {
 	r.labelSelector = &labelSelector
+	r.labelSelectorErr = nil
+	return r
+}
+
+// LabelSelectorFrom filters the resources by the labels of selector, e.g.
+// labels.NewSelector().Eq("env", "prod").In("region", "eu01", "eu02").
+// If selector is invalid, the request fails with its error before it is sent.
+func (r ListRoutesOfRoutingTableRequest) LabelSelectorFrom(selector labels.Selector) ApiListRoutesOfRoutingTableRequest {
+	labelSelector, err := selector.Build()
+	r.labelSelector = &labelSelector
+	r.labelSelectorErr = err
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListRoutesOfRoutingTableRequest) SetQueryParam(key, value string) ApiListRoutesOfRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListRoutesOfRoutingTableRequest) AddQueryParam(key, value string) ApiListRoutesOfRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
 	return r
 }
 
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListRoutesOfRoutingTableRequest) Clone() ApiListRoutesOfRoutingTableRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListRoutesOfRoutingTableRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListRoutesOfRoutingTable", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "routingTableId", Value: ParameterValueToString(r.routingTableId, "routingTableId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListRoutesOfRoutingTableRequest) execute() (*RouteListResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2543,7 +3218,7 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListRoutesOfRoutingTable")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListRoutesOfRoutingTable", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2557,6 +3232,18 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.routingTableId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "routingTableId"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return localVarReturnValue, fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -2576,6 +3263,9 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		return localVarReturnValue, fmt.Errorf("routingTableId must have less than 36 elements")
 	}
 
+	if r.labelSelectorErr != nil {
+		return localVarReturnValue, fmt.Errorf("invalid label selector: %w", r.labelSelectorErr)
+	}
 	if r.labelSelector != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "label_selector", r.labelSelector, "")
 	}
@@ -2596,7 +3286,8 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListRoutesOfRoutingTable"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2615,6 +3306,10 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2627,10 +3322,14 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2641,7 +3340,7 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2652,7 +3351,7 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2663,7 +3362,7 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2674,7 +3373,7 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2685,7 +3384,7 @@ func (r ListRoutesOfRoutingTableRequest) Execute() (*RouteListResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2734,22 +3433,71 @@ func (a *APIClient) ListRoutesOfRoutingTableExecute(ctx context.Context, organiz
 }
 
 type ListRoutingTablesOfAreaRequest struct {
-	ctx            context.Context
-	apiService     *DefaultApiService
-	organizationId string
-	areaId         string
-	region         string
-	labelSelector  *string
+	ctx              context.Context
+	apiService       *DefaultApiService
+	organizationId   string
+	areaId           string
+	region           string
+	labelSelector    *string
+	labelSelectorErr error
+	queryParams      []queryParam
 }
 
 // Filter resources by labels.
 
 func (r ListRoutingTablesOfAreaRequest) LabelSelector(labelSelector string) ApiListRoutingTablesOfAreaRequest {
 	r.labelSelector = &labelSelector
+	r.labelSelectorErr = nil
+	return r
+}
+
+// LabelSelectorFrom filters the resources by the labels of selector, e.g.
+// labels.NewSelector().Eq("env", "prod").In("region", "eu01", "eu02").
+// If selector is invalid, the request fails with its error before it is sent.
+func (r ListRoutingTablesOfAreaRequest) LabelSelectorFrom(selector labels.Selector) ApiListRoutingTablesOfAreaRequest {
+	labelSelector, err := selector.Build()
+	r.labelSelector = &labelSelector
+	r.labelSelectorErr = err
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListRoutingTablesOfAreaRequest) SetQueryParam(key, value string) ApiListRoutingTablesOfAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListRoutingTablesOfAreaRequest) AddQueryParam(key, value string) ApiListRoutingTablesOfAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListRoutingTablesOfAreaRequest) Clone() ApiListRoutingTablesOfAreaRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListRoutingTablesOfAreaRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListRoutingTablesOfArea", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListRoutingTablesOfAreaRequest) execute() (*RoutingTableListResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2761,7 +3509,7 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListRoutingTablesOfArea")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListRoutingTablesOfArea", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2774,6 +3522,15 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return localVarReturnValue, fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -2787,6 +3544,9 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 		return localVarReturnValue, fmt.Errorf("areaId must have less than 36 elements")
 	}
 
+	if r.labelSelectorErr != nil {
+		return localVarReturnValue, fmt.Errorf("invalid label selector: %w", r.labelSelectorErr)
+	}
 	if r.labelSelector != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "label_selector", r.labelSelector, "")
 	}
@@ -2807,7 +3567,8 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListRoutingTablesOfArea"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2826,6 +3587,10 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2838,10 +3603,14 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2852,7 +3621,7 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2863,7 +3632,7 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2874,7 +3643,7 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2885,7 +3654,7 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2896,7 +3665,7 @@ func (r ListRoutingTablesOfAreaRequest) Execute() (*RoutingTableListResponse, er
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2948,6 +3717,7 @@ type PartialUpdateNetworkRequest struct {
 	region                      string
 	networkId                   string
 	partialUpdateNetworkPayload *PartialUpdateNetworkPayload
+	queryParams                 []queryParam
 }
 
 // Request an update of a network.
@@ -2957,7 +3727,43 @@ func (r PartialUpdateNetworkRequest) PartialUpdateNetworkPayload(partialUpdateNe
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateNetworkRequest) SetQueryParam(key, value string) ApiPartialUpdateNetworkRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateNetworkRequest) AddQueryParam(key, value string) ApiPartialUpdateNetworkRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateNetworkRequest) Clone() ApiPartialUpdateNetworkRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateNetworkRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateNetworkRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateNetwork", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "networkId", Value: ParameterValueToString(r.networkId, "networkId")})
+	}
+	return err
+}
+
+func (r PartialUpdateNetworkRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPatch
 		localVarPostBody   interface{}
@@ -2968,7 +3774,7 @@ func (r PartialUpdateNetworkRequest) Execute() error {
 	if !ok {
 		return fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.PartialUpdateNetwork")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.PartialUpdateNetwork", r.region)
 	if err != nil {
 		return &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2981,6 +3787,15 @@ func (r PartialUpdateNetworkRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return &oapierror.ValidationError{Field: "region"}
+	}
+	if r.networkId == "" {
+		return &oapierror.ValidationError{Field: "networkId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -2994,7 +3809,7 @@ func (r PartialUpdateNetworkRequest) Execute() error {
 		return fmt.Errorf("networkId must have less than 36 elements")
 	}
 	if r.partialUpdateNetworkPayload == nil {
-		return fmt.Errorf("partialUpdateNetworkPayload is required and must be specified")
+		return &oapierror.ValidationError{Field: "partialUpdateNetworkPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -3016,7 +3831,8 @@ func (r PartialUpdateNetworkRequest) Execute() error {
 	}
 	// body params
 	localVarPostBody = r.partialUpdateNetworkPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateNetwork"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -3047,10 +3863,14 @@ func (r PartialUpdateNetworkRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -3061,7 +3881,7 @@ func (r PartialUpdateNetworkRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -3072,7 +3892,7 @@ func (r PartialUpdateNetworkRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -3083,7 +3903,7 @@ func (r PartialUpdateNetworkRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -3094,7 +3914,7 @@ func (r PartialUpdateNetworkRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -3149,6 +3969,7 @@ type UpdateRouteOfRoutingTableRequest struct {
 	routingTableId                   string
 	routeId                          string
 	updateRouteOfRoutingTablePayload *UpdateRouteOfRoutingTablePayload
+	queryParams                      []queryParam
 }
 
 // Request an update of a route in a routing table.
@@ -3158,7 +3979,43 @@ func (r UpdateRouteOfRoutingTableRequest) UpdateRouteOfRoutingTablePayload(updat
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateRouteOfRoutingTableRequest) SetQueryParam(key, value string) ApiUpdateRouteOfRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateRouteOfRoutingTableRequest) AddQueryParam(key, value string) ApiUpdateRouteOfRoutingTableRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateRouteOfRoutingTableRequest) Clone() ApiUpdateRouteOfRoutingTableRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateRouteOfRoutingTableRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateRouteOfRoutingTable", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "routingTableId", Value: ParameterValueToString(r.routingTableId, "routingTableId")}, oapierror.Parameter{Name: "routeId", Value: ParameterValueToString(r.routeId, "routeId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateRouteOfRoutingTableRequest) execute() (*Route, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -3170,7 +4027,7 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateRouteOfRoutingTable")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateRouteOfRoutingTable", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -3185,6 +4042,21 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.routingTableId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "routingTableId"}
+	}
+	if r.routeId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "routeId"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return localVarReturnValue, fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -3210,7 +4082,7 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		return localVarReturnValue, fmt.Errorf("routeId must have less than 36 elements")
 	}
 	if r.updateRouteOfRoutingTablePayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateRouteOfRoutingTablePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateRouteOfRoutingTablePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -3232,7 +4104,8 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 	}
 	// body params
 	localVarPostBody = r.updateRouteOfRoutingTablePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateRouteOfRoutingTable"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3263,10 +4136,14 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3277,7 +4154,7 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3288,7 +4165,7 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3299,7 +4176,7 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3310,7 +4187,7 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3321,7 +4198,7 @@ func (r UpdateRouteOfRoutingTableRequest) Execute() (*Route, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3380,6 +4257,7 @@ type UpdateRoutingTableOfAreaRequest struct {
 	region                          string
 	routingTableId                  string
 	updateRoutingTableOfAreaPayload *UpdateRoutingTableOfAreaPayload
+	queryParams                     []queryParam
 }
 
 // Request an update of a routing table.
@@ -3389,7 +4267,43 @@ func (r UpdateRoutingTableOfAreaRequest) UpdateRoutingTableOfAreaPayload(updateR
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateRoutingTableOfAreaRequest) SetQueryParam(key, value string) ApiUpdateRoutingTableOfAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateRoutingTableOfAreaRequest) AddQueryParam(key, value string) ApiUpdateRoutingTableOfAreaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateRoutingTableOfAreaRequest) Clone() ApiUpdateRoutingTableOfAreaRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateRoutingTableOfAreaRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateRoutingTableOfArea", oapierror.Parameter{Name: "organizationId", Value: ParameterValueToString(r.organizationId, "organizationId")}, oapierror.Parameter{Name: "areaId", Value: ParameterValueToString(r.areaId, "areaId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "routingTableId", Value: ParameterValueToString(r.routingTableId, "routingTableId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateRoutingTableOfAreaRequest) execute() (*RoutingTable, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -3401,7 +4315,7 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateRoutingTableOfArea")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateRoutingTableOfArea", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -3415,6 +4329,18 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.organizationId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "organizationId"}
+	}
+	if r.areaId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "areaId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.routingTableId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "routingTableId"}
+	}
 	if strlen(r.organizationId) < 36 {
 		return localVarReturnValue, fmt.Errorf("organizationId must have at least 36 elements")
 	}
@@ -3434,7 +4360,7 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		return localVarReturnValue, fmt.Errorf("routingTableId must have less than 36 elements")
 	}
 	if r.updateRoutingTableOfAreaPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateRoutingTableOfAreaPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateRoutingTableOfAreaPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -3456,7 +4382,8 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 	}
 	// body params
 	localVarPostBody = r.updateRoutingTableOfAreaPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateRoutingTableOfArea"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3487,10 +4414,14 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3501,7 +4432,7 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3512,7 +4443,7 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3523,7 +4454,7 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3534,7 +4465,7 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3545,7 +4476,7 @@ func (r UpdateRoutingTableOfAreaRequest) Execute() (*RoutingTable, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/iaasalpha",
+		ServiceName:   "iaasalpha",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,27 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"AddRoutesToRoutingTable",
+	"AddRoutingTableToArea",
+	"CreateNetwork",
+	"DeleteNetwork",
+	"DeleteRouteFromRoutingTable",
+	"DeleteRoutingTableFromArea",
+	"GetNetwork",
+	"GetRouteOfRoutingTable",
+	"GetRoutingTableOfArea",
+	"ListNetworks",
+	"ListRoutesOfRoutingTable",
+	"ListRoutingTablesOfArea",
+	"PartialUpdateNetwork",
+	"UpdateRouteOfRoutingTable",
+	"UpdateRoutingTableOfArea",
+}
+
+func init() {
+	config.RegisterOperations("iaasalpha", operations...)
+}
@@ -0,0 +1,78 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaasalpha"
+)
+
+// CreateNetworkOperation returns the async action of CreateNetworkWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateNetworkPollFunc. The name of the operation is
+// "iaasalpha/CreateNetwork/{projectId}/{region}/{networkId}".
+func CreateNetworkOperation(a APIClientInterface, projectId, region, networkId string) *lro.Operation[iaasalpha.Network] {
+	return createNetworkOperationHandler(a).Operation(projectId, region, networkId)
+}
+
+// CreateNetworkPollFunc returns the lro.PollFunc of the operations returned by CreateNetworkOperation, e.g. to resume
+// one with lro.Resume
+func CreateNetworkPollFunc(a APIClientInterface) lro.PollFunc[iaasalpha.Network] {
+	return createNetworkOperationHandler(a).PollFunc()
+}
+
+func createNetworkOperationHandler(a APIClientInterface) lro.WaitHandler[iaasalpha.Network] {
+	return lro.WaitHandler[iaasalpha.Network]{
+		Kind: "iaasalpha/CreateNetwork",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaasalpha.Network] {
+			return CreateNetworkWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// UpdateNetworkOperation returns the async action of UpdateNetworkWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with UpdateNetworkPollFunc. The name of the operation is
+// "iaasalpha/UpdateNetwork/{projectId}/{region}/{networkId}".
+func UpdateNetworkOperation(a APIClientInterface, projectId, region, networkId string) *lro.Operation[iaasalpha.Network] {
+	return updateNetworkOperationHandler(a).Operation(projectId, region, networkId)
+}
+
+// UpdateNetworkPollFunc returns the lro.PollFunc of the operations returned by UpdateNetworkOperation, e.g. to resume
+// one with lro.Resume
+func UpdateNetworkPollFunc(a APIClientInterface) lro.PollFunc[iaasalpha.Network] {
+	return updateNetworkOperationHandler(a).PollFunc()
+}
+
+func updateNetworkOperationHandler(a APIClientInterface) lro.WaitHandler[iaasalpha.Network] {
+	return lro.WaitHandler[iaasalpha.Network]{
+		Kind: "iaasalpha/UpdateNetwork",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaasalpha.Network] {
+			return UpdateNetworkWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteNetworkOperation returns the async action of DeleteNetworkWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteNetworkPollFunc. The name of the operation is
+// "iaasalpha/DeleteNetwork/{projectId}/{region}/{networkId}".
+func DeleteNetworkOperation(a APIClientInterface, projectId, region, networkId string) *lro.Operation[iaasalpha.Network] {
+	return deleteNetworkOperationHandler(a).Operation(projectId, region, networkId)
+}
+
+// DeleteNetworkPollFunc returns the lro.PollFunc of the operations returned by DeleteNetworkOperation, e.g. to resume
+// one with lro.Resume
+func DeleteNetworkPollFunc(a APIClientInterface) lro.PollFunc[iaasalpha.Network] {
+	return deleteNetworkOperationHandler(a).PollFunc()
+}
+
+func deleteNetworkOperationHandler(a APIClientInterface) lro.WaitHandler[iaasalpha.Network] {
+	return lro.WaitHandler[iaasalpha.Network]{
+		Kind: "iaasalpha/DeleteNetwork",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[iaasalpha.Network] {
+			return DeleteNetworkWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateIntake Method for CreateIntake
@@ -335,46 +338,88 @@ type DefaultApi interface {
 	UpdateIntakeUserExecute(ctx context.Context, projectId string, regionId string, intakeId string, intakeUserId string) (*IntakeUserResponse, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateIntakeRequest interface {
 	CreateIntakePayload(createIntakePayload CreateIntakePayload) ApiCreateIntakeRequest
+	RetryOnConflict(maxAttempts int) ApiCreateIntakeRequest
+	SetQueryParam(key, value string) ApiCreateIntakeRequest
+	AddQueryParam(key, value string) ApiCreateIntakeRequest
+	Clone() ApiCreateIntakeRequest
+	Fingerprint() (string, error)
 	Execute() (*IntakeResponse, error)
 }
 
 type ApiCreateIntakeRunnerRequest interface {
 	CreateIntakeRunnerPayload(createIntakeRunnerPayload CreateIntakeRunnerPayload) ApiCreateIntakeRunnerRequest
+	RetryOnConflict(maxAttempts int) ApiCreateIntakeRunnerRequest
+	SetQueryParam(key, value string) ApiCreateIntakeRunnerRequest
+	AddQueryParam(key, value string) ApiCreateIntakeRunnerRequest
+	Clone() ApiCreateIntakeRunnerRequest
+	Fingerprint() (string, error)
 	Execute() (*IntakeRunnerResponse, error)
 }
 
 type ApiCreateIntakeUserRequest interface {
 	CreateIntakeUserPayload(createIntakeUserPayload CreateIntakeUserPayload) ApiCreateIntakeUserRequest
+	RetryOnConflict(maxAttempts int) ApiCreateIntakeUserRequest
+	SetQueryParam(key, value string) ApiCreateIntakeUserRequest
+	AddQueryParam(key, value string) ApiCreateIntakeUserRequest
+	Clone() ApiCreateIntakeUserRequest
+	Fingerprint() (string, error)
 	Execute() (*IntakeUserResponse, error)
 }
 
 type ApiDeleteIntakeRequest interface {
 	// If true, deletes all child resources. If false, the resource remains in a &#39;deletion state&#39; while child resources exist.
 	Force(force bool) ApiDeleteIntakeRequest
+	SetQueryParam(key, value string) ApiDeleteIntakeRequest
+	AddQueryParam(key, value string) ApiDeleteIntakeRequest
+	Clone() ApiDeleteIntakeRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteIntakeRunnerRequest interface {
 	// If true, deletes all child resources. If false, the resource remains in a &#39;deletion state&#39; while child resources exist.
 	Force(force bool) ApiDeleteIntakeRunnerRequest
+	SetQueryParam(key, value string) ApiDeleteIntakeRunnerRequest
+	AddQueryParam(key, value string) ApiDeleteIntakeRunnerRequest
+	Clone() ApiDeleteIntakeRunnerRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteIntakeUserRequest interface {
+	SetQueryParam(key, value string) ApiDeleteIntakeUserRequest
+	AddQueryParam(key, value string) ApiDeleteIntakeUserRequest
+	Clone() ApiDeleteIntakeUserRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiGetIntakeRequest interface {
+	SetQueryParam(key, value string) ApiGetIntakeRequest
+	AddQueryParam(key, value string) ApiGetIntakeRequest
+	Clone() ApiGetIntakeRequest
+	Fingerprint() (string, error)
 	Execute() (*IntakeResponse, error)
 }
 
 type ApiGetIntakeRunnerRequest interface {
+	SetQueryParam(key, value string) ApiGetIntakeRunnerRequest
+	AddQueryParam(key, value string) ApiGetIntakeRunnerRequest
+	Clone() ApiGetIntakeRunnerRequest
+	Fingerprint() (string, error)
 	Execute() (*IntakeRunnerResponse, error)
 }
 
 type ApiGetIntakeUserRequest interface {
+	SetQueryParam(key, value string) ApiGetIntakeUserRequest
+	AddQueryParam(key, value string) ApiGetIntakeUserRequest
+	Clone() ApiGetIntakeUserRequest
+	Fingerprint() (string, error)
 	Execute() (*IntakeUserResponse, error)
 }
 
@@ -383,6 +428,10 @@ type ApiListIntakeRunnersRequest interface {
 	PageToken(pageToken string) ApiListIntakeRunnersRequest
 	// Maximum number of items to return
 	PageSize(pageSize int32) ApiListIntakeRunnersRequest
+	SetQueryParam(key, value string) ApiListIntakeRunnersRequest
+	AddQueryParam(key, value string) ApiListIntakeRunnersRequest
+	Clone() ApiListIntakeRunnersRequest
+	Fingerprint() (string, error)
 	Execute() (*ListIntakeRunnersResponse, error)
 }
 
@@ -391,6 +440,10 @@ type ApiListIntakeUsersRequest interface {
 	PageToken(pageToken string) ApiListIntakeUsersRequest
 	// Maximum number of items to return
 	PageSize(pageSize int32) ApiListIntakeUsersRequest
+	SetQueryParam(key, value string) ApiListIntakeUsersRequest
+	AddQueryParam(key, value string) ApiListIntakeUsersRequest
+	Clone() ApiListIntakeUsersRequest
+	Fingerprint() (string, error)
 	Execute() (*ListIntakeUsersResponse, error)
 }
 
@@ -399,21 +452,37 @@ type ApiListIntakesRequest interface {
 	PageToken(pageToken string) ApiListIntakesRequest
 	// Maximum number of items to return
 	PageSize(pageSize int32) ApiListIntakesRequest
+	SetQueryParam(key, value string) ApiListIntakesRequest
+	AddQueryParam(key, value string) ApiListIntakesRequest
+	Clone() ApiListIntakesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListIntakesResponse, error)
 }
 
 type ApiUpdateIntakeRequest interface {
 	UpdateIntakePayload(updateIntakePayload UpdateIntakePayload) ApiUpdateIntakeRequest
+	SetQueryParam(key, value string) ApiUpdateIntakeRequest
+	AddQueryParam(key, value string) ApiUpdateIntakeRequest
+	Clone() ApiUpdateIntakeRequest
+	Fingerprint() (string, error)
 	Execute() (*IntakeResponse, error)
 }
 
 type ApiUpdateIntakeRunnerRequest interface {
 	UpdateIntakeRunnerPayload(updateIntakeRunnerPayload UpdateIntakeRunnerPayload) ApiUpdateIntakeRunnerRequest
+	SetQueryParam(key, value string) ApiUpdateIntakeRunnerRequest
+	AddQueryParam(key, value string) ApiUpdateIntakeRunnerRequest
+	Clone() ApiUpdateIntakeRunnerRequest
+	Fingerprint() (string, error)
 	Execute() (*IntakeRunnerResponse, error)
 }
 
 type ApiUpdateIntakeUserRequest interface {
 	UpdateIntakeUserPayload(updateIntakeUserPayload UpdateIntakeUserPayload) ApiUpdateIntakeUserRequest
+	SetQueryParam(key, value string) ApiUpdateIntakeUserRequest
+	AddQueryParam(key, value string) ApiUpdateIntakeUserRequest
+	Clone() ApiUpdateIntakeUserRequest
+	Fingerprint() (string, error)
 	Execute() (*IntakeUserResponse, error)
 }
 
@@ -426,6 +495,8 @@ type CreateIntakeRequest struct {
 	projectId           string
 	regionId            string
 	createIntakePayload *CreateIntakePayload
+	retryOnConflict     int
+	queryParams         []queryParam
 }
 
 func (r CreateIntakeRequest) CreateIntakePayload(createIntakePayload CreateIntakePayload) ApiCreateIntakeRequest {
@@ -433,7 +504,50 @@ func (r CreateIntakeRequest) CreateIntakePayload(createIntakePayload CreateIntak
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateIntakeRequest) RetryOnConflict(maxAttempts int) ApiCreateIntakeRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateIntakeRequest) SetQueryParam(key, value string) ApiCreateIntakeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateIntakeRequest) AddQueryParam(key, value string) ApiCreateIntakeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateIntakeRequest) Clone() ApiCreateIntakeRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateIntakeRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateIntakeRequest) Execute() (*IntakeResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateIntake", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateIntakeRequest) execute() (*IntakeResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -457,8 +571,14 @@ func (r CreateIntakeRequest) Execute() (*IntakeResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
 	if r.createIntakePayload == nil {
-		return localVarReturnValue, fmt.Errorf("createIntakePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createIntakePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -480,7 +600,8 @@ func (r CreateIntakeRequest) Execute() (*IntakeResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createIntakePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateIntake"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -511,11 +632,12 @@ func (r CreateIntakeRequest) Execute() (*IntakeResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -563,6 +685,8 @@ type CreateIntakeRunnerRequest struct {
 	projectId                 string
 	regionId                  string
 	createIntakeRunnerPayload *CreateIntakeRunnerPayload
+	retryOnConflict           int
+	queryParams               []queryParam
 }
 
 func (r CreateIntakeRunnerRequest) CreateIntakeRunnerPayload(createIntakeRunnerPayload CreateIntakeRunnerPayload) ApiCreateIntakeRunnerRequest {
@@ -570,7 +694,50 @@ func (r CreateIntakeRunnerRequest) CreateIntakeRunnerPayload(createIntakeRunnerP
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateIntakeRunnerRequest) RetryOnConflict(maxAttempts int) ApiCreateIntakeRunnerRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateIntakeRunnerRequest) SetQueryParam(key, value string) ApiCreateIntakeRunnerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateIntakeRunnerRequest) AddQueryParam(key, value string) ApiCreateIntakeRunnerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateIntakeRunnerRequest) Clone() ApiCreateIntakeRunnerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateIntakeRunnerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateIntakeRunner", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateIntakeRunnerRequest) execute() (*IntakeRunnerResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -594,8 +761,14 @@ func (r CreateIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
 	if r.createIntakeRunnerPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createIntakeRunnerPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createIntakeRunnerPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -617,7 +790,8 @@ func (r CreateIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createIntakeRunnerPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateIntakeRunner"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -648,11 +822,12 @@ func (r CreateIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -701,6 +876,8 @@ type CreateIntakeUserRequest struct {
 	regionId                string
 	intakeId                string
 	createIntakeUserPayload *CreateIntakeUserPayload
+	retryOnConflict         int
+	queryParams             []queryParam
 }
 
 func (r CreateIntakeUserRequest) CreateIntakeUserPayload(createIntakeUserPayload CreateIntakeUserPayload) ApiCreateIntakeUserRequest {
@@ -708,7 +885,50 @@ func (r CreateIntakeUserRequest) CreateIntakeUserPayload(createIntakeUserPayload
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateIntakeUserRequest) RetryOnConflict(maxAttempts int) ApiCreateIntakeUserRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateIntakeUserRequest) SetQueryParam(key, value string) ApiCreateIntakeUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateIntakeUserRequest) AddQueryParam(key, value string) ApiCreateIntakeUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateIntakeUserRequest) Clone() ApiCreateIntakeUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateIntakeUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateIntakeUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeId", Value: ParameterValueToString(r.intakeId, "intakeId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateIntakeUserRequest) execute() (*IntakeUserResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -733,8 +953,17 @@ func (r CreateIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeId"}
+	}
 	if r.createIntakeUserPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createIntakeUserPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createIntakeUserPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -756,7 +985,8 @@ func (r CreateIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createIntakeUserPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateIntakeUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -787,11 +1017,12 @@ func (r CreateIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -837,12 +1068,13 @@ func (a *APIClient) CreateIntakeUserExecute(ctx context.Context, projectId strin
 }
 
 type DeleteIntakeRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	intakeId   string
-	force      *bool
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	intakeId    string
+	force       *bool
+	queryParams []queryParam
 }
 
 // If true, deletes all child resources. If false, the resource remains in a &#39;deletion state&#39; while child resources exist.
@@ -852,7 +1084,43 @@ func (r DeleteIntakeRequest) Force(force bool) ApiDeleteIntakeRequest {
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteIntakeRequest) SetQueryParam(key, value string) ApiDeleteIntakeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteIntakeRequest) AddQueryParam(key, value string) ApiDeleteIntakeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteIntakeRequest) Clone() ApiDeleteIntakeRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteIntakeRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteIntakeRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteIntake", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeId", Value: ParameterValueToString(r.intakeId, "intakeId")})
+	}
+	return err
+}
+
+func (r DeleteIntakeRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -876,6 +1144,15 @@ func (r DeleteIntakeRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeId == "" {
+		return &oapierror.ValidationError{Field: "intakeId"}
+	}
 
 	if r.force != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "force", r.force, "")
@@ -897,7 +1174,8 @@ func (r DeleteIntakeRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteIntake"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -928,6 +1206,7 @@ func (r DeleteIntakeRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return newErr
 	}
@@ -974,6 +1253,7 @@ type DeleteIntakeRunnerRequest struct {
 	regionId       string
 	intakeRunnerId string
 	force          *bool
+	queryParams    []queryParam
 }
 
 // If true, deletes all child resources. If false, the resource remains in a &#39;deletion state&#39; while child resources exist.
@@ -983,7 +1263,43 @@ func (r DeleteIntakeRunnerRequest) Force(force bool) ApiDeleteIntakeRunnerReques
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteIntakeRunnerRequest) SetQueryParam(key, value string) ApiDeleteIntakeRunnerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteIntakeRunnerRequest) AddQueryParam(key, value string) ApiDeleteIntakeRunnerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteIntakeRunnerRequest) Clone() ApiDeleteIntakeRunnerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteIntakeRunnerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteIntakeRunnerRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteIntakeRunner", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeRunnerId", Value: ParameterValueToString(r.intakeRunnerId, "intakeRunnerId")})
+	}
+	return err
+}
+
+func (r DeleteIntakeRunnerRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1007,6 +1323,15 @@ func (r DeleteIntakeRunnerRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeRunnerId == "" {
+		return &oapierror.ValidationError{Field: "intakeRunnerId"}
+	}
 
 	if r.force != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "force", r.force, "")
@@ -1028,7 +1353,8 @@ func (r DeleteIntakeRunnerRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteIntakeRunner"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1059,6 +1385,7 @@ func (r DeleteIntakeRunnerRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return newErr
 	}
@@ -1105,9 +1432,46 @@ type DeleteIntakeUserRequest struct {
 	regionId     string
 	intakeId     string
 	intakeUserId string
+	queryParams  []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteIntakeUserRequest) SetQueryParam(key, value string) ApiDeleteIntakeUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteIntakeUserRequest) AddQueryParam(key, value string) ApiDeleteIntakeUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteIntakeUserRequest) Clone() ApiDeleteIntakeUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteIntakeUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteIntakeUserRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteIntakeUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeId", Value: ParameterValueToString(r.intakeId, "intakeId")}, oapierror.Parameter{Name: "intakeUserId", Value: ParameterValueToString(r.intakeUserId, "intakeUserId")})
+	}
+	return err
+}
+
+func (r DeleteIntakeUserRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1132,6 +1496,18 @@ func (r DeleteIntakeUserRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeId == "" {
+		return &oapierror.ValidationError{Field: "intakeId"}
+	}
+	if r.intakeUserId == "" {
+		return &oapierror.ValidationError{Field: "intakeUserId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1150,7 +1526,8 @@ func (r DeleteIntakeUserRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteIntakeUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1181,6 +1558,7 @@ func (r DeleteIntakeUserRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return newErr
 	}
@@ -1224,14 +1602,51 @@ func (a *APIClient) DeleteIntakeUserExecute(ctx context.Context, projectId strin
 }
 
 type GetIntakeRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	intakeId   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	intakeId    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetIntakeRequest) SetQueryParam(key, value string) ApiGetIntakeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetIntakeRequest) AddQueryParam(key, value string) ApiGetIntakeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetIntakeRequest) Clone() ApiGetIntakeRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetIntakeRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetIntakeRequest) Execute() (*IntakeResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetIntake", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeId", Value: ParameterValueToString(r.intakeId, "intakeId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetIntakeRequest) execute() (*IntakeResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1256,6 +1671,15 @@ func (r GetIntakeRequest) Execute() (*IntakeResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1274,7 +1698,8 @@ func (r GetIntakeRequest) Execute() (*IntakeResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetIntake"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1305,11 +1730,12 @@ func (r GetIntakeRequest) Execute() (*IntakeResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1360,9 +1786,46 @@ type GetIntakeRunnerRequest struct {
 	projectId      string
 	regionId       string
 	intakeRunnerId string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetIntakeRunnerRequest) SetQueryParam(key, value string) ApiGetIntakeRunnerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetIntakeRunnerRequest) AddQueryParam(key, value string) ApiGetIntakeRunnerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetIntakeRunnerRequest) Clone() ApiGetIntakeRunnerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetIntakeRunnerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetIntakeRunner", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeRunnerId", Value: ParameterValueToString(r.intakeRunnerId, "intakeRunnerId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetIntakeRunnerRequest) execute() (*IntakeRunnerResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1387,6 +1850,15 @@ func (r GetIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeRunnerId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeRunnerId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1405,7 +1877,8 @@ func (r GetIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetIntakeRunner"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1436,11 +1909,12 @@ func (r GetIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1492,9 +1966,46 @@ type GetIntakeUserRequest struct {
 	regionId     string
 	intakeId     string
 	intakeUserId string
+	queryParams  []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetIntakeUserRequest) SetQueryParam(key, value string) ApiGetIntakeUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetIntakeUserRequest) AddQueryParam(key, value string) ApiGetIntakeUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetIntakeUserRequest) Clone() ApiGetIntakeUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetIntakeUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetIntakeUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeId", Value: ParameterValueToString(r.intakeId, "intakeId")}, oapierror.Parameter{Name: "intakeUserId", Value: ParameterValueToString(r.intakeUserId, "intakeUserId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetIntakeUserRequest) execute() (*IntakeUserResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1520,6 +2031,18 @@ func (r GetIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeId"}
+	}
+	if r.intakeUserId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeUserId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1538,7 +2061,8 @@ func (r GetIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetIntakeUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1569,11 +2093,12 @@ func (r GetIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1622,12 +2147,13 @@ func (a *APIClient) GetIntakeUserExecute(ctx context.Context, projectId string,
 }
 
 type ListIntakeRunnersRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	pageToken  *string
-	pageSize   *int32
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	pageToken   *string
+	pageSize    *int32
+	queryParams []queryParam
 }
 
 // A token to retrieve the next page of results.
@@ -1644,7 +2170,43 @@ func (r ListIntakeRunnersRequest) PageSize(pageSize int32) ApiListIntakeRunnersR
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListIntakeRunnersRequest) SetQueryParam(key, value string) ApiListIntakeRunnersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListIntakeRunnersRequest) AddQueryParam(key, value string) ApiListIntakeRunnersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListIntakeRunnersRequest) Clone() ApiListIntakeRunnersRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListIntakeRunnersRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListIntakeRunnersRequest) Execute() (*ListIntakeRunnersResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListIntakeRunners", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListIntakeRunnersRequest) execute() (*ListIntakeRunnersResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1668,6 +2230,12 @@ func (r ListIntakeRunnersRequest) Execute() (*ListIntakeRunnersResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
 
 	if r.pageToken != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "pageToken", r.pageToken, "")
@@ -1692,7 +2260,8 @@ func (r ListIntakeRunnersRequest) Execute() (*ListIntakeRunnersResponse, error)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListIntakeRunners"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1711,6 +2280,10 @@ func (r ListIntakeRunnersRequest) Execute() (*ListIntakeRunnersResponse, error)
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1723,11 +2296,12 @@ func (r ListIntakeRunnersRequest) Execute() (*ListIntakeRunnersResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1770,13 +2344,14 @@ func (a *APIClient) ListIntakeRunnersExecute(ctx context.Context, projectId stri
 }
 
 type ListIntakeUsersRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	intakeId   string
-	pageToken  *string
-	pageSize   *int32
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	intakeId    string
+	pageToken   *string
+	pageSize    *int32
+	queryParams []queryParam
 }
 
 // A token to retrieve the next page of results.
@@ -1793,7 +2368,43 @@ func (r ListIntakeUsersRequest) PageSize(pageSize int32) ApiListIntakeUsersReque
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListIntakeUsersRequest) SetQueryParam(key, value string) ApiListIntakeUsersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListIntakeUsersRequest) AddQueryParam(key, value string) ApiListIntakeUsersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListIntakeUsersRequest) Clone() ApiListIntakeUsersRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListIntakeUsersRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListIntakeUsersRequest) Execute() (*ListIntakeUsersResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListIntakeUsers", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeId", Value: ParameterValueToString(r.intakeId, "intakeId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListIntakeUsersRequest) execute() (*ListIntakeUsersResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1818,6 +2429,15 @@ func (r ListIntakeUsersRequest) Execute() (*ListIntakeUsersResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeId"}
+	}
 
 	if r.pageToken != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "pageToken", r.pageToken, "")
@@ -1842,7 +2462,8 @@ func (r ListIntakeUsersRequest) Execute() (*ListIntakeUsersResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListIntakeUsers"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1861,6 +2482,10 @@ func (r ListIntakeUsersRequest) Execute() (*ListIntakeUsersResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1873,11 +2498,12 @@ func (r ListIntakeUsersRequest) Execute() (*ListIntakeUsersResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1923,12 +2549,13 @@ func (a *APIClient) ListIntakeUsersExecute(ctx context.Context, projectId string
 }
 
 type ListIntakesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	pageToken  *string
-	pageSize   *int32
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	pageToken   *string
+	pageSize    *int32
+	queryParams []queryParam
 }
 
 // A token to retrieve the next page of results.
@@ -1945,7 +2572,43 @@ func (r ListIntakesRequest) PageSize(pageSize int32) ApiListIntakesRequest {
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListIntakesRequest) SetQueryParam(key, value string) ApiListIntakesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListIntakesRequest) AddQueryParam(key, value string) ApiListIntakesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListIntakesRequest) Clone() ApiListIntakesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListIntakesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListIntakesRequest) Execute() (*ListIntakesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListIntakes", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListIntakesRequest) execute() (*ListIntakesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1969,6 +2632,12 @@ func (r ListIntakesRequest) Execute() (*ListIntakesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
 
 	if r.pageToken != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "pageToken", r.pageToken, "")
@@ -1993,7 +2662,8 @@ func (r ListIntakesRequest) Execute() (*ListIntakesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListIntakes"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2012,6 +2682,10 @@ func (r ListIntakesRequest) Execute() (*ListIntakesResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2024,11 +2698,12 @@ func (r ListIntakesRequest) Execute() (*ListIntakesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2077,6 +2752,7 @@ type UpdateIntakeRequest struct {
 	regionId            string
 	intakeId            string
 	updateIntakePayload *UpdateIntakePayload
+	queryParams         []queryParam
 }
 
 func (r UpdateIntakeRequest) UpdateIntakePayload(updateIntakePayload UpdateIntakePayload) ApiUpdateIntakeRequest {
@@ -2084,7 +2760,43 @@ func (r UpdateIntakeRequest) UpdateIntakePayload(updateIntakePayload UpdateIntak
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateIntakeRequest) SetQueryParam(key, value string) ApiUpdateIntakeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateIntakeRequest) AddQueryParam(key, value string) ApiUpdateIntakeRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateIntakeRequest) Clone() ApiUpdateIntakeRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateIntakeRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateIntakeRequest) Execute() (*IntakeResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateIntake", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeId", Value: ParameterValueToString(r.intakeId, "intakeId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateIntakeRequest) execute() (*IntakeResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -2109,8 +2821,17 @@ func (r UpdateIntakeRequest) Execute() (*IntakeResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeId"}
+	}
 	if r.updateIntakePayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateIntakePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateIntakePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2132,7 +2853,8 @@ func (r UpdateIntakeRequest) Execute() (*IntakeResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateIntakePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateIntake"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2163,11 +2885,12 @@ func (r UpdateIntakeRequest) Execute() (*IntakeResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2219,6 +2942,7 @@ type UpdateIntakeRunnerRequest struct {
 	regionId                  string
 	intakeRunnerId            string
 	updateIntakeRunnerPayload *UpdateIntakeRunnerPayload
+	queryParams               []queryParam
 }
 
 func (r UpdateIntakeRunnerRequest) UpdateIntakeRunnerPayload(updateIntakeRunnerPayload UpdateIntakeRunnerPayload) ApiUpdateIntakeRunnerRequest {
@@ -2226,7 +2950,43 @@ func (r UpdateIntakeRunnerRequest) UpdateIntakeRunnerPayload(updateIntakeRunnerP
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateIntakeRunnerRequest) SetQueryParam(key, value string) ApiUpdateIntakeRunnerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateIntakeRunnerRequest) AddQueryParam(key, value string) ApiUpdateIntakeRunnerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateIntakeRunnerRequest) Clone() ApiUpdateIntakeRunnerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateIntakeRunnerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateIntakeRunner", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeRunnerId", Value: ParameterValueToString(r.intakeRunnerId, "intakeRunnerId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateIntakeRunnerRequest) execute() (*IntakeRunnerResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -2251,8 +3011,17 @@ func (r UpdateIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeRunnerId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeRunnerId"}
+	}
 	if r.updateIntakeRunnerPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateIntakeRunnerPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateIntakeRunnerPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2274,7 +3043,8 @@ func (r UpdateIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateIntakeRunnerPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateIntakeRunner"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2305,11 +3075,12 @@ func (r UpdateIntakeRunnerRequest) Execute() (*IntakeRunnerResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2362,6 +3133,7 @@ type UpdateIntakeUserRequest struct {
 	intakeId                string
 	intakeUserId            string
 	updateIntakeUserPayload *UpdateIntakeUserPayload
+	queryParams             []queryParam
 }
 
 func (r UpdateIntakeUserRequest) UpdateIntakeUserPayload(updateIntakeUserPayload UpdateIntakeUserPayload) ApiUpdateIntakeUserRequest {
@@ -2369,7 +3141,43 @@ func (r UpdateIntakeUserRequest) UpdateIntakeUserPayload(updateIntakeUserPayload
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateIntakeUserRequest) SetQueryParam(key, value string) ApiUpdateIntakeUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateIntakeUserRequest) AddQueryParam(key, value string) ApiUpdateIntakeUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateIntakeUserRequest) Clone() ApiUpdateIntakeUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateIntakeUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateIntakeUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "intakeId", Value: ParameterValueToString(r.intakeId, "intakeId")}, oapierror.Parameter{Name: "intakeUserId", Value: ParameterValueToString(r.intakeUserId, "intakeUserId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateIntakeUserRequest) execute() (*IntakeUserResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -2395,8 +3203,20 @@ func (r UpdateIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.intakeId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeId"}
+	}
+	if r.intakeUserId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "intakeUserId"}
+	}
 	if r.updateIntakeUserPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateIntakeUserPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateIntakeUserPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2418,7 +3238,8 @@ func (r UpdateIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateIntakeUserPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateIntakeUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2449,11 +3270,12 @@ func (r UpdateIntakeUserRequest) Execute() (*IntakeUserResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/intake",
+		ServiceName:   "intake",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -39,3 +40,27 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateIntake",
+	"CreateIntakeRunner",
+	"CreateIntakeUser",
+	"DeleteIntake",
+	"DeleteIntakeRunner",
+	"DeleteIntakeUser",
+	"GetIntake",
+	"GetIntakeRunner",
+	"GetIntakeUser",
+	"ListIntakeRunners",
+	"ListIntakeUsers",
+	"ListIntakes",
+	"UpdateIntake",
+	"UpdateIntakeRunner",
+	"UpdateIntakeUser",
+}
+
+func init() {
+	config.RegisterOperations("intake", operations...)
+}
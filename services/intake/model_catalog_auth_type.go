@@ -42,14 +42,9 @@ func (v *CatalogAuthType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CatalogAuthType(value)
-	for _, existing := range AllowedCatalogAuthTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CatalogAuthType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCatalogAuthTypeFromValue returns a pointer to a valid CatalogAuthType
@@ -73,6 +68,17 @@ func (v CatalogAuthType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CatalogAuthType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CatalogAuthType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to catalogAuthType value
 func (v CatalogAuthType) Ptr() *CatalogAuthType {
 	return &v
@@ -246,14 +246,9 @@ func (v *IntakeResponseState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := IntakeResponseState(value)
-	for _, existing := range AllowedIntakeResponseStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid IntakeResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewIntakeResponseStateFromValue returns a pointer to a valid IntakeResponseState
@@ -277,6 +272,17 @@ func (v IntakeResponseState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v IntakeResponseState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v IntakeResponseState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v IntakeResponseState) Ptr() *IntakeResponseState {
 	return &v
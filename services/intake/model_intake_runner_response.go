@@ -201,14 +201,9 @@ func (v *IntakeRunnerResponseState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := IntakeRunnerResponseState(value)
-	for _, existing := range AllowedIntakeRunnerResponseStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid IntakeRunnerResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewIntakeRunnerResponseStateFromValue returns a pointer to a valid IntakeRunnerResponseState
@@ -232,6 +227,17 @@ func (v IntakeRunnerResponseState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v IntakeRunnerResponseState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v IntakeRunnerResponseState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v IntakeRunnerResponseState) Ptr() *IntakeRunnerResponseState {
 	return &v
@@ -181,14 +181,9 @@ func (v *IntakeUserResponseState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := IntakeUserResponseState(value)
-	for _, existing := range AllowedIntakeUserResponseStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid IntakeUserResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewIntakeUserResponseStateFromValue returns a pointer to a valid IntakeUserResponseState
@@ -212,6 +207,17 @@ func (v IntakeUserResponseState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v IntakeUserResponseState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v IntakeUserResponseState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v IntakeUserResponseState) Ptr() *IntakeUserResponseState {
 	return &v
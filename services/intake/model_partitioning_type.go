@@ -44,14 +44,9 @@ func (v *PartitioningType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := PartitioningType(value)
-	for _, existing := range AllowedPartitioningTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid PartitioningType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPartitioningTypeFromValue returns a pointer to a valid PartitioningType
@@ -75,6 +70,17 @@ func (v PartitioningType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v PartitioningType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v PartitioningType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to partitioningType value
 func (v PartitioningType) Ptr() *PartitioningType {
 	return &v
@@ -44,14 +44,9 @@ func (v *PartitioningUpdateType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := PartitioningUpdateType(value)
-	for _, existing := range AllowedPartitioningUpdateTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid PartitioningUpdateType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPartitioningUpdateTypeFromValue returns a pointer to a valid PartitioningUpdateType
@@ -75,6 +70,17 @@ func (v PartitioningUpdateType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v PartitioningUpdateType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v PartitioningUpdateType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to partitioningUpdateType value
 func (v PartitioningUpdateType) Ptr() *PartitioningUpdateType {
 	return &v
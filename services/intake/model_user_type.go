@@ -42,14 +42,9 @@ func (v *UserType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := UserType(value)
-	for _, existing := range AllowedUserTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid UserType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewUserTypeFromValue returns a pointer to a valid UserType
@@ -73,6 +68,17 @@ func (v UserType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v UserType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v UserType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to userType value
 func (v UserType) Ptr() *UserType {
 	return &v
@@ -0,0 +1,149 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/intake"
+)
+
+// CreateOrUpdateIntakeRunnerOperation returns the async action of CreateOrUpdateIntakeRunnerWaitHandler as an
+// lro.Operation, which can be serialized to resume waiting for it in another process with
+// CreateOrUpdateIntakeRunnerPollFunc. The name of the operation is
+// "intake/CreateOrUpdateIntakeRunner/{projectId}/{region}/{intakeRunnerId}".
+func CreateOrUpdateIntakeRunnerOperation(a APIClientInterface, projectId, region, intakeRunnerId string) *lro.Operation[intake.IntakeRunnerResponse] {
+	return createOrUpdateIntakeRunnerOperationHandler(a).Operation(projectId, region, intakeRunnerId)
+}
+
+// CreateOrUpdateIntakeRunnerPollFunc returns the lro.PollFunc of the operations returned by
+// CreateOrUpdateIntakeRunnerOperation, e.g. to resume one with lro.Resume
+func CreateOrUpdateIntakeRunnerPollFunc(a APIClientInterface) lro.PollFunc[intake.IntakeRunnerResponse] {
+	return createOrUpdateIntakeRunnerOperationHandler(a).PollFunc()
+}
+
+func createOrUpdateIntakeRunnerOperationHandler(a APIClientInterface) lro.WaitHandler[intake.IntakeRunnerResponse] {
+	return lro.WaitHandler[intake.IntakeRunnerResponse]{
+		Kind: "intake/CreateOrUpdateIntakeRunner",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[intake.IntakeRunnerResponse] {
+			return CreateOrUpdateIntakeRunnerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteIntakeRunnerOperation returns the async action of DeleteIntakeRunnerWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with DeleteIntakeRunnerPollFunc. The name of the operation
+// is "intake/DeleteIntakeRunner/{projectId}/{region}/{intakeRunnerId}".
+func DeleteIntakeRunnerOperation(a APIClientInterface, projectId, region, intakeRunnerId string) *lro.Operation[intake.IntakeRunnerResponse] {
+	return deleteIntakeRunnerOperationHandler(a).Operation(projectId, region, intakeRunnerId)
+}
+
+// DeleteIntakeRunnerPollFunc returns the lro.PollFunc of the operations returned by DeleteIntakeRunnerOperation, e.g.
+// to resume one with lro.Resume
+func DeleteIntakeRunnerPollFunc(a APIClientInterface) lro.PollFunc[intake.IntakeRunnerResponse] {
+	return deleteIntakeRunnerOperationHandler(a).PollFunc()
+}
+
+func deleteIntakeRunnerOperationHandler(a APIClientInterface) lro.WaitHandler[intake.IntakeRunnerResponse] {
+	return lro.WaitHandler[intake.IntakeRunnerResponse]{
+		Kind: "intake/DeleteIntakeRunner",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[intake.IntakeRunnerResponse] {
+			return DeleteIntakeRunnerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CreateOrUpdateIntakeOperation returns the async action of CreateOrUpdateIntakeWaitHandler as an lro.Operation, which
+// can be serialized to resume waiting for it in another process with CreateOrUpdateIntakePollFunc. The name of the
+// operation is "intake/CreateOrUpdateIntake/{projectId}/{region}/{intakeId}".
+func CreateOrUpdateIntakeOperation(a APIClientInterface, projectId, region, intakeId string) *lro.Operation[intake.IntakeResponse] {
+	return createOrUpdateIntakeOperationHandler(a).Operation(projectId, region, intakeId)
+}
+
+// CreateOrUpdateIntakePollFunc returns the lro.PollFunc of the operations returned by CreateOrUpdateIntakeOperation,
+// e.g. to resume one with lro.Resume
+func CreateOrUpdateIntakePollFunc(a APIClientInterface) lro.PollFunc[intake.IntakeResponse] {
+	return createOrUpdateIntakeOperationHandler(a).PollFunc()
+}
+
+func createOrUpdateIntakeOperationHandler(a APIClientInterface) lro.WaitHandler[intake.IntakeResponse] {
+	return lro.WaitHandler[intake.IntakeResponse]{
+		Kind: "intake/CreateOrUpdateIntake",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[intake.IntakeResponse] {
+			return CreateOrUpdateIntakeWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteIntakeOperation returns the async action of DeleteIntakeWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteIntakePollFunc. The name of the operation is
+// "intake/DeleteIntake/{projectId}/{region}/{intakeId}".
+func DeleteIntakeOperation(a APIClientInterface, projectId, region, intakeId string) *lro.Operation[intake.IntakeResponse] {
+	return deleteIntakeOperationHandler(a).Operation(projectId, region, intakeId)
+}
+
+// DeleteIntakePollFunc returns the lro.PollFunc of the operations returned by DeleteIntakeOperation, e.g. to resume one
+// with lro.Resume
+func DeleteIntakePollFunc(a APIClientInterface) lro.PollFunc[intake.IntakeResponse] {
+	return deleteIntakeOperationHandler(a).PollFunc()
+}
+
+func deleteIntakeOperationHandler(a APIClientInterface) lro.WaitHandler[intake.IntakeResponse] {
+	return lro.WaitHandler[intake.IntakeResponse]{
+		Kind: "intake/DeleteIntake",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[intake.IntakeResponse] {
+			return DeleteIntakeWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CreateOrUpdateIntakeUserOperation returns the async action of CreateOrUpdateIntakeUserWaitHandler as an
+// lro.Operation, which can be serialized to resume waiting for it in another process with
+// CreateOrUpdateIntakeUserPollFunc. The name of the operation is
+// "intake/CreateOrUpdateIntakeUser/{projectId}/{region}/{intakeId}/{intakeUserId}".
+func CreateOrUpdateIntakeUserOperation(a APIClientInterface, projectId, region, intakeId, intakeUserId string) *lro.Operation[intake.IntakeUserResponse] {
+	return createOrUpdateIntakeUserOperationHandler(a).Operation(projectId, region, intakeId, intakeUserId)
+}
+
+// CreateOrUpdateIntakeUserPollFunc returns the lro.PollFunc of the operations returned by
+// CreateOrUpdateIntakeUserOperation, e.g. to resume one with lro.Resume
+func CreateOrUpdateIntakeUserPollFunc(a APIClientInterface) lro.PollFunc[intake.IntakeUserResponse] {
+	return createOrUpdateIntakeUserOperationHandler(a).PollFunc()
+}
+
+func createOrUpdateIntakeUserOperationHandler(a APIClientInterface) lro.WaitHandler[intake.IntakeUserResponse] {
+	return lro.WaitHandler[intake.IntakeUserResponse]{
+		Kind: "intake/CreateOrUpdateIntakeUser",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[intake.IntakeUserResponse] {
+			return CreateOrUpdateIntakeUserWaitHandler(ctx, a, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
+
+// DeleteIntakeUserOperation returns the async action of DeleteIntakeUserWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteIntakeUserPollFunc. The name of the operation is
+// "intake/DeleteIntakeUser/{projectId}/{region}/{intakeId}/{intakeUserId}".
+func DeleteIntakeUserOperation(a APIClientInterface, projectId, region, intakeId, intakeUserId string) *lro.Operation[intake.IntakeUserResponse] {
+	return deleteIntakeUserOperationHandler(a).Operation(projectId, region, intakeId, intakeUserId)
+}
+
+// DeleteIntakeUserPollFunc returns the lro.PollFunc of the operations returned by DeleteIntakeUserOperation, e.g. to
+// resume one with lro.Resume
+func DeleteIntakeUserPollFunc(a APIClientInterface) lro.PollFunc[intake.IntakeUserResponse] {
+	return deleteIntakeUserOperationHandler(a).PollFunc()
+}
+
+func deleteIntakeUserOperationHandler(a APIClientInterface) lro.WaitHandler[intake.IntakeUserResponse] {
+	return lro.WaitHandler[intake.IntakeUserResponse]{
+		Kind: "intake/DeleteIntakeUser",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[intake.IntakeUserResponse] {
+			return DeleteIntakeUserWaitHandler(ctx, a, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateKey Create key
@@ -565,111 +568,217 @@ type DefaultApi interface {
 	VerifyExecute(ctx context.Context, projectId string, regionId string, keyRingId string, keyId string, versionNumber int64) (*VerifiedData, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateKeyRequest interface {
 	CreateKeyPayload(createKeyPayload CreateKeyPayload) ApiCreateKeyRequest
+	RetryOnConflict(maxAttempts int) ApiCreateKeyRequest
+	SetQueryParam(key, value string) ApiCreateKeyRequest
+	AddQueryParam(key, value string) ApiCreateKeyRequest
+	Clone() ApiCreateKeyRequest
+	Fingerprint() (string, error)
 	Execute() (*Key, error)
 }
 
 type ApiCreateKeyRingRequest interface {
 	CreateKeyRingPayload(createKeyRingPayload CreateKeyRingPayload) ApiCreateKeyRingRequest
+	RetryOnConflict(maxAttempts int) ApiCreateKeyRingRequest
+	SetQueryParam(key, value string) ApiCreateKeyRingRequest
+	AddQueryParam(key, value string) ApiCreateKeyRingRequest
+	Clone() ApiCreateKeyRingRequest
+	Fingerprint() (string, error)
 	Execute() (*KeyRing, error)
 }
 
 type ApiCreateWrappingKeyRequest interface {
 	CreateWrappingKeyPayload(createWrappingKeyPayload CreateWrappingKeyPayload) ApiCreateWrappingKeyRequest
+	RetryOnConflict(maxAttempts int) ApiCreateWrappingKeyRequest
+	SetQueryParam(key, value string) ApiCreateWrappingKeyRequest
+	AddQueryParam(key, value string) ApiCreateWrappingKeyRequest
+	Clone() ApiCreateWrappingKeyRequest
+	Fingerprint() (string, error)
 	Execute() (*WrappingKey, error)
 }
 
 type ApiDecryptRequest interface {
 	DecryptPayload(decryptPayload DecryptPayload) ApiDecryptRequest
+	SetQueryParam(key, value string) ApiDecryptRequest
+	AddQueryParam(key, value string) ApiDecryptRequest
+	Clone() ApiDecryptRequest
+	Fingerprint() (string, error)
 	Execute() (*DecryptedData, error)
 }
 
 type ApiDeleteKeyRequest interface {
+	SetQueryParam(key, value string) ApiDeleteKeyRequest
+	AddQueryParam(key, value string) ApiDeleteKeyRequest
+	Clone() ApiDeleteKeyRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteKeyRingRequest interface {
+	SetQueryParam(key, value string) ApiDeleteKeyRingRequest
+	AddQueryParam(key, value string) ApiDeleteKeyRingRequest
+	Clone() ApiDeleteKeyRingRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteWrappingKeyRequest interface {
+	SetQueryParam(key, value string) ApiDeleteWrappingKeyRequest
+	AddQueryParam(key, value string) ApiDeleteWrappingKeyRequest
+	Clone() ApiDeleteWrappingKeyRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDestroyVersionRequest interface {
+	SetQueryParam(key, value string) ApiDestroyVersionRequest
+	AddQueryParam(key, value string) ApiDestroyVersionRequest
+	Clone() ApiDestroyVersionRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDisableVersionRequest interface {
+	SetQueryParam(key, value string) ApiDisableVersionRequest
+	AddQueryParam(key, value string) ApiDisableVersionRequest
+	Clone() ApiDisableVersionRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiEnableVersionRequest interface {
+	SetQueryParam(key, value string) ApiEnableVersionRequest
+	AddQueryParam(key, value string) ApiEnableVersionRequest
+	Clone() ApiEnableVersionRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiEncryptRequest interface {
 	EncryptPayload(encryptPayload EncryptPayload) ApiEncryptRequest
+	SetQueryParam(key, value string) ApiEncryptRequest
+	AddQueryParam(key, value string) ApiEncryptRequest
+	Clone() ApiEncryptRequest
+	Fingerprint() (string, error)
 	Execute() (*EncryptedData, error)
 }
 
 type ApiGetKeyRequest interface {
+	SetQueryParam(key, value string) ApiGetKeyRequest
+	AddQueryParam(key, value string) ApiGetKeyRequest
+	Clone() ApiGetKeyRequest
+	Fingerprint() (string, error)
 	Execute() (*Key, error)
 }
 
 type ApiGetKeyRingRequest interface {
+	SetQueryParam(key, value string) ApiGetKeyRingRequest
+	AddQueryParam(key, value string) ApiGetKeyRingRequest
+	Clone() ApiGetKeyRingRequest
+	Fingerprint() (string, error)
 	Execute() (*KeyRing, error)
 }
 
 type ApiGetVersionRequest interface {
+	SetQueryParam(key, value string) ApiGetVersionRequest
+	AddQueryParam(key, value string) ApiGetVersionRequest
+	Clone() ApiGetVersionRequest
+	Fingerprint() (string, error)
 	Execute() (*Version, error)
 }
 
 type ApiGetWrappingKeyRequest interface {
+	SetQueryParam(key, value string) ApiGetWrappingKeyRequest
+	AddQueryParam(key, value string) ApiGetWrappingKeyRequest
+	Clone() ApiGetWrappingKeyRequest
+	Fingerprint() (string, error)
 	Execute() (*WrappingKey, error)
 }
 
 type ApiImportKeyRequest interface {
 	ImportKeyPayload(importKeyPayload ImportKeyPayload) ApiImportKeyRequest
+	SetQueryParam(key, value string) ApiImportKeyRequest
+	AddQueryParam(key, value string) ApiImportKeyRequest
+	Clone() ApiImportKeyRequest
+	Fingerprint() (string, error)
 	Execute() (*Version, error)
 }
 
 type ApiListKeyRingsRequest interface {
+	SetQueryParam(key, value string) ApiListKeyRingsRequest
+	AddQueryParam(key, value string) ApiListKeyRingsRequest
+	Clone() ApiListKeyRingsRequest
+	Fingerprint() (string, error)
 	Execute() (*KeyRingList, error)
 }
 
 type ApiListKeysRequest interface {
+	SetQueryParam(key, value string) ApiListKeysRequest
+	AddQueryParam(key, value string) ApiListKeysRequest
+	Clone() ApiListKeysRequest
+	Fingerprint() (string, error)
 	Execute() (*KeyList, error)
 }
 
 type ApiListVersionsRequest interface {
+	SetQueryParam(key, value string) ApiListVersionsRequest
+	AddQueryParam(key, value string) ApiListVersionsRequest
+	Clone() ApiListVersionsRequest
+	Fingerprint() (string, error)
 	Execute() (*VersionList, error)
 }
 
 type ApiListWrappingKeysRequest interface {
+	SetQueryParam(key, value string) ApiListWrappingKeysRequest
+	AddQueryParam(key, value string) ApiListWrappingKeysRequest
+	Clone() ApiListWrappingKeysRequest
+	Fingerprint() (string, error)
 	Execute() (*WrappingKeyList, error)
 }
 
 type ApiRestoreKeyRequest interface {
+	SetQueryParam(key, value string) ApiRestoreKeyRequest
+	AddQueryParam(key, value string) ApiRestoreKeyRequest
+	Clone() ApiRestoreKeyRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiRestoreVersionRequest interface {
+	SetQueryParam(key, value string) ApiRestoreVersionRequest
+	AddQueryParam(key, value string) ApiRestoreVersionRequest
+	Clone() ApiRestoreVersionRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiRotateKeyRequest interface {
+	SetQueryParam(key, value string) ApiRotateKeyRequest
+	AddQueryParam(key, value string) ApiRotateKeyRequest
+	Clone() ApiRotateKeyRequest
+	Fingerprint() (string, error)
 	Execute() (*Version, error)
 }
 
 type ApiSignRequest interface {
 	SignPayload(signPayload SignPayload) ApiSignRequest
+	SetQueryParam(key, value string) ApiSignRequest
+	AddQueryParam(key, value string) ApiSignRequest
+	Clone() ApiSignRequest
+	Fingerprint() (string, error)
 	Execute() (*SignedData, error)
 }
 
 type ApiVerifyRequest interface {
 	VerifyPayload(verifyPayload VerifyPayload) ApiVerifyRequest
+	SetQueryParam(key, value string) ApiVerifyRequest
+	AddQueryParam(key, value string) ApiVerifyRequest
+	Clone() ApiVerifyRequest
+	Fingerprint() (string, error)
 	Execute() (*VerifiedData, error)
 }
 
@@ -683,6 +792,8 @@ type CreateKeyRequest struct {
 	regionId         string
 	keyRingId        string
 	createKeyPayload *CreateKeyPayload
+	retryOnConflict  int
+	queryParams      []queryParam
 }
 
 func (r CreateKeyRequest) CreateKeyPayload(createKeyPayload CreateKeyPayload) ApiCreateKeyRequest {
@@ -690,7 +801,50 @@ func (r CreateKeyRequest) CreateKeyPayload(createKeyPayload CreateKeyPayload) Ap
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateKeyRequest) RetryOnConflict(maxAttempts int) ApiCreateKeyRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateKeyRequest) SetQueryParam(key, value string) ApiCreateKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateKeyRequest) AddQueryParam(key, value string) ApiCreateKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateKeyRequest) Clone() ApiCreateKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateKeyRequest) Execute() (*Key, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateKeyRequest) execute() (*Key, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -715,8 +869,17 @@ func (r CreateKeyRequest) Execute() (*Key, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
 	if r.createKeyPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createKeyPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createKeyPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -738,7 +901,8 @@ func (r CreateKeyRequest) Execute() (*Key, error) {
 	}
 	// body params
 	localVarPostBody = r.createKeyPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -769,10 +933,14 @@ func (r CreateKeyRequest) Execute() (*Key, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -783,7 +951,7 @@ func (r CreateKeyRequest) Execute() (*Key, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -794,7 +962,7 @@ func (r CreateKeyRequest) Execute() (*Key, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -805,7 +973,7 @@ func (r CreateKeyRequest) Execute() (*Key, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -816,7 +984,7 @@ func (r CreateKeyRequest) Execute() (*Key, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -867,6 +1035,8 @@ type CreateKeyRingRequest struct {
 	projectId            string
 	regionId             string
 	createKeyRingPayload *CreateKeyRingPayload
+	retryOnConflict      int
+	queryParams          []queryParam
 }
 
 func (r CreateKeyRingRequest) CreateKeyRingPayload(createKeyRingPayload CreateKeyRingPayload) ApiCreateKeyRingRequest {
@@ -874,7 +1044,50 @@ func (r CreateKeyRingRequest) CreateKeyRingPayload(createKeyRingPayload CreateKe
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateKeyRingRequest) RetryOnConflict(maxAttempts int) ApiCreateKeyRingRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateKeyRingRequest) SetQueryParam(key, value string) ApiCreateKeyRingRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateKeyRingRequest) AddQueryParam(key, value string) ApiCreateKeyRingRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateKeyRingRequest) Clone() ApiCreateKeyRingRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateKeyRingRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateKeyRingRequest) Execute() (*KeyRing, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateKeyRing", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateKeyRingRequest) execute() (*KeyRing, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -898,8 +1111,14 @@ func (r CreateKeyRingRequest) Execute() (*KeyRing, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
 	if r.createKeyRingPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createKeyRingPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createKeyRingPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -921,7 +1140,8 @@ func (r CreateKeyRingRequest) Execute() (*KeyRing, error) {
 	}
 	// body params
 	localVarPostBody = r.createKeyRingPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateKeyRing"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -952,10 +1172,14 @@ func (r CreateKeyRingRequest) Execute() (*KeyRing, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -966,7 +1190,7 @@ func (r CreateKeyRingRequest) Execute() (*KeyRing, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -977,7 +1201,7 @@ func (r CreateKeyRingRequest) Execute() (*KeyRing, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -988,7 +1212,7 @@ func (r CreateKeyRingRequest) Execute() (*KeyRing, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1037,6 +1261,8 @@ type CreateWrappingKeyRequest struct {
 	regionId                 string
 	keyRingId                string
 	createWrappingKeyPayload *CreateWrappingKeyPayload
+	retryOnConflict          int
+	queryParams              []queryParam
 }
 
 func (r CreateWrappingKeyRequest) CreateWrappingKeyPayload(createWrappingKeyPayload CreateWrappingKeyPayload) ApiCreateWrappingKeyRequest {
@@ -1044,7 +1270,50 @@ func (r CreateWrappingKeyRequest) CreateWrappingKeyPayload(createWrappingKeyPayl
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateWrappingKeyRequest) RetryOnConflict(maxAttempts int) ApiCreateWrappingKeyRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateWrappingKeyRequest) SetQueryParam(key, value string) ApiCreateWrappingKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateWrappingKeyRequest) AddQueryParam(key, value string) ApiCreateWrappingKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateWrappingKeyRequest) Clone() ApiCreateWrappingKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateWrappingKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateWrappingKeyRequest) Execute() (*WrappingKey, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateWrappingKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateWrappingKeyRequest) execute() (*WrappingKey, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1069,8 +1338,17 @@ func (r CreateWrappingKeyRequest) Execute() (*WrappingKey, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
 	if r.createWrappingKeyPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createWrappingKeyPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createWrappingKeyPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1092,7 +1370,8 @@ func (r CreateWrappingKeyRequest) Execute() (*WrappingKey, error) {
 	}
 	// body params
 	localVarPostBody = r.createWrappingKeyPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateWrappingKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1123,10 +1402,14 @@ func (r CreateWrappingKeyRequest) Execute() (*WrappingKey, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1137,7 +1420,7 @@ func (r CreateWrappingKeyRequest) Execute() (*WrappingKey, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1148,7 +1431,7 @@ func (r CreateWrappingKeyRequest) Execute() (*WrappingKey, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1159,7 +1442,7 @@ func (r CreateWrappingKeyRequest) Execute() (*WrappingKey, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1170,7 +1453,7 @@ func (r CreateWrappingKeyRequest) Execute() (*WrappingKey, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1224,6 +1507,7 @@ type DecryptRequest struct {
 	keyId          string
 	versionNumber  int64
 	decryptPayload *DecryptPayload
+	queryParams    []queryParam
 }
 
 func (r DecryptRequest) DecryptPayload(decryptPayload DecryptPayload) ApiDecryptRequest {
@@ -1231,7 +1515,43 @@ func (r DecryptRequest) DecryptPayload(decryptPayload DecryptPayload) ApiDecrypt
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DecryptRequest) SetQueryParam(key, value string) ApiDecryptRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DecryptRequest) AddQueryParam(key, value string) ApiDecryptRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DecryptRequest) Clone() ApiDecryptRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DecryptRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DecryptRequest) Execute() (*DecryptedData, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "Decrypt", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")}, oapierror.Parameter{Name: "versionNumber", Value: ParameterValueToString(r.versionNumber, "versionNumber")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DecryptRequest) execute() (*DecryptedData, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1258,8 +1578,20 @@ func (r DecryptRequest) Execute() (*DecryptedData, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 	if r.decryptPayload == nil {
-		return localVarReturnValue, fmt.Errorf("decryptPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "decryptPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1281,7 +1613,8 @@ func (r DecryptRequest) Execute() (*DecryptedData, error) {
 	}
 	// body params
 	localVarPostBody = r.decryptPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "Decrypt"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1312,10 +1645,14 @@ func (r DecryptRequest) Execute() (*DecryptedData, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1326,7 +1663,7 @@ func (r DecryptRequest) Execute() (*DecryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1337,7 +1674,7 @@ func (r DecryptRequest) Execute() (*DecryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1348,7 +1685,7 @@ func (r DecryptRequest) Execute() (*DecryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1359,7 +1696,7 @@ func (r DecryptRequest) Execute() (*DecryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1370,7 +1707,7 @@ func (r DecryptRequest) Execute() (*DecryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 503 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1381,7 +1718,7 @@ func (r DecryptRequest) Execute() (*DecryptedData, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1433,15 +1770,52 @@ func (a *APIClient) DecryptExecute(ctx context.Context, projectId string, region
 }
 
 type DeleteKeyRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	keyRingId  string
-	keyId      string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	keyRingId   string
+	keyId       string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteKeyRequest) SetQueryParam(key, value string) ApiDeleteKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteKeyRequest) AddQueryParam(key, value string) ApiDeleteKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteKeyRequest) Clone() ApiDeleteKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteKeyRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")})
+	}
+	return err
+}
+
+func (r DeleteKeyRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1466,6 +1840,18 @@ func (r DeleteKeyRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1484,7 +1870,8 @@ func (r DeleteKeyRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1515,10 +1902,14 @@ func (r DeleteKeyRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1529,7 +1920,7 @@ func (r DeleteKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1540,7 +1931,7 @@ func (r DeleteKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1551,7 +1942,7 @@ func (r DeleteKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1562,7 +1953,7 @@ func (r DeleteKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1612,14 +2003,51 @@ func (a *APIClient) DeleteKeyExecute(ctx context.Context, projectId string, regi
 }
 
 type DeleteKeyRingRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	keyRingId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	keyRingId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteKeyRingRequest) SetQueryParam(key, value string) ApiDeleteKeyRingRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteKeyRingRequest) AddQueryParam(key, value string) ApiDeleteKeyRingRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteKeyRingRequest) Clone() ApiDeleteKeyRingRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteKeyRingRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteKeyRingRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteKeyRing", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")})
+	}
+	return err
+}
+
+func (r DeleteKeyRingRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1643,6 +2071,15 @@ func (r DeleteKeyRingRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return &oapierror.ValidationError{Field: "keyRingId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1661,7 +2098,8 @@ func (r DeleteKeyRingRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteKeyRing"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1692,10 +2130,14 @@ func (r DeleteKeyRingRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1706,7 +2148,7 @@ func (r DeleteKeyRingRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1717,7 +2159,7 @@ func (r DeleteKeyRingRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1728,7 +2170,7 @@ func (r DeleteKeyRingRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1739,7 +2181,7 @@ func (r DeleteKeyRingRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1792,9 +2234,46 @@ type DeleteWrappingKeyRequest struct {
 	regionId      string
 	keyRingId     string
 	wrappingKeyId string
+	queryParams   []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteWrappingKeyRequest) SetQueryParam(key, value string) ApiDeleteWrappingKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteWrappingKeyRequest) AddQueryParam(key, value string) ApiDeleteWrappingKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteWrappingKeyRequest) Clone() ApiDeleteWrappingKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteWrappingKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteWrappingKeyRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteWrappingKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "wrappingKeyId", Value: ParameterValueToString(r.wrappingKeyId, "wrappingKeyId")})
+	}
+	return err
+}
+
+func (r DeleteWrappingKeyRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1819,6 +2298,18 @@ func (r DeleteWrappingKeyRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.wrappingKeyId == "" {
+		return &oapierror.ValidationError{Field: "wrappingKeyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1837,7 +2328,8 @@ func (r DeleteWrappingKeyRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteWrappingKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1868,10 +2360,14 @@ func (r DeleteWrappingKeyRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1882,7 +2378,7 @@ func (r DeleteWrappingKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1893,7 +2389,7 @@ func (r DeleteWrappingKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1904,7 +2400,7 @@ func (r DeleteWrappingKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1915,7 +2411,7 @@ func (r DeleteWrappingKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1972,9 +2468,46 @@ type DestroyVersionRequest struct {
 	keyRingId     string
 	keyId         string
 	versionNumber int64
+	queryParams   []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DestroyVersionRequest) SetQueryParam(key, value string) ApiDestroyVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DestroyVersionRequest) AddQueryParam(key, value string) ApiDestroyVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DestroyVersionRequest) Clone() ApiDestroyVersionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DestroyVersionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DestroyVersionRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DestroyVersion", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")}, oapierror.Parameter{Name: "versionNumber", Value: ParameterValueToString(r.versionNumber, "versionNumber")})
+	}
+	return err
+}
+
+func (r DestroyVersionRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
@@ -2000,6 +2533,18 @@ func (r DestroyVersionRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2018,7 +2563,8 @@ func (r DestroyVersionRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DestroyVersion"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -2049,10 +2595,14 @@ func (r DestroyVersionRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2063,7 +2613,7 @@ func (r DestroyVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2074,7 +2624,7 @@ func (r DestroyVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2085,7 +2635,7 @@ func (r DestroyVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2096,7 +2646,7 @@ func (r DestroyVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2156,9 +2706,46 @@ type DisableVersionRequest struct {
 	keyRingId     string
 	keyId         string
 	versionNumber int64
+	queryParams   []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DisableVersionRequest) SetQueryParam(key, value string) ApiDisableVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DisableVersionRequest) AddQueryParam(key, value string) ApiDisableVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DisableVersionRequest) Clone() ApiDisableVersionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DisableVersionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DisableVersionRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DisableVersion", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")}, oapierror.Parameter{Name: "versionNumber", Value: ParameterValueToString(r.versionNumber, "versionNumber")})
+	}
+	return err
+}
+
+func (r DisableVersionRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
@@ -2184,6 +2771,18 @@ func (r DisableVersionRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2202,7 +2801,8 @@ func (r DisableVersionRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DisableVersion"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -2233,10 +2833,14 @@ func (r DisableVersionRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2247,7 +2851,7 @@ func (r DisableVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2258,7 +2862,7 @@ func (r DisableVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2269,7 +2873,7 @@ func (r DisableVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2280,7 +2884,7 @@ func (r DisableVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2340,9 +2944,46 @@ type EnableVersionRequest struct {
 	keyRingId     string
 	keyId         string
 	versionNumber int64
+	queryParams   []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r EnableVersionRequest) SetQueryParam(key, value string) ApiEnableVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r EnableVersionRequest) AddQueryParam(key, value string) ApiEnableVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r EnableVersionRequest) Clone() ApiEnableVersionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r EnableVersionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r EnableVersionRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "EnableVersion", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")}, oapierror.Parameter{Name: "versionNumber", Value: ParameterValueToString(r.versionNumber, "versionNumber")})
+	}
+	return err
+}
+
+func (r EnableVersionRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
@@ -2368,6 +3009,18 @@ func (r EnableVersionRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2386,7 +3039,8 @@ func (r EnableVersionRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "EnableVersion"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -2417,10 +3071,14 @@ func (r EnableVersionRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2431,7 +3089,7 @@ func (r EnableVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2442,7 +3100,7 @@ func (r EnableVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2453,7 +3111,7 @@ func (r EnableVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2464,7 +3122,7 @@ func (r EnableVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -2525,6 +3183,7 @@ type EncryptRequest struct {
 	keyId          string
 	versionNumber  int64
 	encryptPayload *EncryptPayload
+	queryParams    []queryParam
 }
 
 func (r EncryptRequest) EncryptPayload(encryptPayload EncryptPayload) ApiEncryptRequest {
@@ -2532,7 +3191,43 @@ func (r EncryptRequest) EncryptPayload(encryptPayload EncryptPayload) ApiEncrypt
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r EncryptRequest) SetQueryParam(key, value string) ApiEncryptRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r EncryptRequest) AddQueryParam(key, value string) ApiEncryptRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r EncryptRequest) Clone() ApiEncryptRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r EncryptRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r EncryptRequest) Execute() (*EncryptedData, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "Encrypt", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")}, oapierror.Parameter{Name: "versionNumber", Value: ParameterValueToString(r.versionNumber, "versionNumber")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r EncryptRequest) execute() (*EncryptedData, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2559,8 +3254,20 @@ func (r EncryptRequest) Execute() (*EncryptedData, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 	if r.encryptPayload == nil {
-		return localVarReturnValue, fmt.Errorf("encryptPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "encryptPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2582,7 +3289,8 @@ func (r EncryptRequest) Execute() (*EncryptedData, error) {
 	}
 	// body params
 	localVarPostBody = r.encryptPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "Encrypt"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2613,10 +3321,14 @@ func (r EncryptRequest) Execute() (*EncryptedData, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2627,7 +3339,7 @@ func (r EncryptRequest) Execute() (*EncryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2638,7 +3350,7 @@ func (r EncryptRequest) Execute() (*EncryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2649,7 +3361,7 @@ func (r EncryptRequest) Execute() (*EncryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2660,7 +3372,7 @@ func (r EncryptRequest) Execute() (*EncryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2671,7 +3383,7 @@ func (r EncryptRequest) Execute() (*EncryptedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 503 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2682,7 +3394,7 @@ func (r EncryptRequest) Execute() (*EncryptedData, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2734,15 +3446,52 @@ func (a *APIClient) EncryptExecute(ctx context.Context, projectId string, region
 }
 
 type GetKeyRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	keyRingId  string
-	keyId      string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	keyRingId   string
+	keyId       string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetKeyRequest) SetQueryParam(key, value string) ApiGetKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetKeyRequest) AddQueryParam(key, value string) ApiGetKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetKeyRequest) Clone() ApiGetKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetKeyRequest) Execute() (*Key, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetKeyRequest) execute() (*Key, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2768,6 +3517,18 @@ func (r GetKeyRequest) Execute() (*Key, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2786,7 +3547,8 @@ func (r GetKeyRequest) Execute() (*Key, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2817,10 +3579,14 @@ func (r GetKeyRequest) Execute() (*Key, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2831,7 +3597,7 @@ func (r GetKeyRequest) Execute() (*Key, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2842,7 +3608,7 @@ func (r GetKeyRequest) Execute() (*Key, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2853,7 +3619,7 @@ func (r GetKeyRequest) Execute() (*Key, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2864,7 +3630,7 @@ func (r GetKeyRequest) Execute() (*Key, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2913,17 +3679,54 @@ func (a *APIClient) GetKeyExecute(ctx context.Context, projectId string, regionI
 }
 
 type GetKeyRingRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	keyRingId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	keyRingId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetKeyRingRequest) SetQueryParam(key, value string) ApiGetKeyRingRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
 }
 
-func (r GetKeyRingRequest) Execute() (*KeyRing, error) {
-	var (
-		localVarHTTPMethod  = http.MethodGet
-		localVarPostBody    interface{}
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetKeyRingRequest) AddQueryParam(key, value string) ApiGetKeyRingRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetKeyRingRequest) Clone() ApiGetKeyRingRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetKeyRingRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
+func (r GetKeyRingRequest) Execute() (*KeyRing, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetKeyRing", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetKeyRingRequest) execute() (*KeyRing, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
 		formFiles           []formFile
 		localVarReturnValue *KeyRing
 	)
@@ -2945,6 +3748,15 @@ func (r GetKeyRingRequest) Execute() (*KeyRing, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2963,7 +3775,8 @@ func (r GetKeyRingRequest) Execute() (*KeyRing, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetKeyRing"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2994,10 +3807,14 @@ func (r GetKeyRingRequest) Execute() (*KeyRing, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3008,7 +3825,7 @@ func (r GetKeyRingRequest) Execute() (*KeyRing, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3019,7 +3836,7 @@ func (r GetKeyRingRequest) Execute() (*KeyRing, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3030,7 +3847,7 @@ func (r GetKeyRingRequest) Execute() (*KeyRing, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3041,7 +3858,7 @@ func (r GetKeyRingRequest) Execute() (*KeyRing, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3094,9 +3911,46 @@ type GetVersionRequest struct {
 	keyRingId     string
 	keyId         string
 	versionNumber int64
+	queryParams   []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetVersionRequest) SetQueryParam(key, value string) ApiGetVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetVersionRequest) AddQueryParam(key, value string) ApiGetVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetVersionRequest) Clone() ApiGetVersionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetVersionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetVersionRequest) Execute() (*Version, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetVersion", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")}, oapierror.Parameter{Name: "versionNumber", Value: ParameterValueToString(r.versionNumber, "versionNumber")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetVersionRequest) execute() (*Version, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3123,6 +3977,18 @@ func (r GetVersionRequest) Execute() (*Version, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3141,7 +4007,8 @@ func (r GetVersionRequest) Execute() (*Version, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetVersion"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3172,10 +4039,14 @@ func (r GetVersionRequest) Execute() (*Version, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3186,7 +4057,7 @@ func (r GetVersionRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3197,7 +4068,7 @@ func (r GetVersionRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3208,7 +4079,7 @@ func (r GetVersionRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3219,7 +4090,7 @@ func (r GetVersionRequest) Execute() (*Version, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3277,9 +4148,46 @@ type GetWrappingKeyRequest struct {
 	regionId      string
 	keyRingId     string
 	wrappingKeyId string
+	queryParams   []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetWrappingKeyRequest) SetQueryParam(key, value string) ApiGetWrappingKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetWrappingKeyRequest) AddQueryParam(key, value string) ApiGetWrappingKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetWrappingKeyRequest) Clone() ApiGetWrappingKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetWrappingKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetWrappingKeyRequest) Execute() (*WrappingKey, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetWrappingKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "wrappingKeyId", Value: ParameterValueToString(r.wrappingKeyId, "wrappingKeyId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetWrappingKeyRequest) execute() (*WrappingKey, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3305,6 +4213,18 @@ func (r GetWrappingKeyRequest) Execute() (*WrappingKey, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.wrappingKeyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "wrappingKeyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3323,7 +4243,8 @@ func (r GetWrappingKeyRequest) Execute() (*WrappingKey, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetWrappingKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3354,10 +4275,14 @@ func (r GetWrappingKeyRequest) Execute() (*WrappingKey, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3368,7 +4293,7 @@ func (r GetWrappingKeyRequest) Execute() (*WrappingKey, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3379,7 +4304,7 @@ func (r GetWrappingKeyRequest) Execute() (*WrappingKey, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3390,7 +4315,7 @@ func (r GetWrappingKeyRequest) Execute() (*WrappingKey, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3401,7 +4326,7 @@ func (r GetWrappingKeyRequest) Execute() (*WrappingKey, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3457,6 +4382,7 @@ type ImportKeyRequest struct {
 	keyRingId        string
 	keyId            string
 	importKeyPayload *ImportKeyPayload
+	queryParams      []queryParam
 }
 
 func (r ImportKeyRequest) ImportKeyPayload(importKeyPayload ImportKeyPayload) ApiImportKeyRequest {
@@ -3464,7 +4390,43 @@ func (r ImportKeyRequest) ImportKeyPayload(importKeyPayload ImportKeyPayload) Ap
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ImportKeyRequest) SetQueryParam(key, value string) ApiImportKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ImportKeyRequest) AddQueryParam(key, value string) ApiImportKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ImportKeyRequest) Clone() ApiImportKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ImportKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ImportKeyRequest) Execute() (*Version, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ImportKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ImportKeyRequest) execute() (*Version, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -3490,8 +4452,20 @@ func (r ImportKeyRequest) Execute() (*Version, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 	if r.importKeyPayload == nil {
-		return localVarReturnValue, fmt.Errorf("importKeyPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "importKeyPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -3513,7 +4487,8 @@ func (r ImportKeyRequest) Execute() (*Version, error) {
 	}
 	// body params
 	localVarPostBody = r.importKeyPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ImportKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3544,10 +4519,14 @@ func (r ImportKeyRequest) Execute() (*Version, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3558,7 +4537,7 @@ func (r ImportKeyRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3569,7 +4548,7 @@ func (r ImportKeyRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3580,7 +4559,7 @@ func (r ImportKeyRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3591,7 +4570,7 @@ func (r ImportKeyRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3602,7 +4581,7 @@ func (r ImportKeyRequest) Execute() (*Version, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3651,13 +4630,50 @@ func (a *APIClient) ImportKeyExecute(ctx context.Context, projectId string, regi
 }
 
 type ListKeyRingsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListKeyRingsRequest) SetQueryParam(key, value string) ApiListKeyRingsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListKeyRingsRequest) AddQueryParam(key, value string) ApiListKeyRingsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListKeyRingsRequest) Clone() ApiListKeyRingsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListKeyRingsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListKeyRingsRequest) Execute() (*KeyRingList, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListKeyRings", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListKeyRingsRequest) execute() (*KeyRingList, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3681,6 +4697,12 @@ func (r ListKeyRingsRequest) Execute() (*KeyRingList, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3699,7 +4721,8 @@ func (r ListKeyRingsRequest) Execute() (*KeyRingList, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListKeyRings"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3718,6 +4741,10 @@ func (r ListKeyRingsRequest) Execute() (*KeyRingList, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -3730,10 +4757,14 @@ func (r ListKeyRingsRequest) Execute() (*KeyRingList, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3744,7 +4775,7 @@ func (r ListKeyRingsRequest) Execute() (*KeyRingList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3755,7 +4786,7 @@ func (r ListKeyRingsRequest) Execute() (*KeyRingList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3766,7 +4797,7 @@ func (r ListKeyRingsRequest) Execute() (*KeyRingList, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3809,14 +4840,51 @@ func (a *APIClient) ListKeyRingsExecute(ctx context.Context, projectId string, r
 }
 
 type ListKeysRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	keyRingId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	keyRingId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListKeysRequest) SetQueryParam(key, value string) ApiListKeysRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListKeysRequest) AddQueryParam(key, value string) ApiListKeysRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListKeysRequest) Clone() ApiListKeysRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListKeysRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListKeysRequest) Execute() (*KeyList, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListKeys", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListKeysRequest) execute() (*KeyList, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3841,6 +4909,15 @@ func (r ListKeysRequest) Execute() (*KeyList, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3859,7 +4936,8 @@ func (r ListKeysRequest) Execute() (*KeyList, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListKeys"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3878,6 +4956,10 @@ func (r ListKeysRequest) Execute() (*KeyList, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -3890,10 +4972,14 @@ func (r ListKeysRequest) Execute() (*KeyList, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3904,7 +4990,7 @@ func (r ListKeysRequest) Execute() (*KeyList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3915,7 +5001,7 @@ func (r ListKeysRequest) Execute() (*KeyList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3926,7 +5012,7 @@ func (r ListKeysRequest) Execute() (*KeyList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3937,7 +5023,7 @@ func (r ListKeysRequest) Execute() (*KeyList, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3983,15 +5069,52 @@ func (a *APIClient) ListKeysExecute(ctx context.Context, projectId string, regio
 }
 
 type ListVersionsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	keyRingId  string
-	keyId      string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	keyRingId   string
+	keyId       string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListVersionsRequest) SetQueryParam(key, value string) ApiListVersionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListVersionsRequest) AddQueryParam(key, value string) ApiListVersionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListVersionsRequest) Clone() ApiListVersionsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListVersionsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListVersionsRequest) Execute() (*VersionList, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListVersions", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListVersionsRequest) execute() (*VersionList, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -4017,6 +5140,18 @@ func (r ListVersionsRequest) Execute() (*VersionList, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4035,7 +5170,8 @@ func (r ListVersionsRequest) Execute() (*VersionList, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListVersions"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4054,6 +5190,10 @@ func (r ListVersionsRequest) Execute() (*VersionList, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -4066,10 +5206,14 @@ func (r ListVersionsRequest) Execute() (*VersionList, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4080,7 +5224,7 @@ func (r ListVersionsRequest) Execute() (*VersionList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4091,7 +5235,7 @@ func (r ListVersionsRequest) Execute() (*VersionList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4102,7 +5246,7 @@ func (r ListVersionsRequest) Execute() (*VersionList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4113,7 +5257,7 @@ func (r ListVersionsRequest) Execute() (*VersionList, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4162,14 +5306,51 @@ func (a *APIClient) ListVersionsExecute(ctx context.Context, projectId string, r
 }
 
 type ListWrappingKeysRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	keyRingId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	keyRingId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListWrappingKeysRequest) SetQueryParam(key, value string) ApiListWrappingKeysRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListWrappingKeysRequest) AddQueryParam(key, value string) ApiListWrappingKeysRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListWrappingKeysRequest) Clone() ApiListWrappingKeysRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListWrappingKeysRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListWrappingKeysRequest) Execute() (*WrappingKeyList, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListWrappingKeys", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListWrappingKeysRequest) execute() (*WrappingKeyList, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -4194,6 +5375,15 @@ func (r ListWrappingKeysRequest) Execute() (*WrappingKeyList, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4212,7 +5402,8 @@ func (r ListWrappingKeysRequest) Execute() (*WrappingKeyList, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListWrappingKeys"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4231,6 +5422,10 @@ func (r ListWrappingKeysRequest) Execute() (*WrappingKeyList, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -4243,10 +5438,14 @@ func (r ListWrappingKeysRequest) Execute() (*WrappingKeyList, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4257,7 +5456,7 @@ func (r ListWrappingKeysRequest) Execute() (*WrappingKeyList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4268,7 +5467,7 @@ func (r ListWrappingKeysRequest) Execute() (*WrappingKeyList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4279,7 +5478,7 @@ func (r ListWrappingKeysRequest) Execute() (*WrappingKeyList, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4290,7 +5489,7 @@ func (r ListWrappingKeysRequest) Execute() (*WrappingKeyList, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4336,15 +5535,52 @@ func (a *APIClient) ListWrappingKeysExecute(ctx context.Context, projectId strin
 }
 
 type RestoreKeyRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	keyRingId  string
-	keyId      string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	keyRingId   string
+	keyId       string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r RestoreKeyRequest) SetQueryParam(key, value string) ApiRestoreKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r RestoreKeyRequest) AddQueryParam(key, value string) ApiRestoreKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r RestoreKeyRequest) Clone() ApiRestoreKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r RestoreKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r RestoreKeyRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "RestoreKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")})
+	}
+	return err
+}
+
+func (r RestoreKeyRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
@@ -4369,6 +5605,18 @@ func (r RestoreKeyRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4387,7 +5635,8 @@ func (r RestoreKeyRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "RestoreKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -4418,10 +5667,14 @@ func (r RestoreKeyRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4432,7 +5685,7 @@ func (r RestoreKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4443,7 +5696,7 @@ func (r RestoreKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4454,7 +5707,7 @@ func (r RestoreKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4465,7 +5718,7 @@ func (r RestoreKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4522,9 +5775,46 @@ type RestoreVersionRequest struct {
 	keyRingId     string
 	keyId         string
 	versionNumber int64
+	queryParams   []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r RestoreVersionRequest) SetQueryParam(key, value string) ApiRestoreVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r RestoreVersionRequest) AddQueryParam(key, value string) ApiRestoreVersionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r RestoreVersionRequest) Clone() ApiRestoreVersionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r RestoreVersionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r RestoreVersionRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "RestoreVersion", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")}, oapierror.Parameter{Name: "versionNumber", Value: ParameterValueToString(r.versionNumber, "versionNumber")})
+	}
+	return err
+}
+
+func (r RestoreVersionRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
@@ -4550,6 +5840,18 @@ func (r RestoreVersionRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4568,7 +5870,8 @@ func (r RestoreVersionRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "RestoreVersion"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -4599,10 +5902,14 @@ func (r RestoreVersionRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4613,7 +5920,7 @@ func (r RestoreVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4624,7 +5931,7 @@ func (r RestoreVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4635,7 +5942,7 @@ func (r RestoreVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4646,7 +5953,7 @@ func (r RestoreVersionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4699,15 +6006,52 @@ func (a *APIClient) RestoreVersionExecute(ctx context.Context, projectId string,
 }
 
 type RotateKeyRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	regionId   string
-	keyRingId  string
-	keyId      string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	regionId    string
+	keyRingId   string
+	keyId       string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r RotateKeyRequest) SetQueryParam(key, value string) ApiRotateKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r RotateKeyRequest) AddQueryParam(key, value string) ApiRotateKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r RotateKeyRequest) Clone() ApiRotateKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r RotateKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r RotateKeyRequest) Execute() (*Version, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "RotateKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r RotateKeyRequest) execute() (*Version, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -4733,6 +6077,18 @@ func (r RotateKeyRequest) Execute() (*Version, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4751,7 +6107,8 @@ func (r RotateKeyRequest) Execute() (*Version, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "RotateKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4782,10 +6139,14 @@ func (r RotateKeyRequest) Execute() (*Version, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4796,7 +6157,7 @@ func (r RotateKeyRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4807,7 +6168,7 @@ func (r RotateKeyRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4818,7 +6179,7 @@ func (r RotateKeyRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4829,7 +6190,7 @@ func (r RotateKeyRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4840,7 +6201,7 @@ func (r RotateKeyRequest) Execute() (*Version, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4851,7 +6212,7 @@ func (r RotateKeyRequest) Execute() (*Version, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4908,6 +6269,7 @@ type SignRequest struct {
 	keyId         string
 	versionNumber int64
 	signPayload   *SignPayload
+	queryParams   []queryParam
 }
 
 func (r SignRequest) SignPayload(signPayload SignPayload) ApiSignRequest {
@@ -4915,7 +6277,43 @@ func (r SignRequest) SignPayload(signPayload SignPayload) ApiSignRequest {
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r SignRequest) SetQueryParam(key, value string) ApiSignRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r SignRequest) AddQueryParam(key, value string) ApiSignRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r SignRequest) Clone() ApiSignRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r SignRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r SignRequest) Execute() (*SignedData, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "Sign", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")}, oapierror.Parameter{Name: "versionNumber", Value: ParameterValueToString(r.versionNumber, "versionNumber")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r SignRequest) execute() (*SignedData, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -4942,8 +6340,20 @@ func (r SignRequest) Execute() (*SignedData, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 	if r.signPayload == nil {
-		return localVarReturnValue, fmt.Errorf("signPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "signPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -4965,7 +6375,8 @@ func (r SignRequest) Execute() (*SignedData, error) {
 	}
 	// body params
 	localVarPostBody = r.signPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "Sign"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4996,10 +6407,14 @@ func (r SignRequest) Execute() (*SignedData, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5010,7 +6425,7 @@ func (r SignRequest) Execute() (*SignedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5021,7 +6436,7 @@ func (r SignRequest) Execute() (*SignedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5032,7 +6447,7 @@ func (r SignRequest) Execute() (*SignedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5043,7 +6458,7 @@ func (r SignRequest) Execute() (*SignedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5054,7 +6469,7 @@ func (r SignRequest) Execute() (*SignedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 503 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5065,7 +6480,7 @@ func (r SignRequest) Execute() (*SignedData, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5125,6 +6540,7 @@ type VerifyRequest struct {
 	keyId         string
 	versionNumber int64
 	verifyPayload *VerifyPayload
+	queryParams   []queryParam
 }
 
 func (r VerifyRequest) VerifyPayload(verifyPayload VerifyPayload) ApiVerifyRequest {
@@ -5132,7 +6548,43 @@ func (r VerifyRequest) VerifyPayload(verifyPayload VerifyPayload) ApiVerifyReque
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r VerifyRequest) SetQueryParam(key, value string) ApiVerifyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r VerifyRequest) AddQueryParam(key, value string) ApiVerifyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r VerifyRequest) Clone() ApiVerifyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r VerifyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r VerifyRequest) Execute() (*VerifiedData, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "Verify", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "keyRingId", Value: ParameterValueToString(r.keyRingId, "keyRingId")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")}, oapierror.Parameter{Name: "versionNumber", Value: ParameterValueToString(r.versionNumber, "versionNumber")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r VerifyRequest) execute() (*VerifiedData, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -5159,8 +6611,20 @@ func (r VerifyRequest) Execute() (*VerifiedData, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.keyRingId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyRingId"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 	if r.verifyPayload == nil {
-		return localVarReturnValue, fmt.Errorf("verifyPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "verifyPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -5182,7 +6646,8 @@ func (r VerifyRequest) Execute() (*VerifiedData, error) {
 	}
 	// body params
 	localVarPostBody = r.verifyPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "Verify"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5213,10 +6678,14 @@ func (r VerifyRequest) Execute() (*VerifiedData, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5227,7 +6696,7 @@ func (r VerifyRequest) Execute() (*VerifiedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5238,7 +6707,7 @@ func (r VerifyRequest) Execute() (*VerifiedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5249,7 +6718,7 @@ func (r VerifyRequest) Execute() (*VerifiedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5260,7 +6729,7 @@ func (r VerifyRequest) Execute() (*VerifiedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5271,7 +6740,7 @@ func (r VerifyRequest) Execute() (*VerifiedData, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 503 {
 			var v HttpError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5282,7 +6751,7 @@ func (r VerifyRequest) Execute() (*VerifiedData, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/kms",
+		ServiceName:   "kms",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -39,3 +40,37 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateKey",
+	"CreateKeyRing",
+	"CreateWrappingKey",
+	"Decrypt",
+	"DeleteKey",
+	"DeleteKeyRing",
+	"DeleteWrappingKey",
+	"DestroyVersion",
+	"DisableVersion",
+	"EnableVersion",
+	"Encrypt",
+	"GetKey",
+	"GetKeyRing",
+	"GetVersion",
+	"GetWrappingKey",
+	"ImportKey",
+	"ListKeyRings",
+	"ListKeys",
+	"ListVersions",
+	"ListWrappingKeys",
+	"RestoreKey",
+	"RestoreVersion",
+	"RotateKey",
+	"Sign",
+	"Verify",
+}
+
+func init() {
+	config.RegisterOperations("kms", operations...)
+}
@@ -42,14 +42,9 @@ func (v *AccessScope) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := AccessScope(value)
-	for _, existing := range AllowedAccessScopeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid AccessScope", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewAccessScopeFromValue returns a pointer to a valid AccessScope
@@ -73,6 +68,17 @@ func (v AccessScope) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v AccessScope) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v AccessScope) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to access_scope value
 func (v AccessScope) Ptr() *AccessScope {
 	return &v
@@ -60,14 +60,9 @@ func (v *Algorithm) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := Algorithm(value)
-	for _, existing := range AllowedAlgorithmEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Algorithm", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewAlgorithmFromValue returns a pointer to a valid Algorithm
@@ -91,6 +86,17 @@ func (v Algorithm) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v Algorithm) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v Algorithm) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to algorithm value
 func (v Algorithm) Ptr() *Algorithm {
 	return &v
@@ -288,14 +288,9 @@ func (v *KeyState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := KeyState(value)
-	for _, existing := range AllowedKeyStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Key", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewKeyStateFromValue returns a pointer to a valid KeyState
@@ -319,6 +314,17 @@ func (v KeyState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v KeyState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v KeyState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v KeyState) Ptr() *KeyState {
 	return &v
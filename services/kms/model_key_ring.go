@@ -141,14 +141,9 @@ func (v *KeyRingState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := KeyRingState(value)
-	for _, existing := range AllowedKeyRingStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid KeyRing", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewKeyRingStateFromValue returns a pointer to a valid KeyRingState
@@ -172,6 +167,17 @@ func (v KeyRingState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v KeyRingState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v KeyRingState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v KeyRingState) Ptr() *KeyRingState {
 	return &v
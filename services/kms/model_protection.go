@@ -40,14 +40,9 @@ func (v *Protection) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := Protection(value)
-	for _, existing := range AllowedProtectionEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Protection", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewProtectionFromValue returns a pointer to a valid Protection
@@ -71,6 +66,17 @@ func (v Protection) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v Protection) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v Protection) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to protection value
 func (v Protection) Ptr() *Protection {
 	return &v
@@ -46,14 +46,9 @@ func (v *Purpose) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := Purpose(value)
-	for _, existing := range AllowedPurposeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Purpose", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPurposeFromValue returns a pointer to a valid Purpose
@@ -77,6 +72,17 @@ func (v Purpose) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v Purpose) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v Purpose) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to purpose value
 func (v Purpose) Ptr() *Purpose {
 	return &v
@@ -207,14 +207,9 @@ func (v *VersionState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := VersionState(value)
-	for _, existing := range AllowedVersionStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Version", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewVersionStateFromValue returns a pointer to a valid VersionState
@@ -238,6 +233,17 @@ func (v VersionState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v VersionState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v VersionState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v VersionState) Ptr() *VersionState {
 	return &v
@@ -54,14 +54,9 @@ func (v *WrappingAlgorithm) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := WrappingAlgorithm(value)
-	for _, existing := range AllowedWrappingAlgorithmEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid WrappingAlgorithm", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewWrappingAlgorithmFromValue returns a pointer to a valid WrappingAlgorithm
@@ -85,6 +80,17 @@ func (v WrappingAlgorithm) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v WrappingAlgorithm) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v WrappingAlgorithm) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to wrappingAlgorithm value
 func (v WrappingAlgorithm) Ptr() *WrappingAlgorithm {
 	return &v
@@ -287,14 +287,9 @@ func (v *WrappingKeyState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := WrappingKeyState(value)
-	for _, existing := range AllowedWrappingKeyStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid WrappingKey", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewWrappingKeyStateFromValue returns a pointer to a valid WrappingKeyState
@@ -318,6 +313,17 @@ func (v WrappingKeyState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v WrappingKeyState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v WrappingKeyState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v WrappingKeyState) Ptr() *WrappingKeyState {
 	return &v
@@ -42,14 +42,9 @@ func (v *WrappingPurpose) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := WrappingPurpose(value)
-	for _, existing := range AllowedWrappingPurposeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid WrappingPurpose", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewWrappingPurposeFromValue returns a pointer to a valid WrappingPurpose
@@ -73,6 +68,17 @@ func (v WrappingPurpose) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v WrappingPurpose) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v WrappingPurpose) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to wrappingPurpose value
 func (v WrappingPurpose) Ptr() *WrappingPurpose {
 	return &v
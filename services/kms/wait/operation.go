@@ -0,0 +1,161 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/kms"
+)
+
+// CreateKeyRingOperation returns the async action of CreateKeyRingWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateKeyRingPollFunc. The name of the operation is
+// "kms/CreateKeyRing/{projectId}/{region}/{keyRingId}".
+func CreateKeyRingOperation(client ApiKmsClient, projectId, region, keyRingId string) *lro.Operation[kms.KeyRing] {
+	return createKeyRingOperationHandler(client).Operation(projectId, region, keyRingId)
+}
+
+// CreateKeyRingPollFunc returns the lro.PollFunc of the operations returned by CreateKeyRingOperation, e.g. to resume
+// one with lro.Resume
+func CreateKeyRingPollFunc(client ApiKmsClient) lro.PollFunc[kms.KeyRing] {
+	return createKeyRingOperationHandler(client).PollFunc()
+}
+
+func createKeyRingOperationHandler(client ApiKmsClient) lro.WaitHandler[kms.KeyRing] {
+	return lro.WaitHandler[kms.KeyRing]{
+		Kind: "kms/CreateKeyRing",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[kms.KeyRing] {
+			return CreateKeyRingWaitHandler(ctx, client, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CreateOrUpdateKeyOperation returns the async action of CreateOrUpdateKeyWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateOrUpdateKeyPollFunc. The name of the operation is
+// "kms/CreateOrUpdateKey/{projectId}/{region}/{keyRingId}/{keyId}".
+func CreateOrUpdateKeyOperation(client ApiKmsClient, projectId, region, keyRingId, keyId string) *lro.Operation[kms.Key] {
+	return createOrUpdateKeyOperationHandler(client).Operation(projectId, region, keyRingId, keyId)
+}
+
+// CreateOrUpdateKeyPollFunc returns the lro.PollFunc of the operations returned by CreateOrUpdateKeyOperation, e.g. to
+// resume one with lro.Resume
+func CreateOrUpdateKeyPollFunc(client ApiKmsClient) lro.PollFunc[kms.Key] {
+	return createOrUpdateKeyOperationHandler(client).PollFunc()
+}
+
+func createOrUpdateKeyOperationHandler(client ApiKmsClient) lro.WaitHandler[kms.Key] {
+	return lro.WaitHandler[kms.Key]{
+		Kind: "kms/CreateOrUpdateKey",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[kms.Key] {
+			return CreateOrUpdateKeyWaitHandler(ctx, client, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
+
+// DeleteKeyOperation returns the async action of DeleteKeyWaitHandler as an lro.Operation, which can be serialized to
+// resume waiting for it in another process with DeleteKeyPollFunc. The name of the operation is
+// "kms/DeleteKey/{projectId}/{region}/{keyRingId}/{keyId}".
+func DeleteKeyOperation(client ApiKmsClient, projectId, region, keyRingId, keyId string) *lro.Operation[kms.Key] {
+	return deleteKeyOperationHandler(client).Operation(projectId, region, keyRingId, keyId)
+}
+
+// DeleteKeyPollFunc returns the lro.PollFunc of the operations returned by DeleteKeyOperation, e.g. to resume one with
+// lro.Resume
+func DeleteKeyPollFunc(client ApiKmsClient) lro.PollFunc[kms.Key] {
+	return deleteKeyOperationHandler(client).PollFunc()
+}
+
+func deleteKeyOperationHandler(client ApiKmsClient) lro.WaitHandler[kms.Key] {
+	return lro.WaitHandler[kms.Key]{
+		Kind: "kms/DeleteKey",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[kms.Key] {
+			return DeleteKeyWaitHandler(ctx, client, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
+
+// EnableKeyVersionOperation returns the async action of EnableKeyVersionWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with EnableKeyVersionPollFunc. The name of the operation is
+// "kms/EnableKeyVersion/{projectId}/{region}/{keyRingId}/{keyId}/{version}".
+func EnableKeyVersionOperation(client ApiKmsClient, projectId, region, keyRingId, keyId string, version int64) *lro.Operation[kms.Version] {
+	return enableKeyVersionOperationHandler(client).Operation(projectId, region, keyRingId, keyId, strconv.FormatInt(version, 10))
+}
+
+// EnableKeyVersionPollFunc returns the lro.PollFunc of the operations returned by EnableKeyVersionOperation, e.g. to
+// resume one with lro.Resume
+func EnableKeyVersionPollFunc(client ApiKmsClient) lro.PollFunc[kms.Version] {
+	return enableKeyVersionOperationHandler(client).PollFunc()
+}
+
+func enableKeyVersionOperationHandler(client ApiKmsClient) lro.WaitHandler[kms.Version] {
+	return lro.WaitHandler[kms.Version]{
+		Kind: "kms/EnableKeyVersion",
+		IDs:  5,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[kms.Version] {
+			version, err := strconv.ParseInt(ids[4], 10, 64)
+			if err != nil {
+				return wait.New(func() (bool, *kms.Version, error) {
+					return false, nil, fmt.Errorf("invalid version %q: %w", ids[4], err)
+				})
+			}
+			return EnableKeyVersionWaitHandler(ctx, client, ids[0], ids[1], ids[2], ids[3], version)
+		},
+	}
+}
+
+// DisableKeyVersionOperation returns the async action of DisableKeyVersionWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DisableKeyVersionPollFunc. The name of the operation is
+// "kms/DisableKeyVersion/{projectId}/{region}/{keyRingId}/{keyId}/{version}".
+func DisableKeyVersionOperation(client ApiKmsClient, projectId, region, keyRingId, keyId string, version int64) *lro.Operation[kms.Version] {
+	return disableKeyVersionOperationHandler(client).Operation(projectId, region, keyRingId, keyId, strconv.FormatInt(version, 10))
+}
+
+// DisableKeyVersionPollFunc returns the lro.PollFunc of the operations returned by DisableKeyVersionOperation, e.g. to
+// resume one with lro.Resume
+func DisableKeyVersionPollFunc(client ApiKmsClient) lro.PollFunc[kms.Version] {
+	return disableKeyVersionOperationHandler(client).PollFunc()
+}
+
+func disableKeyVersionOperationHandler(client ApiKmsClient) lro.WaitHandler[kms.Version] {
+	return lro.WaitHandler[kms.Version]{
+		Kind: "kms/DisableKeyVersion",
+		IDs:  5,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[kms.Version] {
+			version, err := strconv.ParseInt(ids[4], 10, 64)
+			if err != nil {
+				return wait.New(func() (bool, *kms.Version, error) {
+					return false, nil, fmt.Errorf("invalid version %q: %w", ids[4], err)
+				})
+			}
+			return DisableKeyVersionWaitHandler(ctx, client, ids[0], ids[1], ids[2], ids[3], version)
+		},
+	}
+}
+
+// CreateWrappingKeyOperation returns the async action of CreateWrappingKeyWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateWrappingKeyPollFunc. The name of the operation is
+// "kms/CreateWrappingKey/{projectId}/{region}/{keyRingId}/{wrappingKeyId}".
+func CreateWrappingKeyOperation(client ApiKmsClient, projectId, region, keyRingId, wrappingKeyId string) *lro.Operation[kms.WrappingKey] {
+	return createWrappingKeyOperationHandler(client).Operation(projectId, region, keyRingId, wrappingKeyId)
+}
+
+// CreateWrappingKeyPollFunc returns the lro.PollFunc of the operations returned by CreateWrappingKeyOperation, e.g. to
+// resume one with lro.Resume
+func CreateWrappingKeyPollFunc(client ApiKmsClient) lro.PollFunc[kms.WrappingKey] {
+	return createWrappingKeyOperationHandler(client).PollFunc()
+}
+
+func createWrappingKeyOperationHandler(client ApiKmsClient) lro.WaitHandler[kms.WrappingKey] {
+	return lro.WaitHandler[kms.WrappingKey]{
+		Kind: "kms/CreateWrappingKey",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[kms.WrappingKey] {
+			return CreateWrappingKeyWaitHandler(ctx, client, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/lbapplication",
+		ServiceName:   "lbapplication",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -39,3 +40,28 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateCredentials",
+	"CreateLoadBalancer",
+	"DeleteCredentials",
+	"DeleteLoadBalancer",
+	"DisableService",
+	"EnableService",
+	"GetCredentials",
+	"GetLoadBalancer",
+	"GetQuota",
+	"GetServiceStatus",
+	"ListCredentials",
+	"ListLoadBalancers",
+	"ListPlans",
+	"UpdateCredentials",
+	"UpdateLoadBalancer",
+	"UpdateTargetPool",
+}
+
+func init() {
+	config.RegisterOperations("lbapplication", operations...)
+}
@@ -225,14 +225,9 @@ func (v *CreateLoadBalancerPayloadStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CreateLoadBalancerPayloadStatus(value)
-	for _, existing := range AllowedCreateLoadBalancerPayloadStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateLoadBalancerPayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateLoadBalancerPayloadStatusFromValue returns a pointer to a valid CreateLoadBalancerPayloadStatus
@@ -256,6 +251,17 @@ func (v CreateLoadBalancerPayloadStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateLoadBalancerPayloadStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateLoadBalancerPayloadStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v CreateLoadBalancerPayloadStatus) Ptr() *CreateLoadBalancerPayloadStatus {
 	return &v
@@ -65,14 +65,9 @@ func (v *GetServiceStatusResponseStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := GetServiceStatusResponseStatus(value)
-	for _, existing := range AllowedGetServiceStatusResponseStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid GetServiceStatusResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewGetServiceStatusResponseStatusFromValue returns a pointer to a valid GetServiceStatusResponseStatus
@@ -96,6 +91,17 @@ func (v GetServiceStatusResponseStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v GetServiceStatusResponseStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v GetServiceStatusResponseStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v GetServiceStatusResponseStatus) Ptr() *GetServiceStatusResponseStatus {
 	return &v
@@ -159,14 +159,9 @@ func (v *ListenerProtocol) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ListenerProtocol(value)
-	for _, existing := range AllowedListenerProtocolEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Listener", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewListenerProtocolFromValue returns a pointer to a valid ListenerProtocol
@@ -190,6 +185,17 @@ func (v ListenerProtocol) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ListenerProtocol) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ListenerProtocol) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ProtocolProtocol value
 func (v ListenerProtocol) Ptr() *ListenerProtocol {
 	return &v
@@ -225,14 +225,9 @@ func (v *UpdateLoadBalancerPayloadStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := UpdateLoadBalancerPayloadStatus(value)
-	for _, existing := range AllowedUpdateLoadBalancerPayloadStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid UpdateLoadBalancerPayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewUpdateLoadBalancerPayloadStatusFromValue returns a pointer to a valid UpdateLoadBalancerPayloadStatus
@@ -256,6 +251,17 @@ func (v UpdateLoadBalancerPayloadStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v UpdateLoadBalancerPayloadStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v UpdateLoadBalancerPayloadStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v UpdateLoadBalancerPayloadStatus) Ptr() *UpdateLoadBalancerPayloadStatus {
 	return &v
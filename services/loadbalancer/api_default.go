@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateCredentials Create credentials for observability of the Load Balancer
@@ -313,39 +316,76 @@ type DefaultApi interface {
 	UpdateTargetPoolExecute(ctx context.Context, projectId string, region string, name string, targetPoolName string) (*TargetPool, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateCredentialsRequest interface {
 	CreateCredentialsPayload(createCredentialsPayload CreateCredentialsPayload) ApiCreateCredentialsRequest
 	XRequestID(xRequestID string) ApiCreateCredentialsRequest
+	RetryOnConflict(maxAttempts int) ApiCreateCredentialsRequest
+	SetQueryParam(key, value string) ApiCreateCredentialsRequest
+	AddQueryParam(key, value string) ApiCreateCredentialsRequest
+	Clone() ApiCreateCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateCredentialsResponse, error)
 }
 
 type ApiCreateLoadBalancerRequest interface {
 	CreateLoadBalancerPayload(createLoadBalancerPayload CreateLoadBalancerPayload) ApiCreateLoadBalancerRequest
 	XRequestID(xRequestID string) ApiCreateLoadBalancerRequest
+	RetryOnConflict(maxAttempts int) ApiCreateLoadBalancerRequest
+	SetQueryParam(key, value string) ApiCreateLoadBalancerRequest
+	AddQueryParam(key, value string) ApiCreateLoadBalancerRequest
+	Clone() ApiCreateLoadBalancerRequest
+	Fingerprint() (string, error)
 	Execute() (*LoadBalancer, error)
 }
 
 type ApiDeleteCredentialsRequest interface {
+	SetQueryParam(key, value string) ApiDeleteCredentialsRequest
+	AddQueryParam(key, value string) ApiDeleteCredentialsRequest
+	Clone() ApiDeleteCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (map[string]interface{}, error)
 }
 
 type ApiDeleteLoadBalancerRequest interface {
+	SetQueryParam(key, value string) ApiDeleteLoadBalancerRequest
+	AddQueryParam(key, value string) ApiDeleteLoadBalancerRequest
+	Clone() ApiDeleteLoadBalancerRequest
+	Fingerprint() (string, error)
 	Execute() (map[string]interface{}, error)
 }
 
 type ApiGetCredentialsRequest interface {
+	SetQueryParam(key, value string) ApiGetCredentialsRequest
+	AddQueryParam(key, value string) ApiGetCredentialsRequest
+	Clone() ApiGetCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*GetCredentialsResponse, error)
 }
 
 type ApiGetLoadBalancerRequest interface {
+	SetQueryParam(key, value string) ApiGetLoadBalancerRequest
+	AddQueryParam(key, value string) ApiGetLoadBalancerRequest
+	Clone() ApiGetLoadBalancerRequest
+	Fingerprint() (string, error)
 	Execute() (*LoadBalancer, error)
 }
 
 type ApiGetQuotaRequest interface {
+	SetQueryParam(key, value string) ApiGetQuotaRequest
+	AddQueryParam(key, value string) ApiGetQuotaRequest
+	Clone() ApiGetQuotaRequest
+	Fingerprint() (string, error)
 	Execute() (*GetQuotaResponse, error)
 }
 
 type ApiListCredentialsRequest interface {
+	SetQueryParam(key, value string) ApiListCredentialsRequest
+	AddQueryParam(key, value string) ApiListCredentialsRequest
+	Clone() ApiListCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListCredentialsResponse, error)
 }
 
@@ -354,25 +394,45 @@ type ApiListLoadBalancersRequest interface {
 	PageSize(pageSize string) ApiListLoadBalancersRequest
 	// page_id is a page identifier returned by the previous response and is used to request the next page
 	PageId(pageId string) ApiListLoadBalancersRequest
+	SetQueryParam(key, value string) ApiListLoadBalancersRequest
+	AddQueryParam(key, value string) ApiListLoadBalancersRequest
+	Clone() ApiListLoadBalancersRequest
+	Fingerprint() (string, error)
 	Execute() (*ListLoadBalancersResponse, error)
 }
 
 type ApiListPlansRequest interface {
+	SetQueryParam(key, value string) ApiListPlansRequest
+	AddQueryParam(key, value string) ApiListPlansRequest
+	Clone() ApiListPlansRequest
+	Fingerprint() (string, error)
 	Execute() (*ListPlansResponse, error)
 }
 
 type ApiUpdateCredentialsRequest interface {
 	UpdateCredentialsPayload(updateCredentialsPayload UpdateCredentialsPayload) ApiUpdateCredentialsRequest
+	SetQueryParam(key, value string) ApiUpdateCredentialsRequest
+	AddQueryParam(key, value string) ApiUpdateCredentialsRequest
+	Clone() ApiUpdateCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*UpdateCredentialsResponse, error)
 }
 
 type ApiUpdateLoadBalancerRequest interface {
 	UpdateLoadBalancerPayload(updateLoadBalancerPayload UpdateLoadBalancerPayload) ApiUpdateLoadBalancerRequest
+	SetQueryParam(key, value string) ApiUpdateLoadBalancerRequest
+	AddQueryParam(key, value string) ApiUpdateLoadBalancerRequest
+	Clone() ApiUpdateLoadBalancerRequest
+	Fingerprint() (string, error)
 	Execute() (*LoadBalancer, error)
 }
 
 type ApiUpdateTargetPoolRequest interface {
 	UpdateTargetPoolPayload(updateTargetPoolPayload UpdateTargetPoolPayload) ApiUpdateTargetPoolRequest
+	SetQueryParam(key, value string) ApiUpdateTargetPoolRequest
+	AddQueryParam(key, value string) ApiUpdateTargetPoolRequest
+	Clone() ApiUpdateTargetPoolRequest
+	Fingerprint() (string, error)
 	Execute() (*TargetPool, error)
 }
 
@@ -386,6 +446,8 @@ type CreateCredentialsRequest struct {
 	region                   string
 	createCredentialsPayload *CreateCredentialsPayload
 	xRequestID               *string
+	retryOnConflict          int
+	queryParams              []queryParam
 }
 
 func (r CreateCredentialsRequest) CreateCredentialsPayload(createCredentialsPayload CreateCredentialsPayload) ApiCreateCredentialsRequest {
@@ -398,7 +460,50 @@ func (r CreateCredentialsRequest) XRequestID(xRequestID string) ApiCreateCredent
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateCredentialsRequest) RetryOnConflict(maxAttempts int) ApiCreateCredentialsRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateCredentialsRequest) SetQueryParam(key, value string) ApiCreateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateCredentialsRequest) AddQueryParam(key, value string) ApiCreateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateCredentialsRequest) Clone() ApiCreateCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateCredentialsRequest) execute() (*CreateCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -410,7 +515,7 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.CreateCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.CreateCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -422,8 +527,14 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.createCredentialsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createCredentialsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createCredentialsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -448,7 +559,8 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 	}
 	// body params
 	localVarPostBody = r.createCredentialsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -479,10 +591,14 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -492,7 +608,7 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -502,7 +618,7 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -554,6 +670,8 @@ type CreateLoadBalancerRequest struct {
 	region                    string
 	createLoadBalancerPayload *CreateLoadBalancerPayload
 	xRequestID                *string
+	retryOnConflict           int
+	queryParams               []queryParam
 }
 
 func (r CreateLoadBalancerRequest) CreateLoadBalancerPayload(createLoadBalancerPayload CreateLoadBalancerPayload) ApiCreateLoadBalancerRequest {
@@ -566,7 +684,50 @@ func (r CreateLoadBalancerRequest) XRequestID(xRequestID string) ApiCreateLoadBa
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateLoadBalancerRequest) RetryOnConflict(maxAttempts int) ApiCreateLoadBalancerRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateLoadBalancerRequest) SetQueryParam(key, value string) ApiCreateLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateLoadBalancerRequest) AddQueryParam(key, value string) ApiCreateLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateLoadBalancerRequest) Clone() ApiCreateLoadBalancerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateLoadBalancerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateLoadBalancer", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateLoadBalancerRequest) execute() (*LoadBalancer, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -578,7 +739,7 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.CreateLoadBalancer")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.CreateLoadBalancer", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -590,8 +751,14 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.createLoadBalancerPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createLoadBalancerPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createLoadBalancerPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -616,7 +783,8 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	}
 	// body params
 	localVarPostBody = r.createLoadBalancerPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateLoadBalancer"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -647,10 +815,14 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -660,7 +832,7 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -670,7 +842,7 @@ func (r CreateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -720,9 +892,46 @@ type DeleteCredentialsRequest struct {
 	projectId      string
 	region         string
 	credentialsRef string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteCredentialsRequest) SetQueryParam(key, value string) ApiDeleteCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteCredentialsRequest) AddQueryParam(key, value string) ApiDeleteCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteCredentialsRequest) Clone() ApiDeleteCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "credentialsRef", Value: ParameterValueToString(r.credentialsRef, "credentialsRef")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteCredentialsRequest) execute() (map[string]interface{}, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -734,7 +943,7 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -765,7 +974,8 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -796,10 +1006,14 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -809,7 +1023,7 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -819,7 +1033,7 @@ func (r DeleteCredentialsRequest) Execute() (map[string]interface{}, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -865,14 +1079,51 @@ func (a *APIClient) DeleteCredentialsExecute(ctx context.Context, projectId stri
 }
 
 type DeleteLoadBalancerRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	name       string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	name        string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteLoadBalancerRequest) SetQueryParam(key, value string) ApiDeleteLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteLoadBalancerRequest) AddQueryParam(key, value string) ApiDeleteLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteLoadBalancerRequest) Clone() ApiDeleteLoadBalancerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteLoadBalancerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteLoadBalancer", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "name", Value: ParameterValueToString(r.name, "name")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteLoadBalancerRequest) execute() (map[string]interface{}, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -884,7 +1135,7 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteLoadBalancer")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteLoadBalancer", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -915,7 +1166,8 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteLoadBalancer"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -946,10 +1198,14 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -959,7 +1215,7 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -969,7 +1225,7 @@ func (r DeleteLoadBalancerRequest) Execute() (map[string]interface{}, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1020,9 +1276,46 @@ type GetCredentialsRequest struct {
 	projectId      string
 	region         string
 	credentialsRef string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetCredentialsRequest) SetQueryParam(key, value string) ApiGetCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetCredentialsRequest) AddQueryParam(key, value string) ApiGetCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetCredentialsRequest) Clone() ApiGetCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "credentialsRef", Value: ParameterValueToString(r.credentialsRef, "credentialsRef")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetCredentialsRequest) execute() (*GetCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1034,7 +1327,7 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1047,6 +1340,15 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.credentialsRef == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "credentialsRef"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1065,7 +1367,8 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1096,10 +1399,14 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1109,7 +1416,7 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1119,7 +1426,7 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1165,14 +1472,51 @@ func (a *APIClient) GetCredentialsExecute(ctx context.Context, projectId string,
 }
 
 type GetLoadBalancerRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	name       string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	name        string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetLoadBalancerRequest) SetQueryParam(key, value string) ApiGetLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetLoadBalancerRequest) AddQueryParam(key, value string) ApiGetLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetLoadBalancerRequest) Clone() ApiGetLoadBalancerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetLoadBalancerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetLoadBalancer", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "name", Value: ParameterValueToString(r.name, "name")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetLoadBalancerRequest) execute() (*LoadBalancer, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1184,7 +1528,7 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetLoadBalancer")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetLoadBalancer", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1197,6 +1541,15 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.name == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "name"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1215,7 +1568,8 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetLoadBalancer"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1246,10 +1600,14 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1259,7 +1617,7 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1269,7 +1627,7 @@ func (r GetLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1318,13 +1676,50 @@ func (a *APIClient) GetLoadBalancerExecute(ctx context.Context, projectId string
 }
 
 type GetQuotaRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetQuotaRequest) SetQueryParam(key, value string) ApiGetQuotaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetQuotaRequest) AddQueryParam(key, value string) ApiGetQuotaRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetQuotaRequest) Clone() ApiGetQuotaRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetQuotaRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetQuota", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetQuotaRequest) execute() (*GetQuotaResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1336,7 +1731,7 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetQuota")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetQuota", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1348,6 +1743,12 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1366,7 +1767,8 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetQuota"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1397,10 +1799,14 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1410,7 +1816,7 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1420,7 +1826,7 @@ func (r GetQuotaRequest) Execute() (*GetQuotaResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1465,13 +1871,50 @@ func (a *APIClient) GetQuotaExecute(ctx context.Context, projectId string, regio
 }
 
 type ListCredentialsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListCredentialsRequest) SetQueryParam(key, value string) ApiListCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListCredentialsRequest) AddQueryParam(key, value string) ApiListCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListCredentialsRequest) Clone() ApiListCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListCredentialsRequest) execute() (*ListCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1483,7 +1926,7 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1495,6 +1938,12 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1513,7 +1962,8 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1532,6 +1982,10 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1544,10 +1998,14 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1557,7 +2015,7 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1567,7 +2025,7 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1610,12 +2068,13 @@ func (a *APIClient) ListCredentialsExecute(ctx context.Context, projectId string
 }
 
 type ListLoadBalancersRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
-	pageSize   *string
-	pageId     *string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	pageSize    *string
+	pageId      *string
+	queryParams []queryParam
 }
 
 // page_size specifies how many load balancers should be returned on this page. Must be a positive number &lt;&#x3D; 1000
@@ -1632,7 +2091,43 @@ func (r ListLoadBalancersRequest) PageId(pageId string) ApiListLoadBalancersRequ
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListLoadBalancersRequest) SetQueryParam(key, value string) ApiListLoadBalancersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListLoadBalancersRequest) AddQueryParam(key, value string) ApiListLoadBalancersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListLoadBalancersRequest) Clone() ApiListLoadBalancersRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListLoadBalancersRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListLoadBalancers", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListLoadBalancersRequest) execute() (*ListLoadBalancersResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1644,7 +2139,7 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListLoadBalancers")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListLoadBalancers", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1656,6 +2151,12 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	if r.pageSize != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "pageSize", r.pageSize, "")
@@ -1680,7 +2181,8 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListLoadBalancers"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1699,6 +2201,10 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1711,10 +2217,14 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1724,7 +2234,7 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1734,7 +2244,7 @@ func (r ListLoadBalancersRequest) Execute() (*ListLoadBalancersResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1780,12 +2290,49 @@ func (a *APIClient) ListLoadBalancersExecute(ctx context.Context, projectId stri
 }
 
 type ListPlansRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListPlansRequest) SetQueryParam(key, value string) ApiListPlansRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListPlansRequest) AddQueryParam(key, value string) ApiListPlansRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListPlansRequest) Clone() ApiListPlansRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListPlansRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListPlans", oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListPlansRequest) execute() (*ListPlansResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1797,7 +2344,7 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListPlans")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListPlans", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1808,6 +2355,9 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1826,7 +2376,8 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListPlans"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1845,6 +2396,10 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1857,10 +2412,14 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1870,7 +2429,7 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -1880,7 +2439,7 @@ func (r ListPlansRequest) Execute() (*ListPlansResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1926,6 +2485,7 @@ type UpdateCredentialsRequest struct {
 	region                   string
 	credentialsRef           string
 	updateCredentialsPayload *UpdateCredentialsPayload
+	queryParams              []queryParam
 }
 
 func (r UpdateCredentialsRequest) UpdateCredentialsPayload(updateCredentialsPayload UpdateCredentialsPayload) ApiUpdateCredentialsRequest {
@@ -1933,7 +2493,43 @@ func (r UpdateCredentialsRequest) UpdateCredentialsPayload(updateCredentialsPayl
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateCredentialsRequest) SetQueryParam(key, value string) ApiUpdateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateCredentialsRequest) AddQueryParam(key, value string) ApiUpdateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateCredentialsRequest) Clone() ApiUpdateCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateCredentials", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "credentialsRef", Value: ParameterValueToString(r.credentialsRef, "credentialsRef")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateCredentialsRequest) execute() (*UpdateCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -1945,7 +2541,7 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateCredentials")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateCredentials", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1958,8 +2554,17 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.credentialsRef == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "credentialsRef"}
+	}
 	if r.updateCredentialsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateCredentialsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateCredentialsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1981,7 +2586,8 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 	}
 	// body params
 	localVarPostBody = r.updateCredentialsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2012,10 +2618,14 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2025,7 +2635,7 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2035,7 +2645,7 @@ func (r UpdateCredentialsRequest) Execute() (*UpdateCredentialsResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2087,6 +2697,7 @@ type UpdateLoadBalancerRequest struct {
 	region                    string
 	name                      string
 	updateLoadBalancerPayload *UpdateLoadBalancerPayload
+	queryParams               []queryParam
 }
 
 func (r UpdateLoadBalancerRequest) UpdateLoadBalancerPayload(updateLoadBalancerPayload UpdateLoadBalancerPayload) ApiUpdateLoadBalancerRequest {
@@ -2094,7 +2705,43 @@ func (r UpdateLoadBalancerRequest) UpdateLoadBalancerPayload(updateLoadBalancerP
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateLoadBalancerRequest) SetQueryParam(key, value string) ApiUpdateLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateLoadBalancerRequest) AddQueryParam(key, value string) ApiUpdateLoadBalancerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateLoadBalancerRequest) Clone() ApiUpdateLoadBalancerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateLoadBalancerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateLoadBalancer", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "name", Value: ParameterValueToString(r.name, "name")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateLoadBalancerRequest) execute() (*LoadBalancer, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -2106,7 +2753,7 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateLoadBalancer")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateLoadBalancer", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2119,8 +2766,17 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.name == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "name"}
+	}
 	if r.updateLoadBalancerPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateLoadBalancerPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateLoadBalancerPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2142,7 +2798,8 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 	}
 	// body params
 	localVarPostBody = r.updateLoadBalancerPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateLoadBalancer"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2173,10 +2830,14 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2186,7 +2847,7 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2196,7 +2857,7 @@ func (r UpdateLoadBalancerRequest) Execute() (*LoadBalancer, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2252,6 +2913,7 @@ type UpdateTargetPoolRequest struct {
 	name                    string
 	targetPoolName          string
 	updateTargetPoolPayload *UpdateTargetPoolPayload
+	queryParams             []queryParam
 }
 
 func (r UpdateTargetPoolRequest) UpdateTargetPoolPayload(updateTargetPoolPayload UpdateTargetPoolPayload) ApiUpdateTargetPoolRequest {
@@ -2259,7 +2921,43 @@ func (r UpdateTargetPoolRequest) UpdateTargetPoolPayload(updateTargetPoolPayload
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateTargetPoolRequest) SetQueryParam(key, value string) ApiUpdateTargetPoolRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateTargetPoolRequest) AddQueryParam(key, value string) ApiUpdateTargetPoolRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateTargetPoolRequest) Clone() ApiUpdateTargetPoolRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateTargetPoolRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateTargetPool", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")}, oapierror.Parameter{Name: "name", Value: ParameterValueToString(r.name, "name")}, oapierror.Parameter{Name: "targetPoolName", Value: ParameterValueToString(r.targetPoolName, "targetPoolName")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateTargetPoolRequest) execute() (*TargetPool, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -2271,7 +2969,7 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateTargetPool")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateTargetPool", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2285,8 +2983,20 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
+	if r.name == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "name"}
+	}
+	if r.targetPoolName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "targetPoolName"}
+	}
 	if r.updateTargetPoolPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateTargetPoolPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateTargetPoolPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2308,7 +3018,8 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 	}
 	// body params
 	localVarPostBody = r.updateTargetPoolPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateTargetPool"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2339,10 +3050,14 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v string
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2352,7 +3067,7 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 			return localVarReturnValue, newErr
 		}
 		var v Status
-		err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.ErrorMessage = err.Error()
 			return localVarReturnValue, newErr
@@ -2362,7 +3077,7 @@ func (r UpdateTargetPoolRequest) Execute() (*TargetPool, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -306,14 +306,9 @@ func (v *LoadBalancerStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := LoadBalancerStatus(value)
-	for _, existing := range AllowedLoadBalancerStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid LoadBalancer", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewLoadBalancerStatusFromValue returns a pointer to a valid LoadBalancerStatus
@@ -337,6 +332,17 @@ func (v LoadBalancerStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v LoadBalancerStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v LoadBalancerStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v LoadBalancerStatus) Ptr() *LoadBalancerStatus {
 	return &v
@@ -92,14 +92,9 @@ func (v *LoadBalancerErrorTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := LoadBalancerErrorTypes(value)
-	for _, existing := range AllowedLoadBalancerErrorTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid LoadBalancerError", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewLoadBalancerErrorTypesFromValue returns a pointer to a valid LoadBalancerErrorTypes
@@ -123,6 +118,17 @@ func (v LoadBalancerErrorTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v LoadBalancerErrorTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v LoadBalancerErrorTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v LoadBalancerErrorTypes) Ptr() *LoadBalancerErrorTypes {
 	return &v
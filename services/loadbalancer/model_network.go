@@ -80,14 +80,9 @@ func (v *NetworkRole) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := NetworkRole(value)
-	for _, existing := range AllowedNetworkRoleEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Network", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewNetworkRoleFromValue returns a pointer to a valid NetworkRole
@@ -111,6 +106,17 @@ func (v NetworkRole) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v NetworkRole) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v NetworkRole) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to RoleRole value
 func (v NetworkRole) Ptr() *NetworkRole {
 	return &v
@@ -0,0 +1,95 @@
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
+)
+
+// ValidateActiveHealthCheck checks that the settings of an active health check are consistent before the load
+// balancer is created or updated, so that an invalid combination is reported with the offending fields instead of a
+// generic 400 Bad Request of the API. The settings which aren't set are left to the defaults of the API.
+//
+//   - Interval, IntervalJitter and Timeout must be durations in seconds, e.g. "3s" or "0.5s"
+//   - Interval and Timeout must be positive, IntervalJitter must not be negative
+//   - Timeout must not exceed Interval, and IntervalJitter must be shorter than Interval
+//   - HealthyThreshold and UnhealthyThreshold must be between 1 and the maximum of an int32
+//
+// The load balancer API version of this SDK only supports TCP health checks, it doesn't define HTTP or gRPC
+// health checks, e.g. with a path, a host header or the expected status codes.
+func ValidateActiveHealthCheck(hc *loadbalancer.ActiveHealthCheck) error {
+	if hc == nil {
+		return nil
+	}
+	var errs []error
+
+	interval, intervalSet, err := healthCheckDuration("interval", hc.Interval)
+	errs = append(errs, err)
+	if intervalSet && interval <= 0 {
+		errs = append(errs, fmt.Errorf("interval %s must be positive", *hc.Interval))
+	}
+	timeout, timeoutSet, err := healthCheckDuration("timeout", hc.Timeout)
+	errs = append(errs, err)
+	if timeoutSet && timeout <= 0 {
+		errs = append(errs, fmt.Errorf("timeout %s must be positive", *hc.Timeout))
+	}
+	jitter, jitterSet, err := healthCheckDuration("intervalJitter", hc.IntervalJitter)
+	errs = append(errs, err)
+	if jitterSet && jitter < 0 {
+		errs = append(errs, fmt.Errorf("intervalJitter %s must not be negative", *hc.IntervalJitter))
+	}
+
+	if intervalSet && interval > 0 {
+		if timeoutSet && timeout > interval {
+			errs = append(errs, fmt.Errorf("timeout %s must not exceed interval %s", *hc.Timeout, *hc.Interval))
+		}
+		if jitterSet && jitter >= interval {
+			errs = append(errs, fmt.Errorf("intervalJitter %s must be shorter than interval %s", *hc.IntervalJitter, *hc.Interval))
+		}
+	}
+
+	errs = append(errs, healthCheckThreshold("healthyThreshold", hc.HealthyThreshold))
+	errs = append(errs, healthCheckThreshold("unhealthyThreshold", hc.UnhealthyThreshold))
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid active health check: %w", err)
+	}
+	return nil
+}
+
+// ValidateTargetPools checks the active health checks of the target pools of a load balancer with
+// ValidateActiveHealthCheck
+func ValidateTargetPools(pools []loadbalancer.TargetPool) error {
+	var errs []error
+	for _, pool := range pools {
+		if err := ValidateActiveHealthCheck(pool.ActiveHealthCheck); err != nil {
+			errs = append(errs, fmt.Errorf("target pool %s: %w", pool.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// healthCheckDuration parses a duration in seconds of the API, e.g. "3s"
+func healthCheckDuration(field string, value *string) (d time.Duration, set bool, err error) {
+	if value == nil {
+		return 0, false, nil
+	}
+	seconds, ok := strings.CutSuffix(*value, "s")
+	f, parseErr := strconv.ParseFloat(seconds, 64)
+	if !ok || parseErr != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, false, fmt.Errorf("%s %q must be a duration in seconds, e.g. \"3s\"", field, *value)
+	}
+	return time.Duration(f * float64(time.Second)), true, nil
+}
+
+func healthCheckThreshold(field string, value *int64) error {
+	if value != nil && (*value < 1 || *value > math.MaxInt32) {
+		return fmt.Errorf("%s %d must be between 1 and %d", field, *value, math.MaxInt32)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package wait
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
+)
+
+func TestValidateActiveHealthCheck(t *testing.T) {
+	for _, tt := range []struct {
+		desc        string
+		hc          *loadbalancer.ActiveHealthCheck
+		wantErrText []string
+	}{
+		{"nil", nil, nil},
+		{"empty", &loadbalancer.ActiveHealthCheck{}, nil},
+		{
+			desc: "valid",
+			hc: &loadbalancer.ActiveHealthCheck{
+				HealthyThreshold:   utils.Ptr(int64(2)),
+				Interval:           utils.Ptr("3s"),
+				IntervalJitter:     utils.Ptr("0.5s"),
+				Timeout:            utils.Ptr("3s"),
+				UnhealthyThreshold: utils.Ptr(int64(3)),
+			},
+		},
+		{
+			desc:        "not_seconds",
+			hc:          &loadbalancer.ActiveHealthCheck{Interval: utils.Ptr("3m"), Timeout: utils.Ptr("fast")},
+			wantErrText: []string{`interval "3m"`, `timeout "fast"`},
+		},
+		{
+			desc:        "not_positive",
+			hc:          &loadbalancer.ActiveHealthCheck{Interval: utils.Ptr("0s"), Timeout: utils.Ptr("-1s"), IntervalJitter: utils.Ptr("-1s")},
+			wantErrText: []string{"interval 0s must be positive", "timeout -1s must be positive", "intervalJitter -1s must not be negative"},
+		},
+		{
+			desc:        "timeout_exceeds_interval",
+			hc:          &loadbalancer.ActiveHealthCheck{Interval: utils.Ptr("2s"), Timeout: utils.Ptr("5s")},
+			wantErrText: []string{"timeout 5s must not exceed interval 2s"},
+		},
+		{
+			desc:        "jitter_exceeds_interval",
+			hc:          &loadbalancer.ActiveHealthCheck{Interval: utils.Ptr("2s"), IntervalJitter: utils.Ptr("2s")},
+			wantErrText: []string{"intervalJitter 2s must be shorter than interval 2s"},
+		},
+		{
+			desc:        "thresholds",
+			hc:          &loadbalancer.ActiveHealthCheck{HealthyThreshold: utils.Ptr(int64(0)), UnhealthyThreshold: utils.Ptr(int64(1 << 32))},
+			wantErrText: []string{"healthyThreshold 0", "unhealthyThreshold 4294967296"},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := ValidateActiveHealthCheck(tt.hc)
+			if len(tt.wantErrText) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			for _, text := range tt.wantErrText {
+				if !strings.Contains(err.Error(), text) {
+					t.Errorf("expected the error to contain %q, got %q", text, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateTargetPools(t *testing.T) {
+	pools := []loadbalancer.TargetPool{
+		{Name: utils.Ptr("valid"), ActiveHealthCheck: &loadbalancer.ActiveHealthCheck{Interval: utils.Ptr("3s")}},
+		{Name: utils.Ptr("invalid"), ActiveHealthCheck: &loadbalancer.ActiveHealthCheck{Interval: utils.Ptr("3s"), Timeout: utils.Ptr("4s")}},
+		{Name: utils.Ptr("no_health_check")},
+	}
+	err := ValidateTargetPools(pools)
+	if err == nil || !strings.Contains(err.Error(), "target pool invalid") || strings.Contains(err.Error(), "target pool valid") {
+		t.Fatalf("expected an error for the invalid target pool only, got %v", err)
+	}
+	if err := ValidateTargetPools(pools[:1]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
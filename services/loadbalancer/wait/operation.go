@@ -0,0 +1,55 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
+)
+
+// CreateLoadBalancerOperation returns the async action of CreateLoadBalancerWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with CreateLoadBalancerPollFunc. The name of the operation
+// is "loadbalancer/CreateLoadBalancer/{projectId}/{region}/{instanceName}".
+func CreateLoadBalancerOperation(a APIClientInterface, projectId, region, instanceName string) *lro.Operation[loadbalancer.LoadBalancer] {
+	return createLoadBalancerOperationHandler(a).Operation(projectId, region, instanceName)
+}
+
+// CreateLoadBalancerPollFunc returns the lro.PollFunc of the operations returned by CreateLoadBalancerOperation, e.g.
+// to resume one with lro.Resume
+func CreateLoadBalancerPollFunc(a APIClientInterface) lro.PollFunc[loadbalancer.LoadBalancer] {
+	return createLoadBalancerOperationHandler(a).PollFunc()
+}
+
+func createLoadBalancerOperationHandler(a APIClientInterface) lro.WaitHandler[loadbalancer.LoadBalancer] {
+	return lro.WaitHandler[loadbalancer.LoadBalancer]{
+		Kind: "loadbalancer/CreateLoadBalancer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[loadbalancer.LoadBalancer] {
+			return CreateLoadBalancerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteLoadBalancerOperation returns the async action of DeleteLoadBalancerWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with DeleteLoadBalancerPollFunc. The name of the operation
+// is "loadbalancer/DeleteLoadBalancer/{projectId}/{region}/{instanceId}".
+func DeleteLoadBalancerOperation(a APIClientInterface, projectId, region, instanceId string) *lro.Operation[struct{}] {
+	return deleteLoadBalancerOperationHandler(a).Operation(projectId, region, instanceId)
+}
+
+// DeleteLoadBalancerPollFunc returns the lro.PollFunc of the operations returned by DeleteLoadBalancerOperation, e.g.
+// to resume one with lro.Resume
+func DeleteLoadBalancerPollFunc(a APIClientInterface) lro.PollFunc[struct{}] {
+	return deleteLoadBalancerOperationHandler(a).PollFunc()
+}
+
+func deleteLoadBalancerOperationHandler(a APIClientInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "loadbalancer/DeleteLoadBalancer",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteLoadBalancerWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
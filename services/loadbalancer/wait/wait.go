@@ -2,8 +2,10 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -67,6 +69,13 @@ func CreateLoadBalancerWaitHandler(ctx context.Context, a APIClientInterface, pr
 			return true, s, fmt.Errorf("instance with name %s has unexpected status %s", instanceName, *s.Status)
 		}
 	})
+	handler.SetStateFetch(func() (*loadbalancer.LoadBalancer, string, error) {
+		s, err := a.GetLoadBalancerExecute(ctx, projectId, region, instanceName)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		return s, string(s.GetStatus()), nil
+	})
 	handler.SetTimeout(45 * time.Minute)
 	return handler
 }
@@ -78,7 +87,8 @@ func DeleteLoadBalancerWaitHandler(ctx context.Context, a APIClientInterface, pr
 		if err == nil {
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, nil, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError")
 		}
@@ -90,3 +100,157 @@ func DeleteLoadBalancerWaitHandler(ctx context.Context, a APIClientInterface, pr
 	handler.SetTimeout(15 * time.Minute)
 	return handler
 }
+
+// LBConfigVersion is the version of the format of LBConfig
+const LBConfigVersion = "loadbalancer.stackit.cloud/v1"
+
+// LBConfig is the configuration of a load balancer, without its status and the fields set by the API, e.g. to store it
+// in a file and recreate the load balancer from it. It can be serialized as JSON, or as YAML with a library that
+// honors the JSON field tags, e.g. sigs.k8s.io/yaml. Listeners, target pools and targets are sorted, so that
+// the serialized configuration is stable.
+type LBConfig struct {
+	// Version of the format, LBConfigVersion
+	Version string `json:"version"`
+	// Project of the exported load balancer
+	SourceProjectId string            `json:"sourceProjectId,omitempty"`
+	Name            string            `json:"name"`
+	PlanId          *string           `json:"planId,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	// Static IP address of the load balancer, which belongs to the source project
+	ExternalAddress                      *string                           `json:"externalAddress,omitempty"`
+	DisableTargetSecurityGroupAssignment *bool                             `json:"disableTargetSecurityGroupAssignment,omitempty"`
+	Networks                             []loadbalancer.Network            `json:"networks,omitempty"`
+	Listeners                            []loadbalancer.Listener           `json:"listeners,omitempty"`
+	TargetPools                          []loadbalancer.TargetPool         `json:"targetPools,omitempty"`
+	Options                              *loadbalancer.LoadBalancerOptions `json:"options,omitempty"`
+}
+
+// Interface needed for the configuration import and export
+type APIClientConfigInterface interface {
+	GetLoadBalancerExecute(ctx context.Context, projectId, region, name string) (*loadbalancer.LoadBalancer, error)
+	CreateLoadBalancer(ctx context.Context, projectId string, region string) loadbalancer.ApiCreateLoadBalancerRequest
+}
+
+// ExportConfig returns the configuration of a load balancer, which can be recreated with ImportConfig
+func ExportConfig(ctx context.Context, a APIClientConfigInterface, projectId, region, name string) (LBConfig, error) {
+	lb, err := a.GetLoadBalancerExecute(ctx, projectId, region, name)
+	if err != nil {
+		return LBConfig{}, fmt.Errorf("get load balancer %s: %w", name, err)
+	}
+
+	cfg := LBConfig{
+		Version:                              LBConfigVersion,
+		SourceProjectId:                      projectId,
+		Name:                                 name,
+		PlanId:                               lb.PlanId,
+		ExternalAddress:                      lb.ExternalAddress,
+		DisableTargetSecurityGroupAssignment: lb.DisableTargetSecurityGroupAssignment,
+		Options:                              lb.Options,
+	}
+	if lb.Labels != nil {
+		cfg.Labels = *lb.Labels
+	}
+	if lb.Networks != nil {
+		cfg.Networks = append(cfg.Networks, *lb.Networks...)
+	}
+	if lb.Listeners != nil {
+		cfg.Listeners = append(cfg.Listeners, *lb.Listeners...)
+		sort.SliceStable(cfg.Listeners, func(i, j int) bool {
+			if name, other := ptrValue(cfg.Listeners[i].Name), ptrValue(cfg.Listeners[j].Name); name != other {
+				return name < other
+			}
+			return ptrValue(cfg.Listeners[i].Port) < ptrValue(cfg.Listeners[j].Port)
+		})
+	}
+	if lb.TargetPools != nil {
+		for _, pool := range *lb.TargetPools {
+			if pool.Targets != nil {
+				targets := append([]loadbalancer.Target{}, *pool.Targets...)
+				sort.SliceStable(targets, func(i, j int) bool {
+					return ptrValue(targets[i].Ip) < ptrValue(targets[j].Ip)
+				})
+				pool.Targets = &targets
+			}
+			cfg.TargetPools = append(cfg.TargetPools, pool)
+		}
+		sort.SliceStable(cfg.TargetPools, func(i, j int) bool {
+			return ptrValue(cfg.TargetPools[i].Name) < ptrValue(cfg.TargetPools[j].Name)
+		})
+	}
+	return cfg, nil
+}
+
+// ImportConfig creates a load balancer from a configuration returned by ExportConfig. It doesn't wait for the load balancer
+// to be ready, see CreateLoadBalancerWaitHandler.
+//
+// If the configuration was exported from another project, the fields that reference resources of the source project
+// are removed: the static external address and the credentials of the observability options.
+// The networks are kept and must be replaced by networks of the target project beforehand, unless they are shared with it.
+func ImportConfig(ctx context.Context, a APIClientConfigInterface, projectId, region string, cfg LBConfig) (*loadbalancer.LoadBalancer, error) {
+	if cfg.Version != LBConfigVersion {
+		return nil, fmt.Errorf("unsupported load balancer configuration version %q, expected %q", cfg.Version, LBConfigVersion)
+	}
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("load balancer configuration has no name")
+	}
+
+	payload := loadbalancer.CreateLoadBalancerPayload{
+		Name:                                 &cfg.Name,
+		PlanId:                               cfg.PlanId,
+		ExternalAddress:                      cfg.ExternalAddress,
+		DisableTargetSecurityGroupAssignment: cfg.DisableTargetSecurityGroupAssignment,
+		Options:                              cfg.Options,
+	}
+	if cfg.Labels != nil {
+		payload.Labels = &cfg.Labels
+	}
+	if cfg.Networks != nil {
+		payload.Networks = &cfg.Networks
+	}
+	if cfg.Listeners != nil {
+		payload.Listeners = &cfg.Listeners
+	}
+	if cfg.TargetPools != nil {
+		payload.TargetPools = &cfg.TargetPools
+	}
+
+	if cfg.SourceProjectId != "" && cfg.SourceProjectId != projectId {
+		payload.ExternalAddress = nil
+		payload.Options = withoutObservabilityCredentials(cfg.Options)
+	}
+
+	lb, err := a.CreateLoadBalancer(ctx, projectId, region).CreateLoadBalancerPayload(payload).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("create load balancer %s: %w", cfg.Name, err)
+	}
+	return lb, nil
+}
+
+// withoutObservabilityCredentials returns a copy of options without the credentials references of the observability options
+func withoutObservabilityCredentials(options *loadbalancer.LoadBalancerOptions) *loadbalancer.LoadBalancerOptions {
+	if options == nil || options.Observability == nil {
+		return options
+	}
+	optionsCopy := *options
+	observability := *options.Observability
+	if observability.Logs != nil {
+		logs := *observability.Logs
+		logs.CredentialsRef = nil
+		observability.Logs = &logs
+	}
+	if observability.Metrics != nil {
+		metrics := *observability.Metrics
+		metrics.CredentialsRef = nil
+		observability.Metrics = &metrics
+	}
+	optionsCopy.Observability = &observability
+	return &optionsCopy
+}
+
+func ptrValue[T any](p *T) T {
+	var v T
+	if p != nil {
+		v = *p
+	}
+	return v
+}
@@ -115,6 +115,87 @@ func TestCreateInstanceWaitHandler(t *testing.T) {
 	}
 }
 
+func TestCreateInstanceWaitHandlerTerminalStates(t *testing.T) {
+	tests := []struct {
+		desc             string
+		instanceGetFails bool
+		instanceStatus   loadbalancer.LoadBalancerStatus
+		wantErr          bool
+		wantResp         bool
+	}{
+		{
+			desc:           "success_state",
+			instanceStatus: loadbalancer.LOADBALANCERSTATUS_READY,
+			wantErr:        false,
+			wantResp:       true,
+		},
+		{
+			desc:           "other_success_state",
+			instanceStatus: "STATUS_DEGRADED",
+			wantErr:        false,
+			wantResp:       true,
+		},
+		{
+			desc:           "failure_state",
+			instanceStatus: loadbalancer.LOADBALANCERSTATUS_ERROR,
+			wantErr:        true,
+			wantResp:       true,
+		},
+		{
+			desc:           "unknown_state_timeout",
+			instanceStatus: "STATUS_MIGRATING",
+			wantErr:        true,
+			wantResp:       false,
+		},
+		{
+			desc:           "state_no_longer_terminal_timeout",
+			instanceStatus: loadbalancer.LOADBALANCERSTATUS_TERMINATING,
+			wantErr:        true,
+			wantResp:       false,
+		},
+		{
+			desc:             "instance_get_fails",
+			instanceGetFails: true,
+			wantErr:          true,
+			wantResp:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			instanceName := "foo-bar"
+
+			apiClient := &apiClientMocked{
+				instanceName:     instanceName,
+				instanceStatus:   tt.instanceStatus,
+				instanceGetFails: tt.instanceGetFails,
+			}
+
+			var wantRes *loadbalancer.LoadBalancer
+			if tt.wantResp {
+				wantRes = &loadbalancer.LoadBalancer{
+					Name:   &instanceName,
+					Status: utils.Ptr(tt.instanceStatus),
+				}
+			}
+
+			handler := CreateLoadBalancerWaitHandler(context.Background(), apiClient, "", testRegion, instanceName)
+			handler.SetTerminalStates(
+				[]string{string(loadbalancer.LOADBALANCERSTATUS_READY), "STATUS_DEGRADED"},
+				[]string{string(loadbalancer.LOADBALANCERSTATUS_ERROR)},
+			)
+
+			gotRes, err := handler.SetTimeout(10 * time.Millisecond).WaitWithContext(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !cmp.Equal(gotRes, wantRes) {
+				t.Fatalf("handler gotRes = %v, want %v", gotRes, wantRes)
+			}
+		})
+	}
+}
+
 func TestDeleteInstanceWaitHandler(t *testing.T) {
 	tests := []struct {
 		desc              string
@@ -160,3 +241,270 @@ func TestDeleteInstanceWaitHandler(t *testing.T) {
 		})
 	}
 }
+
+// Used for testing the configuration import and export
+type apiClientConfigMocked struct {
+	loadBalancer   *loadbalancer.LoadBalancer
+	createdPayload *loadbalancer.CreateLoadBalancerPayload
+	createdProject string
+	createFails    bool
+	getFails       bool
+}
+
+func (a *apiClientConfigMocked) GetLoadBalancerExecute(_ context.Context, _, _, _ string) (*loadbalancer.LoadBalancer, error) {
+	if a.getFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: 404,
+		}
+	}
+	return a.loadBalancer, nil
+}
+
+func (a *apiClientConfigMocked) CreateLoadBalancer(_ context.Context, projectId, _ string) loadbalancer.ApiCreateLoadBalancerRequest {
+	a.createdProject = projectId
+	return &createLoadBalancerRequestMocked{client: a}
+}
+
+type createLoadBalancerRequestMocked struct {
+	client  *apiClientConfigMocked
+	payload loadbalancer.CreateLoadBalancerPayload
+}
+
+func (r *createLoadBalancerRequestMocked) CreateLoadBalancerPayload(payload loadbalancer.CreateLoadBalancerPayload) loadbalancer.ApiCreateLoadBalancerRequest {
+	r.payload = payload
+	return r
+}
+
+func (r *createLoadBalancerRequestMocked) XRequestID(_ string) loadbalancer.ApiCreateLoadBalancerRequest {
+	return r
+}
+
+func (r *createLoadBalancerRequestMocked) RetryOnConflict(_ int) loadbalancer.ApiCreateLoadBalancerRequest {
+	return r
+}
+
+func (r *createLoadBalancerRequestMocked) SetQueryParam(_, _ string) loadbalancer.ApiCreateLoadBalancerRequest {
+	return r
+}
+
+func (r *createLoadBalancerRequestMocked) AddQueryParam(_, _ string) loadbalancer.ApiCreateLoadBalancerRequest {
+	return r
+}
+
+func (r *createLoadBalancerRequestMocked) Clone() loadbalancer.ApiCreateLoadBalancerRequest {
+	return r
+}
+
+func (r *createLoadBalancerRequestMocked) Fingerprint() (string, error) {
+	return "", nil
+}
+
+func (r *createLoadBalancerRequestMocked) Execute() (*loadbalancer.LoadBalancer, error) {
+	if r.client.createFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: 400,
+		}
+	}
+	r.client.createdPayload = &r.payload
+	return &loadbalancer.LoadBalancer{Name: r.payload.Name}, nil
+}
+
+func fixtureLoadBalancer() *loadbalancer.LoadBalancer {
+	return &loadbalancer.LoadBalancer{
+		Name:            utils.Ptr("lb"),
+		PlanId:          utils.Ptr("p10"),
+		ExternalAddress: utils.Ptr("193.148.160.1"),
+		PrivateAddress:  utils.Ptr("10.0.0.5"),
+		Status:          utils.Ptr(loadbalancer.LOADBALANCERSTATUS_READY),
+		Version:         utils.Ptr("3"),
+		Region:          utils.Ptr(testRegion),
+		Labels:          &map[string]string{"env": "prod"},
+		Networks: &[]loadbalancer.Network{
+			{NetworkId: utils.Ptr("network-id"), Role: utils.Ptr(loadbalancer.NETWORKROLE_LISTENERS_AND_TARGETS)},
+		},
+		Listeners: &[]loadbalancer.Listener{
+			{Name: utils.Ptr("tcp-443"), Port: utils.Ptr(int64(443)), TargetPool: utils.Ptr("web")},
+			{Name: utils.Ptr("tcp-22"), Port: utils.Ptr(int64(22)), TargetPool: utils.Ptr("bastion")},
+		},
+		TargetPools: &[]loadbalancer.TargetPool{
+			{
+				Name:       utils.Ptr("web"),
+				TargetPort: utils.Ptr(int64(8443)),
+				Targets: &[]loadbalancer.Target{
+					{DisplayName: utils.Ptr("web-2"), Ip: utils.Ptr("10.0.0.12")},
+					{DisplayName: utils.Ptr("web-1"), Ip: utils.Ptr("10.0.0.11")},
+				},
+				ActiveHealthCheck: &loadbalancer.ActiveHealthCheck{HealthyThreshold: utils.Ptr(int64(2))},
+			},
+			{
+				Name:       utils.Ptr("bastion"),
+				TargetPort: utils.Ptr(int64(22)),
+			},
+		},
+		Options: &loadbalancer.LoadBalancerOptions{
+			Observability: &loadbalancer.LoadbalancerOptionObservability{
+				Logs: &loadbalancer.LoadbalancerOptionLogs{
+					CredentialsRef: utils.Ptr("credentials-ref"),
+					PushUrl:        utils.Ptr("https://logs.example"),
+				},
+			},
+		},
+	}
+}
+
+func TestExportConfig(t *testing.T) {
+	tests := []struct {
+		desc        string
+		getFails    bool
+		wantErr     bool
+		expectedCfg LBConfig
+	}{
+		{
+			desc: "export",
+			expectedCfg: LBConfig{
+				Version:         LBConfigVersion,
+				SourceProjectId: "pid",
+				Name:            "lb",
+				PlanId:          utils.Ptr("p10"),
+				Labels:          map[string]string{"env": "prod"},
+				ExternalAddress: utils.Ptr("193.148.160.1"),
+				Networks: []loadbalancer.Network{
+					{NetworkId: utils.Ptr("network-id"), Role: utils.Ptr(loadbalancer.NETWORKROLE_LISTENERS_AND_TARGETS)},
+				},
+				Listeners: []loadbalancer.Listener{
+					{Name: utils.Ptr("tcp-22"), Port: utils.Ptr(int64(22)), TargetPool: utils.Ptr("bastion")},
+					{Name: utils.Ptr("tcp-443"), Port: utils.Ptr(int64(443)), TargetPool: utils.Ptr("web")},
+				},
+				TargetPools: []loadbalancer.TargetPool{
+					{
+						Name:       utils.Ptr("bastion"),
+						TargetPort: utils.Ptr(int64(22)),
+					},
+					{
+						Name:       utils.Ptr("web"),
+						TargetPort: utils.Ptr(int64(8443)),
+						Targets: &[]loadbalancer.Target{
+							{DisplayName: utils.Ptr("web-1"), Ip: utils.Ptr("10.0.0.11")},
+							{DisplayName: utils.Ptr("web-2"), Ip: utils.Ptr("10.0.0.12")},
+						},
+						ActiveHealthCheck: &loadbalancer.ActiveHealthCheck{HealthyThreshold: utils.Ptr(int64(2))},
+					},
+				},
+				Options: &loadbalancer.LoadBalancerOptions{
+					Observability: &loadbalancer.LoadbalancerOptionObservability{
+						Logs: &loadbalancer.LoadbalancerOptionLogs{
+							CredentialsRef: utils.Ptr("credentials-ref"),
+							PushUrl:        utils.Ptr("https://logs.example"),
+						},
+					},
+				},
+			},
+		},
+		{
+			desc:     "get_fails",
+			getFails: true,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientConfigMocked{
+				loadBalancer: fixtureLoadBalancer(),
+				getFails:     tt.getFails,
+			}
+
+			cfg, err := ExportConfig(context.Background(), apiClient, "pid", testRegion, "lb")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExportConfig error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.expectedCfg, cfg); diff != "" {
+				t.Fatalf("unexpected configuration (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImportConfig(t *testing.T) {
+	tests := []struct {
+		desc                    string
+		projectId               string
+		modifyCfg               func(cfg *LBConfig)
+		createFails             bool
+		wantErr                 bool
+		expectedExternalAddress *string
+		expectedCredentialsRef  *string
+	}{
+		{
+			desc:                    "same_project",
+			projectId:               "pid",
+			expectedExternalAddress: utils.Ptr("193.148.160.1"),
+			expectedCredentialsRef:  utils.Ptr("credentials-ref"),
+		},
+		{
+			desc:      "other_project",
+			projectId: "other-pid",
+		},
+		{
+			desc:      "unsupported_version",
+			projectId: "pid",
+			modifyCfg: func(cfg *LBConfig) { cfg.Version = "loadbalancer.stackit.cloud/v0" },
+			wantErr:   true,
+		},
+		{
+			desc:      "no_name",
+			projectId: "pid",
+			modifyCfg: func(cfg *LBConfig) { cfg.Name = "" },
+			wantErr:   true,
+		},
+		{
+			desc:        "create_fails",
+			projectId:   "pid",
+			createFails: true,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientConfigMocked{
+				loadBalancer: fixtureLoadBalancer(),
+				createFails:  tt.createFails,
+			}
+			cfg, err := ExportConfig(context.Background(), apiClient, "pid", testRegion, "lb")
+			if err != nil {
+				t.Fatalf("ExportConfig failed: %v", err)
+			}
+			if tt.modifyCfg != nil {
+				tt.modifyCfg(&cfg)
+			}
+
+			lb, err := ImportConfig(context.Background(), apiClient, tt.projectId, testRegion, cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ImportConfig error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if lb == nil || lb.Name == nil || *lb.Name != "lb" {
+				t.Fatalf("unexpected load balancer %+v", lb)
+			}
+			if apiClient.createdProject != tt.projectId {
+				t.Fatalf("load balancer created in project %q, expected %q", apiClient.createdProject, tt.projectId)
+			}
+
+			payload := apiClient.createdPayload
+			if diff := cmp.Diff(tt.expectedExternalAddress, payload.ExternalAddress); diff != "" {
+				t.Errorf("unexpected external address (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.expectedCredentialsRef, payload.Options.Observability.Logs.CredentialsRef); diff != "" {
+				t.Errorf("unexpected credentials reference (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(cfg.TargetPools, *payload.TargetPools); diff != "" {
+				t.Errorf("unexpected target pools (-want +got):\n%s", diff)
+			}
+			// The exported configuration must not be modified
+			if cfg.ExternalAddress == nil || cfg.Options.Observability.Logs.CredentialsRef == nil {
+				t.Errorf("the configuration was modified")
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -84,6 +85,13 @@ func CreateInstanceWaitHandler(ctx context.Context, a APIClientInstanceInterface
 		}
 		return false, nil, nil
 	})
+	handler.SetStateFetch(func() (*mariadb.Instance, string, error) {
+		s, err := a.GetInstanceExecute(ctx, projectId, instanceId)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		return s, string(s.GetStatus()), nil
+	})
 	handler.SetTimeout(45 * time.Minute)
 	return handler
 }
@@ -110,6 +118,13 @@ func PartialUpdateInstanceWaitHandler(ctx context.Context, a APIClientInstanceIn
 		}
 		return false, nil, nil
 	})
+	handler.SetStateFetch(func() (*mariadb.Instance, string, error) {
+		s, err := a.GetInstanceExecute(ctx, projectId, instanceId)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		return s, string(s.GetStatus()), nil
+	})
 	handler.SetTimeout(45 * time.Minute)
 	return handler
 }
@@ -133,7 +148,8 @@ func DeleteInstanceWaitHandler(ctx context.Context, a APIClientInstanceInterface
 			}
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, nil, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError")
 		}
@@ -151,7 +167,8 @@ func CreateCredentialsWaitHandler(ctx context.Context, a APIClientCredentialsInt
 	handler := wait.New(func() (waitFinished bool, response *mariadb.CredentialsResponse, err error) {
 		s, err := a.GetCredentialsExecute(ctx, projectId, instanceId, credentialsId)
 		if err != nil {
-			oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+			var oapiErr *oapierror.GenericOpenAPIError
+			ok := errors.As(err, &oapiErr)
 			if !ok {
 				return false, nil, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError")
 			}
@@ -177,7 +194,8 @@ func DeleteCredentialsWaitHandler(ctx context.Context, a APIClientCredentialsInt
 		if err == nil {
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, nil, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError")
 		}
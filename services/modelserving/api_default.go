@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateToken create auth token for shared model use
@@ -190,37 +193,73 @@ type DefaultApi interface {
 	PartialUpdateTokenExecute(ctx context.Context, regionId string, projectId string, tId string) (*UpdateTokenResponse, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateTokenRequest interface {
 	CreateTokenPayload(createTokenPayload CreateTokenPayload) ApiCreateTokenRequest
+	RetryOnConflict(maxAttempts int) ApiCreateTokenRequest
+	SetQueryParam(key, value string) ApiCreateTokenRequest
+	AddQueryParam(key, value string) ApiCreateTokenRequest
+	Clone() ApiCreateTokenRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateTokenResponse, error)
 }
 
 type ApiDeleteTokenRequest interface {
+	SetQueryParam(key, value string) ApiDeleteTokenRequest
+	AddQueryParam(key, value string) ApiDeleteTokenRequest
+	Clone() ApiDeleteTokenRequest
+	Fingerprint() (string, error)
 	Execute() (*MessageResponse, error)
 }
 
 type ApiGetChatModelRequest interface {
+	SetQueryParam(key, value string) ApiGetChatModelRequest
+	AddQueryParam(key, value string) ApiGetChatModelRequest
+	Clone() ApiGetChatModelRequest
+	Fingerprint() (string, error)
 	Execute() (*GetChatModelResponse, error)
 }
 
 type ApiGetEmbeddingModelRequest interface {
+	SetQueryParam(key, value string) ApiGetEmbeddingModelRequest
+	AddQueryParam(key, value string) ApiGetEmbeddingModelRequest
+	Clone() ApiGetEmbeddingModelRequest
+	Fingerprint() (string, error)
 	Execute() (*GetEmbeddingsModelResp, error)
 }
 
 type ApiGetTokenRequest interface {
+	SetQueryParam(key, value string) ApiGetTokenRequest
+	AddQueryParam(key, value string) ApiGetTokenRequest
+	Clone() ApiGetTokenRequest
+	Fingerprint() (string, error)
 	Execute() (*GetTokenResponse, error)
 }
 
 type ApiListModelsRequest interface {
+	SetQueryParam(key, value string) ApiListModelsRequest
+	AddQueryParam(key, value string) ApiListModelsRequest
+	Clone() ApiListModelsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListModelsResponse, error)
 }
 
 type ApiListTokensRequest interface {
+	SetQueryParam(key, value string) ApiListTokensRequest
+	AddQueryParam(key, value string) ApiListTokensRequest
+	Clone() ApiListTokensRequest
+	Fingerprint() (string, error)
 	Execute() (*ListTokenResp, error)
 }
 
 type ApiPartialUpdateTokenRequest interface {
 	PartialUpdateTokenPayload(partialUpdateTokenPayload PartialUpdateTokenPayload) ApiPartialUpdateTokenRequest
+	SetQueryParam(key, value string) ApiPartialUpdateTokenRequest
+	AddQueryParam(key, value string) ApiPartialUpdateTokenRequest
+	Clone() ApiPartialUpdateTokenRequest
+	Fingerprint() (string, error)
 	Execute() (*UpdateTokenResponse, error)
 }
 
@@ -233,6 +272,8 @@ type CreateTokenRequest struct {
 	regionId           string
 	projectId          string
 	createTokenPayload *CreateTokenPayload
+	retryOnConflict    int
+	queryParams        []queryParam
 }
 
 func (r CreateTokenRequest) CreateTokenPayload(createTokenPayload CreateTokenPayload) ApiCreateTokenRequest {
@@ -240,7 +281,50 @@ func (r CreateTokenRequest) CreateTokenPayload(createTokenPayload CreateTokenPay
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateTokenRequest) RetryOnConflict(maxAttempts int) ApiCreateTokenRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateTokenRequest) SetQueryParam(key, value string) ApiCreateTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateTokenRequest) AddQueryParam(key, value string) ApiCreateTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateTokenRequest) Clone() ApiCreateTokenRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateTokenRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateTokenRequest) Execute() (*CreateTokenResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateToken", oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateTokenRequest) execute() (*CreateTokenResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -264,6 +348,12 @@ func (r CreateTokenRequest) Execute() (*CreateTokenResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -284,7 +374,8 @@ func (r CreateTokenRequest) Execute() (*CreateTokenResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createTokenPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateToken"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -315,10 +406,14 @@ func (r CreateTokenRequest) Execute() (*CreateTokenResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -329,7 +424,7 @@ func (r CreateTokenRequest) Execute() (*CreateTokenResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -340,7 +435,7 @@ func (r CreateTokenRequest) Execute() (*CreateTokenResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -351,7 +446,7 @@ func (r CreateTokenRequest) Execute() (*CreateTokenResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -362,7 +457,7 @@ func (r CreateTokenRequest) Execute() (*CreateTokenResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -405,14 +500,51 @@ func (a *APIClient) CreateTokenExecute(ctx context.Context, regionId string, pro
 }
 
 type DeleteTokenRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	regionId   string
-	projectId  string
-	tId        string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	regionId    string
+	projectId   string
+	tId         string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteTokenRequest) SetQueryParam(key, value string) ApiDeleteTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteTokenRequest) AddQueryParam(key, value string) ApiDeleteTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteTokenRequest) Clone() ApiDeleteTokenRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteTokenRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteTokenRequest) Execute() (*MessageResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteToken", oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "tId", Value: ParameterValueToString(r.tId, "tId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteTokenRequest) execute() (*MessageResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -437,6 +569,15 @@ func (r DeleteTokenRequest) Execute() (*MessageResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.tId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "tId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -455,7 +596,8 @@ func (r DeleteTokenRequest) Execute() (*MessageResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteToken"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -486,10 +628,14 @@ func (r DeleteTokenRequest) Execute() (*MessageResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -500,7 +646,7 @@ func (r DeleteTokenRequest) Execute() (*MessageResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -511,7 +657,7 @@ func (r DeleteTokenRequest) Execute() (*MessageResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -522,7 +668,7 @@ func (r DeleteTokenRequest) Execute() (*MessageResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -533,7 +679,7 @@ func (r DeleteTokenRequest) Execute() (*MessageResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -579,13 +725,50 @@ func (a *APIClient) DeleteTokenExecute(ctx context.Context, regionId string, pro
 }
 
 type GetChatModelRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	regionId   string
-	modelId    string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	regionId    string
+	modelId     string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetChatModelRequest) SetQueryParam(key, value string) ApiGetChatModelRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetChatModelRequest) AddQueryParam(key, value string) ApiGetChatModelRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetChatModelRequest) Clone() ApiGetChatModelRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetChatModelRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetChatModelRequest) Execute() (*GetChatModelResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetChatModel", oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "modelId", Value: ParameterValueToString(r.modelId, "modelId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetChatModelRequest) execute() (*GetChatModelResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -609,6 +792,12 @@ func (r GetChatModelRequest) Execute() (*GetChatModelResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.modelId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "modelId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -627,7 +816,8 @@ func (r GetChatModelRequest) Execute() (*GetChatModelResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetChatModel"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -658,10 +848,14 @@ func (r GetChatModelRequest) Execute() (*GetChatModelResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -672,7 +866,7 @@ func (r GetChatModelRequest) Execute() (*GetChatModelResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -683,7 +877,7 @@ func (r GetChatModelRequest) Execute() (*GetChatModelResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -694,7 +888,7 @@ func (r GetChatModelRequest) Execute() (*GetChatModelResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -737,13 +931,50 @@ func (a *APIClient) GetChatModelExecute(ctx context.Context, regionId string, mo
 }
 
 type GetEmbeddingModelRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	regionId   string
-	modelId    string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	regionId    string
+	modelId     string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetEmbeddingModelRequest) SetQueryParam(key, value string) ApiGetEmbeddingModelRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetEmbeddingModelRequest) AddQueryParam(key, value string) ApiGetEmbeddingModelRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetEmbeddingModelRequest) Clone() ApiGetEmbeddingModelRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetEmbeddingModelRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetEmbeddingModelRequest) Execute() (*GetEmbeddingsModelResp, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetEmbeddingModel", oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "modelId", Value: ParameterValueToString(r.modelId, "modelId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetEmbeddingModelRequest) execute() (*GetEmbeddingsModelResp, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -767,6 +998,12 @@ func (r GetEmbeddingModelRequest) Execute() (*GetEmbeddingsModelResp, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.modelId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "modelId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -785,7 +1022,8 @@ func (r GetEmbeddingModelRequest) Execute() (*GetEmbeddingsModelResp, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetEmbeddingModel"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -816,10 +1054,14 @@ func (r GetEmbeddingModelRequest) Execute() (*GetEmbeddingsModelResp, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -830,7 +1072,7 @@ func (r GetEmbeddingModelRequest) Execute() (*GetEmbeddingsModelResp, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -841,7 +1083,7 @@ func (r GetEmbeddingModelRequest) Execute() (*GetEmbeddingsModelResp, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -852,7 +1094,7 @@ func (r GetEmbeddingModelRequest) Execute() (*GetEmbeddingsModelResp, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -895,14 +1137,51 @@ func (a *APIClient) GetEmbeddingModelExecute(ctx context.Context, regionId strin
 }
 
 type GetTokenRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	regionId   string
-	projectId  string
-	tId        string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	regionId    string
+	projectId   string
+	tId         string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetTokenRequest) SetQueryParam(key, value string) ApiGetTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetTokenRequest) AddQueryParam(key, value string) ApiGetTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetTokenRequest) Clone() ApiGetTokenRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetTokenRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetTokenRequest) Execute() (*GetTokenResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetToken", oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "tId", Value: ParameterValueToString(r.tId, "tId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetTokenRequest) execute() (*GetTokenResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -927,6 +1206,15 @@ func (r GetTokenRequest) Execute() (*GetTokenResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.tId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "tId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -945,7 +1233,8 @@ func (r GetTokenRequest) Execute() (*GetTokenResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetToken"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -976,10 +1265,14 @@ func (r GetTokenRequest) Execute() (*GetTokenResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -990,7 +1283,7 @@ func (r GetTokenRequest) Execute() (*GetTokenResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1001,7 +1294,7 @@ func (r GetTokenRequest) Execute() (*GetTokenResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1012,7 +1305,7 @@ func (r GetTokenRequest) Execute() (*GetTokenResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1023,7 +1316,7 @@ func (r GetTokenRequest) Execute() (*GetTokenResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1069,12 +1362,49 @@ func (a *APIClient) GetTokenExecute(ctx context.Context, regionId string, projec
 }
 
 type ListModelsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	regionId   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	regionId    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListModelsRequest) SetQueryParam(key, value string) ApiListModelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListModelsRequest) AddQueryParam(key, value string) ApiListModelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListModelsRequest) Clone() ApiListModelsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListModelsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListModelsRequest) Execute() (*ListModelsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListModels", oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListModelsRequest) execute() (*ListModelsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1097,6 +1427,9 @@ func (r ListModelsRequest) Execute() (*ListModelsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1115,7 +1448,8 @@ func (r ListModelsRequest) Execute() (*ListModelsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListModels"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1134,6 +1468,10 @@ func (r ListModelsRequest) Execute() (*ListModelsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1146,10 +1484,14 @@ func (r ListModelsRequest) Execute() (*ListModelsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1160,7 +1502,7 @@ func (r ListModelsRequest) Execute() (*ListModelsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1171,7 +1513,7 @@ func (r ListModelsRequest) Execute() (*ListModelsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1182,7 +1524,7 @@ func (r ListModelsRequest) Execute() (*ListModelsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1222,13 +1564,50 @@ func (a *APIClient) ListModelsExecute(ctx context.Context, regionId string) (*Li
 }
 
 type ListTokensRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	regionId   string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	regionId    string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListTokensRequest) SetQueryParam(key, value string) ApiListTokensRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListTokensRequest) AddQueryParam(key, value string) ApiListTokensRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListTokensRequest) Clone() ApiListTokensRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListTokensRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListTokensRequest) Execute() (*ListTokenResp, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListTokens", oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListTokensRequest) execute() (*ListTokenResp, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1252,6 +1631,12 @@ func (r ListTokensRequest) Execute() (*ListTokenResp, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1270,7 +1655,8 @@ func (r ListTokensRequest) Execute() (*ListTokenResp, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListTokens"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1289,6 +1675,10 @@ func (r ListTokensRequest) Execute() (*ListTokenResp, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1301,10 +1691,14 @@ func (r ListTokensRequest) Execute() (*ListTokenResp, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1315,7 +1709,7 @@ func (r ListTokensRequest) Execute() (*ListTokenResp, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1326,7 +1720,7 @@ func (r ListTokensRequest) Execute() (*ListTokenResp, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1337,7 +1731,7 @@ func (r ListTokensRequest) Execute() (*ListTokenResp, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1386,6 +1780,7 @@ type PartialUpdateTokenRequest struct {
 	projectId                 string
 	tId                       string
 	partialUpdateTokenPayload *PartialUpdateTokenPayload
+	queryParams               []queryParam
 }
 
 func (r PartialUpdateTokenRequest) PartialUpdateTokenPayload(partialUpdateTokenPayload PartialUpdateTokenPayload) ApiPartialUpdateTokenRequest {
@@ -1393,7 +1788,43 @@ func (r PartialUpdateTokenRequest) PartialUpdateTokenPayload(partialUpdateTokenP
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateTokenRequest) SetQueryParam(key, value string) ApiPartialUpdateTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateTokenRequest) AddQueryParam(key, value string) ApiPartialUpdateTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateTokenRequest) Clone() ApiPartialUpdateTokenRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateTokenRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateTokenRequest) Execute() (*UpdateTokenResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateToken", oapierror.Parameter{Name: "regionId", Value: ParameterValueToString(r.regionId, "regionId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "tId", Value: ParameterValueToString(r.tId, "tId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateTokenRequest) execute() (*UpdateTokenResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -1418,6 +1849,15 @@ func (r PartialUpdateTokenRequest) Execute() (*UpdateTokenResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.regionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "regionId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.tId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "tId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -1438,7 +1878,8 @@ func (r PartialUpdateTokenRequest) Execute() (*UpdateTokenResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.partialUpdateTokenPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateToken"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1469,10 +1910,14 @@ func (r PartialUpdateTokenRequest) Execute() (*UpdateTokenResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1483,7 +1928,7 @@ func (r PartialUpdateTokenRequest) Execute() (*UpdateTokenResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1494,7 +1939,7 @@ func (r PartialUpdateTokenRequest) Execute() (*UpdateTokenResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1505,7 +1950,7 @@ func (r PartialUpdateTokenRequest) Execute() (*UpdateTokenResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v ErrorMessageResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1516,7 +1961,7 @@ func (r PartialUpdateTokenRequest) Execute() (*UpdateTokenResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/modelserving",
+		ServiceName:   "modelserving",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,20 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateToken",
+	"DeleteToken",
+	"GetChatModel",
+	"GetEmbeddingModel",
+	"GetToken",
+	"ListModels",
+	"ListTokens",
+	"PartialUpdateToken",
+}
+
+func init() {
+	config.RegisterOperations("modelserving", operations...)
+}
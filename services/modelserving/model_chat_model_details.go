@@ -61,14 +61,9 @@ func (v *ChatModelDetailsBits) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ChatModelDetailsBits(value)
-	for _, existing := range AllowedChatModelDetailsBitsEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ChatModelDetails", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewChatModelDetailsBitsFromValue returns a pointer to a valid ChatModelDetailsBits
@@ -92,6 +87,17 @@ func (v ChatModelDetailsBits) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ChatModelDetailsBits) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ChatModelDetailsBits) String() string {
+	return fmt.Sprint(int(v))
+}
+
 // Ptr returns reference to BitsBits value
 func (v ChatModelDetailsBits) Ptr() *ChatModelDetailsBits {
 	return &v
@@ -187,14 +193,9 @@ func (v *ChatModelDetailsCategory) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ChatModelDetailsCategory(value)
-	for _, existing := range AllowedChatModelDetailsCategoryEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ChatModelDetails", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewChatModelDetailsCategoryFromValue returns a pointer to a valid ChatModelDetailsCategory
@@ -218,6 +219,17 @@ func (v ChatModelDetailsCategory) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ChatModelDetailsCategory) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ChatModelDetailsCategory) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to CategoryCategory value
 func (v ChatModelDetailsCategory) Ptr() *ChatModelDetailsCategory {
 	return &v
@@ -415,14 +427,9 @@ func (v *ChatModelDetailsQuantizationMethod) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ChatModelDetailsQuantizationMethod(value)
-	for _, existing := range AllowedChatModelDetailsQuantizationMethodEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ChatModelDetails", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewChatModelDetailsQuantizationMethodFromValue returns a pointer to a valid ChatModelDetailsQuantizationMethod
@@ -446,6 +453,17 @@ func (v ChatModelDetailsQuantizationMethod) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ChatModelDetailsQuantizationMethod) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ChatModelDetailsQuantizationMethod) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to QuantizationMethodQuantizationMethod value
 func (v ChatModelDetailsQuantizationMethod) Ptr() *ChatModelDetailsQuantizationMethod {
 	return &v
@@ -60,6 +60,13 @@ func TestChatModelDetailsBits_UnmarshalJSON(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "success - unknown value is preserved",
+			args: args{
+				src: []byte("12345"),
+			},
+			wantErr: false,
+		},
 		{
 			name: "fail",
 			args: args{
@@ -111,11 +118,11 @@ func TestChatModelDetailsCategory_UnmarshalJSON(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -154,11 +161,11 @@ func TestChatModelDetailsQuantizationMethod_UnmarshalJSON(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -57,14 +57,9 @@ func (v *EmbeddingModelDetailsCategory) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := EmbeddingModelDetailsCategory(value)
-	for _, existing := range AllowedEmbeddingModelDetailsCategoryEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid EmbeddingModelDetails", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewEmbeddingModelDetailsCategoryFromValue returns a pointer to a valid EmbeddingModelDetailsCategory
@@ -88,6 +83,17 @@ func (v EmbeddingModelDetailsCategory) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v EmbeddingModelDetailsCategory) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v EmbeddingModelDetailsCategory) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to CategoryCategory value
 func (v EmbeddingModelDetailsCategory) Ptr() *EmbeddingModelDetailsCategory {
 	return &v
@@ -57,14 +57,9 @@ func (v *ModelCategory) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ModelCategory(value)
-	for _, existing := range AllowedModelCategoryEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Model", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewModelCategoryFromValue returns a pointer to a valid ModelCategory
@@ -88,6 +83,17 @@ func (v ModelCategory) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ModelCategory) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ModelCategory) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to CategoryCategory value
 func (v ModelCategory) Ptr() *ModelCategory {
 	return &v
@@ -330,14 +336,9 @@ func (v *ModelTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ModelTypes(value)
-	for _, existing := range AllowedModelTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Model", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewModelTypesFromValue returns a pointer to a valid ModelTypes
@@ -361,6 +362,17 @@ func (v ModelTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ModelTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ModelTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v ModelTypes) Ptr() *ModelTypes {
 	return &v
@@ -144,14 +144,9 @@ func (v *TokenState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := TokenState(value)
-	for _, existing := range AllowedTokenStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Token", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewTokenStateFromValue returns a pointer to a valid TokenState
@@ -175,6 +170,17 @@ func (v TokenState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v TokenState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v TokenState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v TokenState) Ptr() *TokenState {
 	return &v
@@ -163,14 +163,9 @@ func (v *TokenCreatedState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := TokenCreatedState(value)
-	for _, existing := range AllowedTokenCreatedStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid TokenCreated", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewTokenCreatedStateFromValue returns a pointer to a valid TokenCreatedState
@@ -194,6 +189,17 @@ func (v TokenCreatedState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v TokenCreatedState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v TokenCreatedState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v TokenCreatedState) Ptr() *TokenCreatedState {
 	return &v
@@ -0,0 +1,78 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/modelserving"
+)
+
+// CreateModelServingOperation returns the async action of CreateModelServingWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with CreateModelServingPollFunc. The name of the operation
+// is "modelserving/CreateModelServing/{region}/{projectId}/{tokenId}".
+func CreateModelServingOperation(a APIClientInterface, region, projectId, tokenId string) *lro.Operation[modelserving.GetTokenResponse] {
+	return createModelServingOperationHandler(a).Operation(region, projectId, tokenId)
+}
+
+// CreateModelServingPollFunc returns the lro.PollFunc of the operations returned by CreateModelServingOperation, e.g.
+// to resume one with lro.Resume
+func CreateModelServingPollFunc(a APIClientInterface) lro.PollFunc[modelserving.GetTokenResponse] {
+	return createModelServingOperationHandler(a).PollFunc()
+}
+
+func createModelServingOperationHandler(a APIClientInterface) lro.WaitHandler[modelserving.GetTokenResponse] {
+	return lro.WaitHandler[modelserving.GetTokenResponse]{
+		Kind: "modelserving/CreateModelServing",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[modelserving.GetTokenResponse] {
+			return CreateModelServingWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// UpdateModelServingOperation returns the async action of UpdateModelServingWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with UpdateModelServingPollFunc. The name of the operation
+// is "modelserving/UpdateModelServing/{region}/{projectId}/{tokenId}".
+func UpdateModelServingOperation(a APIClientInterface, region, projectId, tokenId string) *lro.Operation[modelserving.GetTokenResponse] {
+	return updateModelServingOperationHandler(a).Operation(region, projectId, tokenId)
+}
+
+// UpdateModelServingPollFunc returns the lro.PollFunc of the operations returned by UpdateModelServingOperation, e.g.
+// to resume one with lro.Resume
+func UpdateModelServingPollFunc(a APIClientInterface) lro.PollFunc[modelserving.GetTokenResponse] {
+	return updateModelServingOperationHandler(a).PollFunc()
+}
+
+func updateModelServingOperationHandler(a APIClientInterface) lro.WaitHandler[modelserving.GetTokenResponse] {
+	return lro.WaitHandler[modelserving.GetTokenResponse]{
+		Kind: "modelserving/UpdateModelServing",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[modelserving.GetTokenResponse] {
+			return UpdateModelServingWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteModelServingOperation returns the async action of DeleteModelServingWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with DeleteModelServingPollFunc. The name of the operation
+// is "modelserving/DeleteModelServing/{region}/{projectId}/{tokenId}".
+func DeleteModelServingOperation(a APIClientInterface, region, projectId, tokenId string) *lro.Operation[modelserving.GetTokenResponse] {
+	return deleteModelServingOperationHandler(a).Operation(region, projectId, tokenId)
+}
+
+// DeleteModelServingPollFunc returns the lro.PollFunc of the operations returned by DeleteModelServingOperation, e.g.
+// to resume one with lro.Resume
+func DeleteModelServingPollFunc(a APIClientInterface) lro.PollFunc[modelserving.GetTokenResponse] {
+	return deleteModelServingOperationHandler(a).PollFunc()
+}
+
+func deleteModelServingOperationHandler(a APIClientInterface) lro.WaitHandler[modelserving.GetTokenResponse] {
+	return lro.WaitHandler[modelserving.GetTokenResponse]{
+		Kind: "modelserving/DeleteModelServing",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[modelserving.GetTokenResponse] {
+			return DeleteModelServingWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CloneInstance Clone instance
@@ -556,59 +559,112 @@ type DefaultApi interface {
 	UpdateUserExecute(ctx context.Context, projectId string, instanceId string, userId string, region string) error
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCloneInstanceRequest interface {
 	// payload
 	CloneInstancePayload(cloneInstancePayload CloneInstancePayload) ApiCloneInstanceRequest
+	SetQueryParam(key, value string) ApiCloneInstanceRequest
+	AddQueryParam(key, value string) ApiCloneInstanceRequest
+	Clone() ApiCloneInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*CloneInstanceResponse, error)
 }
 
 type ApiCreateInstanceRequest interface {
 	// payload
 	CreateInstancePayload(createInstancePayload CreateInstancePayload) ApiCreateInstanceRequest
+	RetryOnConflict(maxAttempts int) ApiCreateInstanceRequest
+	SetQueryParam(key, value string) ApiCreateInstanceRequest
+	AddQueryParam(key, value string) ApiCreateInstanceRequest
+	Clone() ApiCreateInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateInstanceResponse, error)
 }
 
 type ApiCreateUserRequest interface {
 	// payload
 	CreateUserPayload(createUserPayload CreateUserPayload) ApiCreateUserRequest
+	RetryOnConflict(maxAttempts int) ApiCreateUserRequest
+	SetQueryParam(key, value string) ApiCreateUserRequest
+	AddQueryParam(key, value string) ApiCreateUserRequest
+	Clone() ApiCreateUserRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateUserResponse, error)
 }
 
 type ApiDeleteInstanceRequest interface {
+	SetQueryParam(key, value string) ApiDeleteInstanceRequest
+	AddQueryParam(key, value string) ApiDeleteInstanceRequest
+	Clone() ApiDeleteInstanceRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteUserRequest interface {
+	SetQueryParam(key, value string) ApiDeleteUserRequest
+	AddQueryParam(key, value string) ApiDeleteUserRequest
+	Clone() ApiDeleteUserRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiGetBackupRequest interface {
+	SetQueryParam(key, value string) ApiGetBackupRequest
+	AddQueryParam(key, value string) ApiGetBackupRequest
+	Clone() ApiGetBackupRequest
+	Fingerprint() (string, error)
 	Execute() (*GetBackupResponse, error)
 }
 
 type ApiGetInstanceRequest interface {
+	SetQueryParam(key, value string) ApiGetInstanceRequest
+	AddQueryParam(key, value string) ApiGetInstanceRequest
+	Clone() ApiGetInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*InstanceResponse, error)
 }
 
 type ApiGetUserRequest interface {
+	SetQueryParam(key, value string) ApiGetUserRequest
+	AddQueryParam(key, value string) ApiGetUserRequest
+	Clone() ApiGetUserRequest
+	Fingerprint() (string, error)
 	Execute() (*GetUserResponse, error)
 }
 
 type ApiListAdvisorSlowQueriesRequest interface {
+	SetQueryParam(key, value string) ApiListAdvisorSlowQueriesRequest
+	AddQueryParam(key, value string) ApiListAdvisorSlowQueriesRequest
+	Clone() ApiListAdvisorSlowQueriesRequest
+	Fingerprint() (string, error)
 	Execute() (*HandlersInstancesSlowQueriesResponse, error)
 }
 
 type ApiListBackupsRequest interface {
+	SetQueryParam(key, value string) ApiListBackupsRequest
+	AddQueryParam(key, value string) ApiListBackupsRequest
+	Clone() ApiListBackupsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListBackupsResponse, error)
 }
 
 type ApiListFlavorsRequest interface {
+	SetQueryParam(key, value string) ApiListFlavorsRequest
+	AddQueryParam(key, value string) ApiListFlavorsRequest
+	Clone() ApiListFlavorsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListFlavorsResponse, error)
 }
 
 type ApiListInstancesRequest interface {
 	// instance tag
 	Tag(tag string) ApiListInstancesRequest
+	SetQueryParam(key, value string) ApiListInstancesRequest
+	AddQueryParam(key, value string) ApiListInstancesRequest
+	Clone() ApiListInstancesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListInstancesResponse, error)
 }
 
@@ -621,66 +677,118 @@ type ApiListMetricsRequest interface {
 	Start(start string) ApiListMetricsRequest
 	// period end
 	End(end string) ApiListMetricsRequest
+	SetQueryParam(key, value string) ApiListMetricsRequest
+	AddQueryParam(key, value string) ApiListMetricsRequest
+	Clone() ApiListMetricsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListMetricsResponse, error)
 }
 
 type ApiListRestoreJobsRequest interface {
+	SetQueryParam(key, value string) ApiListRestoreJobsRequest
+	AddQueryParam(key, value string) ApiListRestoreJobsRequest
+	Clone() ApiListRestoreJobsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListRestoreJobsResponse, error)
 }
 
 type ApiListStoragesRequest interface {
+	SetQueryParam(key, value string) ApiListStoragesRequest
+	AddQueryParam(key, value string) ApiListStoragesRequest
+	Clone() ApiListStoragesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListStoragesResponse, error)
 }
 
 type ApiListSuggestedIndexesRequest interface {
+	SetQueryParam(key, value string) ApiListSuggestedIndexesRequest
+	AddQueryParam(key, value string) ApiListSuggestedIndexesRequest
+	Clone() ApiListSuggestedIndexesRequest
+	Fingerprint() (string, error)
 	Execute() (*HandlersInstancesSuggestedIndexesResponse, error)
 }
 
 type ApiListUsersRequest interface {
+	SetQueryParam(key, value string) ApiListUsersRequest
+	AddQueryParam(key, value string) ApiListUsersRequest
+	Clone() ApiListUsersRequest
+	Fingerprint() (string, error)
 	Execute() (*ListUsersResponse, error)
 }
 
 type ApiListVersionsRequest interface {
+	SetQueryParam(key, value string) ApiListVersionsRequest
+	AddQueryParam(key, value string) ApiListVersionsRequest
+	Clone() ApiListVersionsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListVersionsResponse, error)
 }
 
 type ApiPartialUpdateInstanceRequest interface {
 	// payload
 	PartialUpdateInstancePayload(partialUpdateInstancePayload PartialUpdateInstancePayload) ApiPartialUpdateInstanceRequest
+	SetQueryParam(key, value string) ApiPartialUpdateInstanceRequest
+	AddQueryParam(key, value string) ApiPartialUpdateInstanceRequest
+	Clone() ApiPartialUpdateInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*UpdateInstanceResponse, error)
 }
 
 type ApiPartialUpdateUserRequest interface {
 	// payload
 	PartialUpdateUserPayload(partialUpdateUserPayload PartialUpdateUserPayload) ApiPartialUpdateUserRequest
+	SetQueryParam(key, value string) ApiPartialUpdateUserRequest
+	AddQueryParam(key, value string) ApiPartialUpdateUserRequest
+	Clone() ApiPartialUpdateUserRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiResetUserRequest interface {
+	SetQueryParam(key, value string) ApiResetUserRequest
+	AddQueryParam(key, value string) ApiResetUserRequest
+	Clone() ApiResetUserRequest
+	Fingerprint() (string, error)
 	Execute() (*User, error)
 }
 
 type ApiRestoreInstanceRequest interface {
 	// payload
 	RestoreInstancePayload(restoreInstancePayload RestoreInstancePayload) ApiRestoreInstanceRequest
+	SetQueryParam(key, value string) ApiRestoreInstanceRequest
+	AddQueryParam(key, value string) ApiRestoreInstanceRequest
+	Clone() ApiRestoreInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*RestoreInstanceResponse, error)
 }
 
 type ApiUpdateBackupScheduleRequest interface {
 	// payload
 	UpdateBackupSchedulePayload(updateBackupSchedulePayload UpdateBackupSchedulePayload) ApiUpdateBackupScheduleRequest
+	SetQueryParam(key, value string) ApiUpdateBackupScheduleRequest
+	AddQueryParam(key, value string) ApiUpdateBackupScheduleRequest
+	Clone() ApiUpdateBackupScheduleRequest
+	Fingerprint() (string, error)
 	Execute() (*BackupSchedule, error)
 }
 
 type ApiUpdateInstanceRequest interface {
 	// payload
 	UpdateInstancePayload(updateInstancePayload UpdateInstancePayload) ApiUpdateInstanceRequest
+	SetQueryParam(key, value string) ApiUpdateInstanceRequest
+	AddQueryParam(key, value string) ApiUpdateInstanceRequest
+	Clone() ApiUpdateInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*UpdateInstanceResponse, error)
 }
 
 type ApiUpdateUserRequest interface {
 	// payload
 	UpdateUserPayload(updateUserPayload UpdateUserPayload) ApiUpdateUserRequest
+	SetQueryParam(key, value string) ApiUpdateUserRequest
+	AddQueryParam(key, value string) ApiUpdateUserRequest
+	Clone() ApiUpdateUserRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
@@ -694,6 +802,7 @@ type CloneInstanceRequest struct {
 	instanceId           string
 	region               string
 	cloneInstancePayload *CloneInstancePayload
+	queryParams          []queryParam
 }
 
 // payload
@@ -703,7 +812,43 @@ func (r CloneInstanceRequest) CloneInstancePayload(cloneInstancePayload CloneIns
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CloneInstanceRequest) SetQueryParam(key, value string) ApiCloneInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CloneInstanceRequest) AddQueryParam(key, value string) ApiCloneInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CloneInstanceRequest) Clone() ApiCloneInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CloneInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CloneInstanceRequest) Execute() (*CloneInstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CloneInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CloneInstanceRequest) execute() (*CloneInstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -715,7 +860,7 @@ func (r CloneInstanceRequest) Execute() (*CloneInstanceResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.CloneInstance")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.CloneInstance", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -728,8 +873,17 @@ func (r CloneInstanceRequest) Execute() (*CloneInstanceResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.cloneInstancePayload == nil {
-		return localVarReturnValue, fmt.Errorf("cloneInstancePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "cloneInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -751,7 +905,8 @@ func (r CloneInstanceRequest) Execute() (*CloneInstanceResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.cloneInstancePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "CloneInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -782,10 +937,14 @@ func (r CloneInstanceRequest) Execute() (*CloneInstanceResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -796,7 +955,7 @@ func (r CloneInstanceRequest) Execute() (*CloneInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -807,7 +966,7 @@ func (r CloneInstanceRequest) Execute() (*CloneInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -818,7 +977,7 @@ func (r CloneInstanceRequest) Execute() (*CloneInstanceResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -870,6 +1029,8 @@ type CreateInstanceRequest struct {
 	projectId             string
 	region                string
 	createInstancePayload *CreateInstancePayload
+	retryOnConflict       int
+	queryParams           []queryParam
 }
 
 // payload
@@ -879,7 +1040,50 @@ func (r CreateInstanceRequest) CreateInstancePayload(createInstancePayload Creat
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateInstanceRequest) RetryOnConflict(maxAttempts int) ApiCreateInstanceRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateInstanceRequest) SetQueryParam(key, value string) ApiCreateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateInstanceRequest) AddQueryParam(key, value string) ApiCreateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateInstanceRequest) Clone() ApiCreateInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateInstanceRequest) execute() (*CreateInstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -891,7 +1095,7 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.CreateInstance")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.CreateInstance", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -903,8 +1107,14 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.createInstancePayload == nil {
-		return localVarReturnValue, fmt.Errorf("createInstancePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -926,7 +1136,8 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createInstancePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -957,10 +1168,14 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -971,7 +1186,7 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -982,7 +1197,7 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -993,7 +1208,7 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1004,7 +1219,7 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1053,6 +1268,8 @@ type CreateUserRequest struct {
 	instanceId        string
 	region            string
 	createUserPayload *CreateUserPayload
+	retryOnConflict   int
+	queryParams       []queryParam
 }
 
 // payload
@@ -1062,7 +1279,50 @@ func (r CreateUserRequest) CreateUserPayload(createUserPayload CreateUserPayload
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateUserRequest) RetryOnConflict(maxAttempts int) ApiCreateUserRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateUserRequest) SetQueryParam(key, value string) ApiCreateUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateUserRequest) AddQueryParam(key, value string) ApiCreateUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateUserRequest) Clone() ApiCreateUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateUserRequest) execute() (*CreateUserResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1074,7 +1334,7 @@ func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.CreateUser")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.CreateUser", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1087,8 +1347,17 @@ func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.createUserPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createUserPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createUserPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1110,7 +1379,8 @@ func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createUserPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1141,10 +1411,14 @@ func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1155,7 +1429,7 @@ func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1166,7 +1440,7 @@ func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1177,7 +1451,7 @@ func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1188,7 +1462,7 @@ func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1199,7 +1473,7 @@ func (r CreateUserRequest) Execute() (*CreateUserResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1245,14 +1519,51 @@ func (a *APIClient) CreateUserExecute(ctx context.Context, projectId string, ins
 }
 
 type DeleteInstanceRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteInstanceRequest) SetQueryParam(key, value string) ApiDeleteInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteInstanceRequest) AddQueryParam(key, value string) ApiDeleteInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteInstanceRequest) Clone() ApiDeleteInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteInstanceRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return err
+}
+
+func (r DeleteInstanceRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1263,7 +1574,7 @@ func (r DeleteInstanceRequest) Execute() error {
 	if !ok {
 		return fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteInstance")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteInstance", r.region)
 	if err != nil {
 		return &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1276,6 +1587,15 @@ func (r DeleteInstanceRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1294,7 +1614,8 @@ func (r DeleteInstanceRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1325,10 +1646,14 @@ func (r DeleteInstanceRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1339,7 +1664,7 @@ func (r DeleteInstanceRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1350,7 +1675,7 @@ func (r DeleteInstanceRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1361,7 +1686,7 @@ func (r DeleteInstanceRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1408,15 +1733,52 @@ func (a *APIClient) DeleteInstanceExecute(ctx context.Context, projectId string,
 }
 
 type DeleteUserRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	userId     string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	userId      string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteUserRequest) SetQueryParam(key, value string) ApiDeleteUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteUserRequest) AddQueryParam(key, value string) ApiDeleteUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteUserRequest) Clone() ApiDeleteUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteUserRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "userId", Value: ParameterValueToString(r.userId, "userId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return err
+}
+
+func (r DeleteUserRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1427,7 +1789,7 @@ func (r DeleteUserRequest) Execute() error {
 	if !ok {
 		return fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.DeleteUser")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.DeleteUser", r.region)
 	if err != nil {
 		return &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1441,6 +1803,18 @@ func (r DeleteUserRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.userId == "" {
+		return &oapierror.ValidationError{Field: "userId"}
+	}
+	if r.region == "" {
+		return &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1459,7 +1833,8 @@ func (r DeleteUserRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1490,10 +1865,14 @@ func (r DeleteUserRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1504,7 +1883,7 @@ func (r DeleteUserRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1515,7 +1894,7 @@ func (r DeleteUserRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1526,7 +1905,7 @@ func (r DeleteUserRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1576,15 +1955,52 @@ func (a *APIClient) DeleteUserExecute(ctx context.Context, projectId string, ins
 }
 
 type GetBackupRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	backupId   string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	backupId    string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetBackupRequest) SetQueryParam(key, value string) ApiGetBackupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetBackupRequest) AddQueryParam(key, value string) ApiGetBackupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetBackupRequest) Clone() ApiGetBackupRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetBackupRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetBackupRequest) Execute() (*GetBackupResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetBackup", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "backupId", Value: ParameterValueToString(r.backupId, "backupId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetBackupRequest) execute() (*GetBackupResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1596,7 +2012,7 @@ func (r GetBackupRequest) Execute() (*GetBackupResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetBackup")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetBackup", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1610,6 +2026,18 @@ func (r GetBackupRequest) Execute() (*GetBackupResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.backupId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "backupId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1628,7 +2056,8 @@ func (r GetBackupRequest) Execute() (*GetBackupResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetBackup"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1659,10 +2088,14 @@ func (r GetBackupRequest) Execute() (*GetBackupResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1673,7 +2106,7 @@ func (r GetBackupRequest) Execute() (*GetBackupResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1684,7 +2117,7 @@ func (r GetBackupRequest) Execute() (*GetBackupResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1695,7 +2128,7 @@ func (r GetBackupRequest) Execute() (*GetBackupResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1744,14 +2177,51 @@ func (a *APIClient) GetBackupExecute(ctx context.Context, projectId string, inst
 }
 
 type GetInstanceRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetInstanceRequest) SetQueryParam(key, value string) ApiGetInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetInstanceRequest) AddQueryParam(key, value string) ApiGetInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetInstanceRequest) Clone() ApiGetInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetInstanceRequest) Execute() (*InstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetInstanceRequest) execute() (*InstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1763,7 +2233,7 @@ func (r GetInstanceRequest) Execute() (*InstanceResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetInstance")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetInstance", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1776,6 +2246,15 @@ func (r GetInstanceRequest) Execute() (*InstanceResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1794,7 +2273,8 @@ func (r GetInstanceRequest) Execute() (*InstanceResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1825,10 +2305,14 @@ func (r GetInstanceRequest) Execute() (*InstanceResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1839,7 +2323,7 @@ func (r GetInstanceRequest) Execute() (*InstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1850,7 +2334,7 @@ func (r GetInstanceRequest) Execute() (*InstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1861,7 +2345,7 @@ func (r GetInstanceRequest) Execute() (*InstanceResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1907,15 +2391,52 @@ func (a *APIClient) GetInstanceExecute(ctx context.Context, projectId string, in
 }
 
 type GetUserRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	userId     string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	userId      string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetUserRequest) SetQueryParam(key, value string) ApiGetUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetUserRequest) AddQueryParam(key, value string) ApiGetUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetUserRequest) Clone() ApiGetUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetUserRequest) Execute() (*GetUserResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "userId", Value: ParameterValueToString(r.userId, "userId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetUserRequest) execute() (*GetUserResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1927,7 +2448,7 @@ func (r GetUserRequest) Execute() (*GetUserResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.GetUser")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.GetUser", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -1941,6 +2462,18 @@ func (r GetUserRequest) Execute() (*GetUserResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.userId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "userId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1959,7 +2492,8 @@ func (r GetUserRequest) Execute() (*GetUserResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1990,10 +2524,14 @@ func (r GetUserRequest) Execute() (*GetUserResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2004,7 +2542,7 @@ func (r GetUserRequest) Execute() (*GetUserResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2015,7 +2553,7 @@ func (r GetUserRequest) Execute() (*GetUserResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2026,7 +2564,7 @@ func (r GetUserRequest) Execute() (*GetUserResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2037,7 +2575,7 @@ func (r GetUserRequest) Execute() (*GetUserResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2086,14 +2624,51 @@ func (a *APIClient) GetUserExecute(ctx context.Context, projectId string, instan
 }
 
 type ListAdvisorSlowQueriesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListAdvisorSlowQueriesRequest) SetQueryParam(key, value string) ApiListAdvisorSlowQueriesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListAdvisorSlowQueriesRequest) AddQueryParam(key, value string) ApiListAdvisorSlowQueriesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListAdvisorSlowQueriesRequest) Clone() ApiListAdvisorSlowQueriesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListAdvisorSlowQueriesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListAdvisorSlowQueries", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListAdvisorSlowQueriesRequest) execute() (*HandlersInstancesSlowQueriesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2105,7 +2680,7 @@ func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesR
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListAdvisorSlowQueries")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListAdvisorSlowQueries", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2118,6 +2693,15 @@ func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesR
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2136,7 +2720,8 @@ func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesR
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListAdvisorSlowQueries"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2155,6 +2740,10 @@ func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesR
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2167,10 +2756,14 @@ func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesR
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2181,7 +2774,7 @@ func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesR
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2192,7 +2785,7 @@ func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesR
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2203,7 +2796,7 @@ func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesR
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2214,7 +2807,7 @@ func (r ListAdvisorSlowQueriesRequest) Execute() (*HandlersInstancesSlowQueriesR
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2260,14 +2853,51 @@ func (a *APIClient) ListAdvisorSlowQueriesExecute(ctx context.Context, projectId
 }
 
 type ListBackupsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListBackupsRequest) SetQueryParam(key, value string) ApiListBackupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListBackupsRequest) AddQueryParam(key, value string) ApiListBackupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListBackupsRequest) Clone() ApiListBackupsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListBackupsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListBackupsRequest) Execute() (*ListBackupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListBackups", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListBackupsRequest) execute() (*ListBackupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2279,7 +2909,7 @@ func (r ListBackupsRequest) Execute() (*ListBackupsResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListBackups")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListBackups", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2292,6 +2922,15 @@ func (r ListBackupsRequest) Execute() (*ListBackupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2310,7 +2949,8 @@ func (r ListBackupsRequest) Execute() (*ListBackupsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListBackups"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2329,6 +2969,10 @@ func (r ListBackupsRequest) Execute() (*ListBackupsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2341,10 +2985,14 @@ func (r ListBackupsRequest) Execute() (*ListBackupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2355,7 +3003,7 @@ func (r ListBackupsRequest) Execute() (*ListBackupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2366,7 +3014,7 @@ func (r ListBackupsRequest) Execute() (*ListBackupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2377,7 +3025,7 @@ func (r ListBackupsRequest) Execute() (*ListBackupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2423,13 +3071,50 @@ func (a *APIClient) ListBackupsExecute(ctx context.Context, projectId string, in
 }
 
 type ListFlavorsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListFlavorsRequest) SetQueryParam(key, value string) ApiListFlavorsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListFlavorsRequest) AddQueryParam(key, value string) ApiListFlavorsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListFlavorsRequest) Clone() ApiListFlavorsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListFlavorsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListFlavorsRequest) Execute() (*ListFlavorsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListFlavors", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListFlavorsRequest) execute() (*ListFlavorsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2441,7 +3126,7 @@ func (r ListFlavorsRequest) Execute() (*ListFlavorsResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListFlavors")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListFlavors", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2453,6 +3138,12 @@ func (r ListFlavorsRequest) Execute() (*ListFlavorsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2471,7 +3162,8 @@ func (r ListFlavorsRequest) Execute() (*ListFlavorsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListFlavors"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2490,6 +3182,10 @@ func (r ListFlavorsRequest) Execute() (*ListFlavorsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2502,10 +3198,14 @@ func (r ListFlavorsRequest) Execute() (*ListFlavorsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2516,7 +3216,7 @@ func (r ListFlavorsRequest) Execute() (*ListFlavorsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2527,7 +3227,7 @@ func (r ListFlavorsRequest) Execute() (*ListFlavorsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2538,7 +3238,7 @@ func (r ListFlavorsRequest) Execute() (*ListFlavorsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2581,11 +3281,12 @@ func (a *APIClient) ListFlavorsExecute(ctx context.Context, projectId string, re
 }
 
 type ListInstancesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	tag        *string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	tag         *string
+	region      string
+	queryParams []queryParam
 }
 
 // instance tag
@@ -2595,7 +3296,43 @@ func (r ListInstancesRequest) Tag(tag string) ApiListInstancesRequest {
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListInstancesRequest) SetQueryParam(key, value string) ApiListInstancesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListInstancesRequest) AddQueryParam(key, value string) ApiListInstancesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListInstancesRequest) Clone() ApiListInstancesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListInstancesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListInstances", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListInstancesRequest) execute() (*ListInstancesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2607,7 +3344,7 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListInstances")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListInstances", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2619,8 +3356,14 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.tag == nil {
-		return localVarReturnValue, fmt.Errorf("tag is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "tag"}
 	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "tag", r.tag, "")
@@ -2641,7 +3384,8 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListInstances"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2660,6 +3404,10 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2672,10 +3420,14 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2686,7 +3438,7 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2697,7 +3449,7 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2708,7 +3460,7 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2761,6 +3513,7 @@ type ListMetricsRequest struct {
 	period      *string
 	start       *string
 	end         *string
+	queryParams []queryParam
 }
 
 // granularity
@@ -2791,7 +3544,43 @@ func (r ListMetricsRequest) End(end string) ApiListMetricsRequest {
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListMetricsRequest) SetQueryParam(key, value string) ApiListMetricsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListMetricsRequest) AddQueryParam(key, value string) ApiListMetricsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListMetricsRequest) Clone() ApiListMetricsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListMetricsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListMetrics", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "metric", Value: ParameterValueToString(r.metric, "metric")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListMetricsRequest) execute() (*ListMetricsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2803,7 +3592,7 @@ func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListMetrics")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListMetrics", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -2817,8 +3606,20 @@ func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.metric == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "metric"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.granularity == nil {
-		return localVarReturnValue, fmt.Errorf("granularity is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "granularity"}
 	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "granularity", r.granularity, "")
@@ -2848,7 +3649,8 @@ func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListMetrics"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2867,6 +3669,10 @@ func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2879,10 +3685,14 @@ func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2893,7 +3703,7 @@ func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2904,7 +3714,7 @@ func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2915,7 +3725,7 @@ func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2926,7 +3736,7 @@ func (r ListMetricsRequest) Execute() (*ListMetricsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2975,14 +3785,51 @@ func (a *APIClient) ListMetricsExecute(ctx context.Context, projectId string, in
 }
 
 type ListRestoreJobsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListRestoreJobsRequest) SetQueryParam(key, value string) ApiListRestoreJobsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListRestoreJobsRequest) AddQueryParam(key, value string) ApiListRestoreJobsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListRestoreJobsRequest) Clone() ApiListRestoreJobsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListRestoreJobsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListRestoreJobsRequest) Execute() (*ListRestoreJobsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListRestoreJobs", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListRestoreJobsRequest) execute() (*ListRestoreJobsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2994,7 +3841,7 @@ func (r ListRestoreJobsRequest) Execute() (*ListRestoreJobsResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListRestoreJobs")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListRestoreJobs", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -3007,6 +3854,15 @@ func (r ListRestoreJobsRequest) Execute() (*ListRestoreJobsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3025,7 +3881,8 @@ func (r ListRestoreJobsRequest) Execute() (*ListRestoreJobsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListRestoreJobs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3044,6 +3901,10 @@ func (r ListRestoreJobsRequest) Execute() (*ListRestoreJobsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -3056,10 +3917,14 @@ func (r ListRestoreJobsRequest) Execute() (*ListRestoreJobsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3070,7 +3935,7 @@ func (r ListRestoreJobsRequest) Execute() (*ListRestoreJobsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3081,7 +3946,7 @@ func (r ListRestoreJobsRequest) Execute() (*ListRestoreJobsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3092,7 +3957,7 @@ func (r ListRestoreJobsRequest) Execute() (*ListRestoreJobsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3126,26 +3991,63 @@ func (a *APIClient) ListRestoreJobs(ctx context.Context, projectId string, insta
 	}
 }
 
-func (a *APIClient) ListRestoreJobsExecute(ctx context.Context, projectId string, instanceId string, region string) (*ListRestoreJobsResponse, error) {
-	r := ListRestoreJobsRequest{
-		apiService: a.defaultApi,
-		ctx:        ctx,
-		projectId:  projectId,
-		instanceId: instanceId,
-		region:     region,
-	}
-	return r.Execute()
+func (a *APIClient) ListRestoreJobsExecute(ctx context.Context, projectId string, instanceId string, region string) (*ListRestoreJobsResponse, error) {
+	r := ListRestoreJobsRequest{
+		apiService: a.defaultApi,
+		ctx:        ctx,
+		projectId:  projectId,
+		instanceId: instanceId,
+		region:     region,
+	}
+	return r.Execute()
+}
+
+type ListStoragesRequest struct {
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	flavor      string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListStoragesRequest) SetQueryParam(key, value string) ApiListStoragesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListStoragesRequest) AddQueryParam(key, value string) ApiListStoragesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
 }
 
-type ListStoragesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	flavor     string
-	region     string
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListStoragesRequest) Clone() ApiListStoragesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListStoragesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListStoragesRequest) Execute() (*ListStoragesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListStorages", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "flavor", Value: ParameterValueToString(r.flavor, "flavor")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListStoragesRequest) execute() (*ListStoragesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3157,7 +4059,7 @@ func (r ListStoragesRequest) Execute() (*ListStoragesResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListStorages")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListStorages", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -3170,6 +4072,15 @@ func (r ListStoragesRequest) Execute() (*ListStoragesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.flavor == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "flavor"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3188,7 +4099,8 @@ func (r ListStoragesRequest) Execute() (*ListStoragesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListStorages"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3207,6 +4119,10 @@ func (r ListStoragesRequest) Execute() (*ListStoragesResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -3219,10 +4135,14 @@ func (r ListStoragesRequest) Execute() (*ListStoragesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3233,7 +4153,7 @@ func (r ListStoragesRequest) Execute() (*ListStoragesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3244,7 +4164,7 @@ func (r ListStoragesRequest) Execute() (*ListStoragesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3255,7 +4175,7 @@ func (r ListStoragesRequest) Execute() (*ListStoragesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3301,14 +4221,51 @@ func (a *APIClient) ListStoragesExecute(ctx context.Context, projectId string, f
 }
 
 type ListSuggestedIndexesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListSuggestedIndexesRequest) SetQueryParam(key, value string) ApiListSuggestedIndexesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListSuggestedIndexesRequest) AddQueryParam(key, value string) ApiListSuggestedIndexesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListSuggestedIndexesRequest) Clone() ApiListSuggestedIndexesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListSuggestedIndexesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndexesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListSuggestedIndexes", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListSuggestedIndexesRequest) execute() (*HandlersInstancesSuggestedIndexesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3320,7 +4277,7 @@ func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndex
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListSuggestedIndexes")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListSuggestedIndexes", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -3333,6 +4290,15 @@ func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndex
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3351,7 +4317,8 @@ func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndex
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListSuggestedIndexes"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3370,6 +4337,10 @@ func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndex
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -3382,10 +4353,14 @@ func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndex
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3396,7 +4371,7 @@ func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndex
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3407,7 +4382,7 @@ func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndex
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3418,7 +4393,7 @@ func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndex
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3429,7 +4404,7 @@ func (r ListSuggestedIndexesRequest) Execute() (*HandlersInstancesSuggestedIndex
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3475,14 +4450,51 @@ func (a *APIClient) ListSuggestedIndexesExecute(ctx context.Context, projectId s
 }
 
 type ListUsersRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListUsersRequest) SetQueryParam(key, value string) ApiListUsersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListUsersRequest) AddQueryParam(key, value string) ApiListUsersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListUsersRequest) Clone() ApiListUsersRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListUsersRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListUsers", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListUsersRequest) execute() (*ListUsersResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3494,7 +4506,7 @@ func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListUsers")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListUsers", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -3507,6 +4519,15 @@ func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3525,7 +4546,8 @@ func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListUsers"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3544,6 +4566,10 @@ func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -3556,10 +4582,14 @@ func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3570,7 +4600,7 @@ func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3581,7 +4611,7 @@ func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3592,7 +4622,7 @@ func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3603,7 +4633,7 @@ func (r ListUsersRequest) Execute() (*ListUsersResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3649,13 +4679,50 @@ func (a *APIClient) ListUsersExecute(ctx context.Context, projectId string, inst
 }
 
 type ListVersionsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListVersionsRequest) SetQueryParam(key, value string) ApiListVersionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListVersionsRequest) AddQueryParam(key, value string) ApiListVersionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListVersionsRequest) Clone() ApiListVersionsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListVersionsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListVersionsRequest) Execute() (*ListVersionsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListVersions", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListVersionsRequest) execute() (*ListVersionsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -3667,7 +4734,7 @@ func (r ListVersionsRequest) Execute() (*ListVersionsResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ListVersions")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ListVersions", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -3679,6 +4746,12 @@ func (r ListVersionsRequest) Execute() (*ListVersionsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3697,7 +4770,8 @@ func (r ListVersionsRequest) Execute() (*ListVersionsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListVersions"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3716,6 +4790,10 @@ func (r ListVersionsRequest) Execute() (*ListVersionsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -3728,10 +4806,14 @@ func (r ListVersionsRequest) Execute() (*ListVersionsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3742,7 +4824,7 @@ func (r ListVersionsRequest) Execute() (*ListVersionsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3753,7 +4835,7 @@ func (r ListVersionsRequest) Execute() (*ListVersionsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3802,6 +4884,7 @@ type PartialUpdateInstanceRequest struct {
 	instanceId                   string
 	region                       string
 	partialUpdateInstancePayload *PartialUpdateInstancePayload
+	queryParams                  []queryParam
 }
 
 // payload
@@ -3811,7 +4894,43 @@ func (r PartialUpdateInstanceRequest) PartialUpdateInstancePayload(partialUpdate
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateInstanceRequest) SetQueryParam(key, value string) ApiPartialUpdateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateInstanceRequest) AddQueryParam(key, value string) ApiPartialUpdateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateInstanceRequest) Clone() ApiPartialUpdateInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateInstanceRequest) execute() (*UpdateInstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -3823,7 +4942,7 @@ func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error)
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.PartialUpdateInstance")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.PartialUpdateInstance", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -3836,8 +4955,17 @@ func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.partialUpdateInstancePayload == nil {
-		return localVarReturnValue, fmt.Errorf("partialUpdateInstancePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "partialUpdateInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -3859,7 +4987,8 @@ func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error)
 	}
 	// body params
 	localVarPostBody = r.partialUpdateInstancePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3890,10 +5019,14 @@ func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3904,7 +5037,7 @@ func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3915,7 +5048,7 @@ func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3926,7 +5059,7 @@ func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3937,7 +5070,7 @@ func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3948,7 +5081,7 @@ func (r PartialUpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4001,6 +5134,7 @@ type PartialUpdateUserRequest struct {
 	userId                   string
 	region                   string
 	partialUpdateUserPayload *PartialUpdateUserPayload
+	queryParams              []queryParam
 }
 
 // payload
@@ -4010,7 +5144,43 @@ func (r PartialUpdateUserRequest) PartialUpdateUserPayload(partialUpdateUserPayl
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateUserRequest) SetQueryParam(key, value string) ApiPartialUpdateUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateUserRequest) AddQueryParam(key, value string) ApiPartialUpdateUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateUserRequest) Clone() ApiPartialUpdateUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateUserRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "userId", Value: ParameterValueToString(r.userId, "userId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return err
+}
+
+func (r PartialUpdateUserRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPatch
 		localVarPostBody   interface{}
@@ -4021,7 +5191,7 @@ func (r PartialUpdateUserRequest) Execute() error {
 	if !ok {
 		return fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.PartialUpdateUser")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.PartialUpdateUser", r.region)
 	if err != nil {
 		return &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -4035,8 +5205,20 @@ func (r PartialUpdateUserRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.userId == "" {
+		return &oapierror.ValidationError{Field: "userId"}
+	}
+	if r.region == "" {
+		return &oapierror.ValidationError{Field: "region"}
+	}
 	if r.partialUpdateUserPayload == nil {
-		return fmt.Errorf("partialUpdateUserPayload is required and must be specified")
+		return &oapierror.ValidationError{Field: "partialUpdateUserPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -4058,7 +5240,8 @@ func (r PartialUpdateUserRequest) Execute() error {
 	}
 	// body params
 	localVarPostBody = r.partialUpdateUserPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -4089,10 +5272,14 @@ func (r PartialUpdateUserRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4103,7 +5290,7 @@ func (r PartialUpdateUserRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4114,7 +5301,7 @@ func (r PartialUpdateUserRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4125,7 +5312,7 @@ func (r PartialUpdateUserRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -4175,15 +5362,52 @@ func (a *APIClient) PartialUpdateUserExecute(ctx context.Context, projectId stri
 }
 
 type ResetUserRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	instanceId string
-	userId     string
-	region     string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	instanceId  string
+	userId      string
+	region      string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ResetUserRequest) SetQueryParam(key, value string) ApiResetUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ResetUserRequest) AddQueryParam(key, value string) ApiResetUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ResetUserRequest) Clone() ApiResetUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ResetUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ResetUserRequest) Execute() (*User, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ResetUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "userId", Value: ParameterValueToString(r.userId, "userId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ResetUserRequest) execute() (*User, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -4195,7 +5419,7 @@ func (r ResetUserRequest) Execute() (*User, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.ResetUser")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.ResetUser", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -4209,6 +5433,18 @@ func (r ResetUserRequest) Execute() (*User, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.userId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "userId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4227,7 +5463,8 @@ func (r ResetUserRequest) Execute() (*User, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ResetUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4258,10 +5495,14 @@ func (r ResetUserRequest) Execute() (*User, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4272,7 +5513,7 @@ func (r ResetUserRequest) Execute() (*User, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4283,7 +5524,7 @@ func (r ResetUserRequest) Execute() (*User, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4294,7 +5535,7 @@ func (r ResetUserRequest) Execute() (*User, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4305,7 +5546,7 @@ func (r ResetUserRequest) Execute() (*User, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4360,6 +5601,7 @@ type RestoreInstanceRequest struct {
 	instanceId             string
 	region                 string
 	restoreInstancePayload *RestoreInstancePayload
+	queryParams            []queryParam
 }
 
 // payload
@@ -4369,7 +5611,43 @@ func (r RestoreInstanceRequest) RestoreInstancePayload(restoreInstancePayload Re
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r RestoreInstanceRequest) SetQueryParam(key, value string) ApiRestoreInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r RestoreInstanceRequest) AddQueryParam(key, value string) ApiRestoreInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r RestoreInstanceRequest) Clone() ApiRestoreInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r RestoreInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r RestoreInstanceRequest) Execute() (*RestoreInstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "RestoreInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r RestoreInstanceRequest) execute() (*RestoreInstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -4381,7 +5659,7 @@ func (r RestoreInstanceRequest) Execute() (*RestoreInstanceResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.RestoreInstance")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.RestoreInstance", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -4394,8 +5672,17 @@ func (r RestoreInstanceRequest) Execute() (*RestoreInstanceResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.restoreInstancePayload == nil {
-		return localVarReturnValue, fmt.Errorf("restoreInstancePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "restoreInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -4417,7 +5704,8 @@ func (r RestoreInstanceRequest) Execute() (*RestoreInstanceResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.restoreInstancePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "RestoreInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4448,10 +5736,14 @@ func (r RestoreInstanceRequest) Execute() (*RestoreInstanceResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4462,7 +5754,7 @@ func (r RestoreInstanceRequest) Execute() (*RestoreInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4473,7 +5765,7 @@ func (r RestoreInstanceRequest) Execute() (*RestoreInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4484,7 +5776,7 @@ func (r RestoreInstanceRequest) Execute() (*RestoreInstanceResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4536,6 +5828,7 @@ type UpdateBackupScheduleRequest struct {
 	instanceId                  string
 	region                      string
 	updateBackupSchedulePayload *UpdateBackupSchedulePayload
+	queryParams                 []queryParam
 }
 
 // payload
@@ -4545,7 +5838,43 @@ func (r UpdateBackupScheduleRequest) UpdateBackupSchedulePayload(updateBackupSch
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateBackupScheduleRequest) SetQueryParam(key, value string) ApiUpdateBackupScheduleRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateBackupScheduleRequest) AddQueryParam(key, value string) ApiUpdateBackupScheduleRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateBackupScheduleRequest) Clone() ApiUpdateBackupScheduleRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateBackupScheduleRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateBackupScheduleRequest) Execute() (*BackupSchedule, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateBackupSchedule", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateBackupScheduleRequest) execute() (*BackupSchedule, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -4557,7 +5886,7 @@ func (r UpdateBackupScheduleRequest) Execute() (*BackupSchedule, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateBackupSchedule")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateBackupSchedule", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -4570,8 +5899,17 @@ func (r UpdateBackupScheduleRequest) Execute() (*BackupSchedule, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.updateBackupSchedulePayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateBackupSchedulePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateBackupSchedulePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -4593,7 +5931,8 @@ func (r UpdateBackupScheduleRequest) Execute() (*BackupSchedule, error) {
 	}
 	// body params
 	localVarPostBody = r.updateBackupSchedulePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateBackupSchedule"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4624,10 +5963,14 @@ func (r UpdateBackupScheduleRequest) Execute() (*BackupSchedule, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4638,7 +5981,7 @@ func (r UpdateBackupScheduleRequest) Execute() (*BackupSchedule, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4649,7 +5992,7 @@ func (r UpdateBackupScheduleRequest) Execute() (*BackupSchedule, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4660,7 +6003,7 @@ func (r UpdateBackupScheduleRequest) Execute() (*BackupSchedule, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4712,6 +6055,7 @@ type UpdateInstanceRequest struct {
 	instanceId            string
 	region                string
 	updateInstancePayload *UpdateInstancePayload
+	queryParams           []queryParam
 }
 
 // payload
@@ -4721,7 +6065,43 @@ func (r UpdateInstanceRequest) UpdateInstancePayload(updateInstancePayload Updat
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateInstanceRequest) SetQueryParam(key, value string) ApiUpdateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateInstanceRequest) AddQueryParam(key, value string) ApiUpdateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateInstanceRequest) Clone() ApiUpdateInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateInstanceRequest) execute() (*UpdateInstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -4733,7 +6113,7 @@ func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
 	if !ok {
 		return localVarReturnValue, fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateInstance")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateInstance", r.region)
 	if err != nil {
 		return localVarReturnValue, &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -4746,8 +6126,17 @@ func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.region == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "region"}
+	}
 	if r.updateInstancePayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateInstancePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -4769,7 +6158,8 @@ func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateInstancePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4800,10 +6190,14 @@ func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4814,7 +6208,7 @@ func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4825,7 +6219,7 @@ func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4836,7 +6230,7 @@ func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4847,7 +6241,7 @@ func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4858,7 +6252,7 @@ func (r UpdateInstanceRequest) Execute() (*UpdateInstanceResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4911,6 +6305,7 @@ type UpdateUserRequest struct {
 	userId            string
 	region            string
 	updateUserPayload *UpdateUserPayload
+	queryParams       []queryParam
 }
 
 // payload
@@ -4920,7 +6315,43 @@ func (r UpdateUserRequest) UpdateUserPayload(updateUserPayload UpdateUserPayload
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateUserRequest) SetQueryParam(key, value string) ApiUpdateUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateUserRequest) AddQueryParam(key, value string) ApiUpdateUserRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateUserRequest) Clone() ApiUpdateUserRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateUserRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateUserRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateUser", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "userId", Value: ParameterValueToString(r.userId, "userId")}, oapierror.Parameter{Name: "region", Value: ParameterValueToString(r.region, "region")})
+	}
+	return err
+}
+
+func (r UpdateUserRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPut
 		localVarPostBody   interface{}
@@ -4931,7 +6362,7 @@ func (r UpdateUserRequest) Execute() error {
 	if !ok {
 		return fmt.Errorf("could not parse client to type APIClient")
 	}
-	localBasePath, err := client.cfg.ServerURLWithContext(r.ctx, "DefaultApiService.UpdateUser")
+	localBasePath, err := client.cfg.ServerURLForRegion(r.ctx, "DefaultApiService.UpdateUser", r.region)
 	if err != nil {
 		return &oapierror.GenericOpenAPIError{ErrorMessage: err.Error()}
 	}
@@ -4945,8 +6376,20 @@ func (r UpdateUserRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.instanceId == "" {
+		return &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.userId == "" {
+		return &oapierror.ValidationError{Field: "userId"}
+	}
+	if r.region == "" {
+		return &oapierror.ValidationError{Field: "region"}
+	}
 	if r.updateUserPayload == nil {
-		return fmt.Errorf("updateUserPayload is required and must be specified")
+		return &oapierror.ValidationError{Field: "updateUserPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -4968,7 +6411,8 @@ func (r UpdateUserRequest) Execute() error {
 	}
 	// body params
 	localVarPostBody = r.updateUserPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateUser"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -4999,10 +6443,14 @@ func (r UpdateUserRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -5013,7 +6461,7 @@ func (r UpdateUserRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -5024,7 +6472,7 @@ func (r UpdateUserRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -5035,7 +6483,7 @@ func (r UpdateUserRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/mongodbflex",
+		ServiceName:   "mongodbflex",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,37 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CloneInstance",
+	"CreateInstance",
+	"CreateUser",
+	"DeleteInstance",
+	"DeleteUser",
+	"GetBackup",
+	"GetInstance",
+	"GetUser",
+	"ListAdvisorSlowQueries",
+	"ListBackups",
+	"ListFlavors",
+	"ListInstances",
+	"ListMetrics",
+	"ListRestoreJobs",
+	"ListStorages",
+	"ListSuggestedIndexes",
+	"ListUsers",
+	"ListVersions",
+	"PartialUpdateInstance",
+	"PartialUpdateUser",
+	"ResetUser",
+	"RestoreInstance",
+	"UpdateBackupSchedule",
+	"UpdateInstance",
+	"UpdateUser",
+}
+
+func init() {
+	config.RegisterOperations("mongodbflex", operations...)
+}
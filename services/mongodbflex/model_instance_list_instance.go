@@ -103,14 +103,9 @@ func (v *InstanceListInstanceStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InstanceListInstanceStatus(value)
-	for _, existing := range AllowedInstanceListInstanceStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InstanceListInstance", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceListInstanceStatusFromValue returns a pointer to a valid InstanceListInstanceStatus
@@ -134,6 +129,17 @@ func (v InstanceListInstanceStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceListInstanceStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceListInstanceStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v InstanceListInstanceStatus) Ptr() *InstanceListInstanceStatus {
 	return &v
@@ -0,0 +1,147 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/mongodbflex"
+)
+
+// CreateInstanceOperation returns the async action of CreateInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateInstancePollFunc. The name of the operation is
+// "mongodbflex/CreateInstance/{projectId}/{instanceId}/{region}".
+func CreateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, region string) *lro.Operation[mongodbflex.InstanceResponse] {
+	return createInstanceOperationHandler(a).Operation(projectId, instanceId, region)
+}
+
+// CreateInstancePollFunc returns the lro.PollFunc of the operations returned by CreateInstanceOperation, e.g. to resume
+// one with lro.Resume
+func CreateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[mongodbflex.InstanceResponse] {
+	return createInstanceOperationHandler(a).PollFunc()
+}
+
+func createInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[mongodbflex.InstanceResponse] {
+	return lro.WaitHandler[mongodbflex.InstanceResponse]{
+		Kind: "mongodbflex/CreateInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[mongodbflex.InstanceResponse] {
+			return CreateInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CloneInstanceOperation returns the async action of CloneInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CloneInstancePollFunc. The name of the operation is
+// "mongodbflex/CloneInstance/{projectId}/{instanceId}/{region}".
+func CloneInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, region string) *lro.Operation[mongodbflex.InstanceResponse] {
+	return cloneInstanceOperationHandler(a).Operation(projectId, instanceId, region)
+}
+
+// CloneInstancePollFunc returns the lro.PollFunc of the operations returned by CloneInstanceOperation, e.g. to resume
+// one with lro.Resume
+func CloneInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[mongodbflex.InstanceResponse] {
+	return cloneInstanceOperationHandler(a).PollFunc()
+}
+
+func cloneInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[mongodbflex.InstanceResponse] {
+	return lro.WaitHandler[mongodbflex.InstanceResponse]{
+		Kind: "mongodbflex/CloneInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[mongodbflex.InstanceResponse] {
+			return CloneInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// RestoreInstanceOperation returns the async action of RestoreInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with RestoreInstancePollFunc. The name of the operation is
+// "mongodbflex/RestoreInstance/{projectId}/{instanceId}/{backupId}/{region}".
+func RestoreInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, backupId, region string) *lro.Operation[mongodbflex.ListRestoreJobsResponse] {
+	return restoreInstanceOperationHandler(a).Operation(projectId, instanceId, backupId, region)
+}
+
+// RestoreInstancePollFunc returns the lro.PollFunc of the operations returned by RestoreInstanceOperation, e.g. to
+// resume one with lro.Resume
+func RestoreInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[mongodbflex.ListRestoreJobsResponse] {
+	return restoreInstanceOperationHandler(a).PollFunc()
+}
+
+func restoreInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[mongodbflex.ListRestoreJobsResponse] {
+	return lro.WaitHandler[mongodbflex.ListRestoreJobsResponse]{
+		Kind: "mongodbflex/RestoreInstance",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[mongodbflex.ListRestoreJobsResponse] {
+			return RestoreInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
+
+// UpdateInstanceOperation returns the async action of UpdateInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with UpdateInstancePollFunc. The name of the operation is
+// "mongodbflex/UpdateInstance/{projectId}/{instanceId}/{region}".
+func UpdateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, region string) *lro.Operation[mongodbflex.InstanceResponse] {
+	return updateInstanceOperationHandler(a).Operation(projectId, instanceId, region)
+}
+
+// UpdateInstancePollFunc returns the lro.PollFunc of the operations returned by UpdateInstanceOperation, e.g. to resume
+// one with lro.Resume
+func UpdateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[mongodbflex.InstanceResponse] {
+	return updateInstanceOperationHandler(a).PollFunc()
+}
+
+func updateInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[mongodbflex.InstanceResponse] {
+	return lro.WaitHandler[mongodbflex.InstanceResponse]{
+		Kind: "mongodbflex/UpdateInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[mongodbflex.InstanceResponse] {
+			return UpdateInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// PartialUpdateInstanceOperation returns the async action of PartialUpdateInstanceWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with PartialUpdateInstancePollFunc. The name of
+// the operation is "mongodbflex/PartialUpdateInstance/{projectId}/{instanceId}/{region}".
+func PartialUpdateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, region string) *lro.Operation[mongodbflex.InstanceResponse] {
+	return partialUpdateInstanceOperationHandler(a).Operation(projectId, instanceId, region)
+}
+
+// PartialUpdateInstancePollFunc returns the lro.PollFunc of the operations returned by PartialUpdateInstanceOperation,
+// e.g. to resume one with lro.Resume
+func PartialUpdateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[mongodbflex.InstanceResponse] {
+	return partialUpdateInstanceOperationHandler(a).PollFunc()
+}
+
+func partialUpdateInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[mongodbflex.InstanceResponse] {
+	return lro.WaitHandler[mongodbflex.InstanceResponse]{
+		Kind: "mongodbflex/PartialUpdateInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[mongodbflex.InstanceResponse] {
+			return PartialUpdateInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteInstanceOperation returns the async action of DeleteInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteInstancePollFunc. The name of the operation is
+// "mongodbflex/DeleteInstance/{projectId}/{instanceId}/{region}".
+func DeleteInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, region string) *lro.Operation[struct{}] {
+	return deleteInstanceOperationHandler(a).Operation(projectId, instanceId, region)
+}
+
+// DeleteInstancePollFunc returns the lro.PollFunc of the operations returned by DeleteInstanceOperation, e.g. to resume
+// one with lro.Resume
+func DeleteInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[struct{}] {
+	return deleteInstanceOperationHandler(a).PollFunc()
+}
+
+func deleteInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "mongodbflex/DeleteInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -42,14 +42,9 @@ func (v *ProjectScope) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ProjectScope(value)
-	for _, existing := range AllowedProjectScopeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ProjectScope", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewProjectScopeFromValue returns a pointer to a valid ProjectScope
@@ -73,6 +68,17 @@ func (v ProjectScope) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ProjectScope) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ProjectScope) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ProjectScope value
 func (v ProjectScope) Ptr() *ProjectScope {
 	return &v
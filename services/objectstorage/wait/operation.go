@@ -0,0 +1,78 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/objectstorage"
+)
+
+// CreateBucketOperation returns the async action of CreateBucketWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateBucketPollFunc. The name of the operation is
+// "objectstorage/CreateBucket/{projectId}/{region}/{bucketName}".
+func CreateBucketOperation(a APIClientBucketInterface, projectId, region, bucketName string) *lro.Operation[objectstorage.GetBucketResponse] {
+	return createBucketOperationHandler(a).Operation(projectId, region, bucketName)
+}
+
+// CreateBucketPollFunc returns the lro.PollFunc of the operations returned by CreateBucketOperation, e.g. to resume one
+// with lro.Resume
+func CreateBucketPollFunc(a APIClientBucketInterface) lro.PollFunc[objectstorage.GetBucketResponse] {
+	return createBucketOperationHandler(a).PollFunc()
+}
+
+func createBucketOperationHandler(a APIClientBucketInterface) lro.WaitHandler[objectstorage.GetBucketResponse] {
+	return lro.WaitHandler[objectstorage.GetBucketResponse]{
+		Kind: "objectstorage/CreateBucket",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[objectstorage.GetBucketResponse] {
+			return CreateBucketWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteBucketOperation returns the async action of DeleteBucketWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteBucketPollFunc. The name of the operation is
+// "objectstorage/DeleteBucket/{projectId}/{region}/{bucketName}".
+func DeleteBucketOperation(a APIClientBucketInterface, projectId, region, bucketName string) *lro.Operation[struct{}] {
+	return deleteBucketOperationHandler(a).Operation(projectId, region, bucketName)
+}
+
+// DeleteBucketPollFunc returns the lro.PollFunc of the operations returned by DeleteBucketOperation, e.g. to resume one
+// with lro.Resume
+func DeleteBucketPollFunc(a APIClientBucketInterface) lro.PollFunc[struct{}] {
+	return deleteBucketOperationHandler(a).PollFunc()
+}
+
+func deleteBucketOperationHandler(a APIClientBucketInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "objectstorage/DeleteBucket",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteBucketWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CreateAccessKeyOperation returns the async action of CreateAccessKeyWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateAccessKeyPollFunc. The name of the operation is
+// "objectstorage/CreateAccessKey/{projectId}/{region}/{credentialsGroup}/{keyId}".
+func CreateAccessKeyOperation(a APIClientAccessKeyInterface, projectId, region, credentialsGroup, keyId string) *lro.Operation[objectstorage.AccessKey] {
+	return createAccessKeyOperationHandler(a).Operation(projectId, region, credentialsGroup, keyId)
+}
+
+// CreateAccessKeyPollFunc returns the lro.PollFunc of the operations returned by CreateAccessKeyOperation, e.g. to
+// resume one with lro.Resume
+func CreateAccessKeyPollFunc(a APIClientAccessKeyInterface) lro.PollFunc[objectstorage.AccessKey] {
+	return createAccessKeyOperationHandler(a).PollFunc()
+}
+
+func createAccessKeyOperationHandler(a APIClientAccessKeyInterface) lro.WaitHandler[objectstorage.AccessKey] {
+	return lro.WaitHandler[objectstorage.AccessKey]{
+		Kind: "objectstorage/CreateAccessKey",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[objectstorage.AccessKey] {
+			return CreateAccessKeyWaitHandler(ctx, a, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
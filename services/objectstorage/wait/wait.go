@@ -2,6 +2,7 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -36,7 +37,8 @@ func DeleteBucketWaitHandler(ctx context.Context, a APIClientBucketInterface, pr
 		if err == nil {
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, nil, fmt.Errorf("could not convert error to GenericOpenApiError")
 		}
@@ -48,3 +50,119 @@ func DeleteBucketWaitHandler(ctx context.Context, a APIClientBucketInterface, pr
 	handler.SetTimeout(1 * time.Minute)
 	return handler
 }
+
+// Interface needed for the access key lifecycle helpers
+type APIClientAccessKeyInterface interface {
+	CreateAccessKey(ctx context.Context, projectId string, region string) objectstorage.ApiCreateAccessKeyRequest
+	ListAccessKeys(ctx context.Context, projectId string, region string) objectstorage.ApiListAccessKeysRequest
+	DeleteAccessKey(ctx context.Context, projectId string, region string, keyId string) objectstorage.ApiDeleteAccessKeyRequest
+}
+
+// AccessKeyVerifier confirms that an access key works, e.g. by listing the buckets through the S3 API using the key.
+// It is retried until it succeeds, since a new access key may take a few seconds to be usable.
+type AccessKeyVerifier func(ctx context.Context, key *objectstorage.CreateAccessKeyResponse) error
+
+// CreateAccessKeyWaitHandler will wait for an access key to be listed in its credentials group.
+// If credentialsGroup is empty, the default credentials group is used.
+func CreateAccessKeyWaitHandler(ctx context.Context, a APIClientAccessKeyInterface, projectId, region, credentialsGroup, keyId string) *wait.AsyncActionHandler[objectstorage.AccessKey] {
+	handler := wait.New(func() (waitFinished bool, response *objectstorage.AccessKey, err error) {
+		req := a.ListAccessKeys(ctx, projectId, region)
+		if credentialsGroup != "" {
+			req = req.CredentialsGroup(credentialsGroup)
+		}
+		s, err := req.Execute()
+		if err != nil {
+			return false, nil, err
+		}
+		if s.AccessKeys == nil {
+			return false, nil, nil
+		}
+		for i := range *s.AccessKeys {
+			key := (*s.AccessKeys)[i]
+			if key.KeyId != nil && *key.KeyId == keyId {
+				return true, &key, nil
+			}
+		}
+		return false, nil, nil
+	})
+	handler.SetTimeout(1 * time.Minute)
+	return handler
+}
+
+// CreateAccessKeyAndWait creates an access key in the credentials group and waits until it is available.
+// If credentialsGroup is empty, the default credentials group is used.
+//
+// If verify is not nil, it is called until it confirms that the access key works. If the access key can't be verified,
+// it is returned together with the error, so that it can be deleted.
+func CreateAccessKeyAndWait(ctx context.Context, a APIClientAccessKeyInterface, projectId, region, credentialsGroup string, payload objectstorage.CreateAccessKeyPayload, verify AccessKeyVerifier) (*objectstorage.CreateAccessKeyResponse, error) {
+	return createAccessKeyAndWait(ctx, a, projectId, region, credentialsGroup, payload, verify, 5*time.Second)
+}
+
+func createAccessKeyAndWait(ctx context.Context, a APIClientAccessKeyInterface, projectId, region, credentialsGroup string, payload objectstorage.CreateAccessKeyPayload, verify AccessKeyVerifier, throttle time.Duration) (*objectstorage.CreateAccessKeyResponse, error) {
+	req := a.CreateAccessKey(ctx, projectId, region).CreateAccessKeyPayload(payload)
+	if credentialsGroup != "" {
+		req = req.CredentialsGroup(credentialsGroup)
+	}
+	key, err := req.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("create access key: %w", err)
+	}
+	if key.KeyId == nil {
+		return nil, fmt.Errorf("create access key: response has no key id")
+	}
+
+	_, err = CreateAccessKeyWaitHandler(ctx, a, projectId, region, credentialsGroup, *key.KeyId).SetThrottle(throttle).WaitWithContext(ctx)
+	if err != nil {
+		return key, fmt.Errorf("wait for access key %s: %w", *key.KeyId, err)
+	}
+
+	if verify != nil {
+		var verifyErr error
+		_, err = wait.New(func() (waitFinished bool, response *struct{}, err error) {
+			verifyErr = verify(ctx, key)
+			return verifyErr == nil, nil, nil
+		}).SetThrottle(throttle).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+		if err != nil {
+			if verifyErr != nil {
+				err = verifyErr
+			}
+			return key, fmt.Errorf("verify access key %s: %w", *key.KeyId, err)
+		}
+	}
+	return key, nil
+}
+
+// RotateAccessKey replaces the access key oldKeyId with a new access key in the same credentials group.
+// If credentialsGroup is empty, the default credentials group is used.
+//
+// The old access key is only deleted once the new one is available and, if verify is not nil, confirmed to work.
+// Otherwise the new access key is deleted again and the old one is kept.
+func RotateAccessKey(ctx context.Context, a APIClientAccessKeyInterface, projectId, region, credentialsGroup, oldKeyId string, payload objectstorage.CreateAccessKeyPayload, verify AccessKeyVerifier) (*objectstorage.CreateAccessKeyResponse, error) {
+	return rotateAccessKey(ctx, a, projectId, region, credentialsGroup, oldKeyId, payload, verify, 5*time.Second)
+}
+
+func rotateAccessKey(ctx context.Context, a APIClientAccessKeyInterface, projectId, region, credentialsGroup, oldKeyId string, payload objectstorage.CreateAccessKeyPayload, verify AccessKeyVerifier, throttle time.Duration) (*objectstorage.CreateAccessKeyResponse, error) {
+	key, err := createAccessKeyAndWait(ctx, a, projectId, region, credentialsGroup, payload, verify, throttle)
+	if err != nil {
+		if key != nil && key.KeyId != nil {
+			if deleteErr := deleteAccessKey(ctx, a, projectId, region, credentialsGroup, *key.KeyId); deleteErr != nil {
+				return nil, fmt.Errorf("%w, delete new access key: %w", err, deleteErr)
+			}
+		}
+		return nil, err
+	}
+
+	if err := deleteAccessKey(ctx, a, projectId, region, credentialsGroup, oldKeyId); err != nil {
+		return key, fmt.Errorf("delete old access key %s: %w", oldKeyId, err)
+	}
+	return key, nil
+}
+
+func deleteAccessKey(ctx context.Context, a APIClientAccessKeyInterface, projectId, region, credentialsGroup, keyId string) error {
+	req := a.DeleteAccessKey(ctx, projectId, region, keyId)
+	if credentialsGroup != "" {
+		req = req.CredentialsGroup(credentialsGroup)
+	}
+	_, err := req.Execute()
+	return err
+}
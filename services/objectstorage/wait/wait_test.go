@@ -2,6 +2,7 @@ package wait
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -111,3 +112,268 @@ func TestDeleteBucketWaitHandler(t *testing.T) {
 		})
 	}
 }
+
+// Used for testing access key operations
+type apiClientAccessKeyMocked struct {
+	keys             []objectstorage.AccessKey
+	createFails      bool
+	listFails        bool
+	createdKeyIds    []string
+	deletedKeyIds    []string
+	credentialsGroup string
+}
+
+func (a *apiClientAccessKeyMocked) CreateAccessKey(_ context.Context, _, _ string) objectstorage.ApiCreateAccessKeyRequest {
+	return &createAccessKeyRequestMocked{a: a}
+}
+
+func (a *apiClientAccessKeyMocked) ListAccessKeys(_ context.Context, _, _ string) objectstorage.ApiListAccessKeysRequest {
+	return &listAccessKeysRequestMocked{a: a}
+}
+
+func (a *apiClientAccessKeyMocked) DeleteAccessKey(_ context.Context, _, _, keyId string) objectstorage.ApiDeleteAccessKeyRequest {
+	return &deleteAccessKeyRequestMocked{a: a, keyId: keyId}
+}
+
+type createAccessKeyRequestMocked struct {
+	a *apiClientAccessKeyMocked
+}
+
+func (r *createAccessKeyRequestMocked) CreateAccessKeyPayload(_ objectstorage.CreateAccessKeyPayload) objectstorage.ApiCreateAccessKeyRequest {
+	return r
+}
+
+func (r *createAccessKeyRequestMocked) CredentialsGroup(credentialsGroup string) objectstorage.ApiCreateAccessKeyRequest {
+	r.a.credentialsGroup = credentialsGroup
+	return r
+}
+
+func (r *createAccessKeyRequestMocked) RetryOnConflict(_ int) objectstorage.ApiCreateAccessKeyRequest {
+	return r
+}
+
+func (r *createAccessKeyRequestMocked) SetQueryParam(_, _ string) objectstorage.ApiCreateAccessKeyRequest {
+	return r
+}
+
+func (r *createAccessKeyRequestMocked) AddQueryParam(_, _ string) objectstorage.ApiCreateAccessKeyRequest {
+	return r
+}
+
+func (r *createAccessKeyRequestMocked) Clone() objectstorage.ApiCreateAccessKeyRequest {
+	return r
+}
+
+func (r *createAccessKeyRequestMocked) Fingerprint() (string, error) {
+	return "", nil
+}
+
+func (r *createAccessKeyRequestMocked) Execute() (*objectstorage.CreateAccessKeyResponse, error) {
+	if r.a.createFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: 500,
+		}
+	}
+	keyId := fmt.Sprintf("key-%d", len(r.a.createdKeyIds)+1)
+	r.a.createdKeyIds = append(r.a.createdKeyIds, keyId)
+	r.a.keys = append(r.a.keys, objectstorage.AccessKey{KeyId: objectstorage.PtrString(keyId)})
+	return &objectstorage.CreateAccessKeyResponse{KeyId: objectstorage.PtrString(keyId)}, nil
+}
+
+type listAccessKeysRequestMocked struct {
+	a *apiClientAccessKeyMocked
+}
+
+func (r *listAccessKeysRequestMocked) CredentialsGroup(_ string) objectstorage.ApiListAccessKeysRequest {
+	return r
+}
+
+func (r *listAccessKeysRequestMocked) SetQueryParam(_, _ string) objectstorage.ApiListAccessKeysRequest {
+	return r
+}
+
+func (r *listAccessKeysRequestMocked) AddQueryParam(_, _ string) objectstorage.ApiListAccessKeysRequest {
+	return r
+}
+
+func (r *listAccessKeysRequestMocked) Clone() objectstorage.ApiListAccessKeysRequest {
+	return r
+}
+
+func (r *listAccessKeysRequestMocked) Fingerprint() (string, error) {
+	return "", nil
+}
+
+func (r *listAccessKeysRequestMocked) Execute() (*objectstorage.ListAccessKeysResponse, error) {
+	if r.a.listFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: 500,
+		}
+	}
+	keys := append([]objectstorage.AccessKey{}, r.a.keys...)
+	return &objectstorage.ListAccessKeysResponse{AccessKeys: &keys}, nil
+}
+
+type deleteAccessKeyRequestMocked struct {
+	a     *apiClientAccessKeyMocked
+	keyId string
+}
+
+func (r *deleteAccessKeyRequestMocked) CredentialsGroup(_ string) objectstorage.ApiDeleteAccessKeyRequest {
+	return r
+}
+
+func (r *deleteAccessKeyRequestMocked) SetQueryParam(_, _ string) objectstorage.ApiDeleteAccessKeyRequest {
+	return r
+}
+
+func (r *deleteAccessKeyRequestMocked) AddQueryParam(_, _ string) objectstorage.ApiDeleteAccessKeyRequest {
+	return r
+}
+
+func (r *deleteAccessKeyRequestMocked) Clone() objectstorage.ApiDeleteAccessKeyRequest {
+	return r
+}
+
+func (r *deleteAccessKeyRequestMocked) Fingerprint() (string, error) {
+	return "", nil
+}
+
+func (r *deleteAccessKeyRequestMocked) Execute() (*objectstorage.DeleteAccessKeyResponse, error) {
+	r.a.deletedKeyIds = append(r.a.deletedKeyIds, r.keyId)
+	keys := []objectstorage.AccessKey{}
+	for _, key := range r.a.keys {
+		if *key.KeyId != r.keyId {
+			keys = append(keys, key)
+		}
+	}
+	r.a.keys = keys
+	return &objectstorage.DeleteAccessKeyResponse{}, nil
+}
+
+func TestCreateAccessKeyAndWait(t *testing.T) {
+	tests := []struct {
+		desc            string
+		createFails     bool
+		listFails       bool
+		verifyFailures  int
+		verifyAlwaysErr bool
+		wantErr         bool
+		wantResp        bool
+	}{
+		{
+			desc:     "create_succeeded",
+			wantErr:  false,
+			wantResp: true,
+		},
+		{
+			desc:           "verify_succeeds_after_retry",
+			verifyFailures: 2,
+			wantErr:        false,
+			wantResp:       true,
+		},
+		{
+			desc:            "verify_fails",
+			verifyAlwaysErr: true,
+			wantErr:         true,
+			wantResp:        true,
+		},
+		{
+			desc:        "create_fails",
+			createFails: true,
+			wantErr:     true,
+			wantResp:    false,
+		},
+		{
+			desc:      "list_fails",
+			listFails: true,
+			wantErr:   true,
+			wantResp:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientAccessKeyMocked{
+				createFails: tt.createFails,
+				listFails:   tt.listFails,
+			}
+			verifyCalls := 0
+			verify := func(_ context.Context, _ *objectstorage.CreateAccessKeyResponse) error {
+				verifyCalls++
+				if tt.verifyAlwaysErr || verifyCalls <= tt.verifyFailures {
+					return fmt.Errorf("access denied")
+				}
+				return nil
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			gotRes, err := createAccessKeyAndWait(ctx, apiClient, "pid", "eu01", "group", objectstorage.CreateAccessKeyPayload{}, verify, time.Millisecond)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (gotRes != nil) != tt.wantResp {
+				t.Fatalf("handler gotRes = %v, wantResp %v", gotRes, tt.wantResp)
+			}
+			if !tt.createFails && apiClient.credentialsGroup != "group" {
+				t.Fatalf("credentials group %q was not passed", apiClient.credentialsGroup)
+			}
+		})
+	}
+}
+
+func TestRotateAccessKey(t *testing.T) {
+	tests := []struct {
+		desc            string
+		verifyFails     bool
+		wantErr         bool
+		wantKeyIds      []string
+		wantDeletedKeys []string
+	}{
+		{
+			desc:            "rotation_succeeded",
+			wantErr:         false,
+			wantKeyIds:      []string{"key-1"},
+			wantDeletedKeys: []string{"old"},
+		},
+		{
+			desc:            "verify_fails",
+			verifyFails:     true,
+			wantErr:         true,
+			wantKeyIds:      []string{"old"},
+			wantDeletedKeys: []string{"key-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientAccessKeyMocked{
+				keys: []objectstorage.AccessKey{{KeyId: objectstorage.PtrString("old")}},
+			}
+			verify := func(_ context.Context, _ *objectstorage.CreateAccessKeyResponse) error {
+				if tt.verifyFails {
+					return fmt.Errorf("access denied")
+				}
+				return nil
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			_, err := rotateAccessKey(ctx, apiClient, "pid", "eu01", "", "old", objectstorage.CreateAccessKeyPayload{}, verify, time.Millisecond)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+			gotKeyIds := []string{}
+			for _, key := range apiClient.keys {
+				gotKeyIds = append(gotKeyIds, *key.KeyId)
+			}
+			if diff := cmp.Diff(gotKeyIds, tt.wantKeyIds); diff != "" {
+				t.Fatalf("unexpected access keys: %s", diff)
+			}
+			if diff := cmp.Diff(apiClient.deletedKeyIds, tt.wantDeletedKeys); diff != "" {
+				t.Fatalf("unexpected deleted access keys: %s", diff)
+			}
+		})
+	}
+}
@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateAlertConfigReceiver Method for CreateAlertConfigReceiver
@@ -1332,285 +1335,550 @@ type DefaultApi interface {
 	UpdateTracesConfigsExecute(ctx context.Context, instanceId string, projectId string) (*Message, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateAlertConfigReceiverRequest interface {
 	CreateAlertConfigReceiverPayload(createAlertConfigReceiverPayload CreateAlertConfigReceiverPayload) ApiCreateAlertConfigReceiverRequest
+	RetryOnConflict(maxAttempts int) ApiCreateAlertConfigReceiverRequest
+	SetQueryParam(key, value string) ApiCreateAlertConfigReceiverRequest
+	AddQueryParam(key, value string) ApiCreateAlertConfigReceiverRequest
+	Clone() ApiCreateAlertConfigReceiverRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertConfigReceiversResponse, error)
 }
 
 type ApiCreateAlertConfigRouteRequest interface {
 	CreateAlertConfigRoutePayload(createAlertConfigRoutePayload CreateAlertConfigRoutePayload) ApiCreateAlertConfigRouteRequest
+	RetryOnConflict(maxAttempts int) ApiCreateAlertConfigRouteRequest
+	SetQueryParam(key, value string) ApiCreateAlertConfigRouteRequest
+	AddQueryParam(key, value string) ApiCreateAlertConfigRouteRequest
+	Clone() ApiCreateAlertConfigRouteRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertConfigRouteResponse, error)
 }
 
 type ApiCreateAlertgroupsRequest interface {
 	CreateAlertgroupsPayload(createAlertgroupsPayload CreateAlertgroupsPayload) ApiCreateAlertgroupsRequest
+	RetryOnConflict(maxAttempts int) ApiCreateAlertgroupsRequest
+	SetQueryParam(key, value string) ApiCreateAlertgroupsRequest
+	AddQueryParam(key, value string) ApiCreateAlertgroupsRequest
+	Clone() ApiCreateAlertgroupsRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiCreateAlertrulesRequest interface {
 	CreateAlertrulesPayload(createAlertrulesPayload CreateAlertrulesPayload) ApiCreateAlertrulesRequest
+	RetryOnConflict(maxAttempts int) ApiCreateAlertrulesRequest
+	SetQueryParam(key, value string) ApiCreateAlertrulesRequest
+	AddQueryParam(key, value string) ApiCreateAlertrulesRequest
+	Clone() ApiCreateAlertrulesRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertRulesResponse, error)
 }
 
 type ApiCreateCertCheckRequest interface {
 	CreateCertCheckPayload(createCertCheckPayload CreateCertCheckPayload) ApiCreateCertCheckRequest
+	RetryOnConflict(maxAttempts int) ApiCreateCertCheckRequest
+	SetQueryParam(key, value string) ApiCreateCertCheckRequest
+	AddQueryParam(key, value string) ApiCreateCertCheckRequest
+	Clone() ApiCreateCertCheckRequest
+	Fingerprint() (string, error)
 	Execute() (*CertCheckResponse, error)
 }
 
 type ApiCreateCredentialsRequest interface {
 	CreateCredentialsPayload(createCredentialsPayload CreateCredentialsPayload) ApiCreateCredentialsRequest
+	RetryOnConflict(maxAttempts int) ApiCreateCredentialsRequest
+	SetQueryParam(key, value string) ApiCreateCredentialsRequest
+	AddQueryParam(key, value string) ApiCreateCredentialsRequest
+	Clone() ApiCreateCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateCredentialsResponse, error)
 }
 
 type ApiCreateHttpCheckRequest interface {
 	CreateHttpCheckPayload(createHttpCheckPayload CreateHttpCheckPayload) ApiCreateHttpCheckRequest
+	RetryOnConflict(maxAttempts int) ApiCreateHttpCheckRequest
+	SetQueryParam(key, value string) ApiCreateHttpCheckRequest
+	AddQueryParam(key, value string) ApiCreateHttpCheckRequest
+	Clone() ApiCreateHttpCheckRequest
+	Fingerprint() (string, error)
 	Execute() (*HttpCheckResponse, error)
 }
 
 type ApiCreateInstanceRequest interface {
 	CreateInstancePayload(createInstancePayload CreateInstancePayload) ApiCreateInstanceRequest
+	RetryOnConflict(maxAttempts int) ApiCreateInstanceRequest
+	SetQueryParam(key, value string) ApiCreateInstanceRequest
+	AddQueryParam(key, value string) ApiCreateInstanceRequest
+	Clone() ApiCreateInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateInstanceResponse, error)
 }
 
 type ApiCreateLogsAlertgroupsRequest interface {
 	CreateLogsAlertgroupsPayload(createLogsAlertgroupsPayload CreateLogsAlertgroupsPayload) ApiCreateLogsAlertgroupsRequest
+	RetryOnConflict(maxAttempts int) ApiCreateLogsAlertgroupsRequest
+	SetQueryParam(key, value string) ApiCreateLogsAlertgroupsRequest
+	AddQueryParam(key, value string) ApiCreateLogsAlertgroupsRequest
+	Clone() ApiCreateLogsAlertgroupsRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiCreateScrapeConfigRequest interface {
 	CreateScrapeConfigPayload(createScrapeConfigPayload CreateScrapeConfigPayload) ApiCreateScrapeConfigRequest
+	RetryOnConflict(maxAttempts int) ApiCreateScrapeConfigRequest
+	SetQueryParam(key, value string) ApiCreateScrapeConfigRequest
+	AddQueryParam(key, value string) ApiCreateScrapeConfigRequest
+	Clone() ApiCreateScrapeConfigRequest
+	Fingerprint() (string, error)
 	Execute() (*ScrapeConfigsResponse, error)
 }
 
 type ApiDeleteAlertConfigReceiverRequest interface {
+	SetQueryParam(key, value string) ApiDeleteAlertConfigReceiverRequest
+	AddQueryParam(key, value string) ApiDeleteAlertConfigReceiverRequest
+	Clone() ApiDeleteAlertConfigReceiverRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertConfigReceiversResponse, error)
 }
 
 type ApiDeleteAlertConfigRouteRequest interface {
+	SetQueryParam(key, value string) ApiDeleteAlertConfigRouteRequest
+	AddQueryParam(key, value string) ApiDeleteAlertConfigRouteRequest
+	Clone() ApiDeleteAlertConfigRouteRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertConfigRouteResponse, error)
 }
 
 type ApiDeleteAlertgroupRequest interface {
+	SetQueryParam(key, value string) ApiDeleteAlertgroupRequest
+	AddQueryParam(key, value string) ApiDeleteAlertgroupRequest
+	Clone() ApiDeleteAlertgroupRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiDeleteAlertgroupsRequest interface {
 	// Name of the groups that should be deleted
 	GroupName(groupName []string) ApiDeleteAlertgroupsRequest
+	SetQueryParam(key, value string) ApiDeleteAlertgroupsRequest
+	AddQueryParam(key, value string) ApiDeleteAlertgroupsRequest
+	Clone() ApiDeleteAlertgroupsRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiDeleteAlertrulesRequest interface {
 	// Name of the alert rules that should be deleted
 	AlertName(alertName []string) ApiDeleteAlertrulesRequest
+	SetQueryParam(key, value string) ApiDeleteAlertrulesRequest
+	AddQueryParam(key, value string) ApiDeleteAlertrulesRequest
+	Clone() ApiDeleteAlertrulesRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertRulesResponse, error)
 }
 
 type ApiDeleteCertCheckRequest interface {
+	SetQueryParam(key, value string) ApiDeleteCertCheckRequest
+	AddQueryParam(key, value string) ApiDeleteCertCheckRequest
+	Clone() ApiDeleteCertCheckRequest
+	Fingerprint() (string, error)
 	Execute() (*CertCheckResponse, error)
 }
 
 type ApiDeleteCredentialsRequest interface {
+	SetQueryParam(key, value string) ApiDeleteCredentialsRequest
+	AddQueryParam(key, value string) ApiDeleteCredentialsRequest
+	Clone() ApiDeleteCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiDeleteCredentialsRemoteWriteConfigRequest interface {
+	SetQueryParam(key, value string) ApiDeleteCredentialsRemoteWriteConfigRequest
+	AddQueryParam(key, value string) ApiDeleteCredentialsRemoteWriteConfigRequest
+	Clone() ApiDeleteCredentialsRemoteWriteConfigRequest
+	Fingerprint() (string, error)
 	Execute() (*CredentialsRemoteWriteDeleteResponse, error)
 }
 
 type ApiDeleteHttpCheckRequest interface {
+	SetQueryParam(key, value string) ApiDeleteHttpCheckRequest
+	AddQueryParam(key, value string) ApiDeleteHttpCheckRequest
+	Clone() ApiDeleteHttpCheckRequest
+	Fingerprint() (string, error)
 	Execute() (*HttpCheckResponse, error)
 }
 
 type ApiDeleteInstanceRequest interface {
+	SetQueryParam(key, value string) ApiDeleteInstanceRequest
+	AddQueryParam(key, value string) ApiDeleteInstanceRequest
+	Clone() ApiDeleteInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*InstanceResponse, error)
 }
 
 type ApiDeleteLogsAlertgroupRequest interface {
+	SetQueryParam(key, value string) ApiDeleteLogsAlertgroupRequest
+	AddQueryParam(key, value string) ApiDeleteLogsAlertgroupRequest
+	Clone() ApiDeleteLogsAlertgroupRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiDeleteScrapeConfigRequest interface {
+	SetQueryParam(key, value string) ApiDeleteScrapeConfigRequest
+	AddQueryParam(key, value string) ApiDeleteScrapeConfigRequest
+	Clone() ApiDeleteScrapeConfigRequest
+	Fingerprint() (string, error)
 	Execute() (*DeleteScrapeConfigResponse, error)
 }
 
 type ApiGetAlertConfigReceiverRequest interface {
+	SetQueryParam(key, value string) ApiGetAlertConfigReceiverRequest
+	AddQueryParam(key, value string) ApiGetAlertConfigReceiverRequest
+	Clone() ApiGetAlertConfigReceiverRequest
+	Fingerprint() (string, error)
 	Execute() (*Receiver, error)
 }
 
 type ApiGetAlertConfigRouteRequest interface {
+	SetQueryParam(key, value string) ApiGetAlertConfigRouteRequest
+	AddQueryParam(key, value string) ApiGetAlertConfigRouteRequest
+	Clone() ApiGetAlertConfigRouteRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertConfigRouteResponse, error)
 }
 
 type ApiGetAlertConfigsRequest interface {
+	SetQueryParam(key, value string) ApiGetAlertConfigsRequest
+	AddQueryParam(key, value string) ApiGetAlertConfigsRequest
+	Clone() ApiGetAlertConfigsRequest
+	Fingerprint() (string, error)
 	Execute() (*GetAlertConfigsResponse, error)
 }
 
 type ApiGetAlertgroupRequest interface {
+	SetQueryParam(key, value string) ApiGetAlertgroupRequest
+	AddQueryParam(key, value string) ApiGetAlertgroupRequest
+	Clone() ApiGetAlertgroupRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupResponse, error)
 }
 
 type ApiGetCredentialsRequest interface {
+	SetQueryParam(key, value string) ApiGetCredentialsRequest
+	AddQueryParam(key, value string) ApiGetCredentialsRequest
+	Clone() ApiGetCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*GetCredentialsResponse, error)
 }
 
 type ApiGetCredentialsRemoteWriteConfigRequest interface {
+	SetQueryParam(key, value string) ApiGetCredentialsRemoteWriteConfigRequest
+	AddQueryParam(key, value string) ApiGetCredentialsRemoteWriteConfigRequest
+	Clone() ApiGetCredentialsRemoteWriteConfigRequest
+	Fingerprint() (string, error)
 	Execute() (*CredentialsRemoteWriteConfig, error)
 }
 
 type ApiGetGrafanaConfigsRequest interface {
+	SetQueryParam(key, value string) ApiGetGrafanaConfigsRequest
+	AddQueryParam(key, value string) ApiGetGrafanaConfigsRequest
+	Clone() ApiGetGrafanaConfigsRequest
+	Fingerprint() (string, error)
 	Execute() (*GrafanaConfigs, error)
 }
 
 type ApiGetInstanceRequest interface {
+	SetQueryParam(key, value string) ApiGetInstanceRequest
+	AddQueryParam(key, value string) ApiGetInstanceRequest
+	Clone() ApiGetInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*GetInstanceResponse, error)
 }
 
 type ApiGetLogsAlertgroupRequest interface {
+	SetQueryParam(key, value string) ApiGetLogsAlertgroupRequest
+	AddQueryParam(key, value string) ApiGetLogsAlertgroupRequest
+	Clone() ApiGetLogsAlertgroupRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupResponse, error)
 }
 
 type ApiGetLogsConfigsRequest interface {
+	SetQueryParam(key, value string) ApiGetLogsConfigsRequest
+	AddQueryParam(key, value string) ApiGetLogsConfigsRequest
+	Clone() ApiGetLogsConfigsRequest
+	Fingerprint() (string, error)
 	Execute() (*LogsConfigResponse, error)
 }
 
 type ApiGetMetricsStorageRetentionRequest interface {
+	SetQueryParam(key, value string) ApiGetMetricsStorageRetentionRequest
+	AddQueryParam(key, value string) ApiGetMetricsStorageRetentionRequest
+	Clone() ApiGetMetricsStorageRetentionRequest
+	Fingerprint() (string, error)
 	Execute() (*GetMetricsStorageRetentionResponse, error)
 }
 
 type ApiGetScrapeConfigRequest interface {
+	SetQueryParam(key, value string) ApiGetScrapeConfigRequest
+	AddQueryParam(key, value string) ApiGetScrapeConfigRequest
+	Clone() ApiGetScrapeConfigRequest
+	Fingerprint() (string, error)
 	Execute() (*GetScrapeConfigResponse, error)
 }
 
 type ApiGetTracesConfigsRequest interface {
+	SetQueryParam(key, value string) ApiGetTracesConfigsRequest
+	AddQueryParam(key, value string) ApiGetTracesConfigsRequest
+	Clone() ApiGetTracesConfigsRequest
+	Fingerprint() (string, error)
 	Execute() (*TracesConfigResponse, error)
 }
 
 type ApiListACLRequest interface {
+	SetQueryParam(key, value string) ApiListACLRequest
+	AddQueryParam(key, value string) ApiListACLRequest
+	Clone() ApiListACLRequest
+	Fingerprint() (string, error)
 	Execute() (*ListACLResponse, error)
 }
 
 type ApiListAlertConfigReceiversRequest interface {
+	SetQueryParam(key, value string) ApiListAlertConfigReceiversRequest
+	AddQueryParam(key, value string) ApiListAlertConfigReceiversRequest
+	Clone() ApiListAlertConfigReceiversRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertConfigReceiversResponse, error)
 }
 
 type ApiListAlertConfigRoutesRequest interface {
+	SetQueryParam(key, value string) ApiListAlertConfigRoutesRequest
+	AddQueryParam(key, value string) ApiListAlertConfigRoutesRequest
+	Clone() ApiListAlertConfigRoutesRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertConfigRouteResponse, error)
 }
 
 type ApiListAlertgroupsRequest interface {
+	SetQueryParam(key, value string) ApiListAlertgroupsRequest
+	AddQueryParam(key, value string) ApiListAlertgroupsRequest
+	Clone() ApiListAlertgroupsRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiListAlertrulesRequest interface {
+	SetQueryParam(key, value string) ApiListAlertrulesRequest
+	AddQueryParam(key, value string) ApiListAlertrulesRequest
+	Clone() ApiListAlertrulesRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertRulesResponse, error)
 }
 
 type ApiListCertChecksRequest interface {
+	SetQueryParam(key, value string) ApiListCertChecksRequest
+	AddQueryParam(key, value string) ApiListCertChecksRequest
+	Clone() ApiListCertChecksRequest
+	Fingerprint() (string, error)
 	Execute() (*CertCheckResponse, error)
 }
 
 type ApiListCredentialsRequest interface {
+	SetQueryParam(key, value string) ApiListCredentialsRequest
+	AddQueryParam(key, value string) ApiListCredentialsRequest
+	Clone() ApiListCredentialsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListCredentialsResponse, error)
 }
 
 type ApiListHttpChecksRequest interface {
+	SetQueryParam(key, value string) ApiListHttpChecksRequest
+	AddQueryParam(key, value string) ApiListHttpChecksRequest
+	Clone() ApiListHttpChecksRequest
+	Fingerprint() (string, error)
 	Execute() (*HttpCheckResponse, error)
 }
 
 type ApiListInstancesRequest interface {
+	SetQueryParam(key, value string) ApiListInstancesRequest
+	AddQueryParam(key, value string) ApiListInstancesRequest
+	Clone() ApiListInstancesRequest
+	Fingerprint() (string, error)
 	Execute() (*ListInstancesResponse, error)
 }
 
 type ApiListLogsAlertgroupsRequest interface {
+	SetQueryParam(key, value string) ApiListLogsAlertgroupsRequest
+	AddQueryParam(key, value string) ApiListLogsAlertgroupsRequest
+	Clone() ApiListLogsAlertgroupsRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiListPlansRequest interface {
+	SetQueryParam(key, value string) ApiListPlansRequest
+	AddQueryParam(key, value string) ApiListPlansRequest
+	Clone() ApiListPlansRequest
+	Fingerprint() (string, error)
 	Execute() (*PlansResponse, error)
 }
 
 type ApiListScrapeConfigsRequest interface {
+	SetQueryParam(key, value string) ApiListScrapeConfigsRequest
+	AddQueryParam(key, value string) ApiListScrapeConfigsRequest
+	Clone() ApiListScrapeConfigsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListScrapeConfigsResponse, error)
 }
 
 type ApiPartialUpdateAlertgroupsRequest interface {
 	UpdateAlertgroupsRequestInner(updateAlertgroupsRequestInner []UpdateAlertgroupsRequestInner) ApiPartialUpdateAlertgroupsRequest
+	SetQueryParam(key, value string) ApiPartialUpdateAlertgroupsRequest
+	AddQueryParam(key, value string) ApiPartialUpdateAlertgroupsRequest
+	Clone() ApiPartialUpdateAlertgroupsRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiPartialUpdateAlertrulesRequest interface {
 	UpdateAlertgroupsRequestInnerRulesInner(updateAlertgroupsRequestInnerRulesInner []UpdateAlertgroupsRequestInnerRulesInner) ApiPartialUpdateAlertrulesRequest
+	SetQueryParam(key, value string) ApiPartialUpdateAlertrulesRequest
+	AddQueryParam(key, value string) ApiPartialUpdateAlertrulesRequest
+	Clone() ApiPartialUpdateAlertrulesRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertRulesResponse, error)
 }
 
 type ApiUpdateACLRequest interface {
 	UpdateACLPayload(updateACLPayload UpdateACLPayload) ApiUpdateACLRequest
+	SetQueryParam(key, value string) ApiUpdateACLRequest
+	AddQueryParam(key, value string) ApiUpdateACLRequest
+	Clone() ApiUpdateACLRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiUpdateAlertConfigReceiverRequest interface {
 	UpdateAlertConfigReceiverPayload(updateAlertConfigReceiverPayload UpdateAlertConfigReceiverPayload) ApiUpdateAlertConfigReceiverRequest
+	SetQueryParam(key, value string) ApiUpdateAlertConfigReceiverRequest
+	AddQueryParam(key, value string) ApiUpdateAlertConfigReceiverRequest
+	Clone() ApiUpdateAlertConfigReceiverRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertConfigReceiversResponse, error)
 }
 
 type ApiUpdateAlertConfigRouteRequest interface {
 	UpdateAlertConfigRoutePayload(updateAlertConfigRoutePayload UpdateAlertConfigRoutePayload) ApiUpdateAlertConfigRouteRequest
+	SetQueryParam(key, value string) ApiUpdateAlertConfigRouteRequest
+	AddQueryParam(key, value string) ApiUpdateAlertConfigRouteRequest
+	Clone() ApiUpdateAlertConfigRouteRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertConfigRouteResponse, error)
 }
 
 type ApiUpdateAlertConfigsRequest interface {
 	UpdateAlertConfigsPayload(updateAlertConfigsPayload UpdateAlertConfigsPayload) ApiUpdateAlertConfigsRequest
+	SetQueryParam(key, value string) ApiUpdateAlertConfigsRequest
+	AddQueryParam(key, value string) ApiUpdateAlertConfigsRequest
+	Clone() ApiUpdateAlertConfigsRequest
+	Fingerprint() (string, error)
 	Execute() (*UpdateAlertConfigsResponse, error)
 }
 
 type ApiUpdateAlertgroupRequest interface {
 	UpdateAlertgroupPayload(updateAlertgroupPayload UpdateAlertgroupPayload) ApiUpdateAlertgroupRequest
+	SetQueryParam(key, value string) ApiUpdateAlertgroupRequest
+	AddQueryParam(key, value string) ApiUpdateAlertgroupRequest
+	Clone() ApiUpdateAlertgroupRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiUpdateAlertgroupsRequest interface {
 	UpdateAlertgroupsRequestInner(updateAlertgroupsRequestInner []UpdateAlertgroupsRequestInner) ApiUpdateAlertgroupsRequest
+	SetQueryParam(key, value string) ApiUpdateAlertgroupsRequest
+	AddQueryParam(key, value string) ApiUpdateAlertgroupsRequest
+	Clone() ApiUpdateAlertgroupsRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiUpdateCredentialsRemoteWriteConfigRequest interface {
 	UpdateCredentialsRemoteWriteConfigPayload(updateCredentialsRemoteWriteConfigPayload UpdateCredentialsRemoteWriteConfigPayload) ApiUpdateCredentialsRemoteWriteConfigRequest
+	SetQueryParam(key, value string) ApiUpdateCredentialsRemoteWriteConfigRequest
+	AddQueryParam(key, value string) ApiUpdateCredentialsRemoteWriteConfigRequest
+	Clone() ApiUpdateCredentialsRemoteWriteConfigRequest
+	Fingerprint() (string, error)
 	Execute() (*CredentialsRemoteWriteConfig, error)
 }
 
 type ApiUpdateGrafanaConfigsRequest interface {
 	UpdateGrafanaConfigsPayload(updateGrafanaConfigsPayload UpdateGrafanaConfigsPayload) ApiUpdateGrafanaConfigsRequest
+	SetQueryParam(key, value string) ApiUpdateGrafanaConfigsRequest
+	AddQueryParam(key, value string) ApiUpdateGrafanaConfigsRequest
+	Clone() ApiUpdateGrafanaConfigsRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiUpdateInstanceRequest interface {
 	UpdateInstancePayload(updateInstancePayload UpdateInstancePayload) ApiUpdateInstanceRequest
+	SetQueryParam(key, value string) ApiUpdateInstanceRequest
+	AddQueryParam(key, value string) ApiUpdateInstanceRequest
+	Clone() ApiUpdateInstanceRequest
+	Fingerprint() (string, error)
 	Execute() (*InstanceResponse, error)
 }
 
 type ApiUpdateLogsAlertgroupRequest interface {
 	UpdateLogsAlertgroupPayload(updateLogsAlertgroupPayload UpdateLogsAlertgroupPayload) ApiUpdateLogsAlertgroupRequest
+	SetQueryParam(key, value string) ApiUpdateLogsAlertgroupRequest
+	AddQueryParam(key, value string) ApiUpdateLogsAlertgroupRequest
+	Clone() ApiUpdateLogsAlertgroupRequest
+	Fingerprint() (string, error)
 	Execute() (*AlertGroupsResponse, error)
 }
 
 type ApiUpdateLogsConfigsRequest interface {
 	UpdateLogsConfigsPayload(updateLogsConfigsPayload UpdateLogsConfigsPayload) ApiUpdateLogsConfigsRequest
+	SetQueryParam(key, value string) ApiUpdateLogsConfigsRequest
+	AddQueryParam(key, value string) ApiUpdateLogsConfigsRequest
+	Clone() ApiUpdateLogsConfigsRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiUpdateMetricsStorageRetentionRequest interface {
 	UpdateMetricsStorageRetentionPayload(updateMetricsStorageRetentionPayload UpdateMetricsStorageRetentionPayload) ApiUpdateMetricsStorageRetentionRequest
+	SetQueryParam(key, value string) ApiUpdateMetricsStorageRetentionRequest
+	AddQueryParam(key, value string) ApiUpdateMetricsStorageRetentionRequest
+	Clone() ApiUpdateMetricsStorageRetentionRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
 type ApiUpdateScrapeConfigRequest interface {
 	UpdateScrapeConfigPayload(updateScrapeConfigPayload UpdateScrapeConfigPayload) ApiUpdateScrapeConfigRequest
+	SetQueryParam(key, value string) ApiUpdateScrapeConfigRequest
+	AddQueryParam(key, value string) ApiUpdateScrapeConfigRequest
+	Clone() ApiUpdateScrapeConfigRequest
+	Fingerprint() (string, error)
 	Execute() (*ScrapeConfigsResponse, error)
 }
 
 type ApiUpdateTracesConfigsRequest interface {
 	UpdateTracesConfigsPayload(updateTracesConfigsPayload UpdateTracesConfigsPayload) ApiUpdateTracesConfigsRequest
+	SetQueryParam(key, value string) ApiUpdateTracesConfigsRequest
+	AddQueryParam(key, value string) ApiUpdateTracesConfigsRequest
+	Clone() ApiUpdateTracesConfigsRequest
+	Fingerprint() (string, error)
 	Execute() (*Message, error)
 }
 
@@ -1623,6 +1891,8 @@ type CreateAlertConfigReceiverRequest struct {
 	instanceId                       string
 	projectId                        string
 	createAlertConfigReceiverPayload *CreateAlertConfigReceiverPayload
+	retryOnConflict                  int
+	queryParams                      []queryParam
 }
 
 func (r CreateAlertConfigReceiverRequest) CreateAlertConfigReceiverPayload(createAlertConfigReceiverPayload CreateAlertConfigReceiverPayload) ApiCreateAlertConfigReceiverRequest {
@@ -1630,7 +1900,50 @@ func (r CreateAlertConfigReceiverRequest) CreateAlertConfigReceiverPayload(creat
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateAlertConfigReceiverRequest) RetryOnConflict(maxAttempts int) ApiCreateAlertConfigReceiverRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateAlertConfigReceiverRequest) SetQueryParam(key, value string) ApiCreateAlertConfigReceiverRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateAlertConfigReceiverRequest) AddQueryParam(key, value string) ApiCreateAlertConfigReceiverRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateAlertConfigReceiverRequest) Clone() ApiCreateAlertConfigReceiverRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateAlertConfigReceiverRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateAlertConfigReceiver", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateAlertConfigReceiverRequest) execute() (*AlertConfigReceiversResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1654,8 +1967,14 @@ func (r CreateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createAlertConfigReceiverPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createAlertConfigReceiverPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createAlertConfigReceiverPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1677,7 +1996,8 @@ func (r CreateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 	}
 	// body params
 	localVarPostBody = r.createAlertConfigReceiverPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateAlertConfigReceiver"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1708,10 +2028,14 @@ func (r CreateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1722,7 +2046,7 @@ func (r CreateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1733,7 +2057,7 @@ func (r CreateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1781,6 +2105,8 @@ type CreateAlertConfigRouteRequest struct {
 	instanceId                    string
 	projectId                     string
 	createAlertConfigRoutePayload *CreateAlertConfigRoutePayload
+	retryOnConflict               int
+	queryParams                   []queryParam
 }
 
 func (r CreateAlertConfigRouteRequest) CreateAlertConfigRoutePayload(createAlertConfigRoutePayload CreateAlertConfigRoutePayload) ApiCreateAlertConfigRouteRequest {
@@ -1788,7 +2114,50 @@ func (r CreateAlertConfigRouteRequest) CreateAlertConfigRoutePayload(createAlert
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateAlertConfigRouteRequest) RetryOnConflict(maxAttempts int) ApiCreateAlertConfigRouteRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateAlertConfigRouteRequest) SetQueryParam(key, value string) ApiCreateAlertConfigRouteRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateAlertConfigRouteRequest) AddQueryParam(key, value string) ApiCreateAlertConfigRouteRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateAlertConfigRouteRequest) Clone() ApiCreateAlertConfigRouteRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateAlertConfigRouteRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateAlertConfigRoute", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateAlertConfigRouteRequest) execute() (*AlertConfigRouteResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1812,8 +2181,14 @@ func (r CreateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createAlertConfigRoutePayload == nil {
-		return localVarReturnValue, fmt.Errorf("createAlertConfigRoutePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createAlertConfigRoutePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1835,7 +2210,8 @@ func (r CreateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 	}
 	// body params
 	localVarPostBody = r.createAlertConfigRoutePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateAlertConfigRoute"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1866,10 +2242,14 @@ func (r CreateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1880,7 +2260,7 @@ func (r CreateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1891,7 +2271,7 @@ func (r CreateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1939,6 +2319,8 @@ type CreateAlertgroupsRequest struct {
 	instanceId               string
 	projectId                string
 	createAlertgroupsPayload *CreateAlertgroupsPayload
+	retryOnConflict          int
+	queryParams              []queryParam
 }
 
 func (r CreateAlertgroupsRequest) CreateAlertgroupsPayload(createAlertgroupsPayload CreateAlertgroupsPayload) ApiCreateAlertgroupsRequest {
@@ -1946,7 +2328,50 @@ func (r CreateAlertgroupsRequest) CreateAlertgroupsPayload(createAlertgroupsPayl
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateAlertgroupsRequest) RetryOnConflict(maxAttempts int) ApiCreateAlertgroupsRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateAlertgroupsRequest) SetQueryParam(key, value string) ApiCreateAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateAlertgroupsRequest) AddQueryParam(key, value string) ApiCreateAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateAlertgroupsRequest) Clone() ApiCreateAlertgroupsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateAlertgroupsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateAlertgroups", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateAlertgroupsRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1970,8 +2395,14 @@ func (r CreateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createAlertgroupsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createAlertgroupsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createAlertgroupsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1993,7 +2424,8 @@ func (r CreateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createAlertgroupsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateAlertgroups"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2024,10 +2456,14 @@ func (r CreateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2038,7 +2474,7 @@ func (r CreateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2049,7 +2485,7 @@ func (r CreateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2098,6 +2534,8 @@ type CreateAlertrulesRequest struct {
 	instanceId              string
 	projectId               string
 	createAlertrulesPayload *CreateAlertrulesPayload
+	retryOnConflict         int
+	queryParams             []queryParam
 }
 
 func (r CreateAlertrulesRequest) CreateAlertrulesPayload(createAlertrulesPayload CreateAlertrulesPayload) ApiCreateAlertrulesRequest {
@@ -2105,7 +2543,50 @@ func (r CreateAlertrulesRequest) CreateAlertrulesPayload(createAlertrulesPayload
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateAlertrulesRequest) RetryOnConflict(maxAttempts int) ApiCreateAlertrulesRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateAlertrulesRequest) SetQueryParam(key, value string) ApiCreateAlertrulesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateAlertrulesRequest) AddQueryParam(key, value string) ApiCreateAlertrulesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateAlertrulesRequest) Clone() ApiCreateAlertrulesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateAlertrulesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateAlertrules", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateAlertrulesRequest) execute() (*AlertRulesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2130,8 +2611,17 @@ func (r CreateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createAlertrulesPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createAlertrulesPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createAlertrulesPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2153,7 +2643,8 @@ func (r CreateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createAlertrulesPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateAlertrules"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2184,10 +2675,14 @@ func (r CreateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2198,7 +2693,7 @@ func (r CreateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2209,7 +2704,7 @@ func (r CreateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2260,6 +2755,8 @@ type CreateCertCheckRequest struct {
 	instanceId             string
 	projectId              string
 	createCertCheckPayload *CreateCertCheckPayload
+	retryOnConflict        int
+	queryParams            []queryParam
 }
 
 func (r CreateCertCheckRequest) CreateCertCheckPayload(createCertCheckPayload CreateCertCheckPayload) ApiCreateCertCheckRequest {
@@ -2267,7 +2764,50 @@ func (r CreateCertCheckRequest) CreateCertCheckPayload(createCertCheckPayload Cr
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateCertCheckRequest) RetryOnConflict(maxAttempts int) ApiCreateCertCheckRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateCertCheckRequest) SetQueryParam(key, value string) ApiCreateCertCheckRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateCertCheckRequest) AddQueryParam(key, value string) ApiCreateCertCheckRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateCertCheckRequest) Clone() ApiCreateCertCheckRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateCertCheckRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateCertCheckRequest) Execute() (*CertCheckResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateCertCheck", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateCertCheckRequest) execute() (*CertCheckResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2291,8 +2831,14 @@ func (r CreateCertCheckRequest) Execute() (*CertCheckResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createCertCheckPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createCertCheckPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createCertCheckPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2314,7 +2860,8 @@ func (r CreateCertCheckRequest) Execute() (*CertCheckResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createCertCheckPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateCertCheck"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2345,10 +2892,14 @@ func (r CreateCertCheckRequest) Execute() (*CertCheckResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2359,7 +2910,7 @@ func (r CreateCertCheckRequest) Execute() (*CertCheckResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2407,6 +2958,8 @@ type CreateCredentialsRequest struct {
 	instanceId               string
 	projectId                string
 	createCredentialsPayload *CreateCredentialsPayload
+	retryOnConflict          int
+	queryParams              []queryParam
 }
 
 func (r CreateCredentialsRequest) CreateCredentialsPayload(createCredentialsPayload CreateCredentialsPayload) ApiCreateCredentialsRequest {
@@ -2414,7 +2967,50 @@ func (r CreateCredentialsRequest) CreateCredentialsPayload(createCredentialsPayl
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateCredentialsRequest) RetryOnConflict(maxAttempts int) ApiCreateCredentialsRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateCredentialsRequest) SetQueryParam(key, value string) ApiCreateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateCredentialsRequest) AddQueryParam(key, value string) ApiCreateCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateCredentialsRequest) Clone() ApiCreateCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateCredentials", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateCredentialsRequest) execute() (*CreateCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2438,6 +3034,12 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -2458,7 +3060,8 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 	}
 	// body params
 	localVarPostBody = r.createCredentialsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2489,10 +3092,14 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2503,7 +3110,7 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2514,7 +3121,7 @@ func (r CreateCredentialsRequest) Execute() (*CreateCredentialsResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2562,6 +3169,8 @@ type CreateHttpCheckRequest struct {
 	instanceId             string
 	projectId              string
 	createHttpCheckPayload *CreateHttpCheckPayload
+	retryOnConflict        int
+	queryParams            []queryParam
 }
 
 func (r CreateHttpCheckRequest) CreateHttpCheckPayload(createHttpCheckPayload CreateHttpCheckPayload) ApiCreateHttpCheckRequest {
@@ -2569,7 +3178,50 @@ func (r CreateHttpCheckRequest) CreateHttpCheckPayload(createHttpCheckPayload Cr
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateHttpCheckRequest) RetryOnConflict(maxAttempts int) ApiCreateHttpCheckRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateHttpCheckRequest) SetQueryParam(key, value string) ApiCreateHttpCheckRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateHttpCheckRequest) AddQueryParam(key, value string) ApiCreateHttpCheckRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateHttpCheckRequest) Clone() ApiCreateHttpCheckRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateHttpCheckRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateHttpCheck", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateHttpCheckRequest) execute() (*HttpCheckResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2593,8 +3245,14 @@ func (r CreateHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createHttpCheckPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createHttpCheckPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createHttpCheckPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2616,7 +3274,8 @@ func (r CreateHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createHttpCheckPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateHttpCheck"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2647,10 +3306,14 @@ func (r CreateHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2661,7 +3324,7 @@ func (r CreateHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2708,6 +3371,8 @@ type CreateInstanceRequest struct {
 	apiService            *DefaultApiService
 	projectId             string
 	createInstancePayload *CreateInstancePayload
+	retryOnConflict       int
+	queryParams           []queryParam
 }
 
 func (r CreateInstanceRequest) CreateInstancePayload(createInstancePayload CreateInstancePayload) ApiCreateInstanceRequest {
@@ -2715,7 +3380,50 @@ func (r CreateInstanceRequest) CreateInstancePayload(createInstancePayload Creat
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateInstanceRequest) RetryOnConflict(maxAttempts int) ApiCreateInstanceRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateInstanceRequest) SetQueryParam(key, value string) ApiCreateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateInstanceRequest) AddQueryParam(key, value string) ApiCreateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateInstanceRequest) Clone() ApiCreateInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateInstance", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateInstanceRequest) execute() (*CreateInstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2738,8 +3446,11 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createInstancePayload == nil {
-		return localVarReturnValue, fmt.Errorf("createInstancePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2761,7 +3472,8 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createInstancePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2792,10 +3504,14 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2806,7 +3522,7 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2817,7 +3533,7 @@ func (r CreateInstanceRequest) Execute() (*CreateInstanceResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2862,6 +3578,8 @@ type CreateLogsAlertgroupsRequest struct {
 	instanceId                   string
 	projectId                    string
 	createLogsAlertgroupsPayload *CreateLogsAlertgroupsPayload
+	retryOnConflict              int
+	queryParams                  []queryParam
 }
 
 func (r CreateLogsAlertgroupsRequest) CreateLogsAlertgroupsPayload(createLogsAlertgroupsPayload CreateLogsAlertgroupsPayload) ApiCreateLogsAlertgroupsRequest {
@@ -2869,7 +3587,50 @@ func (r CreateLogsAlertgroupsRequest) CreateLogsAlertgroupsPayload(createLogsAle
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateLogsAlertgroupsRequest) RetryOnConflict(maxAttempts int) ApiCreateLogsAlertgroupsRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateLogsAlertgroupsRequest) SetQueryParam(key, value string) ApiCreateLogsAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateLogsAlertgroupsRequest) AddQueryParam(key, value string) ApiCreateLogsAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateLogsAlertgroupsRequest) Clone() ApiCreateLogsAlertgroupsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateLogsAlertgroupsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateLogsAlertgroups", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateLogsAlertgroupsRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -2893,8 +3654,14 @@ func (r CreateLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createLogsAlertgroupsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createLogsAlertgroupsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createLogsAlertgroupsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -2916,7 +3683,8 @@ func (r CreateLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createLogsAlertgroupsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateLogsAlertgroups"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2947,10 +3715,14 @@ func (r CreateLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2961,7 +3733,7 @@ func (r CreateLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2972,7 +3744,7 @@ func (r CreateLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3020,6 +3792,8 @@ type CreateScrapeConfigRequest struct {
 	instanceId                string
 	projectId                 string
 	createScrapeConfigPayload *CreateScrapeConfigPayload
+	retryOnConflict           int
+	queryParams               []queryParam
 }
 
 func (r CreateScrapeConfigRequest) CreateScrapeConfigPayload(createScrapeConfigPayload CreateScrapeConfigPayload) ApiCreateScrapeConfigRequest {
@@ -3027,7 +3801,50 @@ func (r CreateScrapeConfigRequest) CreateScrapeConfigPayload(createScrapeConfigP
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateScrapeConfigRequest) RetryOnConflict(maxAttempts int) ApiCreateScrapeConfigRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateScrapeConfigRequest) SetQueryParam(key, value string) ApiCreateScrapeConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateScrapeConfigRequest) AddQueryParam(key, value string) ApiCreateScrapeConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateScrapeConfigRequest) Clone() ApiCreateScrapeConfigRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateScrapeConfigRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateScrapeConfig", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateScrapeConfigRequest) execute() (*ScrapeConfigsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -3051,8 +3868,14 @@ func (r CreateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.createScrapeConfigPayload == nil {
-		return localVarReturnValue, fmt.Errorf("createScrapeConfigPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "createScrapeConfigPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -3074,7 +3897,8 @@ func (r CreateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createScrapeConfigPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateScrapeConfig"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3105,10 +3929,14 @@ func (r CreateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3119,7 +3947,7 @@ func (r CreateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3130,7 +3958,7 @@ func (r CreateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3173,14 +4001,51 @@ func (a *APIClient) CreateScrapeConfigExecute(ctx context.Context, instanceId st
 }
 
 type DeleteAlertConfigReceiverRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	receiver   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	receiver    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteAlertConfigReceiverRequest) SetQueryParam(key, value string) ApiDeleteAlertConfigReceiverRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteAlertConfigReceiverRequest) AddQueryParam(key, value string) ApiDeleteAlertConfigReceiverRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteAlertConfigReceiverRequest) Clone() ApiDeleteAlertConfigReceiverRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteAlertConfigReceiverRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteAlertConfigReceiver", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "receiver", Value: ParameterValueToString(r.receiver, "receiver")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteAlertConfigReceiverRequest) execute() (*AlertConfigReceiversResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -3205,6 +4070,15 @@ func (r DeleteAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.receiver == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "receiver"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3223,7 +4097,8 @@ func (r DeleteAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteAlertConfigReceiver"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3254,10 +4129,14 @@ func (r DeleteAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3268,7 +4147,7 @@ func (r DeleteAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3279,7 +4158,7 @@ func (r DeleteAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3325,14 +4204,51 @@ func (a *APIClient) DeleteAlertConfigReceiverExecute(ctx context.Context, instan
 }
 
 type DeleteAlertConfigRouteRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	receiver   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	receiver    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteAlertConfigRouteRequest) SetQueryParam(key, value string) ApiDeleteAlertConfigRouteRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteAlertConfigRouteRequest) AddQueryParam(key, value string) ApiDeleteAlertConfigRouteRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteAlertConfigRouteRequest) Clone() ApiDeleteAlertConfigRouteRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteAlertConfigRouteRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteAlertConfigRoute", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "receiver", Value: ParameterValueToString(r.receiver, "receiver")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteAlertConfigRouteRequest) execute() (*AlertConfigRouteResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -3357,6 +4273,15 @@ func (r DeleteAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.receiver == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "receiver"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3375,7 +4300,8 @@ func (r DeleteAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteAlertConfigRoute"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3406,10 +4332,14 @@ func (r DeleteAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
-		if localVarHTTPResponse.StatusCode == 403 {
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3420,7 +4350,7 @@ func (r DeleteAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3431,7 +4361,7 @@ func (r DeleteAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3477,14 +4407,51 @@ func (a *APIClient) DeleteAlertConfigRouteExecute(ctx context.Context, instanceI
 }
 
 type DeleteAlertgroupRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	groupName  string
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	groupName   string
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteAlertgroupRequest) SetQueryParam(key, value string) ApiDeleteAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteAlertgroupRequest) AddQueryParam(key, value string) ApiDeleteAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteAlertgroupRequest) Clone() ApiDeleteAlertgroupRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteAlertgroupRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteAlertgroup", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteAlertgroupRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -3509,6 +4476,15 @@ func (r DeleteAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3527,7 +4503,8 @@ func (r DeleteAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteAlertgroup"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3558,10 +4535,14 @@ func (r DeleteAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3572,7 +4553,7 @@ func (r DeleteAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3583,7 +4564,7 @@ func (r DeleteAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3594,7 +4575,7 @@ func (r DeleteAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3640,11 +4621,12 @@ func (a *APIClient) DeleteAlertgroupExecute(ctx context.Context, groupName strin
 }
 
 type DeleteAlertgroupsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	groupName  *[]string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	groupName   *[]string
+	queryParams []queryParam
 }
 
 // Name of the groups that should be deleted
@@ -3654,7 +4636,43 @@ func (r DeleteAlertgroupsRequest) GroupName(groupName []string) ApiDeleteAlertgr
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteAlertgroupsRequest) SetQueryParam(key, value string) ApiDeleteAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteAlertgroupsRequest) AddQueryParam(key, value string) ApiDeleteAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteAlertgroupsRequest) Clone() ApiDeleteAlertgroupsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteAlertgroupsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteAlertgroups", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteAlertgroupsRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -3678,8 +4696,14 @@ func (r DeleteAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.groupName == nil {
-		return localVarReturnValue, fmt.Errorf("groupName is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
 	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "groupName", r.groupName, "csv")
@@ -3700,7 +4724,8 @@ func (r DeleteAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteAlertgroups"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3731,10 +4756,14 @@ func (r DeleteAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3745,7 +4774,7 @@ func (r DeleteAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3788,12 +4817,13 @@ func (a *APIClient) DeleteAlertgroupsExecute(ctx context.Context, instanceId str
 }
 
 type DeleteAlertrulesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	groupName  string
-	instanceId string
-	projectId  string
-	alertName  *[]string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	groupName   string
+	instanceId  string
+	projectId   string
+	alertName   *[]string
+	queryParams []queryParam
 }
 
 // Name of the alert rules that should be deleted
@@ -3803,7 +4833,43 @@ func (r DeleteAlertrulesRequest) AlertName(alertName []string) ApiDeleteAlertrul
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteAlertrulesRequest) SetQueryParam(key, value string) ApiDeleteAlertrulesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteAlertrulesRequest) AddQueryParam(key, value string) ApiDeleteAlertrulesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteAlertrulesRequest) Clone() ApiDeleteAlertrulesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteAlertrulesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteAlertrules", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteAlertrulesRequest) execute() (*AlertRulesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -3828,8 +4894,17 @@ func (r DeleteAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.alertName == nil {
-		return localVarReturnValue, fmt.Errorf("alertName is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "alertName"}
 	}
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "alertName", r.alertName, "csv")
@@ -3850,7 +4925,8 @@ func (r DeleteAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteAlertrules"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -3881,10 +4957,14 @@ func (r DeleteAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -3895,7 +4975,7 @@ func (r DeleteAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -3941,14 +5021,51 @@ func (a *APIClient) DeleteAlertrulesExecute(ctx context.Context, groupName strin
 }
 
 type DeleteCertCheckRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	id         string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	id          string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteCertCheckRequest) SetQueryParam(key, value string) ApiDeleteCertCheckRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteCertCheckRequest) AddQueryParam(key, value string) ApiDeleteCertCheckRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteCertCheckRequest) Clone() ApiDeleteCertCheckRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteCertCheckRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteCertCheckRequest) Execute() (*CertCheckResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteCertCheck", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "id", Value: ParameterValueToString(r.id, "id")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteCertCheckRequest) execute() (*CertCheckResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -3973,6 +5090,15 @@ func (r DeleteCertCheckRequest) Execute() (*CertCheckResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.id == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "id"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3991,7 +5117,8 @@ func (r DeleteCertCheckRequest) Execute() (*CertCheckResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteCertCheck"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4022,10 +5149,14 @@ func (r DeleteCertCheckRequest) Execute() (*CertCheckResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4036,7 +5167,7 @@ func (r DeleteCertCheckRequest) Execute() (*CertCheckResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4047,7 +5178,7 @@ func (r DeleteCertCheckRequest) Execute() (*CertCheckResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4093,14 +5224,51 @@ func (a *APIClient) DeleteCertCheckExecute(ctx context.Context, instanceId strin
 }
 
 type DeleteCredentialsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	username   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	username    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteCredentialsRequest) SetQueryParam(key, value string) ApiDeleteCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteCredentialsRequest) AddQueryParam(key, value string) ApiDeleteCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteCredentialsRequest) Clone() ApiDeleteCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteCredentialsRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteCredentials", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "username", Value: ParameterValueToString(r.username, "username")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteCredentialsRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -4125,6 +5293,15 @@ func (r DeleteCredentialsRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.username == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "username"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4143,7 +5320,8 @@ func (r DeleteCredentialsRequest) Execute() (*Message, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4174,10 +5352,14 @@ func (r DeleteCredentialsRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4188,7 +5370,7 @@ func (r DeleteCredentialsRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4199,7 +5381,7 @@ func (r DeleteCredentialsRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4210,7 +5392,7 @@ func (r DeleteCredentialsRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4256,14 +5438,51 @@ func (a *APIClient) DeleteCredentialsExecute(ctx context.Context, instanceId str
 }
 
 type DeleteCredentialsRemoteWriteConfigRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	username   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	username    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteCredentialsRemoteWriteConfigRequest) SetQueryParam(key, value string) ApiDeleteCredentialsRemoteWriteConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteCredentialsRemoteWriteConfigRequest) AddQueryParam(key, value string) ApiDeleteCredentialsRemoteWriteConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteCredentialsRemoteWriteConfigRequest) Clone() ApiDeleteCredentialsRemoteWriteConfigRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteCredentialsRemoteWriteConfigRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemoteWriteDeleteResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteCredentialsRemoteWriteConfig", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "username", Value: ParameterValueToString(r.username, "username")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteCredentialsRemoteWriteConfigRequest) execute() (*CredentialsRemoteWriteDeleteResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -4288,6 +5507,15 @@ func (r DeleteCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.username == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "username"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4306,7 +5534,8 @@ func (r DeleteCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteCredentialsRemoteWriteConfig"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4337,10 +5566,14 @@ func (r DeleteCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4351,7 +5584,7 @@ func (r DeleteCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4362,7 +5595,7 @@ func (r DeleteCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4373,7 +5606,7 @@ func (r DeleteCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4384,7 +5617,7 @@ func (r DeleteCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4430,14 +5663,51 @@ func (a *APIClient) DeleteCredentialsRemoteWriteConfigExecute(ctx context.Contex
 }
 
 type DeleteHttpCheckRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	id         string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	id          string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteHttpCheckRequest) SetQueryParam(key, value string) ApiDeleteHttpCheckRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteHttpCheckRequest) AddQueryParam(key, value string) ApiDeleteHttpCheckRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteHttpCheckRequest) Clone() ApiDeleteHttpCheckRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteHttpCheckRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteHttpCheck", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "id", Value: ParameterValueToString(r.id, "id")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteHttpCheckRequest) execute() (*HttpCheckResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -4462,6 +5732,15 @@ func (r DeleteHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.id == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "id"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4480,7 +5759,8 @@ func (r DeleteHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteHttpCheck"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4511,10 +5791,14 @@ func (r DeleteHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4525,7 +5809,7 @@ func (r DeleteHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4536,7 +5820,7 @@ func (r DeleteHttpCheckRequest) Execute() (*HttpCheckResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4582,13 +5866,50 @@ func (a *APIClient) DeleteHttpCheckExecute(ctx context.Context, instanceId strin
 }
 
 type DeleteInstanceRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteInstanceRequest) SetQueryParam(key, value string) ApiDeleteInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteInstanceRequest) AddQueryParam(key, value string) ApiDeleteInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteInstanceRequest) Clone() ApiDeleteInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteInstanceRequest) Execute() (*InstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteInstance", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteInstanceRequest) execute() (*InstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -4612,6 +5933,12 @@ func (r DeleteInstanceRequest) Execute() (*InstanceResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4630,7 +5957,8 @@ func (r DeleteInstanceRequest) Execute() (*InstanceResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4661,10 +5989,14 @@ func (r DeleteInstanceRequest) Execute() (*InstanceResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4675,7 +6007,7 @@ func (r DeleteInstanceRequest) Execute() (*InstanceResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4718,14 +6050,51 @@ func (a *APIClient) DeleteInstanceExecute(ctx context.Context, instanceId string
 }
 
 type DeleteLogsAlertgroupRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	groupName  string
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	groupName   string
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteLogsAlertgroupRequest) SetQueryParam(key, value string) ApiDeleteLogsAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteLogsAlertgroupRequest) AddQueryParam(key, value string) ApiDeleteLogsAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteLogsAlertgroupRequest) Clone() ApiDeleteLogsAlertgroupRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteLogsAlertgroupRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteLogsAlertgroup", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteLogsAlertgroupRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -4750,6 +6119,15 @@ func (r DeleteLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4768,7 +6146,8 @@ func (r DeleteLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteLogsAlertgroup"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4799,10 +6178,14 @@ func (r DeleteLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4813,7 +6196,7 @@ func (r DeleteLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4824,7 +6207,7 @@ func (r DeleteLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4835,7 +6218,7 @@ func (r DeleteLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -4881,14 +6264,51 @@ func (a *APIClient) DeleteLogsAlertgroupExecute(ctx context.Context, groupName s
 }
 
 type DeleteScrapeConfigRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	jobName    string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	jobName     string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteScrapeConfigRequest) SetQueryParam(key, value string) ApiDeleteScrapeConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteScrapeConfigRequest) AddQueryParam(key, value string) ApiDeleteScrapeConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteScrapeConfigRequest) Clone() ApiDeleteScrapeConfigRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteScrapeConfigRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteScrapeConfigRequest) Execute() (*DeleteScrapeConfigResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteScrapeConfig", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "jobName", Value: ParameterValueToString(r.jobName, "jobName")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r DeleteScrapeConfigRequest) execute() (*DeleteScrapeConfigResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
@@ -4913,6 +6333,15 @@ func (r DeleteScrapeConfigRequest) Execute() (*DeleteScrapeConfigResponse, error
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.jobName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "jobName"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4931,7 +6360,8 @@ func (r DeleteScrapeConfigRequest) Execute() (*DeleteScrapeConfigResponse, error
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteScrapeConfig"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -4962,10 +6392,14 @@ func (r DeleteScrapeConfigRequest) Execute() (*DeleteScrapeConfigResponse, error
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4976,7 +6410,7 @@ func (r DeleteScrapeConfigRequest) Execute() (*DeleteScrapeConfigResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -4987,7 +6421,7 @@ func (r DeleteScrapeConfigRequest) Execute() (*DeleteScrapeConfigResponse, error
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5033,14 +6467,51 @@ func (a *APIClient) DeleteScrapeConfigExecute(ctx context.Context, instanceId st
 }
 
 type GetAlertConfigReceiverRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	receiver   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	receiver    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetAlertConfigReceiverRequest) SetQueryParam(key, value string) ApiGetAlertConfigReceiverRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetAlertConfigReceiverRequest) AddQueryParam(key, value string) ApiGetAlertConfigReceiverRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetAlertConfigReceiverRequest) Clone() ApiGetAlertConfigReceiverRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetAlertConfigReceiverRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetAlertConfigReceiverRequest) Execute() (*Receiver, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetAlertConfigReceiver", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "receiver", Value: ParameterValueToString(r.receiver, "receiver")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetAlertConfigReceiverRequest) execute() (*Receiver, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -5065,6 +6536,15 @@ func (r GetAlertConfigReceiverRequest) Execute() (*Receiver, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.receiver == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "receiver"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5083,7 +6563,8 @@ func (r GetAlertConfigReceiverRequest) Execute() (*Receiver, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetAlertConfigReceiver"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5114,10 +6595,14 @@ func (r GetAlertConfigReceiverRequest) Execute() (*Receiver, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5128,7 +6613,7 @@ func (r GetAlertConfigReceiverRequest) Execute() (*Receiver, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5139,7 +6624,7 @@ func (r GetAlertConfigReceiverRequest) Execute() (*Receiver, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5185,14 +6670,51 @@ func (a *APIClient) GetAlertConfigReceiverExecute(ctx context.Context, instanceI
 }
 
 type GetAlertConfigRouteRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	receiver   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	receiver    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetAlertConfigRouteRequest) SetQueryParam(key, value string) ApiGetAlertConfigRouteRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetAlertConfigRouteRequest) AddQueryParam(key, value string) ApiGetAlertConfigRouteRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetAlertConfigRouteRequest) Clone() ApiGetAlertConfigRouteRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetAlertConfigRouteRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetAlertConfigRoute", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "receiver", Value: ParameterValueToString(r.receiver, "receiver")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetAlertConfigRouteRequest) execute() (*AlertConfigRouteResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -5217,6 +6739,15 @@ func (r GetAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.receiver == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "receiver"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5235,7 +6766,8 @@ func (r GetAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, error)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetAlertConfigRoute"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5266,10 +6798,14 @@ func (r GetAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5280,7 +6816,7 @@ func (r GetAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5326,13 +6862,50 @@ func (a *APIClient) GetAlertConfigRouteExecute(ctx context.Context, instanceId s
 }
 
 type GetAlertConfigsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetAlertConfigsRequest) SetQueryParam(key, value string) ApiGetAlertConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetAlertConfigsRequest) AddQueryParam(key, value string) ApiGetAlertConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetAlertConfigsRequest) Clone() ApiGetAlertConfigsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetAlertConfigsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetAlertConfigsRequest) Execute() (*GetAlertConfigsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetAlertConfigs", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetAlertConfigsRequest) execute() (*GetAlertConfigsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -5356,6 +6929,12 @@ func (r GetAlertConfigsRequest) Execute() (*GetAlertConfigsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5374,7 +6953,8 @@ func (r GetAlertConfigsRequest) Execute() (*GetAlertConfigsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetAlertConfigs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5405,10 +6985,14 @@ func (r GetAlertConfigsRequest) Execute() (*GetAlertConfigsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5419,7 +7003,7 @@ func (r GetAlertConfigsRequest) Execute() (*GetAlertConfigsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5462,14 +7046,51 @@ func (a *APIClient) GetAlertConfigsExecute(ctx context.Context, instanceId strin
 }
 
 type GetAlertgroupRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	groupName  string
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	groupName   string
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetAlertgroupRequest) SetQueryParam(key, value string) ApiGetAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetAlertgroupRequest) AddQueryParam(key, value string) ApiGetAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetAlertgroupRequest) Clone() ApiGetAlertgroupRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetAlertgroupRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetAlertgroup", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetAlertgroupRequest) execute() (*AlertGroupResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -5494,7 +7115,16 @@ func (r GetAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -5512,7 +7142,8 @@ func (r GetAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetAlertgroup"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5543,10 +7174,14 @@ func (r GetAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5557,7 +7192,7 @@ func (r GetAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5568,7 +7203,7 @@ func (r GetAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5579,7 +7214,7 @@ func (r GetAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5625,14 +7260,51 @@ func (a *APIClient) GetAlertgroupExecute(ctx context.Context, groupName string,
 }
 
 type GetCredentialsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	username   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	username    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetCredentialsRequest) SetQueryParam(key, value string) ApiGetCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetCredentialsRequest) AddQueryParam(key, value string) ApiGetCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetCredentialsRequest) Clone() ApiGetCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetCredentials", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "username", Value: ParameterValueToString(r.username, "username")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetCredentialsRequest) execute() (*GetCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -5657,6 +7329,15 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.username == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "username"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5675,7 +7356,8 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5706,10 +7388,14 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5720,7 +7406,7 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5731,7 +7417,7 @@ func (r GetCredentialsRequest) Execute() (*GetCredentialsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5777,14 +7463,51 @@ func (a *APIClient) GetCredentialsExecute(ctx context.Context, instanceId string
 }
 
 type GetCredentialsRemoteWriteConfigRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
-	username   string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	username    string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetCredentialsRemoteWriteConfigRequest) SetQueryParam(key, value string) ApiGetCredentialsRemoteWriteConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetCredentialsRemoteWriteConfigRequest) AddQueryParam(key, value string) ApiGetCredentialsRemoteWriteConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetCredentialsRemoteWriteConfigRequest) Clone() ApiGetCredentialsRemoteWriteConfigRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetCredentialsRemoteWriteConfigRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemoteWriteConfig, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetCredentialsRemoteWriteConfig", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "username", Value: ParameterValueToString(r.username, "username")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetCredentialsRemoteWriteConfigRequest) execute() (*CredentialsRemoteWriteConfig, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -5809,6 +7532,15 @@ func (r GetCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemoteWri
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.username == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "username"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5827,7 +7559,8 @@ func (r GetCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemoteWri
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetCredentialsRemoteWriteConfig"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -5858,10 +7591,14 @@ func (r GetCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemoteWri
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5872,7 +7609,7 @@ func (r GetCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemoteWri
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5883,7 +7620,7 @@ func (r GetCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemoteWri
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -5894,7 +7631,7 @@ func (r GetCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemoteWri
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -5940,13 +7677,50 @@ func (a *APIClient) GetCredentialsRemoteWriteConfigExecute(ctx context.Context,
 }
 
 type GetGrafanaConfigsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetGrafanaConfigsRequest) SetQueryParam(key, value string) ApiGetGrafanaConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetGrafanaConfigsRequest) AddQueryParam(key, value string) ApiGetGrafanaConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetGrafanaConfigsRequest) Clone() ApiGetGrafanaConfigsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetGrafanaConfigsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetGrafanaConfigsRequest) Execute() (*GrafanaConfigs, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetGrafanaConfigs", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetGrafanaConfigsRequest) execute() (*GrafanaConfigs, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -5970,6 +7744,12 @@ func (r GetGrafanaConfigsRequest) Execute() (*GrafanaConfigs, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5988,7 +7768,8 @@ func (r GetGrafanaConfigsRequest) Execute() (*GrafanaConfigs, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetGrafanaConfigs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -6019,10 +7800,14 @@ func (r GetGrafanaConfigsRequest) Execute() (*GrafanaConfigs, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -6033,7 +7818,7 @@ func (r GetGrafanaConfigsRequest) Execute() (*GrafanaConfigs, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -6076,13 +7861,50 @@ func (a *APIClient) GetGrafanaConfigsExecute(ctx context.Context, instanceId str
 }
 
 type GetInstanceRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetInstanceRequest) SetQueryParam(key, value string) ApiGetInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetInstanceRequest) AddQueryParam(key, value string) ApiGetInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetInstanceRequest) Clone() ApiGetInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetInstanceRequest) Execute() (*GetInstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetInstance", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetInstanceRequest) execute() (*GetInstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -6106,6 +7928,12 @@ func (r GetInstanceRequest) Execute() (*GetInstanceResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6124,7 +7952,8 @@ func (r GetInstanceRequest) Execute() (*GetInstanceResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -6155,10 +7984,14 @@ func (r GetInstanceRequest) Execute() (*GetInstanceResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -6169,7 +8002,7 @@ func (r GetInstanceRequest) Execute() (*GetInstanceResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -6212,14 +8045,51 @@ func (a *APIClient) GetInstanceExecute(ctx context.Context, instanceId string, p
 }
 
 type GetLogsAlertgroupRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	groupName  string
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	groupName   string
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetLogsAlertgroupRequest) SetQueryParam(key, value string) ApiGetLogsAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetLogsAlertgroupRequest) AddQueryParam(key, value string) ApiGetLogsAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetLogsAlertgroupRequest) Clone() ApiGetLogsAlertgroupRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetLogsAlertgroupRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetLogsAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetLogsAlertgroup", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetLogsAlertgroupRequest) execute() (*AlertGroupResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -6244,6 +8114,15 @@ func (r GetLogsAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6262,7 +8141,8 @@ func (r GetLogsAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetLogsAlertgroup"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -6293,10 +8173,14 @@ func (r GetLogsAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -6307,7 +8191,7 @@ func (r GetLogsAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -6318,7 +8202,7 @@ func (r GetLogsAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -6329,7 +8213,7 @@ func (r GetLogsAlertgroupRequest) Execute() (*AlertGroupResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -6375,13 +8259,50 @@ func (a *APIClient) GetLogsAlertgroupExecute(ctx context.Context, groupName stri
 }
 
 type GetLogsConfigsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetLogsConfigsRequest) SetQueryParam(key, value string) ApiGetLogsConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetLogsConfigsRequest) AddQueryParam(key, value string) ApiGetLogsConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetLogsConfigsRequest) Clone() ApiGetLogsConfigsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetLogsConfigsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetLogsConfigsRequest) Execute() (*LogsConfigResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetLogsConfigs", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetLogsConfigsRequest) execute() (*LogsConfigResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -6405,6 +8326,12 @@ func (r GetLogsConfigsRequest) Execute() (*LogsConfigResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6423,7 +8350,8 @@ func (r GetLogsConfigsRequest) Execute() (*LogsConfigResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetLogsConfigs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -6454,10 +8382,14 @@ func (r GetLogsConfigsRequest) Execute() (*LogsConfigResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -6468,7 +8400,7 @@ func (r GetLogsConfigsRequest) Execute() (*LogsConfigResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -6511,13 +8443,50 @@ func (a *APIClient) GetLogsConfigsExecute(ctx context.Context, instanceId string
 }
 
 type GetMetricsStorageRetentionRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetMetricsStorageRetentionRequest) SetQueryParam(key, value string) ApiGetMetricsStorageRetentionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetMetricsStorageRetentionRequest) AddQueryParam(key, value string) ApiGetMetricsStorageRetentionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetMetricsStorageRetentionRequest) Clone() ApiGetMetricsStorageRetentionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetMetricsStorageRetentionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetMetricsStorageRetentionRequest) Execute() (*GetMetricsStorageRetentionResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetMetricsStorageRetention", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetMetricsStorageRetentionRequest) execute() (*GetMetricsStorageRetentionResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -6541,6 +8510,12 @@ func (r GetMetricsStorageRetentionRequest) Execute() (*GetMetricsStorageRetentio
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6559,7 +8534,8 @@ func (r GetMetricsStorageRetentionRequest) Execute() (*GetMetricsStorageRetentio
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetMetricsStorageRetention"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -6590,10 +8566,14 @@ func (r GetMetricsStorageRetentionRequest) Execute() (*GetMetricsStorageRetentio
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -6604,7 +8584,7 @@ func (r GetMetricsStorageRetentionRequest) Execute() (*GetMetricsStorageRetentio
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -6647,14 +8627,51 @@ func (a *APIClient) GetMetricsStorageRetentionExecute(ctx context.Context, insta
 }
 
 type GetScrapeConfigRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	jobName    string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	jobName     string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetScrapeConfigRequest) SetQueryParam(key, value string) ApiGetScrapeConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetScrapeConfigRequest) AddQueryParam(key, value string) ApiGetScrapeConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetScrapeConfigRequest) Clone() ApiGetScrapeConfigRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetScrapeConfigRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetScrapeConfigRequest) Execute() (*GetScrapeConfigResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetScrapeConfig", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "jobName", Value: ParameterValueToString(r.jobName, "jobName")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetScrapeConfigRequest) execute() (*GetScrapeConfigResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -6679,6 +8696,15 @@ func (r GetScrapeConfigRequest) Execute() (*GetScrapeConfigResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.jobName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "jobName"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6697,7 +8723,8 @@ func (r GetScrapeConfigRequest) Execute() (*GetScrapeConfigResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetScrapeConfig"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -6728,10 +8755,14 @@ func (r GetScrapeConfigRequest) Execute() (*GetScrapeConfigResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -6742,7 +8773,7 @@ func (r GetScrapeConfigRequest) Execute() (*GetScrapeConfigResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -6788,13 +8819,50 @@ func (a *APIClient) GetScrapeConfigExecute(ctx context.Context, instanceId strin
 }
 
 type GetTracesConfigsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetTracesConfigsRequest) SetQueryParam(key, value string) ApiGetTracesConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetTracesConfigsRequest) AddQueryParam(key, value string) ApiGetTracesConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetTracesConfigsRequest) Clone() ApiGetTracesConfigsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetTracesConfigsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetTracesConfigsRequest) Execute() (*TracesConfigResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetTracesConfigs", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetTracesConfigsRequest) execute() (*TracesConfigResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -6818,6 +8886,12 @@ func (r GetTracesConfigsRequest) Execute() (*TracesConfigResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6836,7 +8910,8 @@ func (r GetTracesConfigsRequest) Execute() (*TracesConfigResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetTracesConfigs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -6867,10 +8942,14 @@ func (r GetTracesConfigsRequest) Execute() (*TracesConfigResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -6881,7 +8960,7 @@ func (r GetTracesConfigsRequest) Execute() (*TracesConfigResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -6924,13 +9003,50 @@ func (a *APIClient) GetTracesConfigsExecute(ctx context.Context, instanceId stri
 }
 
 type ListACLRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListACLRequest) SetQueryParam(key, value string) ApiListACLRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListACLRequest) AddQueryParam(key, value string) ApiListACLRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListACLRequest) Clone() ApiListACLRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListACLRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListACLRequest) Execute() (*ListACLResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListACL", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListACLRequest) execute() (*ListACLResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -6954,6 +9070,12 @@ func (r ListACLRequest) Execute() (*ListACLResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6972,7 +9094,8 @@ func (r ListACLRequest) Execute() (*ListACLResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListACL"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -6991,6 +9114,10 @@ func (r ListACLRequest) Execute() (*ListACLResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -7003,10 +9130,14 @@ func (r ListACLRequest) Execute() (*ListACLResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -7017,7 +9148,7 @@ func (r ListACLRequest) Execute() (*ListACLResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -7060,13 +9191,50 @@ func (a *APIClient) ListACLExecute(ctx context.Context, instanceId string, proje
 }
 
 type ListAlertConfigReceiversRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListAlertConfigReceiversRequest) SetQueryParam(key, value string) ApiListAlertConfigReceiversRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListAlertConfigReceiversRequest) AddQueryParam(key, value string) ApiListAlertConfigReceiversRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListAlertConfigReceiversRequest) Clone() ApiListAlertConfigReceiversRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListAlertConfigReceiversRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListAlertConfigReceiversRequest) Execute() (*AlertConfigReceiversResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListAlertConfigReceivers", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListAlertConfigReceiversRequest) execute() (*AlertConfigReceiversResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -7090,6 +9258,12 @@ func (r ListAlertConfigReceiversRequest) Execute() (*AlertConfigReceiversRespons
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -7108,7 +9282,8 @@ func (r ListAlertConfigReceiversRequest) Execute() (*AlertConfigReceiversRespons
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListAlertConfigReceivers"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -7127,6 +9302,10 @@ func (r ListAlertConfigReceiversRequest) Execute() (*AlertConfigReceiversRespons
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -7139,10 +9318,14 @@ func (r ListAlertConfigReceiversRequest) Execute() (*AlertConfigReceiversRespons
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -7153,7 +9336,7 @@ func (r ListAlertConfigReceiversRequest) Execute() (*AlertConfigReceiversRespons
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -7196,13 +9379,50 @@ func (a *APIClient) ListAlertConfigReceiversExecute(ctx context.Context, instanc
 }
 
 type ListAlertConfigRoutesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListAlertConfigRoutesRequest) SetQueryParam(key, value string) ApiListAlertConfigRoutesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListAlertConfigRoutesRequest) AddQueryParam(key, value string) ApiListAlertConfigRoutesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListAlertConfigRoutesRequest) Clone() ApiListAlertConfigRoutesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListAlertConfigRoutesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListAlertConfigRoutesRequest) Execute() (*AlertConfigRouteResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListAlertConfigRoutes", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListAlertConfigRoutesRequest) execute() (*AlertConfigRouteResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -7226,6 +9446,12 @@ func (r ListAlertConfigRoutesRequest) Execute() (*AlertConfigRouteResponse, erro
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -7244,7 +9470,8 @@ func (r ListAlertConfigRoutesRequest) Execute() (*AlertConfigRouteResponse, erro
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListAlertConfigRoutes"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -7263,6 +9490,10 @@ func (r ListAlertConfigRoutesRequest) Execute() (*AlertConfigRouteResponse, erro
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -7275,10 +9506,14 @@ func (r ListAlertConfigRoutesRequest) Execute() (*AlertConfigRouteResponse, erro
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -7289,7 +9524,7 @@ func (r ListAlertConfigRoutesRequest) Execute() (*AlertConfigRouteResponse, erro
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -7332,13 +9567,50 @@ func (a *APIClient) ListAlertConfigRoutesExecute(ctx context.Context, instanceId
 }
 
 type ListAlertgroupsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListAlertgroupsRequest) SetQueryParam(key, value string) ApiListAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListAlertgroupsRequest) AddQueryParam(key, value string) ApiListAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListAlertgroupsRequest) Clone() ApiListAlertgroupsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListAlertgroupsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListAlertgroups", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListAlertgroupsRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -7362,6 +9634,12 @@ func (r ListAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -7380,7 +9658,8 @@ func (r ListAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListAlertgroups"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -7399,6 +9678,10 @@ func (r ListAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -7411,10 +9694,14 @@ func (r ListAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -7425,7 +9712,7 @@ func (r ListAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -7436,7 +9723,7 @@ func (r ListAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -7479,14 +9766,51 @@ func (a *APIClient) ListAlertgroupsExecute(ctx context.Context, instanceId strin
 }
 
 type ListAlertrulesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	groupName  string
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	groupName   string
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListAlertrulesRequest) SetQueryParam(key, value string) ApiListAlertrulesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListAlertrulesRequest) AddQueryParam(key, value string) ApiListAlertrulesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListAlertrulesRequest) Clone() ApiListAlertrulesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListAlertrulesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListAlertrules", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListAlertrulesRequest) execute() (*AlertRulesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -7511,6 +9835,15 @@ func (r ListAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -7529,7 +9862,8 @@ func (r ListAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListAlertrules"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -7548,6 +9882,10 @@ func (r ListAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -7560,10 +9898,14 @@ func (r ListAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -7574,7 +9916,7 @@ func (r ListAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -7620,13 +9962,50 @@ func (a *APIClient) ListAlertrulesExecute(ctx context.Context, groupName string,
 }
 
 type ListCertChecksRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListCertChecksRequest) SetQueryParam(key, value string) ApiListCertChecksRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListCertChecksRequest) AddQueryParam(key, value string) ApiListCertChecksRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListCertChecksRequest) Clone() ApiListCertChecksRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListCertChecksRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListCertChecksRequest) Execute() (*CertCheckResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListCertChecks", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListCertChecksRequest) execute() (*CertCheckResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -7650,6 +10029,12 @@ func (r ListCertChecksRequest) Execute() (*CertCheckResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -7668,7 +10053,8 @@ func (r ListCertChecksRequest) Execute() (*CertCheckResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListCertChecks"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -7687,6 +10073,10 @@ func (r ListCertChecksRequest) Execute() (*CertCheckResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -7699,10 +10089,14 @@ func (r ListCertChecksRequest) Execute() (*CertCheckResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -7713,7 +10107,7 @@ func (r ListCertChecksRequest) Execute() (*CertCheckResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -7756,13 +10150,50 @@ func (a *APIClient) ListCertChecksExecute(ctx context.Context, instanceId string
 }
 
 type ListCredentialsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListCredentialsRequest) SetQueryParam(key, value string) ApiListCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListCredentialsRequest) AddQueryParam(key, value string) ApiListCredentialsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListCredentialsRequest) Clone() ApiListCredentialsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListCredentialsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListCredentials", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListCredentialsRequest) execute() (*ListCredentialsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -7786,6 +10217,12 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -7804,7 +10241,8 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListCredentials"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -7823,6 +10261,10 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -7835,10 +10277,14 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -7849,7 +10295,7 @@ func (r ListCredentialsRequest) Execute() (*ListCredentialsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -7892,13 +10338,50 @@ func (a *APIClient) ListCredentialsExecute(ctx context.Context, instanceId strin
 }
 
 type ListHttpChecksRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListHttpChecksRequest) SetQueryParam(key, value string) ApiListHttpChecksRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListHttpChecksRequest) AddQueryParam(key, value string) ApiListHttpChecksRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListHttpChecksRequest) Clone() ApiListHttpChecksRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListHttpChecksRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListHttpChecksRequest) Execute() (*HttpCheckResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListHttpChecks", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListHttpChecksRequest) execute() (*HttpCheckResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -7922,6 +10405,12 @@ func (r ListHttpChecksRequest) Execute() (*HttpCheckResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -7940,7 +10429,8 @@ func (r ListHttpChecksRequest) Execute() (*HttpCheckResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListHttpChecks"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -7959,6 +10449,10 @@ func (r ListHttpChecksRequest) Execute() (*HttpCheckResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -7971,10 +10465,14 @@ func (r ListHttpChecksRequest) Execute() (*HttpCheckResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -7985,7 +10483,7 @@ func (r ListHttpChecksRequest) Execute() (*HttpCheckResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -8028,12 +10526,49 @@ func (a *APIClient) ListHttpChecksExecute(ctx context.Context, instanceId string
 }
 
 type ListInstancesRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListInstancesRequest) SetQueryParam(key, value string) ApiListInstancesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListInstancesRequest) AddQueryParam(key, value string) ApiListInstancesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListInstancesRequest) Clone() ApiListInstancesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListInstancesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListInstances", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListInstancesRequest) execute() (*ListInstancesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -8056,6 +10591,9 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -8074,7 +10612,8 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListInstances"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -8093,6 +10632,10 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -8105,10 +10648,14 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8119,7 +10666,7 @@ func (r ListInstancesRequest) Execute() (*ListInstancesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -8159,13 +10706,50 @@ func (a *APIClient) ListInstancesExecute(ctx context.Context, projectId string)
 }
 
 type ListLogsAlertgroupsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListLogsAlertgroupsRequest) SetQueryParam(key, value string) ApiListLogsAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListLogsAlertgroupsRequest) AddQueryParam(key, value string) ApiListLogsAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListLogsAlertgroupsRequest) Clone() ApiListLogsAlertgroupsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListLogsAlertgroupsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListLogsAlertgroups", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListLogsAlertgroupsRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -8189,6 +10773,12 @@ func (r ListLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -8207,7 +10797,8 @@ func (r ListLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListLogsAlertgroups"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -8226,6 +10817,10 @@ func (r ListLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -8238,10 +10833,14 @@ func (r ListLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8252,7 +10851,7 @@ func (r ListLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8263,7 +10862,7 @@ func (r ListLogsAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -8306,12 +10905,49 @@ func (a *APIClient) ListLogsAlertgroupsExecute(ctx context.Context, instanceId s
 }
 
 type ListPlansRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListPlansRequest) SetQueryParam(key, value string) ApiListPlansRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListPlansRequest) AddQueryParam(key, value string) ApiListPlansRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListPlansRequest) Clone() ApiListPlansRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListPlansRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListPlansRequest) Execute() (*PlansResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListPlans", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListPlansRequest) execute() (*PlansResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -8334,6 +10970,9 @@ func (r ListPlansRequest) Execute() (*PlansResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -8352,7 +10991,8 @@ func (r ListPlansRequest) Execute() (*PlansResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListPlans"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -8371,6 +11011,10 @@ func (r ListPlansRequest) Execute() (*PlansResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -8383,10 +11027,14 @@ func (r ListPlansRequest) Execute() (*PlansResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8397,7 +11045,7 @@ func (r ListPlansRequest) Execute() (*PlansResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -8437,13 +11085,50 @@ func (a *APIClient) ListPlansExecute(ctx context.Context, projectId string) (*Pl
 }
 
 type ListScrapeConfigsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	instanceId string
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	instanceId  string
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListScrapeConfigsRequest) SetQueryParam(key, value string) ApiListScrapeConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListScrapeConfigsRequest) AddQueryParam(key, value string) ApiListScrapeConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListScrapeConfigsRequest) Clone() ApiListScrapeConfigsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListScrapeConfigsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListScrapeConfigsRequest) Execute() (*ListScrapeConfigsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListScrapeConfigs", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListScrapeConfigsRequest) execute() (*ListScrapeConfigsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -8467,6 +11152,12 @@ func (r ListScrapeConfigsRequest) Execute() (*ListScrapeConfigsResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -8485,7 +11176,8 @@ func (r ListScrapeConfigsRequest) Execute() (*ListScrapeConfigsResponse, error)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListScrapeConfigs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -8504,6 +11196,10 @@ func (r ListScrapeConfigsRequest) Execute() (*ListScrapeConfigsResponse, error)
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -8516,10 +11212,14 @@ func (r ListScrapeConfigsRequest) Execute() (*ListScrapeConfigsResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8530,7 +11230,7 @@ func (r ListScrapeConfigsRequest) Execute() (*ListScrapeConfigsResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -8578,6 +11278,7 @@ type PartialUpdateAlertgroupsRequest struct {
 	instanceId                    string
 	projectId                     string
 	updateAlertgroupsRequestInner *[]UpdateAlertgroupsRequestInner
+	queryParams                   []queryParam
 }
 
 func (r PartialUpdateAlertgroupsRequest) UpdateAlertgroupsRequestInner(updateAlertgroupsRequestInner []UpdateAlertgroupsRequestInner) ApiPartialUpdateAlertgroupsRequest {
@@ -8585,7 +11286,43 @@ func (r PartialUpdateAlertgroupsRequest) UpdateAlertgroupsRequestInner(updateAle
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateAlertgroupsRequest) SetQueryParam(key, value string) ApiPartialUpdateAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateAlertgroupsRequest) AddQueryParam(key, value string) ApiPartialUpdateAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateAlertgroupsRequest) Clone() ApiPartialUpdateAlertgroupsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateAlertgroupsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateAlertgroups", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateAlertgroupsRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -8609,8 +11346,14 @@ func (r PartialUpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error)
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateAlertgroupsRequestInner == nil {
-		return localVarReturnValue, fmt.Errorf("updateAlertgroupsRequestInner is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateAlertgroupsRequestInner"}
 	}
 
 	// to determine the Content-Type header
@@ -8632,7 +11375,8 @@ func (r PartialUpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error)
 	}
 	// body params
 	localVarPostBody = r.updateAlertgroupsRequestInner
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateAlertgroups"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -8663,10 +11407,14 @@ func (r PartialUpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8677,7 +11425,7 @@ func (r PartialUpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8688,7 +11436,7 @@ func (r PartialUpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -8737,6 +11485,7 @@ type PartialUpdateAlertrulesRequest struct {
 	instanceId                              string
 	projectId                               string
 	updateAlertgroupsRequestInnerRulesInner *[]UpdateAlertgroupsRequestInnerRulesInner
+	queryParams                             []queryParam
 }
 
 func (r PartialUpdateAlertrulesRequest) UpdateAlertgroupsRequestInnerRulesInner(updateAlertgroupsRequestInnerRulesInner []UpdateAlertgroupsRequestInnerRulesInner) ApiPartialUpdateAlertrulesRequest {
@@ -8744,7 +11493,43 @@ func (r PartialUpdateAlertrulesRequest) UpdateAlertgroupsRequestInnerRulesInner(
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateAlertrulesRequest) SetQueryParam(key, value string) ApiPartialUpdateAlertrulesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateAlertrulesRequest) AddQueryParam(key, value string) ApiPartialUpdateAlertrulesRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateAlertrulesRequest) Clone() ApiPartialUpdateAlertrulesRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateAlertrulesRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateAlertrules", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateAlertrulesRequest) execute() (*AlertRulesResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -8769,8 +11554,17 @@ func (r PartialUpdateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateAlertgroupsRequestInnerRulesInner == nil {
-		return localVarReturnValue, fmt.Errorf("updateAlertgroupsRequestInnerRulesInner is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateAlertgroupsRequestInnerRulesInner"}
 	}
 
 	// to determine the Content-Type header
@@ -8792,7 +11586,8 @@ func (r PartialUpdateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateAlertgroupsRequestInnerRulesInner
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateAlertrules"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -8823,10 +11618,14 @@ func (r PartialUpdateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8837,7 +11636,7 @@ func (r PartialUpdateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8848,7 +11647,7 @@ func (r PartialUpdateAlertrulesRequest) Execute() (*AlertRulesResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -8899,6 +11698,7 @@ type UpdateACLRequest struct {
 	instanceId       string
 	projectId        string
 	updateACLPayload *UpdateACLPayload
+	queryParams      []queryParam
 }
 
 func (r UpdateACLRequest) UpdateACLPayload(updateACLPayload UpdateACLPayload) ApiUpdateACLRequest {
@@ -8906,7 +11706,43 @@ func (r UpdateACLRequest) UpdateACLPayload(updateACLPayload UpdateACLPayload) Ap
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateACLRequest) SetQueryParam(key, value string) ApiUpdateACLRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateACLRequest) AddQueryParam(key, value string) ApiUpdateACLRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateACLRequest) Clone() ApiUpdateACLRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateACLRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateACLRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateACL", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateACLRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -8930,6 +11766,12 @@ func (r UpdateACLRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -8950,7 +11792,8 @@ func (r UpdateACLRequest) Execute() (*Message, error) {
 	}
 	// body params
 	localVarPostBody = r.updateACLPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateACL"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -8981,10 +11824,14 @@ func (r UpdateACLRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -8995,7 +11842,7 @@ func (r UpdateACLRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9006,7 +11853,7 @@ func (r UpdateACLRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -9055,6 +11902,7 @@ type UpdateAlertConfigReceiverRequest struct {
 	projectId                        string
 	receiver                         string
 	updateAlertConfigReceiverPayload *UpdateAlertConfigReceiverPayload
+	queryParams                      []queryParam
 }
 
 func (r UpdateAlertConfigReceiverRequest) UpdateAlertConfigReceiverPayload(updateAlertConfigReceiverPayload UpdateAlertConfigReceiverPayload) ApiUpdateAlertConfigReceiverRequest {
@@ -9062,7 +11910,43 @@ func (r UpdateAlertConfigReceiverRequest) UpdateAlertConfigReceiverPayload(updat
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateAlertConfigReceiverRequest) SetQueryParam(key, value string) ApiUpdateAlertConfigReceiverRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateAlertConfigReceiverRequest) AddQueryParam(key, value string) ApiUpdateAlertConfigReceiverRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateAlertConfigReceiverRequest) Clone() ApiUpdateAlertConfigReceiverRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateAlertConfigReceiverRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateAlertConfigReceiver", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "receiver", Value: ParameterValueToString(r.receiver, "receiver")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateAlertConfigReceiverRequest) execute() (*AlertConfigReceiversResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -9087,8 +11971,17 @@ func (r UpdateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.receiver == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "receiver"}
+	}
 	if r.updateAlertConfigReceiverPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateAlertConfigReceiverPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateAlertConfigReceiverPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -9110,7 +12003,8 @@ func (r UpdateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 	}
 	// body params
 	localVarPostBody = r.updateAlertConfigReceiverPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateAlertConfigReceiver"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -9141,10 +12035,14 @@ func (r UpdateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9155,7 +12053,7 @@ func (r UpdateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9166,7 +12064,7 @@ func (r UpdateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9177,7 +12075,7 @@ func (r UpdateAlertConfigReceiverRequest) Execute() (*AlertConfigReceiversRespon
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -9229,6 +12127,7 @@ type UpdateAlertConfigRouteRequest struct {
 	projectId                     string
 	receiver                      string
 	updateAlertConfigRoutePayload *UpdateAlertConfigRoutePayload
+	queryParams                   []queryParam
 }
 
 func (r UpdateAlertConfigRouteRequest) UpdateAlertConfigRoutePayload(updateAlertConfigRoutePayload UpdateAlertConfigRoutePayload) ApiUpdateAlertConfigRouteRequest {
@@ -9236,7 +12135,43 @@ func (r UpdateAlertConfigRouteRequest) UpdateAlertConfigRoutePayload(updateAlert
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateAlertConfigRouteRequest) SetQueryParam(key, value string) ApiUpdateAlertConfigRouteRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateAlertConfigRouteRequest) AddQueryParam(key, value string) ApiUpdateAlertConfigRouteRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateAlertConfigRouteRequest) Clone() ApiUpdateAlertConfigRouteRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateAlertConfigRouteRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateAlertConfigRoute", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "receiver", Value: ParameterValueToString(r.receiver, "receiver")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateAlertConfigRouteRequest) execute() (*AlertConfigRouteResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -9261,8 +12196,17 @@ func (r UpdateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.receiver == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "receiver"}
+	}
 	if r.updateAlertConfigRoutePayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateAlertConfigRoutePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateAlertConfigRoutePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -9284,7 +12228,8 @@ func (r UpdateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 	}
 	// body params
 	localVarPostBody = r.updateAlertConfigRoutePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateAlertConfigRoute"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -9315,10 +12260,14 @@ func (r UpdateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9329,7 +12278,7 @@ func (r UpdateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9340,7 +12289,7 @@ func (r UpdateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9351,7 +12300,7 @@ func (r UpdateAlertConfigRouteRequest) Execute() (*AlertConfigRouteResponse, err
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -9402,6 +12351,7 @@ type UpdateAlertConfigsRequest struct {
 	instanceId                string
 	projectId                 string
 	updateAlertConfigsPayload *UpdateAlertConfigsPayload
+	queryParams               []queryParam
 }
 
 func (r UpdateAlertConfigsRequest) UpdateAlertConfigsPayload(updateAlertConfigsPayload UpdateAlertConfigsPayload) ApiUpdateAlertConfigsRequest {
@@ -9409,7 +12359,43 @@ func (r UpdateAlertConfigsRequest) UpdateAlertConfigsPayload(updateAlertConfigsP
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateAlertConfigsRequest) SetQueryParam(key, value string) ApiUpdateAlertConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateAlertConfigsRequest) AddQueryParam(key, value string) ApiUpdateAlertConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateAlertConfigsRequest) Clone() ApiUpdateAlertConfigsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateAlertConfigsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateAlertConfigsRequest) Execute() (*UpdateAlertConfigsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateAlertConfigs", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateAlertConfigsRequest) execute() (*UpdateAlertConfigsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -9433,8 +12419,14 @@ func (r UpdateAlertConfigsRequest) Execute() (*UpdateAlertConfigsResponse, error
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateAlertConfigsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateAlertConfigsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateAlertConfigsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -9456,7 +12448,8 @@ func (r UpdateAlertConfigsRequest) Execute() (*UpdateAlertConfigsResponse, error
 	}
 	// body params
 	localVarPostBody = r.updateAlertConfigsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateAlertConfigs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -9487,10 +12480,14 @@ func (r UpdateAlertConfigsRequest) Execute() (*UpdateAlertConfigsResponse, error
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9501,7 +12498,7 @@ func (r UpdateAlertConfigsRequest) Execute() (*UpdateAlertConfigsResponse, error
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9512,7 +12509,7 @@ func (r UpdateAlertConfigsRequest) Execute() (*UpdateAlertConfigsResponse, error
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -9561,6 +12558,7 @@ type UpdateAlertgroupRequest struct {
 	instanceId              string
 	projectId               string
 	updateAlertgroupPayload *UpdateAlertgroupPayload
+	queryParams             []queryParam
 }
 
 func (r UpdateAlertgroupRequest) UpdateAlertgroupPayload(updateAlertgroupPayload UpdateAlertgroupPayload) ApiUpdateAlertgroupRequest {
@@ -9568,7 +12566,43 @@ func (r UpdateAlertgroupRequest) UpdateAlertgroupPayload(updateAlertgroupPayload
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateAlertgroupRequest) SetQueryParam(key, value string) ApiUpdateAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateAlertgroupRequest) AddQueryParam(key, value string) ApiUpdateAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateAlertgroupRequest) Clone() ApiUpdateAlertgroupRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateAlertgroupRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateAlertgroup", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateAlertgroupRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -9593,8 +12627,17 @@ func (r UpdateAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateAlertgroupPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateAlertgroupPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateAlertgroupPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -9616,7 +12659,8 @@ func (r UpdateAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateAlertgroupPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateAlertgroup"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -9647,10 +12691,14 @@ func (r UpdateAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9661,7 +12709,7 @@ func (r UpdateAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9672,7 +12720,7 @@ func (r UpdateAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9683,7 +12731,7 @@ func (r UpdateAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -9734,6 +12782,7 @@ type UpdateAlertgroupsRequest struct {
 	instanceId                    string
 	projectId                     string
 	updateAlertgroupsRequestInner *[]UpdateAlertgroupsRequestInner
+	queryParams                   []queryParam
 }
 
 func (r UpdateAlertgroupsRequest) UpdateAlertgroupsRequestInner(updateAlertgroupsRequestInner []UpdateAlertgroupsRequestInner) ApiUpdateAlertgroupsRequest {
@@ -9741,7 +12790,43 @@ func (r UpdateAlertgroupsRequest) UpdateAlertgroupsRequestInner(updateAlertgroup
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateAlertgroupsRequest) SetQueryParam(key, value string) ApiUpdateAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateAlertgroupsRequest) AddQueryParam(key, value string) ApiUpdateAlertgroupsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateAlertgroupsRequest) Clone() ApiUpdateAlertgroupsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateAlertgroupsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateAlertgroups", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateAlertgroupsRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -9765,8 +12850,14 @@ func (r UpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateAlertgroupsRequestInner == nil {
-		return localVarReturnValue, fmt.Errorf("updateAlertgroupsRequestInner is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateAlertgroupsRequestInner"}
 	}
 
 	// to determine the Content-Type header
@@ -9788,7 +12879,8 @@ func (r UpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateAlertgroupsRequestInner
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateAlertgroups"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -9819,10 +12911,14 @@ func (r UpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9833,7 +12929,7 @@ func (r UpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9844,7 +12940,7 @@ func (r UpdateAlertgroupsRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -9893,6 +12989,7 @@ type UpdateCredentialsRemoteWriteConfigRequest struct {
 	projectId                                 string
 	username                                  string
 	updateCredentialsRemoteWriteConfigPayload *UpdateCredentialsRemoteWriteConfigPayload
+	queryParams                               []queryParam
 }
 
 func (r UpdateCredentialsRemoteWriteConfigRequest) UpdateCredentialsRemoteWriteConfigPayload(updateCredentialsRemoteWriteConfigPayload UpdateCredentialsRemoteWriteConfigPayload) ApiUpdateCredentialsRemoteWriteConfigRequest {
@@ -9900,7 +12997,43 @@ func (r UpdateCredentialsRemoteWriteConfigRequest) UpdateCredentialsRemoteWriteC
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateCredentialsRemoteWriteConfigRequest) SetQueryParam(key, value string) ApiUpdateCredentialsRemoteWriteConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateCredentialsRemoteWriteConfigRequest) AddQueryParam(key, value string) ApiUpdateCredentialsRemoteWriteConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateCredentialsRemoteWriteConfigRequest) Clone() ApiUpdateCredentialsRemoteWriteConfigRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateCredentialsRemoteWriteConfigRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemoteWriteConfig, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateCredentialsRemoteWriteConfig", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "username", Value: ParameterValueToString(r.username, "username")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateCredentialsRemoteWriteConfigRequest) execute() (*CredentialsRemoteWriteConfig, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -9925,8 +13058,17 @@ func (r UpdateCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.username == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "username"}
+	}
 	if r.updateCredentialsRemoteWriteConfigPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateCredentialsRemoteWriteConfigPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateCredentialsRemoteWriteConfigPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -9948,7 +13090,8 @@ func (r UpdateCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 	}
 	// body params
 	localVarPostBody = r.updateCredentialsRemoteWriteConfigPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateCredentialsRemoteWriteConfig"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -9979,10 +13122,14 @@ func (r UpdateCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -9993,7 +13140,7 @@ func (r UpdateCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10004,7 +13151,7 @@ func (r UpdateCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10015,7 +13162,7 @@ func (r UpdateCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 		}
 		if localVarHTTPResponse.StatusCode == 502 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10026,7 +13173,7 @@ func (r UpdateCredentialsRemoteWriteConfigRequest) Execute() (*CredentialsRemote
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -10077,6 +13224,7 @@ type UpdateGrafanaConfigsRequest struct {
 	instanceId                  string
 	projectId                   string
 	updateGrafanaConfigsPayload *UpdateGrafanaConfigsPayload
+	queryParams                 []queryParam
 }
 
 func (r UpdateGrafanaConfigsRequest) UpdateGrafanaConfigsPayload(updateGrafanaConfigsPayload UpdateGrafanaConfigsPayload) ApiUpdateGrafanaConfigsRequest {
@@ -10084,7 +13232,43 @@ func (r UpdateGrafanaConfigsRequest) UpdateGrafanaConfigsPayload(updateGrafanaCo
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateGrafanaConfigsRequest) SetQueryParam(key, value string) ApiUpdateGrafanaConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateGrafanaConfigsRequest) AddQueryParam(key, value string) ApiUpdateGrafanaConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateGrafanaConfigsRequest) Clone() ApiUpdateGrafanaConfigsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateGrafanaConfigsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateGrafanaConfigsRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateGrafanaConfigs", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateGrafanaConfigsRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -10108,8 +13292,14 @@ func (r UpdateGrafanaConfigsRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateGrafanaConfigsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateGrafanaConfigsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateGrafanaConfigsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -10131,7 +13321,8 @@ func (r UpdateGrafanaConfigsRequest) Execute() (*Message, error) {
 	}
 	// body params
 	localVarPostBody = r.updateGrafanaConfigsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateGrafanaConfigs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -10162,10 +13353,14 @@ func (r UpdateGrafanaConfigsRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10176,7 +13371,7 @@ func (r UpdateGrafanaConfigsRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10187,7 +13382,7 @@ func (r UpdateGrafanaConfigsRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -10235,6 +13430,7 @@ type UpdateInstanceRequest struct {
 	instanceId            string
 	projectId             string
 	updateInstancePayload *UpdateInstancePayload
+	queryParams           []queryParam
 }
 
 func (r UpdateInstanceRequest) UpdateInstancePayload(updateInstancePayload UpdateInstancePayload) ApiUpdateInstanceRequest {
@@ -10242,7 +13438,43 @@ func (r UpdateInstanceRequest) UpdateInstancePayload(updateInstancePayload Updat
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateInstanceRequest) SetQueryParam(key, value string) ApiUpdateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateInstanceRequest) AddQueryParam(key, value string) ApiUpdateInstanceRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateInstanceRequest) Clone() ApiUpdateInstanceRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateInstanceRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateInstanceRequest) Execute() (*InstanceResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateInstance", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateInstanceRequest) execute() (*InstanceResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -10266,8 +13498,14 @@ func (r UpdateInstanceRequest) Execute() (*InstanceResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateInstancePayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateInstancePayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateInstancePayload"}
 	}
 
 	// to determine the Content-Type header
@@ -10289,7 +13527,8 @@ func (r UpdateInstanceRequest) Execute() (*InstanceResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateInstancePayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateInstance"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -10320,10 +13559,14 @@ func (r UpdateInstanceRequest) Execute() (*InstanceResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10334,7 +13577,7 @@ func (r UpdateInstanceRequest) Execute() (*InstanceResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10345,7 +13588,7 @@ func (r UpdateInstanceRequest) Execute() (*InstanceResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -10394,6 +13637,7 @@ type UpdateLogsAlertgroupRequest struct {
 	instanceId                  string
 	projectId                   string
 	updateLogsAlertgroupPayload *UpdateLogsAlertgroupPayload
+	queryParams                 []queryParam
 }
 
 func (r UpdateLogsAlertgroupRequest) UpdateLogsAlertgroupPayload(updateLogsAlertgroupPayload UpdateLogsAlertgroupPayload) ApiUpdateLogsAlertgroupRequest {
@@ -10401,7 +13645,43 @@ func (r UpdateLogsAlertgroupRequest) UpdateLogsAlertgroupPayload(updateLogsAlert
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateLogsAlertgroupRequest) SetQueryParam(key, value string) ApiUpdateLogsAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateLogsAlertgroupRequest) AddQueryParam(key, value string) ApiUpdateLogsAlertgroupRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateLogsAlertgroupRequest) Clone() ApiUpdateLogsAlertgroupRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateLogsAlertgroupRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateLogsAlertgroup", oapierror.Parameter{Name: "groupName", Value: ParameterValueToString(r.groupName, "groupName")}, oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateLogsAlertgroupRequest) execute() (*AlertGroupsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -10426,8 +13706,17 @@ func (r UpdateLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.groupName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "groupName"}
+	}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateLogsAlertgroupPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateLogsAlertgroupPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateLogsAlertgroupPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -10449,7 +13738,8 @@ func (r UpdateLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateLogsAlertgroupPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateLogsAlertgroup"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -10480,10 +13770,14 @@ func (r UpdateLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10494,7 +13788,7 @@ func (r UpdateLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10505,7 +13799,7 @@ func (r UpdateLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10516,7 +13810,7 @@ func (r UpdateLogsAlertgroupRequest) Execute() (*AlertGroupsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -10567,6 +13861,7 @@ type UpdateLogsConfigsRequest struct {
 	instanceId               string
 	projectId                string
 	updateLogsConfigsPayload *UpdateLogsConfigsPayload
+	queryParams              []queryParam
 }
 
 func (r UpdateLogsConfigsRequest) UpdateLogsConfigsPayload(updateLogsConfigsPayload UpdateLogsConfigsPayload) ApiUpdateLogsConfigsRequest {
@@ -10574,7 +13869,43 @@ func (r UpdateLogsConfigsRequest) UpdateLogsConfigsPayload(updateLogsConfigsPayl
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateLogsConfigsRequest) SetQueryParam(key, value string) ApiUpdateLogsConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateLogsConfigsRequest) AddQueryParam(key, value string) ApiUpdateLogsConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateLogsConfigsRequest) Clone() ApiUpdateLogsConfigsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateLogsConfigsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateLogsConfigsRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateLogsConfigs", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateLogsConfigsRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -10598,8 +13929,14 @@ func (r UpdateLogsConfigsRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateLogsConfigsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateLogsConfigsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateLogsConfigsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -10621,7 +13958,8 @@ func (r UpdateLogsConfigsRequest) Execute() (*Message, error) {
 	}
 	// body params
 	localVarPostBody = r.updateLogsConfigsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateLogsConfigs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -10652,10 +13990,14 @@ func (r UpdateLogsConfigsRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10666,7 +14008,7 @@ func (r UpdateLogsConfigsRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10677,7 +14019,7 @@ func (r UpdateLogsConfigsRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -10725,6 +14067,7 @@ type UpdateMetricsStorageRetentionRequest struct {
 	instanceId                           string
 	projectId                            string
 	updateMetricsStorageRetentionPayload *UpdateMetricsStorageRetentionPayload
+	queryParams                          []queryParam
 }
 
 func (r UpdateMetricsStorageRetentionRequest) UpdateMetricsStorageRetentionPayload(updateMetricsStorageRetentionPayload UpdateMetricsStorageRetentionPayload) ApiUpdateMetricsStorageRetentionRequest {
@@ -10732,7 +14075,43 @@ func (r UpdateMetricsStorageRetentionRequest) UpdateMetricsStorageRetentionPaylo
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateMetricsStorageRetentionRequest) SetQueryParam(key, value string) ApiUpdateMetricsStorageRetentionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateMetricsStorageRetentionRequest) AddQueryParam(key, value string) ApiUpdateMetricsStorageRetentionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateMetricsStorageRetentionRequest) Clone() ApiUpdateMetricsStorageRetentionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateMetricsStorageRetentionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateMetricsStorageRetentionRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateMetricsStorageRetention", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateMetricsStorageRetentionRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -10756,8 +14135,14 @@ func (r UpdateMetricsStorageRetentionRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateMetricsStorageRetentionPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateMetricsStorageRetentionPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateMetricsStorageRetentionPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -10779,7 +14164,8 @@ func (r UpdateMetricsStorageRetentionRequest) Execute() (*Message, error) {
 	}
 	// body params
 	localVarPostBody = r.updateMetricsStorageRetentionPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateMetricsStorageRetention"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -10810,10 +14196,14 @@ func (r UpdateMetricsStorageRetentionRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10824,7 +14214,7 @@ func (r UpdateMetricsStorageRetentionRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10835,7 +14225,7 @@ func (r UpdateMetricsStorageRetentionRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -10884,6 +14274,7 @@ type UpdateScrapeConfigRequest struct {
 	jobName                   string
 	projectId                 string
 	updateScrapeConfigPayload *UpdateScrapeConfigPayload
+	queryParams               []queryParam
 }
 
 func (r UpdateScrapeConfigRequest) UpdateScrapeConfigPayload(updateScrapeConfigPayload UpdateScrapeConfigPayload) ApiUpdateScrapeConfigRequest {
@@ -10891,7 +14282,43 @@ func (r UpdateScrapeConfigRequest) UpdateScrapeConfigPayload(updateScrapeConfigP
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateScrapeConfigRequest) SetQueryParam(key, value string) ApiUpdateScrapeConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateScrapeConfigRequest) AddQueryParam(key, value string) ApiUpdateScrapeConfigRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateScrapeConfigRequest) Clone() ApiUpdateScrapeConfigRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateScrapeConfigRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateScrapeConfig", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "jobName", Value: ParameterValueToString(r.jobName, "jobName")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateScrapeConfigRequest) execute() (*ScrapeConfigsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -10916,8 +14343,17 @@ func (r UpdateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.jobName == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "jobName"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateScrapeConfigPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateScrapeConfigPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateScrapeConfigPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -10939,7 +14375,8 @@ func (r UpdateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.updateScrapeConfigPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateScrapeConfig"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -10970,10 +14407,14 @@ func (r UpdateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10984,7 +14425,7 @@ func (r UpdateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -10995,7 +14436,7 @@ func (r UpdateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Message
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -11006,7 +14447,7 @@ func (r UpdateScrapeConfigRequest) Execute() (*ScrapeConfigsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -11057,6 +14498,7 @@ type UpdateTracesConfigsRequest struct {
 	instanceId                 string
 	projectId                  string
 	updateTracesConfigsPayload *UpdateTracesConfigsPayload
+	queryParams                []queryParam
 }
 
 func (r UpdateTracesConfigsRequest) UpdateTracesConfigsPayload(updateTracesConfigsPayload UpdateTracesConfigsPayload) ApiUpdateTracesConfigsRequest {
@@ -11064,7 +14506,43 @@ func (r UpdateTracesConfigsRequest) UpdateTracesConfigsPayload(updateTracesConfi
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r UpdateTracesConfigsRequest) SetQueryParam(key, value string) ApiUpdateTracesConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r UpdateTracesConfigsRequest) AddQueryParam(key, value string) ApiUpdateTracesConfigsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r UpdateTracesConfigsRequest) Clone() ApiUpdateTracesConfigsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r UpdateTracesConfigsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r UpdateTracesConfigsRequest) Execute() (*Message, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "UpdateTracesConfigs", oapierror.Parameter{Name: "instanceId", Value: ParameterValueToString(r.instanceId, "instanceId")}, oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r UpdateTracesConfigsRequest) execute() (*Message, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
@@ -11088,8 +14566,14 @@ func (r UpdateTracesConfigsRequest) Execute() (*Message, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.instanceId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "instanceId"}
+	}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if r.updateTracesConfigsPayload == nil {
-		return localVarReturnValue, fmt.Errorf("updateTracesConfigsPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "updateTracesConfigsPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -11111,7 +14595,8 @@ func (r UpdateTracesConfigsRequest) Execute() (*Message, error) {
 	}
 	// body params
 	localVarPostBody = r.updateTracesConfigsPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "UpdateTracesConfigs"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -11142,10 +14627,14 @@ func (r UpdateTracesConfigsRequest) Execute() (*Message, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -11156,7 +14645,7 @@ func (r UpdateTracesConfigsRequest) Execute() (*Message, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v PermissionDenied
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -11167,7 +14656,7 @@ func (r UpdateTracesConfigsRequest) Execute() (*Message, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/observability",
+		ServiceName:   "observability",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -39,3 +40,75 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateAlertConfigReceiver",
+	"CreateAlertConfigRoute",
+	"CreateAlertgroups",
+	"CreateAlertrules",
+	"CreateCertCheck",
+	"CreateCredentials",
+	"CreateHttpCheck",
+	"CreateInstance",
+	"CreateLogsAlertgroups",
+	"CreateScrapeConfig",
+	"DeleteAlertConfigReceiver",
+	"DeleteAlertConfigRoute",
+	"DeleteAlertgroup",
+	"DeleteAlertgroups",
+	"DeleteAlertrules",
+	"DeleteCertCheck",
+	"DeleteCredentials",
+	"DeleteCredentialsRemoteWriteConfig",
+	"DeleteHttpCheck",
+	"DeleteInstance",
+	"DeleteLogsAlertgroup",
+	"DeleteScrapeConfig",
+	"GetAlertConfigReceiver",
+	"GetAlertConfigRoute",
+	"GetAlertConfigs",
+	"GetAlertgroup",
+	"GetCredentials",
+	"GetCredentialsRemoteWriteConfig",
+	"GetGrafanaConfigs",
+	"GetInstance",
+	"GetLogsAlertgroup",
+	"GetLogsConfigs",
+	"GetMetricsStorageRetention",
+	"GetScrapeConfig",
+	"GetTracesConfigs",
+	"ListACL",
+	"ListAlertConfigReceivers",
+	"ListAlertConfigRoutes",
+	"ListAlertgroups",
+	"ListAlertrules",
+	"ListCertChecks",
+	"ListCredentials",
+	"ListHttpChecks",
+	"ListInstances",
+	"ListLogsAlertgroups",
+	"ListPlans",
+	"ListScrapeConfigs",
+	"PartialUpdateAlertgroups",
+	"PartialUpdateAlertrules",
+	"UpdateACL",
+	"UpdateAlertConfigReceiver",
+	"UpdateAlertConfigRoute",
+	"UpdateAlertConfigs",
+	"UpdateAlertgroup",
+	"UpdateAlertgroups",
+	"UpdateCredentialsRemoteWriteConfig",
+	"UpdateGrafanaConfigs",
+	"UpdateInstance",
+	"UpdateLogsAlertgroup",
+	"UpdateLogsConfigs",
+	"UpdateMetricsStorageRetention",
+	"UpdateScrapeConfig",
+	"UpdateTracesConfigs",
+}
+
+func init() {
+	config.RegisterOperations("observability", operations...)
+}
@@ -278,14 +278,9 @@ func (v *CreateScrapeConfigPayloadScheme) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CreateScrapeConfigPayloadScheme(value)
-	for _, existing := range AllowedCreateScrapeConfigPayloadSchemeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateScrapeConfigPayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateScrapeConfigPayloadSchemeFromValue returns a pointer to a valid CreateScrapeConfigPayloadScheme
@@ -309,6 +304,17 @@ func (v CreateScrapeConfigPayloadScheme) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateScrapeConfigPayloadScheme) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateScrapeConfigPayloadScheme) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to SchemeScheme value
 func (v CreateScrapeConfigPayloadScheme) Ptr() *CreateScrapeConfigPayloadScheme {
 	return &v
@@ -65,14 +65,9 @@ func (v *CreateScrapeConfigPayloadMetricsRelabelConfigsInnerAction) UnmarshalJSO
 		return nil
 	}
 	enumTypeValue := CreateScrapeConfigPayloadMetricsRelabelConfigsInnerAction(value)
-	for _, existing := range AllowedCreateScrapeConfigPayloadMetricsRelabelConfigsInnerActionEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateScrapeConfigPayloadMetricsRelabelConfigsInner", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateScrapeConfigPayloadMetricsRelabelConfigsInnerActionFromValue returns a pointer to a valid CreateScrapeConfigPayloadMetricsRelabelConfigsInnerAction
@@ -96,6 +91,17 @@ func (v CreateScrapeConfigPayloadMetricsRelabelConfigsInnerAction) IsValid() boo
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateScrapeConfigPayloadMetricsRelabelConfigsInnerAction) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateScrapeConfigPayloadMetricsRelabelConfigsInnerAction) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ActionAction value
 func (v CreateScrapeConfigPayloadMetricsRelabelConfigsInnerAction) Ptr() *CreateScrapeConfigPayloadMetricsRelabelConfigsInnerAction {
 	return &v
@@ -75,11 +75,11 @@ func TestCreateScrapeConfigPayloadMetricsRelabelConfigsInnerAction_UnmarshalJSON
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -322,14 +322,9 @@ func (v *GetInstanceResponseStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := GetInstanceResponseStatus(value)
-	for _, existing := range AllowedGetInstanceResponseStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid GetInstanceResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewGetInstanceResponseStatusFromValue returns a pointer to a valid GetInstanceResponseStatus
@@ -353,6 +348,17 @@ func (v GetInstanceResponseStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v GetInstanceResponseStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v GetInstanceResponseStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v GetInstanceResponseStatus) Ptr() *GetInstanceResponseStatus {
 	return &v
@@ -260,14 +260,9 @@ func (v *InstanceState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InstanceState(value)
-	for _, existing := range AllowedInstanceStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Instance", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceStateFromValue returns a pointer to a valid InstanceState
@@ -291,6 +286,17 @@ func (v InstanceState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v InstanceState) Ptr() *InstanceState {
 	return &v
@@ -278,14 +278,9 @@ func (v *JobScheme) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := JobScheme(value)
-	for _, existing := range AllowedJobSchemeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Job", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewJobSchemeFromValue returns a pointer to a valid JobScheme
@@ -309,6 +304,17 @@ func (v JobScheme) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v JobScheme) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v JobScheme) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to SchemeScheme value
 func (v JobScheme) Ptr() *JobScheme {
 	return &v
@@ -65,14 +65,9 @@ func (v *MetricsRelabelConfigAction) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := MetricsRelabelConfigAction(value)
-	for _, existing := range AllowedMetricsRelabelConfigActionEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid MetricsRelabelConfig", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewMetricsRelabelConfigActionFromValue returns a pointer to a valid MetricsRelabelConfigAction
@@ -96,6 +91,17 @@ func (v MetricsRelabelConfigAction) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v MetricsRelabelConfigAction) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v MetricsRelabelConfigAction) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ActionAction value
 func (v MetricsRelabelConfigAction) Ptr() *MetricsRelabelConfigAction {
 	return &v
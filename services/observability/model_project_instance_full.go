@@ -199,14 +199,9 @@ func (v *ProjectInstanceFullStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ProjectInstanceFullStatus(value)
-	for _, existing := range AllowedProjectInstanceFullStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ProjectInstanceFull", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewProjectInstanceFullStatusFromValue returns a pointer to a valid ProjectInstanceFullStatus
@@ -230,6 +225,17 @@ func (v ProjectInstanceFullStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ProjectInstanceFullStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ProjectInstanceFullStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v ProjectInstanceFullStatus) Ptr() *ProjectInstanceFullStatus {
 	return &v
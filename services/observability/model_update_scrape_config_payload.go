@@ -217,14 +217,9 @@ func (v *UpdateScrapeConfigPayloadScheme) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := UpdateScrapeConfigPayloadScheme(value)
-	for _, existing := range AllowedUpdateScrapeConfigPayloadSchemeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid UpdateScrapeConfigPayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewUpdateScrapeConfigPayloadSchemeFromValue returns a pointer to a valid UpdateScrapeConfigPayloadScheme
@@ -248,6 +243,17 @@ func (v UpdateScrapeConfigPayloadScheme) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v UpdateScrapeConfigPayloadScheme) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v UpdateScrapeConfigPayloadScheme) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to SchemeScheme value
 func (v UpdateScrapeConfigPayloadScheme) Ptr() *UpdateScrapeConfigPayloadScheme {
 	return &v
@@ -0,0 +1,124 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+// CreateInstanceOperation returns the async action of CreateInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateInstancePollFunc. The name of the operation is
+// "observability/CreateInstance/{instanceId}/{projectId}".
+func CreateInstanceOperation(a APIClientInterface, instanceId, projectId string) *lro.Operation[observability.GetInstanceResponse] {
+	return createInstanceOperationHandler(a).Operation(instanceId, projectId)
+}
+
+// CreateInstancePollFunc returns the lro.PollFunc of the operations returned by CreateInstanceOperation, e.g. to resume
+// one with lro.Resume
+func CreateInstancePollFunc(a APIClientInterface) lro.PollFunc[observability.GetInstanceResponse] {
+	return createInstanceOperationHandler(a).PollFunc()
+}
+
+func createInstanceOperationHandler(a APIClientInterface) lro.WaitHandler[observability.GetInstanceResponse] {
+	return lro.WaitHandler[observability.GetInstanceResponse]{
+		Kind: "observability/CreateInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[observability.GetInstanceResponse] {
+			return CreateInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// UpdateInstanceOperation returns the async action of UpdateInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with UpdateInstancePollFunc. The name of the operation is
+// "observability/UpdateInstance/{instanceId}/{projectId}".
+func UpdateInstanceOperation(a APIClientInterface, instanceId, projectId string) *lro.Operation[observability.GetInstanceResponse] {
+	return updateInstanceOperationHandler(a).Operation(instanceId, projectId)
+}
+
+// UpdateInstancePollFunc returns the lro.PollFunc of the operations returned by UpdateInstanceOperation, e.g. to resume
+// one with lro.Resume
+func UpdateInstancePollFunc(a APIClientInterface) lro.PollFunc[observability.GetInstanceResponse] {
+	return updateInstanceOperationHandler(a).PollFunc()
+}
+
+func updateInstanceOperationHandler(a APIClientInterface) lro.WaitHandler[observability.GetInstanceResponse] {
+	return lro.WaitHandler[observability.GetInstanceResponse]{
+		Kind: "observability/UpdateInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[observability.GetInstanceResponse] {
+			return UpdateInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// DeleteInstanceOperation returns the async action of DeleteInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteInstancePollFunc. The name of the operation is
+// "observability/DeleteInstance/{instanceId}/{projectId}".
+func DeleteInstanceOperation(a APIClientInterface, instanceId, projectId string) *lro.Operation[observability.GetInstanceResponse] {
+	return deleteInstanceOperationHandler(a).Operation(instanceId, projectId)
+}
+
+// DeleteInstancePollFunc returns the lro.PollFunc of the operations returned by DeleteInstanceOperation, e.g. to resume
+// one with lro.Resume
+func DeleteInstancePollFunc(a APIClientInterface) lro.PollFunc[observability.GetInstanceResponse] {
+	return deleteInstanceOperationHandler(a).PollFunc()
+}
+
+func deleteInstanceOperationHandler(a APIClientInterface) lro.WaitHandler[observability.GetInstanceResponse] {
+	return lro.WaitHandler[observability.GetInstanceResponse]{
+		Kind: "observability/DeleteInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[observability.GetInstanceResponse] {
+			return DeleteInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// CreateScrapeConfigOperation returns the async action of CreateScrapeConfigWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with CreateScrapeConfigPollFunc. The name of the operation
+// is "observability/CreateScrapeConfig/{instanceId}/{jobName}/{projectId}".
+func CreateScrapeConfigOperation(a APIClientInterface, instanceId, jobName, projectId string) *lro.Operation[observability.ListScrapeConfigsResponse] {
+	return createScrapeConfigOperationHandler(a).Operation(instanceId, jobName, projectId)
+}
+
+// CreateScrapeConfigPollFunc returns the lro.PollFunc of the operations returned by CreateScrapeConfigOperation, e.g.
+// to resume one with lro.Resume
+func CreateScrapeConfigPollFunc(a APIClientInterface) lro.PollFunc[observability.ListScrapeConfigsResponse] {
+	return createScrapeConfigOperationHandler(a).PollFunc()
+}
+
+func createScrapeConfigOperationHandler(a APIClientInterface) lro.WaitHandler[observability.ListScrapeConfigsResponse] {
+	return lro.WaitHandler[observability.ListScrapeConfigsResponse]{
+		Kind: "observability/CreateScrapeConfig",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[observability.ListScrapeConfigsResponse] {
+			return CreateScrapeConfigWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteScrapeConfigOperation returns the async action of DeleteScrapeConfigWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with DeleteScrapeConfigPollFunc. The name of the operation
+// is "observability/DeleteScrapeConfig/{instanceId}/{jobName}/{projectId}".
+func DeleteScrapeConfigOperation(a APIClientInterface, instanceId, jobName, projectId string) *lro.Operation[observability.ListScrapeConfigsResponse] {
+	return deleteScrapeConfigOperationHandler(a).Operation(instanceId, jobName, projectId)
+}
+
+// DeleteScrapeConfigPollFunc returns the lro.PollFunc of the operations returned by DeleteScrapeConfigOperation, e.g.
+// to resume one with lro.Resume
+func DeleteScrapeConfigPollFunc(a APIClientInterface) lro.PollFunc[observability.ListScrapeConfigsResponse] {
+	return deleteScrapeConfigOperationHandler(a).PollFunc()
+}
+
+func deleteScrapeConfigOperationHandler(a APIClientInterface) lro.WaitHandler[observability.ListScrapeConfigsResponse] {
+	return lro.WaitHandler[observability.ListScrapeConfigsResponse]{
+		Kind: "observability/DeleteScrapeConfig",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[observability.ListScrapeConfigsResponse] {
+			return DeleteScrapeConfigWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/opensearch",
+		ServiceName:   "opensearch",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -39,3 +40,31 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateBackup",
+	"CreateCredentials",
+	"CreateInstance",
+	"DeleteCredentials",
+	"DeleteInstance",
+	"DownloadBackup",
+	"GetCredentials",
+	"GetInstance",
+	"GetMetrics",
+	"ListBackups",
+	"ListCredentials",
+	"ListInstances",
+	"ListOfferings",
+	"ListRestores",
+	"PartialUpdateInstance",
+	"TriggerRecreate",
+	"TriggerRestart",
+	"TriggerRestore",
+	"UpdateBackupsConfig",
+}
+
+func init() {
+	config.RegisterOperations("opensearch", operations...)
+}
@@ -78,14 +78,9 @@ func (v *InstanceLastOperationState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InstanceLastOperationState(value)
-	for _, existing := range AllowedInstanceLastOperationStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InstanceLastOperation", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceLastOperationStateFromValue returns a pointer to a valid InstanceLastOperationState
@@ -109,6 +104,17 @@ func (v InstanceLastOperationState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceLastOperationState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceLastOperationState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v InstanceLastOperationState) Ptr() *InstanceLastOperationState {
 	return &v
@@ -204,14 +210,9 @@ func (v *InstanceLastOperationTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InstanceLastOperationTypes(value)
-	for _, existing := range AllowedInstanceLastOperationTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InstanceLastOperation", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceLastOperationTypesFromValue returns a pointer to a valid InstanceLastOperationTypes
@@ -235,6 +236,17 @@ func (v InstanceLastOperationTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceLastOperationTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceLastOperationTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v InstanceLastOperationTypes) Ptr() *InstanceLastOperationTypes {
 	return &v
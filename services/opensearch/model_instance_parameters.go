@@ -100,14 +100,9 @@ func (v *InstanceParametersJavaGarbageCollector) UnmarshalJSON(src []byte) error
 		return nil
 	}
 	enumTypeValue := InstanceParametersJavaGarbageCollector(value)
-	for _, existing := range AllowedInstanceParametersJavaGarbageCollectorEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InstanceParameters", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceParametersJavaGarbageCollectorFromValue returns a pointer to a valid InstanceParametersJavaGarbageCollector
@@ -131,6 +126,17 @@ func (v InstanceParametersJavaGarbageCollector) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceParametersJavaGarbageCollector) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceParametersJavaGarbageCollector) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to JavaGarbageCollectorJavaGarbageCollector value
 func (v InstanceParametersJavaGarbageCollector) Ptr() *InstanceParametersJavaGarbageCollector {
 	return &v
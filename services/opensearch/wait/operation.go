@@ -0,0 +1,124 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/opensearch"
+)
+
+// CreateInstanceOperation returns the async action of CreateInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateInstancePollFunc. The name of the operation is
+// "opensearch/CreateInstance/{projectId}/{instanceId}".
+func CreateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId string) *lro.Operation[opensearch.Instance] {
+	return createInstanceOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// CreateInstancePollFunc returns the lro.PollFunc of the operations returned by CreateInstanceOperation, e.g. to resume
+// one with lro.Resume
+func CreateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[opensearch.Instance] {
+	return createInstanceOperationHandler(a).PollFunc()
+}
+
+func createInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[opensearch.Instance] {
+	return lro.WaitHandler[opensearch.Instance]{
+		Kind: "opensearch/CreateInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[opensearch.Instance] {
+			return CreateInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// PartialUpdateInstanceOperation returns the async action of PartialUpdateInstanceWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with PartialUpdateInstancePollFunc. The name of
+// the operation is "opensearch/PartialUpdateInstance/{projectId}/{instanceId}".
+func PartialUpdateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId string) *lro.Operation[opensearch.Instance] {
+	return partialUpdateInstanceOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// PartialUpdateInstancePollFunc returns the lro.PollFunc of the operations returned by PartialUpdateInstanceOperation,
+// e.g. to resume one with lro.Resume
+func PartialUpdateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[opensearch.Instance] {
+	return partialUpdateInstanceOperationHandler(a).PollFunc()
+}
+
+func partialUpdateInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[opensearch.Instance] {
+	return lro.WaitHandler[opensearch.Instance]{
+		Kind: "opensearch/PartialUpdateInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[opensearch.Instance] {
+			return PartialUpdateInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// DeleteInstanceOperation returns the async action of DeleteInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteInstancePollFunc. The name of the operation is
+// "opensearch/DeleteInstance/{projectId}/{instanceId}".
+func DeleteInstanceOperation(a APIClientInstanceInterface, projectId, instanceId string) *lro.Operation[struct{}] {
+	return deleteInstanceOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// DeleteInstancePollFunc returns the lro.PollFunc of the operations returned by DeleteInstanceOperation, e.g. to resume
+// one with lro.Resume
+func DeleteInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[struct{}] {
+	return deleteInstanceOperationHandler(a).PollFunc()
+}
+
+func deleteInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "opensearch/DeleteInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// CreateCredentialsOperation returns the async action of CreateCredentialsWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateCredentialsPollFunc. The name of the operation is
+// "opensearch/CreateCredentials/{projectId}/{instanceId}/{credentialsId}".
+func CreateCredentialsOperation(a APIClientCredentialsInterface, projectId, instanceId, credentialsId string) *lro.Operation[opensearch.CredentialsResponse] {
+	return createCredentialsOperationHandler(a).Operation(projectId, instanceId, credentialsId)
+}
+
+// CreateCredentialsPollFunc returns the lro.PollFunc of the operations returned by CreateCredentialsOperation, e.g. to
+// resume one with lro.Resume
+func CreateCredentialsPollFunc(a APIClientCredentialsInterface) lro.PollFunc[opensearch.CredentialsResponse] {
+	return createCredentialsOperationHandler(a).PollFunc()
+}
+
+func createCredentialsOperationHandler(a APIClientCredentialsInterface) lro.WaitHandler[opensearch.CredentialsResponse] {
+	return lro.WaitHandler[opensearch.CredentialsResponse]{
+		Kind: "opensearch/CreateCredentials",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[opensearch.CredentialsResponse] {
+			return CreateCredentialsWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteCredentialsOperation returns the async action of DeleteCredentialsWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteCredentialsPollFunc. The name of the operation is
+// "opensearch/DeleteCredentials/{projectId}/{instanceId}/{credentialsId}".
+func DeleteCredentialsOperation(a APIClientCredentialsInterface, projectId, instanceId, credentialsId string) *lro.Operation[struct{}] {
+	return deleteCredentialsOperationHandler(a).Operation(projectId, instanceId, credentialsId)
+}
+
+// DeleteCredentialsPollFunc returns the lro.PollFunc of the operations returned by DeleteCredentialsOperation, e.g. to
+// resume one with lro.Resume
+func DeleteCredentialsPollFunc(a APIClientCredentialsInterface) lro.PollFunc[struct{}] {
+	return deleteCredentialsOperationHandler(a).PollFunc()
+}
+
+func deleteCredentialsOperationHandler(a APIClientCredentialsInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "opensearch/DeleteCredentials",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteCredentialsWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
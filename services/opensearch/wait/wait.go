@@ -2,6 +2,7 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -83,6 +84,13 @@ func CreateInstanceWaitHandler(ctx context.Context, a APIClientInstanceInterface
 		}
 		return false, nil, nil
 	})
+	handler.SetStateFetch(func() (*opensearch.Instance, string, error) {
+		s, err := a.GetInstanceExecute(ctx, projectId, instanceId)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		return s, string(s.GetStatus()), nil
+	})
 	handler.SetTimeout(45 * time.Minute)
 	return handler
 }
@@ -109,6 +117,13 @@ func PartialUpdateInstanceWaitHandler(ctx context.Context, a APIClientInstanceIn
 		}
 		return false, nil, nil
 	})
+	handler.SetStateFetch(func() (*opensearch.Instance, string, error) {
+		s, err := a.GetInstanceExecute(ctx, projectId, instanceId)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		return s, string(s.GetStatus()), nil
+	})
 	handler.SetTimeout(45 * time.Minute)
 	return handler
 }
@@ -132,7 +147,8 @@ func DeleteInstanceWaitHandler(ctx context.Context, a APIClientInstanceInterface
 			}
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, nil, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError")
 		}
@@ -150,7 +166,8 @@ func CreateCredentialsWaitHandler(ctx context.Context, a APIClientCredentialsInt
 	handler := wait.New(func() (waitFinished bool, response *opensearch.CredentialsResponse, err error) {
 		s, err := a.GetCredentialsExecute(ctx, projectId, instanceId, credentialsId)
 		if err != nil {
-			oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+			var oapiErr *oapierror.GenericOpenAPIError
+			ok := errors.As(err, &oapiErr)
 			if !ok {
 				return false, nil, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError")
 			}
@@ -176,7 +193,8 @@ func DeleteCredentialsWaitHandler(ctx context.Context, a APIClientCredentialsInt
 		if err == nil {
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, nil, fmt.Errorf("could not convert error to oapierror.GenericOpenAPIError")
 		}
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/postgresflex",
+		ServiceName:   "postgresflex",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,38 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CloneInstance",
+	"CreateDatabase",
+	"CreateInstance",
+	"CreateUser",
+	"DeleteDatabase",
+	"DeleteInstance",
+	"DeleteUser",
+	"ForceDeleteInstance",
+	"GetBackup",
+	"GetInstance",
+	"GetUser",
+	"ListBackups",
+	"ListDatabaseParameters",
+	"ListDatabases",
+	"ListFlavors",
+	"ListInstances",
+	"ListMetrics",
+	"ListStorages",
+	"ListUsers",
+	"ListVersions",
+	"PartialUpdateInstance",
+	"PartialUpdateUser",
+	"ResetUser",
+	"UpdateBackupSchedule",
+	"UpdateInstance",
+	"UpdateUser",
+}
+
+func init() {
+	config.RegisterOperations("postgresflex", operations...)
+}
@@ -0,0 +1,124 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+)
+
+// CreateInstanceOperation returns the async action of CreateInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateInstancePollFunc. The name of the operation is
+// "postgresflex/CreateInstance/{projectId}/{region}/{instanceId}".
+func CreateInstanceOperation(a APIClientInstanceInterface, projectId, region, instanceId string) *lro.Operation[postgresflex.InstanceResponse] {
+	return createInstanceOperationHandler(a).Operation(projectId, region, instanceId)
+}
+
+// CreateInstancePollFunc returns the lro.PollFunc of the operations returned by CreateInstanceOperation, e.g. to resume
+// one with lro.Resume
+func CreateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[postgresflex.InstanceResponse] {
+	return createInstanceOperationHandler(a).PollFunc()
+}
+
+func createInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[postgresflex.InstanceResponse] {
+	return lro.WaitHandler[postgresflex.InstanceResponse]{
+		Kind: "postgresflex/CreateInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[postgresflex.InstanceResponse] {
+			return CreateInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// PartialUpdateInstanceOperation returns the async action of PartialUpdateInstanceWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with PartialUpdateInstancePollFunc. The name of
+// the operation is "postgresflex/PartialUpdateInstance/{projectId}/{region}/{instanceId}".
+func PartialUpdateInstanceOperation(a APIClientInstanceInterface, projectId, region, instanceId string) *lro.Operation[postgresflex.InstanceResponse] {
+	return partialUpdateInstanceOperationHandler(a).Operation(projectId, region, instanceId)
+}
+
+// PartialUpdateInstancePollFunc returns the lro.PollFunc of the operations returned by PartialUpdateInstanceOperation,
+// e.g. to resume one with lro.Resume
+func PartialUpdateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[postgresflex.InstanceResponse] {
+	return partialUpdateInstanceOperationHandler(a).PollFunc()
+}
+
+func partialUpdateInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[postgresflex.InstanceResponse] {
+	return lro.WaitHandler[postgresflex.InstanceResponse]{
+		Kind: "postgresflex/PartialUpdateInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[postgresflex.InstanceResponse] {
+			return PartialUpdateInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteInstanceOperation returns the async action of DeleteInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteInstancePollFunc. The name of the operation is
+// "postgresflex/DeleteInstance/{projectId}/{region}/{instanceId}".
+func DeleteInstanceOperation(a APIClientInstanceInterface, projectId, region, instanceId string) *lro.Operation[struct{}] {
+	return deleteInstanceOperationHandler(a).Operation(projectId, region, instanceId)
+}
+
+// DeleteInstancePollFunc returns the lro.PollFunc of the operations returned by DeleteInstanceOperation, e.g. to resume
+// one with lro.Resume
+func DeleteInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[struct{}] {
+	return deleteInstanceOperationHandler(a).PollFunc()
+}
+
+func deleteInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "postgresflex/DeleteInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// ForceDeleteInstanceOperation returns the async action of ForceDeleteInstanceWaitHandler as an lro.Operation, which
+// can be serialized to resume waiting for it in another process with ForceDeleteInstancePollFunc. The name of the
+// operation is "postgresflex/ForceDeleteInstance/{projectId}/{region}/{instanceId}".
+func ForceDeleteInstanceOperation(a APIClientInstanceInterface, projectId, region, instanceId string) *lro.Operation[struct{}] {
+	return forceDeleteInstanceOperationHandler(a).Operation(projectId, region, instanceId)
+}
+
+// ForceDeleteInstancePollFunc returns the lro.PollFunc of the operations returned by ForceDeleteInstanceOperation, e.g.
+// to resume one with lro.Resume
+func ForceDeleteInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[struct{}] {
+	return forceDeleteInstanceOperationHandler(a).PollFunc()
+}
+
+func forceDeleteInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "postgresflex/ForceDeleteInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return ForceDeleteInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteUserOperation returns the async action of DeleteUserWaitHandler as an lro.Operation, which can be serialized to
+// resume waiting for it in another process with DeleteUserPollFunc. The name of the operation is
+// "postgresflex/DeleteUser/{projectId}/{region}/{instanceId}/{userId}".
+func DeleteUserOperation(a APIClientUserInterface, projectId, region, instanceId, userId string) *lro.Operation[struct{}] {
+	return deleteUserOperationHandler(a).Operation(projectId, region, instanceId, userId)
+}
+
+// DeleteUserPollFunc returns the lro.PollFunc of the operations returned by DeleteUserOperation, e.g. to resume one
+// with lro.Resume
+func DeleteUserPollFunc(a APIClientUserInterface) lro.PollFunc[struct{}] {
+	return deleteUserOperationHandler(a).PollFunc()
+}
+
+func deleteUserOperationHandler(a APIClientUserInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "postgresflex/DeleteUser",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteUserWaitHandler(ctx, a, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
@@ -2,6 +2,7 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -46,10 +47,9 @@ func CreateInstanceWaitHandler(ctx context.Context, a APIClientInstanceInterface
 			switch *s.Item.Status {
 			default:
 				return true, s, fmt.Errorf("instance with id %s has unexpected status %s", instanceId, *s.Item.Status)
-			case InstanceStateEmpty:
-				return false, nil, nil
-			case InstanceStateProgressing:
-				return false, nil, nil
+			case InstanceStateEmpty, InstanceStateProgressing:
+				// The instance in its intermediate state is reported as progress, see wait.AsyncActionHandler.SetProgressFunc
+				return false, s, nil
 			case InstanceStateSuccess:
 				instanceCreated = true
 				instanceGetResponse = s
@@ -64,14 +64,15 @@ func CreateInstanceWaitHandler(ctx context.Context, a APIClientInstanceInterface
 		if err == nil {
 			return true, instanceGetResponse, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, nil, err
 		}
 		if oapiErr.StatusCode < 500 {
 			return true, instanceGetResponse, fmt.Errorf("users request after instance creation returned %d status code", oapiErr.StatusCode)
 		}
-		return false, nil, nil
+		return false, instanceGetResponse, nil
 	})
 	// Sleep before wait is set because sometimes API returns 404 right after creation request
 	handler.SetTimeout(45 * time.Minute).SetSleepBeforeWait(15 * time.Second)
@@ -135,7 +136,8 @@ func ForceDeleteInstanceWaitHandler(ctx context.Context, a APIClientInstanceInte
 		if err == nil {
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, nil, err
 		}
@@ -155,7 +157,8 @@ func DeleteUserWaitHandler(ctx context.Context, a APIClientUserInterface, projec
 		if err == nil {
 			return false, nil, nil
 		}
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
 		if !ok {
 			return false, nil, err
 		}
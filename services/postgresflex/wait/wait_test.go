@@ -110,7 +110,7 @@ func TestCreateInstanceWaitHandler(t *testing.T) {
 			instanceGetFails: false,
 			instanceState:    InstanceStateEmpty,
 			wantErr:          true,
-			wantResp:         false,
+			wantResp:         true,
 		},
 		{
 			desc:             "instance_get_fails",
@@ -124,7 +124,7 @@ func TestCreateInstanceWaitHandler(t *testing.T) {
 			instanceState:       InstanceStateSuccess,
 			usersGetErrorStatus: 500,
 			wantErr:             true,
-			wantResp:            false,
+			wantResp:            true,
 		},
 		{
 			desc:                "users_get_fails_2",
@@ -139,7 +139,7 @@ func TestCreateInstanceWaitHandler(t *testing.T) {
 			instanceGetFails: false,
 			instanceState:    InstanceStateProgressing,
 			wantErr:          true,
-			wantResp:         false,
+			wantResp:         true,
 		},
 	}
 	for _, tt := range tests {
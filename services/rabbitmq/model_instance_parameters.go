@@ -299,14 +299,9 @@ func (v *InstanceParametersTlsProtocols) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InstanceParametersTlsProtocols(value)
-	for _, existing := range AllowedInstanceParametersTlsProtocolsEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InstanceParameters", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceParametersTlsProtocolsFromValue returns a pointer to a valid InstanceParametersTlsProtocols
@@ -330,6 +325,17 @@ func (v InstanceParametersTlsProtocols) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceParametersTlsProtocols) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceParametersTlsProtocols) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TlsProtocolsTlsProtocols value
 func (v InstanceParametersTlsProtocols) Ptr() *InstanceParametersTlsProtocols {
 	return &v
@@ -0,0 +1,124 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/rabbitmq"
+)
+
+// CreateInstanceOperation returns the async action of CreateInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateInstancePollFunc. The name of the operation is
+// "rabbitmq/CreateInstance/{projectId}/{instanceId}".
+func CreateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId string) *lro.Operation[rabbitmq.Instance] {
+	return createInstanceOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// CreateInstancePollFunc returns the lro.PollFunc of the operations returned by CreateInstanceOperation, e.g. to resume
+// one with lro.Resume
+func CreateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[rabbitmq.Instance] {
+	return createInstanceOperationHandler(a).PollFunc()
+}
+
+func createInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[rabbitmq.Instance] {
+	return lro.WaitHandler[rabbitmq.Instance]{
+		Kind: "rabbitmq/CreateInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[rabbitmq.Instance] {
+			return CreateInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// PartialUpdateInstanceOperation returns the async action of PartialUpdateInstanceWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with PartialUpdateInstancePollFunc. The name of
+// the operation is "rabbitmq/PartialUpdateInstance/{projectId}/{instanceId}".
+func PartialUpdateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId string) *lro.Operation[rabbitmq.Instance] {
+	return partialUpdateInstanceOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// PartialUpdateInstancePollFunc returns the lro.PollFunc of the operations returned by PartialUpdateInstanceOperation,
+// e.g. to resume one with lro.Resume
+func PartialUpdateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[rabbitmq.Instance] {
+	return partialUpdateInstanceOperationHandler(a).PollFunc()
+}
+
+func partialUpdateInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[rabbitmq.Instance] {
+	return lro.WaitHandler[rabbitmq.Instance]{
+		Kind: "rabbitmq/PartialUpdateInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[rabbitmq.Instance] {
+			return PartialUpdateInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// DeleteInstanceOperation returns the async action of DeleteInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteInstancePollFunc. The name of the operation is
+// "rabbitmq/DeleteInstance/{projectId}/{instanceId}".
+func DeleteInstanceOperation(a APIClientInstanceInterface, projectId, instanceId string) *lro.Operation[struct{}] {
+	return deleteInstanceOperationHandler(a).Operation(projectId, instanceId)
+}
+
+// DeleteInstancePollFunc returns the lro.PollFunc of the operations returned by DeleteInstanceOperation, e.g. to resume
+// one with lro.Resume
+func DeleteInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[struct{}] {
+	return deleteInstanceOperationHandler(a).PollFunc()
+}
+
+func deleteInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "rabbitmq/DeleteInstance",
+		IDs:  2,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteInstanceWaitHandler(ctx, a, ids[0], ids[1])
+		},
+	}
+}
+
+// CreateCredentialsOperation returns the async action of CreateCredentialsWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateCredentialsPollFunc. The name of the operation is
+// "rabbitmq/CreateCredentials/{projectId}/{instanceId}/{credentialsId}".
+func CreateCredentialsOperation(a APIClientCredentialsInterface, projectId, instanceId, credentialsId string) *lro.Operation[rabbitmq.CredentialsResponse] {
+	return createCredentialsOperationHandler(a).Operation(projectId, instanceId, credentialsId)
+}
+
+// CreateCredentialsPollFunc returns the lro.PollFunc of the operations returned by CreateCredentialsOperation, e.g. to
+// resume one with lro.Resume
+func CreateCredentialsPollFunc(a APIClientCredentialsInterface) lro.PollFunc[rabbitmq.CredentialsResponse] {
+	return createCredentialsOperationHandler(a).PollFunc()
+}
+
+func createCredentialsOperationHandler(a APIClientCredentialsInterface) lro.WaitHandler[rabbitmq.CredentialsResponse] {
+	return lro.WaitHandler[rabbitmq.CredentialsResponse]{
+		Kind: "rabbitmq/CreateCredentials",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[rabbitmq.CredentialsResponse] {
+			return CreateCredentialsWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteCredentialsOperation returns the async action of DeleteCredentialsWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteCredentialsPollFunc. The name of the operation is
+// "rabbitmq/DeleteCredentials/{projectId}/{instanceId}/{credentialsId}".
+func DeleteCredentialsOperation(a APIClientCredentialsInterface, projectId, instanceId, credentialsId string) *lro.Operation[struct{}] {
+	return deleteCredentialsOperationHandler(a).Operation(projectId, instanceId, credentialsId)
+}
+
+// DeleteCredentialsPollFunc returns the lro.PollFunc of the operations returned by DeleteCredentialsOperation, e.g. to
+// resume one with lro.Resume
+func DeleteCredentialsPollFunc(a APIClientCredentialsInterface) lro.PollFunc[struct{}] {
+	return deleteCredentialsOperationHandler(a).PollFunc()
+}
+
+func deleteCredentialsOperationHandler(a APIClientCredentialsInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "rabbitmq/DeleteCredentials",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteCredentialsWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -334,14 +334,9 @@ func (v *InstanceStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InstanceStatus(value)
-	for _, existing := range AllowedInstanceStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Instance", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceStatusFromValue returns a pointer to a valid InstanceStatus
@@ -365,6 +360,17 @@ func (v InstanceStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v InstanceStatus) Ptr() *InstanceStatus {
 	return &v
@@ -136,14 +136,9 @@ func (v *InstanceParametersLazyfreeLazyEviction) UnmarshalJSON(src []byte) error
 		return nil
 	}
 	enumTypeValue := InstanceParametersLazyfreeLazyEviction(value)
-	for _, existing := range AllowedInstanceParametersLazyfreeLazyEvictionEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InstanceParameters", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceParametersLazyfreeLazyEvictionFromValue returns a pointer to a valid InstanceParametersLazyfreeLazyEviction
@@ -167,6 +162,17 @@ func (v InstanceParametersLazyfreeLazyEviction) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceParametersLazyfreeLazyEviction) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceParametersLazyfreeLazyEviction) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to LazyfreeLazyEvictionLazyfreeLazyEviction value
 func (v InstanceParametersLazyfreeLazyEviction) Ptr() *InstanceParametersLazyfreeLazyEviction {
 	return &v
@@ -260,14 +266,9 @@ func (v *InstanceParametersLazyfreeLazyExpire) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InstanceParametersLazyfreeLazyExpire(value)
-	for _, existing := range AllowedInstanceParametersLazyfreeLazyExpireEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InstanceParameters", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceParametersLazyfreeLazyExpireFromValue returns a pointer to a valid InstanceParametersLazyfreeLazyExpire
@@ -291,6 +292,17 @@ func (v InstanceParametersLazyfreeLazyExpire) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceParametersLazyfreeLazyExpire) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceParametersLazyfreeLazyExpire) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to LazyfreeLazyExpireLazyfreeLazyExpire value
 func (v InstanceParametersLazyfreeLazyExpire) Ptr() *InstanceParametersLazyfreeLazyExpire {
 	return &v
@@ -452,14 +464,9 @@ func (v *InstanceParametersMaxmemoryPolicy) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InstanceParametersMaxmemoryPolicy(value)
-	for _, existing := range AllowedInstanceParametersMaxmemoryPolicyEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InstanceParameters", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceParametersMaxmemoryPolicyFromValue returns a pointer to a valid InstanceParametersMaxmemoryPolicy
@@ -483,6 +490,17 @@ func (v InstanceParametersMaxmemoryPolicy) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceParametersMaxmemoryPolicy) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceParametersMaxmemoryPolicy) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to MaxmemoryPolicyMaxmemoryPolicy value
 func (v InstanceParametersMaxmemoryPolicy) Ptr() *InstanceParametersMaxmemoryPolicy {
 	return &v
@@ -802,14 +820,9 @@ func (v *InstanceParametersTlsProtocols) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InstanceParametersTlsProtocols(value)
-	for _, existing := range AllowedInstanceParametersTlsProtocolsEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InstanceParameters", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInstanceParametersTlsProtocolsFromValue returns a pointer to a valid InstanceParametersTlsProtocols
@@ -833,6 +846,17 @@ func (v InstanceParametersTlsProtocols) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InstanceParametersTlsProtocols) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InstanceParametersTlsProtocols) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TlsProtocolsTlsProtocols value
 func (v InstanceParametersTlsProtocols) Ptr() *InstanceParametersTlsProtocols {
 	return &v
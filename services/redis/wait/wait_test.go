@@ -144,6 +144,29 @@ func TestCreateInstanceWaitHandler(t *testing.T) {
 	}
 }
 
+func TestCreateInstanceWaitHandlerTerminalStates(t *testing.T) {
+	instanceId := "foo-bar"
+	apiClient := &apiClientInstanceMocked{
+		resourceId:    instanceId,
+		resourceState: redis.INSTANCESTATUS_STOPPED,
+	}
+
+	// A stopped instance is accepted instead of failing the wait at the timeout
+	handler := CreateInstanceWaitHandler(context.Background(), apiClient, "pid", instanceId).
+		SetTerminalStates([]string{string(redis.INSTANCESTATUS_ACTIVE), string(redis.INSTANCESTATUS_STOPPED)}, []string{string(redis.INSTANCESTATUS_FAILED)})
+	gotRes, err := handler.SetTimeout(10 * time.Millisecond).WaitWithContext(context.Background())
+	if err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+	wantRes := &redis.Instance{
+		InstanceId: &instanceId,
+		Status:     utils.Ptr(redis.INSTANCESTATUS_STOPPED),
+	}
+	if diff := cmp.Diff(gotRes, wantRes); diff != "" {
+		t.Fatalf("handler gotRes = %+v\n want %+v\n diff = %s", gotRes, wantRes, diff)
+	}
+}
+
 func TestUpdateInstanceWaitHandler(t *testing.T) {
 	tests := []struct {
 		desc          string
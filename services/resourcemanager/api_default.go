@@ -21,10 +21,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateFolder Create Folder
@@ -330,55 +333,100 @@ type DefaultApi interface {
 	PartialUpdateProjectExecute(ctx context.Context, id string) (*Project, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateFolderRequest interface {
 	CreateFolderPayload(createFolderPayload CreateFolderPayload) ApiCreateFolderRequest
+	RetryOnConflict(maxAttempts int) ApiCreateFolderRequest
+	SetQueryParam(key, value string) ApiCreateFolderRequest
+	AddQueryParam(key, value string) ApiCreateFolderRequest
+	Clone() ApiCreateFolderRequest
+	Fingerprint() (string, error)
 	Execute() (*FolderResponse, error)
 }
 
 type ApiCreateProjectRequest interface {
 	CreateProjectPayload(createProjectPayload CreateProjectPayload) ApiCreateProjectRequest
+	RetryOnConflict(maxAttempts int) ApiCreateProjectRequest
+	SetQueryParam(key, value string) ApiCreateProjectRequest
+	AddQueryParam(key, value string) ApiCreateProjectRequest
+	Clone() ApiCreateProjectRequest
+	Fingerprint() (string, error)
 	Execute() (*Project, error)
 }
 
 type ApiDeleteFolderRequest interface {
 	// If true, all nested, empty folders are deleted recursively - if no project is attached!
 	Force(force bool) ApiDeleteFolderRequest
+	SetQueryParam(key, value string) ApiDeleteFolderRequest
+	AddQueryParam(key, value string) ApiDeleteFolderRequest
+	Clone() ApiDeleteFolderRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteFolderLabelsRequest interface {
 	// Label name.
 	Keys(keys []string) ApiDeleteFolderLabelsRequest
+	SetQueryParam(key, value string) ApiDeleteFolderLabelsRequest
+	AddQueryParam(key, value string) ApiDeleteFolderLabelsRequest
+	Clone() ApiDeleteFolderLabelsRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteOrganizationLabelsRequest interface {
 	// Label name.
 	Keys(keys []string) ApiDeleteOrganizationLabelsRequest
+	SetQueryParam(key, value string) ApiDeleteOrganizationLabelsRequest
+	AddQueryParam(key, value string) ApiDeleteOrganizationLabelsRequest
+	Clone() ApiDeleteOrganizationLabelsRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteProjectRequest interface {
+	SetQueryParam(key, value string) ApiDeleteProjectRequest
+	AddQueryParam(key, value string) ApiDeleteProjectRequest
+	Clone() ApiDeleteProjectRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteProjectLabelsRequest interface {
 	// Label name.
 	Keys(keys []string) ApiDeleteProjectLabelsRequest
+	SetQueryParam(key, value string) ApiDeleteProjectLabelsRequest
+	AddQueryParam(key, value string) ApiDeleteProjectLabelsRequest
+	Clone() ApiDeleteProjectLabelsRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiGetFolderDetailsRequest interface {
 	IncludeParents(includeParents bool) ApiGetFolderDetailsRequest
+	SetQueryParam(key, value string) ApiGetFolderDetailsRequest
+	AddQueryParam(key, value string) ApiGetFolderDetailsRequest
+	Clone() ApiGetFolderDetailsRequest
+	Fingerprint() (string, error)
 	Execute() (*GetFolderDetailsResponse, error)
 }
 
 type ApiGetOrganizationRequest interface {
+	SetQueryParam(key, value string) ApiGetOrganizationRequest
+	AddQueryParam(key, value string) ApiGetOrganizationRequest
+	Clone() ApiGetOrganizationRequest
+	Fingerprint() (string, error)
 	Execute() (*OrganizationResponse, error)
 }
 
 type ApiGetProjectRequest interface {
 	IncludeParents(includeParents bool) ApiGetProjectRequest
+	SetQueryParam(key, value string) ApiGetProjectRequest
+	AddQueryParam(key, value string) ApiGetProjectRequest
+	Clone() ApiGetProjectRequest
+	Fingerprint() (string, error)
 	Execute() (*GetProjectResponse, error)
 }
 
@@ -395,6 +443,10 @@ type ApiListFoldersRequest interface {
 	Offset(offset float32) ApiListFoldersRequest
 	// A timestamp to specify the beginning of the creationTime from which entries should be returned. If not given, defaults to the beginning of time.
 	CreationTimeStart(creationTimeStart time.Time) ApiListFoldersRequest
+	SetQueryParam(key, value string) ApiListFoldersRequest
+	AddQueryParam(key, value string) ApiListFoldersRequest
+	Clone() ApiListFoldersRequest
+	Fingerprint() (string, error)
 	Execute() (*ListFoldersResponse, error)
 }
 
@@ -409,6 +461,10 @@ type ApiListOrganizationsRequest interface {
 	Offset(offset float32) ApiListOrganizationsRequest
 	// A timestamp to specify the beginning of the creationTime from which entries should be returned. If not given, defaults to the beginning of time.
 	CreationTimeStart(creationTimeStart time.Time) ApiListOrganizationsRequest
+	SetQueryParam(key, value string) ApiListOrganizationsRequest
+	AddQueryParam(key, value string) ApiListOrganizationsRequest
+	Clone() ApiListOrganizationsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListOrganizationsResponse, error)
 }
 
@@ -425,21 +481,37 @@ type ApiListProjectsRequest interface {
 	Limit(limit float32) ApiListProjectsRequest
 	// A timestamp to specify the beginning of the creationTime from which entries should be returned. If not given, defaults to the beginning of time.
 	CreationTimeStart(creationTimeStart time.Time) ApiListProjectsRequest
+	SetQueryParam(key, value string) ApiListProjectsRequest
+	AddQueryParam(key, value string) ApiListProjectsRequest
+	Clone() ApiListProjectsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListProjectsResponse, error)
 }
 
 type ApiPartialUpdateFolderRequest interface {
 	PartialUpdateFolderPayload(partialUpdateFolderPayload PartialUpdateFolderPayload) ApiPartialUpdateFolderRequest
+	SetQueryParam(key, value string) ApiPartialUpdateFolderRequest
+	AddQueryParam(key, value string) ApiPartialUpdateFolderRequest
+	Clone() ApiPartialUpdateFolderRequest
+	Fingerprint() (string, error)
 	Execute() (*FolderResponse, error)
 }
 
 type ApiPartialUpdateOrganizationRequest interface {
 	PartialUpdateOrganizationPayload(partialUpdateOrganizationPayload PartialUpdateOrganizationPayload) ApiPartialUpdateOrganizationRequest
+	SetQueryParam(key, value string) ApiPartialUpdateOrganizationRequest
+	AddQueryParam(key, value string) ApiPartialUpdateOrganizationRequest
+	Clone() ApiPartialUpdateOrganizationRequest
+	Fingerprint() (string, error)
 	Execute() (*OrganizationResponse, error)
 }
 
 type ApiPartialUpdateProjectRequest interface {
 	PartialUpdateProjectPayload(partialUpdateProjectPayload PartialUpdateProjectPayload) ApiPartialUpdateProjectRequest
+	SetQueryParam(key, value string) ApiPartialUpdateProjectRequest
+	AddQueryParam(key, value string) ApiPartialUpdateProjectRequest
+	Clone() ApiPartialUpdateProjectRequest
+	Fingerprint() (string, error)
 	Execute() (*Project, error)
 }
 
@@ -450,6 +522,8 @@ type CreateFolderRequest struct {
 	ctx                 context.Context
 	apiService          *DefaultApiService
 	createFolderPayload *CreateFolderPayload
+	retryOnConflict     int
+	queryParams         []queryParam
 }
 
 func (r CreateFolderRequest) CreateFolderPayload(createFolderPayload CreateFolderPayload) ApiCreateFolderRequest {
@@ -457,7 +531,50 @@ func (r CreateFolderRequest) CreateFolderPayload(createFolderPayload CreateFolde
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateFolderRequest) RetryOnConflict(maxAttempts int) ApiCreateFolderRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateFolderRequest) SetQueryParam(key, value string) ApiCreateFolderRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateFolderRequest) AddQueryParam(key, value string) ApiCreateFolderRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateFolderRequest) Clone() ApiCreateFolderRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateFolderRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateFolderRequest) Execute() (*FolderResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateFolder")
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateFolderRequest) execute() (*FolderResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -499,7 +616,8 @@ func (r CreateFolderRequest) Execute() (*FolderResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.createFolderPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateFolder"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -530,10 +648,14 @@ func (r CreateFolderRequest) Execute() (*FolderResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -544,7 +666,7 @@ func (r CreateFolderRequest) Execute() (*FolderResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -555,7 +677,7 @@ func (r CreateFolderRequest) Execute() (*FolderResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -566,7 +688,7 @@ func (r CreateFolderRequest) Execute() (*FolderResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -606,6 +728,8 @@ type CreateProjectRequest struct {
 	ctx                  context.Context
 	apiService           *DefaultApiService
 	createProjectPayload *CreateProjectPayload
+	retryOnConflict      int
+	queryParams          []queryParam
 }
 
 func (r CreateProjectRequest) CreateProjectPayload(createProjectPayload CreateProjectPayload) ApiCreateProjectRequest {
@@ -613,7 +737,50 @@ func (r CreateProjectRequest) CreateProjectPayload(createProjectPayload CreatePr
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateProjectRequest) RetryOnConflict(maxAttempts int) ApiCreateProjectRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateProjectRequest) SetQueryParam(key, value string) ApiCreateProjectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateProjectRequest) AddQueryParam(key, value string) ApiCreateProjectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateProjectRequest) Clone() ApiCreateProjectRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateProjectRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateProjectRequest) Execute() (*Project, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateProject")
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateProjectRequest) execute() (*Project, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -655,7 +822,8 @@ func (r CreateProjectRequest) Execute() (*Project, error) {
 	}
 	// body params
 	localVarPostBody = r.createProjectPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateProject"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -686,10 +854,14 @@ func (r CreateProjectRequest) Execute() (*Project, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -700,7 +872,7 @@ func (r CreateProjectRequest) Execute() (*Project, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -711,7 +883,7 @@ func (r CreateProjectRequest) Execute() (*Project, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -722,7 +894,7 @@ func (r CreateProjectRequest) Execute() (*Project, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -765,6 +937,7 @@ type DeleteFolderRequest struct {
 	apiService  *DefaultApiService
 	containerId string
 	force       *bool
+	queryParams []queryParam
 }
 
 // If true, all nested, empty folders are deleted recursively - if no project is attached!
@@ -774,7 +947,43 @@ func (r DeleteFolderRequest) Force(force bool) ApiDeleteFolderRequest {
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteFolderRequest) SetQueryParam(key, value string) ApiDeleteFolderRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteFolderRequest) AddQueryParam(key, value string) ApiDeleteFolderRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteFolderRequest) Clone() ApiDeleteFolderRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteFolderRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteFolderRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteFolder", oapierror.Parameter{Name: "containerId", Value: ParameterValueToString(r.containerId, "containerId")})
+	}
+	return err
+}
+
+func (r DeleteFolderRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -796,6 +1005,9 @@ func (r DeleteFolderRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.containerId == "" {
+		return &oapierror.ValidationError{Field: "containerId"}
+	}
 
 	if r.force != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "force", r.force, "")
@@ -817,7 +1029,8 @@ func (r DeleteFolderRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteFolder"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -848,10 +1061,14 @@ func (r DeleteFolderRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -862,7 +1079,7 @@ func (r DeleteFolderRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -909,6 +1126,7 @@ type DeleteFolderLabelsRequest struct {
 	apiService  *DefaultApiService
 	containerId string
 	keys        *[]string
+	queryParams []queryParam
 }
 
 // Label name.
@@ -918,7 +1136,43 @@ func (r DeleteFolderLabelsRequest) Keys(keys []string) ApiDeleteFolderLabelsRequ
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteFolderLabelsRequest) SetQueryParam(key, value string) ApiDeleteFolderLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteFolderLabelsRequest) AddQueryParam(key, value string) ApiDeleteFolderLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteFolderLabelsRequest) Clone() ApiDeleteFolderLabelsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteFolderLabelsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteFolderLabelsRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteFolderLabels", oapierror.Parameter{Name: "containerId", Value: ParameterValueToString(r.containerId, "containerId")})
+	}
+	return err
+}
+
+func (r DeleteFolderLabelsRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -940,6 +1194,9 @@ func (r DeleteFolderLabelsRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.containerId == "" {
+		return &oapierror.ValidationError{Field: "containerId"}
+	}
 
 	if r.keys != nil {
 		t := *r.keys
@@ -969,7 +1226,8 @@ func (r DeleteFolderLabelsRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteFolderLabels"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1000,10 +1258,14 @@ func (r DeleteFolderLabelsRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1050,6 +1312,7 @@ type DeleteOrganizationLabelsRequest struct {
 	apiService  *DefaultApiService
 	containerId string
 	keys        *[]string
+	queryParams []queryParam
 }
 
 // Label name.
@@ -1059,7 +1322,43 @@ func (r DeleteOrganizationLabelsRequest) Keys(keys []string) ApiDeleteOrganizati
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteOrganizationLabelsRequest) SetQueryParam(key, value string) ApiDeleteOrganizationLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteOrganizationLabelsRequest) AddQueryParam(key, value string) ApiDeleteOrganizationLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteOrganizationLabelsRequest) Clone() ApiDeleteOrganizationLabelsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteOrganizationLabelsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteOrganizationLabelsRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteOrganizationLabels", oapierror.Parameter{Name: "containerId", Value: ParameterValueToString(r.containerId, "containerId")})
+	}
+	return err
+}
+
+func (r DeleteOrganizationLabelsRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1081,6 +1380,9 @@ func (r DeleteOrganizationLabelsRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.containerId == "" {
+		return &oapierror.ValidationError{Field: "containerId"}
+	}
 
 	if r.keys != nil {
 		t := *r.keys
@@ -1110,7 +1412,8 @@ func (r DeleteOrganizationLabelsRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteOrganizationLabels"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1141,10 +1444,14 @@ func (r DeleteOrganizationLabelsRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1187,12 +1494,49 @@ func (a *APIClient) DeleteOrganizationLabelsExecute(ctx context.Context, contain
 }
 
 type DeleteProjectRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	id         string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	id          string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteProjectRequest) SetQueryParam(key, value string) ApiDeleteProjectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteProjectRequest) AddQueryParam(key, value string) ApiDeleteProjectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteProjectRequest) Clone() ApiDeleteProjectRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteProjectRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteProjectRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteProject", oapierror.Parameter{Name: "id", Value: ParameterValueToString(r.id, "id")})
+	}
+	return err
+}
+
+func (r DeleteProjectRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1214,6 +1558,9 @@ func (r DeleteProjectRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.id == "" {
+		return &oapierror.ValidationError{Field: "id"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1232,7 +1579,8 @@ func (r DeleteProjectRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteProject"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1263,10 +1611,14 @@ func (r DeleteProjectRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1313,6 +1665,7 @@ type DeleteProjectLabelsRequest struct {
 	apiService  *DefaultApiService
 	containerId string
 	keys        *[]string
+	queryParams []queryParam
 }
 
 // Label name.
@@ -1322,7 +1675,43 @@ func (r DeleteProjectLabelsRequest) Keys(keys []string) ApiDeleteProjectLabelsRe
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteProjectLabelsRequest) SetQueryParam(key, value string) ApiDeleteProjectLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteProjectLabelsRequest) AddQueryParam(key, value string) ApiDeleteProjectLabelsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteProjectLabelsRequest) Clone() ApiDeleteProjectLabelsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteProjectLabelsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r DeleteProjectLabelsRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteProjectLabels", oapierror.Parameter{Name: "containerId", Value: ParameterValueToString(r.containerId, "containerId")})
+	}
+	return err
+}
+
+func (r DeleteProjectLabelsRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1344,6 +1733,9 @@ func (r DeleteProjectLabelsRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.containerId == "" {
+		return &oapierror.ValidationError{Field: "containerId"}
+	}
 
 	if r.keys != nil {
 		t := *r.keys
@@ -1373,7 +1765,8 @@ func (r DeleteProjectLabelsRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteProjectLabels"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1404,10 +1797,14 @@ func (r DeleteProjectLabelsRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1454,6 +1851,7 @@ type GetFolderDetailsRequest struct {
 	apiService     *DefaultApiService
 	containerId    string
 	includeParents *bool
+	queryParams    []queryParam
 }
 
 func (r GetFolderDetailsRequest) IncludeParents(includeParents bool) ApiGetFolderDetailsRequest {
@@ -1461,7 +1859,43 @@ func (r GetFolderDetailsRequest) IncludeParents(includeParents bool) ApiGetFolde
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetFolderDetailsRequest) SetQueryParam(key, value string) ApiGetFolderDetailsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetFolderDetailsRequest) AddQueryParam(key, value string) ApiGetFolderDetailsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetFolderDetailsRequest) Clone() ApiGetFolderDetailsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetFolderDetailsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r GetFolderDetailsRequest) Execute() (*GetFolderDetailsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetFolderDetails", oapierror.Parameter{Name: "containerId", Value: ParameterValueToString(r.containerId, "containerId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetFolderDetailsRequest) execute() (*GetFolderDetailsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1484,6 +1918,9 @@ func (r GetFolderDetailsRequest) Execute() (*GetFolderDetailsResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.containerId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "containerId"}
+	}
 
 	if r.includeParents != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "includeParents", r.includeParents, "")
@@ -1505,7 +1942,8 @@ func (r GetFolderDetailsRequest) Execute() (*GetFolderDetailsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetFolderDetails"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1536,10 +1974,14 @@ func (r GetFolderDetailsRequest) Execute() (*GetFolderDetailsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1550,7 +1992,7 @@ func (r GetFolderDetailsRequest) Execute() (*GetFolderDetailsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1561,7 +2003,7 @@ func (r GetFolderDetailsRequest) Execute() (*GetFolderDetailsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1601,12 +2043,49 @@ func (a *APIClient) GetFolderDetailsExecute(ctx context.Context, containerId str
 }
 
 type GetOrganizationRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	id         string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	id          string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetOrganizationRequest) SetQueryParam(key, value string) ApiGetOrganizationRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetOrganizationRequest) AddQueryParam(key, value string) ApiGetOrganizationRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetOrganizationRequest) Clone() ApiGetOrganizationRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetOrganizationRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetOrganizationRequest) Execute() (*OrganizationResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetOrganization", oapierror.Parameter{Name: "id", Value: ParameterValueToString(r.id, "id")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetOrganizationRequest) execute() (*OrganizationResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1629,6 +2108,9 @@ func (r GetOrganizationRequest) Execute() (*OrganizationResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.id == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "id"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1647,7 +2129,8 @@ func (r GetOrganizationRequest) Execute() (*OrganizationResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetOrganization"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1678,10 +2161,14 @@ func (r GetOrganizationRequest) Execute() (*OrganizationResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1692,7 +2179,7 @@ func (r GetOrganizationRequest) Execute() (*OrganizationResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1736,6 +2223,7 @@ type GetProjectRequest struct {
 	apiService     *DefaultApiService
 	id             string
 	includeParents *bool
+	queryParams    []queryParam
 }
 
 func (r GetProjectRequest) IncludeParents(includeParents bool) ApiGetProjectRequest {
@@ -1743,7 +2231,43 @@ func (r GetProjectRequest) IncludeParents(includeParents bool) ApiGetProjectRequ
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetProjectRequest) SetQueryParam(key, value string) ApiGetProjectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetProjectRequest) AddQueryParam(key, value string) ApiGetProjectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetProjectRequest) Clone() ApiGetProjectRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetProjectRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r GetProjectRequest) Execute() (*GetProjectResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetProject", oapierror.Parameter{Name: "id", Value: ParameterValueToString(r.id, "id")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetProjectRequest) execute() (*GetProjectResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1766,6 +2290,9 @@ func (r GetProjectRequest) Execute() (*GetProjectResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.id == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "id"}
+	}
 
 	if r.includeParents != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "includeParents", r.includeParents, "")
@@ -1787,7 +2314,8 @@ func (r GetProjectRequest) Execute() (*GetProjectResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetProject"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1818,10 +2346,14 @@ func (r GetProjectRequest) Execute() (*GetProjectResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1832,7 +2364,7 @@ func (r GetProjectRequest) Execute() (*GetProjectResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1843,7 +2375,7 @@ func (r GetProjectRequest) Execute() (*GetProjectResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1891,6 +2423,7 @@ type ListFoldersRequest struct {
 	limit             *float32
 	offset            *float32
 	creationTimeStart *time.Time
+	queryParams       []queryParam
 }
 
 // Identifier of the parent resource container - containerId as well as UUID identifier is supported.
@@ -1935,7 +2468,43 @@ func (r ListFoldersRequest) CreationTimeStart(creationTimeStart time.Time) ApiLi
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListFoldersRequest) SetQueryParam(key, value string) ApiListFoldersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListFoldersRequest) AddQueryParam(key, value string) ApiListFoldersRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListFoldersRequest) Clone() ApiListFoldersRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListFoldersRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListFoldersRequest) Execute() (*ListFoldersResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListFolders")
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListFoldersRequest) execute() (*ListFoldersResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2001,7 +2570,8 @@ func (r ListFoldersRequest) Execute() (*ListFoldersResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListFolders"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2020,6 +2590,10 @@ func (r ListFoldersRequest) Execute() (*ListFoldersResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2032,10 +2606,14 @@ func (r ListFoldersRequest) Execute() (*ListFoldersResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2046,7 +2624,7 @@ func (r ListFoldersRequest) Execute() (*ListFoldersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2057,7 +2635,7 @@ func (r ListFoldersRequest) Execute() (*ListFoldersResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2068,7 +2646,7 @@ func (r ListFoldersRequest) Execute() (*ListFoldersResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2121,6 +2699,7 @@ type ListOrganizationsRequest struct {
 	limit             *float32
 	offset            *float32
 	creationTimeStart *time.Time
+	queryParams       []queryParam
 }
 
 // Organization identifiers - containerId as well as UUID identifier is supported. A combination of both is not allowed.
@@ -2158,7 +2737,43 @@ func (r ListOrganizationsRequest) CreationTimeStart(creationTimeStart time.Time)
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListOrganizationsRequest) SetQueryParam(key, value string) ApiListOrganizationsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListOrganizationsRequest) AddQueryParam(key, value string) ApiListOrganizationsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListOrganizationsRequest) Clone() ApiListOrganizationsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListOrganizationsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListOrganizationsRequest) Execute() (*ListOrganizationsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListOrganizations")
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListOrganizationsRequest) execute() (*ListOrganizationsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2221,7 +2836,8 @@ func (r ListOrganizationsRequest) Execute() (*ListOrganizationsResponse, error)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListOrganizations"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2240,6 +2856,10 @@ func (r ListOrganizationsRequest) Execute() (*ListOrganizationsResponse, error)
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2252,10 +2872,14 @@ func (r ListOrganizationsRequest) Execute() (*ListOrganizationsResponse, error)
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2266,7 +2890,7 @@ func (r ListOrganizationsRequest) Execute() (*ListOrganizationsResponse, error)
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2277,7 +2901,7 @@ func (r ListOrganizationsRequest) Execute() (*ListOrganizationsResponse, error)
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2326,6 +2950,7 @@ type ListProjectsRequest struct {
 	offset            *float32
 	limit             *float32
 	creationTimeStart *time.Time
+	queryParams       []queryParam
 }
 
 // Identifier of the parent resource container - containerId as well as UUID identifier is supported.
@@ -2370,7 +2995,43 @@ func (r ListProjectsRequest) CreationTimeStart(creationTimeStart time.Time) ApiL
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListProjectsRequest) SetQueryParam(key, value string) ApiListProjectsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListProjectsRequest) AddQueryParam(key, value string) ApiListProjectsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListProjectsRequest) Clone() ApiListProjectsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListProjectsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListProjectsRequest) Execute() (*ListProjectsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListProjects")
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListProjectsRequest) execute() (*ListProjectsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2436,7 +3097,8 @@ func (r ListProjectsRequest) Execute() (*ListProjectsResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListProjects"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2455,6 +3117,10 @@ func (r ListProjectsRequest) Execute() (*ListProjectsResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2467,10 +3133,14 @@ func (r ListProjectsRequest) Execute() (*ListProjectsResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2481,7 +3151,7 @@ func (r ListProjectsRequest) Execute() (*ListProjectsResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2492,7 +3162,7 @@ func (r ListProjectsRequest) Execute() (*ListProjectsResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2542,6 +3212,7 @@ type PartialUpdateFolderRequest struct {
 	apiService                 *DefaultApiService
 	containerId                string
 	partialUpdateFolderPayload *PartialUpdateFolderPayload
+	queryParams                []queryParam
 }
 
 func (r PartialUpdateFolderRequest) PartialUpdateFolderPayload(partialUpdateFolderPayload PartialUpdateFolderPayload) ApiPartialUpdateFolderRequest {
@@ -2549,7 +3220,43 @@ func (r PartialUpdateFolderRequest) PartialUpdateFolderPayload(partialUpdateFold
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateFolderRequest) SetQueryParam(key, value string) ApiPartialUpdateFolderRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateFolderRequest) AddQueryParam(key, value string) ApiPartialUpdateFolderRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateFolderRequest) Clone() ApiPartialUpdateFolderRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateFolderRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateFolderRequest) Execute() (*FolderResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateFolder", oapierror.Parameter{Name: "containerId", Value: ParameterValueToString(r.containerId, "containerId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateFolderRequest) execute() (*FolderResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -2572,6 +3279,9 @@ func (r PartialUpdateFolderRequest) Execute() (*FolderResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.containerId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "containerId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -2592,7 +3302,8 @@ func (r PartialUpdateFolderRequest) Execute() (*FolderResponse, error) {
 	}
 	// body params
 	localVarPostBody = r.partialUpdateFolderPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateFolder"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2623,10 +3334,14 @@ func (r PartialUpdateFolderRequest) Execute() (*FolderResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2637,7 +3352,7 @@ func (r PartialUpdateFolderRequest) Execute() (*FolderResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2648,7 +3363,7 @@ func (r PartialUpdateFolderRequest) Execute() (*FolderResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2659,7 +3374,7 @@ func (r PartialUpdateFolderRequest) Execute() (*FolderResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2706,6 +3421,7 @@ type PartialUpdateOrganizationRequest struct {
 	apiService                       *DefaultApiService
 	id                               string
 	partialUpdateOrganizationPayload *PartialUpdateOrganizationPayload
+	queryParams                      []queryParam
 }
 
 func (r PartialUpdateOrganizationRequest) PartialUpdateOrganizationPayload(partialUpdateOrganizationPayload PartialUpdateOrganizationPayload) ApiPartialUpdateOrganizationRequest {
@@ -2713,7 +3429,43 @@ func (r PartialUpdateOrganizationRequest) PartialUpdateOrganizationPayload(parti
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateOrganizationRequest) SetQueryParam(key, value string) ApiPartialUpdateOrganizationRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateOrganizationRequest) AddQueryParam(key, value string) ApiPartialUpdateOrganizationRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateOrganizationRequest) Clone() ApiPartialUpdateOrganizationRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateOrganizationRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateOrganizationRequest) Execute() (*OrganizationResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateOrganization", oapierror.Parameter{Name: "id", Value: ParameterValueToString(r.id, "id")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateOrganizationRequest) execute() (*OrganizationResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -2736,6 +3488,9 @@ func (r PartialUpdateOrganizationRequest) Execute() (*OrganizationResponse, erro
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.id == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "id"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -2756,7 +3511,8 @@ func (r PartialUpdateOrganizationRequest) Execute() (*OrganizationResponse, erro
 	}
 	// body params
 	localVarPostBody = r.partialUpdateOrganizationPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateOrganization"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2787,10 +3543,14 @@ func (r PartialUpdateOrganizationRequest) Execute() (*OrganizationResponse, erro
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2801,7 +3561,7 @@ func (r PartialUpdateOrganizationRequest) Execute() (*OrganizationResponse, erro
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2812,7 +3572,7 @@ func (r PartialUpdateOrganizationRequest) Execute() (*OrganizationResponse, erro
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2858,6 +3618,7 @@ type PartialUpdateProjectRequest struct {
 	apiService                  *DefaultApiService
 	id                          string
 	partialUpdateProjectPayload *PartialUpdateProjectPayload
+	queryParams                 []queryParam
 }
 
 func (r PartialUpdateProjectRequest) PartialUpdateProjectPayload(partialUpdateProjectPayload PartialUpdateProjectPayload) ApiPartialUpdateProjectRequest {
@@ -2865,7 +3626,43 @@ func (r PartialUpdateProjectRequest) PartialUpdateProjectPayload(partialUpdatePr
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateProjectRequest) SetQueryParam(key, value string) ApiPartialUpdateProjectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateProjectRequest) AddQueryParam(key, value string) ApiPartialUpdateProjectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateProjectRequest) Clone() ApiPartialUpdateProjectRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateProjectRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateProjectRequest) Execute() (*Project, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateProject", oapierror.Parameter{Name: "id", Value: ParameterValueToString(r.id, "id")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateProjectRequest) execute() (*Project, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -2888,6 +3685,9 @@ func (r PartialUpdateProjectRequest) Execute() (*Project, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.id == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "id"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -2908,7 +3708,8 @@ func (r PartialUpdateProjectRequest) Execute() (*Project, error) {
 	}
 	// body params
 	localVarPostBody = r.partialUpdateProjectPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateProject"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2939,10 +3740,14 @@ func (r PartialUpdateProjectRequest) Execute() (*Project, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2953,7 +3758,7 @@ func (r PartialUpdateProjectRequest) Execute() (*Project, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2964,7 +3769,7 @@ func (r PartialUpdateProjectRequest) Execute() (*Project, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2975,7 +3780,7 @@ func (r PartialUpdateProjectRequest) Execute() (*Project, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
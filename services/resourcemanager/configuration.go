@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/resourcemanager",
+		ServiceName:   "resourcemanager",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,28 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateFolder",
+	"CreateProject",
+	"DeleteFolder",
+	"DeleteFolderLabels",
+	"DeleteOrganizationLabels",
+	"DeleteProject",
+	"DeleteProjectLabels",
+	"GetFolderDetails",
+	"GetOrganization",
+	"GetProject",
+	"ListFolders",
+	"ListOrganizations",
+	"ListProjects",
+	"PartialUpdateFolder",
+	"PartialUpdateOrganization",
+	"PartialUpdateProject",
+}
+
+func init() {
+	config.RegisterOperations("resourcemanager", operations...)
+}
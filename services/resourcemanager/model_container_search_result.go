@@ -76,14 +76,9 @@ func (v *ContainerSearchResultContainerType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ContainerSearchResultContainerType(value)
-	for _, existing := range AllowedContainerSearchResultContainerTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ContainerSearchResult", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewContainerSearchResultContainerTypeFromValue returns a pointer to a valid ContainerSearchResultContainerType
@@ -107,6 +102,17 @@ func (v ContainerSearchResultContainerType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ContainerSearchResultContainerType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ContainerSearchResultContainerType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ContainerTypeContainerType value
 func (v ContainerSearchResultContainerType) Ptr() *ContainerSearchResultContainerType {
 	return &v
@@ -46,14 +46,9 @@ func (v *LifecycleState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := LifecycleState(value)
-	for _, existing := range AllowedLifecycleStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid LifecycleState", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewLifecycleStateFromValue returns a pointer to a valid LifecycleState
@@ -77,6 +72,17 @@ func (v LifecycleState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v LifecycleState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v LifecycleState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to LifecycleState value
 func (v LifecycleState) Ptr() *LifecycleState {
 	return &v
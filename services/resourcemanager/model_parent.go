@@ -97,14 +97,9 @@ func (v *ParentTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ParentTypes(value)
-	for _, existing := range AllowedParentTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Parent", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewParentTypesFromValue returns a pointer to a valid ParentTypes
@@ -128,6 +123,17 @@ func (v ParentTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ParentTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ParentTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v ParentTypes) Ptr() *ParentTypes {
 	return &v
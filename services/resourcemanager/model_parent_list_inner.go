@@ -160,14 +160,9 @@ func (v *ParentListInnerTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ParentListInnerTypes(value)
-	for _, existing := range AllowedParentListInnerTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ParentListInner", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewParentListInnerTypesFromValue returns a pointer to a valid ParentListInnerTypes
@@ -191,6 +186,17 @@ func (v ParentListInnerTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ParentListInnerTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ParentListInnerTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v ParentListInnerTypes) Ptr() *ParentListInnerTypes {
 	return &v
@@ -0,0 +1,55 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/resourcemanager"
+)
+
+// CreateProjectOperation returns the async action of CreateProjectWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateProjectPollFunc. The name of the operation is
+// "resourcemanager/CreateProject/{containerId}".
+func CreateProjectOperation(a APIClientInterface, containerId string) *lro.Operation[resourcemanager.GetProjectResponse] {
+	return createProjectOperationHandler(a).Operation(containerId)
+}
+
+// CreateProjectPollFunc returns the lro.PollFunc of the operations returned by CreateProjectOperation, e.g. to resume
+// one with lro.Resume
+func CreateProjectPollFunc(a APIClientInterface) lro.PollFunc[resourcemanager.GetProjectResponse] {
+	return createProjectOperationHandler(a).PollFunc()
+}
+
+func createProjectOperationHandler(a APIClientInterface) lro.WaitHandler[resourcemanager.GetProjectResponse] {
+	return lro.WaitHandler[resourcemanager.GetProjectResponse]{
+		Kind: "resourcemanager/CreateProject",
+		IDs:  1,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[resourcemanager.GetProjectResponse] {
+			return CreateProjectWaitHandler(ctx, a, ids[0])
+		},
+	}
+}
+
+// DeleteProjectOperation returns the async action of DeleteProjectWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteProjectPollFunc. The name of the operation is
+// "resourcemanager/DeleteProject/{containerId}".
+func DeleteProjectOperation(a APIClientInterface, containerId string) *lro.Operation[struct{}] {
+	return deleteProjectOperationHandler(a).Operation(containerId)
+}
+
+// DeleteProjectPollFunc returns the lro.PollFunc of the operations returned by DeleteProjectOperation, e.g. to resume
+// one with lro.Resume
+func DeleteProjectPollFunc(a APIClientInterface) lro.PollFunc[struct{}] {
+	return deleteProjectOperationHandler(a).PollFunc()
+}
+
+func deleteProjectOperationHandler(a APIClientInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "resourcemanager/DeleteProject",
+		IDs:  1,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteProjectWaitHandler(ctx, a, ids[0])
+		},
+	}
+}
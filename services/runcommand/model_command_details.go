@@ -225,14 +225,9 @@ func (v *CommandDetailsStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CommandDetailsStatus(value)
-	for _, existing := range AllowedCommandDetailsStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CommandDetails", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCommandDetailsStatusFromValue returns a pointer to a valid CommandDetailsStatus
@@ -256,6 +251,17 @@ func (v CommandDetailsStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CommandDetailsStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CommandDetailsStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v CommandDetailsStatus) Ptr() *CommandDetailsStatus {
 	return &v
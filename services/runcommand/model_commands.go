@@ -163,14 +163,9 @@ func (v *CommandsStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CommandsStatus(value)
-	for _, existing := range AllowedCommandsStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Commands", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCommandsStatusFromValue returns a pointer to a valid CommandsStatus
@@ -194,6 +189,17 @@ func (v CommandsStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CommandsStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CommandsStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v CommandsStatus) Ptr() *CommandsStatus {
 	return &v
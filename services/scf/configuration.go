@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/scf",
+		ServiceName:   "scf",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,35 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"ApplyOrganizationQuota",
+	"CreateOrgManager",
+	"CreateOrgRole",
+	"CreateOrganization",
+	"CreateSpace",
+	"CreateSpaceRole",
+	"DeleteOrgManager",
+	"DeleteOrganization",
+	"DeleteSpace",
+	"GetOrgManager",
+	"GetOrganization",
+	"GetOrganizationQuota",
+	"GetOrganizationUsageSummary",
+	"GetPlatform",
+	"GetPlatformQuota",
+	"GetSpace",
+	"ListOrganizationQuotas",
+	"ListOrganizations",
+	"ListPlatformQuotas",
+	"ListPlatforms",
+	"ListSpaces",
+	"UpdateOrganization",
+	"UpdateSpace",
+}
+
+func init() {
+	config.RegisterOperations("scf", operations...)
+}
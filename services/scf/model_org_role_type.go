@@ -46,14 +46,9 @@ func (v *OrgRoleType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := OrgRoleType(value)
-	for _, existing := range AllowedOrgRoleTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid OrgRoleType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewOrgRoleTypeFromValue returns a pointer to a valid OrgRoleType
@@ -77,6 +72,17 @@ func (v OrgRoleType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v OrgRoleType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v OrgRoleType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to OrgRoleType value
 func (v OrgRoleType) Ptr() *OrgRoleType {
 	return &v
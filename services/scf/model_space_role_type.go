@@ -46,14 +46,9 @@ func (v *SpaceRoleType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := SpaceRoleType(value)
-	for _, existing := range AllowedSpaceRoleTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid SpaceRoleType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewSpaceRoleTypeFromValue returns a pointer to a valid SpaceRoleType
@@ -77,6 +72,17 @@ func (v SpaceRoleType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v SpaceRoleType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v SpaceRoleType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to SpaceRoleType value
 func (v SpaceRoleType) Ptr() *SpaceRoleType {
 	return &v
@@ -0,0 +1,32 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/scf"
+)
+
+// DeleteOrganizationOperation returns the async action of DeleteOrganizationWaitHandler as an lro.Operation, which can
+// be serialized to resume waiting for it in another process with DeleteOrganizationPollFunc. The name of the operation
+// is "scf/DeleteOrganization/{projectId}/{region}/{orgId}".
+func DeleteOrganizationOperation(a APIClientInterface, projectId, region, orgId string) *lro.Operation[scf.Organization] {
+	return deleteOrganizationOperationHandler(a).Operation(projectId, region, orgId)
+}
+
+// DeleteOrganizationPollFunc returns the lro.PollFunc of the operations returned by DeleteOrganizationOperation, e.g.
+// to resume one with lro.Resume
+func DeleteOrganizationPollFunc(a APIClientInterface) lro.PollFunc[scf.Organization] {
+	return deleteOrganizationOperationHandler(a).PollFunc()
+}
+
+func deleteOrganizationOperationHandler(a APIClientInterface) lro.WaitHandler[scf.Organization] {
+	return lro.WaitHandler[scf.Organization]{
+		Kind: "scf/DeleteOrganization",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[scf.Organization] {
+			return DeleteOrganizationWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/secretsmanager",
+		ServiceName:   "secretsmanager",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -39,3 +40,28 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateACL",
+	"CreateInstance",
+	"CreateUser",
+	"DeleteACL",
+	"DeleteInstance",
+	"DeleteUser",
+	"GetACL",
+	"GetInstance",
+	"GetUser",
+	"ListACLs",
+	"ListInstances",
+	"ListUsers",
+	"UpdateACL",
+	"UpdateACLs",
+	"UpdateInstance",
+	"UpdateUser",
+}
+
+func init() {
+	config.RegisterOperations("secretsmanager", operations...)
+}
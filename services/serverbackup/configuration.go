@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/serverbackup",
+		ServiceName:   "serverbackup",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,28 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateBackup",
+	"CreateBackupSchedule",
+	"DeleteBackup",
+	"DeleteBackupSchedule",
+	"DeleteVolumeBackup",
+	"DisableServiceResource",
+	"EnableServiceResource",
+	"GetBackup",
+	"GetBackupSchedule",
+	"GetServiceResource",
+	"ListBackupPolicies",
+	"ListBackupSchedules",
+	"ListBackups",
+	"RestoreBackup",
+	"RestoreVolumeBackup",
+	"UpdateBackupSchedule",
+}
+
+func init() {
+	config.RegisterOperations("serverbackup", operations...)
+}
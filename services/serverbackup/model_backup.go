@@ -194,14 +194,9 @@ func (v *BackupStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := BackupStatus(value)
-	for _, existing := range AllowedBackupStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Backup", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewBackupStatusFromValue returns a pointer to a valid BackupStatus
@@ -225,6 +220,17 @@ func (v BackupStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v BackupStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v BackupStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v BackupStatus) Ptr() *BackupStatus {
 	return &v
@@ -148,14 +148,9 @@ func (v *BackupVolumeBackupsInnerStatus) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := BackupVolumeBackupsInnerStatus(value)
-	for _, existing := range AllowedBackupVolumeBackupsInnerStatusEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid BackupVolumeBackupsInner", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewBackupVolumeBackupsInnerStatusFromValue returns a pointer to a valid BackupVolumeBackupsInnerStatus
@@ -179,6 +174,17 @@ func (v BackupVolumeBackupsInnerStatus) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v BackupVolumeBackupsInnerStatus) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v BackupVolumeBackupsInnerStatus) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StatusStatus value
 func (v BackupVolumeBackupsInnerStatus) Ptr() *BackupVolumeBackupsInnerStatus {
 	return &v
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/serverupdate",
+		ServiceName:   "serverupdate",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,24 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateUpdate",
+	"CreateUpdateSchedule",
+	"DeleteUpdateSchedule",
+	"DisableServiceResource",
+	"EnableServiceResource",
+	"GetServiceResource",
+	"GetUpdate",
+	"GetUpdateSchedule",
+	"ListUpdatePolicies",
+	"ListUpdateSchedules",
+	"ListUpdates",
+	"UpdateUpdateSchedule",
+}
+
+func init() {
+	config.RegisterOperations("serverupdate", operations...)
+}
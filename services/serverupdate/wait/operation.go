@@ -0,0 +1,32 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/serverupdate"
+)
+
+// UpdateOperation returns the async action of UpdateWaitHandler as an lro.Operation, which can be serialized to resume
+// waiting for it in another process with UpdatePollFunc. The name of the operation is
+// "serverupdate/Update/{projectId}/{serverId}/{region}/{updateId}".
+func UpdateOperation(a APIClientUpdateInterface, projectId, serverId, region, updateId string) *lro.Operation[serverupdate.Update] {
+	return updateOperationHandler(a).Operation(projectId, serverId, region, updateId)
+}
+
+// UpdatePollFunc returns the lro.PollFunc of the operations returned by UpdateOperation, e.g. to resume one with
+// lro.Resume
+func UpdatePollFunc(a APIClientUpdateInterface) lro.PollFunc[serverupdate.Update] {
+	return updateOperationHandler(a).PollFunc()
+}
+
+func updateOperationHandler(a APIClientUpdateInterface) lro.WaitHandler[serverupdate.Update] {
+	return lro.WaitHandler[serverupdate.Update]{
+		Kind: "serverupdate/Update",
+		IDs:  4,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[serverupdate.Update] {
+			return UpdateWaitHandler(ctx, a, ids[0], ids[1], ids[2], ids[3])
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/serverupdate"
+)
+
+// Interface needed for tests
+type APIClientUpdateInterface interface {
+	CreateUpdate(ctx context.Context, projectId string, serverId string, region string) serverupdate.ApiCreateUpdateRequest
+	GetUpdateExecute(ctx context.Context, projectId string, serverId string, updateId string, region string) (*serverupdate.Update, error)
+}
+
+// Interface needed for tests
+type APIClientScheduleInterface interface {
+	ListUpdateSchedulesExecute(ctx context.Context, projectId string, serverId string, region string) (*serverupdate.GetUpdateSchedulesResponse, error)
+	CreateUpdateSchedule(ctx context.Context, projectId string, serverId string, region string) serverupdate.ApiCreateUpdateScheduleRequest
+	UpdateUpdateSchedule(ctx context.Context, projectId string, serverId string, scheduleId string, region string) serverupdate.ApiUpdateUpdateScheduleRequest
+}
+
+// UpdateWaitHandler will wait for an update to finish. It fails if the update reports a fail reason.
+func UpdateWaitHandler(ctx context.Context, a APIClientUpdateInterface, projectId, serverId, region, updateId string) *wait.AsyncActionHandler[serverupdate.Update] {
+	handler := wait.New(func() (waitFinished bool, response *serverupdate.Update, err error) {
+		s, err := a.GetUpdateExecute(ctx, projectId, serverId, updateId, region)
+		if err != nil {
+			return false, nil, err
+		}
+		if s.FailReason != nil && *s.FailReason != "" {
+			return true, s, fmt.Errorf("update with id %s failed: %s", updateId, *s.FailReason)
+		}
+		if s.EndDate != nil && *s.EndDate != "" {
+			return true, s, nil
+		}
+		return false, nil, nil
+	})
+	handler.SetTimeout(2 * time.Hour)
+	return handler
+}
+
+// TriggerUpdateAndWait runs an update of the server now and waits until it is finished, returning the final state of the update
+func TriggerUpdateAndWait(ctx context.Context, a APIClientUpdateInterface, projectId, serverId, region string, payload serverupdate.CreateUpdatePayload) (*serverupdate.Update, error) {
+	return triggerUpdateAndWait(ctx, a, projectId, serverId, region, payload, 5*time.Second)
+}
+
+func triggerUpdateAndWait(ctx context.Context, a APIClientUpdateInterface, projectId, serverId, region string, payload serverupdate.CreateUpdatePayload, throttle time.Duration) (*serverupdate.Update, error) {
+	update, err := a.CreateUpdate(ctx, projectId, serverId, region).CreateUpdatePayload(payload).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("create update: %w", err)
+	}
+	if update.Id == nil {
+		return nil, fmt.Errorf("create update: response has no id")
+	}
+	updateId := strconv.FormatInt(*update.Id, 10)
+	return UpdateWaitHandler(ctx, a, projectId, serverId, region, updateId).SetThrottle(throttle).WaitWithContext(ctx)
+}
+
+// UpsertSchedule creates the update schedule, or updates the existing schedule of the server with the same name.
+// If the existing schedule already matches, it is returned without being updated.
+// It fails if the server has multiple schedules with the name.
+func UpsertSchedule(ctx context.Context, a APIClientScheduleInterface, projectId, serverId, region string, schedule serverupdate.CreateUpdateSchedulePayload) (*serverupdate.UpdateSchedule, error) {
+	if schedule.Name == nil || *schedule.Name == "" {
+		return nil, fmt.Errorf("schedule name cannot be empty")
+	}
+
+	schedules, err := a.ListUpdateSchedulesExecute(ctx, projectId, serverId, region)
+	if err != nil {
+		return nil, fmt.Errorf("list update schedules: %w", err)
+	}
+	var existing *serverupdate.UpdateSchedule
+	if schedules.Items != nil {
+		for i := range *schedules.Items {
+			item := (*schedules.Items)[i]
+			if item.Name == nil || *item.Name != *schedule.Name {
+				continue
+			}
+			if existing != nil {
+				return nil, fmt.Errorf("multiple update schedules found with name %s", *schedule.Name)
+			}
+			existing = &item
+		}
+	}
+
+	if existing == nil {
+		created, err := a.CreateUpdateSchedule(ctx, projectId, serverId, region).CreateUpdateSchedulePayload(schedule).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("create update schedule: %w", err)
+		}
+		return created, nil
+	}
+
+	if scheduleMatches(existing, schedule) {
+		return existing, nil
+	}
+	if existing.Id == nil {
+		return nil, fmt.Errorf("update schedule %s has no id", *schedule.Name)
+	}
+	updated, err := a.UpdateUpdateSchedule(ctx, projectId, serverId, strconv.FormatInt(*existing.Id, 10), region).UpdateUpdateSchedulePayload(serverupdate.UpdateUpdateSchedulePayload{
+		Enabled:           schedule.Enabled,
+		MaintenanceWindow: schedule.MaintenanceWindow,
+		Name:              schedule.Name,
+		Rrule:             schedule.Rrule,
+	}).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("update update schedule: %w", err)
+	}
+	return updated, nil
+}
+
+func scheduleMatches(existing *serverupdate.UpdateSchedule, schedule serverupdate.CreateUpdateSchedulePayload) bool {
+	return equalPtr(existing.Enabled, schedule.Enabled) &&
+		equalPtr(existing.MaintenanceWindow, schedule.MaintenanceWindow) &&
+		equalPtr(existing.Rrule, schedule.Rrule)
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,334 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/serverupdate"
+)
+
+// Used for testing update operations
+type apiClientUpdateMocked struct {
+	createFails      bool
+	getFails         bool
+	callsUntilFinish int
+	failReason       string
+	getCalls         int
+}
+
+func (a *apiClientUpdateMocked) CreateUpdate(_ context.Context, _, _, _ string) serverupdate.ApiCreateUpdateRequest {
+	return &createUpdateRequestMocked{a: a}
+}
+
+func (a *apiClientUpdateMocked) GetUpdateExecute(_ context.Context, _, _, updateId, _ string) (*serverupdate.Update, error) {
+	if a.getFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: 500,
+		}
+	}
+	if updateId != "1" {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: 404,
+		}
+	}
+	a.getCalls++
+	update := &serverupdate.Update{
+		Id:     utils.Ptr(int64(1)),
+		Status: utils.Ptr("running"),
+	}
+	if a.getCalls >= a.callsUntilFinish {
+		update.Status = utils.Ptr("finished")
+		update.EndDate = utils.Ptr("2025-01-01T00:00:00Z")
+		if a.failReason != "" {
+			update.Status = utils.Ptr("failed")
+			update.FailReason = utils.Ptr(a.failReason)
+		}
+	}
+	return update, nil
+}
+
+type createUpdateRequestMocked struct {
+	a *apiClientUpdateMocked
+}
+
+func (r *createUpdateRequestMocked) CreateUpdatePayload(_ serverupdate.CreateUpdatePayload) serverupdate.ApiCreateUpdateRequest {
+	return r
+}
+
+func (r *createUpdateRequestMocked) RetryOnConflict(_ int) serverupdate.ApiCreateUpdateRequest {
+	return r
+}
+
+func (r *createUpdateRequestMocked) SetQueryParam(_, _ string) serverupdate.ApiCreateUpdateRequest {
+	return r
+}
+
+func (r *createUpdateRequestMocked) AddQueryParam(_, _ string) serverupdate.ApiCreateUpdateRequest {
+	return r
+}
+
+func (r *createUpdateRequestMocked) Clone() serverupdate.ApiCreateUpdateRequest {
+	return r
+}
+
+func (r *createUpdateRequestMocked) Fingerprint() (string, error) {
+	return "", nil
+}
+
+func (r *createUpdateRequestMocked) Execute() (*serverupdate.Update, error) {
+	if r.a.createFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: 500,
+		}
+	}
+	return &serverupdate.Update{Id: utils.Ptr(int64(1))}, nil
+}
+
+func TestTriggerUpdateAndWait(t *testing.T) {
+	tests := []struct {
+		desc             string
+		createFails      bool
+		getFails         bool
+		callsUntilFinish int
+		failReason       string
+		wantErr          bool
+		wantStatus       string
+	}{
+		{
+			desc:             "update_succeeded",
+			callsUntilFinish: 3,
+			wantErr:          false,
+			wantStatus:       "finished",
+		},
+		{
+			desc:             "update_failed",
+			callsUntilFinish: 2,
+			failReason:       "package manager locked",
+			wantErr:          true,
+			wantStatus:       "failed",
+		},
+		{
+			desc:        "create_fails",
+			createFails: true,
+			wantErr:     true,
+		},
+		{
+			desc:     "get_fails",
+			getFails: true,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientUpdateMocked{
+				createFails:      tt.createFails,
+				getFails:         tt.getFails,
+				callsUntilFinish: tt.callsUntilFinish,
+				failReason:       tt.failReason,
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			gotRes, err := triggerUpdateAndWait(ctx, apiClient, "pid", "sid", "eu01", serverupdate.CreateUpdatePayload{}, time.Millisecond)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantStatus == "" {
+				return
+			}
+			if gotRes == nil || gotRes.Status == nil || *gotRes.Status != tt.wantStatus {
+				t.Fatalf("handler gotRes = %v, want status %s", gotRes, tt.wantStatus)
+			}
+			if apiClient.getCalls != tt.callsUntilFinish {
+				t.Fatalf("expected %d calls, got %d", tt.callsUntilFinish, apiClient.getCalls)
+			}
+		})
+	}
+}
+
+// Used for testing schedule operations
+type apiClientScheduleMocked struct {
+	schedules []serverupdate.UpdateSchedule
+	created   *serverupdate.CreateUpdateSchedulePayload
+	updatedId string
+	updated   *serverupdate.UpdateUpdateSchedulePayload
+}
+
+func (a *apiClientScheduleMocked) ListUpdateSchedulesExecute(_ context.Context, _, _, _ string) (*serverupdate.GetUpdateSchedulesResponse, error) {
+	return &serverupdate.GetUpdateSchedulesResponse{Items: &a.schedules}, nil
+}
+
+func (a *apiClientScheduleMocked) CreateUpdateSchedule(_ context.Context, _, _, _ string) serverupdate.ApiCreateUpdateScheduleRequest {
+	return &createUpdateScheduleRequestMocked{a: a}
+}
+
+func (a *apiClientScheduleMocked) UpdateUpdateSchedule(_ context.Context, _, _, scheduleId, _ string) serverupdate.ApiUpdateUpdateScheduleRequest {
+	return &updateUpdateScheduleRequestMocked{a: a, scheduleId: scheduleId}
+}
+
+type createUpdateScheduleRequestMocked struct {
+	a       *apiClientScheduleMocked
+	payload serverupdate.CreateUpdateSchedulePayload
+}
+
+func (r *createUpdateScheduleRequestMocked) CreateUpdateSchedulePayload(payload serverupdate.CreateUpdateSchedulePayload) serverupdate.ApiCreateUpdateScheduleRequest {
+	r.payload = payload
+	return r
+}
+
+func (r *createUpdateScheduleRequestMocked) RetryOnConflict(_ int) serverupdate.ApiCreateUpdateScheduleRequest {
+	return r
+}
+
+func (r *createUpdateScheduleRequestMocked) SetQueryParam(_, _ string) serverupdate.ApiCreateUpdateScheduleRequest {
+	return r
+}
+
+func (r *createUpdateScheduleRequestMocked) AddQueryParam(_, _ string) serverupdate.ApiCreateUpdateScheduleRequest {
+	return r
+}
+
+func (r *createUpdateScheduleRequestMocked) Clone() serverupdate.ApiCreateUpdateScheduleRequest {
+	return r
+}
+
+func (r *createUpdateScheduleRequestMocked) Fingerprint() (string, error) {
+	return "", nil
+}
+
+func (r *createUpdateScheduleRequestMocked) Execute() (*serverupdate.UpdateSchedule, error) {
+	r.a.created = &r.payload
+	return &serverupdate.UpdateSchedule{
+		Id:                utils.Ptr(int64(10)),
+		Enabled:           r.payload.Enabled,
+		MaintenanceWindow: r.payload.MaintenanceWindow,
+		Name:              r.payload.Name,
+		Rrule:             r.payload.Rrule,
+	}, nil
+}
+
+type updateUpdateScheduleRequestMocked struct {
+	a          *apiClientScheduleMocked
+	scheduleId string
+	payload    serverupdate.UpdateUpdateSchedulePayload
+}
+
+func (r *updateUpdateScheduleRequestMocked) UpdateUpdateSchedulePayload(payload serverupdate.UpdateUpdateSchedulePayload) serverupdate.ApiUpdateUpdateScheduleRequest {
+	r.payload = payload
+	return r
+}
+
+func (r *updateUpdateScheduleRequestMocked) SetQueryParam(_, _ string) serverupdate.ApiUpdateUpdateScheduleRequest {
+	return r
+}
+
+func (r *updateUpdateScheduleRequestMocked) AddQueryParam(_, _ string) serverupdate.ApiUpdateUpdateScheduleRequest {
+	return r
+}
+
+func (r *updateUpdateScheduleRequestMocked) Clone() serverupdate.ApiUpdateUpdateScheduleRequest {
+	return r
+}
+
+func (r *updateUpdateScheduleRequestMocked) Fingerprint() (string, error) {
+	return "", nil
+}
+
+func (r *updateUpdateScheduleRequestMocked) Execute() (*serverupdate.UpdateSchedule, error) {
+	r.a.updatedId = r.scheduleId
+	r.a.updated = &r.payload
+	return &serverupdate.UpdateSchedule{Id: utils.Ptr(int64(1))}, nil
+}
+
+func fixtureSchedule(id int64, name, rrule string) serverupdate.UpdateSchedule {
+	return serverupdate.UpdateSchedule{
+		Id:                utils.Ptr(id),
+		Enabled:           utils.Ptr(true),
+		MaintenanceWindow: utils.Ptr(int64(1)),
+		Name:              utils.Ptr(name),
+		Rrule:             utils.Ptr(rrule),
+	}
+}
+
+func TestUpsertSchedule(t *testing.T) {
+	const rrule = "DTSTART;TZID=Europe/Sofia:20200803T023000 RRULE:FREQ=DAILY;INTERVAL=1"
+	schedule := serverupdate.CreateUpdateSchedulePayload{
+		Enabled:           utils.Ptr(true),
+		MaintenanceWindow: utils.Ptr(int64(1)),
+		Name:              utils.Ptr("nightly"),
+		Rrule:             utils.Ptr(rrule),
+	}
+
+	tests := []struct {
+		desc          string
+		schedules     []serverupdate.UpdateSchedule
+		schedule      serverupdate.CreateUpdateSchedulePayload
+		wantErr       bool
+		wantCreated   bool
+		wantUpdatedId string
+	}{
+		{
+			desc:        "created",
+			schedules:   []serverupdate.UpdateSchedule{fixtureSchedule(1, "weekly", rrule)},
+			schedule:    schedule,
+			wantCreated: true,
+		},
+		{
+			desc:          "updated",
+			schedules:     []serverupdate.UpdateSchedule{fixtureSchedule(1, "weekly", rrule), fixtureSchedule(2, "nightly", "RRULE:FREQ=WEEKLY")},
+			schedule:      schedule,
+			wantUpdatedId: "2",
+		},
+		{
+			desc:      "unchanged",
+			schedules: []serverupdate.UpdateSchedule{fixtureSchedule(2, "nightly", rrule)},
+			schedule:  schedule,
+		},
+		{
+			desc:      "duplicated_name",
+			schedules: []serverupdate.UpdateSchedule{fixtureSchedule(1, "nightly", rrule), fixtureSchedule(2, "nightly", rrule)},
+			schedule:  schedule,
+			wantErr:   true,
+		},
+		{
+			desc:     "missing_name",
+			schedule: serverupdate.CreateUpdateSchedulePayload{Rrule: utils.Ptr(rrule)},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientScheduleMocked{
+				schedules: tt.schedules,
+			}
+
+			gotRes, err := UpsertSchedule(context.Background(), apiClient, "pid", "sid", "eu01", tt.schedule)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpsertSchedule error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if gotRes == nil {
+				t.Fatalf("expected schedule, got nil")
+			}
+			if (apiClient.created != nil) != tt.wantCreated {
+				t.Fatalf("expected created %t, got %v", tt.wantCreated, apiClient.created)
+			}
+			if apiClient.updatedId != tt.wantUpdatedId {
+				t.Fatalf("expected updated schedule %q, got %q", tt.wantUpdatedId, apiClient.updatedId)
+			}
+			if tt.wantUpdatedId != "" {
+				if diff := cmp.Diff(tt.schedule.Rrule, apiClient.updated.Rrule); diff != "" {
+					t.Fatalf("unexpected rrule: %s", diff)
+				}
+			}
+		})
+	}
+}
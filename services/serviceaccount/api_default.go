@@ -19,10 +19,13 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		CreateAccessToken Create a new Access Token
@@ -268,21 +271,39 @@ type DefaultApi interface {
 	PartialUpdateServiceAccountKeyExecute(ctx context.Context, projectId string, serviceAccountEmail string, keyId string) (*PartialUpdateServiceAccountKeyResponse, error)
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiCreateAccessTokenRequest interface {
 	// Token request. Optional. If not specified the access token will be valid for 90days.
 	CreateAccessTokenPayload(createAccessTokenPayload CreateAccessTokenPayload) ApiCreateAccessTokenRequest
+	RetryOnConflict(maxAttempts int) ApiCreateAccessTokenRequest
+	SetQueryParam(key, value string) ApiCreateAccessTokenRequest
+	AddQueryParam(key, value string) ApiCreateAccessTokenRequest
+	Clone() ApiCreateAccessTokenRequest
+	Fingerprint() (string, error)
 	Execute() (*AccessToken, error)
 }
 
 type ApiCreateServiceAccountRequest interface {
 	// Service account request
 	CreateServiceAccountPayload(createServiceAccountPayload CreateServiceAccountPayload) ApiCreateServiceAccountRequest
+	RetryOnConflict(maxAttempts int) ApiCreateServiceAccountRequest
+	SetQueryParam(key, value string) ApiCreateServiceAccountRequest
+	AddQueryParam(key, value string) ApiCreateServiceAccountRequest
+	Clone() ApiCreateServiceAccountRequest
+	Fingerprint() (string, error)
 	Execute() (*ServiceAccount, error)
 }
 
 type ApiCreateServiceAccountKeyRequest interface {
 	// Service account request
 	CreateServiceAccountKeyPayload(createServiceAccountKeyPayload CreateServiceAccountKeyPayload) ApiCreateServiceAccountKeyRequest
+	RetryOnConflict(maxAttempts int) ApiCreateServiceAccountKeyRequest
+	SetQueryParam(key, value string) ApiCreateServiceAccountKeyRequest
+	AddQueryParam(key, value string) ApiCreateServiceAccountKeyRequest
+	Clone() ApiCreateServiceAccountKeyRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateServiceAccountKeyResponse, error)
 }
 
@@ -293,46 +314,87 @@ type ApiCreateShortLivedAccessTokenRequest interface {
 	Assertion(assertion string) ApiCreateShortLivedAccessTokenRequest
 	// Refresh token provided by a previous call with a self signed token. Must be given when grant_type is refresh_token
 	RefreshToken(refreshToken string) ApiCreateShortLivedAccessTokenRequest
+	RetryOnConflict(maxAttempts int) ApiCreateShortLivedAccessTokenRequest
+	SetQueryParam(key, value string) ApiCreateShortLivedAccessTokenRequest
+	AddQueryParam(key, value string) ApiCreateShortLivedAccessTokenRequest
+	Clone() ApiCreateShortLivedAccessTokenRequest
+	Fingerprint() (string, error)
 	Execute() (*CreateShortLivedAccessTokenResponse, error)
 }
 
 type ApiDeleteAccessTokenRequest interface {
+	SetQueryParam(key, value string) ApiDeleteAccessTokenRequest
+	AddQueryParam(key, value string) ApiDeleteAccessTokenRequest
+	Clone() ApiDeleteAccessTokenRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteServiceAccountRequest interface {
+	SetQueryParam(key, value string) ApiDeleteServiceAccountRequest
+	AddQueryParam(key, value string) ApiDeleteServiceAccountRequest
+	Clone() ApiDeleteServiceAccountRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiDeleteServiceAccountKeyRequest interface {
+	SetQueryParam(key, value string) ApiDeleteServiceAccountKeyRequest
+	AddQueryParam(key, value string) ApiDeleteServiceAccountKeyRequest
+	Clone() ApiDeleteServiceAccountKeyRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiGetJWKSRequest interface {
+	SetQueryParam(key, value string) ApiGetJWKSRequest
+	AddQueryParam(key, value string) ApiGetJWKSRequest
+	Clone() ApiGetJWKSRequest
+	Fingerprint() (string, error)
 	Execute() (*JWKS, error)
 }
 
 type ApiGetServiceAccountKeyRequest interface {
 	// Requested format for the public key
 	Format(format string) ApiGetServiceAccountKeyRequest
+	SetQueryParam(key, value string) ApiGetServiceAccountKeyRequest
+	AddQueryParam(key, value string) ApiGetServiceAccountKeyRequest
+	Clone() ApiGetServiceAccountKeyRequest
+	Fingerprint() (string, error)
 	Execute() (*GetServiceAccountKeyResponse, error)
 }
 
 type ApiListAccessTokensRequest interface {
+	SetQueryParam(key, value string) ApiListAccessTokensRequest
+	AddQueryParam(key, value string) ApiListAccessTokensRequest
+	Clone() ApiListAccessTokensRequest
+	Fingerprint() (string, error)
 	Execute() (*ListAccessTokensResponse, error)
 }
 
 type ApiListServiceAccountKeysRequest interface {
+	SetQueryParam(key, value string) ApiListServiceAccountKeysRequest
+	AddQueryParam(key, value string) ApiListServiceAccountKeysRequest
+	Clone() ApiListServiceAccountKeysRequest
+	Fingerprint() (string, error)
 	Execute() (*ListServiceAccountKeysResponse, error)
 }
 
 type ApiListServiceAccountsRequest interface {
+	SetQueryParam(key, value string) ApiListServiceAccountsRequest
+	AddQueryParam(key, value string) ApiListServiceAccountsRequest
+	Clone() ApiListServiceAccountsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListServiceAccountsResponse, error)
 }
 
 type ApiPartialUpdateServiceAccountKeyRequest interface {
 	// Service account request
 	PartialUpdateServiceAccountKeyPayload(partialUpdateServiceAccountKeyPayload PartialUpdateServiceAccountKeyPayload) ApiPartialUpdateServiceAccountKeyRequest
+	SetQueryParam(key, value string) ApiPartialUpdateServiceAccountKeyRequest
+	AddQueryParam(key, value string) ApiPartialUpdateServiceAccountKeyRequest
+	Clone() ApiPartialUpdateServiceAccountKeyRequest
+	Fingerprint() (string, error)
 	Execute() (*PartialUpdateServiceAccountKeyResponse, error)
 }
 
@@ -345,6 +407,8 @@ type CreateAccessTokenRequest struct {
 	projectId                string
 	serviceAccountEmail      string
 	createAccessTokenPayload *CreateAccessTokenPayload
+	retryOnConflict          int
+	queryParams              []queryParam
 }
 
 // Token request. Optional. If not specified the access token will be valid for 90days.
@@ -354,7 +418,50 @@ func (r CreateAccessTokenRequest) CreateAccessTokenPayload(createAccessTokenPayl
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateAccessTokenRequest) RetryOnConflict(maxAttempts int) ApiCreateAccessTokenRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateAccessTokenRequest) SetQueryParam(key, value string) ApiCreateAccessTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateAccessTokenRequest) AddQueryParam(key, value string) ApiCreateAccessTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateAccessTokenRequest) Clone() ApiCreateAccessTokenRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateAccessTokenRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateAccessTokenRequest) Execute() (*AccessToken, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateAccessToken", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateAccessTokenRequest) execute() (*AccessToken, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -378,6 +485,12 @@ func (r CreateAccessTokenRequest) Execute() (*AccessToken, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.serviceAccountEmail == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -398,7 +511,8 @@ func (r CreateAccessTokenRequest) Execute() (*AccessToken, error) {
 	}
 	// body params
 	localVarPostBody = r.createAccessTokenPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateAccessToken"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -429,10 +543,14 @@ func (r CreateAccessTokenRequest) Execute() (*AccessToken, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -443,7 +561,7 @@ func (r CreateAccessTokenRequest) Execute() (*AccessToken, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -454,7 +572,7 @@ func (r CreateAccessTokenRequest) Execute() (*AccessToken, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -465,7 +583,7 @@ func (r CreateAccessTokenRequest) Execute() (*AccessToken, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -476,7 +594,7 @@ func (r CreateAccessTokenRequest) Execute() (*AccessToken, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -523,6 +641,8 @@ type CreateServiceAccountRequest struct {
 	apiService                  *DefaultApiService
 	projectId                   string
 	createServiceAccountPayload *CreateServiceAccountPayload
+	retryOnConflict             int
+	queryParams                 []queryParam
 }
 
 // Service account request
@@ -532,7 +652,50 @@ func (r CreateServiceAccountRequest) CreateServiceAccountPayload(createServiceAc
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateServiceAccountRequest) RetryOnConflict(maxAttempts int) ApiCreateServiceAccountRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateServiceAccountRequest) SetQueryParam(key, value string) ApiCreateServiceAccountRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateServiceAccountRequest) AddQueryParam(key, value string) ApiCreateServiceAccountRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateServiceAccountRequest) Clone() ApiCreateServiceAccountRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateServiceAccountRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateServiceAccountRequest) Execute() (*ServiceAccount, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateServiceAccount", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateServiceAccountRequest) execute() (*ServiceAccount, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -555,6 +718,9 @@ func (r CreateServiceAccountRequest) Execute() (*ServiceAccount, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -575,7 +741,8 @@ func (r CreateServiceAccountRequest) Execute() (*ServiceAccount, error) {
 	}
 	// body params
 	localVarPostBody = r.createServiceAccountPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateServiceAccount"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -606,10 +773,14 @@ func (r CreateServiceAccountRequest) Execute() (*ServiceAccount, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -620,7 +791,7 @@ func (r CreateServiceAccountRequest) Execute() (*ServiceAccount, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -631,7 +802,7 @@ func (r CreateServiceAccountRequest) Execute() (*ServiceAccount, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -642,7 +813,7 @@ func (r CreateServiceAccountRequest) Execute() (*ServiceAccount, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -653,7 +824,7 @@ func (r CreateServiceAccountRequest) Execute() (*ServiceAccount, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -698,6 +869,8 @@ type CreateServiceAccountKeyRequest struct {
 	projectId                      string
 	serviceAccountEmail            string
 	createServiceAccountKeyPayload *CreateServiceAccountKeyPayload
+	retryOnConflict                int
+	queryParams                    []queryParam
 }
 
 // Service account request
@@ -707,7 +880,50 @@ func (r CreateServiceAccountKeyRequest) CreateServiceAccountKeyPayload(createSer
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateServiceAccountKeyRequest) RetryOnConflict(maxAttempts int) ApiCreateServiceAccountKeyRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateServiceAccountKeyRequest) SetQueryParam(key, value string) ApiCreateServiceAccountKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateServiceAccountKeyRequest) AddQueryParam(key, value string) ApiCreateServiceAccountKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateServiceAccountKeyRequest) Clone() ApiCreateServiceAccountKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateServiceAccountKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateServiceAccountKeyRequest) Execute() (*CreateServiceAccountKeyResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateServiceAccountKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateServiceAccountKeyRequest) execute() (*CreateServiceAccountKeyResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -731,6 +947,12 @@ func (r CreateServiceAccountKeyRequest) Execute() (*CreateServiceAccountKeyRespo
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.serviceAccountEmail == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -751,7 +973,8 @@ func (r CreateServiceAccountKeyRequest) Execute() (*CreateServiceAccountKeyRespo
 	}
 	// body params
 	localVarPostBody = r.createServiceAccountKeyPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateServiceAccountKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -782,10 +1005,14 @@ func (r CreateServiceAccountKeyRequest) Execute() (*CreateServiceAccountKeyRespo
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -796,7 +1023,7 @@ func (r CreateServiceAccountKeyRequest) Execute() (*CreateServiceAccountKeyRespo
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -807,7 +1034,7 @@ func (r CreateServiceAccountKeyRequest) Execute() (*CreateServiceAccountKeyRespo
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -818,7 +1045,7 @@ func (r CreateServiceAccountKeyRequest) Execute() (*CreateServiceAccountKeyRespo
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -829,7 +1056,7 @@ func (r CreateServiceAccountKeyRequest) Execute() (*CreateServiceAccountKeyRespo
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -872,11 +1099,13 @@ func (a *APIClient) CreateServiceAccountKeyExecute(ctx context.Context, projectI
 }
 
 type CreateShortLivedAccessTokenRequest struct {
-	ctx          context.Context
-	apiService   *DefaultApiService
-	grantType    *string
-	assertion    *string
-	refreshToken *string
+	ctx             context.Context
+	apiService      *DefaultApiService
+	grantType       *string
+	assertion       *string
+	refreshToken    *string
+	retryOnConflict int
+	queryParams     []queryParam
 }
 
 // Always use URL encoded values. E.g. urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Ajwt-bearer
@@ -900,7 +1129,50 @@ func (r CreateShortLivedAccessTokenRequest) RefreshToken(refreshToken string) Ap
 	return r
 }
 
+// RetryOnConflict retries the request with exponential backoff if it fails with 409 Conflict, up to maxAttempts attempts in total.
+// Retrying a create request may create duplicate resources if the conflict wasn't transient, see clients.WithConflictRetry.
+func (r CreateShortLivedAccessTokenRequest) RetryOnConflict(maxAttempts int) ApiCreateShortLivedAccessTokenRequest {
+	r.retryOnConflict = maxAttempts
+	return r
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r CreateShortLivedAccessTokenRequest) SetQueryParam(key, value string) ApiCreateShortLivedAccessTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r CreateShortLivedAccessTokenRequest) AddQueryParam(key, value string) ApiCreateShortLivedAccessTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r CreateShortLivedAccessTokenRequest) Clone() ApiCreateShortLivedAccessTokenRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r CreateShortLivedAccessTokenRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r CreateShortLivedAccessTokenRequest) Execute() (*CreateShortLivedAccessTokenResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "CreateShortLivedAccessToken")
+	}
+	return localVarReturnValue, err
+}
+
+func (r CreateShortLivedAccessTokenRequest) execute() (*CreateShortLivedAccessTokenResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -923,7 +1195,7 @@ func (r CreateShortLivedAccessTokenRequest) Execute() (*CreateShortLivedAccessTo
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 	if r.grantType == nil {
-		return localVarReturnValue, fmt.Errorf("grantType is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "grantType"}
 	}
 
 	// to determine the Content-Type header
@@ -950,7 +1222,8 @@ func (r CreateShortLivedAccessTokenRequest) Execute() (*CreateShortLivedAccessTo
 	if r.refreshToken != nil {
 		parameterAddToHeaderOrQuery(localVarFormParams, "refresh_token", r.refreshToken, "")
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(clients.WithConflictRetry(r.ctx, r.retryOnConflict), client.cfg.ServiceName, "CreateShortLivedAccessToken"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -981,10 +1254,14 @@ func (r CreateShortLivedAccessTokenRequest) Execute() (*CreateShortLivedAccessTo
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -995,7 +1272,7 @@ func (r CreateShortLivedAccessTokenRequest) Execute() (*CreateShortLivedAccessTo
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1006,7 +1283,7 @@ func (r CreateShortLivedAccessTokenRequest) Execute() (*CreateShortLivedAccessTo
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1049,9 +1326,46 @@ type DeleteAccessTokenRequest struct {
 	projectId           string
 	serviceAccountEmail string
 	accessTokenId       string
+	queryParams         []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteAccessTokenRequest) SetQueryParam(key, value string) ApiDeleteAccessTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteAccessTokenRequest) AddQueryParam(key, value string) ApiDeleteAccessTokenRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteAccessTokenRequest) Clone() ApiDeleteAccessTokenRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteAccessTokenRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteAccessTokenRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteAccessToken", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")}, oapierror.Parameter{Name: "accessTokenId", Value: ParameterValueToString(r.accessTokenId, "accessTokenId")})
+	}
+	return err
+}
+
+func (r DeleteAccessTokenRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1075,6 +1389,15 @@ func (r DeleteAccessTokenRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.serviceAccountEmail == "" {
+		return &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
+	if r.accessTokenId == "" {
+		return &oapierror.ValidationError{Field: "accessTokenId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1093,7 +1416,8 @@ func (r DeleteAccessTokenRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteAccessToken"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1124,10 +1448,14 @@ func (r DeleteAccessTokenRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1138,7 +1466,7 @@ func (r DeleteAccessTokenRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1149,7 +1477,7 @@ func (r DeleteAccessTokenRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1160,7 +1488,7 @@ func (r DeleteAccessTokenRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1171,7 +1499,7 @@ func (r DeleteAccessTokenRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1222,9 +1550,46 @@ type DeleteServiceAccountRequest struct {
 	apiService          *DefaultApiService
 	projectId           string
 	serviceAccountEmail string
+	queryParams         []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteServiceAccountRequest) SetQueryParam(key, value string) ApiDeleteServiceAccountRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteServiceAccountRequest) AddQueryParam(key, value string) ApiDeleteServiceAccountRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteServiceAccountRequest) Clone() ApiDeleteServiceAccountRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteServiceAccountRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteServiceAccountRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteServiceAccount", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")})
+	}
+	return err
+}
+
+func (r DeleteServiceAccountRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1247,6 +1612,12 @@ func (r DeleteServiceAccountRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.serviceAccountEmail == "" {
+		return &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1265,7 +1636,8 @@ func (r DeleteServiceAccountRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteServiceAccount"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1296,10 +1668,14 @@ func (r DeleteServiceAccountRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1310,7 +1686,7 @@ func (r DeleteServiceAccountRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1321,7 +1697,7 @@ func (r DeleteServiceAccountRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1332,7 +1708,7 @@ func (r DeleteServiceAccountRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1381,9 +1757,46 @@ type DeleteServiceAccountKeyRequest struct {
 	projectId           string
 	serviceAccountEmail string
 	keyId               string
+	queryParams         []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r DeleteServiceAccountKeyRequest) SetQueryParam(key, value string) ApiDeleteServiceAccountKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r DeleteServiceAccountKeyRequest) AddQueryParam(key, value string) ApiDeleteServiceAccountKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r DeleteServiceAccountKeyRequest) Clone() ApiDeleteServiceAccountKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r DeleteServiceAccountKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r DeleteServiceAccountKeyRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "DeleteServiceAccountKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")})
+	}
+	return err
+}
+
+func (r DeleteServiceAccountKeyRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodDelete
 		localVarPostBody   interface{}
@@ -1407,6 +1820,15 @@ func (r DeleteServiceAccountKeyRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.serviceAccountEmail == "" {
+		return &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
+	if r.keyId == "" {
+		return &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1425,7 +1847,8 @@ func (r DeleteServiceAccountKeyRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "DeleteServiceAccountKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1456,10 +1879,14 @@ func (r DeleteServiceAccountKeyRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1470,7 +1897,7 @@ func (r DeleteServiceAccountKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1481,7 +1908,7 @@ func (r DeleteServiceAccountKeyRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1531,9 +1958,46 @@ type GetJWKSRequest struct {
 	ctx                 context.Context
 	apiService          *DefaultApiService
 	serviceAccountEmail string
+	queryParams         []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetJWKSRequest) SetQueryParam(key, value string) ApiGetJWKSRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetJWKSRequest) AddQueryParam(key, value string) ApiGetJWKSRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetJWKSRequest) Clone() ApiGetJWKSRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetJWKSRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetJWKSRequest) Execute() (*JWKS, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetJWKS", oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetJWKSRequest) execute() (*JWKS, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1556,6 +2020,9 @@ func (r GetJWKSRequest) Execute() (*JWKS, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.serviceAccountEmail == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1574,7 +2041,8 @@ func (r GetJWKSRequest) Execute() (*JWKS, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetJWKS"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1605,11 +2073,12 @@ func (r GetJWKSRequest) Execute() (*JWKS, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
 		}
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1655,6 +2124,7 @@ type GetServiceAccountKeyRequest struct {
 	serviceAccountEmail string
 	keyId               string
 	format              *string
+	queryParams         []queryParam
 }
 
 // Requested format for the public key
@@ -1664,7 +2134,43 @@ func (r GetServiceAccountKeyRequest) Format(format string) ApiGetServiceAccountK
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetServiceAccountKeyRequest) SetQueryParam(key, value string) ApiGetServiceAccountKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetServiceAccountKeyRequest) AddQueryParam(key, value string) ApiGetServiceAccountKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetServiceAccountKeyRequest) Clone() ApiGetServiceAccountKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetServiceAccountKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r GetServiceAccountKeyRequest) Execute() (*GetServiceAccountKeyResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetServiceAccountKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetServiceAccountKeyRequest) execute() (*GetServiceAccountKeyResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1689,6 +2195,15 @@ func (r GetServiceAccountKeyRequest) Execute() (*GetServiceAccountKeyResponse, e
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.serviceAccountEmail == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	if r.format != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "format", r.format, "")
@@ -1710,7 +2225,8 @@ func (r GetServiceAccountKeyRequest) Execute() (*GetServiceAccountKeyResponse, e
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetServiceAccountKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1741,10 +2257,14 @@ func (r GetServiceAccountKeyRequest) Execute() (*GetServiceAccountKeyResponse, e
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1755,7 +2275,7 @@ func (r GetServiceAccountKeyRequest) Execute() (*GetServiceAccountKeyResponse, e
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1766,7 +2286,7 @@ func (r GetServiceAccountKeyRequest) Execute() (*GetServiceAccountKeyResponse, e
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1777,7 +2297,7 @@ func (r GetServiceAccountKeyRequest) Execute() (*GetServiceAccountKeyResponse, e
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1827,9 +2347,46 @@ type ListAccessTokensRequest struct {
 	apiService          *DefaultApiService
 	projectId           string
 	serviceAccountEmail string
+	queryParams         []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListAccessTokensRequest) SetQueryParam(key, value string) ApiListAccessTokensRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListAccessTokensRequest) AddQueryParam(key, value string) ApiListAccessTokensRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListAccessTokensRequest) Clone() ApiListAccessTokensRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListAccessTokensRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListAccessTokensRequest) Execute() (*ListAccessTokensResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListAccessTokens", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListAccessTokensRequest) execute() (*ListAccessTokensResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1853,6 +2410,12 @@ func (r ListAccessTokensRequest) Execute() (*ListAccessTokensResponse, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.serviceAccountEmail == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1871,7 +2434,8 @@ func (r ListAccessTokensRequest) Execute() (*ListAccessTokensResponse, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListAccessTokens"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1890,6 +2454,10 @@ func (r ListAccessTokensRequest) Execute() (*ListAccessTokensResponse, error) {
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1902,10 +2470,14 @@ func (r ListAccessTokensRequest) Execute() (*ListAccessTokensResponse, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1916,7 +2488,7 @@ func (r ListAccessTokensRequest) Execute() (*ListAccessTokensResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1927,7 +2499,7 @@ func (r ListAccessTokensRequest) Execute() (*ListAccessTokensResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1938,7 +2510,7 @@ func (r ListAccessTokensRequest) Execute() (*ListAccessTokensResponse, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1949,7 +2521,7 @@ func (r ListAccessTokensRequest) Execute() (*ListAccessTokensResponse, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1996,9 +2568,46 @@ type ListServiceAccountKeysRequest struct {
 	apiService          *DefaultApiService
 	projectId           string
 	serviceAccountEmail string
+	queryParams         []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListServiceAccountKeysRequest) SetQueryParam(key, value string) ApiListServiceAccountKeysRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListServiceAccountKeysRequest) AddQueryParam(key, value string) ApiListServiceAccountKeysRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListServiceAccountKeysRequest) Clone() ApiListServiceAccountKeysRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListServiceAccountKeysRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListServiceAccountKeysRequest) Execute() (*ListServiceAccountKeysResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListServiceAccountKeys", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListServiceAccountKeysRequest) execute() (*ListServiceAccountKeysResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2022,6 +2631,12 @@ func (r ListServiceAccountKeysRequest) Execute() (*ListServiceAccountKeysRespons
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.serviceAccountEmail == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2040,7 +2655,8 @@ func (r ListServiceAccountKeysRequest) Execute() (*ListServiceAccountKeysRespons
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListServiceAccountKeys"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2059,6 +2675,10 @@ func (r ListServiceAccountKeysRequest) Execute() (*ListServiceAccountKeysRespons
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2071,10 +2691,14 @@ func (r ListServiceAccountKeysRequest) Execute() (*ListServiceAccountKeysRespons
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2085,7 +2709,7 @@ func (r ListServiceAccountKeysRequest) Execute() (*ListServiceAccountKeysRespons
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2096,7 +2720,7 @@ func (r ListServiceAccountKeysRequest) Execute() (*ListServiceAccountKeysRespons
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2107,7 +2731,7 @@ func (r ListServiceAccountKeysRequest) Execute() (*ListServiceAccountKeysRespons
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2150,12 +2774,49 @@ func (a *APIClient) ListServiceAccountKeysExecute(ctx context.Context, projectId
 }
 
 type ListServiceAccountsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	queryParams []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListServiceAccountsRequest) SetQueryParam(key, value string) ApiListServiceAccountsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListServiceAccountsRequest) AddQueryParam(key, value string) ApiListServiceAccountsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListServiceAccountsRequest) Clone() ApiListServiceAccountsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListServiceAccountsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r ListServiceAccountsRequest) Execute() (*ListServiceAccountsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListServiceAccounts", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListServiceAccountsRequest) execute() (*ListServiceAccountsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -2178,6 +2839,9 @@ func (r ListServiceAccountsRequest) Execute() (*ListServiceAccountsResponse, err
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2196,7 +2860,8 @@ func (r ListServiceAccountsRequest) Execute() (*ListServiceAccountsResponse, err
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListServiceAccounts"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2215,6 +2880,10 @@ func (r ListServiceAccountsRequest) Execute() (*ListServiceAccountsResponse, err
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -2227,10 +2896,14 @@ func (r ListServiceAccountsRequest) Execute() (*ListServiceAccountsResponse, err
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2241,7 +2914,7 @@ func (r ListServiceAccountsRequest) Execute() (*ListServiceAccountsResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2252,7 +2925,7 @@ func (r ListServiceAccountsRequest) Execute() (*ListServiceAccountsResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2263,7 +2936,7 @@ func (r ListServiceAccountsRequest) Execute() (*ListServiceAccountsResponse, err
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -2309,6 +2982,7 @@ type PartialUpdateServiceAccountKeyRequest struct {
 	serviceAccountEmail                   string
 	keyId                                 string
 	partialUpdateServiceAccountKeyPayload *PartialUpdateServiceAccountKeyPayload
+	queryParams                           []queryParam
 }
 
 // Service account request
@@ -2318,7 +2992,43 @@ func (r PartialUpdateServiceAccountKeyRequest) PartialUpdateServiceAccountKeyPay
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r PartialUpdateServiceAccountKeyRequest) SetQueryParam(key, value string) ApiPartialUpdateServiceAccountKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r PartialUpdateServiceAccountKeyRequest) AddQueryParam(key, value string) ApiPartialUpdateServiceAccountKeyRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r PartialUpdateServiceAccountKeyRequest) Clone() ApiPartialUpdateServiceAccountKeyRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r PartialUpdateServiceAccountKeyRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r PartialUpdateServiceAccountKeyRequest) Execute() (*PartialUpdateServiceAccountKeyResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "PartialUpdateServiceAccountKey", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "serviceAccountEmail", Value: ParameterValueToString(r.serviceAccountEmail, "serviceAccountEmail")}, oapierror.Parameter{Name: "keyId", Value: ParameterValueToString(r.keyId, "keyId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r PartialUpdateServiceAccountKeyRequest) execute() (*PartialUpdateServiceAccountKeyResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
@@ -2343,6 +3053,15 @@ func (r PartialUpdateServiceAccountKeyRequest) Execute() (*PartialUpdateServiceA
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.serviceAccountEmail == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "serviceAccountEmail"}
+	}
+	if r.keyId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "keyId"}
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
@@ -2363,7 +3082,8 @@ func (r PartialUpdateServiceAccountKeyRequest) Execute() (*PartialUpdateServiceA
 	}
 	// body params
 	localVarPostBody = r.partialUpdateServiceAccountKeyPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "PartialUpdateServiceAccountKey"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -2394,10 +3114,14 @@ func (r PartialUpdateServiceAccountKeyRequest) Execute() (*PartialUpdateServiceA
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2408,7 +3132,7 @@ func (r PartialUpdateServiceAccountKeyRequest) Execute() (*PartialUpdateServiceA
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v AuthError
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2419,7 +3143,7 @@ func (r PartialUpdateServiceAccountKeyRequest) Execute() (*PartialUpdateServiceA
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2430,7 +3154,7 @@ func (r PartialUpdateServiceAccountKeyRequest) Execute() (*PartialUpdateServiceA
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -2441,7 +3165,7 @@ func (r PartialUpdateServiceAccountKeyRequest) Execute() (*PartialUpdateServiceA
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/serviceaccount",
+		ServiceName:   "serviceaccount",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,25 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateAccessToken",
+	"CreateServiceAccount",
+	"CreateServiceAccountKey",
+	"CreateShortLivedAccessToken",
+	"DeleteAccessToken",
+	"DeleteServiceAccount",
+	"DeleteServiceAccountKey",
+	"GetJWKS",
+	"GetServiceAccountKey",
+	"ListAccessTokens",
+	"ListServiceAccountKeys",
+	"ListServiceAccounts",
+	"PartialUpdateServiceAccountKey",
+}
+
+func init() {
+	config.RegisterOperations("serviceaccount", operations...)
+}
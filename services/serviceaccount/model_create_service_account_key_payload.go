@@ -56,14 +56,9 @@ func (v *CreateServiceAccountKeyPayloadAlgorithm) UnmarshalJSON(src []byte) erro
 		return nil
 	}
 	enumTypeValue := CreateServiceAccountKeyPayloadAlgorithm(value)
-	for _, existing := range AllowedCreateServiceAccountKeyPayloadAlgorithmEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateServiceAccountKeyPayload", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateServiceAccountKeyPayloadAlgorithmFromValue returns a pointer to a valid CreateServiceAccountKeyPayloadAlgorithm
@@ -87,6 +82,17 @@ func (v CreateServiceAccountKeyPayloadAlgorithm) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateServiceAccountKeyPayloadAlgorithm) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateServiceAccountKeyPayloadAlgorithm) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to AlgorithmAlgorithm value
 func (v CreateServiceAccountKeyPayloadAlgorithm) Ptr() *CreateServiceAccountKeyPayloadAlgorithm {
 	return &v
@@ -137,14 +137,9 @@ func (v *CreateServiceAccountKeyResponseKeyAlgorithm) UnmarshalJSON(src []byte)
 		return nil
 	}
 	enumTypeValue := CreateServiceAccountKeyResponseKeyAlgorithm(value)
-	for _, existing := range AllowedCreateServiceAccountKeyResponseKeyAlgorithmEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateServiceAccountKeyResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateServiceAccountKeyResponseKeyAlgorithmFromValue returns a pointer to a valid CreateServiceAccountKeyResponseKeyAlgorithm
@@ -168,6 +163,17 @@ func (v CreateServiceAccountKeyResponseKeyAlgorithm) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateServiceAccountKeyResponseKeyAlgorithm) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateServiceAccountKeyResponseKeyAlgorithm) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyAlgorithmKeyAlgorithm value
 func (v CreateServiceAccountKeyResponseKeyAlgorithm) Ptr() *CreateServiceAccountKeyResponseKeyAlgorithm {
 	return &v
@@ -261,14 +267,9 @@ func (v *CreateServiceAccountKeyResponseKeyOrigin) UnmarshalJSON(src []byte) err
 		return nil
 	}
 	enumTypeValue := CreateServiceAccountKeyResponseKeyOrigin(value)
-	for _, existing := range AllowedCreateServiceAccountKeyResponseKeyOriginEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateServiceAccountKeyResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateServiceAccountKeyResponseKeyOriginFromValue returns a pointer to a valid CreateServiceAccountKeyResponseKeyOrigin
@@ -292,6 +293,17 @@ func (v CreateServiceAccountKeyResponseKeyOrigin) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateServiceAccountKeyResponseKeyOrigin) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateServiceAccountKeyResponseKeyOrigin) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyOriginKeyOrigin value
 func (v CreateServiceAccountKeyResponseKeyOrigin) Ptr() *CreateServiceAccountKeyResponseKeyOrigin {
 	return &v
@@ -385,14 +397,9 @@ func (v *CreateServiceAccountKeyResponseKeyType) UnmarshalJSON(src []byte) error
 		return nil
 	}
 	enumTypeValue := CreateServiceAccountKeyResponseKeyType(value)
-	for _, existing := range AllowedCreateServiceAccountKeyResponseKeyTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateServiceAccountKeyResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateServiceAccountKeyResponseKeyTypeFromValue returns a pointer to a valid CreateServiceAccountKeyResponseKeyType
@@ -416,6 +423,17 @@ func (v CreateServiceAccountKeyResponseKeyType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateServiceAccountKeyResponseKeyType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateServiceAccountKeyResponseKeyType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyTypeKeyType value
 func (v CreateServiceAccountKeyResponseKeyType) Ptr() *CreateServiceAccountKeyResponseKeyType {
 	return &v
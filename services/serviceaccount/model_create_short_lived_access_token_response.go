@@ -136,14 +136,9 @@ func (v *CreateShortLivedAccessTokenResponseTokenType) UnmarshalJSON(src []byte)
 		return nil
 	}
 	enumTypeValue := CreateShortLivedAccessTokenResponseTokenType(value)
-	for _, existing := range AllowedCreateShortLivedAccessTokenResponseTokenTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CreateShortLivedAccessTokenResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCreateShortLivedAccessTokenResponseTokenTypeFromValue returns a pointer to a valid CreateShortLivedAccessTokenResponseTokenType
@@ -167,6 +162,17 @@ func (v CreateShortLivedAccessTokenResponseTokenType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CreateShortLivedAccessTokenResponseTokenType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CreateShortLivedAccessTokenResponseTokenType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TokenTypeTokenType value
 func (v CreateShortLivedAccessTokenResponseTokenType) Ptr() *CreateShortLivedAccessTokenResponseTokenType {
 	return &v
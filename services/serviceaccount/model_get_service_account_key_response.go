@@ -137,14 +137,9 @@ func (v *GetServiceAccountKeyResponseKeyAlgorithm) UnmarshalJSON(src []byte) err
 		return nil
 	}
 	enumTypeValue := GetServiceAccountKeyResponseKeyAlgorithm(value)
-	for _, existing := range AllowedGetServiceAccountKeyResponseKeyAlgorithmEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid GetServiceAccountKeyResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewGetServiceAccountKeyResponseKeyAlgorithmFromValue returns a pointer to a valid GetServiceAccountKeyResponseKeyAlgorithm
@@ -168,6 +163,17 @@ func (v GetServiceAccountKeyResponseKeyAlgorithm) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v GetServiceAccountKeyResponseKeyAlgorithm) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v GetServiceAccountKeyResponseKeyAlgorithm) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyAlgorithmKeyAlgorithm value
 func (v GetServiceAccountKeyResponseKeyAlgorithm) Ptr() *GetServiceAccountKeyResponseKeyAlgorithm {
 	return &v
@@ -261,14 +267,9 @@ func (v *GetServiceAccountKeyResponseKeyOrigin) UnmarshalJSON(src []byte) error
 		return nil
 	}
 	enumTypeValue := GetServiceAccountKeyResponseKeyOrigin(value)
-	for _, existing := range AllowedGetServiceAccountKeyResponseKeyOriginEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid GetServiceAccountKeyResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewGetServiceAccountKeyResponseKeyOriginFromValue returns a pointer to a valid GetServiceAccountKeyResponseKeyOrigin
@@ -292,6 +293,17 @@ func (v GetServiceAccountKeyResponseKeyOrigin) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v GetServiceAccountKeyResponseKeyOrigin) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v GetServiceAccountKeyResponseKeyOrigin) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyOriginKeyOrigin value
 func (v GetServiceAccountKeyResponseKeyOrigin) Ptr() *GetServiceAccountKeyResponseKeyOrigin {
 	return &v
@@ -385,14 +397,9 @@ func (v *GetServiceAccountKeyResponseKeyType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := GetServiceAccountKeyResponseKeyType(value)
-	for _, existing := range AllowedGetServiceAccountKeyResponseKeyTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid GetServiceAccountKeyResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewGetServiceAccountKeyResponseKeyTypeFromValue returns a pointer to a valid GetServiceAccountKeyResponseKeyType
@@ -416,6 +423,17 @@ func (v GetServiceAccountKeyResponseKeyType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v GetServiceAccountKeyResponseKeyType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v GetServiceAccountKeyResponseKeyType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyTypeKeyType value
 func (v GetServiceAccountKeyResponseKeyType) Ptr() *GetServiceAccountKeyResponseKeyType {
 	return &v
@@ -117,14 +117,9 @@ func (v *PartialUpdateServiceAccountKeyResponseKeyAlgorithm) UnmarshalJSON(src [
 		return nil
 	}
 	enumTypeValue := PartialUpdateServiceAccountKeyResponseKeyAlgorithm(value)
-	for _, existing := range AllowedPartialUpdateServiceAccountKeyResponseKeyAlgorithmEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid PartialUpdateServiceAccountKeyResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPartialUpdateServiceAccountKeyResponseKeyAlgorithmFromValue returns a pointer to a valid PartialUpdateServiceAccountKeyResponseKeyAlgorithm
@@ -148,6 +143,17 @@ func (v PartialUpdateServiceAccountKeyResponseKeyAlgorithm) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v PartialUpdateServiceAccountKeyResponseKeyAlgorithm) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v PartialUpdateServiceAccountKeyResponseKeyAlgorithm) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyAlgorithmKeyAlgorithm value
 func (v PartialUpdateServiceAccountKeyResponseKeyAlgorithm) Ptr() *PartialUpdateServiceAccountKeyResponseKeyAlgorithm {
 	return &v
@@ -241,14 +247,9 @@ func (v *PartialUpdateServiceAccountKeyResponseKeyOrigin) UnmarshalJSON(src []by
 		return nil
 	}
 	enumTypeValue := PartialUpdateServiceAccountKeyResponseKeyOrigin(value)
-	for _, existing := range AllowedPartialUpdateServiceAccountKeyResponseKeyOriginEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid PartialUpdateServiceAccountKeyResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPartialUpdateServiceAccountKeyResponseKeyOriginFromValue returns a pointer to a valid PartialUpdateServiceAccountKeyResponseKeyOrigin
@@ -272,6 +273,17 @@ func (v PartialUpdateServiceAccountKeyResponseKeyOrigin) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v PartialUpdateServiceAccountKeyResponseKeyOrigin) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v PartialUpdateServiceAccountKeyResponseKeyOrigin) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyOriginKeyOrigin value
 func (v PartialUpdateServiceAccountKeyResponseKeyOrigin) Ptr() *PartialUpdateServiceAccountKeyResponseKeyOrigin {
 	return &v
@@ -365,14 +377,9 @@ func (v *PartialUpdateServiceAccountKeyResponseKeyType) UnmarshalJSON(src []byte
 		return nil
 	}
 	enumTypeValue := PartialUpdateServiceAccountKeyResponseKeyType(value)
-	for _, existing := range AllowedPartialUpdateServiceAccountKeyResponseKeyTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid PartialUpdateServiceAccountKeyResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPartialUpdateServiceAccountKeyResponseKeyTypeFromValue returns a pointer to a valid PartialUpdateServiceAccountKeyResponseKeyType
@@ -396,6 +403,17 @@ func (v PartialUpdateServiceAccountKeyResponseKeyType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v PartialUpdateServiceAccountKeyResponseKeyType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v PartialUpdateServiceAccountKeyResponseKeyType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyTypeKeyType value
 func (v PartialUpdateServiceAccountKeyResponseKeyType) Ptr() *PartialUpdateServiceAccountKeyResponseKeyType {
 	return &v
@@ -40,11 +40,11 @@ func TestPartialUpdateServiceAccountKeyResponseKeyAlgorithm_UnmarshalJSON(t *tes
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -83,11 +83,11 @@ func TestPartialUpdateServiceAccountKeyResponseKeyOrigin_UnmarshalJSON(t *testin
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -126,11 +126,11 @@ func TestPartialUpdateServiceAccountKeyResponseKeyType_UnmarshalJSON(t *testing.
 			wantErr: false,
 		},
 		{
-			name: "fail",
+			name: "success - unknown value is preserved",
 			args: args{
 				src: []byte("\"FOOBAR\""),
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -117,14 +117,9 @@ func (v *ServiceAccountKeyListResponseKeyAlgorithm) UnmarshalJSON(src []byte) er
 		return nil
 	}
 	enumTypeValue := ServiceAccountKeyListResponseKeyAlgorithm(value)
-	for _, existing := range AllowedServiceAccountKeyListResponseKeyAlgorithmEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ServiceAccountKeyListResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewServiceAccountKeyListResponseKeyAlgorithmFromValue returns a pointer to a valid ServiceAccountKeyListResponseKeyAlgorithm
@@ -148,6 +143,17 @@ func (v ServiceAccountKeyListResponseKeyAlgorithm) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ServiceAccountKeyListResponseKeyAlgorithm) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ServiceAccountKeyListResponseKeyAlgorithm) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyAlgorithmKeyAlgorithm value
 func (v ServiceAccountKeyListResponseKeyAlgorithm) Ptr() *ServiceAccountKeyListResponseKeyAlgorithm {
 	return &v
@@ -241,14 +247,9 @@ func (v *ServiceAccountKeyListResponseKeyOrigin) UnmarshalJSON(src []byte) error
 		return nil
 	}
 	enumTypeValue := ServiceAccountKeyListResponseKeyOrigin(value)
-	for _, existing := range AllowedServiceAccountKeyListResponseKeyOriginEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ServiceAccountKeyListResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewServiceAccountKeyListResponseKeyOriginFromValue returns a pointer to a valid ServiceAccountKeyListResponseKeyOrigin
@@ -272,6 +273,17 @@ func (v ServiceAccountKeyListResponseKeyOrigin) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ServiceAccountKeyListResponseKeyOrigin) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ServiceAccountKeyListResponseKeyOrigin) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyOriginKeyOrigin value
 func (v ServiceAccountKeyListResponseKeyOrigin) Ptr() *ServiceAccountKeyListResponseKeyOrigin {
 	return &v
@@ -365,14 +377,9 @@ func (v *ServiceAccountKeyListResponseKeyType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ServiceAccountKeyListResponseKeyType(value)
-	for _, existing := range AllowedServiceAccountKeyListResponseKeyTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ServiceAccountKeyListResponse", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewServiceAccountKeyListResponseKeyTypeFromValue returns a pointer to a valid ServiceAccountKeyListResponseKeyType
@@ -396,6 +403,17 @@ func (v ServiceAccountKeyListResponseKeyType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ServiceAccountKeyListResponseKeyType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ServiceAccountKeyListResponseKeyType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to KeyTypeKeyType value
 func (v ServiceAccountKeyListResponseKeyType) Ptr() *ServiceAccountKeyListResponseKeyType {
 	return &v
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/serviceenablement",
+		ServiceName:   "serviceenablement",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,16 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"DisableServiceRegional",
+	"EnableServiceRegional",
+	"GetServiceStatusRegional",
+	"ListServiceStatusRegional",
+}
+
+func init() {
+	config.RegisterOperations("serviceenablement", operations...)
+}
@@ -55,14 +55,9 @@ func (v *ActionErrorAction) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ActionErrorAction(value)
-	for _, existing := range AllowedActionErrorActionEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ActionError", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewActionErrorActionFromValue returns a pointer to a valid ActionErrorAction
@@ -86,6 +81,17 @@ func (v ActionErrorAction) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ActionErrorAction) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ActionErrorAction) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ActionAction value
 func (v ActionErrorAction) Ptr() *ActionErrorAction {
 	return &v
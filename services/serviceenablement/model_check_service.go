@@ -74,14 +74,9 @@ func (v *CheckServiceResourceType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CheckServiceResourceType(value)
-	for _, existing := range AllowedCheckServiceResourceTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CheckService", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCheckServiceResourceTypeFromValue returns a pointer to a valid CheckServiceResourceType
@@ -105,6 +100,17 @@ func (v CheckServiceResourceType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CheckServiceResourceType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CheckServiceResourceType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ResourceTypeResourceType value
 func (v CheckServiceResourceType) Ptr() *CheckServiceResourceType {
 	return &v
@@ -95,14 +95,9 @@ func (v *CloudServiceScope) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CloudServiceScope(value)
-	for _, existing := range AllowedCloudServiceScopeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CloudService", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCloudServiceScopeFromValue returns a pointer to a valid CloudServiceScope
@@ -126,6 +121,17 @@ func (v CloudServiceScope) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CloudServiceScope) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CloudServiceScope) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ScopeScope value
 func (v CloudServiceScope) Ptr() *CloudServiceScope {
 	return &v
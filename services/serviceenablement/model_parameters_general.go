@@ -97,14 +97,9 @@ func (v *ParametersGeneralProjectScope) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ParametersGeneralProjectScope(value)
-	for _, existing := range AllowedParametersGeneralProjectScopeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ParametersGeneral", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewParametersGeneralProjectScopeFromValue returns a pointer to a valid ParametersGeneralProjectScope
@@ -128,6 +123,17 @@ func (v ParametersGeneralProjectScope) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ParametersGeneralProjectScope) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ParametersGeneralProjectScope) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ProjectScopeProjectScope value
 func (v ParametersGeneralProjectScope) Ptr() *ParametersGeneralProjectScope {
 	return &v
@@ -75,14 +75,9 @@ func (v *ServiceStatusEnablement) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ServiceStatusEnablement(value)
-	for _, existing := range AllowedServiceStatusEnablementEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ServiceStatus", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewServiceStatusEnablementFromValue returns a pointer to a valid ServiceStatusEnablement
@@ -106,6 +101,17 @@ func (v ServiceStatusEnablement) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ServiceStatusEnablement) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ServiceStatusEnablement) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to EnablementEnablement value
 func (v ServiceStatusEnablement) Ptr() *ServiceStatusEnablement {
 	return &v
@@ -239,14 +245,9 @@ func (v *ServiceStatusLifecycle) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ServiceStatusLifecycle(value)
-	for _, existing := range AllowedServiceStatusLifecycleEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ServiceStatus", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewServiceStatusLifecycleFromValue returns a pointer to a valid ServiceStatusLifecycle
@@ -270,6 +271,17 @@ func (v ServiceStatusLifecycle) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ServiceStatusLifecycle) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ServiceStatusLifecycle) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to LifecycleLifecycle value
 func (v ServiceStatusLifecycle) Ptr() *ServiceStatusLifecycle {
 	return &v
@@ -383,14 +395,9 @@ func (v *ServiceStatusScope) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ServiceStatusScope(value)
-	for _, existing := range AllowedServiceStatusScopeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ServiceStatus", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewServiceStatusScopeFromValue returns a pointer to a valid ServiceStatusScope
@@ -414,6 +421,17 @@ func (v ServiceStatusScope) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ServiceStatusScope) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ServiceStatusScope) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ScopeScope value
 func (v ServiceStatusScope) Ptr() *ServiceStatusScope {
 	return &v
@@ -532,14 +550,9 @@ func (v *ServiceStatusState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ServiceStatusState(value)
-	for _, existing := range AllowedServiceStatusStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ServiceStatus", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewServiceStatusStateFromValue returns a pointer to a valid ServiceStatusState
@@ -563,6 +576,17 @@ func (v ServiceStatusState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ServiceStatusState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ServiceStatusState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to StateState value
 func (v ServiceStatusState) Ptr() *ServiceStatusState {
 	return &v
@@ -0,0 +1,55 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement"
+)
+
+// EnableServiceOperation returns the async action of EnableServiceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with EnableServicePollFunc. The name of the operation is
+// "serviceenablement/EnableService/{region}/{projectId}/{serviceId}".
+func EnableServiceOperation(a APIClientInstanceInterface, region, projectId, serviceId string) *lro.Operation[serviceenablement.ServiceStatus] {
+	return enableServiceOperationHandler(a).Operation(region, projectId, serviceId)
+}
+
+// EnableServicePollFunc returns the lro.PollFunc of the operations returned by EnableServiceOperation, e.g. to resume
+// one with lro.Resume
+func EnableServicePollFunc(a APIClientInstanceInterface) lro.PollFunc[serviceenablement.ServiceStatus] {
+	return enableServiceOperationHandler(a).PollFunc()
+}
+
+func enableServiceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[serviceenablement.ServiceStatus] {
+	return lro.WaitHandler[serviceenablement.ServiceStatus]{
+		Kind: "serviceenablement/EnableService",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[serviceenablement.ServiceStatus] {
+			return EnableServiceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DisableServiceOperation returns the async action of DisableServiceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DisableServicePollFunc. The name of the operation is
+// "serviceenablement/DisableService/{region}/{projectId}/{serviceId}".
+func DisableServiceOperation(a APIClientInstanceInterface, region, projectId, serviceId string) *lro.Operation[serviceenablement.ServiceStatus] {
+	return disableServiceOperationHandler(a).Operation(region, projectId, serviceId)
+}
+
+// DisableServicePollFunc returns the lro.PollFunc of the operations returned by DisableServiceOperation, e.g. to resume
+// one with lro.Resume
+func DisableServicePollFunc(a APIClientInstanceInterface) lro.PollFunc[serviceenablement.ServiceStatus] {
+	return disableServiceOperationHandler(a).PollFunc()
+}
+
+func disableServiceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[serviceenablement.ServiceStatus] {
+	return lro.WaitHandler[serviceenablement.ServiceStatus]{
+		Kind: "serviceenablement/DisableService",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[serviceenablement.ServiceStatus] {
+			return DisableServiceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
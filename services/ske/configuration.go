@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/ske",
+		ServiceName:   "ske",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,25 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CompleteCredentialsRotation",
+	"CreateKubeconfig",
+	"CreateOrUpdateCluster",
+	"DeleteCluster",
+	"GetCluster",
+	"GetLoginKubeconfig",
+	"ListClusters",
+	"ListProviderOptions",
+	"StartCredentialsRotation",
+	"TriggerHibernate",
+	"TriggerMaintenance",
+	"TriggerReconcile",
+	"TriggerWakeup",
+}
+
+func init() {
+	config.RegisterOperations("ske", operations...)
+}
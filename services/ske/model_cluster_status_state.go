@@ -56,14 +56,9 @@ func (v *ClusterStatusState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ClusterStatusState(value)
-	for _, existing := range AllowedClusterStatusStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ClusterStatusState", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewClusterStatusStateFromValue returns a pointer to a valid ClusterStatusState
@@ -87,6 +82,17 @@ func (v ClusterStatusState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ClusterStatusState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ClusterStatusState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to ClusterStatusState value
 func (v ClusterStatusState) Ptr() *ClusterStatusState {
 	return &v
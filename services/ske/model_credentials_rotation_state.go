@@ -102,14 +102,9 @@ func (v *CredentialsRotationStatePhase) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CredentialsRotationStatePhase(value)
-	for _, existing := range AllowedCredentialsRotationStatePhaseEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CredentialsRotationState", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCredentialsRotationStatePhaseFromValue returns a pointer to a valid CredentialsRotationStatePhase
@@ -133,6 +128,17 @@ func (v CredentialsRotationStatePhase) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CredentialsRotationStatePhase) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CredentialsRotationStatePhase) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to PhasePhase value
 func (v CredentialsRotationStatePhase) Ptr() *CredentialsRotationStatePhase {
 	return &v
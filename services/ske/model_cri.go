@@ -55,14 +55,9 @@ func (v *CRIName) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := CRIName(value)
-	for _, existing := range AllowedCRINameEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid CRI", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewCRINameFromValue returns a pointer to a valid CRIName
@@ -86,6 +81,17 @@ func (v CRIName) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v CRIName) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v CRIName) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to NameName value
 func (v CRIName) Ptr() *CRIName {
 	return &v
@@ -42,14 +42,9 @@ func (v *GetProviderOptionsRequestVersionState) UnmarshalJSON(src []byte) error
 		return nil
 	}
 	enumTypeValue := GetProviderOptionsRequestVersionState(value)
-	for _, existing := range AllowedGetProviderOptionsRequestVersionStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid GetProviderOptionsRequestVersionState", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewGetProviderOptionsRequestVersionStateFromValue returns a pointer to a valid GetProviderOptionsRequestVersionState
@@ -73,6 +68,17 @@ func (v GetProviderOptionsRequestVersionState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v GetProviderOptionsRequestVersionState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v GetProviderOptionsRequestVersionState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to GetProviderOptionsRequestVersionState value
 func (v GetProviderOptionsRequestVersionState) Ptr() *GetProviderOptionsRequestVersionState {
 	return &v
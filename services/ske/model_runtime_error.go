@@ -73,14 +73,9 @@ func (v *RuntimeErrorCode) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := RuntimeErrorCode(value)
-	for _, existing := range AllowedRuntimeErrorCodeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid RuntimeError", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewRuntimeErrorCodeFromValue returns a pointer to a valid RuntimeErrorCode
@@ -104,6 +99,17 @@ func (v RuntimeErrorCode) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v RuntimeErrorCode) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v RuntimeErrorCode) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to CodeCode value
 func (v RuntimeErrorCode) Ptr() *RuntimeErrorCode {
 	return &v
@@ -57,14 +57,9 @@ func (v *TaintEffect) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := TaintEffect(value)
-	for _, existing := range AllowedTaintEffectEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Taint", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewTaintEffectFromValue returns a pointer to a valid TaintEffect
@@ -88,6 +83,17 @@ func (v TaintEffect) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v TaintEffect) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v TaintEffect) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to EffectEffect value
 func (v TaintEffect) Ptr() *TaintEffect {
 	return &v
@@ -0,0 +1,145 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+// Prefix of the codes of the cluster errors about the nodes, e.g. SKE_NODE_MISCONFIGURED_PDB for nodes which
+// can't be drained because of a PodDisruptionBudget
+const nodeErrorCodePrefix = "SKE_NODE_"
+
+// APIClientScaleNodePoolInterface is the interface needed to scale a node pool and wait for it
+type APIClientScaleNodePoolInterface interface {
+	APIClientClusterInterface
+	CreateOrUpdateCluster(ctx context.Context, projectId, region, clusterName string) ske.ApiCreateOrUpdateClusterRequest
+}
+
+// NodePoolScaleError is returned by ScaleNodePoolAndWait if the cluster fails or reports errors about its nodes
+// once the reconciliation of the node pool has finished
+type NodePoolScaleError struct {
+	ClusterName  string
+	NodePoolName string
+	// Aggregated state of the cluster when the failure was detected
+	State ske.ClusterStatusState
+	// Errors reported by the cluster, e.g. SKE_NODE_MISCONFIGURED_PDB if the nodes of a scale-down can't be drained
+	Errors []ske.ClusterError
+}
+
+func (e *NodePoolScaleError) Error() string {
+	msg := fmt.Sprintf("scaling node pool %q of cluster %q failed in state %s", e.NodePoolName, e.ClusterName, e.State)
+	codes := []string{}
+	for _, clusterErr := range e.Errors {
+		codes = append(codes, clusterErr.GetCode())
+	}
+	if len(codes) > 0 {
+		msg += ": " + strings.Join(codes, ", ")
+	}
+	return msg
+}
+
+// ScaleNodePoolAndWait sets the minimum and maximum number of nodes of a node pool of a cluster to desired and waits
+// until the cluster has reconciled the node pool, i.e. it is healthy (or hibernated) again with the new size.
+// The other settings of the cluster are sent unchanged. It returns the node pool as reported by the cluster.
+//
+// The API doesn't report the number of nodes of a node pool, so the reconciliation of the cluster is awaited instead.
+// On a scale-down, the cluster keeps reconciling while the removed nodes are drained. If the cluster fails, or finishes
+// the reconciliation with errors about its nodes, e.g. because a PodDisruptionBudget blocks the drain, a
+// *NodePoolScaleError is returned.
+func ScaleNodePoolAndWait(ctx context.Context, a APIClientScaleNodePoolInterface, projectId, region, clusterName, nodePoolName string, desired int64) (*ske.Nodepool, error) {
+	return scaleNodePoolAndWait(ctx, a, projectId, region, clusterName, nodePoolName, desired, 5*time.Second)
+}
+
+func scaleNodePoolAndWait(ctx context.Context, a APIClientScaleNodePoolInterface, projectId, region, clusterName, nodePoolName string, desired int64, throttle time.Duration) (*ske.Nodepool, error) {
+	cluster, err := a.GetClusterExecute(ctx, projectId, region, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster: %w", err)
+	}
+	pool := findNodePool(cluster, nodePoolName)
+	if pool == nil {
+		return nil, fmt.Errorf("node pool %q not found in cluster %q", nodePoolName, clusterName)
+	}
+	pool.Minimum = &desired
+	pool.Maximum = &desired
+
+	payload := ske.CreateOrUpdateClusterPayload{
+		Extensions:  cluster.Extensions,
+		Hibernation: cluster.Hibernation,
+		Kubernetes:  cluster.Kubernetes,
+		Maintenance: cluster.Maintenance,
+		Network:     cluster.Network,
+		Nodepools:   cluster.Nodepools,
+	}
+	if _, err := a.CreateOrUpdateCluster(ctx, projectId, region, clusterName).CreateOrUpdateClusterPayload(payload).Execute(); err != nil {
+		return nil, fmt.Errorf("update cluster: %w", err)
+	}
+
+	cluster, err = scaleNodePoolWaitHandler(ctx, a, projectId, region, clusterName, nodePoolName, desired).
+		SetThrottle(throttle).
+		// The cluster may still report the state before the update until the API has processed it
+		SetSleepBeforeWait(throttle).
+		WaitWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("wait for node pool to be scaled: %w", err)
+	}
+	return findNodePool(cluster, nodePoolName), nil
+}
+
+// scaleNodePoolWaitHandler waits until the cluster is healthy with the node pool scaled to desired nodes
+func scaleNodePoolWaitHandler(ctx context.Context, a APIClientClusterInterface, projectId, region, clusterName, nodePoolName string, desired int64) *wait.AsyncActionHandler[ske.Cluster] {
+	handler := wait.New(func() (waitFinished bool, response *ske.Cluster, err error) {
+		s, err := a.GetClusterExecute(ctx, projectId, region, clusterName)
+		if err != nil {
+			return false, nil, err
+		}
+		if s.Status == nil || s.Status.Aggregated == nil {
+			return false, nil, nil
+		}
+		state := *s.Status.Aggregated
+
+		if state == StateFailed {
+			return true, s, &NodePoolScaleError{ClusterName: clusterName, NodePoolName: nodePoolName, State: state, Errors: s.Status.GetErrors()}
+		}
+		if state == ske.CLUSTERSTATUSSTATE_RECONCILING {
+			return false, nil, nil
+		}
+
+		// The reconciliation has finished, errors about the nodes won't resolve without an action of the user
+		nodeErrors := []ske.ClusterError{}
+		for _, clusterErr := range s.Status.GetErrors() {
+			if strings.HasPrefix(clusterErr.GetCode(), nodeErrorCodePrefix) {
+				nodeErrors = append(nodeErrors, clusterErr)
+			}
+		}
+		if len(nodeErrors) > 0 {
+			return true, s, &NodePoolScaleError{ClusterName: clusterName, NodePoolName: nodePoolName, State: state, Errors: nodeErrors}
+		}
+
+		pool := findNodePool(s, nodePoolName)
+		if (state == ske.CLUSTERSTATUSSTATE_HEALTHY || state == ske.CLUSTERSTATUSSTATE_HIBERNATED) && pool != nil && pool.GetMinimum() == desired && pool.GetMaximum() == desired {
+			return true, s, nil
+		}
+		return false, nil, nil
+	})
+	handler.SetTimeout(45 * time.Minute)
+	return handler
+}
+
+// findNodePool returns the node pool with the given name of the cluster, or nil if there is none
+func findNodePool(cluster *ske.Cluster, nodePoolName string) *ske.Nodepool {
+	if cluster == nil || cluster.Nodepools == nil {
+		return nil
+	}
+	nodepools := *cluster.Nodepools
+	for i := range nodepools {
+		if nodepools[i].GetName() == nodePoolName {
+			return &nodepools[i]
+		}
+	}
+	return nil
+}
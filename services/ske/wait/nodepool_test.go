@@ -0,0 +1,183 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+// Used for testing the scaling of node pools
+type apiClientScaleNodePoolMocked struct {
+	apiClientClusterMocked
+	updateFails bool
+	// states returned by consecutive calls to GetClusterExecute after the update, the last one is repeated
+	states   []ske.ClusterStatusState
+	errors   []ske.ClusterError
+	getCalls int
+	payload  *ske.CreateOrUpdateClusterPayload
+}
+
+func (a *apiClientScaleNodePoolMocked) GetClusterExecute(_ context.Context, _, _, _ string) (*ske.Cluster, error) {
+	if a.getFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+	nodepools := []ske.Nodepool{
+		{Name: utils.Ptr("other"), Minimum: utils.Ptr(int64(1)), Maximum: utils.Ptr(int64(2))},
+		{Name: utils.Ptr("pool"), Minimum: utils.Ptr(int64(1)), Maximum: utils.Ptr(int64(3))},
+	}
+	state := ske.CLUSTERSTATUSSTATE_HEALTHY
+	if a.payload != nil {
+		nodepools = *a.payload.Nodepools
+		state = a.states[len(a.states)-1]
+		if a.getCalls < len(a.states) {
+			state = a.states[a.getCalls]
+		}
+		a.getCalls++
+	}
+	return &ske.Cluster{
+		Name:       utils.Ptr("cluster"),
+		Kubernetes: &ske.Kubernetes{Version: utils.Ptr("1.31")},
+		Nodepools:  &nodepools,
+		Status: &ske.ClusterStatus{
+			Aggregated: utils.Ptr(state),
+			Errors:     &a.errors,
+		},
+	}, nil
+}
+
+func (a *apiClientScaleNodePoolMocked) CreateOrUpdateCluster(_ context.Context, _, _, _ string) ske.ApiCreateOrUpdateClusterRequest {
+	return &createOrUpdateClusterRequestMocked{client: a}
+}
+
+type createOrUpdateClusterRequestMocked struct {
+	ske.ApiCreateOrUpdateClusterRequest
+	client  *apiClientScaleNodePoolMocked
+	payload ske.CreateOrUpdateClusterPayload
+}
+
+func (r *createOrUpdateClusterRequestMocked) CreateOrUpdateClusterPayload(payload ske.CreateOrUpdateClusterPayload) ske.ApiCreateOrUpdateClusterRequest {
+	r.payload = payload
+	return r
+}
+
+func (r *createOrUpdateClusterRequestMocked) Execute() (*ske.Cluster, error) {
+	if r.client.updateFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	r.client.payload = &r.payload
+	return &ske.Cluster{}, nil
+}
+
+func TestScaleNodePoolAndWait(t *testing.T) {
+	tests := []struct {
+		desc           string
+		getFails       bool
+		updateFails    bool
+		nodePoolName   string
+		states         []ske.ClusterStatusState
+		errors         []ske.ClusterError
+		wantErr        bool
+		wantScaleError bool
+	}{
+		{
+			desc:         "scaled",
+			nodePoolName: "pool",
+			states:       []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_RECONCILING, ske.CLUSTERSTATUSSTATE_RECONCILING, ske.CLUSTERSTATUSSTATE_HEALTHY},
+		},
+		{
+			desc:         "scaled_after_unhealthy",
+			nodePoolName: "pool",
+			states:       []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_UNHEALTHY, ske.CLUSTERSTATUSSTATE_HEALTHY},
+		},
+		{
+			desc:           "failed",
+			nodePoolName:   "pool",
+			states:         []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_RECONCILING, StateFailed},
+			wantErr:        true,
+			wantScaleError: true,
+		},
+		{
+			desc:           "drain_blocked",
+			nodePoolName:   "pool",
+			states:         []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_UNHEALTHY},
+			errors:         []ske.ClusterError{{Code: utils.Ptr("SKE_NODE_MISCONFIGURED_PDB")}, {Code: utils.Ptr("SKE_DNS_ZONE_NOT_FOUND")}},
+			wantErr:        true,
+			wantScaleError: true,
+		},
+		{
+			desc:         "node_pool_not_found",
+			nodePoolName: "missing",
+			states:       []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_HEALTHY},
+			wantErr:      true,
+		},
+		{
+			desc:         "get_fails",
+			getFails:     true,
+			nodePoolName: "pool",
+			wantErr:      true,
+		},
+		{
+			desc:         "update_fails",
+			updateFails:  true,
+			nodePoolName: "pool",
+			wantErr:      true,
+		},
+		{
+			desc:         "timeout",
+			nodePoolName: "pool",
+			states:       []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_RECONCILING},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientScaleNodePoolMocked{
+				apiClientClusterMocked: apiClientClusterMocked{
+					getFails: tt.getFails,
+				},
+				updateFails: tt.updateFails,
+				states:      tt.states,
+				errors:      tt.errors,
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			pool, err := scaleNodePoolAndWait(ctx, apiClient, "", testRegion, "cluster", tt.nodePoolName, 5, time.Millisecond)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ScaleNodePoolAndWait error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var scaleErr *NodePoolScaleError
+			if errors.As(err, &scaleErr) != tt.wantScaleError {
+				t.Fatalf("ScaleNodePoolAndWait error = %v, wantScaleError %v", err, tt.wantScaleError)
+			}
+			if tt.wantScaleError && tt.errors != nil && len(scaleErr.Errors) != 1 {
+				t.Fatalf("expected only the node errors, got %v", scaleErr.Errors)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if pool.GetName() != "pool" || pool.GetMinimum() != 5 || pool.GetMaximum() != 5 {
+				t.Fatalf("unexpected node pool %+v", pool)
+			}
+			other := (*apiClient.payload.Nodepools)[0]
+			if other.GetMinimum() != 1 || other.GetMaximum() != 2 {
+				t.Fatalf("expected the other node pool to be unchanged, got %+v", other)
+			}
+			if apiClient.payload.Kubernetes.GetVersion() != "1.31" {
+				t.Fatalf("expected the other settings of the cluster to be sent unchanged")
+			}
+		})
+	}
+}
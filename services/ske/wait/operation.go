@@ -0,0 +1,221 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+// CreateOrUpdateClusterOperation returns the async action of CreateOrUpdateClusterWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with CreateOrUpdateClusterPollFunc. The name of
+// the operation is "ske/CreateOrUpdateCluster/{projectId}/{region}/{name}".
+func CreateOrUpdateClusterOperation(a APIClientClusterInterface, projectId, region, name string) *lro.Operation[ske.Cluster] {
+	return createOrUpdateClusterOperationHandler(a).Operation(projectId, region, name)
+}
+
+// CreateOrUpdateClusterPollFunc returns the lro.PollFunc of the operations returned by CreateOrUpdateClusterOperation,
+// e.g. to resume one with lro.Resume
+func CreateOrUpdateClusterPollFunc(a APIClientClusterInterface) lro.PollFunc[ske.Cluster] {
+	return createOrUpdateClusterOperationHandler(a).PollFunc()
+}
+
+func createOrUpdateClusterOperationHandler(a APIClientClusterInterface) lro.WaitHandler[ske.Cluster] {
+	return lro.WaitHandler[ske.Cluster]{
+		Kind: "ske/CreateOrUpdateCluster",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[ske.Cluster] {
+			return CreateOrUpdateClusterWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteClusterOperation returns the async action of DeleteClusterWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteClusterPollFunc. The name of the operation is
+// "ske/DeleteCluster/{projectId}/{region}/{name}".
+func DeleteClusterOperation(a APIClientClusterInterface, projectId, region, name string) *lro.Operation[ske.ListClustersResponse] {
+	return deleteClusterOperationHandler(a).Operation(projectId, region, name)
+}
+
+// DeleteClusterPollFunc returns the lro.PollFunc of the operations returned by DeleteClusterOperation, e.g. to resume
+// one with lro.Resume
+func DeleteClusterPollFunc(a APIClientClusterInterface) lro.PollFunc[ske.ListClustersResponse] {
+	return deleteClusterOperationHandler(a).PollFunc()
+}
+
+func deleteClusterOperationHandler(a APIClientClusterInterface) lro.WaitHandler[ske.ListClustersResponse] {
+	return lro.WaitHandler[ske.ListClustersResponse]{
+		Kind: "ske/DeleteCluster",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[ske.ListClustersResponse] {
+			return DeleteClusterWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// TriggerClusterHibernationOperation returns the async action of TriggerClusterHibernationWaitHandler as an
+// lro.Operation, which can be serialized to resume waiting for it in another process with
+// TriggerClusterHibernationPollFunc. The name of the operation is
+// "ske/TriggerClusterHibernation/{projectId}/{region}/{clusterName}".
+func TriggerClusterHibernationOperation(a APIClientClusterInterface, projectId, region, clusterName string) *lro.Operation[ske.Cluster] {
+	return triggerClusterHibernationOperationHandler(a).Operation(projectId, region, clusterName)
+}
+
+// TriggerClusterHibernationPollFunc returns the lro.PollFunc of the operations returned by
+// TriggerClusterHibernationOperation, e.g. to resume one with lro.Resume
+func TriggerClusterHibernationPollFunc(a APIClientClusterInterface) lro.PollFunc[ske.Cluster] {
+	return triggerClusterHibernationOperationHandler(a).PollFunc()
+}
+
+func triggerClusterHibernationOperationHandler(a APIClientClusterInterface) lro.WaitHandler[ske.Cluster] {
+	return lro.WaitHandler[ske.Cluster]{
+		Kind: "ske/TriggerClusterHibernation",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[ske.Cluster] {
+			return TriggerClusterHibernationWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// TriggerClusterMaintenanceOperation returns the async action of TriggerClusterMaintenanceWaitHandler as an
+// lro.Operation, which can be serialized to resume waiting for it in another process with
+// TriggerClusterMaintenancePollFunc. The name of the operation is
+// "ske/TriggerClusterMaintenance/{projectId}/{region}/{clusterName}".
+func TriggerClusterMaintenanceOperation(a APIClientClusterInterface, projectId, region, clusterName string) *lro.Operation[ske.Cluster] {
+	return triggerClusterMaintenanceOperationHandler(a).Operation(projectId, region, clusterName)
+}
+
+// TriggerClusterMaintenancePollFunc returns the lro.PollFunc of the operations returned by
+// TriggerClusterMaintenanceOperation, e.g. to resume one with lro.Resume
+func TriggerClusterMaintenancePollFunc(a APIClientClusterInterface) lro.PollFunc[ske.Cluster] {
+	return triggerClusterMaintenanceOperationHandler(a).PollFunc()
+}
+
+func triggerClusterMaintenanceOperationHandler(a APIClientClusterInterface) lro.WaitHandler[ske.Cluster] {
+	return lro.WaitHandler[ske.Cluster]{
+		Kind: "ske/TriggerClusterMaintenance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[ske.Cluster] {
+			return TriggerClusterMaintenanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// TriggerClusterReconciliationOperation returns the async action of TriggerClusterReconciliationWaitHandler as an
+// lro.Operation, which can be serialized to resume waiting for it in another process with
+// TriggerClusterReconciliationPollFunc. The name of the operation is
+// "ske/TriggerClusterReconciliation/{projectId}/{region}/{clusterName}".
+func TriggerClusterReconciliationOperation(a APIClientClusterInterface, projectId, region, clusterName string) *lro.Operation[ske.Cluster] {
+	return triggerClusterReconciliationOperationHandler(a).Operation(projectId, region, clusterName)
+}
+
+// TriggerClusterReconciliationPollFunc returns the lro.PollFunc of the operations returned by
+// TriggerClusterReconciliationOperation, e.g. to resume one with lro.Resume
+func TriggerClusterReconciliationPollFunc(a APIClientClusterInterface) lro.PollFunc[ske.Cluster] {
+	return triggerClusterReconciliationOperationHandler(a).PollFunc()
+}
+
+func triggerClusterReconciliationOperationHandler(a APIClientClusterInterface) lro.WaitHandler[ske.Cluster] {
+	return lro.WaitHandler[ske.Cluster]{
+		Kind: "ske/TriggerClusterReconciliation",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[ske.Cluster] {
+			return TriggerClusterReconciliationWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// TriggerClusterWakeupOperation returns the async action of TriggerClusterWakeupWaitHandler as an lro.Operation, which
+// can be serialized to resume waiting for it in another process with TriggerClusterWakeupPollFunc. The name of the
+// operation is "ske/TriggerClusterWakeup/{projectId}/{region}/{clusterName}".
+func TriggerClusterWakeupOperation(a APIClientClusterInterface, projectId, region, clusterName string) *lro.Operation[ske.Cluster] {
+	return triggerClusterWakeupOperationHandler(a).Operation(projectId, region, clusterName)
+}
+
+// TriggerClusterWakeupPollFunc returns the lro.PollFunc of the operations returned by TriggerClusterWakeupOperation,
+// e.g. to resume one with lro.Resume
+func TriggerClusterWakeupPollFunc(a APIClientClusterInterface) lro.PollFunc[ske.Cluster] {
+	return triggerClusterWakeupOperationHandler(a).PollFunc()
+}
+
+func triggerClusterWakeupOperationHandler(a APIClientClusterInterface) lro.WaitHandler[ske.Cluster] {
+	return lro.WaitHandler[ske.Cluster]{
+		Kind: "ske/TriggerClusterWakeup",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[ske.Cluster] {
+			return TriggerClusterWakeupWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// RotateCredentialsOperation returns the async action of RotateCredentialsWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with RotateCredentialsPollFunc. The name of the operation is
+// "ske/RotateCredentials/{projectId}/{region}/{clusterName}".
+func RotateCredentialsOperation(a APIClientClusterInterface, projectId, region, clusterName string) *lro.Operation[ske.Cluster] {
+	return rotateCredentialsOperationHandler(a).Operation(projectId, region, clusterName)
+}
+
+// RotateCredentialsPollFunc returns the lro.PollFunc of the operations returned by RotateCredentialsOperation, e.g. to
+// resume one with lro.Resume
+func RotateCredentialsPollFunc(a APIClientClusterInterface) lro.PollFunc[ske.Cluster] {
+	return rotateCredentialsOperationHandler(a).PollFunc()
+}
+
+func rotateCredentialsOperationHandler(a APIClientClusterInterface) lro.WaitHandler[ske.Cluster] {
+	return lro.WaitHandler[ske.Cluster]{
+		Kind: "ske/RotateCredentials",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[ske.Cluster] {
+			return RotateCredentialsWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// StartCredentialsRotationOperation returns the async action of StartCredentialsRotationWaitHandler as an
+// lro.Operation, which can be serialized to resume waiting for it in another process with
+// StartCredentialsRotationPollFunc. The name of the operation is
+// "ske/StartCredentialsRotation/{projectId}/{region}/{clusterName}".
+func StartCredentialsRotationOperation(a APIClientClusterInterface, projectId, region, clusterName string) *lro.Operation[ske.Cluster] {
+	return startCredentialsRotationOperationHandler(a).Operation(projectId, region, clusterName)
+}
+
+// StartCredentialsRotationPollFunc returns the lro.PollFunc of the operations returned by
+// StartCredentialsRotationOperation, e.g. to resume one with lro.Resume
+func StartCredentialsRotationPollFunc(a APIClientClusterInterface) lro.PollFunc[ske.Cluster] {
+	return startCredentialsRotationOperationHandler(a).PollFunc()
+}
+
+func startCredentialsRotationOperationHandler(a APIClientClusterInterface) lro.WaitHandler[ske.Cluster] {
+	return lro.WaitHandler[ske.Cluster]{
+		Kind: "ske/StartCredentialsRotation",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[ske.Cluster] {
+			return StartCredentialsRotationWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// CompleteCredentialsRotationOperation returns the async action of CompleteCredentialsRotationWaitHandler as an
+// lro.Operation, which can be serialized to resume waiting for it in another process with
+// CompleteCredentialsRotationPollFunc. The name of the operation is
+// "ske/CompleteCredentialsRotation/{projectId}/{region}/{clusterName}".
+func CompleteCredentialsRotationOperation(a APIClientClusterInterface, projectId, region, clusterName string) *lro.Operation[ske.Cluster] {
+	return completeCredentialsRotationOperationHandler(a).Operation(projectId, region, clusterName)
+}
+
+// CompleteCredentialsRotationPollFunc returns the lro.PollFunc of the operations returned by
+// CompleteCredentialsRotationOperation, e.g. to resume one with lro.Resume
+func CompleteCredentialsRotationPollFunc(a APIClientClusterInterface) lro.PollFunc[ske.Cluster] {
+	return completeCredentialsRotationOperationHandler(a).PollFunc()
+}
+
+func completeCredentialsRotationOperationHandler(a APIClientClusterInterface) lro.WaitHandler[ske.Cluster] {
+	return lro.WaitHandler[ske.Cluster]{
+		Kind: "ske/CompleteCredentialsRotation",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[ske.Cluster] {
+			return CompleteCredentialsRotationWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
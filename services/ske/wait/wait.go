@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/core/wait"
 	"github.com/stackitcloud/stackit-sdk-go/services/ske"
 )
@@ -64,7 +65,16 @@ func CreateOrUpdateClusterWaitHandler(ctx context.Context, a APIClientClusterInt
 			return true, s, fmt.Errorf("create failed")
 		}
 
-		return false, nil, nil
+		// The cluster in its intermediate state is reported as progress, see wait.AsyncActionHandler.SetProgressFunc
+		return false, s, nil
+	})
+	handler.SetStateFetch(func() (*ske.Cluster, string, error) {
+		s, err := a.GetClusterExecute(ctx, projectId, region, name)
+		if err != nil || s == nil {
+			return nil, "", err
+		}
+		status := s.GetStatus()
+		return s, string(status.GetAggregated()), nil
 	})
 	handler.SetTimeout(45 * time.Minute)
 	return handler
@@ -90,6 +100,26 @@ func DeleteClusterWaitHandler(ctx context.Context, a APIClientClusterInterface,
 	return handler
 }
 
+// Interface needed for DeleteClustersAndWait
+type APIClientDeleteClusterInterface interface {
+	APIClientClusterInterface
+	DeleteClusterExecute(ctx context.Context, projectId, region, clusterName string) (map[string]interface{}, error)
+}
+
+// DeleteClustersAndWait deletes the clusters and waits for each deletion to finish, running up to concurrency deletions at the same time.
+// It doesn't stop at the first failure, the errors are returned by cluster name. See utils.DeleteAll.
+func DeleteClustersAndWait(ctx context.Context, a APIClientDeleteClusterInterface, projectId, region string, clusterNames []string, concurrency int) map[string]error {
+	return utils.DeleteAll(ctx, clusterNames, func(ctx context.Context, clusterName string) error {
+		if _, err := a.DeleteClusterExecute(ctx, projectId, region, clusterName); err != nil {
+			return fmt.Errorf("delete cluster: %w", err)
+		}
+		if _, err := DeleteClusterWaitHandler(ctx, a, projectId, region, clusterName).WaitWithContext(ctx); err != nil {
+			return fmt.Errorf("wait for cluster deletion: %w", err)
+		}
+		return nil
+	}, concurrency)
+}
+
 func TriggerClusterHibernationWaitHandler(ctx context.Context, a APIClientClusterInterface, projectId, region, clusterName string) *wait.AsyncActionHandler[ske.Cluster] {
 	handler := wait.New(func() (waitFinished bool, response *ske.Cluster, err error) {
 		cluster, err := a.GetClusterExecute(ctx, projectId, region, clusterName)
@@ -237,3 +267,112 @@ func CompleteCredentialsRotationWaitHandler(ctx context.Context, a APIClientClus
 	handler.SetTimeout(45 * time.Minute)
 	return handler
 }
+
+// APIClientCredentialsRotationInterface is the interface needed to trigger and wait for a credentials rotation
+type APIClientCredentialsRotationInterface interface {
+	APIClientClusterInterface
+	StartCredentialsRotationExecute(ctx context.Context, projectId, region, clusterName string) (map[string]interface{}, error)
+	CompleteCredentialsRotationExecute(ctx context.Context, projectId, region, clusterName string) (map[string]interface{}, error)
+}
+
+// CredentialsRotationError is returned by RotateCredentialsAndWait if the cluster enters a failed state during the credentials rotation
+type CredentialsRotationError struct {
+	ClusterName string
+	// Phase of the credentials rotation when the failure was detected
+	Phase ske.CredentialsRotationStatePhase
+}
+
+func (e *CredentialsRotationError) Error() string {
+	return fmt.Sprintf("credentials rotation of cluster %q failed in phase %s", e.ClusterName, e.Phase)
+}
+
+// RotateCredentialsAndWait performs a complete two-step credentials rotation of a cluster.
+// It starts the rotation, waits until the new credentials are prepared, completes the rotation
+// and waits until the rotation is completed.
+// If the cluster enters a failed state during the rotation, a *CredentialsRotationError is returned.
+func RotateCredentialsAndWait(ctx context.Context, a APIClientCredentialsRotationInterface, projectId, region, clusterName string) error {
+	return rotateCredentialsAndWait(ctx, a, projectId, region, clusterName, 5*time.Second)
+}
+
+func rotateCredentialsAndWait(ctx context.Context, a APIClientCredentialsRotationInterface, projectId, region, clusterName string, throttle time.Duration) error {
+	_, err := a.StartCredentialsRotationExecute(ctx, projectId, region, clusterName)
+	if err != nil {
+		return fmt.Errorf("start credentials rotation: %w", err)
+	}
+	_, err = credentialsRotationPhaseWaitHandler(ctx, a, projectId, region, clusterName,
+		ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+		[]ske.CredentialsRotationStatePhase{ske.CREDENTIALSROTATIONSTATEPHASE_PREPARING},
+		// The phase may still report the result of a previous rotation until the API has processed the request
+		[]ske.CredentialsRotationStatePhase{ske.CREDENTIALSROTATIONSTATEPHASE_NEVER, ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETED},
+	).SetThrottle(throttle).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for credentials rotation to be prepared: %w", err)
+	}
+
+	_, err = a.CompleteCredentialsRotationExecute(ctx, projectId, region, clusterName)
+	if err != nil {
+		return fmt.Errorf("complete credentials rotation: %w", err)
+	}
+	_, err = credentialsRotationPhaseWaitHandler(ctx, a, projectId, region, clusterName,
+		ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETED,
+		[]ske.CredentialsRotationStatePhase{ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETING},
+		[]ske.CredentialsRotationStatePhase{ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED},
+	).SetThrottle(throttle).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for credentials rotation to be completed: %w", err)
+	}
+	return nil
+}
+
+// maxStaleCredentialsRotationChecks is the number of checks for which the phase of the credentials rotation may
+// still report the previous phase after a step of the rotation was requested, a minute with the default throttle
+const maxStaleCredentialsRotationChecks = 12
+
+// credentialsRotationPhaseWaitHandler waits until the credentials rotation of a cluster reaches the target phase.
+// All phases in intermediatePhases keep the handler polling. The phases in stalePhases keep it polling for the first
+// maxStaleCredentialsRotationChecks checks, as the API may not have processed the request yet, and afterwards fail,
+// so that a step of the rotation which never takes effect doesn't wait until the timeout.
+// Any other phase is considered unexpected.
+func credentialsRotationPhaseWaitHandler(ctx context.Context, a APIClientClusterInterface, projectId, region, clusterName string, target ske.CredentialsRotationStatePhase, intermediatePhases, stalePhases []ske.CredentialsRotationStatePhase) *wait.AsyncActionHandler[ske.Cluster] {
+	checks := 0
+	handler := wait.New(func() (waitFinished bool, response *ske.Cluster, err error) {
+		checks++
+		s, err := a.GetClusterExecute(ctx, projectId, region, clusterName)
+		if err != nil {
+			return false, nil, err
+		}
+		if s.Status == nil || s.Status.CredentialsRotation == nil || s.Status.CredentialsRotation.Phase == nil {
+			return false, nil, nil
+		}
+		phase := *s.Status.CredentialsRotation.Phase
+
+		if s.Status.Aggregated != nil && *s.Status.Aggregated == StateFailed {
+			return true, s, &CredentialsRotationError{ClusterName: clusterName, Phase: phase}
+		}
+
+		if phase == target {
+			return true, s, nil
+		}
+
+		for _, p := range intermediatePhases {
+			if phase == p {
+				return false, nil, nil
+			}
+		}
+
+		for _, p := range stalePhases {
+			if phase != p {
+				continue
+			}
+			if checks <= maxStaleCredentialsRotationChecks {
+				return false, nil, nil
+			}
+			return true, s, fmt.Errorf("credentials rotation still in phase %s after %d checks while waiting for it to reach %s, the request has not taken effect", phase, checks, target)
+		}
+
+		return true, s, fmt.Errorf("unexpected status %s while waiting for cluster credentials rotation to reach %s", phase, target)
+	})
+
+	handler.SetTimeout(45 * time.Minute)
+	return handler
+}
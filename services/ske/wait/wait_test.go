@@ -2,7 +2,9 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"sync"
 	"testing"
 	"time"
 
@@ -118,7 +120,7 @@ func TestCreateOrUpdateClusterWaitHandler(t *testing.T) {
 			getFails:      false,
 			resourceState: "ANOTHER STATE",
 			wantErr:       true,
-			wantResp:      false,
+			wantResp:      true,
 		},
 	}
 	for _, tt := range tests {
@@ -163,6 +165,32 @@ func TestCreateOrUpdateClusterWaitHandler(t *testing.T) {
 	}
 }
 
+func TestCreateOrUpdateClusterWaitHandlerProgress(t *testing.T) {
+	apiClient := &apiClientClusterMocked{
+		name:          "cluster",
+		resourceState: ske.CLUSTERSTATUSSTATE_CREATING,
+	}
+	next := map[ske.ClusterStatusState]ske.ClusterStatusState{
+		ske.CLUSTERSTATUSSTATE_CREATING:    ske.CLUSTERSTATUSSTATE_RECONCILING,
+		ske.CLUSTERSTATUSSTATE_RECONCILING: ske.CLUSTERSTATUSSTATE_HEALTHY,
+	}
+
+	var progress []ske.ClusterStatusState
+	handler := CreateOrUpdateClusterWaitHandler(context.Background(), apiClient, "", testRegion, "cluster")
+	handler.SetProgressFunc(func(cluster *ske.Cluster) {
+		status := cluster.GetStatus()
+		progress = append(progress, status.GetAggregated())
+		apiClient.resourceState = next[status.GetAggregated()]
+	})
+	if _, err := handler.SetThrottle(time.Millisecond).WaitWithContext(context.Background()); err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+	want := []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_CREATING, ske.CLUSTERSTATUSSTATE_RECONCILING}
+	if diff := cmp.Diff(want, progress); diff != "" {
+		t.Fatalf("unexpected progress (-want +got):\n%s", diff)
+	}
+}
+
 func TestTriggerClusterHibernationWaitHandler(t *testing.T) {
 	tests := []struct {
 		description   string
@@ -514,3 +542,251 @@ func TestRotateCredentialsWaitHandler(t *testing.T) {
 		})
 	}
 }
+
+// Used for testing credentials rotation operations
+type apiClientCredentialsRotationMocked struct {
+	apiClientClusterMocked
+	startFails    bool
+	completeFails bool
+	// phases returned by consecutive calls to GetClusterExecute, the last one is repeated
+	phases      []ske.CredentialsRotationStatePhase
+	getCalls    int
+	startCalled bool
+}
+
+func (a *apiClientCredentialsRotationMocked) GetClusterExecute(_ context.Context, _, _, _ string) (*ske.Cluster, error) {
+	if a.getFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+	phase := a.phases[len(a.phases)-1]
+	if a.getCalls < len(a.phases) {
+		phase = a.phases[a.getCalls]
+	}
+	a.getCalls++
+	return &ske.Cluster{
+		Name: utils.Ptr(a.name),
+		Status: &ske.ClusterStatus{
+			Aggregated: utils.Ptr(a.resourceState),
+			CredentialsRotation: &ske.CredentialsRotationState{
+				Phase: utils.Ptr(phase),
+			},
+		},
+	}, nil
+}
+
+func (a *apiClientCredentialsRotationMocked) StartCredentialsRotationExecute(_ context.Context, _, _, _ string) (map[string]interface{}, error) {
+	if a.startFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+	a.startCalled = true
+	return map[string]interface{}{}, nil
+}
+
+func (a *apiClientCredentialsRotationMocked) CompleteCredentialsRotationExecute(_ context.Context, _, _, _ string) (map[string]interface{}, error) {
+	if a.completeFails || !a.startCalled {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+	return map[string]interface{}{}, nil
+}
+
+func TestRotateCredentialsAndWait(t *testing.T) {
+	tests := []struct {
+		desc              string
+		getFails          bool
+		startFails        bool
+		completeFails     bool
+		resourceState     ske.ClusterStatusState
+		phases            []ske.CredentialsRotationStatePhase
+		wantErr           bool
+		wantRotationError bool
+		// wantGetCalls is the number of checks after which the rotation fails before the timeout, if set
+		wantGetCalls int
+	}{
+		{
+			desc:          "rotation_succeeded",
+			resourceState: ske.CLUSTERSTATUSSTATE_RECONCILING,
+			phases: []ske.CredentialsRotationStatePhase{
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARING,
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+				ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETING,
+				ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETED,
+			},
+			wantErr: false,
+		},
+		{
+			desc:          "rotation_succeeded_stale_phases",
+			resourceState: ske.CLUSTERSTATUSSTATE_HEALTHY,
+			phases: []ske.CredentialsRotationStatePhase{
+				ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETED,
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+				ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETED,
+			},
+			wantErr: false,
+		},
+		{
+			desc:          "rotation_failed",
+			resourceState: StateFailed,
+			phases: []ske.CredentialsRotationStatePhase{
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARING,
+			},
+			wantErr:           true,
+			wantRotationError: true,
+		},
+		{
+			desc:          "start_fails",
+			startFails:    true,
+			resourceState: ske.CLUSTERSTATUSSTATE_HEALTHY,
+			phases: []ske.CredentialsRotationStatePhase{
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+			},
+			wantErr: true,
+		},
+		{
+			desc:          "complete_fails",
+			completeFails: true,
+			resourceState: ske.CLUSTERSTATUSSTATE_HEALTHY,
+			phases: []ske.CredentialsRotationStatePhase{
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+			},
+			wantErr: true,
+		},
+		{
+			desc:     "get_fails",
+			getFails: true,
+			phases: []ske.CredentialsRotationStatePhase{
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+			},
+			wantErr: true,
+		},
+		{
+			desc:          "timeout",
+			resourceState: ske.CLUSTERSTATUSSTATE_RECONCILING,
+			phases: []ske.CredentialsRotationStatePhase{
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARING,
+			},
+			wantErr: true,
+		},
+		{
+			desc:          "rotation_never_starts",
+			resourceState: ske.CLUSTERSTATUSSTATE_HEALTHY,
+			phases: []ske.CredentialsRotationStatePhase{
+				ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETED,
+			},
+			wantErr:      true,
+			wantGetCalls: maxStaleCredentialsRotationChecks + 1,
+		},
+		{
+			desc:          "completion_never_starts",
+			resourceState: ske.CLUSTERSTATUSSTATE_HEALTHY,
+			phases: []ske.CredentialsRotationStatePhase{
+				ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+			},
+			wantErr:      true,
+			wantGetCalls: maxStaleCredentialsRotationChecks + 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientCredentialsRotationMocked{
+				apiClientClusterMocked: apiClientClusterMocked{
+					getFails:      tt.getFails,
+					name:          "cluster",
+					resourceState: tt.resourceState,
+				},
+				startFails:    tt.startFails,
+				completeFails: tt.completeFails,
+				phases:        tt.phases,
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			err := rotateCredentialsAndWait(ctx, apiClient, "", testRegion, "cluster", time.Millisecond)
+			if tt.wantGetCalls > 0 && apiClient.getCalls != tt.wantGetCalls {
+				t.Fatalf("expected the rotation to fail after %d checks, got %d: %v", tt.wantGetCalls, apiClient.getCalls, err)
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RotateCredentialsAndWait error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var rotationErr *CredentialsRotationError
+			if errors.As(err, &rotationErr) != tt.wantRotationError {
+				t.Fatalf("RotateCredentialsAndWait error = %v, wantRotationError %v", err, tt.wantRotationError)
+			}
+		})
+	}
+}
+
+// Used for testing the deletion of multiple clusters
+type apiClientDeleteClusterMocked struct {
+	apiClientClusterMocked
+	mu          sync.Mutex
+	deleteFails map[string]bool
+	deleted     []string
+}
+
+func (a *apiClientDeleteClusterMocked) ListClustersExecute(_ context.Context, _, _ string) (*ske.ListClustersResponse, error) {
+	return &ske.ListClustersResponse{Items: &[]ske.Cluster{}}, nil
+}
+
+func (a *apiClientDeleteClusterMocked) DeleteClusterExecute(_ context.Context, _, _, clusterName string) (map[string]interface{}, error) {
+	if a.deleteFails[clusterName] {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.deleted = append(a.deleted, clusterName)
+	return map[string]interface{}{}, nil
+}
+
+func TestDeleteClustersAndWait(t *testing.T) {
+	tests := []struct {
+		desc        string
+		deleteFails map[string]bool
+		wantFailed  []string
+		wantDeleted int
+	}{
+		{
+			desc:        "all_deleted",
+			wantFailed:  []string{},
+			wantDeleted: 3,
+		},
+		{
+			desc:        "delete_fails",
+			deleteFails: map[string]bool{"cluster-1": true, "cluster-3": true},
+			wantFailed:  []string{"cluster-1", "cluster-3"},
+			wantDeleted: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			apiClient := &apiClientDeleteClusterMocked{
+				deleteFails: tt.deleteFails,
+			}
+			clusterNames := []string{"cluster-1", "cluster-2", "cluster-3"}
+
+			errs := DeleteClustersAndWait(context.Background(), apiClient, "", testRegion, clusterNames, 2)
+
+			failed := []string{}
+			for _, clusterName := range clusterNames {
+				if errs[clusterName] != nil {
+					failed = append(failed, clusterName)
+				}
+			}
+			if diff := cmp.Diff(tt.wantFailed, failed); diff != "" {
+				t.Fatalf("unexpected failed clusters: %s", diff)
+			}
+			if len(apiClient.deleted) != tt.wantDeleted {
+				t.Fatalf("expected %d deleted clusters, got %d", tt.wantDeleted, len(apiClient.deleted))
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/sqlserverflex",
+		ServiceName:   "sqlserverflex",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,40 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"CreateDatabase",
+	"CreateInstance",
+	"CreateUser",
+	"DeleteDatabase",
+	"DeleteInstance",
+	"DeleteUser",
+	"GetBackup",
+	"GetDatabase",
+	"GetInstance",
+	"GetUser",
+	"ListBackups",
+	"ListCollations",
+	"ListCompatibility",
+	"ListDatabases",
+	"ListFlavors",
+	"ListInstances",
+	"ListMetrics",
+	"ListRestoreJobs",
+	"ListRoles",
+	"ListStorages",
+	"ListUsers",
+	"ListVersions",
+	"PartialUpdateInstance",
+	"ResetUser",
+	"TerminateProject",
+	"TriggerDatabaseBackup",
+	"TriggerDatabaseRestore",
+	"UpdateInstance",
+}
+
+func init() {
+	config.RegisterOperations("sqlserverflex", operations...)
+}
@@ -50,14 +50,9 @@ func (v *Type) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := Type(value)
-	for _, existing := range AllowedTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Type", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewTypeFromValue returns a pointer to a valid Type
@@ -81,6 +76,17 @@ func (v Type) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v Type) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v Type) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to Type value
 func (v Type) Ptr() *Type {
 	return &v
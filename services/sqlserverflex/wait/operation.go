@@ -0,0 +1,101 @@
+package wait
+
+import (
+	"context"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/lro"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex"
+)
+
+// CreateInstanceOperation returns the async action of CreateInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with CreateInstancePollFunc. The name of the operation is
+// "sqlserverflex/CreateInstance/{projectId}/{instanceId}/{region}".
+func CreateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, region string) *lro.Operation[sqlserverflex.GetInstanceResponse] {
+	return createInstanceOperationHandler(a).Operation(projectId, instanceId, region)
+}
+
+// CreateInstancePollFunc returns the lro.PollFunc of the operations returned by CreateInstanceOperation, e.g. to resume
+// one with lro.Resume
+func CreateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[sqlserverflex.GetInstanceResponse] {
+	return createInstanceOperationHandler(a).PollFunc()
+}
+
+func createInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[sqlserverflex.GetInstanceResponse] {
+	return lro.WaitHandler[sqlserverflex.GetInstanceResponse]{
+		Kind: "sqlserverflex/CreateInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[sqlserverflex.GetInstanceResponse] {
+			return CreateInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// UpdateInstanceOperation returns the async action of UpdateInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with UpdateInstancePollFunc. The name of the operation is
+// "sqlserverflex/UpdateInstance/{projectId}/{instanceId}/{region}".
+func UpdateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, region string) *lro.Operation[sqlserverflex.GetInstanceResponse] {
+	return updateInstanceOperationHandler(a).Operation(projectId, instanceId, region)
+}
+
+// UpdateInstancePollFunc returns the lro.PollFunc of the operations returned by UpdateInstanceOperation, e.g. to resume
+// one with lro.Resume
+func UpdateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[sqlserverflex.GetInstanceResponse] {
+	return updateInstanceOperationHandler(a).PollFunc()
+}
+
+func updateInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[sqlserverflex.GetInstanceResponse] {
+	return lro.WaitHandler[sqlserverflex.GetInstanceResponse]{
+		Kind: "sqlserverflex/UpdateInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[sqlserverflex.GetInstanceResponse] {
+			return UpdateInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// PartialUpdateInstanceOperation returns the async action of PartialUpdateInstanceWaitHandler as an lro.Operation,
+// which can be serialized to resume waiting for it in another process with PartialUpdateInstancePollFunc. The name of
+// the operation is "sqlserverflex/PartialUpdateInstance/{projectId}/{instanceId}/{region}".
+func PartialUpdateInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, region string) *lro.Operation[sqlserverflex.GetInstanceResponse] {
+	return partialUpdateInstanceOperationHandler(a).Operation(projectId, instanceId, region)
+}
+
+// PartialUpdateInstancePollFunc returns the lro.PollFunc of the operations returned by PartialUpdateInstanceOperation,
+// e.g. to resume one with lro.Resume
+func PartialUpdateInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[sqlserverflex.GetInstanceResponse] {
+	return partialUpdateInstanceOperationHandler(a).PollFunc()
+}
+
+func partialUpdateInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[sqlserverflex.GetInstanceResponse] {
+	return lro.WaitHandler[sqlserverflex.GetInstanceResponse]{
+		Kind: "sqlserverflex/PartialUpdateInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[sqlserverflex.GetInstanceResponse] {
+			return PartialUpdateInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
+
+// DeleteInstanceOperation returns the async action of DeleteInstanceWaitHandler as an lro.Operation, which can be
+// serialized to resume waiting for it in another process with DeleteInstancePollFunc. The name of the operation is
+// "sqlserverflex/DeleteInstance/{projectId}/{instanceId}/{region}".
+func DeleteInstanceOperation(a APIClientInstanceInterface, projectId, instanceId, region string) *lro.Operation[struct{}] {
+	return deleteInstanceOperationHandler(a).Operation(projectId, instanceId, region)
+}
+
+// DeleteInstancePollFunc returns the lro.PollFunc of the operations returned by DeleteInstanceOperation, e.g. to resume
+// one with lro.Resume
+func DeleteInstancePollFunc(a APIClientInstanceInterface) lro.PollFunc[struct{}] {
+	return deleteInstanceOperationHandler(a).PollFunc()
+}
+
+func deleteInstanceOperationHandler(a APIClientInstanceInterface) lro.WaitHandler[struct{}] {
+	return lro.WaitHandler[struct{}]{
+		Kind: "sqlserverflex/DeleteInstance",
+		IDs:  3,
+		New: func(ctx context.Context, ids []string) *wait.AsyncActionHandler[struct{}] {
+			return DeleteInstanceWaitHandler(ctx, a, ids[0], ids[1], ids[2])
+		},
+	}
+}
@@ -20,9 +20,12 @@ import (
 	"strings"
 
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/filter"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 )
 
+// DefaultApi is the interface of the operations of the API, implemented by APIClient, e.g. to inject a mock of the
+// API client generated with gomock or moq in tests
 type DefaultApi interface {
 	/*
 		ApproveSubscription Approve a subscription
@@ -162,23 +165,42 @@ type DefaultApi interface {
 	VendorsSubscriptionsRejectExecute(ctx context.Context, projectId string, subscriptionId string) error
 }
 
+// APIClient implements DefaultApi
+var _ DefaultApi = &APIClient{}
+
 type ApiApproveSubscriptionRequest interface {
 	ApproveSubscriptionPayload(approveSubscriptionPayload ApproveSubscriptionPayload) ApiApproveSubscriptionRequest
+	SetQueryParam(key, value string) ApiApproveSubscriptionRequest
+	AddQueryParam(key, value string) ApiApproveSubscriptionRequest
+	Clone() ApiApproveSubscriptionRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
 type ApiGetCatalogProductRequest interface {
 	// The language of the response.
 	Locale(locale string) ApiGetCatalogProductRequest
+	SetQueryParam(key, value string) ApiGetCatalogProductRequest
+	AddQueryParam(key, value string) ApiGetCatalogProductRequest
+	Clone() ApiGetCatalogProductRequest
+	Fingerprint() (string, error)
 	Execute() (*CatalogProductDetail, error)
 }
 
 type ApiGetVendorSubscriptionRequest interface {
+	SetQueryParam(key, value string) ApiGetVendorSubscriptionRequest
+	AddQueryParam(key, value string) ApiGetVendorSubscriptionRequest
+	Clone() ApiGetVendorSubscriptionRequest
+	Fingerprint() (string, error)
 	Execute() (*VendorSubscription, error)
 }
 
 type ApiInquiriesCreateInquiryRequest interface {
 	InquiriesCreateInquiryPayload(inquiriesCreateInquiryPayload InquiriesCreateInquiryPayload) ApiInquiriesCreateInquiryRequest
+	SetQueryParam(key, value string) ApiInquiriesCreateInquiryRequest
+	AddQueryParam(key, value string) ApiInquiriesCreateInquiryRequest
+	Clone() ApiInquiriesCreateInquiryRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
@@ -191,8 +213,14 @@ type ApiListCatalogProductsRequest interface {
 	Locale(locale string) ApiListCatalogProductsRequest
 	// Filter the products based on attributes, e.g., &#x60;deliveryMethod eq \&quot;SAAS\&quot;&#x60;. The supported operators are: - &#x60;PR&#x60; (present; &#x60;name pr&#x60;) - &#x60;EQ&#x60; (equal) - &#x60;NE&#x60; (not equal) - &#x60;CO&#x60; (contains; &#x60;text co \&quot;searching\&quot;&#x60;) - &#x60;SW&#x60; (starts with) - &#x60;EW&#x60; (ends with) - &#x60;GT&#x60; (greater than) - &#x60;LT&#x60; (less than) - &#x60;GE&#x60; (greater than or equal) - &#x60;LE&#x60; (less than or equal).  These expressions can be logically linked with &#x60;AND&#x60; and &#x60;OR&#x60;. All attributes (and the special &#x60;text&#x60; attribute) can be used as filters, if the attribute type allows the operator.
 	Filter(filter string) ApiListCatalogProductsRequest
+	// FilterExpr filters with expr, built with the fields of ListCatalogProductsFilterFields
+	FilterExpr(expr filter.SCIMExpr) ApiListCatalogProductsRequest
 	// Sort the products based on attributes and order e.g. &#x60;name:asc&#x60;. Attributes with scalar types (&#x60;createdAt&#x60;, &#x60;isProductListing&#x60;) or keywords (&#x60;name&#x60;, &#x60;deliveryMethod&#x60;, &#x60;lifecycleState&#x60;, &#x60;vendor.name&#x60;) can be used as sort criteria. To set the sort order, append &#x60;asc&#x60; (ascending) or &#x60;desc&#x60; (descending) to the attribute, e.g. &#x60;name:asc&#x60;. To sort by multiple attributes, separate them with a comma. E.g &#x60;name:asc,price:desc&#x60;.
 	Sort(sort string) ApiListCatalogProductsRequest
+	SetQueryParam(key, value string) ApiListCatalogProductsRequest
+	AddQueryParam(key, value string) ApiListCatalogProductsRequest
+	Clone() ApiListCatalogProductsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListCatalogProductsResponse, error)
 }
 
@@ -203,15 +231,27 @@ type ApiListVendorSubscriptionsRequest interface {
 	Limit(limit float32) ApiListVendorSubscriptionsRequest
 	// The product ID.
 	ProductId(productId string) ApiListVendorSubscriptionsRequest
+	SetQueryParam(key, value string) ApiListVendorSubscriptionsRequest
+	AddQueryParam(key, value string) ApiListVendorSubscriptionsRequest
+	Clone() ApiListVendorSubscriptionsRequest
+	Fingerprint() (string, error)
 	Execute() (*ListVendorSubscriptionsResponse, error)
 }
 
 type ApiResolveCustomerRequest interface {
 	ResolveCustomerPayload(resolveCustomerPayload ResolveCustomerPayload) ApiResolveCustomerRequest
+	SetQueryParam(key, value string) ApiResolveCustomerRequest
+	AddQueryParam(key, value string) ApiResolveCustomerRequest
+	Clone() ApiResolveCustomerRequest
+	Fingerprint() (string, error)
 	Execute() (*VendorSubscription, error)
 }
 
 type ApiVendorsSubscriptionsRejectRequest interface {
+	SetQueryParam(key, value string) ApiVendorsSubscriptionsRejectRequest
+	AddQueryParam(key, value string) ApiVendorsSubscriptionsRejectRequest
+	Clone() ApiVendorsSubscriptionsRejectRequest
+	Fingerprint() (string, error)
 	Execute() error
 }
 
@@ -224,6 +264,7 @@ type ApproveSubscriptionRequest struct {
 	projectId                  string
 	subscriptionId             string
 	approveSubscriptionPayload *ApproveSubscriptionPayload
+	queryParams                []queryParam
 }
 
 func (r ApproveSubscriptionRequest) ApproveSubscriptionPayload(approveSubscriptionPayload ApproveSubscriptionPayload) ApiApproveSubscriptionRequest {
@@ -231,7 +272,43 @@ func (r ApproveSubscriptionRequest) ApproveSubscriptionPayload(approveSubscripti
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ApproveSubscriptionRequest) SetQueryParam(key, value string) ApiApproveSubscriptionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ApproveSubscriptionRequest) AddQueryParam(key, value string) ApiApproveSubscriptionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ApproveSubscriptionRequest) Clone() ApiApproveSubscriptionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ApproveSubscriptionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ApproveSubscriptionRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ApproveSubscription", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "subscriptionId", Value: ParameterValueToString(r.subscriptionId, "subscriptionId")})
+	}
+	return err
+}
+
+func (r ApproveSubscriptionRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
@@ -254,6 +331,12 @@ func (r ApproveSubscriptionRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.subscriptionId == "" {
+		return &oapierror.ValidationError{Field: "subscriptionId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -286,7 +369,8 @@ func (r ApproveSubscriptionRequest) Execute() error {
 	}
 	// body params
 	localVarPostBody = r.approveSubscriptionPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ApproveSubscription"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -317,10 +401,14 @@ func (r ApproveSubscriptionRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -331,7 +419,7 @@ func (r ApproveSubscriptionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -342,7 +430,7 @@ func (r ApproveSubscriptionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -353,7 +441,7 @@ func (r ApproveSubscriptionRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -397,10 +485,11 @@ func (a *APIClient) ApproveSubscriptionExecute(ctx context.Context, projectId st
 }
 
 type GetCatalogProductRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	productId  string
-	locale     *string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	productId   string
+	locale      *string
+	queryParams []queryParam
 }
 
 // The language of the response.
@@ -410,7 +499,43 @@ func (r GetCatalogProductRequest) Locale(locale string) ApiGetCatalogProductRequ
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetCatalogProductRequest) SetQueryParam(key, value string) ApiGetCatalogProductRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetCatalogProductRequest) AddQueryParam(key, value string) ApiGetCatalogProductRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetCatalogProductRequest) Clone() ApiGetCatalogProductRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetCatalogProductRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r GetCatalogProductRequest) Execute() (*CatalogProductDetail, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetCatalogProduct", oapierror.Parameter{Name: "productId", Value: ParameterValueToString(r.productId, "productId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetCatalogProductRequest) execute() (*CatalogProductDetail, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -433,6 +558,9 @@ func (r GetCatalogProductRequest) Execute() (*CatalogProductDetail, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.productId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "productId"}
+	}
 	if strlen(r.productId) < 10 {
 		return localVarReturnValue, fmt.Errorf("productId must have at least 10 elements")
 	}
@@ -460,7 +588,8 @@ func (r GetCatalogProductRequest) Execute() (*CatalogProductDetail, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetCatalogProduct"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -491,10 +620,14 @@ func (r GetCatalogProductRequest) Execute() (*CatalogProductDetail, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -505,7 +638,7 @@ func (r GetCatalogProductRequest) Execute() (*CatalogProductDetail, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -516,7 +649,7 @@ func (r GetCatalogProductRequest) Execute() (*CatalogProductDetail, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -527,7 +660,7 @@ func (r GetCatalogProductRequest) Execute() (*CatalogProductDetail, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -538,7 +671,7 @@ func (r GetCatalogProductRequest) Execute() (*CatalogProductDetail, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -585,9 +718,46 @@ type GetVendorSubscriptionRequest struct {
 	apiService     *DefaultApiService
 	projectId      string
 	subscriptionId string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r GetVendorSubscriptionRequest) SetQueryParam(key, value string) ApiGetVendorSubscriptionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r GetVendorSubscriptionRequest) AddQueryParam(key, value string) ApiGetVendorSubscriptionRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r GetVendorSubscriptionRequest) Clone() ApiGetVendorSubscriptionRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r GetVendorSubscriptionRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r GetVendorSubscriptionRequest) Execute() (*VendorSubscription, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "GetVendorSubscription", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "subscriptionId", Value: ParameterValueToString(r.subscriptionId, "subscriptionId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r GetVendorSubscriptionRequest) execute() (*VendorSubscription, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -611,6 +781,12 @@ func (r GetVendorSubscriptionRequest) Execute() (*VendorSubscription, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.subscriptionId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "subscriptionId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -641,7 +817,8 @@ func (r GetVendorSubscriptionRequest) Execute() (*VendorSubscription, error) {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "GetVendorSubscription"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -672,10 +849,14 @@ func (r GetVendorSubscriptionRequest) Execute() (*VendorSubscription, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -686,7 +867,7 @@ func (r GetVendorSubscriptionRequest) Execute() (*VendorSubscription, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -697,7 +878,7 @@ func (r GetVendorSubscriptionRequest) Execute() (*VendorSubscription, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -708,7 +889,7 @@ func (r GetVendorSubscriptionRequest) Execute() (*VendorSubscription, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -719,7 +900,7 @@ func (r GetVendorSubscriptionRequest) Execute() (*VendorSubscription, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -765,6 +946,7 @@ type InquiriesCreateInquiryRequest struct {
 	ctx                           context.Context
 	apiService                    *DefaultApiService
 	inquiriesCreateInquiryPayload *InquiriesCreateInquiryPayload
+	queryParams                   []queryParam
 }
 
 func (r InquiriesCreateInquiryRequest) InquiriesCreateInquiryPayload(inquiriesCreateInquiryPayload InquiriesCreateInquiryPayload) ApiInquiriesCreateInquiryRequest {
@@ -772,7 +954,43 @@ func (r InquiriesCreateInquiryRequest) InquiriesCreateInquiryPayload(inquiriesCr
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r InquiriesCreateInquiryRequest) SetQueryParam(key, value string) ApiInquiriesCreateInquiryRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r InquiriesCreateInquiryRequest) AddQueryParam(key, value string) ApiInquiriesCreateInquiryRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r InquiriesCreateInquiryRequest) Clone() ApiInquiriesCreateInquiryRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r InquiriesCreateInquiryRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r InquiriesCreateInquiryRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "InquiriesCreateInquiry")
+	}
+	return err
+}
+
+func (r InquiriesCreateInquiryRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
@@ -794,7 +1012,7 @@ func (r InquiriesCreateInquiryRequest) Execute() error {
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 	if r.inquiriesCreateInquiryPayload == nil {
-		return fmt.Errorf("inquiriesCreateInquiryPayload is required and must be specified")
+		return &oapierror.ValidationError{Field: "inquiriesCreateInquiryPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -816,7 +1034,8 @@ func (r InquiriesCreateInquiryRequest) Execute() error {
 	}
 	// body params
 	localVarPostBody = r.inquiriesCreateInquiryPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "InquiriesCreateInquiry"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -847,10 +1066,14 @@ func (r InquiriesCreateInquiryRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -861,7 +1084,7 @@ func (r InquiriesCreateInquiryRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -872,7 +1095,7 @@ func (r InquiriesCreateInquiryRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -883,7 +1106,7 @@ func (r InquiriesCreateInquiryRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -924,13 +1147,15 @@ func (a *APIClient) InquiriesCreateInquiryExecute(ctx context.Context) error {
 }
 
 type ListCatalogProductsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	cursor     *string
-	limit      *float32
-	locale     *string
-	filter     *string
-	sort       *string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	cursor      *string
+	limit       *float32
+	locale      *string
+	filter      *string
+	filterErr   error
+	sort        *string
+	queryParams []queryParam
 }
 
 // A pagination cursor that represents a position in the dataset. If given, results will be returned from the item after the cursor. If not given, results will be returned from the beginning.
@@ -958,6 +1183,46 @@ func (r ListCatalogProductsRequest) Locale(locale string) ApiListCatalogProducts
 
 func (r ListCatalogProductsRequest) Filter(filter string) ApiListCatalogProductsRequest {
 	r.filter = &filter
+	r.filterErr = nil
+	return r
+}
+
+// ListCatalogProductsFilterFields are the fields of the products which can be used in the filter of ListCatalogProducts, see FilterExpr
+var ListCatalogProductsFilterFields = struct {
+	Name           filter.SCIMStringField
+	DeliveryMethod filter.SCIMStringField
+	LifecycleState filter.SCIMStringField
+	ProductId      filter.SCIMStringField
+	Summary        filter.SCIMStringField
+	Text           filter.SCIMStringField
+}{
+	Name:           filter.SCIMString("name"),
+	DeliveryMethod: filter.SCIMString("deliveryMethod"),
+	LifecycleState: filter.SCIMString("lifecycleState"),
+	ProductId:      filter.SCIMString("productId"),
+	Summary:        filter.SCIMString("summary"),
+	Text:           filter.SCIMString("text"),
+}
+
+var listCatalogProductsFilterFields = filter.Fields{
+	"name":           filter.KindString,
+	"deliveryMethod": filter.KindString,
+	"lifecycleState": filter.KindString,
+	"productId":      filter.KindString,
+	"summary":        filter.KindString,
+	"text":           filter.KindString,
+}
+
+// FilterExpr filters the products with expr, built with the fields of ListCatalogProductsFilterFields:
+//
+//	f := stackitmarketplace.ListCatalogProductsFilterFields
+//	client.ListCatalogProducts(ctx).FilterExpr(f.DeliveryMethod.Eq("SAAS").And(f.Text.Contains("database")))
+//
+// If expr uses a field which can't be used in the filter, or with another type, the request fails before it is sent.
+func (r ListCatalogProductsRequest) FilterExpr(expr filter.SCIMExpr) ApiListCatalogProductsRequest {
+	filterStr := expr.String()
+	r.filter = &filterStr
+	r.filterErr = expr.Validate(listCatalogProductsFilterFields)
 	return r
 }
 
@@ -968,7 +1233,43 @@ func (r ListCatalogProductsRequest) Sort(sort string) ApiListCatalogProductsRequ
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListCatalogProductsRequest) SetQueryParam(key, value string) ApiListCatalogProductsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListCatalogProductsRequest) AddQueryParam(key, value string) ApiListCatalogProductsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListCatalogProductsRequest) Clone() ApiListCatalogProductsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListCatalogProductsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListCatalogProductsRequest) Execute() (*ListCatalogProductsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListCatalogProducts")
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListCatalogProductsRequest) execute() (*ListCatalogProductsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1000,6 +1301,9 @@ func (r ListCatalogProductsRequest) Execute() (*ListCatalogProductsResponse, err
 	if r.locale != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "locale", r.locale, "")
 	}
+	if r.filterErr != nil {
+		return localVarReturnValue, fmt.Errorf("invalid filter: %w", r.filterErr)
+	}
 	if r.filter != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "filter", r.filter, "")
 	}
@@ -1023,7 +1327,8 @@ func (r ListCatalogProductsRequest) Execute() (*ListCatalogProductsResponse, err
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListCatalogProducts"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1042,6 +1347,10 @@ func (r ListCatalogProductsRequest) Execute() (*ListCatalogProductsResponse, err
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1054,10 +1363,14 @@ func (r ListCatalogProductsRequest) Execute() (*ListCatalogProductsResponse, err
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1068,7 +1381,7 @@ func (r ListCatalogProductsRequest) Execute() (*ListCatalogProductsResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1079,7 +1392,7 @@ func (r ListCatalogProductsRequest) Execute() (*ListCatalogProductsResponse, err
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1090,7 +1403,7 @@ func (r ListCatalogProductsRequest) Execute() (*ListCatalogProductsResponse, err
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1130,12 +1443,13 @@ func (a *APIClient) ListCatalogProductsExecute(ctx context.Context) (*ListCatalo
 }
 
 type ListVendorSubscriptionsRequest struct {
-	ctx        context.Context
-	apiService *DefaultApiService
-	projectId  string
-	cursor     *string
-	limit      *float32
-	productId  *string
+	ctx         context.Context
+	apiService  *DefaultApiService
+	projectId   string
+	cursor      *string
+	limit       *float32
+	productId   *string
+	queryParams []queryParam
 }
 
 // A pagination cursor that represents a position in the dataset. If given, results will be returned from the item after the cursor. If not given, results will be returned from the beginning.
@@ -1159,7 +1473,43 @@ func (r ListVendorSubscriptionsRequest) ProductId(productId string) ApiListVendo
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ListVendorSubscriptionsRequest) SetQueryParam(key, value string) ApiListVendorSubscriptionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ListVendorSubscriptionsRequest) AddQueryParam(key, value string) ApiListVendorSubscriptionsRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ListVendorSubscriptionsRequest) Clone() ApiListVendorSubscriptionsRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ListVendorSubscriptionsRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ListVendorSubscriptionsRequest) Execute() (*ListVendorSubscriptionsResponse, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ListVendorSubscriptions", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ListVendorSubscriptionsRequest) execute() (*ListVendorSubscriptionsResponse, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1182,6 +1532,9 @@ func (r ListVendorSubscriptionsRequest) Execute() (*ListVendorSubscriptionsRespo
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -1215,7 +1568,8 @@ func (r ListVendorSubscriptionsRequest) Execute() (*ListVendorSubscriptionsRespo
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ListVendorSubscriptions"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1234,6 +1588,10 @@ func (r ListVendorSubscriptionsRequest) Execute() (*ListVendorSubscriptionsRespo
 		return localVarReturnValue, err
 	}
 
+	if streamed, err := client.decodeListStream(r.ctx, localVarHTTPResponse, &localVarReturnValue); streamed {
+		return localVarReturnValue, err
+	}
+
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
@@ -1246,10 +1604,14 @@ func (r ListVendorSubscriptionsRequest) Execute() (*ListVendorSubscriptionsRespo
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1260,7 +1622,7 @@ func (r ListVendorSubscriptionsRequest) Execute() (*ListVendorSubscriptionsRespo
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1271,7 +1633,7 @@ func (r ListVendorSubscriptionsRequest) Execute() (*ListVendorSubscriptionsRespo
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1282,7 +1644,7 @@ func (r ListVendorSubscriptionsRequest) Execute() (*ListVendorSubscriptionsRespo
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1326,6 +1688,7 @@ type ResolveCustomerRequest struct {
 	apiService             *DefaultApiService
 	projectId              string
 	resolveCustomerPayload *ResolveCustomerPayload
+	queryParams            []queryParam
 }
 
 func (r ResolveCustomerRequest) ResolveCustomerPayload(resolveCustomerPayload ResolveCustomerPayload) ApiResolveCustomerRequest {
@@ -1333,7 +1696,43 @@ func (r ResolveCustomerRequest) ResolveCustomerPayload(resolveCustomerPayload Re
 	return r
 }
 
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r ResolveCustomerRequest) SetQueryParam(key, value string) ApiResolveCustomerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r ResolveCustomerRequest) AddQueryParam(key, value string) ApiResolveCustomerRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r ResolveCustomerRequest) Clone() ApiResolveCustomerRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r ResolveCustomerRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	_, err := r.execute()
+	return config.FingerprintFromError(err)
+}
+
 func (r ResolveCustomerRequest) Execute() (*VendorSubscription, error) {
+	localVarReturnValue, err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "ResolveCustomer", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")})
+	}
+	return localVarReturnValue, err
+}
+
+func (r ResolveCustomerRequest) execute() (*VendorSubscription, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -1356,6 +1755,9 @@ func (r ResolveCustomerRequest) Execute() (*VendorSubscription, error) {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return localVarReturnValue, &oapierror.ValidationError{Field: "projectId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return localVarReturnValue, fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -1363,7 +1765,7 @@ func (r ResolveCustomerRequest) Execute() (*VendorSubscription, error) {
 		return localVarReturnValue, fmt.Errorf("projectId must have less than 36 elements")
 	}
 	if r.resolveCustomerPayload == nil {
-		return localVarReturnValue, fmt.Errorf("resolveCustomerPayload is required and must be specified")
+		return localVarReturnValue, &oapierror.ValidationError{Field: "resolveCustomerPayload"}
 	}
 
 	// to determine the Content-Type header
@@ -1385,7 +1787,8 @@ func (r ResolveCustomerRequest) Execute() (*VendorSubscription, error) {
 	}
 	// body params
 	localVarPostBody = r.resolveCustomerPayload
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "ResolveCustomer"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, err
 	}
@@ -1416,10 +1819,14 @@ func (r ResolveCustomerRequest) Execute() (*VendorSubscription, error) {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return localVarReturnValue, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1430,7 +1837,7 @@ func (r ResolveCustomerRequest) Execute() (*VendorSubscription, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1441,7 +1848,7 @@ func (r ResolveCustomerRequest) Execute() (*VendorSubscription, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1452,7 +1859,7 @@ func (r ResolveCustomerRequest) Execute() (*VendorSubscription, error) {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return localVarReturnValue, newErr
@@ -1463,7 +1870,7 @@ func (r ResolveCustomerRequest) Execute() (*VendorSubscription, error) {
 		return localVarReturnValue, newErr
 	}
 
-	err = client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	err = client.decode(r.ctx, &localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 	if err != nil {
 		newErr := &oapierror.GenericOpenAPIError{
 			StatusCode:   localVarHTTPResponse.StatusCode,
@@ -1507,9 +1914,46 @@ type VendorsSubscriptionsRejectRequest struct {
 	apiService     *DefaultApiService
 	projectId      string
 	subscriptionId string
+	queryParams    []queryParam
+}
+
+// SetQueryParam sets the query parameter key to value, e.g. for a parameter the SDK doesn't support yet.
+// The values of key, including the ones set by the other methods of the request, are replaced.
+func (r VendorsSubscriptionsRejectRequest) SetQueryParam(key, value string) ApiVendorsSubscriptionsRejectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value})
+	return r
+}
+
+// AddQueryParam adds value to the values of the query parameter key, e.g. for a parameter the SDK doesn't support yet.
+func (r VendorsSubscriptionsRejectRequest) AddQueryParam(key, value string) ApiVendorsSubscriptionsRejectRequest {
+	r.queryParams = appendQueryParam(r.queryParams, queryParam{key: key, value: value, add: true})
+	return r
+}
+
+// Clone returns a copy of the request, e.g. to configure a partially configured request separately in each goroutine.
+// The methods of a request return a modified copy and leave the request unchanged, so it can also be executed concurrently.
+func (r VendorsSubscriptionsRejectRequest) Clone() ApiVendorsSubscriptionsRejectRequest {
+	r.queryParams = append([]queryParam(nil), r.queryParams...)
+	return r
+}
+
+// Fingerprint returns a hash of the method, path, query parameters and body of the request without sending it,
+// e.g. to detect duplicate requests before they are sent, see config.RequestFingerprint.
+func (r VendorsSubscriptionsRejectRequest) Fingerprint() (string, error) {
+	r.ctx = config.WithFingerprintOnly(r.ctx)
+	err := r.execute()
+	return config.FingerprintFromError(err)
 }
 
 func (r VendorsSubscriptionsRejectRequest) Execute() error {
+	err := r.execute()
+	if err != nil {
+		err = r.apiService.wrapError(err, "VendorsSubscriptionsReject", oapierror.Parameter{Name: "projectId", Value: ParameterValueToString(r.projectId, "projectId")}, oapierror.Parameter{Name: "subscriptionId", Value: ParameterValueToString(r.subscriptionId, "subscriptionId")})
+	}
+	return err
+}
+
+func (r VendorsSubscriptionsRejectRequest) execute() error {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
@@ -1532,6 +1976,12 @@ func (r VendorsSubscriptionsRejectRequest) Execute() error {
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.projectId == "" {
+		return &oapierror.ValidationError{Field: "projectId"}
+	}
+	if r.subscriptionId == "" {
+		return &oapierror.ValidationError{Field: "subscriptionId"}
+	}
 	if strlen(r.projectId) < 36 {
 		return fmt.Errorf("projectId must have at least 36 elements")
 	}
@@ -1562,7 +2012,8 @@ func (r VendorsSubscriptionsRejectRequest) Execute() error {
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	req, err := client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	applyQueryParams(localVarQueryParams, r.queryParams)
+	req, err := client.prepareRequest(config.WithOperation(r.ctx, client.cfg.ServiceName, "VendorsSubscriptionsReject"), localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return err
 	}
@@ -1593,10 +2044,14 @@ func (r VendorsSubscriptionsRejectRequest) Execute() error {
 			StatusCode:   localVarHTTPResponse.StatusCode,
 			Body:         localVarBody,
 			ErrorMessage: localVarHTTPResponse.Status,
+			ContentType:  localVarHTTPResponse.Header.Get("Content-Type"),
+		}
+		if !isErrorModelContentType(newErr.ContentType) {
+			return newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1607,7 +2062,7 @@ func (r VendorsSubscriptionsRejectRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1618,7 +2073,7 @@ func (r VendorsSubscriptionsRejectRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -1629,7 +2084,7 @@ func (r VendorsSubscriptionsRejectRequest) Execute() error {
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v ErrorResponse
-			err = client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = client.decode(r.ctx, &v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.ErrorMessage = err.Error()
 				return newErr
@@ -19,6 +19,7 @@ func NewConfiguration() *config.Configuration {
 	cfg := &config.Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "stackit-sdk-go/stackitmarketplace",
+		ServiceName:   "stackitmarketplace",
 		Debug:         false,
 		Servers: config.ServerConfigurations{
 			{
@@ -36,3 +37,20 @@ func NewConfiguration() *config.Configuration {
 	}
 	return cfg
 }
+
+// operations are the operation ids of the API, registered to check the operations of the responses of a
+// stackitmock.Server
+var operations = []string{
+	"ApproveSubscription",
+	"GetCatalogProduct",
+	"GetVendorSubscription",
+	"InquiriesCreateInquiry",
+	"ListCatalogProducts",
+	"ListVendorSubscriptions",
+	"ResolveCustomer",
+	"VendorsSubscriptionsReject",
+}
+
+func init() {
+	config.RegisterOperations("stackitmarketplace", operations...)
+}
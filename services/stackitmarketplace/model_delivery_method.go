@@ -46,14 +46,9 @@ func (v *DeliveryMethod) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := DeliveryMethod(value)
-	for _, existing := range AllowedDeliveryMethodEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid DeliveryMethod", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewDeliveryMethodFromValue returns a pointer to a valid DeliveryMethod
@@ -77,6 +72,17 @@ func (v DeliveryMethod) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v DeliveryMethod) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v DeliveryMethod) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to deliveryMethod value
 func (v DeliveryMethod) Ptr() *DeliveryMethod {
 	return &v
@@ -48,14 +48,9 @@ func (v *InquiryFormType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := InquiryFormType(value)
-	for _, existing := range AllowedInquiryFormTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid InquiryFormType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewInquiryFormTypeFromValue returns a pointer to a valid InquiryFormType
@@ -79,6 +74,17 @@ func (v InquiryFormType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v InquiryFormType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v InquiryFormType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to inquiryFormType value
 func (v InquiryFormType) Ptr() *InquiryFormType {
 	return &v
@@ -57,14 +57,9 @@ func (v *NoticePeriodTypes) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := NoticePeriodTypes(value)
-	for _, existing := range AllowedNoticePeriodTypesEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid NoticePeriod", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewNoticePeriodTypesFromValue returns a pointer to a valid NoticePeriodTypes
@@ -88,6 +83,17 @@ func (v NoticePeriodTypes) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v NoticePeriodTypes) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v NoticePeriodTypes) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to TypeTypes value
 func (v NoticePeriodTypes) Ptr() *NoticePeriodTypes {
 	return &v
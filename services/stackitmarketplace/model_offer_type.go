@@ -42,14 +42,9 @@ func (v *OfferType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := OfferType(value)
-	for _, existing := range AllowedOfferTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid OfferType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewOfferTypeFromValue returns a pointer to a valid OfferType
@@ -73,6 +68,17 @@ func (v OfferType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v OfferType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v OfferType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to offerType value
 func (v OfferType) Ptr() *OfferType {
 	return &v
@@ -48,14 +48,9 @@ func (v *PriceType) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := PriceType(value)
-	for _, existing := range AllowedPriceTypeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid PriceType", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPriceTypeFromValue returns a pointer to a valid PriceType
@@ -79,6 +74,17 @@ func (v PriceType) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v PriceType) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v PriceType) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to priceType value
 func (v PriceType) Ptr() *PriceType {
 	return &v
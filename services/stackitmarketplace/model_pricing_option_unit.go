@@ -40,14 +40,9 @@ func (v *PricingOptionUnit) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := PricingOptionUnit(value)
-	for _, existing := range AllowedPricingOptionUnitEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid PricingOptionUnit", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewPricingOptionUnitFromValue returns a pointer to a valid PricingOptionUnit
@@ -71,6 +66,17 @@ func (v PricingOptionUnit) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v PricingOptionUnit) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v PricingOptionUnit) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to pricingOptionUnit value
 func (v PricingOptionUnit) Ptr() *PricingOptionUnit {
 	return &v
@@ -42,14 +42,9 @@ func (v *ProductLifecycleState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := ProductLifecycleState(value)
-	for _, existing := range AllowedProductLifecycleStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid ProductLifecycleState", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewProductLifecycleStateFromValue returns a pointer to a valid ProductLifecycleState
@@ -73,6 +68,17 @@ func (v ProductLifecycleState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v ProductLifecycleState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v ProductLifecycleState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to productLifecycleState value
 func (v ProductLifecycleState) Ptr() *ProductLifecycleState {
 	return &v
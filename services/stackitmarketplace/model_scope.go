@@ -44,14 +44,9 @@ func (v *Scope) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := Scope(value)
-	for _, existing := range AllowedScopeEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid Scope", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewScopeFromValue returns a pointer to a valid Scope
@@ -75,6 +70,17 @@ func (v Scope) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v Scope) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v Scope) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to scope value
 func (v Scope) Ptr() *Scope {
 	return &v
@@ -50,14 +50,9 @@ func (v *SubscriptionLifecycleState) UnmarshalJSON(src []byte) error {
 		return nil
 	}
 	enumTypeValue := SubscriptionLifecycleState(value)
-	for _, existing := range AllowedSubscriptionLifecycleStateEnumValues {
-		if existing == enumTypeValue {
-			*v = enumTypeValue
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%+v is not a valid SubscriptionLifecycleState", value)
+	// Unknown values, e.g. added to the API after the SDK was generated, are preserved, see IsKnown
+	*v = enumTypeValue
+	return nil
 }
 
 // NewSubscriptionLifecycleStateFromValue returns a pointer to a valid SubscriptionLifecycleState
@@ -81,6 +76,17 @@ func (v SubscriptionLifecycleState) IsValid() bool {
 	return false
 }
 
+// IsKnown returns true if the value is one of the values of the enum known to the SDK, false otherwise.
+// Unknown values are preserved when unmarshalling, e.g. if they were added to the API after the SDK was generated.
+func (v SubscriptionLifecycleState) IsKnown() bool {
+	return v.IsValid()
+}
+
+// String returns the value of the enum
+func (v SubscriptionLifecycleState) String() string {
+	return string(v)
+}
+
 // Ptr returns reference to subscriptionLifecycleState value
 func (v SubscriptionLifecycleState) Ptr() *SubscriptionLifecycleState {
 	return &v